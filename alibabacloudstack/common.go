@@ -521,6 +521,10 @@ const (
 	TagResourceTopic         = TagResourceType("topic")
 	TagResourceConsumerGroup = TagResourceType("consumergroup")
 	TagResourceCluster       = TagResourceType("cluster")
+	TagResourceNatGateway    = TagResourceType("NATGATEWAY")
+	TagResourceVpnGateway    = TagResourceType("VpnGateway")
+	TagResourceScalingGroup  = TagResourceType("scalinggroup")
+	TagResourceFileSystem    = TagResourceType("filesystem")
 )
 
 type KubernetesNodeType string
@@ -74,6 +74,7 @@ type AlibabacloudStackClient struct {
 	ResourceGroup                string
 	Config                       *Config
 	teaSdkConfig                 rpc.Config
+	teaProxy                     *teaProxy
 	accountId                    string
 	roleId                       int
 	ecsconn                      *ecs.Client
@@ -96,6 +97,7 @@ type AlibabacloudStackClient struct {
 	hbaseconn                    *hbase.Client
 	adbconn                      *adb.Client
 	ossconn                      *oss.Client
+	ossV2conn                    *oss.Client
 	rkvconn                      *r_kvstore.Client
 	fcconn                       *fc.Client
 	ddsconn                      *dds.Client
@@ -145,23 +147,24 @@ var loadSdkEndpointMutex = sync.Mutex{}
 
 // Client for AlibabacloudStackClient
 func (c *Config) Client() (*AlibabacloudStackClient, error) {
-	// Get the auth and region. This can fail if keys/regions were not
-	// specified and we're attempting to use the environment.
-	if !c.SkipRegionValidation {
-		err := c.loadAndValidate()
-		if err != nil {
-			return nil, err
-		}
-	}
-
 	teaSdkConfig, err := c.getTeaDslSdkConfig(true)
 	if err != nil {
 		return nil, err
 	}
+	// The tea sdk clients can not be given a transport, so their requests are sent to a local proxy which applies
+	// the rate limits and the retries of the throttled requests, and the proxy settings are used by the local proxy instead.
+	teaProxy, err := c.getTeaProxy()
+	if err != nil {
+		return nil, err
+	}
+	teaSdkConfig.SetProtocol("HTTP").SetHttpProxy(teaProxy.URL)
+	teaSdkConfig.HttpsProxy = nil
+	teaSdkConfig.Socks5Proxy = nil
 
-	return &AlibabacloudStackClient{
+	client := &AlibabacloudStackClient{
 		Config:                       c,
 		teaSdkConfig:                 teaSdkConfig,
+		teaProxy:                     teaProxy,
 		Region:                       c.Region,
 		RegionId:                     c.RegionId,
 		AccessKey:                    c.AccessKey,
@@ -171,7 +174,24 @@ func (c *Config) Client() (*AlibabacloudStackClient, error) {
 		Domain:                       c.Domain,
 		OtsInstanceName:              c.OtsInstanceName,
 		tablestoreconnByInstanceName: make(map[string]*tablestore.TableStoreClient),
-	}, nil
+	}
+	// Validate the region with the regions of the stack. This can fail if keys/regions were not
+	// specified and we're attempting to use the environment.
+	if !c.SkipRegionValidation {
+		if err := client.validateRegion(); err != nil {
+			return nil, err
+		}
+	}
+	return client, nil
+}
+
+// teaEndpoint returns the endpoint of a tea sdk client, which is allowed by the local proxy the requests are sent through.
+// The clients which are not created by the config send the requests to the endpoint directly.
+func (client *AlibabacloudStackClient) teaEndpoint(endpoint string) string {
+	if client.teaProxy == nil {
+		return endpoint
+	}
+	return client.teaProxy.Allow(endpoint)
 }
 
 func (client *AlibabacloudStackClient) WithEcsClient(do func(*ecs.Client) (interface{}, error)) (interface{}, error) {
@@ -448,9 +468,21 @@ func (client *AlibabacloudStackClient) WithFcClient(do func(*fc.Client) (interfa
 		}
 
 		config := client.getSdkConfig()
-		clientOptions := []fc.ClientOption{fc.WithSecurityToken(client.Config.SecurityToken), fc.WithTransport(config.HttpTransport),
+		transport := config.HttpTransport
+		endpoint = fmt.Sprintf("https://%s.%s", accountId, endpoint)
+		// The fc sdk can only be given a http.Transport, so its requests are sent through the local proxy of the tea sdk
+		// clients, like them.
+		if client.teaProxy != nil {
+			proxy, err := url.Parse(client.teaProxy.URL)
+			if err != nil {
+				return nil, fmt.Errorf("unable to initialize the FC client: %#v", err)
+			}
+			transport = &http.Transport{Proxy: http.ProxyURL(proxy)}
+			endpoint = "http://" + client.teaEndpoint(endpoint)
+		}
+		clientOptions := []fc.ClientOption{fc.WithSecurityToken(client.Config.SecurityToken), fc.WithTransport(transport),
 			fc.WithTimeout(30), fc.WithRetryCount(DefaultClientRetryCountSmall)}
-		fcconn, err := fc.NewClient(fmt.Sprintf("https://%s.%s", accountId, endpoint), string(ApiVersion20160815), client.Config.AccessKey, client.Config.SecretKey, clientOptions...)
+		fcconn, err := fc.NewClient(endpoint, string(ApiVersion20160815), client.Config.AccessKey, client.Config.SecretKey, clientOptions...)
 		if err != nil {
 			return nil, fmt.Errorf("unable to initialize the FC client: %#v", err)
 		}
@@ -591,6 +623,10 @@ func (client *AlibabacloudStackClient) describeEndpointForService(serviceCode st
 	args.Domain = client.Config.LocationEndpoint
 
 	if args.Domain == "" {
+		// The public location service does not exist in the stack which is configured by the domain.
+		if client.Config.Domain != "" {
+			return nil, fmt.Errorf("There is no endpoint for %s in region %s, please set it in the provider endpoints or the endpoints manifest.", serviceCode, client.RegionId)
+		}
 		args.Domain = "location-readonly.aliyuncs.com"
 	}
 
@@ -682,8 +718,9 @@ func (client *AlibabacloudStackClient) NewCommonRequest(product, serviceCode, sc
 
 func (client *AlibabacloudStackClient) getSdkConfig() *sdk.Config {
 	log.Printf("Protocol is set to %s", client.Config.Protocol)
-	return sdk.NewConfig().
-		WithMaxRetryTime(DefaultClientRetryCountSmall).
+	config := sdk.NewConfig().
+		WithAutoRetry(true).
+		WithMaxRetryTime(client.Config.MaxRetries).
 		WithTimeout(time.Duration(30) * time.Second).
 		WithEnableAsync(true).
 		WithGoRoutinePoolSize(100).
@@ -691,6 +728,8 @@ func (client *AlibabacloudStackClient) getSdkConfig() *sdk.Config {
 		WithDebug(false).
 		WithHttpTransport(client.getTransport()).
 		WithScheme(strings.ToLower(client.Config.Protocol))
+	config.Transport = WrapTransport(config.HttpTransport, client.Config)
+	return config
 }
 
 func (client *AlibabacloudStackClient) getTransport() *http.Transport {
@@ -703,6 +742,11 @@ func (client *AlibabacloudStackClient) getTransport() *http.Transport {
 
 	return transport
 }
+
+// getHttpClient returns a http client with the transport of the config, for the sdk clients which are given a http client.
+func (client *AlibabacloudStackClient) getHttpClient() *http.Client {
+	return &http.Client{Transport: WrapTransport(client.getTransport(), client.Config)}
+}
 func (client *AlibabacloudStackClient) AccountId() (string, error) {
 	client.accountIdMutex.Lock()
 	defer client.accountIdMutex.Unlock()
@@ -826,6 +870,7 @@ func (client *AlibabacloudStackClient) GetCallerInfo() (*responses.BaseResponse,
 	if client.Config.Proxy != "" {
 		ascmClient.SetHttpProxy(client.Config.Proxy)
 	}
+	ascmClient.SetTransport(WrapTransport(client.getTransport(), client.Config))
 	if client.Config.Department == "" || client.Config.ResourceGroup == "" {
 		return nil, fmt.Errorf("unable to initialize the ascm client: department or resource_group is not provided")
 	}
@@ -944,7 +989,7 @@ func (client *AlibabacloudStackClient) NewNasClient() (*rpc.Client, error) {
 		return nil, fmt.Errorf("[ERROR] missing the product %s endpoint.", productCode)
 	}
 	sdkConfig := client.teaSdkConfig
-	sdkConfig.SetEndpoint(endpoint)
+	sdkConfig.SetEndpoint(client.teaEndpoint(endpoint))
 	conn, err := rpc.NewClient(&sdkConfig)
 	if err != nil {
 		return nil, fmt.Errorf("unable to initialize the %s client: %#v", productCode, err)
@@ -984,10 +1029,7 @@ func (client *AlibabacloudStackClient) WithOssClientPutObject(do func(*oss.Clien
 		}
 
 		clientOptions := []oss.ClientOption{oss.UserAgent(client.getUserAgent()),
-			oss.SecurityToken(client.Config.SecurityToken)}
-		if client.Config.Proxy != "" {
-			clientOptions = append(clientOptions, oss.Proxy(client.Config.Proxy))
-		}
+			oss.SecurityToken(client.Config.SecurityToken), oss.HTTPClient(client.getHttpClient())}
 
 		clientOptions = append(clientOptions, oss.UseCname(false))
 
@@ -1034,10 +1076,7 @@ func (client *AlibabacloudStackClient) WithOssClient(do func(*oss.Client) (inter
 		}
 
 		clientOptions := []oss.ClientOption{oss.UserAgent(client.getUserAgent()),
-			oss.SecurityToken(client.Config.SecurityToken)}
-		if client.Config.Proxy != "" {
-			clientOptions = append(clientOptions, oss.Proxy(client.Config.Proxy))
-		}
+			oss.SecurityToken(client.Config.SecurityToken), oss.HTTPClient(client.getHttpClient())}
 
 		clientOptions = append(clientOptions, oss.UseCname(false))
 
@@ -1052,6 +1091,38 @@ func (client *AlibabacloudStackClient) WithOssClient(do func(*oss.Client) (inter
 	return do(client.ossconn)
 }
 
+// WithOssV2Client is like WithOssClient, but the requests of the client are signed with V2, which covers all the
+// sub-resources of the bucket. It is a separate client, so the signature of the shared oss client is never changed.
+func (client *AlibabacloudStackClient) WithOssV2Client(do func(*oss.Client) (interface{}, error)) (interface{}, error) {
+	goSdkMutex.Lock()
+	defer goSdkMutex.Unlock()
+
+	// Initialize the OSS client if necessary
+	if client.ossV2conn == nil {
+		endpoint := client.Config.OssEndpoint
+		if endpoint == "" {
+			return nil, fmt.Errorf("unable to initialize the oss client: endpoint or domain is not provided for OSS service")
+		}
+		if !strings.HasPrefix(endpoint, "http") {
+			endpoint = fmt.Sprintf("http://%s", endpoint)
+		}
+
+		clientOptions := []oss.ClientOption{oss.UserAgent(client.getUserAgent()),
+			oss.SecurityToken(client.Config.SecurityToken), oss.AuthVersion(oss.AuthV2), oss.HTTPClient(client.getHttpClient())}
+
+		clientOptions = append(clientOptions, oss.UseCname(false))
+
+		ossconn, err := oss.New(endpoint, client.Config.AccessKey, client.Config.SecretKey, clientOptions...)
+		if err != nil {
+			return nil, fmt.Errorf("unable to initialize the OSS client: %#v", err)
+		}
+
+		client.ossV2conn = ossconn
+	}
+
+	return do(client.ossV2conn)
+}
+
 func (client *AlibabacloudStackClient) WithRamClient(do func(*ram.Client) (interface{}, error)) (interface{}, error) {
 	// Initialize the RAM client if necessary
 	if client.ramconn == nil {
@@ -1161,9 +1232,7 @@ func (client *AlibabacloudStackClient) WithCsClient(do func(*cs.Client) (interfa
 			}
 			csconn.SetEndpoint(endpoint)
 		}
-		if client.Config.Proxy != "" {
-			os.Setenv("http_proxy", client.Config.Proxy)
-		}
+		csconn.SetTransport(WrapTransport(client.getTransport(), client.Config))
 		client.csconn = csconn
 	}
 
@@ -1225,6 +1294,7 @@ func (client *AlibabacloudStackClient) WithOnsClient(do func(*ons.Client) (inter
 		if client.Config.Proxy != "" {
 			onsconn.SetHttpProxy(client.Config.Proxy)
 		}
+		onsconn.SetTransport(WrapTransport(client.getTransport(), client.Config))
 		client.onsconn = onsconn
 	}
 
@@ -1256,7 +1326,10 @@ func (client *AlibabacloudStackClient) WithLogClient(do func(*sls.Client) (inter
 		}
 	}
 
-	return do(client.logconn)
+	// The log sdk can not be given a transport, and it signs every request again, so the calls are retried instead.
+	return client.retryThrottled(func() (interface{}, error) {
+		return do(client.logconn)
+	})
 }
 func (client *AlibabacloudStackClient) WithLogPopClient(do func(*slsPop.Client) (interface{}, error)) (interface{}, error) {
 	// Initialize the HBase client if necessary
@@ -1492,7 +1565,7 @@ func (client *AlibabacloudStackClient) NewHitsdbClient() (*rpc.Client, error) {
 		return nil, fmt.Errorf("[ERROR] missing the product %s endpoint.", productCode)
 	}
 	sdkConfig := client.teaSdkConfig
-	sdkConfig.SetEndpoint(endpoint)
+	sdkConfig.SetEndpoint(client.teaEndpoint(endpoint))
 	conn, err := rpc.NewClient(&sdkConfig)
 	if err != nil {
 		return nil, fmt.Errorf("unable to initialize the %s client: %#v", productCode, err)
@@ -1517,7 +1590,7 @@ func (client *AlibabacloudStackClient) NewOdpsClient() (*rpc.Client, error) {
 		return nil, fmt.Errorf("[ERROR] missing the product %s endpoint.", productCode)
 	}
 	sdkConfig := client.teaSdkConfig
-	sdkConfig.SetEndpoint(endpoint)
+	sdkConfig.SetEndpoint(client.teaEndpoint(endpoint))
 	conn, err := rpc.NewClient(&sdkConfig)
 	if err != nil {
 		return nil, fmt.Errorf("unable to initialize the %s client: %#v", productCode, err)
@@ -1541,7 +1614,7 @@ func (client *AlibabacloudStackClient) NewKmsClient() (*rpc.Client, error) {
 		return nil, fmt.Errorf("[ERROR] missing the product %s endpoint.", productCode)
 	}
 	sdkConfig := client.teaSdkConfig
-	sdkConfig.SetEndpoint(endpoint)
+	sdkConfig.SetEndpoint(client.teaEndpoint(endpoint))
 	conn, err := rpc.NewClient(&sdkConfig)
 	if err != nil {
 		return nil, fmt.Errorf("unable to initialize the %s client: %#v", productCode, err)
@@ -1568,7 +1641,7 @@ func (client *AlibabacloudStackClient) NewAscmClient() (*rpc.Client, error) {
 		}
 	}
 	sdkConfig := client.teaSdkConfig
-	sdkConfig.SetEndpoint(endpoint)
+	sdkConfig.SetEndpoint(client.teaEndpoint(endpoint))
 	conn, err := rpc.NewClient(&sdkConfig)
 	if err != nil {
 		return nil, fmt.Errorf("unable to initialize the %s client: %#v", productCode, err)
@@ -1591,7 +1664,7 @@ func (client *AlibabacloudStackClient) NewCloudApiClient() (*rpc.Client, error)
 	}
 
 	sdkConfig := client.teaSdkConfig
-	sdkConfig.SetEndpoint(endpoint).SetReadTimeout(60000)
+	sdkConfig.SetEndpoint(client.teaEndpoint(endpoint)).SetReadTimeout(60000)
 
 	conn, err := rpc.NewClient(&sdkConfig)
 	if err != nil {
@@ -1615,7 +1688,7 @@ func (client *AlibabacloudStackClient) NewAdsClient() (*rpc.Client, error) {
 		return nil, fmt.Errorf("[ERROR] missing the product %s endpoint.", productCode)
 	}
 	sdkConfig := client.teaSdkConfig
-	sdkConfig.SetEndpoint(endpoint)
+	sdkConfig.SetEndpoint(client.teaEndpoint(endpoint))
 	conn, err := rpc.NewClient(&sdkConfig)
 	if err != nil {
 		return nil, fmt.Errorf("unable to initialize the %s client: %#v", productCode, err)
@@ -1624,7 +1697,7 @@ func (client *AlibabacloudStackClient) NewAdsClient() (*rpc.Client, error) {
 }
 func (client *AlibabacloudStackClient) NewTeaCommonClient(endpoint string) (*rpc.Client, error) {
 	sdkConfig := client.teaSdkConfig
-	sdkConfig.SetEndpoint(endpoint)
+	sdkConfig.SetEndpoint(client.teaEndpoint(endpoint))
 
 	conn, err := rpc.NewClient(&sdkConfig)
 	if err != nil {
@@ -1651,7 +1724,11 @@ func (client *AlibabacloudStackClient) WithTableStoreClient(instanceName string,
 			endpoint = fmt.Sprintf("https://%s", endpoint)
 		}
 
-		tableStoreClient = tablestore.NewClientWithConfig(endpoint, instanceName, client.Config.AccessKey, client.Config.SecretKey, client.Config.SecurityToken, tablestore.NewDefaultTableStoreConfig())
+		// The tablestore sdk can not be given a transport, so its own retry is limited by the retries of the config.
+		tableStoreConfig := tablestore.NewDefaultTableStoreConfig()
+		tableStoreConfig.RetryTimes = uint(client.Config.MaxRetries)
+		tableStoreConfig.MaxRetryTime = time.Duration(client.Config.MaxRetries*client.Config.RetryMaxWait) * time.Second
+		tableStoreClient = tablestore.NewClientWithConfig(endpoint, instanceName, client.Config.AccessKey, client.Config.SecretKey, client.Config.SecurityToken, tableStoreConfig)
 		client.tablestoreconnByInstanceName[instanceName] = tableStoreClient
 	}
 
@@ -1707,7 +1784,8 @@ func (client *AlibabacloudStackClient) WithDataHubClient(do func(api datahub.Dat
 
 		account := datahub.NewStsCredential(client.Config.AccessKey, client.Config.SecretKey, client.Config.SecurityToken)
 		config := &datahub.Config{
-			UserAgent: client.getUserAgent(),
+			UserAgent:  client.getUserAgent(),
+			HttpClient: client.getHttpClient(),
 		}
 
 		client.dhconn = datahub.NewClientWithConfig(endpoint, config, account)
@@ -1731,7 +1809,32 @@ func (client *AlibabacloudStackClient) NewVpcClient() (*rpc.Client, error) {
 	}
 
 	sdkConfig := client.teaSdkConfig
-	sdkConfig.SetEndpoint(endpoint)
+	sdkConfig.SetEndpoint(client.teaEndpoint(endpoint))
+
+	conn, err := rpc.NewClient(&sdkConfig)
+	if err != nil {
+		return nil, fmt.Errorf("unable to initialize the %s client: %#v", productCode, err)
+	}
+
+	return conn, nil
+}
+func (client *AlibabacloudStackClient) NewCenClient() (*rpc.Client, error) {
+	productCode := "cbn"
+	endpoint := client.Config.CenEndpoint
+	if v, ok := client.Config.Endpoints[productCode]; !ok || v.(string) == "" {
+		if err := client.loadEndpoint(productCode); err != nil {
+			return nil, err
+		}
+	}
+	if v, ok := client.Config.Endpoints[productCode]; ok && v.(string) != "" {
+		endpoint = v.(string)
+	}
+	if endpoint == "" {
+		return nil, fmt.Errorf("[ERROR] missing the product %s endpoint.", productCode)
+	}
+
+	sdkConfig := client.teaSdkConfig
+	sdkConfig.SetEndpoint(client.teaEndpoint(endpoint))
 
 	conn, err := rpc.NewClient(&sdkConfig)
 	if err != nil {
@@ -1756,7 +1859,7 @@ func (client *AlibabacloudStackClient) NewEcsClient() (*rpc.Client, error) {
 	}
 
 	sdkConfig := client.teaSdkConfig
-	sdkConfig.SetEndpoint(endpoint).SetReadTimeout(60000)
+	sdkConfig.SetEndpoint(client.teaEndpoint(endpoint)).SetReadTimeout(60000)
 
 	conn, err := rpc.NewClient(&sdkConfig)
 	if err != nil {
@@ -1780,7 +1883,7 @@ func (client *AlibabacloudStackClient) NewElasticsearchClient() (*rpc.Client, er
 		return nil, fmt.Errorf("[ERROR] misssing the product %s endpoint.", productCode)
 	}
 	roaSdkConfig := client.teaSdkConfig
-	roaSdkConfig.SetEndpoint(endpoint)
+	roaSdkConfig.SetEndpoint(client.teaEndpoint(endpoint))
 
 	conn, err := rpc.NewClient(&roaSdkConfig)
 	if err != nil {
@@ -1804,7 +1907,7 @@ func (client *AlibabacloudStackClient) NewRosClient() (*rpc.Client, error) {
 		return nil, fmt.Errorf("[ERROR] missing the product %s endpoint.", productCode)
 	}
 	sdkConfig := client.teaSdkConfig
-	sdkConfig.SetEndpoint(endpoint)
+	sdkConfig.SetEndpoint(client.teaEndpoint(endpoint))
 	conn, err := rpc.NewClient(&sdkConfig)
 	if err != nil {
 		return nil, fmt.Errorf("unable to initialize the %s client: %#v", productCode, err)
@@ -1827,7 +1930,7 @@ func (client *AlibabacloudStackClient) NewRdsClient() (*rpc.Client, error) {
 		return nil, fmt.Errorf("[ERROR] missing the product %s endpoint.", productCode)
 	}
 	sdkConfig := client.teaSdkConfig
-	sdkConfig.SetEndpoint(endpoint)
+	sdkConfig.SetEndpoint(client.teaEndpoint(endpoint))
 	conn, err := rpc.NewClient(&sdkConfig)
 	if err != nil {
 		return nil, fmt.Errorf("unable to initialize the %s client: %#v", productCode, err)
@@ -1842,16 +1945,19 @@ func (client *AlibabacloudStackClient) NewRoaCsClient() (*roaCS.Client, error) {
 		return nil, fmt.Errorf("[ERROR] missing the product %s endpoint.", productCode)
 	}
 	// Initialize the CS client if necessary
-	roaCSConn, err := roaCS.NewClient(&openapi.Config{
+	roaCSConfig := &openapi.Config{
 		AccessKeyId:     tea.String(client.Config.AccessKey),
 		AccessKeySecret: tea.String(client.Config.SecretKey),
 		SecurityToken:   tea.String(client.Config.SecurityToken),
 		RegionId:        tea.String(client.Config.RegionId),
 		UserAgent:       tea.String(client.getUserAgent()),
-		Endpoint:        tea.String(endpoint),
+		Endpoint:        tea.String(client.teaEndpoint(endpoint)),
 		ReadTimeout:     tea.Int(client.Config.ClientReadTimeout),
 		ConnectTimeout:  tea.Int(client.Config.ClientConnectTimeout),
-	})
+		Protocol:        client.teaSdkConfig.Protocol,
+		HttpProxy:       client.teaSdkConfig.HttpProxy,
+	}
+	roaCSConn, err := roaCS.NewClient(roaCSConfig)
 	if err != nil {
 		return nil, err
 	}
@@ -1876,7 +1982,7 @@ func (client *AlibabacloudStackClient) NewDtsClient() (*rpc.Client, error) {
 		return nil, fmt.Errorf("[ERROR] missing the product %s endpoint.", productCode)
 	}
 	sdkConfig := client.teaSdkConfig
-	sdkConfig.SetEndpoint(endpoint)
+	sdkConfig.SetEndpoint(client.teaEndpoint(endpoint))
 	conn, err := rpc.NewClient(&sdkConfig)
 	if err != nil {
 		return nil, fmt.Errorf("unable to initialize the %s client: %#v", productCode, err)
@@ -1901,7 +2007,7 @@ func (client *AlibabacloudStackClient) NewDmsenterpriseClient() (*rpc.Client, er
 		return nil, fmt.Errorf("[ERROR] missing the product %s endpoint.", productCode)
 	}
 	sdkConfig := client.teaSdkConfig
-	sdkConfig.SetEndpoint(endpoint)
+	sdkConfig.SetEndpoint(client.teaEndpoint(endpoint))
 	conn, err := rpc.NewClient(&sdkConfig)
 	if err != nil {
 		return nil, fmt.Errorf("unable to initialize the %s client: %#v", productCode, err)
@@ -1924,7 +2030,7 @@ func (client *AlibabacloudStackClient) NewHbaseClient() (*rpc.Client, error) {
 		return nil, fmt.Errorf("[ERROR] missing the product %s endpoint.", productCode)
 	}
 	sdkConfig := client.teaSdkConfig
-	sdkConfig.SetEndpoint(endpoint)
+	sdkConfig.SetEndpoint(client.teaEndpoint(endpoint))
 	conn, err := rpc.NewClient(&sdkConfig)
 	if err != nil {
 		return nil, fmt.Errorf("unable to initialize the %s client: %#v", productCode, err)
@@ -1983,7 +2089,7 @@ func (client *AlibabacloudStackClient) NewGpdbClient() (*rpc.Client, error) {
 	}
 
 	sdkConfig := client.teaSdkConfig
-	sdkConfig.SetEndpoint(endpoint)
+	sdkConfig.SetEndpoint(client.teaEndpoint(endpoint))
 
 	conn, err := rpc.NewClient(&sdkConfig)
 	if err != nil {
@@ -2011,7 +2117,7 @@ func (client *AlibabacloudStackClient) NewQuickbiClient() (*rpc.Client, error) {
 		return nil, fmt.Errorf("[ERROR] missing the product %s endpoint.", productCode)
 	}
 	sdkConfig := client.teaSdkConfig
-	sdkConfig.SetEndpoint(endpoint)
+	sdkConfig.SetEndpoint(client.teaEndpoint(endpoint))
 	conn, err := rpc.NewClient(&sdkConfig)
 	if err != nil {
 		return nil, fmt.Errorf("unable to initialize the %s client: %#v", productCode, err)
@@ -2037,7 +2143,7 @@ func (client *AlibabacloudStackClient) NewDataworkspublicClient() (*rpc.Client,
 		return nil, fmt.Errorf("[ERROR] missing the product %s endpoint.", productCode)
 	}
 	sdkConfig := client.teaSdkConfig
-	sdkConfig.SetEndpoint(endpoint)
+	sdkConfig.SetEndpoint(client.teaEndpoint(endpoint))
 	conn, err := rpc.NewClient(&sdkConfig)
 	if err != nil {
 		return nil, fmt.Errorf("unable to initialize the %s client: %#v", productCode, err)
@@ -2065,7 +2171,7 @@ func (client *AlibabacloudStackClient) NewDataworksPrivateClient() (*rpc.Client,
 	sdkConfig := client.teaSdkConfig
 	index := strings.Index(endpoint, ".")
 	privateEndpoint := "dataworks" + endpoint[index:]
-	sdkConfig.SetEndpoint(privateEndpoint)
+	sdkConfig.SetEndpoint(client.teaEndpoint(privateEndpoint))
 	conn, err := rpc.NewClient(&sdkConfig)
 	if err != nil {
 		return nil, fmt.Errorf("unable to initialize the %s client: %#v", productCode, err)
@@ -2091,7 +2197,7 @@ func (client *AlibabacloudStackClient) NewDbsClient() (*rpc.Client, error) {
 		return nil, fmt.Errorf("[ERROR] missing the product %s endpoint.", productCode)
 	}
 	sdkConfig := client.teaSdkConfig
-	sdkConfig.SetEndpoint(endpoint)
+	sdkConfig.SetEndpoint(client.teaEndpoint(endpoint))
 	conn, err := rpc.NewClient(&sdkConfig)
 	if err != nil {
 		return nil, fmt.Errorf("unable to initialize the %s client: %#v", productCode, err)
@@ -1,6 +1,7 @@
 package connectivity
 
 import (
+	"context"
 	"fmt"
 	"log"
 
@@ -43,6 +44,7 @@ type Config struct {
 	RamRoleSessionExpiration int
 
 	Endpoints               map[string]interface{}
+	EndpointSources         map[ServiceCode]string
 	EcsEndpoint             string
 	RdsEndpoint             string
 	SlbEndpoint             string
@@ -100,6 +102,9 @@ type Config struct {
 	DataworkspublicEndpoint string
 	DbsEndpoint             string
 	SkipRegionValidation    bool
+	DefaultTags             map[string]string
+	IgnoreTagKeys           []string
+	IgnoreTagKeyPrefixes    []string
 	ConfigurationSource     string
 	CbnEndpoint             string
 	DmsEnterpriseEndpoint   string
@@ -117,26 +122,19 @@ type Config struct {
 	Proxy                   string
 	Domain                  string
 	QuickbiEndpoint         string
-}
-
-func (c *Config) loadAndValidate() error {
-	err := c.validateRegion()
-	if err != nil {
-		return err
-	}
 
-	return nil
-}
-
-func (c *Config) validateRegion() error {
+	MaxRetries   int
+	RetryMaxWait int
+	RateLimits   map[string]int
+	rateLimiter  *RateLimiter
+	teaProxy     *teaProxy
 
-	for _, valid := range ValidRegions {
-		if c.Region == valid {
-			return nil
-		}
-	}
+	// QuotaCheck is one of QuotaCheckOff and QuotaCheckError.
+	QuotaCheck  string
+	quotaLedger *QuotaLedger
 
-	return fmt.Errorf("Invalid AlibabacloudStack Cloud region: %s", c.RegionId)
+	// StopContext is cancelled when terraform is interrupted.
+	StopContext context.Context
 }
 
 func (c *Config) getAuthCredential(stsSupported bool) auth.Credential {
@@ -1,11 +1,15 @@
 package connectivity
 
 import (
+	"encoding/json"
 	"encoding/xml"
 	"fmt"
 	"io/ioutil"
 	"os"
+	"path/filepath"
 	"strings"
+
+	"github.com/go-yaml/yaml"
 )
 
 // ServiceCode Load endpoints from endpoints.xml or environment variables to meet specified application scenario, like private cloud.
@@ -75,6 +79,20 @@ const (
 	OtsCode             = ServiceCode("OTS")
 	DatahubCode         = ServiceCode("DATAHUB")
 	STSCode             = ServiceCode("STS")
+	HITSDBCode          = ServiceCode("HITSDB")
+	ROSCode             = ServiceCode("ROS")
+	DTSCode             = ServiceCode("DTS")
+	QUICKBICode         = ServiceCode("QUICKBI")
+	DATAWORKSPUBLICCode = ServiceCode("DATAWORKSPUBLIC")
+	DBSCode             = ServiceCode("DBS")
+)
+
+// The sources of the resolved endpoints, from the highest precedence to the lowest.
+const (
+	EndpointSourceProvider    = "provider"
+	EndpointSourceEnvironment = "environment"
+	EndpointSourceManifest    = "manifest"
+	EndpointSourceDomain      = "domain"
 )
 
 type Endpoints struct {
@@ -120,6 +138,26 @@ func hasLocalEndpoint() bool {
 	return len(data) > 0
 }
 
+// parseEndpointsXml returns the endpoints of the region in the endpoints.xml, keyed by the product name.
+func parseEndpointsXml(data []byte, region string) (map[string]string, error) {
+	var endpoints Endpoints
+	if err := xml.Unmarshal(data, &endpoints); err != nil {
+		return nil, err
+	}
+	result := make(map[string]string)
+	for _, endpoint := range endpoints.Endpoint {
+		if endpoint.RegionIds.RegionId != region {
+			continue
+		}
+		for _, product := range endpoint.Products.Product {
+			if _, ok := result[strings.ToLower(product.ProductName)]; !ok {
+				result[strings.ToLower(product.ProductName)] = strings.TrimSpace(product.DomainName)
+			}
+		}
+	}
+	return result, nil
+}
+
 func loadEndpoint(region string, serviceCode ServiceCode) string {
 	endpoint := strings.TrimSpace(os.Getenv(fmt.Sprintf("%s_ENDPOINT", string(serviceCode))))
 	if endpoint != "" {
@@ -138,22 +176,11 @@ func loadEndpoint(region string, serviceCode ServiceCode) string {
 		}
 		data = d
 	}
-	var endpoints Endpoints
-	err = xml.Unmarshal(data, &endpoints)
+	endpoints, err := parseEndpointsXml(data, region)
 	if err != nil {
 		return ""
 	}
-	for _, endpoint := range endpoints.Endpoint {
-		if endpoint.RegionIds.RegionId == string(region) {
-			for _, product := range endpoint.Products.Product {
-				if strings.ToLower(product.ProductName) == strings.ToLower(string(serviceCode)) {
-					return strings.TrimSpace(product.DomainName)
-				}
-			}
-		}
-	}
-
-	return ""
+	return endpoints[strings.ToLower(string(serviceCode))]
 }
 
 func (client *AlibabacloudStackClient) loadEndpoint(productCode string) error {
@@ -189,6 +216,171 @@ func (client *AlibabacloudStackClient) loadEndpoint(productCode string) error {
 	return err
 }
 
+// NormalizeServiceCode converts a product name like "dms_enterprise", "dbs_endpoint" or "cloudapi" to its service code.
+func NormalizeServiceCode(name string) ServiceCode {
+	code := strings.TrimSuffix(strings.ToLower(strings.TrimSpace(name)), "_endpoint")
+	code = strings.NewReplacer("_", "", "-", "", " ", "").Replace(code)
+	if mapped, ok := serviceCodeMapping[code]; ok {
+		code = mapped
+	}
+	return ServiceCode(strings.ToUpper(code))
+}
+
+// LoadEndpointsManifest reads the endpoints of the region from the manifest provided by the stack, keyed by the service code.
+// The manifest can be the endpoints.xml, or a JSON or YAML object of the product names and the endpoints, like {"ecs": "ecs.example.com"}.
+func LoadEndpointsManifest(path, region string) (map[ServiceCode]string, error) {
+	data, err := ioutil.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("reading the endpoints manifest %s got an error: %#v", path, err)
+	}
+	content := strings.TrimSpace(string(data))
+	products := make(map[string]string)
+	switch {
+	case strings.EqualFold(filepath.Ext(path), ".xml") || strings.HasPrefix(content, "<"):
+		products, err = parseEndpointsXml(data, region)
+	case strings.EqualFold(filepath.Ext(path), ".json") || strings.HasPrefix(content, "{"):
+		err = json.Unmarshal(data, &products)
+	default:
+		err = yaml.Unmarshal(data, &products)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("parsing the endpoints manifest %s got an error: %#v", path, err)
+	}
+	endpoints := make(map[ServiceCode]string)
+	for product, endpoint := range products {
+		if endpoint = strings.TrimSpace(endpoint); endpoint != "" {
+			endpoints[NormalizeServiceCode(product)] = endpoint
+		}
+	}
+	return endpoints, nil
+}
+
+type endpointField struct {
+	code  ServiceCode
+	field *string
+}
+
+// endpointFields returns the endpoint fields of the config with their service codes.
+func (c *Config) endpointFields() []endpointField {
+	return []endpointField{
+		{EcsCode, &c.EcsEndpoint},
+		{RDSCode, &c.RdsEndpoint},
+		{SLBCode, &c.SlbEndpoint},
+		{VPCCode, &c.VpcEndpoint},
+		{CENCode, &c.CenEndpoint},
+		{ESSCode, &c.EssEndpoint},
+		{OSSCode, &c.OssEndpoint},
+		{ONSCode, &c.OnsEndpoint},
+		{ALIKAFKACode, &c.AlikafkaEndpoint},
+		{DNSCode, &c.DnsEndpoint},
+		{RAMCode, &c.RamEndpoint},
+		{CONTAINCode, &c.CsEndpoint},
+		{DTSCode, &c.DtsEndpoint},
+		{CRCode, &c.CrEndpoint},
+		{CDNCode, &c.CdnEndpoint},
+		{KMSCode, &c.KmsEndpoint},
+		{OTSCode, &c.OtsEndpoint},
+		{CMSCode, &c.CmsEndpoint},
+		{PVTZCode, &c.PvtzEndpoint},
+		{ASCMCode, &c.AscmEndpoint},
+		{LOGCode, &c.LogEndpoint},
+		{DRDSCode, &c.DrdsEndpoint},
+		{DDSCode, &c.DdsEndpoint},
+		{GPDBCode, &c.GpdbEndpoint},
+		{KVSTORECode, &c.KVStoreEndpoint},
+		{POLARDBCode, &c.PolarDBEndpoint},
+		{FCCode, &c.FcEndpoint},
+		{CLOUDAPICode, &c.ApigatewayEndpoint},
+		{DATAHUBCode, &c.DatahubEndpoint},
+		{MNSCode, &c.MnsEndpoint},
+		{LOCATIONCode, &c.LocationEndpoint},
+		{ELASTICSEARCHCode, &c.ElasticsearchEndpoint},
+		{NasCode, &c.NasEndpoint},
+		{BSSOPENAPICode, &c.BssOpenApiEndpoint},
+		{BSSOPENAPICode, &c.BssopenapiEndpoint},
+		{DDOSCOOCode, &c.DdoscooEndpoint},
+		{DDOSBGPCode, &c.DdosbgpEndpoint},
+		{SAGCode, &c.SagEndpoint},
+		{EMRCode, &c.EmrEndpoint},
+		{CasCode, &c.CasEndpoint},
+		{MARKETCode, &c.MarketEndpoint},
+		{HBASECode, &c.HBaseEndpoint},
+		{ADBCode, &c.AdbEndpoint},
+		{STSCode, &c.StsEndpoint},
+		{MAXCOMPUTECode, &c.MaxComputeEndpoint},
+		{HITSDBCode, &c.HitsdbEndpoint},
+		{ROSCode, &c.RosEndpoint},
+		{EDASCode, &c.EdasEndpoint},
+		{DATAWORKSPUBLICCode, &c.DataworkspublicEndpoint},
+		{DBSCode, &c.DbsEndpoint},
+		{CbnCode, &c.CbnEndpoint},
+		{DmsEnterpriseCode, &c.DmsEnterpriseEndpoint},
+		{WafOpenapiCode, &c.WafOpenapiEndpoint},
+		{ResourcemanagerCode, &c.ResourcemanagerEndpoint},
+		{AlidnsCode, &c.AlidnsEndpoint},
+		{CassandraCode, &c.CassandraEndpoint},
+		{EciCode, &c.EciEndpoint},
+		{OosCode, &c.OosEndpoint},
+		{DcdnCode, &c.DcdnEndpoint},
+		{MseCode, &c.MseEndpoint},
+		{ActiontrailCode, &c.ActiontrailEndpoint},
+		{QUICKBICode, &c.QuickbiEndpoint},
+	}
+}
+
+// domainServiceCodes are the services which use the domain of the provider when their endpoints are not set.
+var domainServiceCodes = []ServiceCode{
+	EcsCode, VPCCode, SLBCode, OSSCode, ASCMCode, RDSCode, ONSCode, KMSCode, LOGCode, CRCode, ESSCode, DNSCode,
+	KVSTORECode, GPDBCode, DDSCode, CONTAINCode, CMSCode, HITSDBCode, MAXCOMPUTECode, OTSCode, DATAHUBCode, EDASCode,
+	ADBCode, ROSCode, DTSCode, ALIKAFKACode, NasCode, CLOUDAPICode, DmsEnterpriseCode, HBASECode, DRDSCode, QUICKBICode,
+	ELASTICSEARCHCode, DATAWORKSPUBLICCode, DBSCode,
+}
+
+// SetEndpoint sets the endpoint of the product, like "ecs" or "dms_enterprise". It returns false if the product is unknown.
+func (c *Config) SetEndpoint(product, endpoint string) bool {
+	code := NormalizeServiceCode(product)
+	found := false
+	for _, f := range c.endpointFields() {
+		if f.code == code {
+			*f.field = strings.TrimSpace(endpoint)
+			found = true
+		}
+	}
+	return found
+}
+
+// ResolveEndpoints fills the endpoints which are not set by the provider arguments. The precedence is, from the highest to the lowest:
+// the provider arguments, the environment variable <SERVICE_CODE>_ENDPOINT like ECS_ENDPOINT, the endpoints manifest and the domain.
+// The source of every resolved endpoint is recorded in EndpointSources.
+func (c *Config) ResolveEndpoints(manifest map[ServiceCode]string, domain string) {
+	if c.Endpoints == nil {
+		c.Endpoints = make(map[string]interface{})
+	}
+	c.EndpointSources = make(map[ServiceCode]string)
+	useDomain := make(map[ServiceCode]bool)
+	for _, code := range domainServiceCodes {
+		useDomain[code] = domain != ""
+	}
+	for _, f := range c.endpointFields() {
+		source := EndpointSourceProvider
+		if *f.field == "" {
+			if endpoint := strings.TrimSpace(os.Getenv(fmt.Sprintf("%s_ENDPOINT", string(f.code)))); endpoint != "" {
+				*f.field, source = endpoint, EndpointSourceEnvironment
+			} else if endpoint := manifest[f.code]; endpoint != "" {
+				*f.field, source = endpoint, EndpointSourceManifest
+			} else if useDomain[f.code] {
+				*f.field, source = domain, EndpointSourceDomain
+			} else {
+				continue
+			}
+		}
+		if _, ok := c.EndpointSources[f.code]; !ok {
+			c.EndpointSources[f.code] = source
+			c.Endpoints[strings.ToLower(string(f.code))] = *f.field
+		}
+	}
+}
+
 const (
 	OpenOtsService        = "ots.cn-hangzhou.aliyuncs.com"
 	OpenDatahubService    = "datahub.aliyuncs.com"
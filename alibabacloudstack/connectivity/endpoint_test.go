@@ -0,0 +1,89 @@
+package connectivity
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func TestLoadEndpointsManifest(t *testing.T) {
+	dir, err := ioutil.TempDir("", "endpoints")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+
+	manifests := map[string]string{
+		"endpoints.xml": `<?xml version="1.0" encoding="UTF-8"?>
+<Endpoints>
+  <Endpoint name="cn-qingdao-env66-d01">
+    <RegionIds><RegionId>cn-qingdao-env66-d01</RegionId></RegionIds>
+    <Products>
+      <Product><ProductName>Ecs</ProductName><DomainName>ecs.example.com</DomainName></Product>
+      <Product><ProductName>DmsEnterprise</ProductName><DomainName>dms.example.com</DomainName></Product>
+    </Products>
+  </Endpoint>
+  <Endpoint name="cn-other">
+    <RegionIds><RegionId>cn-other</RegionId></RegionIds>
+    <Products>
+      <Product><ProductName>Vpc</ProductName><DomainName>vpc.other.com</DomainName></Product>
+    </Products>
+  </Endpoint>
+</Endpoints>`,
+		"endpoints.json": `{"ecs": "ecs.example.com", "dms_enterprise": "dms.example.com"}`,
+		"endpoints.yaml": "ecs: ecs.example.com\ndms-enterprise: dms.example.com\n",
+	}
+	for name, content := range manifests {
+		path := filepath.Join(dir, name)
+		if err := ioutil.WriteFile(path, []byte(content), 0600); err != nil {
+			t.Fatal(err)
+		}
+		endpoints, err := LoadEndpointsManifest(path, "cn-qingdao-env66-d01")
+		if err != nil {
+			t.Fatalf("loading %s got an error: %v", name, err)
+		}
+		if len(endpoints) != 2 || endpoints[EcsCode] != "ecs.example.com" || endpoints[DmsEnterpriseCode] != "dms.example.com" {
+			t.Fatalf("unexpected endpoints of %s: %v", name, endpoints)
+		}
+	}
+
+	if _, err := LoadEndpointsManifest(filepath.Join(dir, "missing.json"), "cn-qingdao-env66-d01"); err == nil {
+		t.Fatalf("expected an error for the missing manifest")
+	}
+}
+
+func TestResolveEndpoints(t *testing.T) {
+	defer os.Unsetenv("VPC_ENDPOINT")
+	os.Setenv("VPC_ENDPOINT", "vpc.environment.com")
+
+	config := &Config{EcsEndpoint: "ecs.provider.com"}
+	config.SetEndpoint("dbs_endpoint", "dbs.provider.com")
+	config.ResolveEndpoints(map[ServiceCode]string{
+		EcsCode: "ecs.manifest.com",
+		VPCCode: "vpc.manifest.com",
+		RDSCode: "rds.manifest.com",
+		RAMCode: "ram.manifest.com",
+	}, "domain.com")
+
+	expected := map[ServiceCode][2]string{
+		EcsCode: {"ecs.provider.com", EndpointSourceProvider},
+		DBSCode: {"dbs.provider.com", EndpointSourceProvider},
+		VPCCode: {"vpc.environment.com", EndpointSourceEnvironment},
+		RDSCode: {"rds.manifest.com", EndpointSourceManifest},
+		RAMCode: {"ram.manifest.com", EndpointSourceManifest},
+		SLBCode: {"domain.com", EndpointSourceDomain},
+	}
+	for code, want := range expected {
+		if got := config.Endpoints[strings.ToLower(string(code))]; got != want[0] || config.EndpointSources[code] != want[1] {
+			t.Fatalf("expected the endpoint of %s to be %s from %s, got %v from %s", code, want[0], want[1], got, config.EndpointSources[code])
+		}
+	}
+	if config.SlbEndpoint != "domain.com" || config.RdsEndpoint != "rds.manifest.com" {
+		t.Fatalf("expected the endpoint fields to be resolved, got %s and %s", config.SlbEndpoint, config.RdsEndpoint)
+	}
+	if _, ok := config.EndpointSources[CENCode]; ok {
+		t.Fatalf("expected the domain not to be used by %s", CENCode)
+	}
+}
@@ -0,0 +1,69 @@
+package connectivity
+
+import (
+	"sort"
+	"sync"
+)
+
+const (
+	QuotaCheckOff   = "off"
+	QuotaCheckError = "error"
+)
+
+var quotaLedgerMutex = sync.Mutex{}
+
+// QuotaLedger keeps the remaining amounts of the quotas described at their first check, and the amounts requested by
+// the resources planned since then, so that all the resources of a plan are checked against the quotas together.
+type QuotaLedger struct {
+	remaining map[string]map[string]int
+	requested map[string]map[string]int
+	mutex     sync.Mutex
+}
+
+// QuotaExceeded is a quota item whose requested amount is more than the remaining amount.
+type QuotaExceeded struct {
+	Item      string
+	Requested int
+	Remaining int
+}
+
+func NewQuotaLedger() *QuotaLedger {
+	return &QuotaLedger{
+		remaining: make(map[string]map[string]int),
+		requested: make(map[string]map[string]int),
+	}
+}
+
+// Request adds the amounts to the requested amounts of the quota, and returns the items which are exceeded.
+// The remaining amounts are described at the first request of the quota, and the items which are not in them are not limited.
+func (l *QuotaLedger) Request(quota string, amounts map[string]int, describe func() (map[string]int, error)) ([]QuotaExceeded, error) {
+	l.mutex.Lock()
+	defer l.mutex.Unlock()
+	remaining, ok := l.remaining[quota]
+	if !ok {
+		var err error
+		if remaining, err = describe(); err != nil {
+			return nil, err
+		}
+		l.remaining[quota] = remaining
+		l.requested[quota] = make(map[string]int)
+	}
+	var exceeded []QuotaExceeded
+	for item, amount := range amounts {
+		l.requested[quota][item] += amount
+		if limit, ok := remaining[item]; ok && l.requested[quota][item] > limit {
+			exceeded = append(exceeded, QuotaExceeded{Item: item, Requested: l.requested[quota][item], Remaining: limit})
+		}
+	}
+	sort.Slice(exceeded, func(i, j int) bool { return exceeded[i].Item < exceeded[j].Item })
+	return exceeded, nil
+}
+
+func (c *Config) GetQuotaLedger() *QuotaLedger {
+	quotaLedgerMutex.Lock()
+	defer quotaLedgerMutex.Unlock()
+	if c.quotaLedger == nil {
+		c.quotaLedger = NewQuotaLedger()
+	}
+	return c.quotaLedger
+}
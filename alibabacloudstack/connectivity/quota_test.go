@@ -0,0 +1,30 @@
+package connectivity
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestQuotaLedgerRequest(t *testing.T) {
+	ledger := NewQuotaLedger()
+	described := 0
+	describe := func() (map[string]int, error) {
+		described++
+		return map[string]int{"cpu": 8, "mem": 16}, nil
+	}
+
+	exceeded, err := ledger.Request("resourceGroup:1:ECS", map[string]int{"cpu": 4, "mem": 8, "gpu": 1}, describe)
+	if err != nil || len(exceeded) != 0 {
+		t.Fatalf("expected the first request to fit in the quota, got %v, %v", exceeded, err)
+	}
+	exceeded, err = ledger.Request("resourceGroup:1:ECS", map[string]int{"cpu": 6, "mem": 8, "gpu": 1}, describe)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if expected := []QuotaExceeded{{Item: "cpu", Requested: 10, Remaining: 8}}; !reflect.DeepEqual(exceeded, expected) {
+		t.Fatalf("expected %v to be exceeded, got %v", expected, exceeded)
+	}
+	if described != 1 {
+		t.Fatalf("expected the remaining quota to be described once, got %d", described)
+	}
+}
@@ -0,0 +1,406 @@
+package connectivity
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"log"
+	"net/http"
+	"net/url"
+	"os"
+	"path/filepath"
+	"regexp"
+	"sort"
+	"strings"
+	"sync"
+)
+
+// RecordMode decides whether the api traffic is recorded into, or replayed from, a cassette file.
+// It is used to run the acceptance tests without a live Apsara Stack environment.
+type RecordMode string
+
+const (
+	RecordModeNone   = RecordMode("")
+	RecordModeRecord = RecordMode("record")
+	RecordModeReplay = RecordMode("replay")
+)
+
+const (
+	RecordModeEnv    = "ALIBABACLOUDSTACK_RECORD_MODE"
+	RecordDirEnv     = "ALIBABACLOUDSTACK_RECORD_DIR"
+	DefaultRecordDir = "testdata/recordings"
+)
+
+// The parameters are different in every request or contain credentials, so they are not written into the cassette
+// and are ignored when matching a request.
+var recordIgnoredParams = map[string]bool{
+	"AccessKeyId":      true,
+	"AccessKeySecret":  true,
+	"SecurityToken":    true,
+	"Signature":        true,
+	"SignatureMethod":  true,
+	"SignatureNonce":   true,
+	"SignatureType":    true,
+	"SignatureVersion": true,
+	"Timestamp":        true,
+	"ClientToken":      true,
+}
+
+var recordedResponseHeaders = []string{"Content-Type", "X-Acs-Request-Id"}
+
+// The provider settings which are saved with the cassette and restored when replaying it.
+// The credentials are never saved.
+var RecordedEnvs = []string{
+	"ALIBABACLOUDSTACK_REGION",
+	"ALIBABACLOUDSTACK_DOMAIN",
+	"ALIBABACLOUDSTACK_INSECURE",
+	"ALIBABACLOUDSTACK_PROXY",
+	"ALIBABACLOUDSTACK_DEPARTMENT",
+	"ALIBABACLOUDSTACK_RESOURCE_GROUP",
+	"ALIBABACLOUDSTACK_RESOURCE_GROUP_SET",
+}
+
+type Cassette struct {
+	Name         string            `json:"name"`
+	Env          map[string]string `json:"env"`
+	Interactions []*Interaction    `json:"interactions"`
+}
+
+type Interaction struct {
+	Request  RecordedRequest  `json:"request"`
+	Response RecordedResponse `json:"response"`
+	replayed bool
+}
+
+type RecordedRequest struct {
+	Method  string            `json:"method"`
+	Path    string            `json:"path"`
+	Action  string            `json:"action"`
+	Version string            `json:"version"`
+	Params  map[string]string `json:"params"`
+}
+
+type RecordedResponse struct {
+	StatusCode int               `json:"status_code"`
+	Header     map[string]string `json:"header"`
+	Body       string            `json:"body"`
+}
+
+// Recorder is a http.RoundTripper which saves every request and response into a cassette when recording,
+// and answers the requests with the saved responses when replaying.
+type Recorder struct {
+	mode     RecordMode
+	path     string
+	cassette *Cassette
+
+	// The values in the replayed requests which are different from the recorded ones, like the random resource names.
+	// They are replaced in the recorded responses.
+	replacements map[string]string
+
+	mutex sync.Mutex
+}
+
+var activeRecorder *Recorder
+var activeRecorderMutex = sync.RWMutex{}
+
+// StartRecorder starts recording or replaying the cassette with the given name according to the ALIBABACLOUDSTACK_RECORD_MODE.
+// It returns nil when the record mode is not set.
+func StartRecorder(name string) (*Recorder, error) {
+	mode := RecordMode(strings.ToLower(strings.TrimSpace(os.Getenv(RecordModeEnv))))
+	if mode == RecordModeNone {
+		return nil, nil
+	}
+	dir := strings.TrimSpace(os.Getenv(RecordDirEnv))
+	if dir == "" {
+		dir = DefaultRecordDir
+	}
+	recorder, err := NewRecorder(mode, filepath.Join(dir, cassetteFileName(name)))
+	if err != nil {
+		return nil, err
+	}
+	recorder.cassette.Name = name
+
+	activeRecorderMutex.Lock()
+	defer activeRecorderMutex.Unlock()
+	if activeRecorder != nil {
+		return nil, fmt.Errorf("the cassette %s is still in use, the tests with a recorder can not run in parallel", activeRecorder.cassette.Name)
+	}
+	activeRecorder = recorder
+	return recorder, nil
+}
+
+// ActiveRecorder returns the recorder which is started by StartRecorder, or nil.
+func ActiveRecorder() *Recorder {
+	activeRecorderMutex.RLock()
+	defer activeRecorderMutex.RUnlock()
+	return activeRecorder
+}
+
+func NewRecorder(mode RecordMode, path string) (*Recorder, error) {
+	recorder := &Recorder{
+		mode:         mode,
+		path:         path,
+		cassette:     &Cassette{Env: map[string]string{}},
+		replacements: map[string]string{},
+	}
+	switch mode {
+	case RecordModeRecord:
+		for _, env := range RecordedEnvs {
+			if v := os.Getenv(env); v != "" {
+				recorder.cassette.Env[env] = v
+			}
+		}
+	case RecordModeReplay:
+		data, err := ioutil.ReadFile(path)
+		if err != nil {
+			return nil, fmt.Errorf("reading the cassette %s got an error: %#v", path, err)
+		}
+		if err := json.Unmarshal(data, recorder.cassette); err != nil {
+			return nil, fmt.Errorf("parsing the cassette %s got an error: %#v", path, err)
+		}
+	default:
+		return nil, fmt.Errorf("invalid %s %q, expected %q or %q", RecordModeEnv, mode, RecordModeRecord, RecordModeReplay)
+	}
+	return recorder, nil
+}
+
+func (r *Recorder) Mode() RecordMode {
+	return r.mode
+}
+
+// Env returns the provider settings saved with the cassette.
+func (r *Recorder) Env() map[string]string {
+	return r.cassette.Env
+}
+
+// Stop releases the recorder and, when recording, writes the cassette file.
+func (r *Recorder) Stop() error {
+	activeRecorderMutex.Lock()
+	if activeRecorder == r {
+		activeRecorder = nil
+	}
+	activeRecorderMutex.Unlock()
+
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+	if r.mode != RecordModeRecord {
+		return nil
+	}
+	data, err := json.MarshalIndent(r.cassette, "", "  ")
+	if err != nil {
+		return err
+	}
+	if err := os.MkdirAll(filepath.Dir(r.path), 0755); err != nil {
+		return err
+	}
+	return ioutil.WriteFile(r.path, data, 0644)
+}
+
+// Wrap returns a http.RoundTripper which sends the requests through the recorder.
+// The transport is only used to reach the real endpoint when recording.
+func (r *Recorder) Wrap(transport http.RoundTripper) http.RoundTripper {
+	return &recorderTransport{recorder: r, transport: transport}
+}
+
+type recorderTransport struct {
+	recorder  *Recorder
+	transport http.RoundTripper
+}
+
+func (t *recorderTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	return t.recorder.roundTrip(req, t.transport)
+}
+
+func (r *Recorder) roundTrip(req *http.Request, transport http.RoundTripper) (*http.Response, error) {
+	var body []byte
+	if req.Body != nil {
+		data, err := ioutil.ReadAll(req.Body)
+		req.Body.Close()
+		if err != nil {
+			return nil, err
+		}
+		body = data
+		req.Body = ioutil.NopCloser(bytes.NewReader(body))
+	}
+	recorded := newRecordedRequest(req, body)
+
+	if r.mode == RecordModeReplay {
+		interaction, err := r.replay(recorded)
+		if err != nil {
+			return nil, err
+		}
+		return r.newResponse(req, interaction.Response), nil
+	}
+
+	resp, err := transport.RoundTrip(req)
+	if err != nil {
+		return nil, err
+	}
+	respBody, err := ioutil.ReadAll(resp.Body)
+	resp.Body.Close()
+	if err != nil {
+		return nil, err
+	}
+	resp.Body = ioutil.NopCloser(bytes.NewReader(respBody))
+
+	header := make(map[string]string)
+	for _, key := range recordedResponseHeaders {
+		if v := resp.Header.Get(key); v != "" {
+			header[key] = v
+		}
+	}
+	r.mutex.Lock()
+	r.cassette.Interactions = append(r.cassette.Interactions, &Interaction{
+		Request: recorded,
+		Response: RecordedResponse{
+			StatusCode: resp.StatusCode,
+			Header:     header,
+			Body:       string(respBody),
+		},
+	})
+	r.mutex.Unlock()
+	return resp, nil
+}
+
+// replay finds the first interaction which has not been replayed and has the same action, version and parameters.
+// If there is none, the first not replayed interaction of the same action is used and the different parameter values
+// are remembered, because the acceptance tests generate random names in every run. Polling requests keep getting
+// the last matched response after all of their recorded responses have been replayed.
+func (r *Recorder) replay(req RecordedRequest) (*Interaction, error) {
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+
+	var candidate, replayed *Interaction
+	for _, interaction := range r.cassette.Interactions {
+		recorded := interaction.Request
+		if recorded.Method != req.Method || recorded.Path != req.Path || recorded.Action != req.Action || recorded.Version != req.Version {
+			continue
+		}
+		sameParams := r.sameParams(recorded.Params, req.Params)
+		if interaction.replayed {
+			if sameParams {
+				replayed = interaction
+			}
+			continue
+		}
+		if sameParams {
+			interaction.replayed = true
+			return interaction, nil
+		}
+		if candidate == nil {
+			candidate = interaction
+		}
+	}
+	if candidate != nil {
+		for key, value := range candidate.Request.Params {
+			if v, ok := req.Params[key]; ok && v != r.replace(value) && len(value) >= 6 {
+				log.Printf("[DEBUG] replaying %s: replacing %q with %q", req.Action, value, v)
+				r.replacements[value] = v
+			}
+		}
+		candidate.replayed = true
+		return candidate, nil
+	}
+	if replayed != nil {
+		return replayed, nil
+	}
+	return nil, fmt.Errorf("there is no recorded interaction for %s %s (action: %s, version: %s, params: %v) in the cassette %s",
+		req.Method, req.Path, req.Action, req.Version, req.Params, r.path)
+}
+
+func (r *Recorder) sameParams(recorded, params map[string]string) bool {
+	if len(recorded) != len(params) {
+		return false
+	}
+	for key, value := range recorded {
+		if v, ok := params[key]; !ok || v != r.replace(value) {
+			return false
+		}
+	}
+	return true
+}
+
+func (r *Recorder) replace(value string) string {
+	keys := make([]string, 0, len(r.replacements))
+	for key := range r.replacements {
+		keys = append(keys, key)
+	}
+	// Replace the longer values firstly, because the random names are usually the prefix of the other names.
+	sort.Slice(keys, func(i, j int) bool {
+		return len(keys[i]) > len(keys[j])
+	})
+	for _, key := range keys {
+		value = strings.Replace(value, key, r.replacements[key], -1)
+	}
+	return value
+}
+
+func (r *Recorder) newResponse(req *http.Request, recorded RecordedResponse) *http.Response {
+	r.mutex.Lock()
+	body := r.replace(recorded.Body)
+	r.mutex.Unlock()
+	header := make(http.Header)
+	for key, value := range recorded.Header {
+		header.Set(key, value)
+	}
+	return &http.Response{
+		Status:        fmt.Sprintf("%d %s", recorded.StatusCode, http.StatusText(recorded.StatusCode)),
+		StatusCode:    recorded.StatusCode,
+		Proto:         "HTTP/1.1",
+		ProtoMajor:    1,
+		ProtoMinor:    1,
+		Header:        header,
+		Body:          ioutil.NopCloser(strings.NewReader(body)),
+		ContentLength: int64(len(body)),
+		Request:       req,
+	}
+}
+
+func newRecordedRequest(req *http.Request, body []byte) RecordedRequest {
+	params := make(map[string]string)
+	for key, values := range req.URL.Query() {
+		params[key] = strings.Join(values, ",")
+	}
+	if len(body) > 0 {
+		if strings.HasPrefix(req.Header.Get("Content-Type"), "application/x-www-form-urlencoded") {
+			if values, err := url.ParseQuery(string(body)); err == nil {
+				for key, value := range values {
+					params[key] = strings.Join(value, ",")
+				}
+			}
+		} else {
+			params["_body"] = string(body)
+		}
+	}
+	for key := range params {
+		if recordIgnoredParams[key] {
+			delete(params, key)
+		}
+	}
+
+	action := params["Action"]
+	if action == "" {
+		action = req.Header.Get("x-acs-action")
+	}
+	version := params["Version"]
+	if version == "" {
+		version = req.Header.Get("x-acs-version")
+	}
+	delete(params, "Action")
+	delete(params, "Version")
+
+	return RecordedRequest{
+		Method:  req.Method,
+		Path:    req.URL.Path,
+		Action:  action,
+		Version: version,
+		Params:  params,
+	}
+}
+
+var cassetteNameRegex = regexp.MustCompile(`[^a-zA-Z0-9_.-]+`)
+
+func cassetteFileName(name string) string {
+	return cassetteNameRegex.ReplaceAllString(name, "_") + ".json"
+}
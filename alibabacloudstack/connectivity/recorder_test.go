@@ -0,0 +1,88 @@
+package connectivity
+
+import (
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func TestRecorderRecordAndReplay(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(`{"VpcId":"vpc-123","VpcName":"` + r.URL.Query().Get("VpcName") + `"}`))
+	}))
+	defer server.Close()
+
+	dir, err := ioutil.TempDir("", "recorder")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+	path := filepath.Join(dir, "TestRecorder.json")
+	recorder, err := NewRecorder(RecordModeRecord, path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	client := &http.Client{Transport: recorder.Wrap(http.DefaultTransport)}
+	if _, err := doRecorderRequest(client, server.URL, "tf-testacc-1234", "signature-1"); err != nil {
+		t.Fatal(err)
+	}
+	if err := recorder.Stop(); err != nil {
+		t.Fatal(err)
+	}
+	data, err := ioutil.ReadFile(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if strings.Contains(string(data), "signature-1") || strings.Contains(string(data), "AccessKeyId") {
+		t.Fatalf("the cassette should not contain the signature or credentials: %s", data)
+	}
+
+	recorder, err = NewRecorder(RecordModeReplay, path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer recorder.Stop()
+	client = &http.Client{Transport: recorder.Wrap(nil)}
+	body, err := doRecorderRequest(client, "http://replay.example.com", "tf-testacc-5678", "signature-2")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if body != `{"VpcId":"vpc-123","VpcName":"tf-testacc-5678"}` {
+		t.Fatalf("unexpected replayed body: %s", body)
+	}
+	// The polling requests get the last matched response again.
+	if _, err := doRecorderRequest(client, "http://replay.example.com", "tf-testacc-5678", "signature-3"); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestRecorderReplayMissingInteraction(t *testing.T) {
+	recorder := &Recorder{mode: RecordModeReplay, cassette: &Cassette{}, replacements: map[string]string{}}
+	client := &http.Client{Transport: recorder.Wrap(nil)}
+	if _, err := doRecorderRequest(client, "http://replay.example.com", "tf-testacc", "signature"); err == nil {
+		t.Fatal("expected an error when there is no recorded interaction")
+	}
+}
+
+func doRecorderRequest(client *http.Client, endpoint, name, signature string) (string, error) {
+	query := url.Values{}
+	query.Set("Action", "CreateVpc")
+	query.Set("Version", "2016-04-28")
+	query.Set("VpcName", name)
+	query.Set("AccessKeyId", "access-key")
+	query.Set("Signature", signature)
+	query.Set("SignatureNonce", signature)
+	resp, err := client.Get(endpoint + "/?" + query.Encode())
+	if err != nil {
+		return "", err
+	}
+	defer resp.Body.Close()
+	body, err := ioutil.ReadAll(resp.Body)
+	return string(body), err
+}
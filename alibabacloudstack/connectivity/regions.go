@@ -1,5 +1,16 @@
 package connectivity
 
+import (
+	"fmt"
+	"log"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/aliyun/alibaba-cloud-sdk-go/services/ecs"
+)
+
 // Region represents ECS region
 type Region string
 
@@ -88,3 +99,74 @@ var FcNoSupportedRegions = []Region{MEEast1}
 var DrdsSupportedRegions = []Region{Beijing, Shenzhen, Hangzhou, Qingdao, Hongkong, Shanghai, Huhehaote, Zhangjiakou, APSouthEast1}
 var DrdsClassicNoSupportedRegions = []Region{Hongkong}
 var AlbSupportRegions = []Region{Hangzhou, Shanghai, Qingdao, Zhangjiakou, Beijing, WuLanChaBu, Shenzhen, ChengDu, Hongkong, APSouthEast1, APSouthEast2, APSouthEast3, APSouthEast5, APNorthEast1, EUCentral1, USEast1, APSouth1}
+
+// regionsCacheTTL is how long the regions of a stack are cached, so the provider configured many times in a run
+// only describes the regions once.
+var regionsCacheTTL = time.Hour
+
+type regionsCacheEntry struct {
+	regions []string
+	expires time.Time
+}
+
+var regionsCache = make(map[string]regionsCacheEntry)
+var regionsCacheMutex = sync.Mutex{}
+
+// DescribeRegions returns the regions which are available in the stack. They are cached by the ecs endpoint and the access key.
+func (client *AlibabacloudStackClient) DescribeRegions() ([]string, error) {
+	key := fmt.Sprintf("%s/%s", client.Config.EcsEndpoint, client.AccessKey)
+	regionsCacheMutex.Lock()
+	defer regionsCacheMutex.Unlock()
+	if entry, ok := regionsCache[key]; ok && time.Now().Before(entry.expires) {
+		return entry.regions, nil
+	}
+
+	request := ecs.CreateDescribeRegionsRequest()
+	if strings.ToLower(client.Config.Protocol) == "https" {
+		request.Scheme = "https"
+	} else {
+		request.Scheme = "http"
+	}
+	request.RegionId = client.RegionId
+	request.Headers = map[string]string{"RegionId": client.RegionId}
+	request.QueryParams = map[string]string{"AccessKeySecret": client.SecretKey, "Product": "ecs", "Department": client.Department, "ResourceGroup": client.ResourceGroup}
+	raw, err := client.WithEcsClient(func(ecsClient *ecs.Client) (interface{}, error) {
+		return ecsClient.DescribeRegions(request)
+	})
+	if err != nil {
+		return nil, err
+	}
+	var regions []string
+	for _, region := range raw.(*ecs.DescribeRegionsResponse).Regions.Region {
+		regions = append(regions, region.RegionId)
+	}
+	if len(regions) < 1 {
+		return nil, fmt.Errorf("there is no region returned by the DescribeRegions of ecs")
+	}
+	sort.Strings(regions)
+	regionsCache[key] = regionsCacheEntry{regions: regions, expires: time.Now().Add(regionsCacheTTL)}
+	return regions, nil
+}
+
+// validateRegion checks the region with the regions of the stack. The known regions in ValidRegions are only used
+// when the regions of the stack can not be described.
+func (client *AlibabacloudStackClient) validateRegion() error {
+	regions, err := client.DescribeRegions()
+	if err != nil {
+		log.Printf("[WARN] Describing the regions of the stack got an error, and the region %s is validated with the known regions: %#v", client.RegionId, err)
+		for _, valid := range ValidRegions {
+			if client.Region == valid {
+				return nil
+			}
+		}
+		return fmt.Errorf("Invalid AlibabacloudStack Cloud region: %s. The regions of the stack can not be described: %s. "+
+			"Please check the ecs endpoint, or set skip_region_validation to skip the validation.", client.RegionId, err)
+	}
+	for _, region := range regions {
+		if region == client.RegionId {
+			return nil
+		}
+	}
+	return fmt.Errorf("Invalid AlibabacloudStack Cloud region: %s. The available regions of the stack are: %s. "+
+		"Please set the right region, or set skip_region_validation to skip the validation.", client.RegionId, strings.Join(regions, ", "))
+}
@@ -0,0 +1,507 @@
+package connectivity
+
+import (
+	"bytes"
+	"context"
+	"crypto/tls"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"log"
+	"math"
+	"math/rand"
+	"net"
+	"net/http"
+	"net/url"
+	"regexp"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/aliyun/alibaba-cloud-sdk-go/sdk/errors"
+	sls "github.com/aliyun/aliyun-log-go-sdk"
+)
+
+const (
+	// DefaultMaxRetries is the same as the default max attempts of the tea sdk clients.
+	DefaultMaxRetries = 3
+	// DefaultRetryMaxWait is the max seconds to wait before retrying a throttled request.
+	DefaultRetryMaxWait = 30
+	// DefaultRateLimitProduct is the key of the rate limits which applies to the products without their own limit.
+	DefaultRateLimitProduct = "default"
+)
+
+// The wait before the first retry, it is doubled for every following retry.
+var retryBaseWait = time.Second
+
+// The same error codes as NeedRetry treats as throttling.
+var throttlingCodeRegex = regexp.MustCompile(`^(Throttling.*|Rejected\.Throttling|ServiceUnavailable)$`)
+
+var rateLimiterMutex = sync.Mutex{}
+var teaProxyMutex = sync.Mutex{}
+
+// RateLimiter limits the requests per second of every product with a token bucket.
+// The buckets are shared by all the clients of a provider, because the products share the quota of the account.
+type RateLimiter struct {
+	limits  map[string]int
+	buckets map[string]*tokenBucket
+	mutex   sync.Mutex
+}
+
+// NewRateLimiter returns a rate limiter with the requests per second of the products.
+// The limit of DefaultRateLimitProduct applies to the products which are not in the limits.
+func NewRateLimiter(limits map[string]int) *RateLimiter {
+	l := &RateLimiter{
+		limits:  make(map[string]int),
+		buckets: make(map[string]*tokenBucket),
+	}
+	for product, limit := range limits {
+		l.limits[strings.ToLower(strings.TrimSpace(product))] = limit
+	}
+	return l
+}
+
+// Wait blocks until the product is allowed to send a request or the context is done.
+func (l *RateLimiter) Wait(ctx context.Context, product string) error {
+	bucket := l.bucket(strings.ToLower(product))
+	if bucket == nil {
+		return nil
+	}
+	wait := bucket.reserve()
+	if wait <= 0 {
+		return nil
+	}
+	timer := time.NewTimer(wait)
+	defer timer.Stop()
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-timer.C:
+		return nil
+	}
+}
+
+func (l *RateLimiter) bucket(product string) *tokenBucket {
+	l.mutex.Lock()
+	defer l.mutex.Unlock()
+	key := product
+	limit, ok := l.limits[key]
+	if !ok {
+		key = DefaultRateLimitProduct
+		limit, ok = l.limits[key]
+	}
+	if !ok || limit <= 0 {
+		return nil
+	}
+	bucket, ok := l.buckets[key]
+	if !ok {
+		bucket = &tokenBucket{
+			rate:   float64(limit),
+			burst:  float64(limit),
+			tokens: float64(limit),
+			last:   time.Now(),
+		}
+		l.buckets[key] = bucket
+	}
+	return bucket
+}
+
+type tokenBucket struct {
+	rate   float64
+	burst  float64
+	tokens float64
+	last   time.Time
+	mutex  sync.Mutex
+}
+
+// reserve takes a token from the bucket and returns how long to wait until the token is available.
+func (b *tokenBucket) reserve() time.Duration {
+	b.mutex.Lock()
+	defer b.mutex.Unlock()
+	now := time.Now()
+	b.tokens = math.Min(b.burst, b.tokens+now.Sub(b.last).Seconds()*b.rate)
+	b.last = now
+	b.tokens--
+	if b.tokens >= 0 {
+		return 0
+	}
+	return time.Duration(-b.tokens / b.rate * float64(time.Second))
+}
+
+// The max size of the request bodies which are kept to send the throttled requests again, the requests with a larger
+// body, like the uploads of oss, are sent once.
+const maxReplayBodySize = 1 << 20
+
+// The max size of the error responses which are read to find the error code.
+const maxErrorBodySize = 64 << 10
+
+// The error code of the json and xml error responses of the products.
+var errorCodeRegex = regexp.MustCompile(`"(?:Code|code|ErrorCode|errorCode)"\s*:\s*"([^"]*)"|<Code>([^<]*)</Code>`)
+
+// rateLimitTransport waits for the rate limiter before sending a request, and sends the request again with an
+// exponential backoff while it is throttled, up to the max retries of the config. It is the transport of all the sdk
+// clients, and of the tea sdk clients through the local proxy, so the requests of every product are retried in the
+// same way. The throttled requests are signed again by resignRequest, because a request can not be sent again with the
+// same SignatureNonce.
+type rateLimitTransport struct {
+	transport http.RoundTripper
+	limiter   *RateLimiter
+	// The config is read for every request, because the max retries can be changed after the clients are created.
+	config *Config
+}
+
+func (t *rateLimitTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	body, replayable, err := requestBody(req)
+	if err != nil {
+		return nil, err
+	}
+	product := requestProduct(req, requestForm(req, body))
+	// The waits are also cancelled by the stop context, because the sdk clients do not send the requests with a context.
+	ctx := req.Context()
+	if t.config.StopContext != nil {
+		var cancel context.CancelFunc
+		ctx, cancel = WithStopContext(ctx, t.config.StopContext)
+		defer cancel()
+	}
+
+	attempt := req
+	for retryTimes := 0; ; retryTimes++ {
+		if err := t.limiter.Wait(ctx, product); err != nil {
+			return nil, err
+		}
+		resp, err := t.transport.RoundTrip(attempt)
+		if err != nil || !replayable || retryTimes >= t.config.MaxRetries {
+			return resp, err
+		}
+		code := responseThrottlingCode(resp)
+		if code == "" {
+			return resp, nil
+		}
+		wait := backoff(retryTimes, time.Duration(t.config.RetryMaxWait)*time.Second)
+		log.Printf("[WARN] The request is throttled by %s, retrying it in %s (%d/%d).", code, wait, retryTimes+1, t.config.MaxRetries)
+		if !sleepContext(ctx, wait) {
+			return resp, nil
+		}
+		// The request is signed again after the wait, so its time is not older than the wait.
+		next, err := t.config.resignRequest(req, body)
+		if err != nil {
+			log.Printf("[WARN] The throttled request can not be sent again: %s.", err)
+			return resp, nil
+		}
+		resp.Body.Close()
+		attempt = next
+	}
+}
+
+// requestBody reads the body of the request to send it again, and returns false if the body can not be sent again.
+func requestBody(req *http.Request) ([]byte, bool, error) {
+	if req.Body == nil || req.Body == http.NoBody {
+		return nil, true, nil
+	}
+	if req.ContentLength > maxReplayBodySize || req.ContentLength <= 0 && req.GetBody == nil {
+		return nil, false, nil
+	}
+	data, err := ioutil.ReadAll(req.Body)
+	req.Body.Close()
+	if err != nil {
+		return nil, false, err
+	}
+	setRequestBody(req, data)
+	return data, true, nil
+}
+
+func setRequestBody(req *http.Request, body []byte) {
+	if body == nil {
+		return
+	}
+	req.Body = ioutil.NopCloser(bytes.NewReader(body))
+	req.GetBody = func() (io.ReadCloser, error) {
+		return ioutil.NopCloser(bytes.NewReader(body)), nil
+	}
+	req.ContentLength = int64(len(body))
+}
+
+// requestForm returns the parameters in the form body of the rpc requests.
+func requestForm(req *http.Request, body []byte) url.Values {
+	if len(body) == 0 || !strings.HasPrefix(req.Header.Get("Content-Type"), "application/x-www-form-urlencoded") {
+		return url.Values{}
+	}
+	form, err := url.ParseQuery(string(body))
+	if err != nil {
+		return url.Values{}
+	}
+	return form
+}
+
+// responseThrottlingCode returns the error code of the response if its request is throttled with a 4xx status, like
+// throttlingCode does for the sdk errors. The throttled responses with a 5xx status are already retried by the sdk
+// clients, they are not retried again. The error responses are read to find the code, and they are given back to the
+// sdk clients as they are.
+func responseThrottlingCode(resp *http.Response) string {
+	if resp.StatusCode < http.StatusBadRequest || resp.StatusCode >= http.StatusInternalServerError {
+		return ""
+	}
+	data, _ := ioutil.ReadAll(io.LimitReader(resp.Body, maxErrorBodySize))
+	resp.Body = struct {
+		io.Reader
+		io.Closer
+	}{io.MultiReader(bytes.NewReader(data), resp.Body), resp.Body}
+	if match := errorCodeRegex.FindSubmatch(data); match != nil {
+		if code := string(match[1]) + string(match[2]); throttlingCodeRegex.MatchString(code) {
+			return code
+		}
+	}
+	if resp.StatusCode == http.StatusTooManyRequests {
+		return strconv.Itoa(resp.StatusCode)
+	}
+	return ""
+}
+
+// retryThrottled calls do again with an exponential backoff while its request is throttled, up to the max retries of
+// the config. It is only used by the clients which can not be given a transport, like the log client, and which build
+// and sign a new request for every call.
+func (client *AlibabacloudStackClient) retryThrottled(do func() (interface{}, error)) (interface{}, error) {
+	config := client.Config
+	for retryTimes := 0; ; retryTimes++ {
+		raw, err := do()
+		code := throttlingCode(err)
+		if code == "" || retryTimes >= config.MaxRetries {
+			return raw, err
+		}
+		wait := backoff(retryTimes, time.Duration(config.RetryMaxWait)*time.Second)
+		log.Printf("[WARN] The request is throttled by %s, retrying it in %s (%d/%d).", code, wait, retryTimes+1, config.MaxRetries)
+		if !config.sleep(wait) {
+			return raw, err
+		}
+	}
+}
+
+// sleep waits for the duration, and returns false if the provider is stopped before that.
+func (c *Config) sleep(wait time.Duration) bool {
+	if c.StopContext == nil {
+		return sleepContext(context.Background(), wait)
+	}
+	return sleepContext(c.StopContext, wait)
+}
+
+// sleepContext waits for the duration, and returns false if the context is done before that.
+func sleepContext(ctx context.Context, wait time.Duration) bool {
+	timer := time.NewTimer(wait)
+	defer timer.Stop()
+	select {
+	case <-ctx.Done():
+		return false
+	case <-timer.C:
+		return true
+	}
+}
+
+// backoff doubles the wait for every retry with a random jitter.
+func backoff(retryTimes int, maxWait time.Duration) time.Duration {
+	wait := retryBaseWait << uint(retryTimes)
+	if wait <= 0 || wait > maxWait {
+		wait = maxWait
+	}
+	return wait/2 + time.Duration(rand.Int63n(int64(wait/2)+1))
+}
+
+// throttlingCode returns the error code of the sdk error if its request is throttled with a 4xx status.
+func throttlingCode(err error) string {
+	var code string
+	var status int
+	switch e := err.(type) {
+	case *errors.ServerError:
+		code, status = e.ErrorCode(), e.HttpStatus()
+	case *sls.Error:
+		code, status = e.Code, int(e.HTTPCode)
+	default:
+		return ""
+	}
+	if status >= http.StatusInternalServerError {
+		return ""
+	}
+	if throttlingCodeRegex.MatchString(code) {
+		return code
+	}
+	if status == http.StatusTooManyRequests {
+		return strconv.Itoa(status)
+	}
+	return ""
+}
+
+// requestProduct gets the product from the parameters of the rpc requests, or from the headers of the roa requests.
+func requestProduct(req *http.Request, form url.Values) string {
+	product := req.URL.Query().Get("Product")
+	if product == "" {
+		product = form.Get("Product")
+	}
+	if product == "" {
+		product = req.Header.Get("x-acs-product")
+	}
+	return strings.ToLower(product)
+}
+
+// WrapTransport returns the transport used by the sdk clients of the config. The requests are limited by the rate
+// limits of the config and retried while they are throttled, and they are sent through the active recorder if there
+// is one.
+func WrapTransport(transport *http.Transport, config *Config) http.RoundTripper {
+	// The sdk only sets the tls, proxy and timeout settings on a bare *http.Transport, so they need to be set here.
+	transport.TLSClientConfig = &tls.Config{InsecureSkipVerify: config.Insecure}
+	transport.Proxy = http.ProxyFromEnvironment
+	if config.Proxy != "" {
+		if proxy, err := url.Parse(config.Proxy); err == nil {
+			transport.Proxy = http.ProxyURL(proxy)
+		}
+	}
+	if config.ClientConnectTimeout > 0 {
+		transport.DialContext = (&net.Dialer{
+			Timeout:   time.Duration(config.ClientConnectTimeout) * time.Millisecond,
+			KeepAlive: 30 * time.Second,
+		}).DialContext
+	}
+
+	var roundTripper http.RoundTripper = transport
+	if recorder := ActiveRecorder(); recorder != nil {
+		roundTripper = recorder.Wrap(transport)
+	}
+	return &rateLimitTransport{
+		transport: roundTripper,
+		limiter:   config.getRateLimiter(),
+		config:    config,
+	}
+}
+
+// StopContext returns the context which is cancelled when terraform is interrupted, or the background context when the
+// client is not configured by terraform. It is used by the describe methods, which are called with the id only.
+func (client *AlibabacloudStackClient) StopContext() context.Context {
+	if client.Config == nil || client.Config.StopContext == nil {
+		return context.Background()
+	}
+	return client.Config.StopContext
+}
+
+// WithStopContext returns a context which is also cancelled when the stop context is done.
+// The provider sets the stop context of the config, so the requests and waits are cancelled when terraform is interrupted.
+func WithStopContext(ctx, stop context.Context) (context.Context, context.CancelFunc) {
+	ctx, cancel := context.WithCancel(ctx)
+	go func() {
+		select {
+		case <-stop.Done():
+			cancel()
+		case <-ctx.Done():
+		}
+	}()
+	return ctx, cancel
+}
+
+func (c *Config) getRateLimiter() *RateLimiter {
+	rateLimiterMutex.Lock()
+	defer rateLimiterMutex.Unlock()
+	if c.rateLimiter == nil {
+		c.rateLimiter = NewRateLimiter(c.RateLimits)
+	}
+	return c.rateLimiter
+}
+
+// getTeaProxy returns a local forward proxy which sends the requests with the transport of the config.
+// It is used by the tea sdk clients, which can not be given a http.RoundTripper. The proxy only listens on the
+// loopback address, only forwards the requests to the endpoints allowed by the clients, and it is closed when
+// terraform stops the provider.
+func (c *Config) getTeaProxy() (*teaProxy, error) {
+	teaProxyMutex.Lock()
+	defer teaProxyMutex.Unlock()
+	if c.teaProxy != nil {
+		return c.teaProxy, nil
+	}
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		return nil, fmt.Errorf("unable to start the local proxy of the tea sdk clients: %#v", err)
+	}
+	scheme := strings.ToLower(c.Protocol)
+	if scheme == "" {
+		scheme = "http"
+	}
+	proxy := &teaProxy{
+		URL:       "http://" + listener.Addr().String(),
+		scheme:    scheme,
+		hosts:     make(map[string]string),
+		listener:  listener,
+		transport: WrapTransport(&http.Transport{MaxIdleConns: 500, MaxIdleConnsPerHost: 100}, c),
+	}
+	go http.Serve(listener, proxy)
+	if c.StopContext != nil {
+		go func() {
+			<-c.StopContext.Done()
+			proxy.Close()
+		}()
+	}
+	c.teaProxy = proxy
+	return proxy, nil
+}
+
+type teaProxy struct {
+	URL       string
+	scheme    string
+	hosts     map[string]string
+	mutex     sync.RWMutex
+	listener  net.Listener
+	transport http.RoundTripper
+}
+
+// Allow adds the endpoint to the hosts which the requests are forwarded to, and returns the endpoint without the
+// scheme. The requests to the endpoint are sent with its own scheme, or with the protocol of the config without one.
+func (p *teaProxy) Allow(endpoint string) string {
+	scheme, host := p.scheme, endpoint
+	if parts := strings.SplitN(endpoint, "://", 2); len(parts) == 2 {
+		scheme, host = strings.ToLower(parts[0]), parts[1]
+	}
+	host = strings.TrimSuffix(host, "/")
+	p.mutex.Lock()
+	defer p.mutex.Unlock()
+	p.hosts[strings.ToLower(host)] = scheme
+	return host
+}
+
+func (p *teaProxy) Close() error {
+	return p.listener.Close()
+}
+
+func (p *teaProxy) ServeHTTP(w http.ResponseWriter, req *http.Request) {
+	target := *req.URL
+	if target.Host == "" {
+		target.Host = req.Host
+	}
+	p.mutex.RLock()
+	scheme, ok := p.hosts[strings.ToLower(target.Host)]
+	p.mutex.RUnlock()
+	if req.Method == http.MethodConnect || !ok {
+		http.Error(w, fmt.Sprintf("the host %s is not an endpoint of the provider", target.Host), http.StatusForbidden)
+		return
+	}
+	target.Scheme = scheme
+	outReq, err := http.NewRequestWithContext(req.Context(), req.Method, target.String(), req.Body)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadGateway)
+		return
+	}
+	outReq.ContentLength = req.ContentLength
+	outReq.Header = req.Header.Clone()
+	outReq.Header.Del("Proxy-Authorization")
+	outReq.Header.Del("Proxy-Connection")
+	outReq.Host = req.Host
+
+	resp, err := p.transport.RoundTrip(outReq)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadGateway)
+		return
+	}
+	defer resp.Body.Close()
+	for key, values := range resp.Header {
+		for _, value := range values {
+			w.Header().Add(key, value)
+		}
+	}
+	w.WriteHeader(resp.StatusCode)
+	io.Copy(w, resp.Body)
+}
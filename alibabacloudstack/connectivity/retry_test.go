@@ -0,0 +1,258 @@
+package connectivity
+
+import (
+	"context"
+	"fmt"
+	"io/ioutil"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	util "github.com/alibabacloud-go/tea-utils/service"
+	"github.com/alibabacloud-go/tea/tea"
+	"github.com/aliyun/alibaba-cloud-sdk-go/services/ecs"
+)
+
+func TestRetryThrottled(t *testing.T) {
+	defer func(wait time.Duration) { retryBaseWait = wait }(retryBaseWait)
+	retryBaseWait = 10 * time.Millisecond
+
+	var calls int32
+	nonces := make(map[string]bool)
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if err := r.ParseForm(); err != nil {
+			t.Error(err)
+		}
+		verifyRpcSignature(t, r, nonces)
+		w.Header().Set("Content-Type", "application/json")
+		if atomic.AddInt32(&calls, 1) <= 2 {
+			w.WriteHeader(http.StatusBadRequest)
+			fmt.Fprint(w, `{"Code":"Throttling.User","Message":"Request was denied due to user flow control.","RequestId":"test"}`)
+			return
+		}
+		fmt.Fprint(w, `{"RequestId":"test","Regions":{"Region":[]}}`)
+	}))
+	defer server.Close()
+
+	config := &Config{
+		RegionId:     "cn-test",
+		AccessKey:    "access-key",
+		SecretKey:    "secret-key",
+		Protocol:     "HTTP",
+		EcsEndpoint:  strings.TrimPrefix(server.URL, "http://"),
+		MaxRetries:   3,
+		RetryMaxWait: 1,
+	}
+	client := &AlibabacloudStackClient{Config: config, RegionId: config.RegionId}
+	describeRegions := func(ecsClient *ecs.Client) (interface{}, error) {
+		return ecsClient.DescribeRegions(ecs.CreateDescribeRegionsRequest())
+	}
+	if _, err := client.WithEcsClient(describeRegions); err != nil || calls != 3 {
+		t.Fatalf("expected the request to succeed after 2 retries, got %v after %d calls", err, calls)
+	}
+
+	calls = 0
+	config.MaxRetries = 1
+	_, err := client.WithEcsClient(describeRegions)
+	if err == nil || !strings.Contains(err.Error(), "Throttling.User") || calls != 2 {
+		t.Fatalf("expected the throttling error after 1 retry, got %v after %d calls", err, calls)
+	}
+}
+
+func TestRetryThrottledTeaClient(t *testing.T) {
+	defer func(wait time.Duration) { retryBaseWait = wait }(retryBaseWait)
+	retryBaseWait = 10 * time.Millisecond
+
+	var calls int32
+	nonces := make(map[string]bool)
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if err := r.ParseForm(); err != nil {
+			t.Error(err)
+		}
+		verifyRpcSignature(t, r, nonces)
+		w.Header().Set("Content-Type", "application/json")
+		if atomic.AddInt32(&calls, 1) <= 2 {
+			w.WriteHeader(http.StatusBadRequest)
+			fmt.Fprint(w, `{"Code":"Throttling","Message":"Request was denied due to request throttling.","RequestId":"test"}`)
+			return
+		}
+		fmt.Fprint(w, `{"RequestId":"test"}`)
+	}))
+	defer server.Close()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	config := &Config{
+		RegionId:             "cn-test",
+		AccessKey:            "access-key",
+		SecretKey:            "secret-key",
+		Protocol:             "HTTP",
+		Endpoints:            map[string]interface{}{"ecs": server.URL},
+		SkipRegionValidation: true,
+		MaxRetries:           3,
+		RetryMaxWait:         1,
+		StopContext:          ctx,
+	}
+	client, err := config.Client()
+	if err != nil {
+		t.Fatal(err)
+	}
+	conn, err := client.NewEcsClient()
+	if err != nil {
+		t.Fatal(err)
+	}
+	request := map[string]interface{}{"RegionId": "cn-test"}
+	_, err = conn.DoRequest(tea.String("DescribeRegions"), nil, tea.String("POST"), tea.String("2014-05-26"), tea.String("AK"), nil, request, &util.RuntimeOptions{})
+	if err != nil || calls != 3 {
+		t.Fatalf("expected the request of the tea client to succeed after 2 retries, got %v after %d calls", err, calls)
+	}
+}
+
+func TestRetryThrottledRequestBody(t *testing.T) {
+	defer func(wait time.Duration) { retryBaseWait = wait }(retryBaseWait)
+	retryBaseWait = 10 * time.Millisecond
+
+	var calls int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, _ := ioutil.ReadAll(r.Body)
+		if string(body) != "object content" {
+			t.Errorf("expected the body to be sent again, got %q", body)
+		}
+		if atomic.AddInt32(&calls, 1) <= 2 {
+			w.WriteHeader(http.StatusTooManyRequests)
+			return
+		}
+	}))
+	defer server.Close()
+
+	client := &http.Client{Transport: WrapTransport(&http.Transport{}, &Config{MaxRetries: 3, RetryMaxWait: 1})}
+	resp, err := client.Post(server.URL+"/bucket/object", "text/plain", strings.NewReader("object content"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	resp.Body.Close()
+	if resp.StatusCode != http.StatusOK || calls != 3 {
+		t.Fatalf("expected the request without a nonce to be sent again as it is, got %d after %d calls", resp.StatusCode, calls)
+	}
+
+	// A request with a nonce which is not signed by the access key of the provider can not be signed again.
+	calls = 0
+	config := &Config{SecretKey: "secret-key", MaxRetries: 3, RetryMaxWait: 1}
+	client = &http.Client{Transport: WrapTransport(&http.Transport{}, config)}
+	query := url.Values{"Action": {"DescribeRegions"}, "SignatureNonce": {"nonce"}, "Signature": {"unknown"}}
+	resp, err = client.Post(server.URL+"/?"+query.Encode(), "text/plain", strings.NewReader("object content"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	resp.Body.Close()
+	if resp.StatusCode != http.StatusTooManyRequests || calls != 1 {
+		t.Fatalf("expected the request with an unknown signature not to be sent again, got %d after %d calls", resp.StatusCode, calls)
+	}
+}
+
+// verifyRpcSignature checks that the rpc request is signed with the secret key of the tests and a new SignatureNonce.
+func verifyRpcSignature(t *testing.T, r *http.Request, nonces map[string]bool) {
+	params := url.Values{}
+	for key, value := range r.Form {
+		params[key] = value
+	}
+	signature := params.Get("Signature")
+	params.Del("Signature")
+	if rpcSignature(r.Method, params, "secret-key") != signature {
+		t.Errorf("expected the request to be signed with the secret key, got the signature %q", signature)
+	}
+	nonce := params.Get("SignatureNonce")
+	if nonce == "" || nonces[nonce] {
+		t.Errorf("expected every retry to be signed with a new SignatureNonce, got %q again", nonce)
+	}
+	nonces[nonce] = true
+}
+
+func TestRateLimiter(t *testing.T) {
+	limiter := NewRateLimiter(map[string]int{"ECS": 20, DefaultRateLimitProduct: 1000})
+
+	start := time.Now()
+	for i := 0; i < 30; i++ {
+		if err := limiter.Wait(context.Background(), "ecs"); err != nil {
+			t.Fatal(err)
+		}
+	}
+	// The first 20 requests use the burst of the bucket, and the other 10 need to wait for half a second.
+	if elapsed := time.Since(start); elapsed < 400*time.Millisecond || elapsed > 2*time.Second {
+		t.Fatalf("expected the requests of ecs to be limited to 20 per second, they took %s", elapsed)
+	}
+
+	start = time.Now()
+	for i := 0; i < 30; i++ {
+		if err := limiter.Wait(context.Background(), "vpc"); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if elapsed := time.Since(start); elapsed > 200*time.Millisecond {
+		t.Fatalf("expected the requests of vpc to use the default limit, they took %s", elapsed)
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	for i := 0; i < 30; i++ {
+		if err := limiter.Wait(ctx, "ecs"); err == context.Canceled {
+			return
+		}
+	}
+	t.Fatalf("expected the wait to stop when the context is canceled")
+}
+
+func TestTeaProxyURL(t *testing.T) {
+	var calls int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		atomic.AddInt32(&calls, 1)
+		fmt.Fprintf(w, `{"Action":"%s"}`, r.URL.Query().Get("Action"))
+	}))
+	defer server.Close()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	config := &Config{Protocol: "HTTP", RateLimits: map[string]int{"ecs": 10}, StopContext: ctx}
+	teaProxy, err := config.getTeaProxy()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if again, _ := config.getTeaProxy(); again != teaProxy {
+		t.Fatalf("expected the local proxy to be started once, got %s and %s", teaProxy.URL, again.URL)
+	}
+	if endpoint := teaProxy.Allow(server.URL); endpoint != strings.TrimPrefix(server.URL, "http://") {
+		t.Fatalf("expected the endpoint without the scheme, got %s", endpoint)
+	}
+	proxy, _ := url.Parse(teaProxy.URL)
+	client := &http.Client{Transport: &http.Transport{Proxy: http.ProxyURL(proxy)}}
+	resp, err := client.Get("http://ecs.example.com/?Product=ecs&Action=DescribeInstances")
+	if err != nil {
+		t.Fatal(err)
+	}
+	resp.Body.Close()
+	if resp.StatusCode != http.StatusForbidden || calls != 0 {
+		t.Fatalf("expected the request to a host which is not an endpoint to be refused, got %d", resp.StatusCode)
+	}
+	resp, err = client.Get(server.URL + "/?Product=ecs&Action=DescribeInstances")
+	if err != nil {
+		t.Fatal(err)
+	}
+	body, _ := ioutil.ReadAll(resp.Body)
+	resp.Body.Close()
+	if resp.StatusCode != http.StatusOK || string(body) != `{"Action":"DescribeInstances"}` || calls != 1 {
+		t.Fatalf("expected the request to be forwarded by the local proxy, got %d %s after %d calls", resp.StatusCode, body, calls)
+	}
+
+	cancel()
+	for i := 0; i < 50; i++ {
+		if _, err = net.Dial("tcp", proxy.Host); err != nil {
+			return
+		}
+		time.Sleep(10 * time.Millisecond)
+	}
+	t.Fatalf("expected the local proxy to be closed when the provider is stopped")
+}
@@ -0,0 +1,192 @@
+package connectivity
+
+import (
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/sha1"
+	"encoding/base64"
+	"encoding/hex"
+	"fmt"
+	"net/http"
+	"net/url"
+	"sort"
+	"strings"
+	"time"
+)
+
+// The values of the signatures are encoded like the sdk clients encode them.
+var signatureEncoder = strings.NewReplacer("+", "%20", "*", "%2A", "%7E", "~")
+
+// resignRequest returns a copy of the request to send it again after it is throttled. A request can not be sent again
+// with the same SignatureNonce, so the rpc and roa requests with a nonce are signed again with a new nonce and time.
+// They are only signed again when the signature of the request is the same as the one computed with the secret key of
+// the config, so the requests signed in another way are not sent again. The requests without a nonce, like the ones of
+// oss, datahub and fc, are sent again as they are.
+func (c *Config) resignRequest(req *http.Request, body []byte) (*http.Request, error) {
+	out := req.Clone(req.Context())
+	setRequestBody(out, body)
+
+	if req.Header.Get("x-acs-signature-nonce") != "" {
+		return out, c.resignRoaRequest(out)
+	}
+	query := req.URL.Query()
+	form := requestForm(req, body)
+	if query.Get("SignatureNonce") == "" && form.Get("SignatureNonce") == "" {
+		return out, nil
+	}
+	return out, c.resignRpcRequest(out, query, form)
+}
+
+func (c *Config) resignRpcRequest(req *http.Request, query, form url.Values) error {
+	params := url.Values{}
+	for _, values := range []url.Values{query, form} {
+		for key, value := range values {
+			params[key] = value
+		}
+	}
+	signature := params.Get("Signature")
+	params.Del("Signature")
+	secret, ok := "", false
+	for _, secret = range c.signatureSecrets() {
+		if ok = rpcSignature(req.Method, params, secret) == signature; ok {
+			break
+		}
+	}
+	if !ok {
+		return fmt.Errorf("the rpc request is not signed by the access key of the provider")
+	}
+
+	nonce, err := newSignatureNonce()
+	if err != nil {
+		return err
+	}
+	timestamp := time.Now().UTC().Format("2006-01-02T15:04:05Z")
+	for _, values := range []url.Values{query, form, params} {
+		if values.Get("SignatureNonce") != "" {
+			values.Set("SignatureNonce", nonce)
+		}
+		if values.Get("Timestamp") != "" {
+			values.Set("Timestamp", timestamp)
+		}
+	}
+	if query.Get("Signature") != "" {
+		query.Set("Signature", rpcSignature(req.Method, params, secret))
+	} else {
+		form.Set("Signature", rpcSignature(req.Method, params, secret))
+	}
+
+	req.URL.RawQuery = signatureEncoder.Replace(query.Encode())
+	if len(form) > 0 {
+		setRequestBody(req, []byte(signatureEncoder.Replace(form.Encode())))
+	}
+	return nil
+}
+
+func (c *Config) resignRoaRequest(req *http.Request) error {
+	authorization := req.Header.Get("Authorization")
+	parts := strings.SplitN(strings.TrimPrefix(authorization, "acs "), ":", 2)
+	if !strings.HasPrefix(authorization, "acs ") || len(parts) != 2 {
+		return fmt.Errorf("the signature of the roa request is unknown")
+	}
+	secret, path, compact, ok := c.roaSignatureFormat(req, parts[1])
+	if !ok {
+		return fmt.Errorf("the roa request is not signed by the access key of the provider")
+	}
+
+	nonce, err := newSignatureNonce()
+	if err != nil {
+		return err
+	}
+	req.Header.Set("x-acs-signature-nonce", nonce)
+	if req.Header.Get("Date") != "" {
+		req.Header.Set("Date", time.Now().UTC().Format(http.TimeFormat))
+	}
+	req.Header.Set("Authorization", "acs "+parts[0]+":"+hmacSignature(roaStringToSign(req, path, compact), secret))
+	return nil
+}
+
+// roaSignatureFormat returns the secret key and the form of the string to sign which the roa request is signed with.
+// The sdk clients differ in the empty headers and the escaping of the path of the string to sign.
+func (c *Config) roaSignatureFormat(req *http.Request, signature string) (secret, path string, compact, ok bool) {
+	for _, secret := range c.signatureSecrets() {
+		for _, path := range []string{req.URL.EscapedPath(), req.URL.Path} {
+			for _, compact := range []bool{false, true} {
+				if hmacSignature(roaStringToSign(req, path, compact), secret) == signature {
+					return secret, path, compact, true
+				}
+			}
+		}
+	}
+	return "", "", false, false
+}
+
+// signatureSecrets returns the secret keys of the config which the requests of the sdk clients are signed with.
+func (c *Config) signatureSecrets() []string {
+	var secrets []string
+	for _, secret := range []string{c.SecretKey, c.OrganizationSecretKey} {
+		if secret != "" {
+			secrets = append(secrets, secret)
+		}
+	}
+	return secrets
+}
+
+func rpcSignature(method string, params url.Values, secret string) string {
+	stringToSign := method + "&%2F&" + url.QueryEscape(signatureEncoder.Replace(params.Encode()))
+	return hmacSignature(stringToSign, secret+"&")
+}
+
+func roaStringToSign(req *http.Request, path string, compact bool) string {
+	var builder strings.Builder
+	builder.WriteString(req.Method + "\n")
+	for _, key := range []string{"Accept", "Content-MD5", "Content-Type", "Date"} {
+		if value := req.Header.Get(key); value != "" || !compact {
+			builder.WriteString(value + "\n")
+		}
+	}
+
+	var acsHeaders []string
+	for key := range req.Header {
+		if strings.HasPrefix(strings.ToLower(key), "x-acs-") {
+			acsHeaders = append(acsHeaders, key)
+		}
+	}
+	sort.Slice(acsHeaders, func(i, j int) bool { return strings.ToLower(acsHeaders[i]) < strings.ToLower(acsHeaders[j]) })
+	for _, key := range acsHeaders {
+		builder.WriteString(strings.ToLower(key) + ":" + req.Header.Get(key) + "\n")
+	}
+
+	builder.WriteString(path)
+	query := req.URL.Query()
+	var queryKeys []string
+	for key := range query {
+		queryKeys = append(queryKeys, key)
+	}
+	sort.Strings(queryKeys)
+	for i, key := range queryKeys {
+		if i == 0 {
+			builder.WriteString("?")
+		} else {
+			builder.WriteString("&")
+		}
+		builder.WriteString(key)
+		if value := query.Get(key); value != "" {
+			builder.WriteString("=" + value)
+		}
+	}
+	return builder.String()
+}
+
+func hmacSignature(stringToSign, secret string) string {
+	h := hmac.New(sha1.New, []byte(secret))
+	h.Write([]byte(stringToSign))
+	return base64.StdEncoding.EncodeToString(h.Sum(nil))
+}
+
+func newSignatureNonce() (string, error) {
+	nonce := make([]byte, 16)
+	if _, err := rand.Read(nonce); err != nil {
+		return "", err
+	}
+	return hex.EncodeToString(nonce), nil
+}
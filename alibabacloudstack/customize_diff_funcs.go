@@ -0,0 +1,256 @@
+package alibabacloudstack
+
+import (
+	"context"
+	"crypto/md5"
+	"encoding/hex"
+	"fmt"
+	"io"
+	"log"
+	"math"
+	"net"
+	"os"
+	"strings"
+
+	"github.com/aliyun/terraform-provider-alibabacloudstack/alibabacloudstack/connectivity"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/mitchellh/go-homedir"
+)
+
+// customizeDiffAll runs the CustomizeDiff functions in order and stops at the first error.
+func customizeDiffAll(funcs ...schema.CustomizeDiffFunc) schema.CustomizeDiffFunc {
+	return func(ctx context.Context, d *schema.ResourceDiff, meta interface{}) error {
+		for _, f := range funcs {
+			if err := f(ctx, d, meta); err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+}
+
+// forceNewIfChange recreates the existing resource when one of the keys is changed and the condition is true,
+// because the update can not apply them.
+func forceNewIfChange(condition func(d *schema.ResourceDiff) bool, keys ...string) schema.CustomizeDiffFunc {
+	return func(ctx context.Context, d *schema.ResourceDiff, meta interface{}) error {
+		if d.Id() == "" || !condition(d) {
+			return nil
+		}
+		for _, key := range keys {
+			if !d.HasChange(key) {
+				continue
+			}
+			if err := d.ForceNew(key); err != nil {
+				return WrapError(err)
+			}
+		}
+		return nil
+	}
+}
+
+func essScalingGroupSizeCustomizeDiff(ctx context.Context, d *schema.ResourceDiff, meta interface{}) error {
+	if !d.NewValueKnown("min_size") || !d.NewValueKnown("max_size") {
+		return nil
+	}
+	minSize, maxSize := d.Get("min_size").(int), d.Get("max_size").(int)
+	if minSize > maxSize {
+		return Error("'min_size' (%d) can not be greater than 'max_size' (%d).", minSize, maxSize)
+	}
+	return nil
+}
+
+func csKubernetesCidrCustomizeDiff(ctx context.Context, d *schema.ResourceDiff, meta interface{}) error {
+	if !d.NewValueKnown("pod_cidr") || !d.NewValueKnown("service_cidr") {
+		return nil
+	}
+	podCidr, serviceCidr := d.Get("pod_cidr").(string), d.Get("service_cidr").(string)
+	if podCidr == "" || serviceCidr == "" {
+		return nil
+	}
+	overlapped, err := cidrOverlapped(podCidr, serviceCidr)
+	if err != nil {
+		return WrapError(err)
+	}
+	if overlapped {
+		return Error("'pod_cidr' %s and 'service_cidr' %s can not overlap with each other.", podCidr, serviceCidr)
+	}
+	return nil
+}
+
+// csKubernetesVersionCustomizeDiff rejects the downgrade of the cluster, which is not supported by the upgrade.
+func csKubernetesVersionCustomizeDiff(ctx context.Context, d *schema.ResourceDiff, meta interface{}) error {
+	if d.Id() == "" || !d.HasChange("version") || !d.NewValueKnown("version") {
+		return nil
+	}
+	oldVersion, newVersion := d.GetChange("version")
+	if oldVersion.(string) == "" || newVersion.(string) == "" {
+		return nil
+	}
+	if compareCsKubernetesVersion(newVersion.(string), oldVersion.(string)) < 0 {
+		return Error("'version' can not be downgraded from %s to %s.", oldVersion, newVersion)
+	}
+	return nil
+}
+
+func dbInstanceEngineVersionCustomizeDiff(ctx context.Context, d *schema.ResourceDiff, meta interface{}) error {
+	if !d.NewValueKnown("engine") || !d.NewValueKnown("engine_version") {
+		return nil
+	}
+	engine, version := d.Get("engine").(string), d.Get("engine_version").(string)
+	if rdsEngineVersionKnown(engine, version) {
+		return nil
+	}
+	return Error("'engine_version' %s is not supported by the engine %s. Valid values: %s.", version, engine, strings.Join(rdsEngineVersions[strings.ToLower(engine)], ", "))
+}
+
+// ossBucketObjectSourceCustomizeDiff plans the upload again when the local source file no longer matches the etag of
+// the object. The etag of the multipart and KMS encrypted objects is not the MD5 of the content, so they rely on the
+// source_hash to detect the changes.
+func ossBucketObjectSourceCustomizeDiff(ctx context.Context, d *schema.ResourceDiff, meta interface{}) error {
+	if d.Id() == "" || d.HasChange("source") || d.HasChange("source_hash") || !d.NewValueKnown("source") {
+		return nil
+	}
+	source, etag := d.Get("source").(string), d.Get("etag").(string)
+	if source == "" || etag == "" || strings.Contains(etag, "-") || d.Get("server_side_encryption").(string) == ServerSideEncryptionKMS {
+		return nil
+	}
+	path, err := homedir.Expand(source)
+	if err != nil {
+		return WrapError(err)
+	}
+	sum, err := fileMd5(path)
+	if err != nil {
+		// The missing source file is reported by the upload.
+		log.Printf("[WARN] Failed to compute the MD5 of the source %s: %s", source, err)
+		return nil
+	}
+	if !strings.EqualFold(sum, etag) {
+		log.Printf("[DEBUG] The MD5 %s of the source %s does not match the etag %s of the object", sum, source, etag)
+		return WrapError(d.SetNewComputed("etag"))
+	}
+	return nil
+}
+
+// quotaCustomizeDiff checks the amounts of the product quota items requested by a new resource, together with the
+// other new resources of the plan, against the remaining quota of the resource group and the organization of the provider.
+// It does nothing unless the provider quota_check is error.
+func quotaCustomizeDiff(productName string, amounts func(d *schema.ResourceDiff, client *connectivity.AlibabacloudStackClient) (map[string]int, error)) schema.CustomizeDiffFunc {
+	return func(ctx context.Context, d *schema.ResourceDiff, meta interface{}) error {
+		client := meta.(*connectivity.AlibabacloudStackClient)
+		if client.Config.QuotaCheck != connectivity.QuotaCheckError || d.Id() != "" {
+			return nil
+		}
+		requested, err := amounts(d, client)
+		if err != nil || len(requested) == 0 {
+			return err
+		}
+		ascmService := AscmService{client}
+		levels := [][2]string{{"resourceGroup", client.ResourceGroup}, {"organization", client.Department}}
+		for _, level := range levels {
+			quotaType, quotaTypeId := level[0], level[1]
+			if quotaTypeId == "" {
+				continue
+			}
+			quota := strings.Join([]string{quotaType, quotaTypeId, productName}, COLON_SEPARATED)
+			exceeded, err := client.Config.GetQuotaLedger().Request(quota, requested, func() (map[string]int, error) {
+				usages, err := ascmService.DescribeAscmQuotaUsage(productName, quotaType, quotaTypeId)
+				if err != nil {
+					return nil, WrapError(err)
+				}
+				remaining := make(map[string]int)
+				for _, usage := range usages {
+					// The items whose total is 0 are not limited by the quota.
+					if usage.Total > 0 {
+						remaining[usage.Item] = usage.Total - usage.Used
+					}
+				}
+				return remaining, nil
+			})
+			if err != nil {
+				return WrapError(err)
+			}
+			if len(exceeded) == 0 {
+				continue
+			}
+			var items []string
+			for _, e := range exceeded {
+				items = append(items, fmt.Sprintf("%s requested %d but %d remaining", e.Item, e.Requested, e.Remaining))
+			}
+			return Error("The plan exceeds the %s quota of the %s %s: %s.", productName, quotaType, quotaTypeId, strings.Join(items, ", "))
+		}
+		return nil
+	}
+}
+
+func addDiskQuotaAmount(amounts map[string]int, category string, size int) {
+	amounts["disk_"+category] += size
+}
+
+func instanceQuotaAmounts(d *schema.ResourceDiff, client *connectivity.AlibabacloudStackClient) (map[string]int, error) {
+	if !d.NewValueKnown("instance_type") {
+		return nil, nil
+	}
+	ecsService := EcsService{client}
+	instanceType, err := ecsService.DescribeInstanceTypeById(d.Get("instance_type").(string))
+	if err != nil {
+		if NotFoundError(err) {
+			// The unknown instance type is reported by the api.
+			return nil, nil
+		}
+		return nil, WrapError(err)
+	}
+	amounts := map[string]int{
+		"cpu": instanceType.CpuCoreCount,
+		"mem": int(math.Ceil(instanceType.MemorySize)),
+		"gpu": instanceType.GPUAmount,
+	}
+	addDiskQuotaAmount(amounts, d.Get("system_disk_category").(string), d.Get("system_disk_size").(int))
+	for _, v := range d.Get("data_disks").([]interface{}) {
+		disk := v.(map[string]interface{})
+		addDiskQuotaAmount(amounts, disk["category"].(string), disk["size"].(int))
+	}
+	return amounts, nil
+}
+
+func diskQuotaAmounts(d *schema.ResourceDiff, client *connectivity.AlibabacloudStackClient) (map[string]int, error) {
+	amounts := make(map[string]int)
+	addDiskQuotaAmount(amounts, d.Get("category").(string), d.Get("size").(int))
+	return amounts, nil
+}
+
+func slbQuotaAmounts(d *schema.ResourceDiff, client *connectivity.AlibabacloudStackClient) (map[string]int, error) {
+	// The address type is intranet when it is not set.
+	if strings.ToLower(d.Get("address_type").(string)) == strings.ToLower(string(Internet)) {
+		return map[string]int{"vip_public": 1}, nil
+	}
+	return map[string]int{"vip_internal": 1}, nil
+}
+
+func eipQuotaAmounts(d *schema.ResourceDiff, client *connectivity.AlibabacloudStackClient) (map[string]int, error) {
+	return map[string]int{"eip": 1}, nil
+}
+
+func cidrOverlapped(cidr1, cidr2 string) (bool, error) {
+	_, net1, err := net.ParseCIDR(cidr1)
+	if err != nil {
+		return false, WrapError(err)
+	}
+	_, net2, err := net.ParseCIDR(cidr2)
+	if err != nil {
+		return false, WrapError(err)
+	}
+	return net1.Contains(net2.IP) || net2.Contains(net1.IP), nil
+}
+
+func fileMd5(path string) (string, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return "", err
+	}
+	defer f.Close()
+	h := md5.New()
+	if _, err := io.Copy(h, f); err != nil {
+		return "", err
+	}
+	return hex.EncodeToString(h.Sum(nil)), nil
+}
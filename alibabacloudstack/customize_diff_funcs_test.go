@@ -0,0 +1,197 @@
+package alibabacloudstack
+
+import (
+	"context"
+	"io/ioutil"
+	"os"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func TestEssScalingGroupSizeCustomizeDiff(t *testing.T) {
+	r := resourceAlibabacloudStackEssScalingGroup()
+
+	_, err := r.Diff(context.Background(), nil, terraform.NewResourceConfigRaw(map[string]interface{}{"min_size": 3, "max_size": 1}), nil)
+	if err == nil || !strings.Contains(err.Error(), "'min_size' (3) can not be greater than 'max_size' (1)") {
+		t.Fatalf("expected the plan to fail with min_size greater than max_size, got %v", err)
+	}
+	if _, err := r.Diff(context.Background(), nil, terraform.NewResourceConfigRaw(map[string]interface{}{"min_size": 1, "max_size": 3}), nil); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestDBInstanceEngineVersionCustomizeDiff(t *testing.T) {
+	r := resourceAlibabacloudStackDBInstance()
+	config := func(engine, version string) *terraform.ResourceConfig {
+		return terraform.NewResourceConfigRaw(map[string]interface{}{
+			"engine":               engine,
+			"engine_version":       version,
+			"db_instance_class":    "rds.mysql.s2.large",
+			"db_instance_storage":  30,
+			"storage_type":         "local_ssd",
+			"encryption_key":       "",
+			"instance_charge_type": "PostPaid",
+		})
+	}
+
+	_, err := r.Diff(context.Background(), nil, config("MySQL", "9.4"), nil)
+	if err == nil || !strings.Contains(err.Error(), "'engine_version' 9.4 is not supported by the engine MySQL") {
+		t.Fatalf("expected the plan to fail with an unsupported engine_version, got %v", err)
+	}
+	for engine, version := range map[string]string{"MySQL": "5.6", "mysql": "8.0", "PostgreSQL": "9.4", "Unknown": "1.0"} {
+		if _, err := r.Diff(context.Background(), nil, config(engine, version), nil); err != nil && strings.Contains(err.Error(), "engine_version") {
+			t.Fatalf("expected the engine_version %s of %s to be valid, got %v", version, engine, err)
+		}
+	}
+}
+
+func TestCSKubernetesCidrCustomizeDiff(t *testing.T) {
+	r := resourceAlibabacloudStackCSKubernetes()
+	raw := map[string]interface{}{
+		"name":         "tf-test",
+		"pod_cidr":     "172.16.0.0/16",
+		"service_cidr": "172.16.128.0/20",
+	}
+
+	_, err := r.Diff(context.Background(), nil, terraform.NewResourceConfigRaw(raw), nil)
+	if err == nil || !strings.Contains(err.Error(), "'pod_cidr' 172.16.0.0/16 and 'service_cidr' 172.16.128.0/20 can not overlap") {
+		t.Fatalf("expected the plan to fail with the overlapped cidr, got %v", err)
+	}
+
+	raw["service_cidr"] = "172.21.0.0/20"
+	state := &terraform.InstanceState{
+		ID: "c-test",
+		Attributes: map[string]string{
+			"id":           "c-test",
+			"name":         "tf-test",
+			"pod_cidr":     "172.20.0.0/16",
+			"service_cidr": "172.21.0.0/20",
+		},
+	}
+	diff, err := r.Diff(context.Background(), state, terraform.NewResourceConfigRaw(raw), nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if diff != nil && diff.Attributes["pod_cidr"] != nil {
+		t.Fatalf("expected the change of pod_cidr to be suppressed without force_update, got %#v", diff.Attributes["pod_cidr"])
+	}
+
+	raw["force_update"] = true
+	diff, err = r.Diff(context.Background(), state, terraform.NewResourceConfigRaw(raw), nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if diff == nil || diff.Attributes["pod_cidr"] == nil || diff.Attributes["pod_cidr"].RequiresNew {
+		t.Fatalf("expected the change of pod_cidr to be planned without recreating the cluster with force_update, got %#v", diff)
+	}
+
+	raw["recreate_on_network_change"] = true
+	diff, err = r.Diff(context.Background(), state, terraform.NewResourceConfigRaw(raw), nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if diff == nil || diff.Attributes["pod_cidr"] == nil || !diff.Attributes["pod_cidr"].RequiresNew {
+		t.Fatalf("expected the change of pod_cidr to recreate the cluster with recreate_on_network_change, got %#v", diff)
+	}
+}
+
+func TestCSKubernetesVersionCustomizeDiff(t *testing.T) {
+	r := resourceAlibabacloudStackCSKubernetes()
+	state := &terraform.InstanceState{
+		ID: "c-test",
+		Attributes: map[string]string{
+			"id":           "c-test",
+			"name":         "tf-test",
+			"pod_cidr":     "172.20.0.0/16",
+			"service_cidr": "172.21.0.0/20",
+			"version":      "1.20.11-aliyun.1",
+		},
+	}
+	config := func(version string) *terraform.ResourceConfig {
+		return terraform.NewResourceConfigRaw(map[string]interface{}{
+			"name":         "tf-test",
+			"pod_cidr":     "172.20.0.0/16",
+			"service_cidr": "172.21.0.0/20",
+			"version":      version,
+		})
+	}
+
+	_, err := r.Diff(context.Background(), state, config("1.18.8-aliyun.1"), nil)
+	if err == nil || !strings.Contains(err.Error(), "'version' can not be downgraded from 1.20.11-aliyun.1 to 1.18.8-aliyun.1") {
+		t.Fatalf("expected the plan to fail with the downgrade, got %v", err)
+	}
+	diff, err := r.Diff(context.Background(), state, config("1.22.3-aliyun.1"), nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if diff == nil || diff.Attributes["version"] == nil || diff.Attributes["version"].RequiresNew {
+		t.Fatalf("expected the version to be upgraded in place, got %#v", diff)
+	}
+}
+
+func TestOssBucketObjectSourceCustomizeDiff(t *testing.T) {
+	source, err := ioutil.TempFile("", "tf-oss-object-source")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.Remove(source.Name())
+	if err := ioutil.WriteFile(source.Name(), []byte("original content"), 0644); err != nil {
+		t.Fatal(err)
+	}
+	sum, err := fileMd5(source.Name())
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	r := resourceAlibabacloudStackOssBucketObject()
+	raw := map[string]interface{}{
+		"bucket": "tf-test",
+		"key":    "tf-test",
+		"source": source.Name(),
+	}
+	state := &terraform.InstanceState{
+		ID: "tf-test",
+		Attributes: map[string]string{
+			"id":                     "tf-test",
+			"bucket":                 "tf-test",
+			"key":                    "tf-test",
+			"source":                 source.Name(),
+			"acl":                    "private",
+			"server_side_encryption": ServerSideEncryptionAes256,
+			"multipart_threshold":    "100",
+			"part_size":              "10",
+			"parallel":               "3",
+			"etag":                   strings.ToUpper(sum),
+		},
+	}
+	diff, err := r.Diff(context.Background(), state, terraform.NewResourceConfigRaw(raw), nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if diff != nil && diff.Attributes["etag"] != nil {
+		t.Fatalf("expected no change of etag with the unchanged source, got %#v", diff.Attributes["etag"])
+	}
+
+	if err := ioutil.WriteFile(source.Name(), []byte("modified content"), 0644); err != nil {
+		t.Fatal(err)
+	}
+	diff, err = r.Diff(context.Background(), state, terraform.NewResourceConfigRaw(raw), nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if diff == nil || diff.Attributes["etag"] == nil || !diff.Attributes["etag"].NewComputed {
+		t.Fatalf("expected the modified source to plan a new etag, got %#v", diff)
+	}
+
+	// The etag of the multipart object is not the MD5 of the content.
+	state.Attributes["etag"] = strings.ToUpper(sum) + "-3"
+	diff, err = r.Diff(context.Background(), state, terraform.NewResourceConfigRaw(raw), nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if diff != nil && diff.Attributes["etag"] != nil {
+		t.Fatalf("expected the multipart etag to be skipped, got %#v", diff.Attributes["etag"])
+	}
+}
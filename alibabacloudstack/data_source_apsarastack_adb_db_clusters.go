@@ -1,6 +1,7 @@
 package alibabacloudstack
 
 import (
+	"context"
 	"fmt"
 	"github.com/PaesslerAG/jsonpath"
 	util "github.com/alibabacloud-go/tea-utils/service"
@@ -14,7 +15,7 @@ import (
 
 func dataSourceAlibabacloudStackAdbDbClusters() *schema.Resource {
 	return &schema.Resource{
-		Read: dataSourceAlibabacloudStackAdbDbClustersRead,
+		ReadContext: withDiagnostics(dataSourceAlibabacloudStackAdbDbClustersRead),
 		Schema: map[string]*schema.Schema{
 			"description_regex": {
 				Type:         schema.TypeString,
@@ -241,7 +242,7 @@ func dataSourceAlibabacloudStackAdbDbClusters() *schema.Resource {
 	}
 }
 
-func dataSourceAlibabacloudStackAdbDbClustersRead(d *schema.ResourceData, meta interface{}) error {
+func dataSourceAlibabacloudStackAdbDbClustersRead(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*connectivity.AlibabacloudStackClient)
 
 	action := "DescribeDBClusters"
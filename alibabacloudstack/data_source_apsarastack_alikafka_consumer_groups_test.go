@@ -0,0 +1,63 @@
+package alibabacloudstack
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+)
+
+func TestAccAlibabacloudStackAlikafkaConsumerGroupsDataSource(t *testing.T) {
+	rand := acctest.RandInt()
+	resourceId := "data.alibabacloudstack_alikafka_consumer_groups.default"
+	name := fmt.Sprintf("tf-testacc-alikafkaconsumers%v", rand)
+
+	testAccConfig := dataSourceTestAccConfigFunc(resourceId, name, dataSourceAlikafkaConsumerGroupsConfigDependence)
+
+	consumerIdRegexConf := dataSourceTestAccConfig{
+		existConfig: testAccConfig(map[string]interface{}{
+			"instance_id":       "${alibabacloudstack_alikafka_consumer_group.default.instance_id}",
+			"consumer_id_regex": "${alibabacloudstack_alikafka_consumer_group.default.consumer_id}",
+		}),
+		fakeConfig: testAccConfig(map[string]interface{}{
+			"instance_id":       "${alibabacloudstack_alikafka_consumer_group.default.instance_id}",
+			"consumer_id_regex": "${alibabacloudstack_alikafka_consumer_group.default.consumer_id}_fake",
+		}),
+	}
+
+	var existAlikafkaConsumerGroupsMapFunc = func(rand int) map[string]string {
+		return map[string]string{
+			"groups.#":             "1",
+			"groups.0.consumer_id": fmt.Sprintf("tf-testacc-alikafkaconsumers%v", rand),
+			"groups.0.description": "alibabacloudstack_alikafka_consumer_group_remark",
+		}
+	}
+
+	var fakeAlikafkaConsumerGroupsMapFunc = func(rand int) map[string]string {
+		return map[string]string{
+			"groups.#": "0",
+		}
+	}
+
+	var alikafkaConsumerGroupsCheckInfo = dataSourceAttr{
+		resourceId:   resourceId,
+		existMapFunc: existAlikafkaConsumerGroupsMapFunc,
+		fakeMapFunc:  fakeAlikafkaConsumerGroupsMapFunc,
+	}
+
+	alikafkaConsumerGroupsCheckInfo.dataSourceTestCheck(t, rand, consumerIdRegexConf)
+}
+
+func dataSourceAlikafkaConsumerGroupsConfigDependence(name string) string {
+	return fmt.Sprintf(`
+variable "name" {
+  default = "%v"
+}
+
+resource "alibabacloudstack_alikafka_consumer_group" "default" {
+  instance_id = "cluster-private-paas-default"
+  consumer_id = var.name
+  description = "alibabacloudstack_alikafka_consumer_group_remark"
+}
+`, name)
+}
@@ -0,0 +1,188 @@
+package alibabacloudstack
+
+import (
+	"context"
+	"regexp"
+
+	"github.com/aliyun/terraform-provider-alibabacloudstack/alibabacloudstack/connectivity"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+func dataSourceAlibabacloudStackAlikafkaInstances() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: withDiagnostics(dataSourceAlibabacloudStackAlikafkaInstancesRead),
+
+		Schema: map[string]*schema.Schema{
+			"ids": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+				Computed: true,
+			},
+			"name_regex": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringIsValidRegExp,
+			},
+			"output_file": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			// Computed values
+			"names": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"instances": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"create_time": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"service_status": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"deploy_type": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"vpc_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"vswitch_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"zone_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"security_group": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"end_point": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"io_max": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"eip_max": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"disk_type": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"disk_size": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"topic_quota": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"spec_type": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"paid_type": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceAlibabacloudStackAlikafkaInstancesRead(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*connectivity.AlibabacloudStackClient)
+	alikafkaService := AlikafkaService{client}
+
+	instances, err := alikafkaService.DescribeAlikafkaInstances(ctx)
+	if err != nil {
+		return WrapErrorf(err, DataDefaultErrorMsg, "alibabacloudstack_alikafka_instances", "GetInstanceList", AlibabacloudStackSdkGoERROR)
+	}
+
+	var r *regexp.Regexp
+	if nameRegex, ok := d.GetOk("name_regex"); ok && nameRegex.(string) != "" {
+		r = regexp.MustCompile(nameRegex.(string))
+	}
+	idsMap := make(map[string]string)
+	if v, ok := d.GetOk("ids"); ok {
+		for _, id := range v.([]interface{}) {
+			idsMap[Trim(id.(string))] = Trim(id.(string))
+		}
+	}
+
+	var ids []string
+	var names []string
+	var s []map[string]interface{}
+	for _, item := range instances {
+		if r != nil && !r.MatchString(item.Name) {
+			continue
+		}
+		if len(idsMap) > 0 {
+			if _, ok := idsMap[item.InstanceId]; !ok {
+				continue
+			}
+		}
+		mapping := map[string]interface{}{
+			"id":             item.InstanceId,
+			"name":           item.Name,
+			"create_time":    item.CreateTime,
+			"service_status": item.ServiceStatus,
+			"deploy_type":    item.DeployType,
+			"vpc_id":         item.VpcId,
+			"vswitch_id":     item.VSwitchId,
+			"zone_id":        item.ZoneId,
+			"security_group": item.SecurityGroup,
+			"end_point":      item.EndPoint,
+			"io_max":         item.IoMax,
+			"eip_max":        item.EipMax,
+			"disk_type":      item.DiskType,
+			"disk_size":      item.DiskSize,
+			"topic_quota":    item.TopicNumLimit,
+			"spec_type":      item.SpecType,
+			"paid_type":      item.PaidType,
+		}
+		ids = append(ids, item.InstanceId)
+		names = append(names, item.Name)
+		s = append(s, mapping)
+	}
+
+	d.SetId(dataResourceIdHash(ids))
+	if err := d.Set("ids", ids); err != nil {
+		return WrapError(err)
+	}
+	if err := d.Set("names", names); err != nil {
+		return WrapError(err)
+	}
+	if err := d.Set("instances", s); err != nil {
+		return WrapError(err)
+	}
+	if output, ok := d.GetOk("output_file"); ok && output.(string) != "" {
+		writeToFile(output.(string), s)
+	}
+	return nil
+}
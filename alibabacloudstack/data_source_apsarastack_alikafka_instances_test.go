@@ -0,0 +1,74 @@
+package alibabacloudstack
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+)
+
+func TestAccAlibabacloudStackAlikafkaInstancesDataSource(t *testing.T) {
+	rand := acctest.RandInt()
+	resourceId := "data.alibabacloudstack_alikafka_instances.default"
+	name := fmt.Sprintf("tf-testacc-alikafkainstances%v", rand)
+
+	testAccConfig := dataSourceTestAccConfigFunc(resourceId, name, dataSourceAlikafkaInstancesConfigDependence)
+
+	nameRegexConf := dataSourceTestAccConfig{
+		existConfig: testAccConfig(map[string]interface{}{
+			"name_regex": "${alibabacloudstack_alikafka_instance.default.name}",
+		}),
+		fakeConfig: testAccConfig(map[string]interface{}{
+			"name_regex": "${alibabacloudstack_alikafka_instance.default.name}_fake",
+		}),
+	}
+	idsConf := dataSourceTestAccConfig{
+		existConfig: testAccConfig(map[string]interface{}{
+			"ids": []string{"${alibabacloudstack_alikafka_instance.default.id}"},
+		}),
+		fakeConfig: testAccConfig(map[string]interface{}{
+			"ids": []string{"${alibabacloudstack_alikafka_instance.default.id}_fake"},
+		}),
+	}
+
+	var existAlikafkaInstancesMapFunc = func(rand int) map[string]string {
+		return map[string]string{
+			"instances.#":             "1",
+			"instances.0.name":        fmt.Sprintf("tf-testacc-alikafkainstances%v", rand),
+			"instances.0.topic_quota": "50",
+			"instances.0.disk_size":   "500",
+			"instances.0.deploy_type": "5",
+			"instances.0.vswitch_id":  CHECKSET,
+		}
+	}
+
+	var fakeAlikafkaInstancesMapFunc = func(rand int) map[string]string {
+		return map[string]string{
+			"instances.#": "0",
+		}
+	}
+
+	var alikafkaInstancesCheckInfo = dataSourceAttr{
+		resourceId:   resourceId,
+		existMapFunc: existAlikafkaInstancesMapFunc,
+		fakeMapFunc:  fakeAlikafkaInstancesMapFunc,
+	}
+
+	alikafkaInstancesCheckInfo.dataSourceTestCheck(t, rand, nameRegexConf, idsConf)
+}
+
+func dataSourceAlikafkaInstancesConfigDependence(name string) string {
+	return fmt.Sprintf(`
+%s
+
+resource "alibabacloudstack_alikafka_instance" "default" {
+  name        = var.name
+  topic_quota = 50
+  disk_type   = 1
+  disk_size   = 500
+  deploy_type = 5
+  io_max      = 20
+  vswitch_id  = alibabacloudstack_vswitch.default.id
+}
+`, resourceAlikafkaInstanceConfigDependence(name))
+}
@@ -0,0 +1,83 @@
+package alibabacloudstack
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/aliyun/terraform-provider-alibabacloudstack/alibabacloudstack/connectivity"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+)
+
+func TestAccAlibabacloudStackAlikafkaSaslUsersDataSource(t *testing.T) {
+	rand := acctest.RandIntRange(10000, 99999)
+	name := fmt.Sprintf("tf-testacc-alikafkasaslusers%d", rand)
+	resourceId := "data.alibabacloudstack_alikafka_sasl_users.default"
+	testAccConfig := dataSourceTestAccConfigFunc(resourceId, name, dataSourceAlikafkaSaslUsersConfigDependence)
+
+	idsConf := dataSourceTestAccConfig{
+		existConfig: testAccConfig(map[string]interface{}{
+			"instance_id": "${alibabacloudstack_alikafka_sasl_user.default.instance_id}",
+			"ids":         []string{"${alibabacloudstack_alikafka_sasl_user.default.id}"},
+		}),
+		fakeConfig: testAccConfig(map[string]interface{}{
+			"instance_id": "${alibabacloudstack_alikafka_sasl_user.default.instance_id}",
+			"ids":         []string{"${alibabacloudstack_alikafka_sasl_user.default.id}_fake"},
+		}),
+	}
+	nameRegexConf := dataSourceTestAccConfig{
+		existConfig: testAccConfig(map[string]interface{}{
+			"instance_id": "${alibabacloudstack_alikafka_sasl_user.default.instance_id}",
+			"name_regex":  "${alibabacloudstack_alikafka_sasl_user.default.username}",
+		}),
+		fakeConfig: testAccConfig(map[string]interface{}{
+			"instance_id": "${alibabacloudstack_alikafka_sasl_user.default.instance_id}",
+			"name_regex":  "${alibabacloudstack_alikafka_sasl_user.default.username}_fake",
+		}),
+	}
+
+	var existAlikafkaSaslUsersMapFunc = func(rand int) map[string]string {
+		return map[string]string{
+			"ids.#":               "1",
+			"names.#":             "1",
+			"users.#":             "1",
+			"users.0.id":          fmt.Sprintf("cluster-private-paas-default:%s:scram", name),
+			"users.0.instance_id": "cluster-private-paas-default",
+			"users.0.username":    name,
+			"users.0.type":        "scram",
+		}
+	}
+
+	var fakeAlikafkaSaslUsersMapFunc = func(rand int) map[string]string {
+		return map[string]string{
+			"ids.#":   "0",
+			"names.#": "0",
+			"users.#": "0",
+		}
+	}
+
+	var alikafkaSaslUsersCheckInfo = dataSourceAttr{
+		resourceId:   resourceId,
+		existMapFunc: existAlikafkaSaslUsersMapFunc,
+		fakeMapFunc:  fakeAlikafkaSaslUsersMapFunc,
+	}
+
+	preCheck := func() {
+		testAccPreCheckWithRegions(t, true, connectivity.AlikafkaSupportedRegions)
+	}
+	alikafkaSaslUsersCheckInfo.dataSourceTestCheckWithPreCheck(t, rand, preCheck, idsConf, nameRegexConf)
+}
+
+func dataSourceAlikafkaSaslUsersConfigDependence(name string) string {
+	return fmt.Sprintf(`
+variable "name" {
+  default = "%s"
+}
+
+resource "alibabacloudstack_alikafka_sasl_user" "default" {
+  instance_id = "cluster-private-paas-default"
+  username    = var.name
+  password    = "inputYourCodeHere"
+  type        = "scram"
+}
+`, name)
+}
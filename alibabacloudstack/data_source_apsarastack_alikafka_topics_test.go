@@ -0,0 +1,87 @@
+package alibabacloudstack
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/aliyun/terraform-provider-alibabacloudstack/alibabacloudstack/connectivity"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+)
+
+func TestAccAlibabacloudStackAlikafkaTopicsDataSource(t *testing.T) {
+	rand := acctest.RandIntRange(10000, 99999)
+	name := fmt.Sprintf("tf-testacc-alikafkatopics%d", rand)
+	resourceId := "data.alibabacloudstack_alikafka_topics.default"
+	testAccConfig := dataSourceTestAccConfigFunc(resourceId, name, dataSourceAlikafkaTopicsConfigDependence)
+
+	idsConf := dataSourceTestAccConfig{
+		existConfig: testAccConfig(map[string]interface{}{
+			"instance_id": "${alibabacloudstack_alikafka_topic.default.instance_id}",
+			"ids":         []string{"${alibabacloudstack_alikafka_topic.default.id}"},
+		}),
+		fakeConfig: testAccConfig(map[string]interface{}{
+			"instance_id": "${alibabacloudstack_alikafka_topic.default.instance_id}",
+			"ids":         []string{"${alibabacloudstack_alikafka_topic.default.id}_fake"},
+		}),
+	}
+	nameRegexConf := dataSourceTestAccConfig{
+		existConfig: testAccConfig(map[string]interface{}{
+			"instance_id": "${alibabacloudstack_alikafka_topic.default.instance_id}",
+			"name_regex":  "${alibabacloudstack_alikafka_topic.default.topic}",
+		}),
+		fakeConfig: testAccConfig(map[string]interface{}{
+			"instance_id": "${alibabacloudstack_alikafka_topic.default.instance_id}",
+			"name_regex":  "${alibabacloudstack_alikafka_topic.default.topic}_fake",
+		}),
+	}
+
+	var existAlikafkaTopicsMapFunc = func(rand int) map[string]string {
+		return map[string]string{
+			"ids.#":                  "1",
+			"names.#":                "1",
+			"topics.#":               "1",
+			"topics.0.id":            fmt.Sprintf("cluster-private-paas-default:%s", name),
+			"topics.0.instance_id":   "cluster-private-paas-default",
+			"topics.0.topic":         name,
+			"topics.0.partition_num": "6",
+			"topics.0.remark":        "alibabacloudstack_alikafka_topic_remark",
+			"topics.0.status":        CHECKSET,
+		}
+	}
+
+	var fakeAlikafkaTopicsMapFunc = func(rand int) map[string]string {
+		return map[string]string{
+			"ids.#":    "0",
+			"names.#":  "0",
+			"topics.#": "0",
+		}
+	}
+
+	var alikafkaTopicsCheckInfo = dataSourceAttr{
+		resourceId:   resourceId,
+		existMapFunc: existAlikafkaTopicsMapFunc,
+		fakeMapFunc:  fakeAlikafkaTopicsMapFunc,
+	}
+
+	preCheck := func() {
+		testAccPreCheckWithRegions(t, true, connectivity.AlikafkaSupportedRegions)
+	}
+	alikafkaTopicsCheckInfo.dataSourceTestCheckWithPreCheck(t, rand, preCheck, idsConf, nameRegexConf)
+}
+
+func dataSourceAlikafkaTopicsConfigDependence(name string) string {
+	return fmt.Sprintf(`
+variable "name" {
+  default = "%s"
+}
+
+resource "alibabacloudstack_alikafka_topic" "default" {
+  instance_id   = "cluster-private-paas-default"
+  topic         = var.name
+  local_topic   = "false"
+  compact_topic = "false"
+  partition_num = "6"
+  remark        = "alibabacloudstack_alikafka_topic_remark"
+}
+`, name)
+}
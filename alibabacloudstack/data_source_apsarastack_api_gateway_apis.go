@@ -1,6 +1,7 @@
 package alibabacloudstack
 
 import (
+	"context"
 	"regexp"
 
 	"github.com/aliyun/alibaba-cloud-sdk-go/sdk/requests"
@@ -12,7 +13,7 @@ import (
 
 func dataSourceAlibabacloudStackApiGatewayApis() *schema.Resource {
 	return &schema.Resource{
-		Read: dataSourceAlibabacloudStackApigatewayApisRead,
+		ReadContext: withDiagnostics(dataSourceAlibabacloudStackApigatewayApisRead),
 
 		Schema: map[string]*schema.Schema{
 			"group_id": {
@@ -83,7 +84,7 @@ func dataSourceAlibabacloudStackApiGatewayApis() *schema.Resource {
 		},
 	}
 }
-func dataSourceAlibabacloudStackApigatewayApisRead(d *schema.ResourceData, meta interface{}) error {
+func dataSourceAlibabacloudStackApigatewayApisRead(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*connectivity.AlibabacloudStackClient)
 	request := cloudapi.CreateDescribeApisRequest()
 	request.RegionId = client.RegionId
@@ -1,6 +1,7 @@
 package alibabacloudstack
 
 import (
+	"context"
 	"regexp"
 	"strconv"
 
@@ -13,7 +14,7 @@ import (
 
 func dataSourceAlibabacloudStackApiGatewayApps() *schema.Resource {
 	return &schema.Resource{
-		Read: dataSourceAlibabacloudStackApigatewayAppsRead,
+		ReadContext: withDiagnostics(dataSourceAlibabacloudStackApigatewayAppsRead),
 
 		Schema: map[string]*schema.Schema{
 			"name_regex": {
@@ -74,7 +75,7 @@ func dataSourceAlibabacloudStackApiGatewayApps() *schema.Resource {
 		},
 	}
 }
-func dataSourceAlibabacloudStackApigatewayAppsRead(d *schema.ResourceData, meta interface{}) error {
+func dataSourceAlibabacloudStackApigatewayAppsRead(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*connectivity.AlibabacloudStackClient)
 	cloudApiService := CloudApiService{client}
 
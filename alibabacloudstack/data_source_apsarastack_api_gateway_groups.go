@@ -1,6 +1,7 @@
 package alibabacloudstack
 
 import (
+	"context"
 	"regexp"
 
 	"github.com/aliyun/alibaba-cloud-sdk-go/sdk/requests"
@@ -12,7 +13,7 @@ import (
 
 func dataSourceAlibabacloudStackApiGatewayGroups() *schema.Resource {
 	return &schema.Resource{
-		Read: dataSourceAlibabacloudStackApigatewayGroupsRead,
+		ReadContext: withDiagnostics(dataSourceAlibabacloudStackApigatewayGroupsRead),
 
 		Schema: map[string]*schema.Schema{
 			"name_regex": {
@@ -88,7 +89,7 @@ func dataSourceAlibabacloudStackApiGatewayGroups() *schema.Resource {
 		},
 	}
 }
-func dataSourceAlibabacloudStackApigatewayGroupsRead(d *schema.ResourceData, meta interface{}) error {
+func dataSourceAlibabacloudStackApigatewayGroupsRead(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*connectivity.AlibabacloudStackClient)
 
 	request := cloudapi.CreateDescribeApiGroupsRequest()
@@ -0,0 +1,79 @@
+package alibabacloudstack
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+)
+
+func TestAccAlibabacloudStackAscmCustomRolesDataSource(t *testing.T) {
+	rand := acctest.RandIntRange(10000, 99999)
+	name := fmt.Sprintf("tf_testacc_ascm_custom_roles%d", rand)
+	resourceId := "data.alibabacloudstack_ascm_custom_roles.default"
+	testAccConfig := dataSourceTestAccConfigFunc(resourceId, name, dataSourceAscmCustomRolesConfigDependence)
+
+	idsConf := dataSourceTestAccConfig{
+		existConfig: testAccConfig(map[string]interface{}{
+			"ids": []string{"${alibabacloudstack_ascm_custom_role.default.id}"},
+		}),
+		fakeConfig: testAccConfig(map[string]interface{}{
+			"ids": []string{"${alibabacloudstack_ascm_custom_role.default.id}_fake"},
+		}),
+	}
+	nameRegexConf := dataSourceTestAccConfig{
+		existConfig: testAccConfig(map[string]interface{}{
+			"name_regex": "${alibabacloudstack_ascm_custom_role.default.role_name}",
+		}),
+		fakeConfig: testAccConfig(map[string]interface{}{
+			"name_regex": "${alibabacloudstack_ascm_custom_role.default.role_name}_fake",
+		}),
+	}
+
+	var existAscmCustomRolesMapFunc = func(rand int) map[string]string {
+		return map[string]string{
+			"ids.#":                           "1",
+			"names.#":                         "1",
+			"roles.#":                         "1",
+			"roles.0.id":                      CHECKSET,
+			"roles.0.role_id":                 CHECKSET,
+			"roles.0.role_name":               name,
+			"roles.0.description":             "TestRole",
+			"roles.0.role_range":              "roleRange.allOrganizations",
+			"roles.0.organization_visibility": "organizationVisibility.global",
+			"roles.0.privileges.#":            "2",
+		}
+	}
+
+	var fakeAscmCustomRolesMapFunc = func(rand int) map[string]string {
+		return map[string]string{
+			"ids.#":   "0",
+			"names.#": "0",
+			"roles.#": "0",
+		}
+	}
+
+	var ascmCustomRolesCheckInfo = dataSourceAttr{
+		resourceId:   resourceId,
+		existMapFunc: existAscmCustomRolesMapFunc,
+		fakeMapFunc:  fakeAscmCustomRolesMapFunc,
+	}
+
+	ascmCustomRolesCheckInfo.dataSourceTestCheck(t, rand, idsConf, nameRegexConf)
+}
+
+func dataSourceAscmCustomRolesConfigDependence(name string) string {
+	return fmt.Sprintf(`
+variable "name" {
+  default = "%s"
+}
+
+resource "alibabacloudstack_ascm_custom_role" "default" {
+  role_name               = var.name
+  description             = "TestRole"
+  organization_visibility = "organizationVisibility.global"
+  role_range              = "roleRange.allOrganizations"
+  privileges              = ["PRIG_SYS_ROLE_READ", "PRIG_SYS_USER_READ"]
+}
+`, name)
+}
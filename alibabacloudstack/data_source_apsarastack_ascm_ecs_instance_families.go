@@ -1,6 +1,7 @@
 package alibabacloudstack
 
 import (
+	"context"
 	"encoding/json"
 	"github.com/aliyun/alibaba-cloud-sdk-go/sdk/requests"
 	"github.com/aliyun/alibaba-cloud-sdk-go/sdk/responses"
@@ -13,7 +14,7 @@ import (
 
 func dataSourceAlibabacloudStackEcsInstanceFamilies() *schema.Resource {
 	return &schema.Resource{
-		Read: dataSourceAlibabacloudStackEcsInstanceFamiliesRead,
+		ReadContext: withDiagnostics(dataSourceAlibabacloudStackEcsInstanceFamiliesRead),
 		Schema: map[string]*schema.Schema{
 			"ids": {
 				Type:     schema.TypeList,
@@ -51,7 +52,7 @@ func dataSourceAlibabacloudStackEcsInstanceFamilies() *schema.Resource {
 	}
 }
 
-func dataSourceAlibabacloudStackEcsInstanceFamiliesRead(d *schema.ResourceData, meta interface{}) error {
+func dataSourceAlibabacloudStackEcsInstanceFamiliesRead(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*connectivity.AlibabacloudStackClient)
 	request := requests.NewCommonRequest()
 	if client.Config.Insecure {
@@ -1,6 +1,7 @@
 package alibabacloudstack
 
 import (
+	"context"
 	"encoding/json"
 	"github.com/aliyun/alibaba-cloud-sdk-go/sdk/requests"
 	"github.com/aliyun/alibaba-cloud-sdk-go/sdk/responses"
@@ -13,7 +14,7 @@ import (
 
 func dataSourceAlibabacloudStackAscmEnvironmentServicesByProduct() *schema.Resource {
 	return &schema.Resource{
-		Read: dataSourceAlibabacloudStackAscmEnvironmentServicesByProductRead,
+		ReadContext: withDiagnostics(dataSourceAlibabacloudStackAscmEnvironmentServicesByProductRead),
 		Schema: map[string]*schema.Schema{
 			"ids": {
 				Type:     schema.TypeList,
@@ -37,7 +38,7 @@ func dataSourceAlibabacloudStackAscmEnvironmentServicesByProduct() *schema.Resou
 	}
 }
 
-func dataSourceAlibabacloudStackAscmEnvironmentServicesByProductRead(d *schema.ResourceData, meta interface{}) error {
+func dataSourceAlibabacloudStackAscmEnvironmentServicesByProductRead(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*connectivity.AlibabacloudStackClient)
 	request := requests.NewCommonRequest()
 	if client.Config.Insecure {
@@ -1,6 +1,7 @@
 package alibabacloudstack
 
 import (
+	"context"
 	"encoding/json"
 	"github.com/aliyun/alibaba-cloud-sdk-go/sdk/requests"
 	"github.com/aliyun/alibaba-cloud-sdk-go/sdk/responses"
@@ -14,7 +15,7 @@ import (
 
 func dataSourceAlibabacloudstackAscmMeteringQueryEcs() *schema.Resource {
 	return &schema.Resource{
-		Read: dataSourceAlibabacloudstackAscmMeteringQueryEcsRead,
+		ReadContext: withDiagnostics(dataSourceAlibabacloudstackAscmMeteringQueryEcsRead),
 		Schema: map[string]*schema.Schema{
 			"start_time": {
 				Type:     schema.TypeString,
@@ -149,7 +150,7 @@ func dataSourceAlibabacloudstackAscmMeteringQueryEcs() *schema.Resource {
 	}
 }
 
-func dataSourceAlibabacloudstackAscmMeteringQueryEcsRead(d *schema.ResourceData, meta interface{}) error {
+func dataSourceAlibabacloudstackAscmMeteringQueryEcsRead(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*connectivity.AlibabacloudStackClient)
 	starttime := d.Get("start_time").(string)
 	endtime := d.Get("end_time").(string)
@@ -1,6 +1,7 @@
 package alibabacloudstack
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
 	"github.com/aliyun/alibaba-cloud-sdk-go/sdk/requests"
@@ -14,7 +15,7 @@ import (
 
 func dataSourceAlibabacloudStackAscmPasswordPolicies() *schema.Resource {
 	return &schema.Resource{
-		Read: dataSourceAlibabacloudStackAscmPasswordPoliciesRead,
+		ReadContext: withDiagnostics(dataSourceAlibabacloudStackAscmPasswordPoliciesRead),
 		Schema: map[string]*schema.Schema{
 			"ids": {
 				Type:     schema.TypeList,
@@ -119,7 +120,7 @@ func dataSourceAlibabacloudStackAscmPasswordPolicies() *schema.Resource {
 	}
 }
 
-func dataSourceAlibabacloudStackAscmPasswordPoliciesRead(d *schema.ResourceData, meta interface{}) error {
+func dataSourceAlibabacloudStackAscmPasswordPoliciesRead(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*connectivity.AlibabacloudStackClient)
 	request := requests.NewCommonRequest()
 	if client.Config.Insecure {
@@ -0,0 +1,119 @@
+package alibabacloudstack
+
+import (
+	"context"
+
+	"github.com/aliyun/terraform-provider-alibabacloudstack/alibabacloudstack/connectivity"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+func dataSourceAlibabacloudStackAscmRemainingQuotas() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: withDiagnostics(dataSourceAlibabacloudStackAscmRemainingQuotasRead),
+		Schema: map[string]*schema.Schema{
+			"product_name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringInSlice([]string{"ECS", "SLB", "EIP", "VPC", "OSS"}, false),
+			},
+			"quota_type": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      "resourceGroup",
+				ValidateFunc: validation.StringInSlice([]string{"resourceGroup", "organization"}, false),
+			},
+			"quota_type_id": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+			"output_file": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"ids": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"quotas": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"item": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"total": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"used": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"remaining": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"limited": {
+							Type:     schema.TypeBool,
+							Computed: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceAlibabacloudStackAscmRemainingQuotasRead(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*connectivity.AlibabacloudStackClient)
+	ascmService := AscmService{client}
+	productName := d.Get("product_name").(string)
+	quotaType := d.Get("quota_type").(string)
+	quotaTypeId := d.Get("quota_type_id").(string)
+	if quotaTypeId == "" {
+		if quotaType == "organization" {
+			quotaTypeId = client.Department
+		} else {
+			quotaTypeId = client.ResourceGroup
+		}
+	}
+	if quotaTypeId == "" {
+		return WrapError(Error("'quota_type_id' is required when the provider has no %s.", quotaType))
+	}
+
+	usages, err := ascmService.DescribeAscmQuotaUsage(productName, quotaType, quotaTypeId)
+	if err != nil {
+		return WrapErrorf(err, DataDefaultErrorMsg, "alibabacloudstack_ascm_remaining_quotas", "GetQuota", AlibabacloudStackSdkGoERROR)
+	}
+	var ids []string
+	var s []map[string]interface{}
+	for _, usage := range usages {
+		// The items whose total is 0 are not limited by the quota.
+		ids = append(ids, usage.Item)
+		s = append(s, map[string]interface{}{
+			"item":      usage.Item,
+			"total":     usage.Total,
+			"used":      usage.Used,
+			"remaining": usage.Total - usage.Used,
+			"limited":   usage.Total > 0,
+		})
+	}
+
+	d.SetId(dataResourceIdHash(ids))
+	d.Set("quota_type_id", quotaTypeId)
+	if err := d.Set("ids", ids); err != nil {
+		return WrapError(err)
+	}
+	if err := d.Set("quotas", s); err != nil {
+		return WrapError(err)
+	}
+	if output, ok := d.GetOk("output_file"); ok && output.(string) != "" {
+		writeToFile(output.(string), s)
+	}
+	return nil
+}
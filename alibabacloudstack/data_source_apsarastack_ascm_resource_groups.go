@@ -1,6 +1,7 @@
 package alibabacloudstack
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
 	"github.com/aliyun/alibaba-cloud-sdk-go/sdk/requests"
@@ -17,7 +18,7 @@ import (
 
 func dataSourceAlibabacloudStackAscmResourceGroups() *schema.Resource {
 	return &schema.Resource{
-		Read: dataSourceAlibabacloudStackAscmResourceGroupsRead,
+		ReadContext: withDiagnostics(dataSourceAlibabacloudStackAscmResourceGroupsRead),
 		Schema: map[string]*schema.Schema{
 			"ids": {
 				Type:     schema.TypeList,
@@ -87,7 +88,7 @@ func dataSourceAlibabacloudStackAscmResourceGroups() *schema.Resource {
 	}
 }
 
-func dataSourceAlibabacloudStackAscmResourceGroupsRead(d *schema.ResourceData, meta interface{}) error {
+func dataSourceAlibabacloudStackAscmResourceGroupsRead(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*connectivity.AlibabacloudStackClient)
 	name := d.Get("name_regex").(string)
 	request := requests.NewCommonRequest()
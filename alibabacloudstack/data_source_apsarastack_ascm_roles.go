@@ -1,6 +1,7 @@
 package alibabacloudstack
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
 	"github.com/aliyun/alibaba-cloud-sdk-go/sdk/requests"
@@ -15,7 +16,7 @@ import (
 
 func dataSourceAlibabacloudStackAscmRoles() *schema.Resource {
 	return &schema.Resource{
-		Read: dataSourceAlibabacloudStackAscmRolesRead,
+		ReadContext: withDiagnostics(dataSourceAlibabacloudStackAscmRolesRead),
 		Schema: map[string]*schema.Schema{
 			"id": {
 				Type:     schema.TypeInt,
@@ -111,7 +112,7 @@ func dataSourceAlibabacloudStackAscmRoles() *schema.Resource {
 	}
 }
 
-func dataSourceAlibabacloudStackAscmRolesRead(d *schema.ResourceData, meta interface{}) error {
+func dataSourceAlibabacloudStackAscmRolesRead(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*connectivity.AlibabacloudStackClient)
 	id := d.Get("id").(int)
 	roleType := d.Get("role_type").(string)
@@ -1,6 +1,7 @@
 package alibabacloudstack
 
 import (
+	"context"
 	"encoding/json"
 	"github.com/aliyun/alibaba-cloud-sdk-go/sdk/requests"
 	"github.com/aliyun/alibaba-cloud-sdk-go/sdk/responses"
@@ -13,7 +14,7 @@ import (
 
 func dataSourceAlibabacloudStackServiceClusterByProduct() *schema.Resource {
 	return &schema.Resource{
-		Read: dataSourceAlibabacloudStackServiceClusterByProductRead,
+		ReadContext: withDiagnostics(dataSourceAlibabacloudStackServiceClusterByProductRead),
 		Schema: map[string]*schema.Schema{
 			"ids": {
 				Type:     schema.TypeList,
@@ -48,7 +49,7 @@ func dataSourceAlibabacloudStackServiceClusterByProduct() *schema.Resource {
 	}
 }
 
-func dataSourceAlibabacloudStackServiceClusterByProductRead(d *schema.ResourceData, meta interface{}) error {
+func dataSourceAlibabacloudStackServiceClusterByProductRead(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*connectivity.AlibabacloudStackClient)
 	request := requests.NewCommonRequest()
 	if client.Config.Insecure {
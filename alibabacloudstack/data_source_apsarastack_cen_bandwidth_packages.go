@@ -0,0 +1,168 @@
+package alibabacloudstack
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+
+	"github.com/aliyun/terraform-provider-alibabacloudstack/alibabacloudstack/connectivity"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+func dataSourceAlibabacloudStackCenBandwidthPackages() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: withDiagnostics(dataSourceAlibabacloudStackCenBandwidthPackagesRead),
+		Schema: map[string]*schema.Schema{
+			"ids": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"name_regex": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringIsValidRegExp,
+			},
+			"instance_id": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"output_file": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"names": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"packages": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"description": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"bandwidth": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"geographic_region_a_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"geographic_region_b_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"status": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"business_status": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"expired_time": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"instance_ids": {
+							Type:     schema.TypeList,
+							Computed: true,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceAlibabacloudStackCenBandwidthPackagesRead(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*connectivity.AlibabacloudStackClient)
+	cenService := CenService{client}
+
+	request := make(map[string]interface{})
+	if v, ok := d.GetOk("instance_id"); ok {
+		request["Filter.1.Key"] = "CenId"
+		request["Filter.1.Value.1"] = v
+	}
+	var nameRegex *regexp.Regexp
+	if v, ok := d.GetOk("name_regex"); ok && v.(string) != "" {
+		nameRegex = regexp.MustCompile(v.(string))
+	}
+	idsMap := make(map[string]string)
+	if v, ok := d.GetOk("ids"); ok {
+		for _, vv := range v.([]interface{}) {
+			if vv == nil {
+				continue
+			}
+			idsMap[vv.(string)] = vv.(string)
+		}
+	}
+
+	action := "DescribeCenBandwidthPackages"
+	objects, err := cenService.describeCenPages(ctx, action, request, "$.CenBandwidthPackages.CenBandwidthPackage")
+	if err != nil {
+		return WrapErrorf(err, DataDefaultErrorMsg, "alibabacloudstack_cen_bandwidth_packages", action, AlibabacloudStackSdkGoERROR)
+	}
+
+	var ids []string
+	var names []string
+	var s []map[string]interface{}
+	for _, v := range objects {
+		object := v.(map[string]interface{})
+		id := fmt.Sprint(object["CenBandwidthPackageId"])
+		if nameRegex != nil && !nameRegex.MatchString(fmt.Sprint(object["Name"])) {
+			continue
+		}
+		if len(idsMap) > 0 {
+			if _, ok := idsMap[id]; !ok {
+				continue
+			}
+		}
+		mapping := map[string]interface{}{
+			"id":                     id,
+			"name":                   object["Name"],
+			"description":            object["Description"],
+			"bandwidth":              formatInt(object["Bandwidth"]),
+			"geographic_region_a_id": object["GeographicRegionAId"],
+			"geographic_region_b_id": object["GeographicRegionBId"],
+			"status":                 object["Status"],
+			"business_status":        object["BusinessStatus"],
+			"expired_time":           object["ExpiredTime"],
+			"instance_ids":           cenStringList(object["CenIds"], "CenId"),
+		}
+		ids = append(ids, id)
+		names = append(names, fmt.Sprint(object["Name"]))
+		s = append(s, mapping)
+	}
+
+	d.SetId(dataResourceIdHash(ids))
+	if err := d.Set("ids", ids); err != nil {
+		return WrapError(err)
+	}
+	if err := d.Set("names", names); err != nil {
+		return WrapError(err)
+	}
+	if err := d.Set("packages", s); err != nil {
+		return WrapError(err)
+	}
+	if output, ok := d.GetOk("output_file"); ok && output.(string) != "" {
+		writeToFile(output.(string), s)
+	}
+	return nil
+}
@@ -0,0 +1,76 @@
+package alibabacloudstack
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+)
+
+func TestAccAlibabacloudStackCenBandwidthPackagesDataSource(t *testing.T) {
+	rand := acctest.RandIntRange(10000, 99999)
+	resourceId := "data.alibabacloudstack_cen_bandwidth_packages.default"
+	name := fmt.Sprintf("tf-testacc-cenbwps%v", rand)
+
+	testAccConfig := dataSourceTestAccConfigFunc(resourceId, name, dataSourceCenBandwidthPackagesConfigDependence)
+
+	nameRegexConf := dataSourceTestAccConfig{
+		existConfig: testAccConfig(map[string]interface{}{
+			"name_regex": "${alibabacloudstack_cen_bandwidth_package.default.name}",
+		}),
+		fakeConfig: testAccConfig(map[string]interface{}{
+			"name_regex": "${alibabacloudstack_cen_bandwidth_package.default.name}_fake",
+		}),
+	}
+	idsConf := dataSourceTestAccConfig{
+		existConfig: testAccConfig(map[string]interface{}{
+			"ids": []string{"${alibabacloudstack_cen_bandwidth_package.default.id}"},
+		}),
+		fakeConfig: testAccConfig(map[string]interface{}{
+			"ids": []string{"${alibabacloudstack_cen_bandwidth_package.default.id}_fake"},
+		}),
+	}
+
+	var existCenBandwidthPackagesMapFunc = func(rand int) map[string]string {
+		return map[string]string{
+			"ids.#":                             "1",
+			"names.#":                           "1",
+			"packages.#":                        "1",
+			"packages.0.name":                   fmt.Sprintf("tf-testacc-cenbwps%v", rand),
+			"packages.0.bandwidth":              "5",
+			"packages.0.geographic_region_a_id": "China",
+			"packages.0.geographic_region_b_id": "China",
+		}
+	}
+
+	var fakeCenBandwidthPackagesMapFunc = func(rand int) map[string]string {
+		return map[string]string{
+			"ids.#":      "0",
+			"names.#":    "0",
+			"packages.#": "0",
+		}
+	}
+
+	var cenBandwidthPackagesCheckInfo = dataSourceAttr{
+		resourceId:   resourceId,
+		existMapFunc: existCenBandwidthPackagesMapFunc,
+		fakeMapFunc:  fakeCenBandwidthPackagesMapFunc,
+	}
+
+	cenBandwidthPackagesCheckInfo.dataSourceTestCheck(t, rand, nameRegexConf, idsConf)
+}
+
+func dataSourceCenBandwidthPackagesConfigDependence(name string) string {
+	return fmt.Sprintf(`
+variable "name" {
+  default = "%v"
+}
+
+resource "alibabacloudstack_cen_bandwidth_package" "default" {
+  name                   = var.name
+  bandwidth              = 5
+  geographic_region_a_id = "China"
+  geographic_region_b_id = "China"
+}
+`, name)
+}
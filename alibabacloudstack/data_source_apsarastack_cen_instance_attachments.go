@@ -0,0 +1,129 @@
+package alibabacloudstack
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/aliyun/terraform-provider-alibabacloudstack/alibabacloudstack/connectivity"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+func dataSourceAlibabacloudStackCenInstanceAttachments() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: withDiagnostics(dataSourceAlibabacloudStackCenInstanceAttachmentsRead),
+		Schema: map[string]*schema.Schema{
+			"instance_id": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"child_instance_type": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringInSlice([]string{ChildInstanceTypeVpc, ChildInstanceTypeVbr, ChildInstanceTypeCcn}, false),
+			},
+			"child_instance_region_id": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"output_file": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"ids": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"attachments": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"instance_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"child_instance_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"child_instance_type": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"child_instance_region_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"child_instance_owner_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"child_instance_attach_time": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"status": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceAlibabacloudStackCenInstanceAttachmentsRead(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*connectivity.AlibabacloudStackClient)
+	cenService := CenService{client}
+	cenId := d.Get("instance_id").(string)
+
+	request := make(map[string]interface{})
+	if v, ok := d.GetOk("child_instance_type"); ok {
+		request["ChildInstanceType"] = v
+	}
+	if v, ok := d.GetOk("child_instance_region_id"); ok {
+		request["ChildInstanceRegionId"] = v
+	}
+	objects, err := cenService.DescribeCenAttachedChildInstances(cenId, request)
+	if err != nil {
+		return WrapErrorf(err, DataDefaultErrorMsg, "alibabacloudstack_cen_instance_attachments", "DescribeCenAttachedChildInstances", AlibabacloudStackSdkGoERROR)
+	}
+
+	var ids []string
+	var s []map[string]interface{}
+	for _, v := range objects {
+		object := v.(map[string]interface{})
+		id := cenId + COLON_SEPARATED + fmt.Sprint(object["ChildInstanceId"])
+		mapping := map[string]interface{}{
+			"id":                         id,
+			"instance_id":                cenId,
+			"child_instance_id":          object["ChildInstanceId"],
+			"child_instance_type":        object["ChildInstanceType"],
+			"child_instance_region_id":   object["ChildInstanceRegionId"],
+			"child_instance_owner_id":    fmt.Sprint(object["ChildInstanceOwnerId"]),
+			"child_instance_attach_time": object["ChildInstanceAttachTime"],
+			"status":                     object["Status"],
+		}
+		ids = append(ids, id)
+		s = append(s, mapping)
+	}
+
+	d.SetId(dataResourceIdHash(ids))
+	if err := d.Set("ids", ids); err != nil {
+		return WrapError(err)
+	}
+	if err := d.Set("attachments", s); err != nil {
+		return WrapError(err)
+	}
+	if output, ok := d.GetOk("output_file"); ok && output.(string) != "" {
+		writeToFile(output.(string), s)
+	}
+	return nil
+}
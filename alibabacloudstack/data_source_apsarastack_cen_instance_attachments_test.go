@@ -0,0 +1,64 @@
+package alibabacloudstack
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+)
+
+func TestAccAlibabacloudStackCenInstanceAttachmentsDataSource(t *testing.T) {
+	rand := acctest.RandIntRange(10000, 99999)
+	resourceId := "data.alibabacloudstack_cen_instance_attachments.default"
+	name := fmt.Sprintf("tf-testacc-cenattachments%v", rand)
+
+	testAccConfig := dataSourceTestAccConfigFunc(resourceId, name, dataSourceCenInstanceAttachmentsConfigDependence)
+
+	typeConf := dataSourceTestAccConfig{
+		existConfig: testAccConfig(map[string]interface{}{
+			"instance_id":         "${alibabacloudstack_cen_instance_attachment.default.instance_id}",
+			"child_instance_type": "VPC",
+		}),
+		fakeConfig: testAccConfig(map[string]interface{}{
+			"instance_id":         "${alibabacloudstack_cen_instance_attachment.default.instance_id}",
+			"child_instance_type": "VBR",
+		}),
+	}
+
+	var existCenInstanceAttachmentsMapFunc = func(rand int) map[string]string {
+		return map[string]string{
+			"ids.#":                                  "1",
+			"attachments.#":                          "1",
+			"attachments.0.child_instance_id":        CHECKSET,
+			"attachments.0.child_instance_type":      "VPC",
+			"attachments.0.child_instance_region_id": CHECKSET,
+			"attachments.0.status":                   "Attached",
+		}
+	}
+
+	var fakeCenInstanceAttachmentsMapFunc = func(rand int) map[string]string {
+		return map[string]string{
+			"ids.#":         "0",
+			"attachments.#": "0",
+		}
+	}
+
+	var cenInstanceAttachmentsCheckInfo = dataSourceAttr{
+		resourceId:   resourceId,
+		existMapFunc: existCenInstanceAttachmentsMapFunc,
+		fakeMapFunc:  fakeCenInstanceAttachmentsMapFunc,
+	}
+
+	cenInstanceAttachmentsCheckInfo.dataSourceTestCheck(t, rand, typeConf)
+}
+
+func dataSourceCenInstanceAttachmentsConfigDependence(name string) string {
+	return fmt.Sprintf(`
+%s
+
+resource "alibabacloudstack_cen_instance_attachment" "default" {
+  instance_id       = alibabacloudstack_cen_instance.default.id
+  child_instance_id = alibabacloudstack_vpc.default.id
+}
+`, resourceCenInstanceAttachmentConfigDependence(name))
+}
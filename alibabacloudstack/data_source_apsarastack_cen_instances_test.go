@@ -0,0 +1,73 @@
+package alibabacloudstack
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+)
+
+func TestAccAlibabacloudStackCenInstancesDataSource(t *testing.T) {
+	rand := acctest.RandIntRange(10000, 99999)
+	resourceId := "data.alibabacloudstack_cen_instances.default"
+	name := fmt.Sprintf("tf-testacc-ceninstances%v", rand)
+
+	testAccConfig := dataSourceTestAccConfigFunc(resourceId, name, dataSourceCenInstancesConfigDependence)
+
+	nameRegexConf := dataSourceTestAccConfig{
+		existConfig: testAccConfig(map[string]interface{}{
+			"name_regex": "${alibabacloudstack_cen_instance.default.name}",
+		}),
+		fakeConfig: testAccConfig(map[string]interface{}{
+			"name_regex": "${alibabacloudstack_cen_instance.default.name}_fake",
+		}),
+	}
+	idsConf := dataSourceTestAccConfig{
+		existConfig: testAccConfig(map[string]interface{}{
+			"ids": []string{"${alibabacloudstack_cen_instance.default.id}"},
+		}),
+		fakeConfig: testAccConfig(map[string]interface{}{
+			"ids": []string{"${alibabacloudstack_cen_instance.default.id}_fake"},
+		}),
+	}
+
+	var existCenInstancesMapFunc = func(rand int) map[string]string {
+		return map[string]string{
+			"ids.#":                   "1",
+			"names.#":                 "1",
+			"instances.#":             "1",
+			"instances.0.name":        fmt.Sprintf("tf-testacc-ceninstances%v", rand),
+			"instances.0.description": "tf-testacc-cen-description",
+			"instances.0.status":      "Active",
+		}
+	}
+
+	var fakeCenInstancesMapFunc = func(rand int) map[string]string {
+		return map[string]string{
+			"ids.#":       "0",
+			"names.#":     "0",
+			"instances.#": "0",
+		}
+	}
+
+	var cenInstancesCheckInfo = dataSourceAttr{
+		resourceId:   resourceId,
+		existMapFunc: existCenInstancesMapFunc,
+		fakeMapFunc:  fakeCenInstancesMapFunc,
+	}
+
+	cenInstancesCheckInfo.dataSourceTestCheck(t, rand, nameRegexConf, idsConf)
+}
+
+func dataSourceCenInstancesConfigDependence(name string) string {
+	return fmt.Sprintf(`
+variable "name" {
+  default = "%v"
+}
+
+resource "alibabacloudstack_cen_instance" "default" {
+  name        = var.name
+  description = "tf-testacc-cen-description"
+}
+`, name)
+}
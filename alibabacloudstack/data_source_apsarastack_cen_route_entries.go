@@ -0,0 +1,127 @@
+package alibabacloudstack
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/aliyun/terraform-provider-alibabacloudstack/alibabacloudstack/connectivity"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func dataSourceAlibabacloudStackCenRouteEntries() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: withDiagnostics(dataSourceAlibabacloudStackCenRouteEntriesRead),
+		Schema: map[string]*schema.Schema{
+			"instance_id": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"route_table_id": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"cidr_block": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"output_file": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"ids": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"entries": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"route_table_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"cidr_block": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"next_hop_type": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"next_hop_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"route_type": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"operational_mode": {
+							Type:     schema.TypeBool,
+							Computed: true,
+						},
+						"publish_status": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceAlibabacloudStackCenRouteEntriesRead(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*connectivity.AlibabacloudStackClient)
+	cenService := CenService{client}
+	cenId := d.Get("instance_id").(string)
+	routeTableId := d.Get("route_table_id").(string)
+	cidrBlock := d.Get("cidr_block").(string)
+
+	objects, err := cenService.DescribeCenPublishedRouteEntries(cenId, routeTableId)
+	if err != nil {
+		return WrapErrorf(err, DataDefaultErrorMsg, "alibabacloudstack_cen_route_entries", "DescribePublishedRouteEntries", AlibabacloudStackSdkGoERROR)
+	}
+
+	var ids []string
+	var s []map[string]interface{}
+	for _, v := range objects {
+		object := v.(map[string]interface{})
+		cidr := fmt.Sprint(object["DestinationCidrBlock"])
+		if cidrBlock != "" && cidr != cidrBlock {
+			continue
+		}
+		id := strings.Join([]string{cenId, routeTableId, cidr}, COLON_SEPARATED)
+		mapping := map[string]interface{}{
+			"id":               id,
+			"route_table_id":   routeTableId,
+			"cidr_block":       cidr,
+			"next_hop_type":    object["NextHopType"],
+			"next_hop_id":      object["NextHopId"],
+			"route_type":       object["RouteType"],
+			"operational_mode": object["OperationalMode"],
+			"publish_status":   object["PublishStatus"],
+		}
+		ids = append(ids, id)
+		s = append(s, mapping)
+	}
+
+	d.SetId(dataResourceIdHash(ids))
+	if err := d.Set("ids", ids); err != nil {
+		return WrapError(err)
+	}
+	if err := d.Set("entries", s); err != nil {
+		return WrapError(err)
+	}
+	if output, ok := d.GetOk("output_file"); ok && output.(string) != "" {
+		writeToFile(output.(string), s)
+	}
+	return nil
+}
@@ -0,0 +1,66 @@
+package alibabacloudstack
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+)
+
+func TestAccAlibabacloudStackCenRouteEntriesDataSource(t *testing.T) {
+	rand := acctest.RandIntRange(10000, 99999)
+	resourceId := "data.alibabacloudstack_cen_route_entries.default"
+	name := fmt.Sprintf("tf-testacc-cenrouteentries%v", rand)
+
+	testAccConfig := dataSourceTestAccConfigFunc(resourceId, name, dataSourceCenRouteEntriesConfigDependence)
+
+	cidrBlockConf := dataSourceTestAccConfig{
+		existConfig: testAccConfig(map[string]interface{}{
+			"instance_id":    "${alibabacloudstack_cen_route_entry.default.instance_id}",
+			"route_table_id": "${alibabacloudstack_cen_route_entry.default.route_table_id}",
+			"cidr_block":     "${alibabacloudstack_cen_route_entry.default.cidr_block}",
+		}),
+		fakeConfig: testAccConfig(map[string]interface{}{
+			"instance_id":    "${alibabacloudstack_cen_route_entry.default.instance_id}",
+			"route_table_id": "${alibabacloudstack_cen_route_entry.default.route_table_id}",
+			"cidr_block":     "12.0.0.0/16",
+		}),
+	}
+
+	var existCenRouteEntriesMapFunc = func(rand int) map[string]string {
+		return map[string]string{
+			"ids.#":                    "1",
+			"entries.#":                "1",
+			"entries.0.cidr_block":     "11.0.0.0/16",
+			"entries.0.next_hop_type":  CHECKSET,
+			"entries.0.publish_status": "Published",
+		}
+	}
+
+	var fakeCenRouteEntriesMapFunc = func(rand int) map[string]string {
+		return map[string]string{
+			"ids.#":     "0",
+			"entries.#": "0",
+		}
+	}
+
+	var cenRouteEntriesCheckInfo = dataSourceAttr{
+		resourceId:   resourceId,
+		existMapFunc: existCenRouteEntriesMapFunc,
+		fakeMapFunc:  fakeCenRouteEntriesMapFunc,
+	}
+
+	cenRouteEntriesCheckInfo.dataSourceTestCheck(t, rand, cidrBlockConf)
+}
+
+func dataSourceCenRouteEntriesConfigDependence(name string) string {
+	return fmt.Sprintf(`
+%s
+
+resource "alibabacloudstack_cen_route_entry" "default" {
+  instance_id    = alibabacloudstack_cen_instance_attachment.default.instance_id
+  route_table_id = alibabacloudstack_vpc.default.route_table_id
+  cidr_block     = alibabacloudstack_route_entry.default.destination_cidrblock
+}
+`, resourceCenRouteEntryConfigDependence(name))
+}
@@ -1,6 +1,7 @@
 package alibabacloudstack
 
 import (
+	"context"
 	"regexp"
 
 	"github.com/aliyun/alibaba-cloud-sdk-go/sdk/requests"
@@ -12,7 +13,7 @@ import (
 
 func dataSourceAlibabacloudstackCmsAlarmContactGroups() *schema.Resource {
 	return &schema.Resource{
-		Read: dataSourceAlibabacloudstackCmsAlarmContactGroupsRead,
+		ReadContext: withDiagnostics(dataSourceAlibabacloudstackCmsAlarmContactGroupsRead),
 		Schema: map[string]*schema.Schema{
 			"name_regex": {
 				Type:         schema.TypeString,
@@ -69,7 +70,7 @@ func dataSourceAlibabacloudstackCmsAlarmContactGroups() *schema.Resource {
 	}
 }
 
-func dataSourceAlibabacloudstackCmsAlarmContactGroupsRead(d *schema.ResourceData, meta interface{}) error {
+func dataSourceAlibabacloudstackCmsAlarmContactGroupsRead(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*connectivity.AlibabacloudStackClient)
 
 	request := cms.CreateDescribeContactGroupListRequest()
@@ -1,6 +1,7 @@
 package alibabacloudstack
 
 import (
+	"context"
 	"encoding/json"
 	"github.com/aliyun/alibaba-cloud-sdk-go/sdk/requests"
 	"github.com/aliyun/alibaba-cloud-sdk-go/sdk/responses"
@@ -15,7 +16,7 @@ import (
 
 func dataSourceAlibabacloudstackCmsProjectMeta() *schema.Resource {
 	return &schema.Resource{
-		Read: dataSourceAlibabacloudstackCmsProjectMetaRead,
+		ReadContext: withDiagnostics(dataSourceAlibabacloudstackCmsProjectMetaRead),
 		Schema: map[string]*schema.Schema{
 			"name_regex": {
 				Type:         schema.TypeString,
@@ -49,7 +50,7 @@ func dataSourceAlibabacloudstackCmsProjectMeta() *schema.Resource {
 	}
 }
 
-func dataSourceAlibabacloudstackCmsProjectMetaRead(d *schema.ResourceData, meta interface{}) error {
+func dataSourceAlibabacloudstackCmsProjectMetaRead(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*connectivity.AlibabacloudStackClient)
 
 	request := requests.NewCommonRequest()
@@ -1,6 +1,7 @@
 package alibabacloudstack
 
 import (
+	"context"
 	"regexp"
 	"strings"
 
@@ -13,7 +14,7 @@ import (
 
 func dataSourceAlibabacloudStackCommonBandwidthPackages() *schema.Resource {
 	return &schema.Resource{
-		Read: dataSourceAlibabacloudStackCommonBandwidthPackagesRead,
+		ReadContext: withDiagnostics(dataSourceAlibabacloudStackCommonBandwidthPackagesRead),
 
 		Schema: map[string]*schema.Schema{
 			"name_regex": {
@@ -105,7 +106,7 @@ func dataSourceAlibabacloudStackCommonBandwidthPackages() *schema.Resource {
 		},
 	}
 }
-func dataSourceAlibabacloudStackCommonBandwidthPackagesRead(d *schema.ResourceData, meta interface{}) error {
+func dataSourceAlibabacloudStackCommonBandwidthPackagesRead(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*connectivity.AlibabacloudStackClient)
 
 	request := vpc.CreateDescribeCommonBandwidthPackagesRequest()
@@ -1,6 +1,7 @@
 package alibabacloudstack
 
 import (
+	"context"
 	"regexp"
 	"sort"
 
@@ -12,7 +13,7 @@ import (
 
 func dataSourceAlibabacloudStackCrEENamespaces() *schema.Resource {
 	return &schema.Resource{
-		Read: dataSourceAlibabacloudStackCrEENamespacesRead,
+		ReadContext: withDiagnostics(dataSourceAlibabacloudStackCrEENamespacesRead),
 		Schema: map[string]*schema.Schema{
 			"instance_id": {
 				Type:     schema.TypeString,
@@ -73,7 +74,7 @@ func dataSourceAlibabacloudStackCrEENamespaces() *schema.Resource {
 	}
 }
 
-func dataSourceAlibabacloudStackCrEENamespacesRead(d *schema.ResourceData, meta interface{}) error {
+func dataSourceAlibabacloudStackCrEENamespacesRead(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*connectivity.AlibabacloudStackClient)
 	crService := &CrService{client}
 	pageNo := 1
@@ -1,6 +1,7 @@
 package alibabacloudstack
 
 import (
+	"context"
 	"regexp"
 
 	"github.com/aliyun/alibaba-cloud-sdk-go/services/cr_ee"
@@ -11,7 +12,7 @@ import (
 
 func dataSourceAlibabacloudStackCrEERepos() *schema.Resource {
 	return &schema.Resource{
-		Read: dataSourceAlibabacloudStackCrEEReposRead,
+		ReadContext: withDiagnostics(dataSourceAlibabacloudStackCrEEReposRead),
 		Schema: map[string]*schema.Schema{
 			"instance_id": {
 				Type:     schema.TypeString,
@@ -126,7 +127,7 @@ func dataSourceAlibabacloudStackCrEERepos() *schema.Resource {
 	}
 }
 
-func dataSourceAlibabacloudStackCrEEReposRead(d *schema.ResourceData, meta interface{}) error {
+func dataSourceAlibabacloudStackCrEEReposRead(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*connectivity.AlibabacloudStackClient)
 	crService := &CrService{client}
 	pageNo := 1
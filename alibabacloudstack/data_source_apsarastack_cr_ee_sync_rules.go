@@ -1,6 +1,7 @@
 package alibabacloudstack
 
 import (
+	"context"
 	"regexp"
 	"sort"
 
@@ -13,7 +14,7 @@ import (
 
 func dataSourceAlibabacloudStackCrEESyncRules() *schema.Resource {
 	return &schema.Resource{
-		Read: dataSourceAlibabacloudStackCrEESyncRulesRead,
+		ReadContext: withDiagnostics(dataSourceAlibabacloudStackCrEESyncRulesRead),
 		Schema: map[string]*schema.Schema{
 			"instance_id": {
 				Type:     schema.TypeString,
@@ -126,7 +127,7 @@ func dataSourceAlibabacloudStackCrEESyncRules() *schema.Resource {
 	}
 }
 
-func dataSourceAlibabacloudStackCrEESyncRulesRead(d *schema.ResourceData, meta interface{}) error {
+func dataSourceAlibabacloudStackCrEESyncRulesRead(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*connectivity.AlibabacloudStackClient)
 	crService := &CrService{client}
 	instanceId := d.Get("instance_id").(string)
@@ -1,6 +1,7 @@
 package alibabacloudstack
 
 import (
+	"context"
 	"encoding/json"
 	"github.com/aliyun/alibaba-cloud-sdk-go/sdk/requests"
 	"github.com/aliyun/alibaba-cloud-sdk-go/sdk/responses"
@@ -16,7 +17,7 @@ import (
 
 func dataSourceAlibabacloudStackCRNamespaces() *schema.Resource {
 	return &schema.Resource{
-		Read: dataSourceAlibabacloudStackCRNamespacesRead,
+		ReadContext: withDiagnostics(dataSourceAlibabacloudStackCRNamespacesRead),
 
 		Schema: map[string]*schema.Schema{
 			"name_regex": {
@@ -62,7 +63,7 @@ func dataSourceAlibabacloudStackCRNamespaces() *schema.Resource {
 		},
 	}
 }
-func dataSourceAlibabacloudStackCRNamespacesRead(d *schema.ResourceData, meta interface{}) error {
+func dataSourceAlibabacloudStackCRNamespacesRead(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*connectivity.AlibabacloudStackClient)
 	crService := CrService{client}
 	//invoker := NewInvoker()
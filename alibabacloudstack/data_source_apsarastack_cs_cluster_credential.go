@@ -0,0 +1,129 @@
+package alibabacloudstack
+
+import (
+	"context"
+	"encoding/base64"
+
+	"github.com/alibabacloud-go/tea/tea"
+	"github.com/aliyun/terraform-provider-alibabacloudstack/alibabacloudstack/connectivity"
+	"github.com/go-yaml/yaml"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+func dataSourceAlibabacloudStackCSClusterCredential() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: withDiagnostics(dataSourceAlibabacloudStackCSClusterCredentialRead),
+		Schema: map[string]*schema.Schema{
+			"cluster_id": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"temporary_duration_minutes": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				ValidateFunc: validation.IntBetween(15, 4320),
+			},
+			"private_ip_address": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+			// Computed values
+			"cluster_name": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"kube_config": {
+				Type:      schema.TypeString,
+				Computed:  true,
+				Sensitive: true,
+			},
+			"endpoint": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"expiration": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"certificate_authority": {
+				Type:      schema.TypeList,
+				Computed:  true,
+				Sensitive: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"cluster_cert": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"client_cert": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"client_key": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceAlibabacloudStackCSClusterCredentialRead(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*connectivity.AlibabacloudStackClient)
+	csService := CsService{client}
+	clusterId := d.Get("cluster_id").(string)
+
+	cluster, err := csService.DescribeCsKubernetes(clusterId)
+	if err != nil {
+		return WrapError(err)
+	}
+	object, err := csService.DescribeCsClusterUserKubeconfig(clusterId, d.Get("private_ip_address").(bool), d.Get("temporary_duration_minutes").(int))
+	if err != nil {
+		return WrapErrorf(err, DataDefaultErrorMsg, "alibabacloudstack_cs_cluster_credential", "DescribeClusterUserKubeconfig", AlibabacloudStackSdkGoERROR)
+	}
+	kubeConfig := tea.StringValue(object.Config)
+
+	var config Config
+	if err := yaml.Unmarshal([]byte(kubeConfig), &config); err != nil {
+		return WrapError(err)
+	}
+	// The certificates are base64 encoded in the kubeconfig, and they are returned in the PEM format.
+	certificateAuthority := map[string]interface{}{}
+	endpoint := ""
+	if len(config.Clusters) > 0 {
+		endpoint = config.Clusters[0].Cluster.Server
+		if certificateAuthority["cluster_cert"], err = decodeKubeConfigData(config.Clusters[0].Cluster.CertificateAuthorityData); err != nil {
+			return WrapError(err)
+		}
+	}
+	if len(config.Users) > 0 {
+		if certificateAuthority["client_cert"], err = decodeKubeConfigData(config.Users[0].User.ClientCertificateData); err != nil {
+			return WrapError(err)
+		}
+		if certificateAuthority["client_key"], err = decodeKubeConfigData(config.Users[0].User.ClientKeyData); err != nil {
+			return WrapError(err)
+		}
+	}
+
+	d.SetId(clusterId)
+	d.Set("cluster_name", cluster.Name)
+	d.Set("kube_config", kubeConfig)
+	d.Set("endpoint", endpoint)
+	d.Set("expiration", tea.StringValue(object.Expiration))
+	if err := d.Set("certificate_authority", []interface{}{certificateAuthority}); err != nil {
+		return WrapError(err)
+	}
+	return nil
+}
+
+func decodeKubeConfigData(data string) (string, error) {
+	decoded, err := base64.StdEncoding.DecodeString(data)
+	if err != nil {
+		return "", err
+	}
+	return string(decoded), nil
+}
@@ -0,0 +1,48 @@
+package alibabacloudstack
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/aliyun/terraform-provider-alibabacloudstack/alibabacloudstack/connectivity"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccAlibabacloudStackCSClusterCredentialDataSource(t *testing.T) {
+	rand := acctest.RandIntRange(1000000, 9999999)
+	resourceId := "data.alibabacloudstack_cs_cluster_credential.default"
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheckWithRegions(t, true, connectivity.KubernetesSupportedRegions)
+		},
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckAlibabacloudStackCSClusterCredentialDataSource(rand),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet(resourceId, "id"),
+					resource.TestCheckResourceAttrSet(resourceId, "cluster_name"),
+					resource.TestCheckResourceAttrSet(resourceId, "kube_config"),
+					resource.TestCheckResourceAttrSet(resourceId, "endpoint"),
+					resource.TestCheckResourceAttrSet(resourceId, "expiration"),
+					resource.TestCheckResourceAttr(resourceId, "certificate_authority.#", "1"),
+					resource.TestCheckResourceAttrSet(resourceId, "certificate_authority.0.cluster_cert"),
+					resource.TestCheckResourceAttrSet(resourceId, "certificate_authority.0.client_cert"),
+					resource.TestCheckResourceAttrSet(resourceId, "certificate_authority.0.client_key"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckAlibabacloudStackCSClusterCredentialDataSource(rand int) string {
+	return fmt.Sprintf(`
+%s
+
+data "alibabacloudstack_cs_cluster_credential" "default" {
+  cluster_id                 = alibabacloudstack_cs_kubernetes.default.id
+  temporary_duration_minutes = 60
+}
+`, dataSourceCSKubernetesClustersConfigDependence(fmt.Sprintf("tf-testacckubernetes-%d", rand)))
+}
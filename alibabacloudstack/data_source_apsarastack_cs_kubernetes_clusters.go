@@ -1,6 +1,7 @@
 package alibabacloudstack
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
 	"github.com/aliyun/alibaba-cloud-sdk-go/sdk/requests"
@@ -20,7 +21,7 @@ import (
 
 func dataSourceAlibabacloudStackCSKubernetesClusters() *schema.Resource {
 	return &schema.Resource{
-		Read: dataSourceAlibabacloudStackCSKubernetesClustersRead,
+		ReadContext: withDiagnostics(dataSourceAlibabacloudStackCSKubernetesClustersRead),
 
 		Schema: map[string]*schema.Schema{
 			"ids": {
@@ -301,7 +302,7 @@ func dataSourceAlibabacloudStackCSKubernetesClusters() *schema.Resource {
 	}
 }
 
-func dataSourceAlibabacloudStackCSKubernetesClustersRead(d *schema.ResourceData, meta interface{}) error {
+func dataSourceAlibabacloudStackCSKubernetesClustersRead(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*connectivity.AlibabacloudStackClient)
 
 	request := requests.NewCommonRequest()
@@ -0,0 +1,92 @@
+package alibabacloudstack
+
+import (
+	"context"
+	"sort"
+
+	roacs "github.com/alibabacloud-go/cs-20151215/v2/client"
+	"github.com/alibabacloud-go/tea/tea"
+	"github.com/aliyun/terraform-provider-alibabacloudstack/alibabacloudstack/connectivity"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func dataSourceAlibabacloudStackCSKubernetesUpgradableVersions() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: withDiagnostics(dataSourceAlibabacloudStackCSKubernetesUpgradableVersionsRead),
+		Schema: map[string]*schema.Schema{
+			"cluster_id": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"output_file": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			// Computed values
+			"current_version": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"versions": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+		},
+	}
+}
+
+func dataSourceAlibabacloudStackCSKubernetesUpgradableVersionsRead(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*connectivity.AlibabacloudStackClient)
+	csService := CsService{client}
+	clusterId := d.Get("cluster_id").(string)
+
+	cluster, err := csService.DescribeCsKubernetes(clusterId)
+	if err != nil {
+		return WrapError(err)
+	}
+	currentVersion := cluster.CurrentVersion
+	if currentVersion == "" {
+		currentVersion = cluster.InitVersion
+	}
+
+	roaCsClient, err := client.NewRoaCsClient()
+	if err != nil {
+		return WrapErrorf(err, DataDefaultErrorMsg, "alibabacloudstack_cs_kubernetes_upgradable_versions", "InitializeClient", err)
+	}
+	request := &roacs.DescribeKubernetesVersionMetadataRequest{
+		Region:      tea.String(client.RegionId),
+		ClusterType: tea.String(string(cluster.ClusterType)),
+	}
+	if cluster.Profile != "" {
+		request.Profile = tea.String(cluster.Profile)
+	}
+	response, err := roaCsClient.DescribeKubernetesVersionMetadata(request)
+	if err != nil {
+		return WrapErrorf(err, DataDefaultErrorMsg, "alibabacloudstack_cs_kubernetes_upgradable_versions", "DescribeKubernetesVersionMetadata", AlibabacloudStackSdkGoERROR)
+	}
+	addDebug("DescribeKubernetesVersionMetadata", response, request)
+
+	versions := make([]string, 0)
+	for _, metadata := range response.Body {
+		if metadata == nil {
+			continue
+		}
+		if version := tea.StringValue(metadata.Version); compareCsKubernetesVersion(version, currentVersion) > 0 {
+			versions = append(versions, version)
+		}
+	}
+	sort.Slice(versions, func(i, j int) bool {
+		return compareCsKubernetesVersion(versions[i], versions[j]) < 0
+	})
+
+	d.SetId(clusterId)
+	d.Set("current_version", currentVersion)
+	if err := d.Set("versions", versions); err != nil {
+		return WrapError(err)
+	}
+	if output, ok := d.GetOk("output_file"); ok && output.(string) != "" {
+		writeToFile(output.(string), versions)
+	}
+	return nil
+}
@@ -0,0 +1,41 @@
+package alibabacloudstack
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/aliyun/terraform-provider-alibabacloudstack/alibabacloudstack/connectivity"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccAlibabacloudStackCSKubernetesUpgradableVersionsDataSource(t *testing.T) {
+	rand := acctest.RandIntRange(1000000, 9999999)
+	resourceId := "data.alibabacloudstack_cs_kubernetes_upgradable_versions.default"
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheckWithRegions(t, true, connectivity.KubernetesSupportedRegions)
+		},
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckAlibabacloudStackCSKubernetesUpgradableVersionsDataSource(rand),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet(resourceId, "id"),
+					resource.TestCheckResourceAttrPair(resourceId, "current_version", "alibabacloudstack_cs_kubernetes.default", "version"),
+					resource.TestCheckResourceAttrSet(resourceId, "versions.#"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckAlibabacloudStackCSKubernetesUpgradableVersionsDataSource(rand int) string {
+	return fmt.Sprintf(`
+%s
+
+data "alibabacloudstack_cs_kubernetes_upgradable_versions" "default" {
+  cluster_id = alibabacloudstack_cs_kubernetes.default.id
+}
+`, dataSourceCSKubernetesClustersConfigDependence(fmt.Sprintf("tf-testacckubernetes-%d", rand)))
+}
@@ -0,0 +1,75 @@
+package alibabacloudstack
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+)
+
+func TestAccAlibabacloudStackDataWorksFoldersDataSource(t *testing.T) {
+	rand := acctest.RandIntRange(10000, 99999)
+	name := fmt.Sprintf("tf-testacc%sdataworksfolders%d", defaultRegionToTest, rand)
+	resourceId := "data.alibabacloudstack_data_works_folders.default"
+	testAccConfig := dataSourceTestAccConfigFunc(resourceId, name, dataSourceDataWorksFoldersConfigDependence)
+
+	idsConf := dataSourceTestAccConfig{
+		existConfig: testAccConfig(map[string]interface{}{
+			"project_id":         "${alibabacloudstack_data_works_folder.default.project_id}",
+			"parent_folder_path": "业务流程/test/folderUserDefined",
+			"ids":                []string{"${alibabacloudstack_data_works_folder.default.id}"},
+		}),
+		fakeConfig: testAccConfig(map[string]interface{}{
+			"project_id":         "${alibabacloudstack_data_works_folder.default.project_id}",
+			"parent_folder_path": "业务流程/test/folderUserDefined",
+			"ids":                []string{"${alibabacloudstack_data_works_folder.default.id}_fake"},
+		}),
+	}
+	nameRegexConf := dataSourceTestAccConfig{
+		existConfig: testAccConfig(map[string]interface{}{
+			"project_id":         "${alibabacloudstack_data_works_folder.default.project_id}",
+			"parent_folder_path": "业务流程/test/folderUserDefined",
+			"name_regex":         name,
+		}),
+		fakeConfig: testAccConfig(map[string]interface{}{
+			"project_id":         "${alibabacloudstack_data_works_folder.default.project_id}",
+			"parent_folder_path": "业务流程/test/folderUserDefined",
+			"name_regex":         name + "_fake",
+		}),
+	}
+
+	var existDataWorksFoldersMapFunc = func(rand int) map[string]string {
+		return map[string]string{
+			"ids.#":                 "1",
+			"folders.#":             "1",
+			"folders.0.id":          CHECKSET,
+			"folders.0.folder_id":   CHECKSET,
+			"folders.0.folder_path": "业务流程/test/folderUserDefined/" + name,
+			"folders.0.project_id":  "10023",
+		}
+	}
+
+	var fakeDataWorksFoldersMapFunc = func(rand int) map[string]string {
+		return map[string]string{
+			"ids.#":     "0",
+			"folders.#": "0",
+		}
+	}
+
+	var dataWorksFoldersCheckInfo = dataSourceAttr{
+		resourceId:   resourceId,
+		existMapFunc: existDataWorksFoldersMapFunc,
+		fakeMapFunc:  fakeDataWorksFoldersMapFunc,
+	}
+
+	dataWorksFoldersCheckInfo.dataSourceTestCheck(t, rand, idsConf, nameRegexConf)
+}
+
+func dataSourceDataWorksFoldersConfigDependence(name string) string {
+	return AlibabacloudStackDataWorksFolderBasicDependence0(name) + `
+resource "alibabacloudstack_data_works_folder" "default" {
+  project_id  = "10023"
+  folder_path = "业务流程/test/folderUserDefined/${var.name}"
+}
+`
+}
@@ -0,0 +1,70 @@
+package alibabacloudstack
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+)
+
+func TestAccAlibabacloudStackDataWorksProjectsDataSource(t *testing.T) {
+	rand := acctest.RandIntRange(10000, 99999)
+	name := fmt.Sprintf("tf_testacc%d", rand)
+	resourceId := "data.alibabacloudstack_data_works_projects.default"
+	testAccConfig := dataSourceTestAccConfigFunc(resourceId, name, dataSourceDataWorksProjectsConfigDependence)
+
+	idsConf := dataSourceTestAccConfig{
+		existConfig: testAccConfig(map[string]interface{}{
+			"ids": []string{"${alibabacloudstack_data_works_project.default.project_id}"},
+		}),
+		fakeConfig: testAccConfig(map[string]interface{}{
+			"ids": []string{"${alibabacloudstack_data_works_project.default.project_id}_fake"},
+		}),
+	}
+	nameRegexConf := dataSourceTestAccConfig{
+		existConfig: testAccConfig(map[string]interface{}{
+			"name_regex": "${alibabacloudstack_data_works_project.default.project_name}",
+		}),
+		fakeConfig: testAccConfig(map[string]interface{}{
+			"name_regex": "${alibabacloudstack_data_works_project.default.project_name}_fake",
+		}),
+	}
+
+	var existDataWorksProjectsMapFunc = func(rand int) map[string]string {
+		return map[string]string{
+			"ids.#":                         "1",
+			"names.#":                       "1",
+			"projects.#":                    "1",
+			"projects.0.id":                 CHECKSET,
+			"projects.0.project_id":         CHECKSET,
+			"projects.0.project_name":       name,
+			"projects.0.project_identifier": name,
+			"projects.0.status":             CHECKSET,
+		}
+	}
+
+	var fakeDataWorksProjectsMapFunc = func(rand int) map[string]string {
+		return map[string]string{
+			"ids.#":      "0",
+			"names.#":    "0",
+			"projects.#": "0",
+		}
+	}
+
+	var dataWorksProjectsCheckInfo = dataSourceAttr{
+		resourceId:   resourceId,
+		existMapFunc: existDataWorksProjectsMapFunc,
+		fakeMapFunc:  fakeDataWorksProjectsMapFunc,
+	}
+
+	dataWorksProjectsCheckInfo.dataSourceTestCheck(t, rand, idsConf, nameRegexConf)
+}
+
+func dataSourceDataWorksProjectsConfigDependence(name string) string {
+	return AlibabacloudStackDataWorksProjectBasicDependence0(name) + `
+resource "alibabacloudstack_data_works_project" "default" {
+  project_name   = var.name
+  task_auth_type = "PROJECT"
+}
+`
+}
@@ -0,0 +1,77 @@
+package alibabacloudstack
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/aliyun/terraform-provider-alibabacloudstack/alibabacloudstack/connectivity"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+)
+
+func TestAccAlibabacloudStackDatahubProjectsDataSource(t *testing.T) {
+	rand := acctest.RandIntRange(10000, 99999)
+	name := fmt.Sprintf("tf_testacc_datahub_projects%d", rand)
+	resourceId := "data.alibabacloudstack_datahub_projects.default"
+	testAccConfig := dataSourceTestAccConfigFunc(resourceId, name, dataSourceDatahubProjectsConfigDependence)
+
+	idsConf := dataSourceTestAccConfig{
+		existConfig: testAccConfig(map[string]interface{}{
+			"ids": []string{"${alibabacloudstack_datahub_project.default.id}"},
+		}),
+		fakeConfig: testAccConfig(map[string]interface{}{
+			"ids": []string{"${alibabacloudstack_datahub_project.default.id}_fake"},
+		}),
+	}
+	nameRegexConf := dataSourceTestAccConfig{
+		existConfig: testAccConfig(map[string]interface{}{
+			"name_regex": "${alibabacloudstack_datahub_project.default.name}",
+		}),
+		fakeConfig: testAccConfig(map[string]interface{}{
+			"name_regex": "${alibabacloudstack_datahub_project.default.name}_fake",
+		}),
+	}
+
+	var existDatahubProjectsMapFunc = func(rand int) map[string]string {
+		return map[string]string{
+			"ids.#":                  "1",
+			"names.#":                "1",
+			"projects.#":             "1",
+			"projects.0.id":          name,
+			"projects.0.name":        name,
+			"projects.0.comment":     "project for basic.",
+			"projects.0.create_time": CHECKSET,
+		}
+	}
+
+	var fakeDatahubProjectsMapFunc = func(rand int) map[string]string {
+		return map[string]string{
+			"ids.#":      "0",
+			"names.#":    "0",
+			"projects.#": "0",
+		}
+	}
+
+	var datahubProjectsCheckInfo = dataSourceAttr{
+		resourceId:   resourceId,
+		existMapFunc: existDatahubProjectsMapFunc,
+		fakeMapFunc:  fakeDatahubProjectsMapFunc,
+	}
+
+	preCheck := func() {
+		testAccPreCheckWithRegions(t, true, connectivity.DatahubSupportedRegions)
+	}
+	datahubProjectsCheckInfo.dataSourceTestCheckWithPreCheck(t, rand, preCheck, idsConf, nameRegexConf)
+}
+
+func dataSourceDatahubProjectsConfigDependence(name string) string {
+	return fmt.Sprintf(`
+variable "name" {
+  default = "%s"
+}
+
+resource "alibabacloudstack_datahub_project" "default" {
+  name    = var.name
+  comment = "project for basic."
+}
+`, name)
+}
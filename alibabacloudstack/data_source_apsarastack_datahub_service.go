@@ -1,6 +1,7 @@
 package alibabacloudstack
 
 import (
+	"context"
 	"github.com/aliyun/alibaba-cloud-sdk-go/sdk/requests"
 	"github.com/aliyun/alibaba-cloud-sdk-go/services/ecs"
 
@@ -17,7 +18,7 @@ import (
 
 func dataSourceAlibabacloudStackDatahubService() *schema.Resource {
 	return &schema.Resource{
-		Read: dataSourceAlibabacloudStackDatahubServiceRead,
+		ReadContext: withDiagnostics(dataSourceAlibabacloudStackDatahubServiceRead),
 
 		Schema: map[string]*schema.Schema{
 			"enable": {
@@ -33,7 +34,7 @@ func dataSourceAlibabacloudStackDatahubService() *schema.Resource {
 		},
 	}
 }
-func dataSourceAlibabacloudStackDatahubServiceRead(d *schema.ResourceData, meta interface{}) error {
+func dataSourceAlibabacloudStackDatahubServiceRead(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*connectivity.AlibabacloudStackClient)
 	if v, ok := d.GetOk("enable"); !ok || v.(string) != "On" {
 		d.SetId("DatahubServiceHasNotBeenOpened")
@@ -65,7 +66,7 @@ func dataSourceAlibabacloudStackDatahubServiceRead(d *schema.ResourceData, meta
 		"ResourceGroup":   client.ResourceGroup,
 	}
 
-	err := resource.Retry(5*time.Minute, func() *resource.RetryError {
+	err := resource.RetryContext(ctx, 5*time.Minute, func() *resource.RetryError {
 		response, err := client.WithEcsClient(func(dataHubClient *ecs.Client) (interface{}, error) {
 			return dataHubClient.ProcessCommonRequest(request)
 		})
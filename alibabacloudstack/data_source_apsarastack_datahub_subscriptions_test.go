@@ -0,0 +1,100 @@
+package alibabacloudstack
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/aliyun/terraform-provider-alibabacloudstack/alibabacloudstack/connectivity"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+)
+
+func TestAccAlibabacloudStackDatahubSubscriptionsDataSource(t *testing.T) {
+	rand := acctest.RandIntRange(10000, 99999)
+	name := fmt.Sprintf("tf_testacc_datahub_subscriptions%d", rand)
+	resourceId := "data.alibabacloudstack_datahub_subscriptions.default"
+	testAccConfig := dataSourceTestAccConfigFunc(resourceId, name, dataSourceDatahubSubscriptionsConfigDependence)
+
+	idsConf := dataSourceTestAccConfig{
+		existConfig: testAccConfig(map[string]interface{}{
+			"project_name": "${alibabacloudstack_datahub_subscription.default.project_name}",
+			"topic_name":   "${alibabacloudstack_datahub_subscription.default.topic_name}",
+			"ids":          []string{"${alibabacloudstack_datahub_subscription.default.id}"},
+		}),
+		fakeConfig: testAccConfig(map[string]interface{}{
+			"project_name": "${alibabacloudstack_datahub_subscription.default.project_name}",
+			"topic_name":   "${alibabacloudstack_datahub_subscription.default.topic_name}",
+			"ids":          []string{"${alibabacloudstack_datahub_subscription.default.id}_fake"},
+		}),
+	}
+	nameRegexConf := dataSourceTestAccConfig{
+		existConfig: testAccConfig(map[string]interface{}{
+			"project_name": "${alibabacloudstack_datahub_subscription.default.project_name}",
+			"topic_name":   "${alibabacloudstack_datahub_subscription.default.topic_name}",
+			"name_regex":   "${alibabacloudstack_datahub_subscription.default.comment}",
+		}),
+		fakeConfig: testAccConfig(map[string]interface{}{
+			"project_name": "${alibabacloudstack_datahub_subscription.default.project_name}",
+			"topic_name":   "${alibabacloudstack_datahub_subscription.default.topic_name}",
+			"name_regex":   "${alibabacloudstack_datahub_subscription.default.comment}_fake",
+		}),
+	}
+
+	var existDatahubSubscriptionsMapFunc = func(rand int) map[string]string {
+		return map[string]string{
+			"ids.#":                        "1",
+			"subscriptions.#":              "1",
+			"subscriptions.0.id":           CHECKSET,
+			"subscriptions.0.sub_id":       CHECKSET,
+			"subscriptions.0.project_name": name,
+			"subscriptions.0.topic_name":   name,
+			"subscriptions.0.comment":      name,
+			"subscriptions.0.create_time":  CHECKSET,
+		}
+	}
+
+	var fakeDatahubSubscriptionsMapFunc = func(rand int) map[string]string {
+		return map[string]string{
+			"ids.#":           "0",
+			"subscriptions.#": "0",
+		}
+	}
+
+	var datahubSubscriptionsCheckInfo = dataSourceAttr{
+		resourceId:   resourceId,
+		existMapFunc: existDatahubSubscriptionsMapFunc,
+		fakeMapFunc:  fakeDatahubSubscriptionsMapFunc,
+	}
+
+	preCheck := func() {
+		testAccPreCheckWithRegions(t, true, connectivity.DatahubSupportedRegions)
+	}
+	datahubSubscriptionsCheckInfo.dataSourceTestCheckWithPreCheck(t, rand, preCheck, idsConf, nameRegexConf)
+}
+
+func dataSourceDatahubSubscriptionsConfigDependence(name string) string {
+	return fmt.Sprintf(`
+variable "name" {
+  default = "%s"
+}
+
+resource "alibabacloudstack_datahub_project" "default" {
+  name    = var.name
+  comment = "project for basic."
+}
+
+resource "alibabacloudstack_datahub_topic" "default" {
+  project_name = alibabacloudstack_datahub_project.default.name
+  name         = var.name
+  record_type  = "BLOB"
+  shard_count  = 3
+  life_cycle   = 7
+  comment      = "topic for basic."
+}
+
+resource "alibabacloudstack_datahub_subscription" "default" {
+  project_name = alibabacloudstack_datahub_project.default.name
+  topic_name   = alibabacloudstack_datahub_topic.default.name
+  comment      = var.name
+}
+`, name)
+}
@@ -0,0 +1,94 @@
+package alibabacloudstack
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/aliyun/terraform-provider-alibabacloudstack/alibabacloudstack/connectivity"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+)
+
+func TestAccAlibabacloudStackDatahubTopicsDataSource(t *testing.T) {
+	rand := acctest.RandIntRange(10000, 99999)
+	name := fmt.Sprintf("tf_testacc_datahub_topics%d", rand)
+	resourceId := "data.alibabacloudstack_datahub_topics.default"
+	testAccConfig := dataSourceTestAccConfigFunc(resourceId, name, dataSourceDatahubTopicsConfigDependence)
+
+	idsConf := dataSourceTestAccConfig{
+		existConfig: testAccConfig(map[string]interface{}{
+			"project_name": "${alibabacloudstack_datahub_topic.default.project_name}",
+			"ids":          []string{"${alibabacloudstack_datahub_topic.default.id}"},
+		}),
+		fakeConfig: testAccConfig(map[string]interface{}{
+			"project_name": "${alibabacloudstack_datahub_topic.default.project_name}",
+			"ids":          []string{"${alibabacloudstack_datahub_topic.default.id}_fake"},
+		}),
+	}
+	nameRegexConf := dataSourceTestAccConfig{
+		existConfig: testAccConfig(map[string]interface{}{
+			"project_name": "${alibabacloudstack_datahub_topic.default.project_name}",
+			"name_regex":   "${alibabacloudstack_datahub_topic.default.name}",
+		}),
+		fakeConfig: testAccConfig(map[string]interface{}{
+			"project_name": "${alibabacloudstack_datahub_topic.default.project_name}",
+			"name_regex":   "${alibabacloudstack_datahub_topic.default.name}_fake",
+		}),
+	}
+
+	var existDatahubTopicsMapFunc = func(rand int) map[string]string {
+		return map[string]string{
+			"ids.#":                 "1",
+			"names.#":               "1",
+			"topics.#":              "1",
+			"topics.0.id":           fmt.Sprintf("%s:%s", name, name),
+			"topics.0.name":         name,
+			"topics.0.project_name": name,
+			"topics.0.shard_count":  "3",
+			"topics.0.life_cycle":   "7",
+			"topics.0.record_type":  "BLOB",
+			"topics.0.comment":      "topic for basic.",
+			"topics.0.create_time":  CHECKSET,
+		}
+	}
+
+	var fakeDatahubTopicsMapFunc = func(rand int) map[string]string {
+		return map[string]string{
+			"ids.#":    "0",
+			"names.#":  "0",
+			"topics.#": "0",
+		}
+	}
+
+	var datahubTopicsCheckInfo = dataSourceAttr{
+		resourceId:   resourceId,
+		existMapFunc: existDatahubTopicsMapFunc,
+		fakeMapFunc:  fakeDatahubTopicsMapFunc,
+	}
+
+	preCheck := func() {
+		testAccPreCheckWithRegions(t, true, connectivity.DatahubSupportedRegions)
+	}
+	datahubTopicsCheckInfo.dataSourceTestCheckWithPreCheck(t, rand, preCheck, idsConf, nameRegexConf)
+}
+
+func dataSourceDatahubTopicsConfigDependence(name string) string {
+	return fmt.Sprintf(`
+variable "name" {
+  default = "%s"
+}
+
+resource "alibabacloudstack_datahub_project" "default" {
+  name    = var.name
+  comment = "project for basic."
+}
+
+resource "alibabacloudstack_datahub_topic" "default" {
+  project_name = alibabacloudstack_datahub_project.default.name
+  name         = var.name
+  record_type  = "BLOB"
+  shard_count  = 3
+  life_cycle   = 7
+  comment      = "topic for basic."
+}
+`, name)
+}
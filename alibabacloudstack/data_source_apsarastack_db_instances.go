@@ -1,6 +1,7 @@
 package alibabacloudstack
 
 import (
+	"context"
 	"encoding/json"
 	"regexp"
 	"strings"
@@ -14,7 +15,7 @@ import (
 
 func dataSourceAlibabacloudStackDBInstances() *schema.Resource {
 	return &schema.Resource{
-		Read: dataSourceAlibabacloudStackDBInstancesRead,
+		ReadContext: withDiagnostics(dataSourceAlibabacloudStackDBInstancesRead),
 
 		Schema: map[string]*schema.Schema{
 			"name_regex": {
@@ -187,7 +188,7 @@ func dataSourceAlibabacloudStackDBInstances() *schema.Resource {
 	}
 }
 
-func dataSourceAlibabacloudStackDBInstancesRead(d *schema.ResourceData, meta interface{}) error {
+func dataSourceAlibabacloudStackDBInstancesRead(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*connectivity.AlibabacloudStackClient)
 
 	request := rds.CreateDescribeDBInstancesRequest()
@@ -1,6 +1,7 @@
 package alibabacloudstack
 
 import (
+	"context"
 	"fmt"
 	"sort"
 	"strings"
@@ -15,7 +16,7 @@ import (
 
 func dataSourceAlibabacloudStackDBZones() *schema.Resource {
 	return &schema.Resource{
-		Read: dataSourceAlibabacloudStackDBZonesRead,
+		ReadContext: withDiagnostics(dataSourceAlibabacloudStackDBZonesRead),
 
 		Schema: map[string]*schema.Schema{
 			"multi": {
@@ -60,7 +61,7 @@ func dataSourceAlibabacloudStackDBZones() *schema.Resource {
 	}
 }
 
-func dataSourceAlibabacloudStackDBZonesRead(d *schema.ResourceData, meta interface{}) error {
+func dataSourceAlibabacloudStackDBZonesRead(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*connectivity.AlibabacloudStackClient)
 
 	multi := d.Get("multi").(bool)
@@ -75,7 +76,7 @@ func dataSourceAlibabacloudStackDBZonesRead(d *schema.ResourceData, meta interfa
 	request.Headers = map[string]string{"RegionId": client.RegionId}
 	request.QueryParams = map[string]string{"AccessKeySecret": client.SecretKey, "Product": "rds", "Department": client.Department, "ResourceGroup": client.ResourceGroup}
 	var response = &rds.DescribeRegionsResponse{}
-	err := resource.Retry(5*time.Minute, func() *resource.RetryError {
+	err := resource.RetryContext(ctx, 5*time.Minute, func() *resource.RetryError {
 		raw, err := client.WithRdsClient(func(rdsClient *rds.Client) (i interface{}, err error) {
 			return rdsClient.DescribeRegions(request)
 		})
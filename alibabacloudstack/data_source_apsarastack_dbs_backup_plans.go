@@ -0,0 +1,190 @@
+package alibabacloudstack
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+
+	"github.com/aliyun/terraform-provider-alibabacloudstack/alibabacloudstack/connectivity"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+func dataSourceAlibabacloudStackDbsBackupPlans() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: withDiagnostics(dataSourceAlibabacloudStackDbsBackupPlansRead),
+		Schema: map[string]*schema.Schema{
+			"ids": {
+				Type:     schema.TypeList,
+				Optional: true,
+				ForceNew: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+				Computed: true,
+			},
+			"name_regex": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringIsValidRegExp,
+			},
+			"backup_plan_name": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+			},
+			"status": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringInSlice([]string{"init", "running", "stop", "locked", "paused", "wait", "check_pass", "check_fail"}, false),
+			},
+			"names": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"output_file": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"plans": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"backup_plan_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"backup_plan_name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"backup_method": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"instance_class": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"backup_period": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"backup_start_time": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"backup_retention_period": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"backup_storage_type": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"source_endpoint_instance_type": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"source_endpoint_instance_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"source_endpoint_region": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"status": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceAlibabacloudStackDbsBackupPlansRead(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*connectivity.AlibabacloudStackClient)
+	dbsService := DbsService{client}
+	request := make(map[string]interface{})
+	if v, ok := d.GetOk("backup_plan_name"); ok {
+		request["BackupPlanName"] = v
+	}
+	if v, ok := d.GetOk("status"); ok {
+		request["BackupPlanStatus"] = v
+	}
+
+	idsMap := make(map[string]string)
+	if v, ok := d.GetOk("ids"); ok {
+		for _, vv := range v.([]interface{}) {
+			if vv == nil {
+				continue
+			}
+			idsMap[vv.(string)] = vv.(string)
+		}
+	}
+	var nameRegex *regexp.Regexp
+	if v, ok := d.GetOk("name_regex"); ok && v.(string) != "" {
+		nameRegex = regexp.MustCompile(v.(string))
+	}
+
+	plans, err := dbsService.DescribeDbsBackupPlans(ctx, request)
+	if err != nil {
+		return WrapError(err)
+	}
+
+	ids := make([]string, 0)
+	names := make([]interface{}, 0)
+	s := make([]map[string]interface{}, 0)
+	for _, object := range plans {
+		if len(idsMap) > 0 {
+			if _, ok := idsMap[fmt.Sprint(object["BackupPlanId"])]; !ok {
+				continue
+			}
+		}
+		if nameRegex != nil && !nameRegex.MatchString(fmt.Sprint(object["BackupPlanName"])) {
+			continue
+		}
+		mapping := map[string]interface{}{
+			"id":                            fmt.Sprint(object["BackupPlanId"]),
+			"backup_plan_id":                fmt.Sprint(object["BackupPlanId"]),
+			"backup_plan_name":              object["BackupPlanName"],
+			"backup_method":                 object["BackupMethod"],
+			"instance_class":                object["InstanceClass"],
+			"backup_period":                 object["BackupPeriod"],
+			"backup_start_time":             object["BackupStartTime"],
+			"backup_retention_period":       formatInt(object["BackupRetentionPeriod"]),
+			"backup_storage_type":           object["BackupStorageType"],
+			"source_endpoint_instance_type": object["SourceEndpointInstanceType"],
+			"source_endpoint_instance_id":   object["SourceEndpointInstanceID"],
+			"source_endpoint_region":        object["SourceEndpointRegion"],
+			"status":                        object["BackupPlanStatus"],
+		}
+		ids = append(ids, fmt.Sprint(object["BackupPlanId"]))
+		names = append(names, object["BackupPlanName"])
+		s = append(s, mapping)
+	}
+
+	d.SetId(dataResourceIdHash(ids))
+	if err := d.Set("ids", ids); err != nil {
+		return WrapError(err)
+	}
+	if err := d.Set("names", names); err != nil {
+		return WrapError(err)
+	}
+	if err := d.Set("plans", s); err != nil {
+		return WrapError(err)
+	}
+	if output, ok := d.GetOk("output_file"); ok && output.(string) != "" {
+		writeToFile(output.(string), s)
+	}
+	return nil
+}
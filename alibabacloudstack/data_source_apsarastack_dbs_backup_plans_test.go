@@ -0,0 +1,73 @@
+package alibabacloudstack
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+)
+
+func TestAccAlibabacloudStackDbsBackupPlansDataSource(t *testing.T) {
+	rand := acctest.RandIntRange(10000, 99999)
+	name := fmt.Sprintf("tf-testacc%sdbsbackupplans%d", defaultRegionToTest, rand)
+	resourceId := "data.alibabacloudstack_dbs_backup_plans.default"
+	testAccConfig := dataSourceTestAccConfigFunc(resourceId, name, dataSourceDbsBackupPlansConfigDependence)
+
+	idsConf := dataSourceTestAccConfig{
+		existConfig: testAccConfig(map[string]interface{}{
+			"ids": []string{"${alibabacloudstack_dbs_backup_plan.default.id}"},
+		}),
+		fakeConfig: testAccConfig(map[string]interface{}{
+			"ids": []string{"${alibabacloudstack_dbs_backup_plan.default.id}_fake"},
+		}),
+	}
+	nameRegexConf := dataSourceTestAccConfig{
+		existConfig: testAccConfig(map[string]interface{}{
+			"name_regex": "${alibabacloudstack_dbs_backup_plan.default.backup_plan_name}",
+		}),
+		fakeConfig: testAccConfig(map[string]interface{}{
+			"name_regex": "${alibabacloudstack_dbs_backup_plan.default.backup_plan_name}_fake",
+		}),
+	}
+
+	var existDbsBackupPlansMapFunc = func(rand int) map[string]string {
+		return map[string]string{
+			"ids.#":                    "1",
+			"names.#":                  "1",
+			"plans.#":                  "1",
+			"plans.0.id":               CHECKSET,
+			"plans.0.backup_plan_id":   CHECKSET,
+			"plans.0.backup_plan_name": name,
+			"plans.0.backup_method":    "logical",
+			"plans.0.instance_class":   "large",
+			"plans.0.status":           CHECKSET,
+		}
+	}
+
+	var fakeDbsBackupPlansMapFunc = func(rand int) map[string]string {
+		return map[string]string{
+			"ids.#":   "0",
+			"names.#": "0",
+			"plans.#": "0",
+		}
+	}
+
+	var dbsBackupPlansCheckInfo = dataSourceAttr{
+		resourceId:   resourceId,
+		existMapFunc: existDbsBackupPlansMapFunc,
+		fakeMapFunc:  fakeDbsBackupPlansMapFunc,
+	}
+
+	dbsBackupPlansCheckInfo.dataSourceTestCheck(t, rand, idsConf, nameRegexConf)
+}
+
+func dataSourceDbsBackupPlansConfigDependence(name string) string {
+	return AlibabacloudStackDbsBackupPlanBasicDependence0(name) + `
+resource "alibabacloudstack_dbs_backup_plan" "default" {
+  backup_method    = "logical"
+  database_type    = "MySQL"
+  instance_class   = "large"
+  backup_plan_name = var.name
+}
+`
+}
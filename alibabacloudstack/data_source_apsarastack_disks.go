@@ -1,6 +1,7 @@
 package alibabacloudstack
 
 import (
+	"context"
 	"log"
 	"regexp"
 	"strings"
@@ -14,7 +15,7 @@ import (
 
 func dataSourceAlibabacloudStackDisks() *schema.Resource {
 	return &schema.Resource{
-		Read: dataSourceAlibabacloudStackDisksRead,
+		ReadContext: withDiagnostics(dataSourceAlibabacloudStackDisksRead),
 
 		Schema: map[string]*schema.Schema{
 			"ids": {
@@ -141,7 +142,7 @@ func dataSourceAlibabacloudStackDisks() *schema.Resource {
 	}
 }
 
-func dataSourceAlibabacloudStackDisksRead(d *schema.ResourceData, meta interface{}) error {
+func dataSourceAlibabacloudStackDisksRead(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*connectivity.AlibabacloudStackClient)
 
 	request := ecs.CreateDescribeDisksRequest()
@@ -1,6 +1,7 @@
 package alibabacloudstack
 
 import (
+	"context"
 	"fmt"
 	"regexp"
 
@@ -13,7 +14,7 @@ import (
 
 func dataSourceAlibabacloudStackDmsEnterpriseInstances() *schema.Resource {
 	return &schema.Resource{
-		Read: dataSourceAlibabacloudStackDmsEnterpriseInstancesRead,
+		ReadContext: withDiagnostics(dataSourceAlibabacloudStackDmsEnterpriseInstancesRead),
 		Schema: map[string]*schema.Schema{
 			"name_regex": {
 				Type:          schema.TypeString,
@@ -187,7 +188,7 @@ func dataSourceAlibabacloudStackDmsEnterpriseInstances() *schema.Resource {
 	}
 }
 
-func dataSourceAlibabacloudStackDmsEnterpriseInstancesRead(d *schema.ResourceData, meta interface{}) error {
+func dataSourceAlibabacloudStackDmsEnterpriseInstancesRead(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*connectivity.AlibabacloudStackClient)
 
 	action := "ListInstances"
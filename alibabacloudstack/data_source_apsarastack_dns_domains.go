@@ -1,6 +1,7 @@
 package alibabacloudstack
 
 import (
+	"context"
 	"encoding/json"
 	"github.com/aliyun/alibaba-cloud-sdk-go/sdk/requests"
 	"github.com/aliyun/alibaba-cloud-sdk-go/sdk/responses"
@@ -14,7 +15,7 @@ import (
 
 func dataSourceAlibabacloudStackDnsDomains() *schema.Resource {
 	return &schema.Resource{
-		Read: dataSourceAlibabacloudStackDnsDomainsRead,
+		ReadContext: withDiagnostics(dataSourceAlibabacloudStackDnsDomainsRead),
 
 		Schema: map[string]*schema.Schema{
 			"domain_name_regex": {
@@ -114,7 +115,7 @@ func dataSourceAlibabacloudStackDnsDomains() *schema.Resource {
 		},
 	}
 }
-func dataSourceAlibabacloudStackDnsDomainsRead(d *schema.ResourceData, meta interface{}) error {
+func dataSourceAlibabacloudStackDnsDomainsRead(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*connectivity.AlibabacloudStackClient)
 	request := requests.NewCommonRequest()
 	request.Method = "POST"
@@ -1,6 +1,7 @@
 package alibabacloudstack
 
 import (
+	"context"
 	"regexp"
 
 	"github.com/aliyun/alibaba-cloud-sdk-go/sdk/requests"
@@ -11,7 +12,7 @@ import (
 
 func dataSourceAlibabacloudStackDnsGroups() *schema.Resource {
 	return &schema.Resource{
-		Read: dataSourceAlibabacloudStackDnsGroupsRead,
+		ReadContext: withDiagnostics(dataSourceAlibabacloudStackDnsGroupsRead),
 
 		Schema: map[string]*schema.Schema{
 			"name_regex": {
@@ -56,7 +57,7 @@ func dataSourceAlibabacloudStackDnsGroups() *schema.Resource {
 	}
 }
 
-func dataSourceAlibabacloudStackDnsGroupsRead(d *schema.ResourceData, meta interface{}) error {
+func dataSourceAlibabacloudStackDnsGroupsRead(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*connectivity.AlibabacloudStackClient)
 
 	request := alidns.CreateDescribeDomainGroupsRequest()
@@ -1,6 +1,7 @@
 package alibabacloudstack
 
 import (
+	"context"
 	"encoding/json"
 	"github.com/aliyun/alibaba-cloud-sdk-go/sdk/requests"
 	"github.com/aliyun/alibaba-cloud-sdk-go/sdk/responses"
@@ -16,7 +17,7 @@ import (
 
 func dataSourceAlibabacloudStackDnsRecords() *schema.Resource {
 	return &schema.Resource{
-		Read: dataSourceAlibabacloudStackDnsRecordsRead,
+		ReadContext: withDiagnostics(dataSourceAlibabacloudStackDnsRecordsRead),
 
 		Schema: map[string]*schema.Schema{
 			"domain_id": {
@@ -88,7 +89,7 @@ func dataSourceAlibabacloudStackDnsRecords() *schema.Resource {
 	}
 }
 
-func dataSourceAlibabacloudStackDnsRecordsRead(d *schema.ResourceData, meta interface{}) error {
+func dataSourceAlibabacloudStackDnsRecordsRead(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*connectivity.AlibabacloudStackClient)
 	DomainId := d.Get("domain_id").(string)
 	request := requests.NewCommonRequest()
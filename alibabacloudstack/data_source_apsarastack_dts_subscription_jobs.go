@@ -0,0 +1,177 @@
+package alibabacloudstack
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+
+	"github.com/aliyun/terraform-provider-alibabacloudstack/alibabacloudstack/connectivity"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+func dataSourceAlibabacloudStackDtsSubscriptionJobs() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: withDiagnostics(dataSourceAlibabacloudStackDtsSubscriptionJobsRead),
+		Schema: map[string]*schema.Schema{
+			"ids": {
+				Type:     schema.TypeList,
+				Optional: true,
+				ForceNew: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+				Computed: true,
+			},
+			"name_regex": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringIsValidRegExp,
+			},
+			"status": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+			},
+			"names": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"output_file": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"jobs": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"dts_job_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"dts_job_name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"dts_instance_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"checkpoint": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"source_endpoint_engine_name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"source_endpoint_instance_type": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"source_endpoint_instance_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"source_endpoint_region": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"payment_type": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"create_time": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"status": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceAlibabacloudStackDtsSubscriptionJobsRead(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*connectivity.AlibabacloudStackClient)
+	dtsService := DtsService{client}
+
+	idsMap := make(map[string]string)
+	if v, ok := d.GetOk("ids"); ok {
+		for _, vv := range v.([]interface{}) {
+			if vv == nil {
+				continue
+			}
+			idsMap[vv.(string)] = vv.(string)
+		}
+	}
+	var nameRegex *regexp.Regexp
+	if v, ok := d.GetOk("name_regex"); ok && v.(string) != "" {
+		nameRegex = regexp.MustCompile(v.(string))
+	}
+	status, statusOk := d.GetOk("status")
+
+	jobs, err := dtsService.DescribeDtsJobs(ctx, "SUBSCRIBE")
+	if err != nil {
+		return WrapErrorf(err, DataDefaultErrorMsg, "alibabacloudstack_dts_subscription_jobs", "DescribeDtsJobs", AlibabacloudStackSdkGoERROR)
+	}
+
+	ids := make([]string, 0)
+	names := make([]interface{}, 0)
+	s := make([]map[string]interface{}, 0)
+	for _, object := range jobs {
+		if len(idsMap) > 0 {
+			if _, ok := idsMap[fmt.Sprint(object["DtsJobId"])]; !ok {
+				continue
+			}
+		}
+		if nameRegex != nil && !nameRegex.MatchString(fmt.Sprint(object["DtsJobName"])) {
+			continue
+		}
+		if statusOk && status.(string) != "" && status.(string) != fmt.Sprint(object["Status"]) {
+			continue
+		}
+		sourceEndpoint, _ := object["SourceEndpoint"].(map[string]interface{})
+		mapping := map[string]interface{}{
+			"id":                            fmt.Sprint(object["DtsJobId"]),
+			"dts_job_id":                    fmt.Sprint(object["DtsJobId"]),
+			"dts_job_name":                  object["DtsJobName"],
+			"dts_instance_id":               object["DtsInstanceID"],
+			"checkpoint":                    fmt.Sprint(formatInt(object["Checkpoint"])),
+			"source_endpoint_engine_name":   sourceEndpoint["EngineName"],
+			"source_endpoint_instance_type": sourceEndpoint["InstanceType"],
+			"source_endpoint_instance_id":   sourceEndpoint["InstanceID"],
+			"source_endpoint_region":        sourceEndpoint["Region"],
+			"payment_type":                  convertDtsPaymentTypeResponse(object["PayType"]),
+			"create_time":                   object["CreateTime"],
+			"status":                        object["Status"],
+		}
+		ids = append(ids, fmt.Sprint(object["DtsJobId"]))
+		names = append(names, object["DtsJobName"])
+		s = append(s, mapping)
+	}
+
+	d.SetId(dataResourceIdHash(ids))
+	if err := d.Set("ids", ids); err != nil {
+		return WrapError(err)
+	}
+	if err := d.Set("names", names); err != nil {
+		return WrapError(err)
+	}
+	if err := d.Set("jobs", s); err != nil {
+		return WrapError(err)
+	}
+	if output, ok := d.GetOk("output_file"); ok && output.(string) != "" {
+		writeToFile(output.(string), s)
+	}
+	return nil
+}
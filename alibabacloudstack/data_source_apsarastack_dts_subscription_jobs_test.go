@@ -0,0 +1,84 @@
+package alibabacloudstack
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+)
+
+func TestAccAlibabacloudStackDtsSubscriptionJobsDataSource(t *testing.T) {
+	rand := acctest.RandIntRange(10000, 99999)
+	name := fmt.Sprintf("tf-testacc%sdtssubscriptionjobs%d", defaultRegionToTest, rand)
+	resourceId := "data.alibabacloudstack_dts_subscription_jobs.default"
+	testAccConfig := dataSourceTestAccConfigFunc(resourceId, name, dataSourceDtsSubscriptionJobsConfigDependence)
+
+	idsConf := dataSourceTestAccConfig{
+		existConfig: testAccConfig(map[string]interface{}{
+			"ids": []string{"${alibabacloudstack_dts_subscription_job.default.id}"},
+		}),
+		fakeConfig: testAccConfig(map[string]interface{}{
+			"ids": []string{"${alibabacloudstack_dts_subscription_job.default.id}_fake"},
+		}),
+	}
+	nameRegexConf := dataSourceTestAccConfig{
+		existConfig: testAccConfig(map[string]interface{}{
+			"name_regex": "${alibabacloudstack_dts_subscription_job.default.dts_job_name}",
+		}),
+		fakeConfig: testAccConfig(map[string]interface{}{
+			"name_regex": "${alibabacloudstack_dts_subscription_job.default.dts_job_name}_fake",
+		}),
+	}
+
+	var existDtsSubscriptionJobsMapFunc = func(rand int) map[string]string {
+		return map[string]string{
+			"ids.#":                                "1",
+			"names.#":                              "1",
+			"jobs.#":                               "1",
+			"jobs.0.id":                            CHECKSET,
+			"jobs.0.dts_job_id":                    CHECKSET,
+			"jobs.0.dts_job_name":                  name,
+			"jobs.0.dts_instance_id":               CHECKSET,
+			"jobs.0.payment_type":                  "PayAsYouGo",
+			"jobs.0.source_endpoint_engine_name":   "MySQL",
+			"jobs.0.source_endpoint_instance_type": "RDS",
+			"jobs.0.status":                        CHECKSET,
+		}
+	}
+
+	var fakeDtsSubscriptionJobsMapFunc = func(rand int) map[string]string {
+		return map[string]string{
+			"ids.#":   "0",
+			"names.#": "0",
+			"jobs.#":  "0",
+		}
+	}
+
+	var dtsSubscriptionJobsCheckInfo = dataSourceAttr{
+		resourceId:   resourceId,
+		existMapFunc: existDtsSubscriptionJobsMapFunc,
+		fakeMapFunc:  fakeDtsSubscriptionJobsMapFunc,
+	}
+
+	dtsSubscriptionJobsCheckInfo.dataSourceTestCheck(t, rand, idsConf, nameRegexConf)
+}
+
+func dataSourceDtsSubscriptionJobsConfigDependence(name string) string {
+	return AlibabacloudStackDTSSubscriptionJobBasicDependence0(name) + fmt.Sprintf(`
+resource "alibabacloudstack_dts_subscription_job" "default" {
+  dts_job_name                       = "%s"
+  payment_type                       = "PayAsYouGo"
+  source_endpoint_engine_name        = "MySQL"
+  source_endpoint_region             = "cn-hangzhou"
+  source_endpoint_instance_type      = "RDS"
+  source_endpoint_instance_id        = alibabacloudstack_db_instance.instance.id
+  source_endpoint_database_name      = "tfaccountpri_0"
+  source_endpoint_user_name          = "tftestprivilege"
+  source_endpoint_password           = "inputYourCodeHere"
+  db_list                            = "{\"tfaccountpri_0\":{\"name\":\"tfaccountpri_0\",\"all\":true,\"state\":\"normal\"}}"
+  subscription_instance_network_type = "vpc"
+  subscription_instance_vpc_id       = alibabacloudstack_vpc.default1.id
+  subscription_instance_vswitch_id   = alibabacloudstack_vswitch.default1.id
+}
+`, name)
+}
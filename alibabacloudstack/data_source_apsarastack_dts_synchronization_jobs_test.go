@@ -0,0 +1,102 @@
+package alibabacloudstack
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+)
+
+func TestAccAlibabacloudStackDtsSynchronizationJobsDataSource(t *testing.T) {
+	rand := acctest.RandIntRange(10000, 99999)
+	name := fmt.Sprintf("tf-testacc%sdtssynchronizationjobs%d", defaultRegionToTest, rand)
+	resourceId := "data.alibabacloudstack_dts_synchronization_jobs.default"
+	testAccConfig := dataSourceTestAccConfigFunc(resourceId, name, dataSourceDtsSynchronizationJobsConfigDependence)
+
+	idsConf := dataSourceTestAccConfig{
+		existConfig: testAccConfig(map[string]interface{}{
+			"ids": []string{"${alibabacloudstack_dts_synchronization_job.default.id}"},
+		}),
+		fakeConfig: testAccConfig(map[string]interface{}{
+			"ids": []string{"${alibabacloudstack_dts_synchronization_job.default.id}_fake"},
+		}),
+	}
+	nameRegexConf := dataSourceTestAccConfig{
+		existConfig: testAccConfig(map[string]interface{}{
+			"name_regex": "${alibabacloudstack_dts_synchronization_job.default.dts_job_name}",
+		}),
+		fakeConfig: testAccConfig(map[string]interface{}{
+			"name_regex": "${alibabacloudstack_dts_synchronization_job.default.dts_job_name}_fake",
+		}),
+	}
+	allConf := dataSourceTestAccConfig{
+		existConfig: testAccConfig(map[string]interface{}{
+			"ids":        []string{"${alibabacloudstack_dts_synchronization_job.default.id}"},
+			"name_regex": "${alibabacloudstack_dts_synchronization_job.default.dts_job_name}",
+			"status":     "${alibabacloudstack_dts_synchronization_job.default.status}",
+		}),
+		fakeConfig: testAccConfig(map[string]interface{}{
+			"ids":        []string{"${alibabacloudstack_dts_synchronization_job.default.id}"},
+			"name_regex": "${alibabacloudstack_dts_synchronization_job.default.dts_job_name}",
+			"status":     "Failed",
+		}),
+	}
+
+	var existDtsSynchronizationJobsMapFunc = func(rand int) map[string]string {
+		return map[string]string{
+			"ids.#":                                "1",
+			"names.#":                              "1",
+			"jobs.#":                               "1",
+			"jobs.0.id":                            CHECKSET,
+			"jobs.0.dts_job_id":                    CHECKSET,
+			"jobs.0.dts_job_name":                  name,
+			"jobs.0.dts_instance_id":               CHECKSET,
+			"jobs.0.source_endpoint_engine_name":   "MySQL",
+			"jobs.0.source_endpoint_instance_type": "RDS",
+			"jobs.0.destination_endpoint_engine_name":   "MySQL",
+			"jobs.0.destination_endpoint_instance_type": "RDS",
+			"jobs.0.status": CHECKSET,
+		}
+	}
+
+	var fakeDtsSynchronizationJobsMapFunc = func(rand int) map[string]string {
+		return map[string]string{
+			"ids.#":   "0",
+			"names.#": "0",
+			"jobs.#":  "0",
+		}
+	}
+
+	var dtsSynchronizationJobsCheckInfo = dataSourceAttr{
+		resourceId:   resourceId,
+		existMapFunc: existDtsSynchronizationJobsMapFunc,
+		fakeMapFunc:  fakeDtsSynchronizationJobsMapFunc,
+	}
+
+	dtsSynchronizationJobsCheckInfo.dataSourceTestCheck(t, rand, idsConf, nameRegexConf, allConf)
+}
+
+func dataSourceDtsSynchronizationJobsConfigDependence(name string) string {
+	return AlibabacloudStackDTSSynchronizationJobBasicDependence0(name) + `
+resource "alibabacloudstack_dts_synchronization_job" "default" {
+  dts_instance_id                    = alibabacloudstack_dts_synchronization_instance.default.id
+  dts_job_name                       = var.name
+  source_endpoint_instance_type      = "RDS"
+  source_endpoint_instance_id        = alibabacloudstack_db_instance.rsinstance.id
+  source_endpoint_engine_name        = "MySQL"
+  source_endpoint_database_name      = "tfaccountpri_0"
+  source_endpoint_user_name          = "tftestdts"
+  source_endpoint_password           = "inputYourCodeHere"
+  destination_endpoint_instance_type = "RDS"
+  destination_endpoint_instance_id   = alibabacloudstack_db_instance.dsinstance.id
+  destination_endpoint_engine_name   = "MySQL"
+  destination_endpoint_database_name = "tfaccountpri_0"
+  destination_endpoint_user_name     = "tftestdts"
+  destination_endpoint_password      = "inputYourCodeHere"
+  db_list                            = "{\"tfaccountpri_0\":{\"name\":\"tfaccountpri_0\",\"all\":true,\"state\":\"normal\"}}"
+  structure_initialization           = "true"
+  data_initialization                = "true"
+  data_synchronization               = "true"
+}
+`
+}
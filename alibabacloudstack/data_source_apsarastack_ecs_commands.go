@@ -1,6 +1,7 @@
 package alibabacloudstack
 
 import (
+	"context"
 	"fmt"
 	"regexp"
 
@@ -13,7 +14,7 @@ import (
 
 func dataSourceAlibabacloudStackEcsCommands() *schema.Resource {
 	return &schema.Resource{
-		Read: dataSourceAlibabacloudStackEcsCommandsRead,
+		ReadContext: withDiagnostics(dataSourceAlibabacloudStackEcsCommandsRead),
 		Schema: map[string]*schema.Schema{
 			"name_regex": {
 				Type:         schema.TypeString,
@@ -116,7 +117,7 @@ func dataSourceAlibabacloudStackEcsCommands() *schema.Resource {
 	}
 }
 
-func dataSourceAlibabacloudStackEcsCommandsRead(d *schema.ResourceData, meta interface{}) error {
+func dataSourceAlibabacloudStackEcsCommandsRead(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*connectivity.AlibabacloudStackClient)
 
 	action := "DescribeCommands"
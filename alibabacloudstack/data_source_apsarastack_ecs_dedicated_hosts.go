@@ -1,6 +1,7 @@
 package alibabacloudstack
 
 import (
+	"context"
 	"fmt"
 	"regexp"
 	"time"
@@ -15,7 +16,7 @@ import (
 
 func dataSourceAlibabacloudStackEcsDedicatedHosts() *schema.Resource {
 	return &schema.Resource{
-		Read: dataSourceAlibabacloudStackEcsDedicatedHostsRead,
+		ReadContext: withDiagnostics(dataSourceAlibabacloudStackEcsDedicatedHostsRead),
 		Schema: map[string]*schema.Schema{
 			"name_regex": {
 				Type:         schema.TypeString,
@@ -268,7 +269,7 @@ func dataSourceAlibabacloudStackEcsDedicatedHosts() *schema.Resource {
 	}
 }
 
-func dataSourceAlibabacloudStackEcsDedicatedHostsRead(d *schema.ResourceData, meta interface{}) error {
+func dataSourceAlibabacloudStackEcsDedicatedHostsRead(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*connectivity.AlibabacloudStackClient)
 
 	action := "DescribeDedicatedHosts"
@@ -343,7 +344,7 @@ func dataSourceAlibabacloudStackEcsDedicatedHostsRead(d *schema.ResourceData, me
 		runtime := util.RuntimeOptions{}
 		runtime.SetAutoretry(true)
 		wait := incrementalWait(3*time.Second, 3*time.Second)
-		err = resource.Retry(5*time.Minute, func() *resource.RetryError {
+		err = resource.RetryContext(ctx, 5*time.Minute, func() *resource.RetryError {
 			response, err = conn.DoRequest(StringPointer(action), nil, StringPointer("POST"), StringPointer("2014-05-26"), StringPointer("AK"), nil, request, &runtime)
 			if err != nil {
 				if NeedRetry(err) {
@@ -1,6 +1,7 @@
 package alibabacloudstack
 
 import (
+	"context"
 	"fmt"
 	"regexp"
 	"time"
@@ -15,7 +16,7 @@ import (
 
 func dataSourceAlibabacloudStackEcsDeploymentSets() *schema.Resource {
 	return &schema.Resource{
-		Read: dataSourceAlibabacloudStackEcsDeploymentSetsRead,
+		ReadContext: withDiagnostics(dataSourceAlibabacloudStackEcsDeploymentSetsRead),
 		Schema: map[string]*schema.Schema{
 			"ids": {
 				Type:     schema.TypeList,
@@ -103,7 +104,7 @@ func dataSourceAlibabacloudStackEcsDeploymentSets() *schema.Resource {
 	}
 }
 
-func dataSourceAlibabacloudStackEcsDeploymentSetsRead(d *schema.ResourceData, meta interface{}) error {
+func dataSourceAlibabacloudStackEcsDeploymentSetsRead(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*connectivity.AlibabacloudStackClient)
 
 	action := "DescribeDeploymentSets"
@@ -150,7 +151,7 @@ func dataSourceAlibabacloudStackEcsDeploymentSetsRead(d *schema.ResourceData, me
 		runtime := util.RuntimeOptions{}
 		runtime.SetAutoretry(true)
 		wait := incrementalWait(3*time.Second, 3*time.Second)
-		err = resource.Retry(5*time.Minute, func() *resource.RetryError {
+		err = resource.RetryContext(ctx, 5*time.Minute, func() *resource.RetryError {
 			response, err = conn.DoRequest(StringPointer(action), nil, StringPointer("POST"), StringPointer("2014-05-26"), StringPointer("AK"), nil, request, &runtime)
 			if err != nil {
 				if NeedRetry(err) {
@@ -1,6 +1,7 @@
 package alibabacloudstack
 
 import (
+	"context"
 	"regexp"
 
 	"github.com/aliyun/alibaba-cloud-sdk-go/services/edas"
@@ -11,7 +12,7 @@ import (
 
 func dataSourceAlibabacloudStackEdasApplications() *schema.Resource {
 	return &schema.Resource{
-		Read: dataSourceAlibabacloudStackEdasApplicationsRead,
+		ReadContext: withDiagnostics(dataSourceAlibabacloudStackEdasApplicationsRead),
 
 		Schema: map[string]*schema.Schema{
 			"output_file": {
@@ -77,7 +78,7 @@ func dataSourceAlibabacloudStackEdasApplications() *schema.Resource {
 	}
 }
 
-func dataSourceAlibabacloudStackEdasApplicationsRead(d *schema.ResourceData, meta interface{}) error {
+func dataSourceAlibabacloudStackEdasApplicationsRead(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*connectivity.AlibabacloudStackClient)
 	edasService := EdasService{client}
 
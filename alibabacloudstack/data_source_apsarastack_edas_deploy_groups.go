@@ -1,6 +1,7 @@
 package alibabacloudstack
 
 import (
+	"context"
 	"regexp"
 
 	"github.com/aliyun/alibaba-cloud-sdk-go/services/edas"
@@ -11,7 +12,7 @@ import (
 
 func dataSourceAlibabacloudStackEdasDeployGroups() *schema.Resource {
 	return &schema.Resource{
-		Read: dataSourceAlibabacloudStackEdasDeployGroupsRead,
+		ReadContext: withDiagnostics(dataSourceAlibabacloudStackEdasDeployGroupsRead),
 
 		Schema: map[string]*schema.Schema{
 			"app_id": {
@@ -86,7 +87,7 @@ func dataSourceAlibabacloudStackEdasDeployGroups() *schema.Resource {
 	}
 }
 
-func dataSourceAlibabacloudStackEdasDeployGroupsRead(d *schema.ResourceData, meta interface{}) error {
+func dataSourceAlibabacloudStackEdasDeployGroupsRead(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*connectivity.AlibabacloudStackClient)
 	edasService := EdasService{client}
 
@@ -0,0 +1,183 @@
+package alibabacloudstack
+
+import (
+	"context"
+	"regexp"
+
+	"github.com/aliyun/alibaba-cloud-sdk-go/services/edas"
+	"github.com/aliyun/terraform-provider-alibabacloudstack/alibabacloudstack/connectivity"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+func dataSourceAlibabacloudStackEdasK8sApplications() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: withDiagnostics(dataSourceAlibabacloudStackEdasK8sApplicationsRead),
+		Schema: map[string]*schema.Schema{
+			"ids": {
+				Type:     schema.TypeList,
+				Optional: true,
+				ForceNew: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+				Computed: true,
+			},
+			"name_regex": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringIsValidRegExp,
+			},
+			"cluster_id": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+			},
+			"names": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"output_file": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"applications": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"application_name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"application_descriotion": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"cluster_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"package_type": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"namespace": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"replicas": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"running_instance_count": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"image_url": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceAlibabacloudStackEdasK8sApplicationsRead(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*connectivity.AlibabacloudStackClient)
+	edasService := EdasService{client}
+
+	request := edas.CreateListApplicationRequest()
+	request.RegionId = client.RegionId
+	request.Headers["x-ascm-product-name"] = "Edas"
+	request.Headers["x-acs-organizationid"] = client.Department
+	request.Headers["x-acs-content-type"] = "application/x-www-form-urlencoded"
+
+	idsMap := make(map[string]string)
+	if v, ok := d.GetOk("ids"); ok {
+		for _, id := range v.([]interface{}) {
+			if id == nil {
+				continue
+			}
+			idsMap[Trim(id.(string))] = Trim(id.(string))
+		}
+	}
+	var nameRegex *regexp.Regexp
+	if v, ok := d.GetOk("name_regex"); ok && v.(string) != "" {
+		nameRegex = regexp.MustCompile(v.(string))
+	}
+	clusterId := d.Get("cluster_id").(string)
+
+	raw, err := edasService.client.WithEdasClient(func(edasClient *edas.Client) (interface{}, error) {
+		return edasClient.ListApplication(request)
+	})
+	if err != nil {
+		return WrapErrorf(err, DefaultErrorMsg, "alibabacloudstack_edas_k8s_applications", request.GetActionName(), AlibabacloudStackSdkGoERROR)
+	}
+	addDebug(request.GetActionName(), raw, request.RoaRequest, request)
+
+	response, _ := raw.(*edas.ListApplicationResponse)
+	if response.Code != 200 {
+		return WrapError(Error(response.Message))
+	}
+
+	var ids []string
+	var names []string
+	var s []map[string]interface{}
+	for _, app := range response.ApplicationList.Application {
+		// Only the applications deployed in the k8s clusters, whose cluster type is 3 or 5, are returned.
+		if app.ClusterType != 3 && app.ClusterType != 5 {
+			continue
+		}
+		if clusterId != "" && app.ClusterId != clusterId {
+			continue
+		}
+		if nameRegex != nil && !nameRegex.MatchString(app.Name) {
+			continue
+		}
+		if len(idsMap) > 0 {
+			if _, ok := idsMap[app.AppId]; !ok {
+				continue
+			}
+		}
+		object, err := edasService.DescribeEdasK8sApplication(app.AppId)
+		if err != nil {
+			return WrapError(err)
+		}
+		mapping := map[string]interface{}{
+			"id":                      app.AppId,
+			"application_name":        app.Name,
+			"application_descriotion": object.App.Description,
+			"cluster_id":              app.ClusterId,
+			"package_type":            app.ApplicationType,
+			"namespace":               object.NameSpace,
+			"replicas":                object.App.Instances,
+			"running_instance_count":  app.RunningInstanceCount,
+			"image_url":               object.ImageInfo.ImageUrl,
+		}
+		ids = append(ids, app.AppId)
+		names = append(names, app.Name)
+		s = append(s, mapping)
+	}
+
+	d.SetId(dataResourceIdHash(ids))
+	if err := d.Set("ids", ids); err != nil {
+		return WrapError(err)
+	}
+	if err := d.Set("names", names); err != nil {
+		return WrapError(err)
+	}
+	if err := d.Set("applications", s); err != nil {
+		return WrapError(err)
+	}
+	if output, ok := d.GetOk("output_file"); ok && output.(string) != "" {
+		writeToFile(output.(string), s)
+	}
+	return nil
+}
@@ -0,0 +1,92 @@
+package alibabacloudstack
+
+import (
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/aliyun/terraform-provider-alibabacloudstack/alibabacloudstack/connectivity"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+)
+
+func TestAccAlibabacloudStackEdasK8sApplicationsDataSource(t *testing.T) {
+	rand := acctest.RandIntRange(1000, 9999)
+	name := fmt.Sprintf("tf-testacc-edask8sapps%v", rand)
+	resourceId := "data.alibabacloudstack_edas_k8s_applications.default"
+	testAccConfig := dataSourceTestAccConfigFunc(resourceId, name, dataSourceEdasK8sApplicationsConfigDependence)
+
+	idsConf := dataSourceTestAccConfig{
+		existConfig: testAccConfig(map[string]interface{}{
+			"ids": []string{"${alibabacloudstack_edas_k8s_application.default.id}"},
+		}),
+		fakeConfig: testAccConfig(map[string]interface{}{
+			"ids": []string{"${alibabacloudstack_edas_k8s_application.default.id}_fake"},
+		}),
+	}
+	nameRegexConf := dataSourceTestAccConfig{
+		existConfig: testAccConfig(map[string]interface{}{
+			"name_regex": "${alibabacloudstack_edas_k8s_application.default.application_name}",
+		}),
+		fakeConfig: testAccConfig(map[string]interface{}{
+			"name_regex": "${alibabacloudstack_edas_k8s_application.default.application_name}_fake",
+		}),
+	}
+	allConf := dataSourceTestAccConfig{
+		existConfig: testAccConfig(map[string]interface{}{
+			"ids":        []string{"${alibabacloudstack_edas_k8s_application.default.id}"},
+			"name_regex": "${alibabacloudstack_edas_k8s_application.default.application_name}",
+			"cluster_id": "${alibabacloudstack_edas_k8s_application.default.cluster_id}",
+		}),
+		fakeConfig: testAccConfig(map[string]interface{}{
+			"ids":        []string{"${alibabacloudstack_edas_k8s_application.default.id}"},
+			"name_regex": "${alibabacloudstack_edas_k8s_application.default.application_name}",
+			"cluster_id": "${alibabacloudstack_edas_k8s_application.default.cluster_id}_fake",
+		}),
+	}
+
+	var existEdasK8sApplicationsMapFunc = func(rand int) map[string]string {
+		return map[string]string{
+			"ids.#":                           "1",
+			"names.#":                         "1",
+			"applications.#":                  "1",
+			"applications.0.id":               CHECKSET,
+			"applications.0.application_name": name,
+			"applications.0.cluster_id":       CHECKSET,
+			"applications.0.package_type":     "Image",
+			"applications.0.replicas":         "1",
+			"applications.0.image_url":        CHECKSET,
+		}
+	}
+
+	var fakeEdasK8sApplicationsMapFunc = func(rand int) map[string]string {
+		return map[string]string{
+			"ids.#":          "0",
+			"names.#":        "0",
+			"applications.#": "0",
+		}
+	}
+
+	var edasK8sApplicationsCheckInfo = dataSourceAttr{
+		resourceId:   resourceId,
+		existMapFunc: existEdasK8sApplicationsMapFunc,
+		fakeMapFunc:  fakeEdasK8sApplicationsMapFunc,
+	}
+
+	preCheck := func() {
+		testAccPreCheckWithRegions(t, true, connectivity.EdasSupportedRegions)
+	}
+	edasK8sApplicationsCheckInfo.dataSourceTestCheckWithPreCheck(t, rand, preCheck, idsConf, nameRegexConf, allConf)
+}
+
+func dataSourceEdasK8sApplicationsConfigDependence(name string) string {
+	image := fmt.Sprintf("registry-vpc.%s.aliyuncs.com/edas-demo-image/consumer:1.0", os.Getenv("ALIBABACLOUDSTACK_REGION"))
+	return resourceEdasK8sApplicationConfigDependence(name) + fmt.Sprintf(`
+resource "alibabacloudstack_edas_k8s_application" "default" {
+  application_name = var.name
+  cluster_id       = alibabacloudstack_edas_k8s_cluster.default.id
+  package_type     = "Image"
+  image_url        = "%s"
+  replicas         = 1
+}
+`, image)
+}
@@ -1,6 +1,7 @@
 package alibabacloudstack
 
 import (
+	"context"
 	"fmt"
 	"time"
 
@@ -13,7 +14,7 @@ import (
 
 func dataSourceAlibabacloudStackEhpcJobTemplates() *schema.Resource {
 	return &schema.Resource{
-		Read: dataSourceAlibabacloudStackEhpcJobTemplatesRead,
+		ReadContext: withDiagnostics(dataSourceAlibabacloudStackEhpcJobTemplatesRead),
 		Schema: map[string]*schema.Schema{
 			"ids": {
 				Type:     schema.TypeList,
@@ -114,7 +115,7 @@ func dataSourceAlibabacloudStackEhpcJobTemplates() *schema.Resource {
 	}
 }
 
-func dataSourceAlibabacloudStackEhpcJobTemplatesRead(d *schema.ResourceData, meta interface{}) error {
+func dataSourceAlibabacloudStackEhpcJobTemplatesRead(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*connectivity.AlibabacloudStackClient)
 
 	action := "ListJobTemplates"
@@ -142,7 +143,7 @@ func dataSourceAlibabacloudStackEhpcJobTemplatesRead(d *schema.ResourceData, met
 		runtime := util.RuntimeOptions{}
 		runtime.SetAutoretry(true)
 		wait := incrementalWait(3*time.Second, 3*time.Second)
-		err = resource.Retry(5*time.Minute, func() *resource.RetryError {
+		err = resource.RetryContext(ctx, 5*time.Minute, func() *resource.RetryError {
 			response, err = conn.DoRequest(StringPointer(action), nil, StringPointer("GET"), StringPointer("2018-04-12"), StringPointer("AK"), request, nil, &runtime)
 			if err != nil {
 				if NeedRetry(err) {
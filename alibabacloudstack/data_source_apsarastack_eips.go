@@ -1,6 +1,7 @@
 package alibabacloudstack
 
 import (
+	"context"
 	"github.com/aliyun/alibaba-cloud-sdk-go/sdk/requests"
 	"github.com/aliyun/alibaba-cloud-sdk-go/services/vpc"
 	"github.com/aliyun/terraform-provider-alibabacloudstack/alibabacloudstack/connectivity"
@@ -10,7 +11,7 @@ import (
 
 func dataSourceAlibabacloudStackEips() *schema.Resource {
 	return &schema.Resource{
-		Read: dataSourceAlibabacloudStackEipsRead,
+		ReadContext: withDiagnostics(dataSourceAlibabacloudStackEipsRead),
 
 		Schema: map[string]*schema.Schema{
 			"ids": {
@@ -75,7 +76,7 @@ func dataSourceAlibabacloudStackEips() *schema.Resource {
 		},
 	}
 }
-func dataSourceAlibabacloudStackEipsRead(d *schema.ResourceData, meta interface{}) error {
+func dataSourceAlibabacloudStackEipsRead(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*connectivity.AlibabacloudStackClient)
 
 	request := vpc.CreateDescribeEipAddressesRequest()
@@ -0,0 +1,96 @@
+package alibabacloudstack
+
+import (
+	"context"
+	"sort"
+	"strings"
+
+	"github.com/aliyun/terraform-provider-alibabacloudstack/alibabacloudstack/connectivity"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func dataSourceAlibabacloudStackEndpoints() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: withDiagnostics(dataSourceAlibabacloudStackEndpointsRead),
+		Schema: map[string]*schema.Schema{
+			"service_codes": {
+				Type:     schema.TypeList,
+				Optional: true,
+				ForceNew: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"output_file": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"ids": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"endpoints": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"service_code": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"endpoint": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"source": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceAlibabacloudStackEndpointsRead(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*connectivity.AlibabacloudStackClient)
+
+	serviceCodes := make(map[connectivity.ServiceCode]bool)
+	if v, ok := d.GetOk("service_codes"); ok {
+		for _, code := range v.([]interface{}) {
+			serviceCodes[connectivity.NormalizeServiceCode(code.(string))] = true
+		}
+	}
+
+	var ids []string
+	for code := range client.Config.EndpointSources {
+		if len(serviceCodes) > 0 && !serviceCodes[code] {
+			continue
+		}
+		ids = append(ids, string(code))
+	}
+	sort.Strings(ids)
+
+	var s []map[string]interface{}
+	for _, id := range ids {
+		code := connectivity.ServiceCode(id)
+		endpoint, _ := client.Config.Endpoints[strings.ToLower(id)].(string)
+		s = append(s, map[string]interface{}{
+			"service_code": id,
+			"endpoint":     endpoint,
+			"source":       client.Config.EndpointSources[code],
+		})
+	}
+
+	d.SetId(dataResourceIdHash(ids))
+	if err := d.Set("ids", ids); err != nil {
+		return WrapError(err)
+	}
+	if err := d.Set("endpoints", s); err != nil {
+		return WrapError(err)
+	}
+	if output, ok := d.GetOk("output_file"); ok && output.(string) != "" {
+		writeToFile(output.(string), s)
+	}
+	return nil
+}
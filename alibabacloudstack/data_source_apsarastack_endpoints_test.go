@@ -0,0 +1,34 @@
+package alibabacloudstack
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccAlibabacloudStackEndpointsDataSource_basic(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+		},
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckAlibabacloudStackEndpointsDataSourceBasic,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAlibabacloudStackDataSourceID("data.alibabacloudstack_endpoints.default"),
+					resource.TestCheckResourceAttr("data.alibabacloudstack_endpoints.default", "ids.#", "1"),
+					resource.TestCheckResourceAttr("data.alibabacloudstack_endpoints.default", "endpoints.0.service_code", "ECS"),
+					resource.TestCheckResourceAttrSet("data.alibabacloudstack_endpoints.default", "endpoints.0.endpoint"),
+					resource.TestCheckResourceAttrSet("data.alibabacloudstack_endpoints.default", "endpoints.0.source"),
+				),
+			},
+		},
+	})
+}
+
+const testAccCheckAlibabacloudStackEndpointsDataSourceBasic = `
+data "alibabacloudstack_endpoints" "default" {
+  service_codes = ["ecs"]
+}
+`
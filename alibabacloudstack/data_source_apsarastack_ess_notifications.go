@@ -1,6 +1,7 @@
 package alibabacloudstack
 
 import (
+	"context"
 	"github.com/aliyun/alibaba-cloud-sdk-go/services/ess"
 	"github.com/aliyun/terraform-provider-alibabacloudstack/alibabacloudstack/connectivity"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
@@ -9,7 +10,7 @@ import (
 
 func dataSourceAlibabacloudStackEssNotifications() *schema.Resource {
 	return &schema.Resource{
-		Read: dataSourceAlibabacloudStackEssNotificationsRead,
+		ReadContext: withDiagnostics(dataSourceAlibabacloudStackEssNotificationsRead),
 		Schema: map[string]*schema.Schema{
 			"scaling_group_id": {
 				Type:     schema.TypeString,
@@ -53,7 +54,7 @@ func dataSourceAlibabacloudStackEssNotifications() *schema.Resource {
 	}
 }
 
-func dataSourceAlibabacloudStackEssNotificationsRead(d *schema.ResourceData, meta interface{}) error {
+func dataSourceAlibabacloudStackEssNotificationsRead(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*connectivity.AlibabacloudStackClient)
 	request := ess.CreateDescribeNotificationConfigurationsRequest()
 	request.RegionId = client.RegionId
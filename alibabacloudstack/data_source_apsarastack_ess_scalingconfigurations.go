@@ -1,6 +1,7 @@
 package alibabacloudstack
 
 import (
+	"context"
 	"regexp"
 	"strings"
 
@@ -13,7 +14,7 @@ import (
 
 func dataSourceAlibabacloudStackEssScalingConfigurations() *schema.Resource {
 	return &schema.Resource{
-		Read: dataSourceAlibabacloudStackEssScalingConfigurationsRead,
+		ReadContext: withDiagnostics(dataSourceAlibabacloudStackEssScalingConfigurationsRead),
 		Schema: map[string]*schema.Schema{
 			"scaling_group_id": {
 				Type:     schema.TypeString,
@@ -131,7 +132,7 @@ func dataSourceAlibabacloudStackEssScalingConfigurations() *schema.Resource {
 	}
 }
 
-func dataSourceAlibabacloudStackEssScalingConfigurationsRead(d *schema.ResourceData, meta interface{}) error {
+func dataSourceAlibabacloudStackEssScalingConfigurationsRead(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*connectivity.AlibabacloudStackClient)
 	request := ess.CreateDescribeScalingConfigurationsRequest()
 	request.RegionId = client.RegionId
@@ -1,6 +1,7 @@
 package alibabacloudstack
 
 import (
+	"context"
 	"regexp"
 	"strings"
 
@@ -13,7 +14,7 @@ import (
 
 func dataSourceAlibabacloudStackEssScalingGroups() *schema.Resource {
 	return &schema.Resource{
-		Read: dataSourceAlibabacloudStackEssScalingGroupsRead,
+		ReadContext: withDiagnostics(dataSourceAlibabacloudStackEssScalingGroupsRead),
 		Schema: map[string]*schema.Schema{
 			"name_regex": {
 				Type:         schema.TypeString,
@@ -122,7 +123,7 @@ func dataSourceAlibabacloudStackEssScalingGroups() *schema.Resource {
 	}
 }
 
-func dataSourceAlibabacloudStackEssScalingGroupsRead(d *schema.ResourceData, meta interface{}) error {
+func dataSourceAlibabacloudStackEssScalingGroupsRead(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*connectivity.AlibabacloudStackClient)
 	request := ess.CreateDescribeScalingGroupsRequest()
 	request.RegionId = client.RegionId
@@ -1,6 +1,7 @@
 package alibabacloudstack
 
 import (
+	"context"
 	"regexp"
 	"strings"
 
@@ -13,7 +14,7 @@ import (
 
 func dataSourceAlibabacloudStackEssScalingRules() *schema.Resource {
 	return &schema.Resource{
-		Read: dataSourceAlibabacloudStackEssScalingRulesRead,
+		ReadContext: withDiagnostics(dataSourceAlibabacloudStackEssScalingRulesRead),
 		Schema: map[string]*schema.Schema{
 			"scaling_group_id": {
 				Type:     schema.TypeString,
@@ -92,7 +93,7 @@ func dataSourceAlibabacloudStackEssScalingRules() *schema.Resource {
 	}
 }
 
-func dataSourceAlibabacloudStackEssScalingRulesRead(d *schema.ResourceData, meta interface{}) error {
+func dataSourceAlibabacloudStackEssScalingRulesRead(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*connectivity.AlibabacloudStackClient)
 	request := ess.CreateDescribeScalingRulesRequest()
 	request.RegionId = client.RegionId
@@ -1,6 +1,7 @@
 package alibabacloudstack
 
 import (
+	"context"
 	"regexp"
 	"strings"
 
@@ -13,7 +14,7 @@ import (
 
 func dataSourceAlibabacloudStackEssScheduledTasks() *schema.Resource {
 	return &schema.Resource{
-		Read: dataSourceAlibabacloudStackEssScheduledTasksRead,
+		ReadContext: withDiagnostics(dataSourceAlibabacloudStackEssScheduledTasksRead),
 		Schema: map[string]*schema.Schema{
 			"scheduled_task_id": {
 				Type:     schema.TypeString,
@@ -103,7 +104,7 @@ func dataSourceAlibabacloudStackEssScheduledTasks() *schema.Resource {
 	}
 }
 
-func dataSourceAlibabacloudStackEssScheduledTasksRead(d *schema.ResourceData, meta interface{}) error {
+func dataSourceAlibabacloudStackEssScheduledTasksRead(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*connectivity.AlibabacloudStackClient)
 	request := ess.CreateDescribeScheduledTasksRequest()
 	request.RegionId = client.RegionId
@@ -1,6 +1,7 @@
 package alibabacloudstack
 
 import (
+	"context"
 	"regexp"
 	"strings"
 
@@ -13,7 +14,7 @@ import (
 
 func dataSourceAlibabacloudStackForwardEntries() *schema.Resource {
 	return &schema.Resource{
-		Read: dataSourceAlibabacloudStackForwardEntriesRead,
+		ReadContext: withDiagnostics(dataSourceAlibabacloudStackForwardEntriesRead),
 
 		Schema: map[string]*schema.Schema{
 			"forward_table_id": {
@@ -85,7 +86,7 @@ func dataSourceAlibabacloudStackForwardEntries() *schema.Resource {
 		},
 	}
 }
-func dataSourceAlibabacloudStackForwardEntriesRead(d *schema.ResourceData, meta interface{}) error {
+func dataSourceAlibabacloudStackForwardEntriesRead(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*connectivity.AlibabacloudStackClient)
 
 	request := vpc.CreateDescribeForwardTableEntriesRequest()
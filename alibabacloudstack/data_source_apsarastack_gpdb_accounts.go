@@ -1,6 +1,7 @@
 package alibabacloudstack
 
 import (
+	"context"
 	"fmt"
 	"regexp"
 	"time"
@@ -15,7 +16,7 @@ import (
 
 func dataSourceAlibabacloudStackGpdbAccounts() *schema.Resource {
 	return &schema.Resource{
-		Read: dataSourceAlibabacloudStackGpdbAccountsRead,
+		ReadContext: withDiagnostics(dataSourceAlibabacloudStackGpdbAccountsRead),
 		Schema: map[string]*schema.Schema{
 			"name_regex": {
 				Type:         schema.TypeString,
@@ -82,7 +83,7 @@ func dataSourceAlibabacloudStackGpdbAccounts() *schema.Resource {
 	}
 }
 
-func dataSourceAlibabacloudStackGpdbAccountsRead(d *schema.ResourceData, meta interface{}) error {
+func dataSourceAlibabacloudStackGpdbAccountsRead(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*connectivity.AlibabacloudStackClient)
 
 	action := "DescribeAccounts"
@@ -119,7 +120,7 @@ func dataSourceAlibabacloudStackGpdbAccountsRead(d *schema.ResourceData, meta in
 	runtime := util.RuntimeOptions{}
 	runtime.SetAutoretry(true)
 	wait := incrementalWait(3*time.Second, 3*time.Second)
-	err = resource.Retry(5*time.Minute, func() *resource.RetryError {
+	err = resource.RetryContext(ctx, 5*time.Minute, func() *resource.RetryError {
 
 		response, err = conn.DoRequest(StringPointer(action), nil, StringPointer("POST"), StringPointer("2016-05-03"), StringPointer("AK"), nil, request, &runtime)
 		if err != nil {
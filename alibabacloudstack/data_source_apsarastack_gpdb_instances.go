@@ -1,6 +1,7 @@
 package alibabacloudstack
 
 import (
+	"context"
 	"encoding/json"
 	"github.com/aliyun/alibaba-cloud-sdk-go/sdk/responses"
 	"github.com/aliyun/alibaba-cloud-sdk-go/services/ecs"
@@ -17,7 +18,7 @@ import (
 
 func dataSourceAlibabacloudStackGpdbInstances() *schema.Resource {
 	return &schema.Resource{
-		Read: dataSourceAlibabacloudStackGpdbInstancesRead,
+		ReadContext: withDiagnostics(dataSourceAlibabacloudStackGpdbInstancesRead),
 		Schema: map[string]*schema.Schema{
 			"name_regex": {
 				Type:         schema.TypeString,
@@ -111,7 +112,7 @@ func dataSourceAlibabacloudStackGpdbInstances() *schema.Resource {
 	}
 }
 
-func dataSourceAlibabacloudStackGpdbInstancesRead(d *schema.ResourceData, meta interface{}) error {
+func dataSourceAlibabacloudStackGpdbInstancesRead(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*connectivity.AlibabacloudStackClient)
 	request := requests.NewCommonRequest()
 	if client.Config.Insecure {
@@ -1,6 +1,7 @@
 package alibabacloudstack
 
 import (
+	"context"
 	"log"
 	"regexp"
 	"sort"
@@ -16,7 +17,7 @@ import (
 
 func dataSourceAlibabacloudStackImages() *schema.Resource {
 	return &schema.Resource{
-		Read: dataSourceAlibabacloudStackImagesRead,
+		ReadContext: withDiagnostics(dataSourceAlibabacloudStackImagesRead),
 
 		Schema: map[string]*schema.Schema{
 			"name_regex": {
@@ -173,7 +174,7 @@ func dataSourceAlibabacloudStackImages() *schema.Resource {
 }
 
 // dataSourceAlibabacloudStackImagesDescriptionRead performs the AlibabacloudStack Image lookup.
-func dataSourceAlibabacloudStackImagesRead(d *schema.ResourceData, meta interface{}) error {
+func dataSourceAlibabacloudStackImagesRead(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*connectivity.AlibabacloudStackClient)
 
 	nameRegex, nameRegexOk := d.GetOk("name_regex")
@@ -1,6 +1,7 @@
 package alibabacloudstack
 
 import (
+	"context"
 	"sort"
 	"strings"
 
@@ -12,7 +13,7 @@ import (
 
 func dataSourceAlibabacloudStackInstanceTypeFamilies() *schema.Resource {
 	return &schema.Resource{
-		Read: dataSourceAlibabacloudStackInstanceTypeFamiliesRead,
+		ReadContext: withDiagnostics(dataSourceAlibabacloudStackInstanceTypeFamiliesRead),
 
 		Schema: map[string]*schema.Schema{
 			"generation": {
@@ -61,7 +62,7 @@ func dataSourceAlibabacloudStackInstanceTypeFamilies() *schema.Resource {
 	}
 }
 
-func dataSourceAlibabacloudStackInstanceTypeFamiliesRead(d *schema.ResourceData, meta interface{}) error {
+func dataSourceAlibabacloudStackInstanceTypeFamiliesRead(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*connectivity.AlibabacloudStackClient)
 	ecsService := EcsService{client}
 	request := ecs.CreateDescribeInstanceTypeFamiliesRequest()
@@ -1,6 +1,7 @@
 package alibabacloudstack
 
 import (
+	"context"
 	"fmt"
 	"regexp"
 	"sort"
@@ -20,7 +21,7 @@ type instanceTypeWithOriginalPrice struct {
 
 func dataSourceAlibabacloudStackInstanceTypes() *schema.Resource {
 	return &schema.Resource{
-		Read: dataSourceAlibabacloudStackInstanceTypesRead,
+		ReadContext: withDiagnostics(dataSourceAlibabacloudStackInstanceTypesRead),
 
 		Schema: map[string]*schema.Schema{
 			"availability_zone": {
@@ -157,7 +158,7 @@ func dataSourceAlibabacloudStackInstanceTypes() *schema.Resource {
 	}
 }
 
-func dataSourceAlibabacloudStackInstanceTypesRead(d *schema.ResourceData, meta interface{}) error {
+func dataSourceAlibabacloudStackInstanceTypesRead(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*connectivity.AlibabacloudStackClient)
 	ecsService := EcsService{client}
 
@@ -1,6 +1,7 @@
 package alibabacloudstack
 
 import (
+	"context"
 	"github.com/aliyun/alibaba-cloud-sdk-go/sdk/requests"
 	"github.com/aliyun/alibaba-cloud-sdk-go/services/ecs"
 	"github.com/aliyun/terraform-provider-alibabacloudstack/alibabacloudstack/connectivity"
@@ -12,7 +13,7 @@ import (
 
 func dataSourceAlibabacloudStackInstances() *schema.Resource {
 	return &schema.Resource{
-		Read: dataSourceAlibabacloudStackInstancesRead,
+		ReadContext: withDiagnostics(dataSourceAlibabacloudStackInstancesRead),
 
 		Schema: map[string]*schema.Schema{
 			"ids": {
@@ -191,7 +192,7 @@ func dataSourceAlibabacloudStackInstances() *schema.Resource {
 	}
 }
 
-func dataSourceAlibabacloudStackInstancesRead(d *schema.ResourceData, meta interface{}) error {
+func dataSourceAlibabacloudStackInstancesRead(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*connectivity.AlibabacloudStackClient)
 
 	request := ecs.CreateDescribeInstancesRequest()
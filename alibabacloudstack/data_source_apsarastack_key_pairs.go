@@ -1,6 +1,7 @@
 package alibabacloudstack
 
 import (
+	"context"
 	"regexp"
 	"strings"
 
@@ -13,7 +14,7 @@ import (
 
 func dataSourceAlibabacloudStackKeyPairs() *schema.Resource {
 	return &schema.Resource{
-		Read: dataSourceAlibabacloudStackKeyPairsRead,
+		ReadContext: withDiagnostics(dataSourceAlibabacloudStackKeyPairsRead),
 
 		Schema: map[string]*schema.Schema{
 			"name_regex": {
@@ -74,7 +75,7 @@ func dataSourceAlibabacloudStackKeyPairs() *schema.Resource {
 	}
 }
 
-func dataSourceAlibabacloudStackKeyPairsRead(d *schema.ResourceData, meta interface{}) error {
+func dataSourceAlibabacloudStackKeyPairsRead(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*connectivity.AlibabacloudStackClient)
 
 	var regex *regexp.Regexp
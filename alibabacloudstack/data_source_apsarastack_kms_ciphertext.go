@@ -1,6 +1,7 @@
 package alibabacloudstack
 
 import (
+	"context"
 	"strconv"
 	"time"
 
@@ -11,7 +12,7 @@ import (
 
 func dataSourceAlibabacloudStackKmsCiphertext() *schema.Resource {
 	return &schema.Resource{
-		Read: dataSourceAlibabacloudStackKmsCiphertextRead,
+		ReadContext: withDiagnostics(dataSourceAlibabacloudStackKmsCiphertextRead),
 
 		Schema: map[string]*schema.Schema{
 			"plaintext": {
@@ -41,7 +42,7 @@ func dataSourceAlibabacloudStackKmsCiphertext() *schema.Resource {
 	}
 }
 
-func dataSourceAlibabacloudStackKmsCiphertextRead(d *schema.ResourceData, meta interface{}) error {
+func dataSourceAlibabacloudStackKmsCiphertextRead(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*connectivity.AlibabacloudStackClient)
 
 	// Since a ciphertext has no ID, we create an ID based on
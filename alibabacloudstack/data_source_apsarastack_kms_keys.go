@@ -1,6 +1,7 @@
 package alibabacloudstack
 
 import (
+	"context"
 	"regexp"
 
 	"github.com/aliyun/alibaba-cloud-sdk-go/sdk/requests"
@@ -12,7 +13,7 @@ import (
 
 func dataSourceAlibabacloudStackKmsKeys() *schema.Resource {
 	return &schema.Resource{
-		Read: dataSourceAlibabacloudStackKmsKeysRead,
+		ReadContext: withDiagnostics(dataSourceAlibabacloudStackKmsKeysRead),
 
 		Schema: map[string]*schema.Schema{
 			"ids": {
@@ -89,7 +90,7 @@ func dataSourceAlibabacloudStackKmsKeys() *schema.Resource {
 	}
 }
 
-func dataSourceAlibabacloudStackKmsKeysRead(d *schema.ResourceData, meta interface{}) error {
+func dataSourceAlibabacloudStackKmsKeysRead(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*connectivity.AlibabacloudStackClient)
 
 	request := kms.CreateListKeysRequest()
@@ -1,6 +1,7 @@
 package alibabacloudstack
 
 import (
+	"context"
 	"fmt"
 	"regexp"
 
@@ -13,7 +14,7 @@ import (
 
 func dataSourceAlibabacloudStackKmsSecrets() *schema.Resource {
 	return &schema.Resource{
-		Read: dataSourceAlibabacloudStackKmsSecretsRead,
+		ReadContext: withDiagnostics(dataSourceAlibabacloudStackKmsSecretsRead),
 		Schema: map[string]*schema.Schema{
 			"name_regex": {
 				Type:         schema.TypeString,
@@ -70,7 +71,7 @@ func dataSourceAlibabacloudStackKmsSecrets() *schema.Resource {
 	}
 }
 
-func dataSourceAlibabacloudStackKmsSecretsRead(d *schema.ResourceData, meta interface{}) error {
+func dataSourceAlibabacloudStackKmsSecretsRead(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*connectivity.AlibabacloudStackClient)
 
 	request := kms.CreateListSecretsRequest()
@@ -1,6 +1,7 @@
 package alibabacloudstack
 
 import (
+	"context"
 	"fmt"
 	"sort"
 	"strconv"
@@ -17,7 +18,7 @@ import (
 
 func dataSourceAlibabacloudStackKVStoreInstanceClasses() *schema.Resource {
 	return &schema.Resource{
-		Read: dataSourceAlibabacloudStackKVStoreAvailableResourceRead,
+		ReadContext: withDiagnostics(dataSourceAlibabacloudStackKVStoreAvailableResourceRead),
 		Schema: map[string]*schema.Schema{
 			"zone_id": {
 				Type:     schema.TypeString,
@@ -127,7 +128,7 @@ func removeRepByMap(slc []string) []string {
 	return result
 }
 
-func dataSourceAlibabacloudStackKVStoreAvailableResourceRead(d *schema.ResourceData, meta interface{}) error {
+func dataSourceAlibabacloudStackKVStoreAvailableResourceRead(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*connectivity.AlibabacloudStackClient)
 
 	request := r_kvstore.CreateSelectCommRequest()
@@ -136,7 +137,7 @@ func dataSourceAlibabacloudStackKVStoreAvailableResourceRead(d *schema.ResourceD
 	request.Status = "Available"
 	instanceChargeType := d.Get("instance_charge_type").(string)
 	var response = &r_kvstore.DescribeCommSelectResponse{}
-	err := resource.Retry(time.Minute*5, func() *resource.RetryError {
+	err := resource.RetryContext(ctx, time.Minute*5, func() *resource.RetryError {
 		raw, err := client.WithRkvClient(func(rkvClient *r_kvstore.Client) (interface{}, error) {
 			return rkvClient.DescribeCommSelect(request)
 		})
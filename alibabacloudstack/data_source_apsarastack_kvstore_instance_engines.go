@@ -1,6 +1,7 @@
 package alibabacloudstack
 
 import (
+	"context"
 	"strings"
 	"time"
 
@@ -13,7 +14,7 @@ import (
 
 func dataSourceAlibabacloudStackKVStoreInstanceEngines() *schema.Resource {
 	return &schema.Resource{
-		Read: dataSourceAlibabacloudStackKVStoreInstanceEnginesRead,
+		ReadContext: withDiagnostics(dataSourceAlibabacloudStackKVStoreInstanceEnginesRead),
 		Schema: map[string]*schema.Schema{
 			"zone_id": {
 				Type:     schema.TypeString,
@@ -71,7 +72,7 @@ func dataSourceAlibabacloudStackKVStoreInstanceEngines() *schema.Resource {
 	}
 }
 
-func dataSourceAlibabacloudStackKVStoreInstanceEnginesRead(d *schema.ResourceData, meta interface{}) error {
+func dataSourceAlibabacloudStackKVStoreInstanceEnginesRead(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*connectivity.AlibabacloudStackClient)
 
 	request := r_kvstore.CreateDescribeAvailableResourceRequest()
@@ -83,7 +84,7 @@ func dataSourceAlibabacloudStackKVStoreInstanceEnginesRead(d *schema.ResourceDat
 	request.InstanceChargeType = instanceChargeType
 	request.Engine = d.Get("engine").(string)
 	var response = &r_kvstore.DescribeAvailableResourceResponse{}
-	err := resource.Retry(time.Minute*5, func() *resource.RetryError {
+	err := resource.RetryContext(ctx, time.Minute*5, func() *resource.RetryError {
 		raw, err := client.WithRkvClient(func(rkvClient *r_kvstore.Client) (interface{}, error) {
 			return rkvClient.DescribeAvailableResource(request)
 		})
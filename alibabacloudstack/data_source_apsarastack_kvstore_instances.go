@@ -1,6 +1,7 @@
 package alibabacloudstack
 
 import (
+	"context"
 	"regexp"
 
 	"github.com/aliyun/alibaba-cloud-sdk-go/sdk/requests"
@@ -12,7 +13,7 @@ import (
 
 func dataSourceAlibabacloudStackKVStoreInstances() *schema.Resource {
 	return &schema.Resource{
-		Read: dataSourceAlibabacloudStackKVStoreInstancesRead,
+		ReadContext: withDiagnostics(dataSourceAlibabacloudStackKVStoreInstancesRead),
 
 		Schema: map[string]*schema.Schema{
 			"name_regex": {
@@ -143,7 +144,7 @@ func dataSourceAlibabacloudStackKVStoreInstances() *schema.Resource {
 	}
 }
 
-func dataSourceAlibabacloudStackKVStoreInstancesRead(d *schema.ResourceData, meta interface{}) error {
+func dataSourceAlibabacloudStackKVStoreInstancesRead(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*connectivity.AlibabacloudStackClient)
 
 	request := r_kvstore.CreateDescribeInstancesRequest()
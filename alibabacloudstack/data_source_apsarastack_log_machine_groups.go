@@ -0,0 +1,177 @@
+package alibabacloudstack
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"time"
+
+	sls "github.com/aliyun/aliyun-log-go-sdk"
+	"github.com/aliyun/terraform-provider-alibabacloudstack/alibabacloudstack/connectivity"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+func dataSourceAlibabacloudStackLogMachineGroups() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: withDiagnostics(dataSourceAlibabacloudStackLogMachineGroupsRead),
+		Schema: map[string]*schema.Schema{
+			"project": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"ids": {
+				Type:     schema.TypeList,
+				Optional: true,
+				ForceNew: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+				Computed: true,
+			},
+			"name_regex": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringIsValidRegExp,
+			},
+			"names": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"output_file": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"groups": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"identify_type": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"identify_list": {
+							Type:     schema.TypeList,
+							Computed: true,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+						"topic": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceAlibabacloudStackLogMachineGroupsRead(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*connectivity.AlibabacloudStackClient)
+	logService := LogService{client}
+	projectName := d.Get("project").(string)
+	var groupNames []string
+	for offset, size := 0, 500; ; {
+		var page []string
+		var requestInfo *sls.Client
+		err := resource.RetryContext(ctx, 2*time.Minute, func() *resource.RetryError {
+			raw, err := client.WithLogClient(func(slsClient *sls.Client) (interface{}, error) {
+				requestInfo = slsClient
+				groups, _, err := slsClient.ListMachineGroup(projectName, offset, size)
+				return groups, err
+			})
+			if err != nil {
+				if IsExpectedErrors(err, []string{"InternalServerError", LogClientTimeout}) {
+					return resource.RetryableError(err)
+				}
+				return resource.NonRetryableError(err)
+			}
+			if debugOn() {
+				addDebug("ListMachineGroup", raw, requestInfo, map[string]interface{}{
+					"project": projectName,
+					"offset":  offset,
+					"size":    size,
+				})
+			}
+			page, _ = raw.([]string)
+			return nil
+		})
+		if err != nil {
+			return WrapErrorf(err, DataDefaultErrorMsg, "alibabacloudstack_log_machine_groups", "ListMachineGroup", AlibabacloudStackLogGoSdkERROR)
+		}
+		groupNames = append(groupNames, page...)
+		if len(page) < size {
+			break
+		}
+		offset += size
+	}
+
+	idsMap := make(map[string]string)
+	if v, ok := d.GetOk("ids"); ok {
+		for _, vv := range v.([]interface{}) {
+			if vv == nil {
+				continue
+			}
+			idsMap[vv.(string)] = vv.(string)
+		}
+	}
+	var nameRegex *regexp.Regexp
+	if v, ok := d.GetOk("name_regex"); ok && v.(string) != "" {
+		nameRegex = regexp.MustCompile(v.(string))
+	}
+
+	ids := make([]string, 0)
+	names := make([]string, 0)
+	s := make([]map[string]interface{}, 0)
+	for _, name := range groupNames {
+		id := fmt.Sprintf("%s%s%s", projectName, COLON_SEPARATED, name)
+		if len(idsMap) > 0 {
+			if _, ok := idsMap[id]; !ok {
+				continue
+			}
+		}
+		if nameRegex != nil && !nameRegex.MatchString(name) {
+			continue
+		}
+		object, err := logService.DescribeLogMachineGroup(id)
+		if err != nil {
+			return WrapError(err)
+		}
+		mapping := map[string]interface{}{
+			"id":            id,
+			"name":          object.Name,
+			"identify_type": object.MachineIDType,
+			"identify_list": object.MachineIDList,
+			"topic":         object.Attribute.TopicName,
+		}
+		ids = append(ids, id)
+		names = append(names, name)
+		s = append(s, mapping)
+	}
+
+	d.SetId(dataResourceIdHash(ids))
+	if err := d.Set("ids", ids); err != nil {
+		return WrapError(err)
+	}
+	if err := d.Set("names", names); err != nil {
+		return WrapError(err)
+	}
+	if err := d.Set("groups", s); err != nil {
+		return WrapError(err)
+	}
+	if output, ok := d.GetOk("output_file"); ok && output.(string) != "" {
+		writeToFile(output.(string), s)
+	}
+	return nil
+}
@@ -0,0 +1,83 @@
+package alibabacloudstack
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+)
+
+func TestAccAlibabacloudStackLogMachineGroupsDataSource(t *testing.T) {
+	rand := acctest.RandIntRange(10000, 99999)
+	name := fmt.Sprintf("tf-testacc-logmachinegroups%d", rand)
+	resourceId := "data.alibabacloudstack_log_machine_groups.default"
+	testAccConfig := dataSourceTestAccConfigFunc(resourceId, name, dataSourceLogMachineGroupsConfigDependence)
+
+	idsConf := dataSourceTestAccConfig{
+		existConfig: testAccConfig(map[string]interface{}{
+			"project": "${alibabacloudstack_log_machine_group.default.project}",
+			"ids":     []string{"${alibabacloudstack_log_machine_group.default.id}"},
+		}),
+		fakeConfig: testAccConfig(map[string]interface{}{
+			"project": "${alibabacloudstack_log_machine_group.default.project}",
+			"ids":     []string{"${alibabacloudstack_log_machine_group.default.id}_fake"},
+		}),
+	}
+	nameRegexConf := dataSourceTestAccConfig{
+		existConfig: testAccConfig(map[string]interface{}{
+			"project":    "${alibabacloudstack_log_machine_group.default.project}",
+			"name_regex": "${alibabacloudstack_log_machine_group.default.name}",
+		}),
+		fakeConfig: testAccConfig(map[string]interface{}{
+			"project":    "${alibabacloudstack_log_machine_group.default.project}",
+			"name_regex": "${alibabacloudstack_log_machine_group.default.name}_fake",
+		}),
+	}
+
+	var existLogMachineGroupsMapFunc = func(rand int) map[string]string {
+		return map[string]string{
+			"ids.#":                    "1",
+			"names.#":                  "1",
+			"groups.#":                 "1",
+			"groups.0.id":              fmt.Sprintf("%s:%s", name, name),
+			"groups.0.name":            name,
+			"groups.0.identify_type":   "ip",
+			"groups.0.identify_list.#": "2",
+		}
+	}
+
+	var fakeLogMachineGroupsMapFunc = func(rand int) map[string]string {
+		return map[string]string{
+			"ids.#":    "0",
+			"names.#":  "0",
+			"groups.#": "0",
+		}
+	}
+
+	var logMachineGroupsCheckInfo = dataSourceAttr{
+		resourceId:   resourceId,
+		existMapFunc: existLogMachineGroupsMapFunc,
+		fakeMapFunc:  fakeLogMachineGroupsMapFunc,
+	}
+
+	logMachineGroupsCheckInfo.dataSourceTestCheck(t, rand, idsConf, nameRegexConf)
+}
+
+func dataSourceLogMachineGroupsConfigDependence(name string) string {
+	return fmt.Sprintf(`
+variable "name" {
+  default = "%s"
+}
+
+resource "alibabacloudstack_log_project" "default" {
+  name        = var.name
+  description = "tf unit test"
+}
+
+resource "alibabacloudstack_log_machine_group" "default" {
+  project       = alibabacloudstack_log_project.default.name
+  name          = var.name
+  identify_list = ["10.0.0.1", "10.0.0.2"]
+}
+`, name)
+}
@@ -0,0 +1,73 @@
+package alibabacloudstack
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+)
+
+func TestAccAlibabacloudStackLogProjectsDataSource(t *testing.T) {
+	rand := acctest.RandIntRange(10000, 99999)
+	name := fmt.Sprintf("tf-testacc-logprojects%d", rand)
+	resourceId := "data.alibabacloudstack_log_projects.default"
+	testAccConfig := dataSourceTestAccConfigFunc(resourceId, name, dataSourceLogProjectsConfigDependence)
+
+	idsConf := dataSourceTestAccConfig{
+		existConfig: testAccConfig(map[string]interface{}{
+			"ids": []string{"${alibabacloudstack_log_project.default.id}"},
+		}),
+		fakeConfig: testAccConfig(map[string]interface{}{
+			"ids": []string{"${alibabacloudstack_log_project.default.id}_fake"},
+		}),
+	}
+	nameRegexConf := dataSourceTestAccConfig{
+		existConfig: testAccConfig(map[string]interface{}{
+			"name_regex": "${alibabacloudstack_log_project.default.name}",
+		}),
+		fakeConfig: testAccConfig(map[string]interface{}{
+			"name_regex": "${alibabacloudstack_log_project.default.name}_fake",
+		}),
+	}
+
+	var existLogProjectsMapFunc = func(rand int) map[string]string {
+		return map[string]string{
+			"ids.#":                  "1",
+			"names.#":                "1",
+			"projects.#":             "1",
+			"projects.0.id":          name,
+			"projects.0.name":        name,
+			"projects.0.description": "tf unit test",
+			"projects.0.status":      CHECKSET,
+		}
+	}
+
+	var fakeLogProjectsMapFunc = func(rand int) map[string]string {
+		return map[string]string{
+			"ids.#":      "0",
+			"names.#":    "0",
+			"projects.#": "0",
+		}
+	}
+
+	var logProjectsCheckInfo = dataSourceAttr{
+		resourceId:   resourceId,
+		existMapFunc: existLogProjectsMapFunc,
+		fakeMapFunc:  fakeLogProjectsMapFunc,
+	}
+
+	logProjectsCheckInfo.dataSourceTestCheck(t, rand, idsConf, nameRegexConf)
+}
+
+func dataSourceLogProjectsConfigDependence(name string) string {
+	return fmt.Sprintf(`
+variable "name" {
+  default = "%s"
+}
+
+resource "alibabacloudstack_log_project" "default" {
+  name        = var.name
+  description = "tf unit test"
+}
+`, name)
+}
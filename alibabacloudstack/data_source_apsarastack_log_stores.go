@@ -0,0 +1,178 @@
+package alibabacloudstack
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"time"
+
+	sls "github.com/aliyun/aliyun-log-go-sdk"
+	"github.com/aliyun/terraform-provider-alibabacloudstack/alibabacloudstack/connectivity"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+func dataSourceAlibabacloudStackLogStores() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: withDiagnostics(dataSourceAlibabacloudStackLogStoresRead),
+		Schema: map[string]*schema.Schema{
+			"project": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"ids": {
+				Type:     schema.TypeList,
+				Optional: true,
+				ForceNew: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+				Computed: true,
+			},
+			"name_regex": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringIsValidRegExp,
+			},
+			"names": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"output_file": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"stores": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"retention_period": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"shard_count": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"auto_split": {
+							Type:     schema.TypeBool,
+							Computed: true,
+						},
+						"max_split_shard_count": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"enable_web_tracking": {
+							Type:     schema.TypeBool,
+							Computed: true,
+						},
+						"append_meta": {
+							Type:     schema.TypeBool,
+							Computed: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceAlibabacloudStackLogStoresRead(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*connectivity.AlibabacloudStackClient)
+	logService := LogService{client}
+	projectName := d.Get("project").(string)
+	var storeNames []string
+	var requestInfo *sls.Client
+	err := resource.RetryContext(ctx, 2*time.Minute, func() *resource.RetryError {
+		raw, err := client.WithLogClient(func(slsClient *sls.Client) (interface{}, error) {
+			requestInfo = slsClient
+			return slsClient.ListLogStore(projectName)
+		})
+		if err != nil {
+			if IsExpectedErrors(err, []string{"InternalServerError", LogClientTimeout}) {
+				return resource.RetryableError(err)
+			}
+			return resource.NonRetryableError(err)
+		}
+		if debugOn() {
+			addDebug("ListLogStore", raw, requestInfo, map[string]string{"project": projectName})
+		}
+		storeNames, _ = raw.([]string)
+		return nil
+	})
+	if err != nil {
+		return WrapErrorf(err, DataDefaultErrorMsg, "alibabacloudstack_log_stores", "ListLogStore", AlibabacloudStackLogGoSdkERROR)
+	}
+
+	idsMap := make(map[string]string)
+	if v, ok := d.GetOk("ids"); ok {
+		for _, vv := range v.([]interface{}) {
+			if vv == nil {
+				continue
+			}
+			idsMap[vv.(string)] = vv.(string)
+		}
+	}
+	var nameRegex *regexp.Regexp
+	if v, ok := d.GetOk("name_regex"); ok && v.(string) != "" {
+		nameRegex = regexp.MustCompile(v.(string))
+	}
+
+	ids := make([]string, 0)
+	names := make([]string, 0)
+	s := make([]map[string]interface{}, 0)
+	for _, name := range storeNames {
+		id := fmt.Sprintf("%s%s%s", projectName, COLON_SEPARATED, name)
+		if len(idsMap) > 0 {
+			if _, ok := idsMap[id]; !ok {
+				continue
+			}
+		}
+		if nameRegex != nil && !nameRegex.MatchString(name) {
+			continue
+		}
+		object, err := logService.DescribeLogStore(id)
+		if err != nil {
+			return WrapError(err)
+		}
+		mapping := map[string]interface{}{
+			"id":                    id,
+			"name":                  object.Name,
+			"retention_period":      object.TTL,
+			"shard_count":           object.ShardCount,
+			"auto_split":            object.AutoSplit,
+			"max_split_shard_count": object.MaxSplitShard,
+			"enable_web_tracking":   object.WebTracking,
+			"append_meta":           object.AppendMeta,
+		}
+		ids = append(ids, id)
+		names = append(names, name)
+		s = append(s, mapping)
+	}
+
+	d.SetId(dataResourceIdHash(ids))
+	if err := d.Set("ids", ids); err != nil {
+		return WrapError(err)
+	}
+	if err := d.Set("names", names); err != nil {
+		return WrapError(err)
+	}
+	if err := d.Set("stores", s); err != nil {
+		return WrapError(err)
+	}
+	if output, ok := d.GetOk("output_file"); ok && output.(string) != "" {
+		writeToFile(output.(string), s)
+	}
+	return nil
+}
@@ -0,0 +1,83 @@
+package alibabacloudstack
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+)
+
+func TestAccAlibabacloudStackLogStoresDataSource(t *testing.T) {
+	rand := acctest.RandIntRange(10000, 99999)
+	name := fmt.Sprintf("tf-testacc-logstores%d", rand)
+	resourceId := "data.alibabacloudstack_log_stores.default"
+	testAccConfig := dataSourceTestAccConfigFunc(resourceId, name, dataSourceLogStoresConfigDependence)
+
+	idsConf := dataSourceTestAccConfig{
+		existConfig: testAccConfig(map[string]interface{}{
+			"project": "${alibabacloudstack_log_store.default.project}",
+			"ids":     []string{"${alibabacloudstack_log_store.default.id}"},
+		}),
+		fakeConfig: testAccConfig(map[string]interface{}{
+			"project": "${alibabacloudstack_log_store.default.project}",
+			"ids":     []string{"${alibabacloudstack_log_store.default.id}_fake"},
+		}),
+	}
+	nameRegexConf := dataSourceTestAccConfig{
+		existConfig: testAccConfig(map[string]interface{}{
+			"project":    "${alibabacloudstack_log_store.default.project}",
+			"name_regex": "${alibabacloudstack_log_store.default.name}",
+		}),
+		fakeConfig: testAccConfig(map[string]interface{}{
+			"project":    "${alibabacloudstack_log_store.default.project}",
+			"name_regex": "${alibabacloudstack_log_store.default.name}_fake",
+		}),
+	}
+
+	var existLogStoresMapFunc = func(rand int) map[string]string {
+		return map[string]string{
+			"ids.#":                     "1",
+			"names.#":                   "1",
+			"stores.#":                  "1",
+			"stores.0.id":               fmt.Sprintf("%s:%s", name, name),
+			"stores.0.name":             name,
+			"stores.0.shard_count":      "1",
+			"stores.0.retention_period": CHECKSET,
+		}
+	}
+
+	var fakeLogStoresMapFunc = func(rand int) map[string]string {
+		return map[string]string{
+			"ids.#":    "0",
+			"names.#":  "0",
+			"stores.#": "0",
+		}
+	}
+
+	var logStoresCheckInfo = dataSourceAttr{
+		resourceId:   resourceId,
+		existMapFunc: existLogStoresMapFunc,
+		fakeMapFunc:  fakeLogStoresMapFunc,
+	}
+
+	logStoresCheckInfo.dataSourceTestCheck(t, rand, idsConf, nameRegexConf)
+}
+
+func dataSourceLogStoresConfigDependence(name string) string {
+	return fmt.Sprintf(`
+variable "name" {
+  default = "%s"
+}
+
+resource "alibabacloudstack_log_project" "default" {
+  name        = var.name
+  description = "tf unit test"
+}
+
+resource "alibabacloudstack_log_store" "default" {
+  project     = alibabacloudstack_log_project.default.name
+  name        = var.name
+  shard_count = 1
+}
+`, name)
+}
@@ -1,6 +1,7 @@
 package alibabacloudstack
 
 import (
+	"context"
 	"log"
 	"strconv"
 
@@ -10,7 +11,7 @@ import (
 
 func dataSourceAlibabacloudStackMaxcomputeProjects() *schema.Resource {
 	return &schema.Resource{
-		Read: dataSourceAlibabacloudStackMaxcomputeProjectsRead,
+		ReadContext: withDiagnostics(dataSourceAlibabacloudStackMaxcomputeProjectsRead),
 		Schema: map[string]*schema.Schema{
 			"ids": {
 				Type:     schema.TypeList,
@@ -92,7 +93,7 @@ func dataSourceAlibabacloudStackMaxcomputeProjects() *schema.Resource {
 	}
 }
 
-func dataSourceAlibabacloudStackMaxcomputeProjectsRead(d *schema.ResourceData, meta interface{}) error {
+func dataSourceAlibabacloudStackMaxcomputeProjectsRead(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*connectivity.AlibabacloudStackClient)
 	maxcomputeService := MaxcomputeService{client}
 	objects, err := maxcomputeService.DescribeMaxcomputeProject(d.Get("name").(string))
@@ -1,6 +1,7 @@
 package alibabacloudstack
 
 import (
+	"context"
 	"log"
 	"strconv"
 
@@ -11,7 +12,7 @@ import (
 
 func dataSourceAlibabacloudStackMaxcomputeUsers() *schema.Resource {
 	return &schema.Resource{
-		Read: dataSourceAlibabacloudStackMaxcomputeUsersRead,
+		ReadContext: withDiagnostics(dataSourceAlibabacloudStackMaxcomputeUsersRead),
 		Schema: map[string]*schema.Schema{
 			"ids": {
 				Type:     schema.TypeList,
@@ -79,7 +80,7 @@ func dataSourceAlibabacloudStackMaxcomputeUsers() *schema.Resource {
 	}
 }
 
-func dataSourceAlibabacloudStackMaxcomputeUsersRead(d *schema.ResourceData, meta interface{}) error {
+func dataSourceAlibabacloudStackMaxcomputeUsersRead(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*connectivity.AlibabacloudStackClient)
 	maxcomputeService := MaxcomputeService{client}
 	objects, err := maxcomputeService.DescribeMaxcomputeUser(d.Get("name_regex").(string))
@@ -1,6 +1,7 @@
 package alibabacloudstack
 
 import (
+	"context"
 	"regexp"
 	"strings"
 
@@ -13,7 +14,7 @@ import (
 
 func dataSourceAlibabacloudStackMongoDBInstances() *schema.Resource {
 	return &schema.Resource{
-		Read: dataSourceAlibabacloudStackMongoDBInstancesRead,
+		ReadContext: withDiagnostics(dataSourceAlibabacloudStackMongoDBInstancesRead),
 
 		Schema: map[string]*schema.Schema{
 			"name_regex": {
@@ -175,7 +176,7 @@ func dataSourceAlibabacloudStackMongoDBInstances() *schema.Resource {
 	}
 }
 
-func dataSourceAlibabacloudStackMongoDBInstancesRead(d *schema.ResourceData, meta interface{}) error {
+func dataSourceAlibabacloudStackMongoDBInstancesRead(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*connectivity.AlibabacloudStackClient)
 	ddsService := MongoDBService{client}
 
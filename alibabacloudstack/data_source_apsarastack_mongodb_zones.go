@@ -1,6 +1,7 @@
 package alibabacloudstack
 
 import (
+	"context"
 	"fmt"
 	"sort"
 	"strings"
@@ -12,7 +13,7 @@ import (
 
 func dataSourceAlibabacloudStackMongoDBZones() *schema.Resource {
 	return &schema.Resource{
-		Read: dataSourceAlibabacloudStackMongoDBZonesRead,
+		ReadContext: withDiagnostics(dataSourceAlibabacloudStackMongoDBZonesRead),
 
 		Schema: map[string]*schema.Schema{
 			"multi": {
@@ -50,7 +51,7 @@ func dataSourceAlibabacloudStackMongoDBZones() *schema.Resource {
 	}
 }
 
-func dataSourceAlibabacloudStackMongoDBZonesRead(d *schema.ResourceData, meta interface{}) error {
+func dataSourceAlibabacloudStackMongoDBZonesRead(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*connectivity.AlibabacloudStackClient)
 	multi := d.Get("multi").(bool)
 	var zoneIds []string
@@ -1,6 +1,7 @@
 package alibabacloudstack
 
 import (
+	"context"
 	"fmt"
 
 	"github.com/PaesslerAG/jsonpath"
@@ -12,7 +13,7 @@ import (
 
 func dataSourceAlibabacloudStackNasMountTargets() *schema.Resource {
 	return &schema.Resource{
-		Read: dataSourceAlibabacloudStackNasMountTargetsRead,
+		ReadContext: withDiagnostics(dataSourceAlibabacloudStackNasMountTargetsRead),
 		Schema: map[string]*schema.Schema{
 			"access_group_name": {
 				Type:     schema.TypeString,
@@ -112,7 +113,7 @@ func dataSourceAlibabacloudStackNasMountTargets() *schema.Resource {
 	}
 }
 
-func dataSourceAlibabacloudStackNasMountTargetsRead(d *schema.ResourceData, meta interface{}) error {
+func dataSourceAlibabacloudStackNasMountTargetsRead(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*connectivity.AlibabacloudStackClient)
 
 	action := "DescribeMountTargets"
@@ -1,6 +1,7 @@
 package alibabacloudstack
 
 import (
+	"context"
 	"strconv"
 	"time"
 
@@ -12,7 +13,7 @@ import (
 
 func dataSourceAlibabacloudStackNasZones() *schema.Resource {
 	return &schema.Resource{
-		Read: dataSourceAlibabacloudStackNasZonesRead,
+		ReadContext: withDiagnostics(dataSourceAlibabacloudStackNasZonesRead),
 		Schema: map[string]*schema.Schema{
 			"output_file": {
 				Type:     schema.TypeString,
@@ -50,7 +51,7 @@ func dataSourceAlibabacloudStackNasZones() *schema.Resource {
 	}
 }
 
-func dataSourceAlibabacloudStackNasZonesRead(d *schema.ResourceData, meta interface{}) error {
+func dataSourceAlibabacloudStackNasZonesRead(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*connectivity.AlibabacloudStackClient)
 
 	action := "DescribeZones"
@@ -1,6 +1,7 @@
 package alibabacloudstack
 
 import (
+	"context"
 	"regexp"
 	"strings"
 
@@ -13,7 +14,7 @@ import (
 
 func dataSourceAlibabacloudStackNatGateways() *schema.Resource {
 	return &schema.Resource{
-		Read: dataSourceAlibabacloudStackNatGatewaysRead,
+		ReadContext: withDiagnostics(dataSourceAlibabacloudStackNatGatewaysRead),
 
 		Schema: map[string]*schema.Schema{
 			"name_regex": {
@@ -86,7 +87,7 @@ func dataSourceAlibabacloudStackNatGateways() *schema.Resource {
 		},
 	}
 }
-func dataSourceAlibabacloudStackNatGatewaysRead(d *schema.ResourceData, meta interface{}) error {
+func dataSourceAlibabacloudStackNatGatewaysRead(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*connectivity.AlibabacloudStackClient)
 
 	request := vpc.CreateDescribeNatGatewaysRequest()
@@ -1,6 +1,7 @@
 package alibabacloudstack
 
 import (
+	"context"
 	"fmt"
 	"regexp"
 	"time"
@@ -15,7 +16,7 @@ import (
 
 func dataSourceAlibabacloudStackNetworkAcls() *schema.Resource {
 	return &schema.Resource{
-		Read: dataSourceAlibabacloudStackNetworkAclsRead,
+		ReadContext: withDiagnostics(dataSourceAlibabacloudStackNetworkAclsRead),
 		Schema: map[string]*schema.Schema{
 			"ids": {
 				Type:     schema.TypeList,
@@ -185,7 +186,7 @@ func dataSourceAlibabacloudStackNetworkAcls() *schema.Resource {
 	}
 }
 
-func dataSourceAlibabacloudStackNetworkAclsRead(d *schema.ResourceData, meta interface{}) error {
+func dataSourceAlibabacloudStackNetworkAclsRead(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*connectivity.AlibabacloudStackClient)
 
 	action := "DescribeNetworkAcls"
@@ -236,7 +237,7 @@ func dataSourceAlibabacloudStackNetworkAclsRead(d *schema.ResourceData, meta int
 		runtime := util.RuntimeOptions{}
 		runtime.SetAutoretry(true)
 		wait := incrementalWait(3*time.Second, 3*time.Second)
-		err = resource.Retry(5*time.Minute, func() *resource.RetryError {
+		err = resource.RetryContext(ctx, 5*time.Minute, func() *resource.RetryError {
 			response, err = conn.DoRequest(StringPointer(action), nil, StringPointer("POST"), StringPointer("2016-04-28"), StringPointer("AK"), nil, request, &runtime)
 			if err != nil {
 				if NeedRetry(err) {
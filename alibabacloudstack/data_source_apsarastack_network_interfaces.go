@@ -1,6 +1,7 @@
 package alibabacloudstack
 
 import (
+	"context"
 	"github.com/aliyun/alibaba-cloud-sdk-go/sdk/requests"
 	"github.com/aliyun/alibaba-cloud-sdk-go/services/ecs"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
@@ -14,7 +15,7 @@ import (
 
 func dataSourceAlibabacloudStackNetworkInterfaces() *schema.Resource {
 	return &schema.Resource{
-		Read: dataSourceAlibabacloudstackNetworkInterfacesRead,
+		ReadContext: withDiagnostics(dataSourceAlibabacloudstackNetworkInterfacesRead),
 		Schema: map[string]*schema.Schema{
 			"ids": {
 				Type:     schema.TypeSet,
@@ -136,7 +137,7 @@ func dataSourceAlibabacloudStackNetworkInterfaces() *schema.Resource {
 	}
 }
 
-func dataSourceAlibabacloudstackNetworkInterfacesRead(d *schema.ResourceData, meta interface{}) error {
+func dataSourceAlibabacloudstackNetworkInterfacesRead(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*connectivity.AlibabacloudStackClient)
 	request := ecs.CreateDescribeNetworkInterfacesRequest()
 	if strings.ToLower(client.Config.Protocol) == "https" {
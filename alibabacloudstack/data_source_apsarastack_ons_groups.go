@@ -1,6 +1,7 @@
 package alibabacloudstack
 
 import (
+	"context"
 	"encoding/json"
 	"github.com/aliyun/alibaba-cloud-sdk-go/sdk/requests"
 	"github.com/aliyun/alibaba-cloud-sdk-go/sdk/responses"
@@ -15,7 +16,7 @@ import (
 
 func dataSourceAlibabacloudStackOnsGroups() *schema.Resource {
 	return &schema.Resource{
-		Read: dataSourceAlibabacloudStackOnsGroupsRead,
+		ReadContext: withDiagnostics(dataSourceAlibabacloudStackOnsGroupsRead),
 
 		Schema: map[string]*schema.Schema{
 			"instance_id": {
@@ -80,7 +81,7 @@ func dataSourceAlibabacloudStackOnsGroups() *schema.Resource {
 	}
 }
 
-func dataSourceAlibabacloudStackOnsGroupsRead(d *schema.ResourceData, meta interface{}) error {
+func dataSourceAlibabacloudStackOnsGroupsRead(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*connectivity.AlibabacloudStackClient)
 	namespaceid := d.Get("instance_id").(string)
 
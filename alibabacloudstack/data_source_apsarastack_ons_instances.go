@@ -1,6 +1,7 @@
 package alibabacloudstack
 
 import (
+	"context"
 	"encoding/json"
 	"github.com/aliyun/alibaba-cloud-sdk-go/sdk/requests"
 	"github.com/aliyun/alibaba-cloud-sdk-go/sdk/responses"
@@ -14,7 +15,7 @@ import (
 
 func dataSourceAlibabacloudStackOnsInstances() *schema.Resource {
 	return &schema.Resource{
-		Read: dataSourceAlibabacloudStackOnsInstancesRead,
+		ReadContext: withDiagnostics(dataSourceAlibabacloudStackOnsInstancesRead),
 
 		Schema: map[string]*schema.Schema{
 			"ids": {
@@ -97,7 +98,7 @@ func dataSourceAlibabacloudStackOnsInstances() *schema.Resource {
 	}
 }
 
-func dataSourceAlibabacloudStackOnsInstancesRead(d *schema.ResourceData, meta interface{}) error {
+func dataSourceAlibabacloudStackOnsInstancesRead(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*connectivity.AlibabacloudStackClient)
 
 	request := requests.NewCommonRequest()
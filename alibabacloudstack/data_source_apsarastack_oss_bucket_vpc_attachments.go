@@ -0,0 +1,130 @@
+package alibabacloudstack
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/aliyun/terraform-provider-alibabacloudstack/alibabacloudstack/connectivity"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func dataSourceAlibabacloudStackOssBucketVpcAttachments() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: withDiagnostics(dataSourceAlibabacloudStackOssBucketVpcAttachmentsRead),
+		Schema: map[string]*schema.Schema{
+			"bucket": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"ids": {
+				Type:     schema.TypeList,
+				Optional: true,
+				ForceNew: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+				Computed: true,
+			},
+			"vpc_id": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+			},
+			"output_file": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"attachments": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"bucket": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"vpc_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"vpc_name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"vlan": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceAlibabacloudStackOssBucketVpcAttachmentsRead(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*connectivity.AlibabacloudStackClient)
+	bucketVpcService := BucketVpcService{client}
+	bucket := d.Get("bucket").(string)
+	vpclist, err := bucketVpcService.BucketVpcList(bucket)
+	if err != nil {
+		return WrapErrorf(err, DataDefaultErrorMsg, "alibabacloudstack_oss_bucket_vpc_attachments", "ListBucketVpc", AlibabacloudStackOssGoSdk)
+	}
+
+	idsMap := make(map[string]string)
+	if v, ok := d.GetOk("ids"); ok {
+		for _, vv := range v.([]interface{}) {
+			if vv == nil {
+				continue
+			}
+			idsMap[vv.(string)] = vv.(string)
+		}
+	}
+	vpcId := d.Get("vpc_id").(string)
+
+	ids := make([]string, 0)
+	s := make([]map[string]interface{}, 0)
+	for _, v := range vpclist.VpcList {
+		vpc, ok := v.(map[string]interface{})
+		if !ok {
+			continue
+		}
+		id := fmt.Sprintf("%s%s%s", bucket, COLON_SEPARATED, fmt.Sprint(vpc["vpcId"]))
+		if len(idsMap) > 0 {
+			if _, ok := idsMap[id]; !ok {
+				continue
+			}
+		}
+		if vpcId != "" && vpcId != fmt.Sprint(vpc["vpcId"]) {
+			continue
+		}
+		mapping := map[string]interface{}{
+			"id":     id,
+			"bucket": bucket,
+			"vpc_id": fmt.Sprint(vpc["vpcId"]),
+		}
+		if v, ok := vpc["vpcName"]; ok {
+			mapping["vpc_name"] = fmt.Sprint(v)
+		}
+		if v, ok := vpc["vLan"]; ok {
+			mapping["vlan"] = fmt.Sprint(v)
+		}
+		ids = append(ids, id)
+		s = append(s, mapping)
+	}
+
+	d.SetId(dataResourceIdHash(ids))
+	if err := d.Set("ids", ids); err != nil {
+		return WrapError(err)
+	}
+	if err := d.Set("attachments", s); err != nil {
+		return WrapError(err)
+	}
+	if output, ok := d.GetOk("output_file"); ok && output.(string) != "" {
+		writeToFile(output.(string), s)
+	}
+	return nil
+}
@@ -0,0 +1,71 @@
+package alibabacloudstack
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+)
+
+func TestAccAlibabacloudStackOssBucketVpcAttachmentsDataSource(t *testing.T) {
+	rand := acctest.RandIntRange(1000000, 9999999)
+	resourceId := "data.alibabacloudstack_oss_bucket_vpc_attachments.default"
+	name := fmt.Sprintf("tf-testacc-bucket-vpcs-%d", rand)
+	testAccConfig := dataSourceTestAccConfigFunc(resourceId, name, dataSourceOssBucketVpcAttachmentsConfigDependence)
+
+	idsConf := dataSourceTestAccConfig{
+		existConfig: testAccConfig(map[string]interface{}{
+			"bucket": "${alibabacloudstack_oss_bucket_vpc_attachment.default.bucket}",
+			"ids":    []string{"${alibabacloudstack_oss_bucket_vpc_attachment.default.id}"},
+		}),
+		fakeConfig: testAccConfig(map[string]interface{}{
+			"bucket": "${alibabacloudstack_oss_bucket_vpc_attachment.default.bucket}",
+			"ids":    []string{"${alibabacloudstack_oss_bucket_vpc_attachment.default.id}_fake"},
+		}),
+	}
+	vpcIdConf := dataSourceTestAccConfig{
+		existConfig: testAccConfig(map[string]interface{}{
+			"bucket": "${alibabacloudstack_oss_bucket_vpc_attachment.default.bucket}",
+			"vpc_id": "${alibabacloudstack_oss_bucket_vpc_attachment.default.vpc_id}",
+		}),
+		fakeConfig: testAccConfig(map[string]interface{}{
+			"bucket": "${alibabacloudstack_oss_bucket_vpc_attachment.default.bucket}",
+			"vpc_id": "${alibabacloudstack_oss_bucket_vpc_attachment.default.vpc_id}_fake",
+		}),
+	}
+
+	var existMapFunc = func(rand int) map[string]string {
+		return map[string]string{
+			"ids.#":                  "1",
+			"attachments.#":          "1",
+			"attachments.0.id":       CHECKSET,
+			"attachments.0.bucket":   name,
+			"attachments.0.vpc_id":   CHECKSET,
+			"attachments.0.vpc_name": CHECKSET,
+			"attachments.0.vlan":     CHECKSET,
+		}
+	}
+	var fakeMapFunc = func(rand int) map[string]string {
+		return map[string]string{
+			"ids.#":         "0",
+			"attachments.#": "0",
+		}
+	}
+	var checkInfo = dataSourceAttr{
+		resourceId:   resourceId,
+		existMapFunc: existMapFunc,
+		fakeMapFunc:  fakeMapFunc,
+	}
+	checkInfo.dataSourceTestCheck(t, rand, idsConf, vpcIdConf)
+}
+
+func dataSourceOssBucketVpcAttachmentsConfigDependence(name string) string {
+	return fmt.Sprintf(`
+%s
+
+resource "alibabacloudstack_oss_bucket_vpc_attachment" "default" {
+  bucket = "${alibabacloudstack_oss_bucket.default.bucket}"
+  vpc_id = "${alibabacloudstack_vpc.default.id}"
+}
+`, resourceOssBucketVpcAttachmentConfigDependence(name))
+}
@@ -1,6 +1,7 @@
 package alibabacloudstack
 
 import (
+	"context"
 	"regexp"
 
 	"github.com/aliyun/alibaba-cloud-sdk-go/services/ots"
@@ -11,7 +12,7 @@ import (
 
 func dataSourceAlibabacloudStackOtsInstanceAttachments() *schema.Resource {
 	return &schema.Resource{
-		Read: dataSourceAlibabacloudStackOtsInstanceAttachmentsRead,
+		ReadContext: withDiagnostics(dataSourceAlibabacloudStackOtsInstanceAttachmentsRead),
 
 		Schema: map[string]*schema.Schema{
 			"instance_name": {
@@ -79,7 +80,7 @@ func dataSourceAlibabacloudStackOtsInstanceAttachments() *schema.Resource {
 	}
 }
 
-func dataSourceAlibabacloudStackOtsInstanceAttachmentsRead(d *schema.ResourceData, meta interface{}) error {
+func dataSourceAlibabacloudStackOtsInstanceAttachmentsRead(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*connectivity.AlibabacloudStackClient)
 	otsService := OtsService{client}
 	instanceName := d.Get("instance_name").(string)
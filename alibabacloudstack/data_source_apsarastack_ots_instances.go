@@ -1,6 +1,7 @@
 package alibabacloudstack
 
 import (
+	"context"
 	"regexp"
 
 	"github.com/aliyun/alibaba-cloud-sdk-go/services/ots"
@@ -11,7 +12,7 @@ import (
 
 func dataSourceAlibabacloudStackOtsInstances() *schema.Resource {
 	return &schema.Resource{
-		Read: dataSourceAlibabacloudStackOtsInstancesRead,
+		ReadContext: withDiagnostics(dataSourceAlibabacloudStackOtsInstancesRead),
 
 		Schema: map[string]*schema.Schema{
 			"ids": {
@@ -97,7 +98,7 @@ func dataSourceAlibabacloudStackOtsInstances() *schema.Resource {
 	}
 }
 
-func dataSourceAlibabacloudStackOtsInstancesRead(d *schema.ResourceData, meta interface{}) error {
+func dataSourceAlibabacloudStackOtsInstancesRead(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*connectivity.AlibabacloudStackClient)
 	otsService := OtsService{client}
 
@@ -1,6 +1,7 @@
 package alibabacloudstack
 
 import (
+	"context"
 	"fmt"
 	"time"
 
@@ -14,7 +15,7 @@ import (
 
 func dataSourceAlibabacloudStackOtsService() *schema.Resource {
 	return &schema.Resource{
-		Read: dataSourceAlibabacloudStackOtsServiceRead,
+		ReadContext: withDiagnostics(dataSourceAlibabacloudStackOtsServiceRead),
 
 		Schema: map[string]*schema.Schema{
 			"enable": {
@@ -30,7 +31,7 @@ func dataSourceAlibabacloudStackOtsService() *schema.Resource {
 		},
 	}
 }
-func dataSourceAlibabacloudStackOtsServiceRead(d *schema.ResourceData, meta interface{}) error {
+func dataSourceAlibabacloudStackOtsServiceRead(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 	if v, ok := d.GetOk("enable"); !ok || v.(string) != "On" {
 		d.SetId("OtsServicHasNotBeenOpened")
 		d.Set("status", "")
@@ -42,7 +43,7 @@ func dataSourceAlibabacloudStackOtsServiceRead(d *schema.ResourceData, meta inte
 		return WrapError(err)
 	}
 	action := "OpenOtsService"
-	err = resource.Retry(5*time.Minute, func() *resource.RetryError {
+	err = resource.RetryContext(ctx, 5*time.Minute, func() *resource.RetryError {
 		response, err := conn.DoRequest(StringPointer(action), nil, StringPointer("POST"), StringPointer("2016-06-20"), StringPointer("AK"), nil, nil, &util.RuntimeOptions{})
 		if err != nil {
 			if NeedRetry(err) {
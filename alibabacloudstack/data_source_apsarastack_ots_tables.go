@@ -1,6 +1,7 @@
 package alibabacloudstack
 
 import (
+	"context"
 	"fmt"
 
 	"regexp"
@@ -13,7 +14,7 @@ import (
 
 func dataSourceAlibabacloudStackOtsTables() *schema.Resource {
 	return &schema.Resource{
-		Read: dataSourceAlibabacloudStackOtsTablesRead,
+		ReadContext: withDiagnostics(dataSourceAlibabacloudStackOtsTablesRead),
 
 		Schema: map[string]*schema.Schema{
 			"instance_name": {
@@ -103,12 +104,12 @@ type OtsTableInfo struct {
 	maxVersion   int
 }
 
-func dataSourceAlibabacloudStackOtsTablesRead(d *schema.ResourceData, meta interface{}) error {
+func dataSourceAlibabacloudStackOtsTablesRead(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*connectivity.AlibabacloudStackClient)
 	otsService := OtsService{client}
 	instanceName := d.Get("instance_name").(string)
 
-	object, err := otsService.ListOtsTable(instanceName)
+	object, err := otsService.ListOtsTable(ctx, instanceName)
 	if err != nil {
 		return WrapError(err)
 	}
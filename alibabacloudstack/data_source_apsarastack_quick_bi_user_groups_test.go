@@ -0,0 +1,71 @@
+package alibabacloudstack
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+)
+
+func TestAccAlibabacloudStackQuickBiUserGroupsDataSource(t *testing.T) {
+	rand := acctest.RandIntRange(10000, 99999)
+	name := fmt.Sprintf("tf-testacc%squickbiusergroups%d", defaultRegionToTest, rand)
+	resourceId := "data.alibabacloudstack_quick_bi_user_groups.default"
+	testAccConfig := dataSourceTestAccConfigFunc(resourceId, name, dataSourceQuickBiUserGroupsConfigDependence)
+
+	idsConf := dataSourceTestAccConfig{
+		existConfig: testAccConfig(map[string]interface{}{
+			"ids": []string{"${alibabacloudstack_quick_bi_user_group.default.id}"},
+		}),
+		fakeConfig: testAccConfig(map[string]interface{}{
+			"ids": []string{"${alibabacloudstack_quick_bi_user_group.default.id}_fake"},
+		}),
+	}
+	nameRegexConf := dataSourceTestAccConfig{
+		existConfig: testAccConfig(map[string]interface{}{
+			"name_regex": "${alibabacloudstack_quick_bi_user_group.default.user_group_name}",
+		}),
+		fakeConfig: testAccConfig(map[string]interface{}{
+			"name_regex": "${alibabacloudstack_quick_bi_user_group.default.user_group_name}_fake",
+		}),
+	}
+
+	var existQuickBiUserGroupsMapFunc = func(rand int) map[string]string {
+		return map[string]string{
+			"ids.#":                           "1",
+			"names.#":                         "1",
+			"groups.#":                        "1",
+			"groups.0.id":                     CHECKSET,
+			"groups.0.user_group_id":          CHECKSET,
+			"groups.0.user_group_name":        name,
+			"groups.0.user_group_description": name,
+			"groups.0.parent_user_group_id":   CHECKSET,
+		}
+	}
+
+	var fakeQuickBiUserGroupsMapFunc = func(rand int) map[string]string {
+		return map[string]string{
+			"ids.#":    "0",
+			"names.#":  "0",
+			"groups.#": "0",
+		}
+	}
+
+	var quickBiUserGroupsCheckInfo = dataSourceAttr{
+		resourceId:   resourceId,
+		existMapFunc: existQuickBiUserGroupsMapFunc,
+		fakeMapFunc:  fakeQuickBiUserGroupsMapFunc,
+	}
+
+	quickBiUserGroupsCheckInfo.dataSourceTestCheck(t, rand, idsConf, nameRegexConf)
+}
+
+func dataSourceQuickBiUserGroupsConfigDependence(name string) string {
+	return AlicloudQuickBIUserGroupBasicDependence0(name) + `
+resource "alibabacloudstack_quick_bi_user_group" "default" {
+  user_group_name        = var.name
+  user_group_description = var.name
+  parent_user_group_id   = "-1"
+}
+`
+}
@@ -1,6 +1,7 @@
 package alibabacloudstack
 
 import (
+	"context"
 	"fmt"
 	"time"
 
@@ -13,7 +14,7 @@ import (
 
 func dataSourceAlibabacloudStackQuickBiUsers() *schema.Resource {
 	return &schema.Resource{
-		Read: dataSourceAlibabacloudStackQuickBiUsersRead,
+		ReadContext: withDiagnostics(dataSourceAlibabacloudStackQuickBiUsersRead),
 		Schema: map[string]*schema.Schema{
 			"keyword": {
 				Type:     schema.TypeString,
@@ -88,7 +89,7 @@ func dataSourceAlibabacloudStackQuickBiUsers() *schema.Resource {
 	}
 }
 
-func dataSourceAlibabacloudStackQuickBiUsersRead(d *schema.ResourceData, meta interface{}) error {
+func dataSourceAlibabacloudStackQuickBiUsersRead(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*connectivity.AlibabacloudStackClient)
 
 	action := "QueryUserList"
@@ -118,7 +119,7 @@ func dataSourceAlibabacloudStackQuickBiUsersRead(d *schema.ResourceData, meta in
 		runtime := util.RuntimeOptions{}
 		runtime.SetAutoretry(true)
 		wait := incrementalWait(3*time.Second, 3*time.Second)
-		err = resource.Retry(5*time.Minute, func() *resource.RetryError {
+		err = resource.RetryContext(ctx, 5*time.Minute, func() *resource.RetryError {
 			response, err = conn.DoRequest(StringPointer(action), nil, StringPointer("GET"), StringPointer("2022-03-01"), StringPointer("AK"), request, nil, &runtime)
 			if err != nil {
 				if NeedRetry(err) {
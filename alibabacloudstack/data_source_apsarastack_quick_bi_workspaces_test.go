@@ -0,0 +1,82 @@
+package alibabacloudstack
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+)
+
+func TestAccAlibabacloudStackQuickBiWorkspacesDataSource(t *testing.T) {
+	rand := acctest.RandIntRange(10000, 99999)
+	name := fmt.Sprintf("tf-testacc%squickbiworkspaces%d", defaultRegionToTest, rand)
+	resourceId := "data.alibabacloudstack_quick_bi_workspaces.default"
+	testAccConfig := dataSourceTestAccConfigFunc(resourceId, name, dataSourceQuickBiWorkspacesConfigDependence)
+
+	idsConf := dataSourceTestAccConfig{
+		existConfig: testAccConfig(map[string]interface{}{
+			"ids": []string{"${alibabacloudstack_quick_bi_workspace.default.id}"},
+		}),
+		fakeConfig: testAccConfig(map[string]interface{}{
+			"ids": []string{"${alibabacloudstack_quick_bi_workspace.default.id}_fake"},
+		}),
+	}
+	nameRegexConf := dataSourceTestAccConfig{
+		existConfig: testAccConfig(map[string]interface{}{
+			"name_regex": "${alibabacloudstack_quick_bi_workspace.default.workspace_name}",
+		}),
+		fakeConfig: testAccConfig(map[string]interface{}{
+			"name_regex": "${alibabacloudstack_quick_bi_workspace.default.workspace_name}_fake",
+		}),
+	}
+	keywordConf := dataSourceTestAccConfig{
+		existConfig: testAccConfig(map[string]interface{}{
+			"keyword": "${alibabacloudstack_quick_bi_workspace.default.workspace_name}",
+		}),
+		fakeConfig: testAccConfig(map[string]interface{}{
+			"keyword": "${alibabacloudstack_quick_bi_workspace.default.workspace_name}_fake",
+		}),
+	}
+
+	var existQuickBiWorkspacesMapFunc = func(rand int) map[string]string {
+		return map[string]string{
+			"ids.#":                       "1",
+			"names.#":                     "1",
+			"workspaces.#":                "1",
+			"workspaces.0.id":             CHECKSET,
+			"workspaces.0.workspace_id":   CHECKSET,
+			"workspaces.0.workspace_name": name,
+			"workspaces.0.workspace_desc": "desc-" + name,
+			"workspaces.0.allow_share":    "false",
+			"workspaces.0.allow_publish":  "false",
+		}
+	}
+
+	var fakeQuickBiWorkspacesMapFunc = func(rand int) map[string]string {
+		return map[string]string{
+			"ids.#":        "0",
+			"names.#":      "0",
+			"workspaces.#": "0",
+		}
+	}
+
+	var quickBiWorkspacesCheckInfo = dataSourceAttr{
+		resourceId:   resourceId,
+		existMapFunc: existQuickBiWorkspacesMapFunc,
+		fakeMapFunc:  fakeQuickBiWorkspacesMapFunc,
+	}
+
+	quickBiWorkspacesCheckInfo.dataSourceTestCheck(t, rand, idsConf, nameRegexConf, keywordConf)
+}
+
+func dataSourceQuickBiWorkspacesConfigDependence(name string) string {
+	return AlicloudQuickBIWorkspaceBasicDependence0(name) + `
+resource "alibabacloudstack_quick_bi_workspace" "default" {
+  workspace_name = var.name
+  workspace_desc = "desc-${var.name}"
+  use_comment    = false
+  allow_share    = false
+  allow_publish  = false
+}
+`
+}
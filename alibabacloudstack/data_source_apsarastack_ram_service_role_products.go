@@ -1,6 +1,7 @@
 package alibabacloudstack
 
 import (
+	"context"
 	"encoding/json"
 	"github.com/aliyun/alibaba-cloud-sdk-go/sdk/requests"
 	"github.com/aliyun/alibaba-cloud-sdk-go/sdk/responses"
@@ -15,7 +16,7 @@ import (
 
 func dataSourceAlibabacloudstackRamServiceRoleProducts() *schema.Resource {
 	return &schema.Resource{
-		Read: dataSourceAlibabacloudstackRamServiceRoleProductsRead,
+		ReadContext: withDiagnostics(dataSourceAlibabacloudstackRamServiceRoleProductsRead),
 		Schema: map[string]*schema.Schema{
 			"name_regex": {
 				Type:         schema.TypeString,
@@ -50,7 +51,7 @@ func dataSourceAlibabacloudstackRamServiceRoleProducts() *schema.Resource {
 	}
 }
 
-func dataSourceAlibabacloudstackRamServiceRoleProductsRead(d *schema.ResourceData, meta interface{}) error {
+func dataSourceAlibabacloudstackRamServiceRoleProductsRead(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*connectivity.AlibabacloudStackClient)
 
 	request := requests.NewCommonRequest()
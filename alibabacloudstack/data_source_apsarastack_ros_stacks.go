@@ -1,6 +1,7 @@
 package alibabacloudstack
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
 	"regexp"
@@ -14,7 +15,7 @@ import (
 
 func dataSourceAlibabacloudStackRosStacks() *schema.Resource {
 	return &schema.Resource{
-		Read: dataSourceAlibabacloudStackRosStacksRead,
+		ReadContext: withDiagnostics(dataSourceAlibabacloudStackRosStacksRead),
 		Schema: map[string]*schema.Schema{
 			"parent_stack_id": {
 				Type:     schema.TypeString,
@@ -161,7 +162,7 @@ func dataSourceAlibabacloudStackRosStacks() *schema.Resource {
 	}
 }
 
-func dataSourceAlibabacloudStackRosStacksRead(d *schema.ResourceData, meta interface{}) error {
+func dataSourceAlibabacloudStackRosStacksRead(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*connectivity.AlibabacloudStackClient)
 
 	action := "ListStacks"
@@ -304,7 +305,7 @@ func dataSourceAlibabacloudStackRosStacksRead(d *schema.ResourceData, meta inter
 		b, err := json.Marshal(getResp1["StackPolicyBody"])
 		mapping["stack_policy_body"] = string(b)
 
-		getResp2, err := rosService.ListTagResources(id, "stack")
+		getResp2, err := rosService.ListTagResources(ctx, id, "stack")
 		if err != nil {
 			return WrapError(err)
 		}
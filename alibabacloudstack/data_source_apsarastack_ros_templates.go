@@ -1,6 +1,7 @@
 package alibabacloudstack
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
 	"regexp"
@@ -14,7 +15,7 @@ import (
 
 func dataSourceAlibabacloudStackRosTemplates() *schema.Resource {
 	return &schema.Resource{
-		Read: dataSourceAlibabacloudStackRosTemplatesRead,
+		ReadContext: withDiagnostics(dataSourceAlibabacloudStackRosTemplatesRead),
 		Schema: map[string]*schema.Schema{
 			"share_type": {
 				Type:         schema.TypeString,
@@ -111,7 +112,7 @@ func dataSourceAlibabacloudStackRosTemplates() *schema.Resource {
 	}
 }
 
-func dataSourceAlibabacloudStackRosTemplatesRead(d *schema.ResourceData, meta interface{}) error {
+func dataSourceAlibabacloudStackRosTemplatesRead(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*connectivity.AlibabacloudStackClient)
 
 	action := "ListTemplates"
@@ -226,7 +227,7 @@ func dataSourceAlibabacloudStackRosTemplatesRead(d *schema.ResourceData, meta in
 		}
 		mapping["template_body"] = string(b)
 
-		getResp1, err := rosService.ListTagResources(id, "template")
+		getResp1, err := rosService.ListTagResources(ctx, id, "template")
 		if err != nil {
 			return WrapError(err)
 		}
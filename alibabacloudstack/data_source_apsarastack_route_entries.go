@@ -1,6 +1,7 @@
 package alibabacloudstack
 
 import (
+	"context"
 	"github.com/aliyun/alibaba-cloud-sdk-go/sdk/requests"
 	"github.com/aliyun/alibaba-cloud-sdk-go/services/vpc"
 	"github.com/aliyun/terraform-provider-alibabacloudstack/alibabacloudstack/connectivity"
@@ -10,7 +11,7 @@ import (
 
 func dataSourceAlibabacloudStackRouteEntries() *schema.Resource {
 	return &schema.Resource{
-		Read: dataSourceAlibabacloudStackRouteEntriesRead,
+		ReadContext: withDiagnostics(dataSourceAlibabacloudStackRouteEntriesRead),
 		Schema: map[string]*schema.Schema{
 			"route_table_id": {
 				Type:     schema.TypeString,
@@ -68,7 +69,7 @@ func dataSourceAlibabacloudStackRouteEntries() *schema.Resource {
 		},
 	}
 }
-func dataSourceAlibabacloudStackRouteEntriesRead(d *schema.ResourceData, meta interface{}) error {
+func dataSourceAlibabacloudStackRouteEntriesRead(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*connectivity.AlibabacloudStackClient)
 	request := vpc.CreateDescribeRouteTablesRequest()
 	if strings.ToLower(client.Config.Protocol) == "https" {
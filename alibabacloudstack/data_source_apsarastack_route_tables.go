@@ -1,6 +1,7 @@
 package alibabacloudstack
 
 import (
+	"context"
 	"regexp"
 	"strings"
 
@@ -13,7 +14,7 @@ import (
 
 func dataSourceAlibabacloudStackRouteTables() *schema.Resource {
 	return &schema.Resource{
-		Read: dataSourceAlibabacloudStackRouteTablesRead,
+		ReadContext: withDiagnostics(dataSourceAlibabacloudStackRouteTablesRead),
 
 		Schema: map[string]*schema.Schema{
 			"name_regex": {
@@ -79,7 +80,7 @@ func dataSourceAlibabacloudStackRouteTables() *schema.Resource {
 		},
 	}
 }
-func dataSourceAlibabacloudStackRouteTablesRead(d *schema.ResourceData, meta interface{}) error {
+func dataSourceAlibabacloudStackRouteTablesRead(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*connectivity.AlibabacloudStackClient)
 	vpcService := VpcService{client}
 
@@ -144,7 +145,7 @@ func dataSourceAlibabacloudStackRouteTablesRead(d *schema.ResourceData, meta int
 				}
 			}
 			if value, ok := d.GetOk("tags"); ok && len(value.(map[string]interface{})) > 0 {
-				tags, err := vpcService.DescribeTags(tables.RouteTableId, value.(map[string]interface{}), TagResourceRouteTable)
+				tags, err := vpcService.DescribeTags(ctx, tables.RouteTableId, value.(map[string]interface{}), TagResourceRouteTable)
 				if err != nil {
 					return WrapError(err)
 				}
@@ -1,6 +1,7 @@
 package alibabacloudstack
 
 import (
+	"context"
 	"regexp"
 	"strings"
 
@@ -13,7 +14,7 @@ import (
 
 func dataSourceAlibabacloudStackRouterInterfaces() *schema.Resource {
 	return &schema.Resource{
-		Read: dataSourceAlibabacloudStackRouterInterfacesRead,
+		ReadContext: withDiagnostics(dataSourceAlibabacloudStackRouterInterfacesRead),
 
 		Schema: map[string]*schema.Schema{
 			"status": {
@@ -160,7 +161,7 @@ func dataSourceAlibabacloudStackRouterInterfaces() *schema.Resource {
 		},
 	}
 }
-func dataSourceAlibabacloudStackRouterInterfacesRead(d *schema.ResourceData, meta interface{}) error {
+func dataSourceAlibabacloudStackRouterInterfacesRead(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*connectivity.AlibabacloudStackClient)
 
 	request := vpc.CreateDescribeRouterInterfacesRequest()
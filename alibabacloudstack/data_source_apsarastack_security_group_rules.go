@@ -1,6 +1,7 @@
 package alibabacloudstack
 
 import (
+	"context"
 	"strconv"
 	"strings"
 
@@ -12,7 +13,7 @@ import (
 
 func dataSourceAlibabacloudStackSecurityGroupRules() *schema.Resource {
 	return &schema.Resource{
-		Read: dataSourceAlibabacloudStackSecurityGroupRulesRead,
+		ReadContext: withDiagnostics(dataSourceAlibabacloudStackSecurityGroupRulesRead),
 
 		Schema: map[string]*schema.Schema{
 			"group_id": {
@@ -119,7 +120,7 @@ func dataSourceAlibabacloudStackSecurityGroupRules() *schema.Resource {
 	}
 }
 
-func dataSourceAlibabacloudStackSecurityGroupRulesRead(d *schema.ResourceData, meta interface{}) error {
+func dataSourceAlibabacloudStackSecurityGroupRulesRead(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*connectivity.AlibabacloudStackClient)
 
 	req := ecs.CreateDescribeSecurityGroupAttributeRequest()
@@ -1,6 +1,7 @@
 package alibabacloudstack
 
 import (
+	"context"
 	"regexp"
 	"strings"
 
@@ -20,7 +21,7 @@ type SecurityGroup struct {
 
 func dataSourceAlibabacloudStackSecurityGroups() *schema.Resource {
 	return &schema.Resource{
-		Read: dataSourceAlibabacloudStackSecurityGroupsRead,
+		ReadContext: withDiagnostics(dataSourceAlibabacloudStackSecurityGroupsRead),
 
 		Schema: map[string]*schema.Schema{
 			"name_regex": {
@@ -83,7 +84,7 @@ func dataSourceAlibabacloudStackSecurityGroups() *schema.Resource {
 	}
 }
 
-func dataSourceAlibabacloudStackSecurityGroupsRead(d *schema.ResourceData, meta interface{}) error {
+func dataSourceAlibabacloudStackSecurityGroupsRead(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*connectivity.AlibabacloudStackClient)
 	ecsService := EcsService{client}
 
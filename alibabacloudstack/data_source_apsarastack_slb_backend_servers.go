@@ -1,6 +1,7 @@
 package alibabacloudstack
 
 import (
+	"context"
 	"github.com/aliyun/alibaba-cloud-sdk-go/services/slb"
 	"github.com/aliyun/terraform-provider-alibabacloudstack/alibabacloudstack/connectivity"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
@@ -9,7 +10,7 @@ import (
 
 func dataSourceAlibabacloudStackSlbBackendServers() *schema.Resource {
 	return &schema.Resource{
-		Read: dataSourceAlibabacloudStackSlbBackendServersRead,
+		ReadContext: withDiagnostics(dataSourceAlibabacloudStackSlbBackendServersRead),
 
 		Schema: map[string]*schema.Schema{
 			"load_balancer_id": {
@@ -48,7 +49,7 @@ func dataSourceAlibabacloudStackSlbBackendServers() *schema.Resource {
 	}
 }
 
-func dataSourceAlibabacloudStackSlbBackendServersRead(d *schema.ResourceData, meta interface{}) error {
+func dataSourceAlibabacloudStackSlbBackendServersRead(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*connectivity.AlibabacloudStackClient)
 
 	request := slb.CreateDescribeLoadBalancerAttributeRequest()
@@ -1,6 +1,7 @@
 package alibabacloudstack
 
 import (
+	"context"
 	"github.com/aliyun/alibaba-cloud-sdk-go/sdk/requests"
 	"github.com/aliyun/alibaba-cloud-sdk-go/services/slb"
 	"github.com/aliyun/terraform-provider-alibabacloudstack/alibabacloudstack/connectivity"
@@ -10,7 +11,7 @@ import (
 
 func dataSourceAlibabacloudStackSlbDomainExtensions() *schema.Resource {
 	return &schema.Resource{
-		Read: dataSourceAlibabacloudStackSlbDomainExtensionsRead,
+		ReadContext: withDiagnostics(dataSourceAlibabacloudStackSlbDomainExtensionsRead),
 		Schema: map[string]*schema.Schema{
 			"ids": {
 				Type:     schema.TypeList,
@@ -56,7 +57,7 @@ func dataSourceAlibabacloudStackSlbDomainExtensions() *schema.Resource {
 	}
 }
 
-func dataSourceAlibabacloudStackSlbDomainExtensionsRead(d *schema.ResourceData, meta interface{}) error {
+func dataSourceAlibabacloudStackSlbDomainExtensionsRead(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*connectivity.AlibabacloudStackClient)
 
 	request := slb.CreateDescribeDomainExtensionsRequest()
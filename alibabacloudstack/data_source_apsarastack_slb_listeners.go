@@ -1,6 +1,7 @@
 package alibabacloudstack
 
 import (
+	"context"
 	"strconv"
 	"strings"
 
@@ -15,7 +16,7 @@ import (
 
 func dataSourceAlibabacloudStackSlbListeners() *schema.Resource {
 	return &schema.Resource{
-		Read: dataSourceAlibabacloudStackSlbListenersRead,
+		ReadContext: withDiagnostics(dataSourceAlibabacloudStackSlbListenersRead),
 
 		Schema: map[string]*schema.Schema{
 			"load_balancer_id": {
@@ -187,7 +188,7 @@ func dataSourceAlibabacloudStackSlbListeners() *schema.Resource {
 	}
 }
 
-func dataSourceAlibabacloudStackSlbListenersRead(d *schema.ResourceData, meta interface{}) error {
+func dataSourceAlibabacloudStackSlbListenersRead(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*connectivity.AlibabacloudStackClient)
 
 	request := slb.CreateDescribeLoadBalancerAttributeRequest()
@@ -1,6 +1,7 @@
 package alibabacloudstack
 
 import (
+	"context"
 	"regexp"
 	"strings"
 
@@ -12,7 +13,7 @@ import (
 
 func dataSourceAlibabacloudStackSlbMasterSlaveServerGroups() *schema.Resource {
 	return &schema.Resource{
-		Read: dataSourceAlibabacloudStackSlbMasterSlaveServerGroupsRead,
+		ReadContext: withDiagnostics(dataSourceAlibabacloudStackSlbMasterSlaveServerGroupsRead),
 
 		Schema: map[string]*schema.Schema{
 			"load_balancer_id": {
@@ -87,7 +88,7 @@ func dataSourceAlibabacloudStackSlbMasterSlaveServerGroups() *schema.Resource {
 	}
 }
 
-func dataSourceAlibabacloudStackSlbMasterSlaveServerGroupsRead(d *schema.ResourceData, meta interface{}) error {
+func dataSourceAlibabacloudStackSlbMasterSlaveServerGroupsRead(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*connectivity.AlibabacloudStackClient)
 
 	request := slb.CreateDescribeMasterSlaveServerGroupsRequest()
@@ -1,6 +1,7 @@
 package alibabacloudstack
 
 import (
+	"context"
 	"regexp"
 	"strings"
 
@@ -13,7 +14,7 @@ import (
 
 func dataSourceAlibabacloudStackSlbRules() *schema.Resource {
 	return &schema.Resource{
-		Read: dataSourceAlibabacloudStackSlbRulesRead,
+		ReadContext: withDiagnostics(dataSourceAlibabacloudStackSlbRulesRead),
 
 		Schema: map[string]*schema.Schema{
 			"load_balancer_id": {
@@ -80,7 +81,7 @@ func dataSourceAlibabacloudStackSlbRules() *schema.Resource {
 	}
 }
 
-func dataSourceAlibabacloudStackSlbRulesRead(d *schema.ResourceData, meta interface{}) error {
+func dataSourceAlibabacloudStackSlbRulesRead(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*connectivity.AlibabacloudStackClient)
 
 	request := slb.CreateDescribeRulesRequest()
@@ -1,6 +1,7 @@
 package alibabacloudstack
 
 import (
+	"context"
 	"regexp"
 	"strings"
 
@@ -12,7 +13,7 @@ import (
 
 func dataSourceAlibabacloudStackSlbServerCertificates() *schema.Resource {
 	return &schema.Resource{
-		Read: dataSourceAlibabacloudStackSlbServerCertificatesRead,
+		ReadContext: withDiagnostics(dataSourceAlibabacloudStackSlbServerCertificatesRead),
 
 		Schema: map[string]*schema.Schema{
 			"output_file": {
@@ -71,7 +72,7 @@ func dataSourceAlibabacloudStackSlbServerCertificates() *schema.Resource {
 	}
 }
 
-func dataSourceAlibabacloudStackSlbServerCertificatesRead(d *schema.ResourceData, meta interface{}) error {
+func dataSourceAlibabacloudStackSlbServerCertificatesRead(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*connectivity.AlibabacloudStackClient)
 
 	request := slb.CreateDescribeServerCertificatesRequest()
@@ -1,6 +1,7 @@
 package alibabacloudstack
 
 import (
+	"context"
 	"regexp"
 	"strings"
 
@@ -12,7 +13,7 @@ import (
 
 func dataSourceAlibabacloudStackSlbServerGroups() *schema.Resource {
 	return &schema.Resource{
-		Read: dataSourceAlibabacloudStackSlbServerGroupsRead,
+		ReadContext: withDiagnostics(dataSourceAlibabacloudStackSlbServerGroupsRead),
 
 		Schema: map[string]*schema.Schema{
 			"load_balancer_id": {
@@ -83,7 +84,7 @@ func dataSourceAlibabacloudStackSlbServerGroups() *schema.Resource {
 	}
 }
 
-func dataSourceAlibabacloudStackSlbServerGroupsRead(d *schema.ResourceData, meta interface{}) error {
+func dataSourceAlibabacloudStackSlbServerGroupsRead(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*connectivity.AlibabacloudStackClient)
 
 	request := slb.CreateDescribeVServerGroupsRequest()
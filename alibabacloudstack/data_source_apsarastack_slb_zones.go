@@ -1,6 +1,7 @@
 package alibabacloudstack
 
 import (
+	"context"
 	"sort"
 	"strings"
 
@@ -11,7 +12,7 @@ import (
 
 func dataSourceAlibabacloudStackSlbZones() *schema.Resource {
 	return &schema.Resource{
-		Read: dataSourceAlibabacloudStackSlbZonesRead,
+		ReadContext: withDiagnostics(dataSourceAlibabacloudStackSlbZonesRead),
 
 		Schema: map[string]*schema.Schema{
 			"output_file": {
@@ -54,7 +55,7 @@ func dataSourceAlibabacloudStackSlbZones() *schema.Resource {
 	}
 }
 
-func dataSourceAlibabacloudStackSlbZonesRead(d *schema.ResourceData, meta interface{}) error {
+func dataSourceAlibabacloudStackSlbZonesRead(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*connectivity.AlibabacloudStackClient)
 	slaveZones := make(map[string][]string)
 	localName := make(map[string][]string)
@@ -1,6 +1,7 @@
 package alibabacloudstack
 
 import (
+	"context"
 	"encoding/json"
 	"github.com/aliyun/alibaba-cloud-sdk-go/sdk/requests"
 	"github.com/aliyun/alibaba-cloud-sdk-go/services/slb"
@@ -14,7 +15,7 @@ import (
 
 func dataSourceAlibabacloudStackSlbs() *schema.Resource {
 	return &schema.Resource{
-		Read: dataSourceAlibabacloudStackSlbsRead,
+		ReadContext: withDiagnostics(dataSourceAlibabacloudStackSlbsRead),
 
 		Schema: map[string]*schema.Schema{
 			"ids": {
@@ -133,7 +134,7 @@ func dataSourceAlibabacloudStackSlbs() *schema.Resource {
 	}
 }
 
-func dataSourceAlibabacloudStackSlbsRead(d *schema.ResourceData, meta interface{}) error {
+func dataSourceAlibabacloudStackSlbsRead(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*connectivity.AlibabacloudStackClient)
 	slbService := &SlbService{client}
 
@@ -242,15 +243,15 @@ func dataSourceAlibabacloudStackSlbsRead(d *schema.ResourceData, meta interface{
 		filteredLoadBalancersTemp = allLoadBalancers
 	}
 
-	return slbsDescriptionAttributes(d, filteredLoadBalancersTemp, slbService)
+	return slbsDescriptionAttributes(ctx, d, filteredLoadBalancersTemp, slbService)
 }
 
-func slbsDescriptionAttributes(d *schema.ResourceData, loadBalancers []slb.LoadBalancer, slbService *SlbService) error {
+func slbsDescriptionAttributes(ctx context.Context, d *schema.ResourceData, loadBalancers []slb.LoadBalancer, slbService *SlbService) error {
 	var ids []string
 	var names []string
 	var s []map[string]interface{}
 	for _, loadBalancer := range loadBalancers {
-		tags, _ := slbService.DescribeTags(loadBalancer.LoadBalancerId, nil, TagResourceInstance)
+		tags, _ := slbService.DescribeTags(ctx, loadBalancer.LoadBalancerId, nil, TagResourceInstance)
 		mapping := map[string]interface{}{
 			"id":                       loadBalancer.LoadBalancerId,
 			"region_id":                loadBalancer.RegionId,
@@ -0,0 +1,199 @@
+package alibabacloudstack
+
+import (
+	"context"
+	"regexp"
+	"strings"
+
+	"github.com/aliyun/alibaba-cloud-sdk-go/sdk/requests"
+	"github.com/aliyun/alibaba-cloud-sdk-go/services/ecs"
+	"github.com/aliyun/terraform-provider-alibabacloudstack/alibabacloudstack/connectivity"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+func dataSourceAlibabacloudStackSnapshotPolicies() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: withDiagnostics(dataSourceAlibabacloudStackSnapshotPoliciesRead),
+		Schema: map[string]*schema.Schema{
+			"ids": {
+				Type:     schema.TypeList,
+				Optional: true,
+				ForceNew: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+				Computed: true,
+			},
+			"name_regex": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringIsValidRegExp,
+			},
+			"names": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"output_file": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"policies": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"repeat_weekdays": {
+							Type:     schema.TypeList,
+							Computed: true,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+						"time_points": {
+							Type:     schema.TypeList,
+							Computed: true,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+						"retention_days": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"disk_nums": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"status": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"creation_time": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"disk_ids": {
+							Type:     schema.TypeList,
+							Computed: true,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceAlibabacloudStackSnapshotPoliciesRead(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*connectivity.AlibabacloudStackClient)
+	ecsService := EcsService{client}
+	request := ecs.CreateDescribeAutoSnapshotPolicyExRequest()
+	request.RegionId = client.RegionId
+	request.Headers = map[string]string{"RegionId": client.RegionId}
+	request.QueryParams = map[string]string{"AccessKeySecret": client.SecretKey, "Product": "ecs", "Department": client.Department, "ResourceGroup": client.ResourceGroup}
+	if strings.ToLower(client.Config.Protocol) == "https" {
+		request.Scheme = "https"
+	} else {
+		request.Scheme = "http"
+	}
+	request.PageSize = requests.NewInteger(PageSizeLarge)
+	request.PageNumber = requests.NewInteger(1)
+	var allPolicies []ecs.AutoSnapshotPolicy
+	for {
+		raw, err := client.WithEcsClient(func(ecsClient *ecs.Client) (interface{}, error) {
+			return ecsClient.DescribeAutoSnapshotPolicyEx(request)
+		})
+		if err != nil {
+			return WrapErrorf(err, DataDefaultErrorMsg, "alibabacloudstack_snapshot_policies", request.GetActionName(), AlibabacloudStackSdkGoERROR)
+		}
+		addDebug(request.GetActionName(), raw, request.RpcRequest, request)
+		response := raw.(*ecs.DescribeAutoSnapshotPolicyExResponse)
+		allPolicies = append(allPolicies, response.AutoSnapshotPolicies.AutoSnapshotPolicy...)
+
+		if len(response.AutoSnapshotPolicies.AutoSnapshotPolicy) < PageSizeLarge {
+			break
+		}
+
+		if page, err := getNextpageNumber(request.PageNumber); err != nil {
+			return WrapError(err)
+		} else {
+			request.PageNumber = page
+		}
+	}
+
+	idsMap := make(map[string]string)
+	if v, ok := d.GetOk("ids"); ok {
+		for _, vv := range v.([]interface{}) {
+			if vv == nil {
+				continue
+			}
+			idsMap[vv.(string)] = vv.(string)
+		}
+	}
+	var r *regexp.Regexp
+	if nameRegex, ok := d.GetOk("name_regex"); ok && nameRegex.(string) != "" {
+		r = regexp.MustCompile(nameRegex.(string))
+	}
+
+	var s []map[string]interface{}
+	var ids []string
+	var names []string
+	for _, policy := range allPolicies {
+		if len(idsMap) > 0 {
+			if _, ok := idsMap[policy.AutoSnapshotPolicyId]; !ok {
+				continue
+			}
+		}
+		if r != nil && !r.MatchString(policy.AutoSnapshotPolicyName) {
+			continue
+		}
+		weekdays, err := convertJsonStringToList(policy.RepeatWeekdays)
+		if err != nil {
+			return WrapError(err)
+		}
+		timePoints, err := convertJsonStringToList(policy.TimePoints)
+		if err != nil {
+			return WrapError(err)
+		}
+		diskIds, err := ecsService.DescribeSnapshotPolicyDisks(policy.AutoSnapshotPolicyId)
+		if err != nil {
+			return WrapError(err)
+		}
+		mapping := map[string]interface{}{
+			"id":              policy.AutoSnapshotPolicyId,
+			"name":            policy.AutoSnapshotPolicyName,
+			"repeat_weekdays": weekdays,
+			"time_points":     timePoints,
+			"retention_days":  policy.RetentionDays,
+			"disk_nums":       policy.DiskNums,
+			"status":          policy.Status,
+			"creation_time":   policy.CreationTime,
+			"disk_ids":        diskIds,
+		}
+		s = append(s, mapping)
+		ids = append(ids, policy.AutoSnapshotPolicyId)
+		names = append(names, policy.AutoSnapshotPolicyName)
+	}
+
+	d.SetId(dataResourceIdHash(ids))
+	if err := d.Set("policies", s); err != nil {
+		return WrapError(err)
+	}
+	if err := d.Set("ids", ids); err != nil {
+		return WrapError(err)
+	}
+	if err := d.Set("names", names); err != nil {
+		return WrapError(err)
+	}
+
+	// create a json file in current directory and write data source to it.
+	if output, ok := d.GetOk("output_file"); ok && output.(string) != "" {
+		writeToFile(output.(string), s)
+	}
+	return nil
+}
@@ -0,0 +1,78 @@
+package alibabacloudstack
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+)
+
+func TestAccAlibabacloudStackSnapshotPoliciesDataSourceBasic(t *testing.T) {
+	rand := acctest.RandIntRange(10000, 99999)
+	name := fmt.Sprintf("tf-testAccSnapshotPolicies%d", rand)
+	resourceId := "data.alibabacloudstack_snapshot_policies.default"
+
+	testAccConfig := dataSourceTestAccConfigFunc(resourceId, name, dataSourceSnapshotPoliciesConfigDependence)
+
+	idsConfig := dataSourceTestAccConfig{
+		existConfig: testAccConfig(map[string]interface{}{
+			"ids": []string{"${alibabacloudstack_snapshot_policy_attachment.default.snapshot_policy_id}"},
+		}),
+		fakeConfig: testAccConfig(map[string]interface{}{
+			"ids": []string{"${alibabacloudstack_snapshot_policy_attachment.default.snapshot_policy_id}_fake"},
+		}),
+	}
+
+	nameRegexConfig := dataSourceTestAccConfig{
+		existConfig: testAccConfig(map[string]interface{}{
+			"name_regex": "${alibabacloudstack_snapshot_policy.default.name}",
+			"ids":        []string{"${alibabacloudstack_snapshot_policy_attachment.default.snapshot_policy_id}"},
+		}),
+		fakeConfig: testAccConfig(map[string]interface{}{
+			"name_regex": "${alibabacloudstack_snapshot_policy.default.name}_fake",
+			"ids":        []string{"${alibabacloudstack_snapshot_policy_attachment.default.snapshot_policy_id}"},
+		}),
+	}
+
+	var existSnapshotPoliciesMapFunc = func(rand int) map[string]string {
+		return map[string]string{
+			"ids.#":                        "1",
+			"names.#":                      "1",
+			"policies.#":                   "1",
+			"policies.0.id":                CHECKSET,
+			"policies.0.name":              name,
+			"policies.0.repeat_weekdays.#": "1",
+			"policies.0.time_points.#":     "1",
+			"policies.0.retention_days":    "-1",
+			"policies.0.disk_nums":         "1",
+			"policies.0.status":            CHECKSET,
+			"policies.0.creation_time":     CHECKSET,
+			"policies.0.disk_ids.#":        "1",
+		}
+	}
+
+	var fakeSnapshotPoliciesMapFunc = func(rand int) map[string]string {
+		return map[string]string{
+			"ids.#":      "0",
+			"names.#":    "0",
+			"policies.#": "0",
+		}
+	}
+
+	var snapshotPoliciesCheckInfo = dataSourceAttr{
+		resourceId:   resourceId,
+		existMapFunc: existSnapshotPoliciesMapFunc,
+		fakeMapFunc:  fakeSnapshotPoliciesMapFunc,
+	}
+
+	snapshotPoliciesCheckInfo.dataSourceTestCheck(t, rand, idsConfig, nameRegexConfig)
+}
+
+func dataSourceSnapshotPoliciesConfigDependence(name string) string {
+	return resourceSnapshotPolicyAttachmentConfigDependence(name) + `
+resource "alibabacloudstack_snapshot_policy_attachment" "default" {
+  snapshot_policy_id = "${alibabacloudstack_snapshot_policy.default.id}"
+  disk_id            = "${alibabacloudstack_disk.default.id}"
+}
+`
+}
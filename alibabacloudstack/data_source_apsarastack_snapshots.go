@@ -1,6 +1,7 @@
 package alibabacloudstack
 
 import (
+	"context"
 	"regexp"
 	"strings"
 
@@ -13,7 +14,7 @@ import (
 
 func dataSourceAlibabacloudStackSnapshots() *schema.Resource {
 	return &schema.Resource{
-		Read: dataSourceAlibabacloudStackSnapshotsRead,
+		ReadContext: withDiagnostics(dataSourceAlibabacloudStackSnapshotsRead),
 		Schema: map[string]*schema.Schema{
 			"instance_id": {
 				Type:     schema.TypeString,
@@ -137,7 +138,7 @@ func dataSourceAlibabacloudStackSnapshots() *schema.Resource {
 	}
 }
 
-func dataSourceAlibabacloudStackSnapshotsRead(d *schema.ResourceData, meta interface{}) error {
+func dataSourceAlibabacloudStackSnapshotsRead(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*connectivity.AlibabacloudStackClient)
 	request := ecs.CreateDescribeSnapshotsRequest()
 	request.RegionId = client.RegionId
@@ -1,6 +1,7 @@
 package alibabacloudstack
 
 import (
+	"context"
 	"github.com/aliyun/alibaba-cloud-sdk-go/sdk/requests"
 	"github.com/aliyun/alibaba-cloud-sdk-go/services/vpc"
 	"github.com/aliyun/terraform-provider-alibabacloudstack/alibabacloudstack/connectivity"
@@ -10,7 +11,7 @@ import (
 
 func dataSourceAlibabacloudStackSnatEntries() *schema.Resource {
 	return &schema.Resource{
-		Read: dataSourceAlibabacloudStackSnatEntriesRead,
+		ReadContext: withDiagnostics(dataSourceAlibabacloudStackSnatEntriesRead),
 
 		Schema: map[string]*schema.Schema{
 			"snat_table_id": {
@@ -65,7 +66,7 @@ func dataSourceAlibabacloudStackSnatEntries() *schema.Resource {
 		},
 	}
 }
-func dataSourceAlibabacloudStackSnatEntriesRead(d *schema.ResourceData, meta interface{}) error {
+func dataSourceAlibabacloudStackSnatEntriesRead(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*connectivity.AlibabacloudStackClient)
 
 	request := vpc.CreateDescribeSnatTableEntriesRequest()
@@ -0,0 +1,89 @@
+package alibabacloudstack
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+)
+
+func TestAccAlibabacloudStackTsdbInstancesDataSource(t *testing.T) {
+	rand := acctest.RandIntRange(10000, 99999)
+	name := fmt.Sprintf("tf-testacc-tsdbinstances%d", rand)
+	resourceId := "data.alibabacloudstack_tsdb_instances.default"
+	testAccConfig := dataSourceTestAccConfigFunc(resourceId, name, dataSourceTsdbInstancesConfigDependence)
+
+	idsConf := dataSourceTestAccConfig{
+		existConfig: testAccConfig(map[string]interface{}{
+			"ids": []string{"${alibabacloudstack_tsdb_instance.default.id}"},
+		}),
+		fakeConfig: testAccConfig(map[string]interface{}{
+			"ids": []string{"${alibabacloudstack_tsdb_instance.default.id}_fake"},
+		}),
+	}
+	nameRegexConf := dataSourceTestAccConfig{
+		existConfig: testAccConfig(map[string]interface{}{
+			"name_regex": "${alibabacloudstack_tsdb_instance.default.instance_alias}",
+		}),
+		fakeConfig: testAccConfig(map[string]interface{}{
+			"name_regex": "${alibabacloudstack_tsdb_instance.default.instance_alias}_fake",
+		}),
+	}
+	allConf := dataSourceTestAccConfig{
+		existConfig: testAccConfig(map[string]interface{}{
+			"ids":         []string{"${alibabacloudstack_tsdb_instance.default.id}"},
+			"name_regex":  "${alibabacloudstack_tsdb_instance.default.instance_alias}",
+			"engine_type": "tsdb_tsdb",
+		}),
+		fakeConfig: testAccConfig(map[string]interface{}{
+			"ids":         []string{"${alibabacloudstack_tsdb_instance.default.id}"},
+			"name_regex":  "${alibabacloudstack_tsdb_instance.default.instance_alias}",
+			"engine_type": "tsdb_influxdb",
+		}),
+	}
+
+	var existTsdbInstancesMapFunc = func(rand int) map[string]string {
+		return map[string]string{
+			"ids.#":                        "1",
+			"names.#":                      "1",
+			"instances.#":                  "1",
+			"instances.0.id":               CHECKSET,
+			"instances.0.instance_alias":   name,
+			"instances.0.instance_class":   "tsdb.1x.basic",
+			"instances.0.instance_storage": "50",
+			"instances.0.engine_type":      "tsdb_tsdb",
+			"instances.0.vpc_id":           CHECKSET,
+			"instances.0.vswitch_id":       CHECKSET,
+			"instances.0.zone_id":          CHECKSET,
+			"instances.0.status":           "ACTIVATION",
+		}
+	}
+
+	var fakeTsdbInstancesMapFunc = func(rand int) map[string]string {
+		return map[string]string{
+			"ids.#":       "0",
+			"names.#":     "0",
+			"instances.#": "0",
+		}
+	}
+
+	var tsdbInstancesCheckInfo = dataSourceAttr{
+		resourceId:   resourceId,
+		existMapFunc: existTsdbInstancesMapFunc,
+		fakeMapFunc:  fakeTsdbInstancesMapFunc,
+	}
+
+	tsdbInstancesCheckInfo.dataSourceTestCheck(t, rand, idsConf, nameRegexConf, allConf)
+}
+
+func dataSourceTsdbInstancesConfigDependence(name string) string {
+	return resourceTsdbInstanceConfigDependence(name) + `
+resource "alibabacloudstack_tsdb_instance" "default" {
+  instance_class   = "tsdb.1x.basic"
+  instance_storage = "50"
+  engine_type      = "tsdb_tsdb"
+  instance_alias   = var.name
+  vswitch_id       = alibabacloudstack_vswitch.default.id
+}
+`
+}
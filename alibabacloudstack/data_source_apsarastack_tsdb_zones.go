@@ -1,6 +1,7 @@
 package alibabacloudstack
 
 import (
+	"context"
 	"fmt"
 
 	"github.com/PaesslerAG/jsonpath"
@@ -11,7 +12,7 @@ import (
 
 func dataSourceAlibabacloudStackTsdbZones() *schema.Resource {
 	return &schema.Resource{
-		Read: dataSourceAlibabacloudStackTsdbZonesRead,
+		ReadContext: withDiagnostics(dataSourceAlibabacloudStackTsdbZonesRead),
 		Schema: map[string]*schema.Schema{
 			"ids": {
 				Type:     schema.TypeList,
@@ -47,7 +48,7 @@ func dataSourceAlibabacloudStackTsdbZones() *schema.Resource {
 	}
 }
 
-func dataSourceAlibabacloudStackTsdbZonesRead(d *schema.ResourceData, meta interface{}) error {
+func dataSourceAlibabacloudStackTsdbZonesRead(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*connectivity.AlibabacloudStackClient)
 
 	action := "DescribeZones"
@@ -1,6 +1,7 @@
 package alibabacloudstack
 
 import (
+	"context"
 	"fmt"
 	"time"
 
@@ -14,7 +15,7 @@ import (
 
 func dataSourceAlibabacloudStackVpcIpv6Addresses() *schema.Resource {
 	return &schema.Resource{
-		Read: dataSourceAlibabacloudStackVpcIpv6AddressesRead,
+		ReadContext: withDiagnostics(dataSourceAlibabacloudStackVpcIpv6AddressesRead),
 		Schema: map[string]*schema.Schema{
 			"associated_instance_id": {
 				Type:     schema.TypeString,
@@ -113,7 +114,7 @@ func dataSourceAlibabacloudStackVpcIpv6Addresses() *schema.Resource {
 	}
 }
 
-func dataSourceAlibabacloudStackVpcIpv6AddressesRead(d *schema.ResourceData, meta interface{}) error {
+func dataSourceAlibabacloudStackVpcIpv6AddressesRead(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*connectivity.AlibabacloudStackClient)
 
 	action := "DescribeIpv6Addresses"
@@ -142,7 +143,7 @@ func dataSourceAlibabacloudStackVpcIpv6AddressesRead(d *schema.ResourceData, met
 		runtime := util.RuntimeOptions{}
 		runtime.SetAutoretry(true)
 		wait := incrementalWait(3*time.Second, 3*time.Second)
-		err = resource.Retry(5*time.Minute, func() *resource.RetryError {
+		err = resource.RetryContext(ctx, 5*time.Minute, func() *resource.RetryError {
 			request["Product"] = "Vpc"
 			request["OrganizationId"] = client.Department
 			response, err = conn.DoRequest(StringPointer(action), nil, StringPointer("POST"), StringPointer("2016-04-28"), StringPointer("AK"), nil, request, &runtime)
@@ -1,6 +1,7 @@
 package alibabacloudstack
 
 import (
+	"context"
 	"fmt"
 	"time"
 
@@ -14,7 +15,7 @@ import (
 
 func dataSourceAlibabacloudStackVpcIpv6InternetBandwidths() *schema.Resource {
 	return &schema.Resource{
-		Read: dataSourceAlibabacloudStackVpcIpv6InternetBandwidthsRead,
+		ReadContext: withDiagnostics(dataSourceAlibabacloudStackVpcIpv6InternetBandwidthsRead),
 		Schema: map[string]*schema.Schema{
 			"ids": {
 				Type:     schema.TypeList,
@@ -87,7 +88,7 @@ func dataSourceAlibabacloudStackVpcIpv6InternetBandwidths() *schema.Resource {
 	}
 }
 
-func dataSourceAlibabacloudStackVpcIpv6InternetBandwidthsRead(d *schema.ResourceData, meta interface{}) error {
+func dataSourceAlibabacloudStackVpcIpv6InternetBandwidthsRead(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*connectivity.AlibabacloudStackClient)
 
 	action := "DescribeIpv6Addresses"
@@ -122,7 +123,7 @@ func dataSourceAlibabacloudStackVpcIpv6InternetBandwidthsRead(d *schema.Resource
 		runtime := util.RuntimeOptions{}
 		runtime.SetAutoretry(true)
 		wait := incrementalWait(3*time.Second, 3*time.Second)
-		err = resource.Retry(5*time.Minute, func() *resource.RetryError {
+		err = resource.RetryContext(ctx, 5*time.Minute, func() *resource.RetryError {
 			request["Product"] = "Vpc"
 			request["OrganizationId"] = client.Department
 			response, err = conn.DoRequest(StringPointer(action), nil, StringPointer("POST"), StringPointer("2016-04-28"), StringPointer("AK"), nil, request, &runtime)
@@ -1,6 +1,7 @@
 package alibabacloudstack
 
 import (
+	"context"
 	"fmt"
 	"regexp"
 	"strings"
@@ -17,7 +18,7 @@ import (
 
 func dataSourceAlibabacloudStackVpcs() *schema.Resource {
 	return &schema.Resource{
-		Read: dataSourceAlibabacloudStackVpcsRead,
+		ReadContext: withDiagnostics(dataSourceAlibabacloudStackVpcsRead),
 
 		Schema: map[string]*schema.Schema{
 			"cidr_block": {
@@ -180,7 +181,7 @@ func dataSourceAlibabacloudStackVpcs() *schema.Resource {
 		},
 	}
 }
-func dataSourceAlibabacloudStackVpcsRead(d *schema.ResourceData, meta interface{}) error {
+func dataSourceAlibabacloudStackVpcsRead(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*connectivity.AlibabacloudStackClient)
 
 	request := vpc.CreateDescribeVpcsRequest()
@@ -319,7 +320,7 @@ func dataSourceAlibabacloudStackVpcsRead(d *schema.ResourceData, meta interface{
 
 		var response *vpc.DescribeVRoutersResponse
 		wait := incrementalWait(1*time.Second, 1*time.Second)
-		err := resource.Retry(d.Timeout(schema.TimeoutCreate), func() *resource.RetryError {
+		err := resource.RetryContext(ctx, d.Timeout(schema.TimeoutCreate), func() *resource.RetryError {
 			raw, err := client.WithVpcClient(func(vpcClient *vpc.Client) (interface{}, error) {
 				return vpcClient.DescribeVRouters(request)
 			})
@@ -1,6 +1,7 @@
 package alibabacloudstack
 
 import (
+	"context"
 	"regexp"
 	"strings"
 
@@ -13,7 +14,7 @@ import (
 
 func dataSourceAlibabacloudStackVpnConnections() *schema.Resource {
 	return &schema.Resource{
-		Read: dataSourceAlibabacloudStackVpnConnectionsRead,
+		ReadContext: withDiagnostics(dataSourceAlibabacloudStackVpnConnectionsRead),
 
 		Schema: map[string]*schema.Schema{
 			"ids": {
@@ -176,7 +177,7 @@ func dataSourceAlibabacloudStackVpnConnections() *schema.Resource {
 	}
 }
 
-func dataSourceAlibabacloudStackVpnConnectionsRead(d *schema.ResourceData, meta interface{}) error {
+func dataSourceAlibabacloudStackVpnConnectionsRead(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*connectivity.AlibabacloudStackClient)
 
 	request := vpc.CreateDescribeVpnConnectionsRequest()
@@ -0,0 +1,148 @@
+package alibabacloudstack
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/aliyun/terraform-provider-alibabacloudstack/alibabacloudstack/connectivity"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func dataSourceAlibabacloudStackVpnRouteEntries() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: withDiagnostics(dataSourceAlibabacloudStackVpnRouteEntriesRead),
+		Schema: map[string]*schema.Schema{
+			"vpn_gateway_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"ids": {
+				Type:     schema.TypeList,
+				Optional: true,
+				ForceNew: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+				Computed: true,
+			},
+			"route_dest": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+			},
+			"next_hop": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+			},
+			"output_file": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"entries": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"vpn_gateway_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"route_dest": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"next_hop": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"weight": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"publish_vpc": {
+							Type:     schema.TypeBool,
+							Computed: true,
+						},
+						"status": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"create_time": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceAlibabacloudStackVpnRouteEntriesRead(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*connectivity.AlibabacloudStackClient)
+	vpnGatewayService := VpnGatewayService{client}
+	gatewayId := d.Get("vpn_gateway_id").(string)
+
+	idsMap := make(map[string]string)
+	if v, ok := d.GetOk("ids"); ok {
+		for _, vv := range v.([]interface{}) {
+			if vv == nil {
+				continue
+			}
+			idsMap[vv.(string)] = vv.(string)
+		}
+	}
+	routeDest := d.Get("route_dest").(string)
+	nextHop := d.Get("next_hop").(string)
+
+	entries, err := vpnGatewayService.DescribeVpnRouteEntries(gatewayId)
+	if err != nil {
+		return WrapErrorf(err, DataDefaultErrorMsg, "alibabacloudstack_vpn_route_entries", "DescribeVpnRouteEntries", AlibabacloudStackSdkGoERROR)
+	}
+
+	ids := make([]string, 0)
+	s := make([]map[string]interface{}, 0)
+	for _, entry := range entries {
+		// The id is consistent with the resource alibabacloudstack_vpn_route_entry.
+		id := fmt.Sprintf("%s:%s:%s", gatewayId, entry.NextHop, entry.RouteDest)
+		if len(idsMap) > 0 {
+			if _, ok := idsMap[id]; !ok {
+				continue
+			}
+		}
+		if routeDest != "" && routeDest != entry.RouteDest {
+			continue
+		}
+		if nextHop != "" && nextHop != entry.NextHop {
+			continue
+		}
+		mapping := map[string]interface{}{
+			"id":             id,
+			"vpn_gateway_id": gatewayId,
+			"route_dest":     entry.RouteDest,
+			"next_hop":       entry.NextHop,
+			"weight":         entry.Weight,
+			"publish_vpc":    entry.State == "published",
+			"status":         entry.State,
+			"create_time":    fmt.Sprint(entry.CreateTime),
+		}
+		ids = append(ids, id)
+		s = append(s, mapping)
+	}
+
+	d.SetId(dataResourceIdHash(ids))
+	if err := d.Set("ids", ids); err != nil {
+		return WrapError(err)
+	}
+	if err := d.Set("entries", s); err != nil {
+		return WrapError(err)
+	}
+	if output, ok := d.GetOk("output_file"); ok && output.(string) != "" {
+		writeToFile(output.(string), s)
+	}
+	return nil
+}
@@ -0,0 +1,90 @@
+package alibabacloudstack
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+)
+
+func TestAccAlibabacloudStackVpnRouteEntriesDataSource(t *testing.T) {
+	rand := acctest.RandIntRange(10000, 99999)
+	name := fmt.Sprintf("tf-testacc%svpnrouteentries%d", defaultRegionToTest, rand)
+	resourceId := "data.alibabacloudstack_vpn_route_entries.default"
+	testAccConfig := dataSourceTestAccConfigFunc(resourceId, name, dataSourceVpnRouteEntriesConfigDependence)
+
+	idsConf := dataSourceTestAccConfig{
+		existConfig: testAccConfig(map[string]interface{}{
+			"vpn_gateway_id": "${alibabacloudstack_vpn_route_entry.default.vpn_gateway_id}",
+			"ids":            []string{"${alibabacloudstack_vpn_route_entry.default.id}"},
+		}),
+		fakeConfig: testAccConfig(map[string]interface{}{
+			"vpn_gateway_id": "${alibabacloudstack_vpn_route_entry.default.vpn_gateway_id}",
+			"ids":            []string{"${alibabacloudstack_vpn_route_entry.default.id}_fake"},
+		}),
+	}
+	routeDestConf := dataSourceTestAccConfig{
+		existConfig: testAccConfig(map[string]interface{}{
+			"vpn_gateway_id": "${alibabacloudstack_vpn_route_entry.default.vpn_gateway_id}",
+			"route_dest":     "${alibabacloudstack_vpn_route_entry.default.route_dest}",
+		}),
+		fakeConfig: testAccConfig(map[string]interface{}{
+			"vpn_gateway_id": "${alibabacloudstack_vpn_route_entry.default.vpn_gateway_id}",
+			"route_dest":     "10.0.1.0/24",
+		}),
+	}
+	allConf := dataSourceTestAccConfig{
+		existConfig: testAccConfig(map[string]interface{}{
+			"vpn_gateway_id": "${alibabacloudstack_vpn_route_entry.default.vpn_gateway_id}",
+			"ids":            []string{"${alibabacloudstack_vpn_route_entry.default.id}"},
+			"route_dest":     "${alibabacloudstack_vpn_route_entry.default.route_dest}",
+			"next_hop":       "${alibabacloudstack_vpn_route_entry.default.next_hop}",
+		}),
+		fakeConfig: testAccConfig(map[string]interface{}{
+			"vpn_gateway_id": "${alibabacloudstack_vpn_route_entry.default.vpn_gateway_id}",
+			"ids":            []string{"${alibabacloudstack_vpn_route_entry.default.id}"},
+			"route_dest":     "${alibabacloudstack_vpn_route_entry.default.route_dest}",
+			"next_hop":       "${alibabacloudstack_vpn_route_entry.default.next_hop}_fake",
+		}),
+	}
+
+	var existVpnRouteEntriesMapFunc = func(rand int) map[string]string {
+		return map[string]string{
+			"ids.#":                    "1",
+			"entries.#":                "1",
+			"entries.0.id":             CHECKSET,
+			"entries.0.vpn_gateway_id": CHECKSET,
+			"entries.0.route_dest":     "10.0.0.0/24",
+			"entries.0.next_hop":       CHECKSET,
+			"entries.0.weight":         "100",
+			"entries.0.publish_vpc":    "false",
+		}
+	}
+
+	var fakeVpnRouteEntriesMapFunc = func(rand int) map[string]string {
+		return map[string]string{
+			"ids.#":     "0",
+			"entries.#": "0",
+		}
+	}
+
+	var vpnRouteEntriesCheckInfo = dataSourceAttr{
+		resourceId:   resourceId,
+		existMapFunc: existVpnRouteEntriesMapFunc,
+		fakeMapFunc:  fakeVpnRouteEntriesMapFunc,
+	}
+
+	vpnRouteEntriesCheckInfo.dataSourceTestCheck(t, rand, idsConf, routeDestConf, allConf)
+}
+
+func dataSourceVpnRouteEntriesConfigDependence(name string) string {
+	return resourceVpnRouteEntryConfigDependence(name) + `
+resource "alibabacloudstack_vpn_route_entry" "default" {
+  vpn_gateway_id = alibabacloudstack_vpn_gateway.default.id
+  route_dest     = "10.0.0.0/24"
+  next_hop       = alibabacloudstack_vpn_connection.default.id
+  weight         = 100
+  publish_vpc    = false
+}
+`
+}
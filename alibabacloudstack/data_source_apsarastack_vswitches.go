@@ -1,6 +1,7 @@
 package alibabacloudstack
 
 import (
+	"context"
 	"regexp"
 	"strings"
 
@@ -14,7 +15,7 @@ import (
 
 func dataSourceAlibabacloudStackVSwitches() *schema.Resource {
 	return &schema.Resource{
-		Read: dataSourceAlibabacloudStackVSwitchesRead,
+		ReadContext: withDiagnostics(dataSourceAlibabacloudStackVSwitchesRead),
 
 		Schema: map[string]*schema.Schema{
 			"cidr_block": {
@@ -111,7 +112,7 @@ func dataSourceAlibabacloudStackVSwitches() *schema.Resource {
 		},
 	}
 }
-func dataSourceAlibabacloudStackVSwitchesRead(d *schema.ResourceData, meta interface{}) error {
+func dataSourceAlibabacloudStackVSwitchesRead(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*connectivity.AlibabacloudStackClient)
 
 	request := vpc.CreateDescribeVSwitchesRequest()
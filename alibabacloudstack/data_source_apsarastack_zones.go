@@ -1,6 +1,7 @@
 package alibabacloudstack
 
 import (
+	"context"
 	"fmt"
 	"reflect"
 	"regexp"
@@ -32,7 +33,7 @@ import (
 
 func dataSourceAlibabacloudStackZones() *schema.Resource {
 	return &schema.Resource{
-		Read: dataSourceAlibabacloudStackZonesRead,
+		ReadContext: withDiagnostics(dataSourceAlibabacloudStackZonesRead),
 
 		Schema: map[string]*schema.Schema{
 			"available_instance_type": {
@@ -185,7 +186,7 @@ func dataSourceAlibabacloudStackZones() *schema.Resource {
 	}
 }
 
-func dataSourceAlibabacloudStackZonesRead(d *schema.ResourceData, meta interface{}) error {
+func dataSourceAlibabacloudStackZonesRead(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*connectivity.AlibabacloudStackClient)
 	ecsService := EcsService{client}
 
@@ -217,7 +218,7 @@ func dataSourceAlibabacloudStackZonesRead(d *schema.ResourceData, meta interface
 		//	request.InstanceChargeType = string(Prepaid)
 		//}
 		var response = &rds.DescribeRegionsResponse{}
-		err := resource.Retry(5*time.Minute, func() *resource.RetryError {
+		err := resource.RetryContext(ctx, 5*time.Minute, func() *resource.RetryError {
 			raw, err := client.WithRdsClient(func(rdsClient *rds.Client) (interface{}, error) {
 				return rdsClient.DescribeRegions(request)
 			})
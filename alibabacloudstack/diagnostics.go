@@ -0,0 +1,132 @@
+package alibabacloudstack
+
+import (
+	"context"
+	"errors"
+	"strconv"
+	"strings"
+	"sync"
+
+	"github.com/aliyun/terraform-provider-alibabacloudstack/alibabacloudstack/connectivity"
+	"github.com/hashicorp/go-cty/cty"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+type diagnosticsKey struct{}
+
+type diagnosticsCollector struct {
+	diags diag.Diagnostics
+	mutex sync.Mutex
+}
+
+// withDiagnostics converts a CRUD function of a resource or data source to the context aware function of the sdk.
+// The context is cancelled when terraform is interrupted, so the waits like BuildStateConf polling stop at once.
+// The returned error and the warnings added by addWarning are reported as diagnostics.
+func withDiagnostics(f func(context.Context, *schema.ResourceData, interface{}) error) func(context.Context, *schema.ResourceData, interface{}) diag.Diagnostics {
+	return func(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+		if client, ok := meta.(*connectivity.AlibabacloudStackClient); ok && client.Config.StopContext != nil {
+			var cancel context.CancelFunc
+			ctx, cancel = connectivity.WithStopContext(ctx, client.Config.StopContext)
+			defer cancel()
+		}
+		collector := &diagnosticsCollector{}
+		err := f(context.WithValue(ctx, diagnosticsKey{}, collector), d, meta)
+
+		collector.mutex.Lock()
+		defer collector.mutex.Unlock()
+		if err != nil {
+			collector.diags = append(collector.diags, errorDiagnostic(err))
+		}
+		return collector.diags
+	}
+}
+
+// addWarning reports a warning of the attribute, like "tags.0.key". The attribute is empty if the warning is about the whole resource.
+func addWarning(ctx context.Context, attribute, summary, detail string) {
+	collector, ok := ctx.Value(diagnosticsKey{}).(*diagnosticsCollector)
+	if !ok {
+		return
+	}
+	collector.mutex.Lock()
+	defer collector.mutex.Unlock()
+	collector.diags = append(collector.diags, diag.Diagnostic{
+		Severity:      diag.Warning,
+		Summary:       summary,
+		Detail:        detail,
+		AttributePath: attributePath(attribute),
+	})
+}
+
+// AttributeError is an error caused by the value of an attribute, and it is reported with the path of the attribute.
+type AttributeError struct {
+	Attribute string
+	Err       error
+}
+
+func (e *AttributeError) Error() string {
+	return e.Err.Error()
+}
+
+func (e *AttributeError) Unwrap() error {
+	return e.Err
+}
+
+// WrapAttributeError marks the error as caused by the value of the attribute, like "disks.0.size".
+func WrapAttributeError(attribute string, err error) error {
+	if err == nil {
+		return nil
+	}
+	return &AttributeError{Attribute: attribute, Err: err}
+}
+
+// errorDiagnostic uses the message of the outermost error as the summary and the whole error with its causes as the detail.
+func errorDiagnostic(err error) diag.Diagnostic {
+	diagnostic := diag.Diagnostic{
+		Severity: diag.Error,
+		Summary:  errorSummary(err),
+		Detail:   err.Error(),
+	}
+	if diagnostic.Detail == diagnostic.Summary {
+		diagnostic.Detail = ""
+	}
+	var attributeErr *AttributeError
+	if errors.As(err, &attributeErr) {
+		diagnostic.AttributePath = attributePath(attributeErr.Attribute)
+	}
+	return diagnostic
+}
+
+func errorSummary(err error) string {
+	for {
+		switch e := err.(type) {
+		case *ComplexError:
+			if e.Err == nil && e.Cause == nil {
+				return e.Error()
+			}
+			if err = e.Err; err == nil {
+				err = e.Cause
+			}
+			continue
+		case *AttributeError:
+			err = e.Err
+			continue
+		}
+		return strings.TrimSpace(strings.SplitN(err.Error(), "\n", 2)[0])
+	}
+}
+
+func attributePath(attribute string) cty.Path {
+	if attribute == "" {
+		return nil
+	}
+	var path cty.Path
+	for _, step := range strings.Split(attribute, ".") {
+		if index, err := strconv.Atoi(step); err == nil {
+			path = path.IndexInt(index)
+		} else {
+			path = path.GetAttr(step)
+		}
+	}
+	return path
+}
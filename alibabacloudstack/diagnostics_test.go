@@ -0,0 +1,59 @@
+package alibabacloudstack
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/aliyun/terraform-provider-alibabacloudstack/alibabacloudstack/connectivity"
+	"github.com/hashicorp/go-cty/cty"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func TestWithDiagnostics(t *testing.T) {
+	d := schema.TestResourceDataRaw(t, map[string]*schema.Schema{"name": {Type: schema.TypeString, Optional: true}}, map[string]interface{}{})
+	client := &connectivity.AlibabacloudStackClient{Config: &connectivity.Config{}}
+
+	diags := withDiagnostics(func(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
+		addWarning(ctx, "name", "The name is deprecated", "")
+		return WrapAttributeError("disks.0.size", WrapErrorf(Error("InvalidDiskSize"), DefaultErrorMsg, "d-test", "CreateDisk", AlibabacloudStackSdkGoERROR))
+	})(context.Background(), d, client)
+
+	if len(diags) != 2 {
+		t.Fatalf("expected a warning and an error, got %v", diags)
+	}
+	if diags[0].Severity != diag.Warning || !diags[0].AttributePath.Equals(cty.GetAttrPath("name")) {
+		t.Fatalf("unexpected warning: %#v", diags[0])
+	}
+	if diags[1].Severity != diag.Error || diags[1].Summary != "Resource d-test CreateDisk Failed!!! [SDK alibaba-cloud-sdk-go ERROR]" {
+		t.Fatalf("unexpected error: %#v", diags[1])
+	}
+	if !diags[1].AttributePath.Equals(cty.GetAttrPath("disks").IndexInt(0).GetAttr("size")) {
+		t.Fatalf("unexpected attribute path of the error: %#v", diags[1].AttributePath)
+	}
+}
+
+func TestWithDiagnosticsStopContext(t *testing.T) {
+	d := schema.TestResourceDataRaw(t, map[string]*schema.Schema{}, map[string]interface{}{})
+	stop, cancel := context.WithCancel(context.Background())
+	client := &connectivity.AlibabacloudStackClient{Config: &connectivity.Config{StopContext: stop}}
+	time.AfterFunc(100*time.Millisecond, cancel)
+
+	start := time.Now()
+	diags := withDiagnostics(func(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
+		stateConf := BuildStateConf([]string{"Pending"}, []string{"Available"}, time.Minute, 0, func() (interface{}, string, error) {
+			return "", "Pending", nil
+		})
+		_, err := stateConf.WaitForStateContext(ctx)
+		return WrapError(err)
+	})(context.Background(), d, client)
+
+	if !diags.HasError() || time.Since(start) > 10*time.Second {
+		t.Fatalf("expected the wait to be cancelled by the stop context, got %v after %s", diags, time.Since(start))
+	}
+	if err := resource.RetryContext(stop, time.Minute, func() *resource.RetryError { return nil }); err == nil {
+		t.Fatalf("expected the retry to fail with the cancelled context")
+	}
+}
@@ -1,6 +1,9 @@
 package alibabacloudstack
 
 import (
+	"encoding/json"
+
+	sls "github.com/aliyun/aliyun-log-go-sdk"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"log"
 	"sort"
@@ -397,3 +400,21 @@ func rdsDiffSuppressFunc(k, old, new string, d *schema.ResourceData) bool {
 	}
 	return true
 }
+
+// logDashboardCharListDiffSuppressFunc suppresses the diff when the charts are the same after being normalized,
+// because the charts returned by the dashboard contain the default values of the attributes which are not set.
+func logDashboardCharListDiffSuppressFunc(k, old, new string, d *schema.ResourceData) bool {
+	if old == "" || new == "" {
+		return false
+	}
+	var oldCharts, newCharts []sls.Chart
+	if err := json.Unmarshal([]byte(old), &oldCharts); err != nil {
+		return false
+	}
+	if err := json.Unmarshal([]byte(new), &newCharts); err != nil {
+		return false
+	}
+	oldValue, _ := json.Marshal(oldCharts)
+	newValue, _ := json.Marshal(newCharts)
+	return string(oldValue) == string(newValue)
+}
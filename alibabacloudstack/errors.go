@@ -316,6 +316,10 @@ func (e ComplexError) Error() string {
 	return fmt.Sprintf("[ERROR] %s:%d: %s:\n%s", e.Path, e.Line, e.Err.Error(), e.Cause.Error())
 }
 
+func (e ComplexError) Unwrap() error {
+	return e.Cause
+}
+
 func Error(msg string, args ...interface{}) error {
 	return fmt.Errorf(msg, args...)
 }
@@ -857,6 +857,7 @@ type AscmQuota struct {
 		UsedCPU                     int    `json:"usedCpu"`
 		TotalVPC                    int    `json:"totalVPC"`
 		TotalEIP                    int    `json:"totalEIP"`
+		UsedEIP                     int    `json:"usedEIP"`
 		UsedVPC                     int    `json:"usedVPC"`
 		AllocateVPC                 int    `json:"allocateVPC"`
 		TotalAmount                 int    `json:"totalAmount"`
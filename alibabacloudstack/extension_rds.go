@@ -1,5 +1,7 @@
 package alibabacloudstack
 
+import "strings"
+
 type Engine string
 
 const (
@@ -7,8 +9,33 @@ const (
 	SQLServer  = Engine("SQLServer")
 	PPAS       = Engine("PPAS")
 	PostgreSQL = Engine("PostgreSQL")
+	MariaDB    = Engine("MariaDB")
 )
 
+// rdsEngineVersions is the engine versions supported by every engine, and the keys are the lower case engines.
+var rdsEngineVersions = map[string][]string{
+	strings.ToLower(string(MySQL)):      {"5.5", "5.6", "5.7", "8.0"},
+	strings.ToLower(string(SQLServer)):  {"2008r2", "2012", "2012_ent_ha", "2012_std_ha", "2012_web", "2016_ent_ha", "2016_std_ha", "2016_web", "2017_ent", "2017_std_ha", "2019_ent", "2019_std_ha"},
+	strings.ToLower(string(PostgreSQL)): {"9.4", "10.0", "11.0", "12.0", "13.0"},
+	strings.ToLower(string(PPAS)):       {"9.3", "10.0"},
+	strings.ToLower(string(MariaDB)):    {"10.3"},
+}
+
+// rdsEngineVersionKnown reports whether the version is supported by the engine. The versions of the engines which
+// are not in rdsEngineVersions are checked by the api, so they are always known.
+func rdsEngineVersionKnown(engine, version string) bool {
+	versions, ok := rdsEngineVersions[strings.ToLower(engine)]
+	if !ok {
+		return true
+	}
+	for _, v := range versions {
+		if strings.EqualFold(v, version) {
+			return true
+		}
+	}
+	return false
+}
+
 var WEEK_ENUM = []string{"Monday", "Tuesday", "Wednesday", "Thursday", "Friday", "Saturday", "Sunday"}
 
 var BACKUP_TIME = []string{
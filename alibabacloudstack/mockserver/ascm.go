@@ -0,0 +1,193 @@
+package mockserver
+
+import (
+	"fmt"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+)
+
+const (
+	kindResourceGroup = "resource_group"
+	kindOrganization  = "organization"
+	// The quotas are seeded with the id <productName>:<quotaType>:<quotaTypeId>.
+	kindQuota = "quota"
+)
+
+func registerAscmHandlers(s *Server) {
+	s.Handle("ascm", "ListResourceGroup", listResourceGroup)
+	s.Handle("ascm", "CreateResourceGroup", createResourceGroup)
+	s.Handle("ascm", "UpdateResourceGroup", updateResourceGroup)
+	s.Handle("ascm", "RemoveResourceGroup", removeResourceGroup)
+	s.Handle("ascm", "GetOrganizationList", getOrganizationList)
+	s.Handle("ascm", "CreateOrganization", createOrganization)
+	s.Handle("ascm", "UpdateOrganization", updateOrganization)
+	s.Handle("ascm", "RemoveOrganization", removeOrganization)
+	s.Handle("ascm", "GetQuota", getQuota)
+
+	// The provider looks up its department and resource group by the resource set name when it is configured.
+	s.Seed(kindOrganization, strconv.Itoa(DefaultDepartmentId), newOrganization(DefaultDepartmentId, "root", 0))
+	s.Seed(kindResourceGroup, strconv.Itoa(DefaultResourceGroupId), newResourceGroup(DefaultResourceGroupId, DefaultResourceSetName, DefaultDepartmentId))
+}
+
+// ascmResponse returns the response in the format of the ascm apis, which is different from the other products.
+func ascmResponse(data interface{}) map[string]interface{} {
+	return map[string]interface{}{
+		"code":         "200",
+		"cost":         1,
+		"data":         data,
+		"message":      "",
+		"pureListData": false,
+		"redirect":     false,
+		"success":      true,
+		"asapiSuccess": true,
+	}
+}
+
+func ascmError(code, message string) *Error {
+	return NewError(http.StatusBadRequest, code, message)
+}
+
+func newResourceGroup(id int, name string, organizationId int) map[string]interface{} {
+	return map[string]interface{}{
+		"id":                id,
+		"resourceGroupName": name,
+		"organizationID":    organizationId,
+		"rsId":              fmt.Sprintf("rs-mock%08d", id),
+		"resourceGroupType": 0,
+		"creator":           "mock",
+		"gmtCreated":        time.Now().UnixNano() / int64(time.Millisecond),
+		"gmtModified":       time.Now().UnixNano() / int64(time.Millisecond),
+	}
+}
+
+func newOrganization(id int, name string, parentId int) map[string]interface{} {
+	return map[string]interface{}{
+		"id":                id,
+		"name":              name,
+		"alias":             name,
+		"parentId":          parentId,
+		"level":             "level1",
+		"internal":          false,
+		"uuid":              fmt.Sprintf("mock-organization-%d", id),
+		"supportRegionList": []string{},
+		"multiCloudStatus":  "",
+		"cuserId":           "mock",
+		"muserId":           "mock",
+		"mtime":             time.Now().UnixNano() / int64(time.Millisecond),
+	}
+}
+
+func listResourceGroup(s *Server, request *Request) (interface{}, error) {
+	groups := s.list(kindResourceGroup, func(o map[string]interface{}) bool {
+		if v := request.Get("resourceGroupName"); v != "" && !strings.Contains(o["resourceGroupName"].(string), v) {
+			return false
+		}
+		if v := request.Get("id"); v != "" && strconv.Itoa(o["id"].(int)) != v {
+			return false
+		}
+		return true
+	})
+	response := ascmResponse(s.views(groups))
+	response["pageInfo"] = map[string]interface{}{"currentPage": 1, "pageSize": len(groups), "total": len(groups), "totalPage": 1}
+	return response, nil
+}
+
+func createResourceGroup(s *Server, request *Request) (interface{}, error) {
+	name := request.Get("resource_group_name")
+	if name == "" {
+		return nil, MissingParameterError("resource_group_name")
+	}
+	for _, group := range s.list(kindResourceGroup, nil) {
+		if group["resourceGroupName"] == name {
+			return nil, ascmError("ErrorResourceGroupExist", fmt.Sprintf("The resource group %s already exists.", name))
+		}
+	}
+	id := s.nextNumber()
+	s.put(kindResourceGroup, strconv.Itoa(id), newResourceGroup(id, name, atoiDefault(request.Get("organization_id"), DefaultDepartmentId)))
+	return ascmResponse(map[string]interface{}{"id": id}), nil
+}
+
+func updateResourceGroup(s *Server, request *Request) (interface{}, error) {
+	group, ok := s.get(kindResourceGroup, request.Get("id"))
+	if !ok {
+		return nil, ascmError("ErrorResourceGroupNotFound", fmt.Sprintf("The resource group %s does not exist.", request.Get("id")))
+	}
+	if v := request.Get("resourceGroupName"); v != "" {
+		group["resourceGroupName"] = v
+	}
+	return ascmResponse(nil), nil
+}
+
+func removeResourceGroup(s *Server, request *Request) (interface{}, error) {
+	for _, group := range s.list(kindResourceGroup, nil) {
+		if group["resourceGroupName"] == request.Get("resourceGroupName") || strconv.Itoa(group["id"].(int)) == request.Get("id") {
+			s.delete(kindResourceGroup, strconv.Itoa(group["id"].(int)))
+			return ascmResponse(nil), nil
+		}
+	}
+	return nil, ascmError("ErrorResourceGroupNotFound", fmt.Sprintf("The resource group %s does not exist.", request.Get("resourceGroupName")))
+}
+
+func getOrganizationList(s *Server, request *Request) (interface{}, error) {
+	organizations := s.list(kindOrganization, func(o map[string]interface{}) bool {
+		if v := request.Get("name"); v != "" && o["name"] != v {
+			return false
+		}
+		if v := request.Get("id"); v != "" && strconv.Itoa(o["id"].(int)) != v {
+			return false
+		}
+		return true
+	})
+	return ascmResponse(s.views(organizations)), nil
+}
+
+func createOrganization(s *Server, request *Request) (interface{}, error) {
+	name := request.Get("name")
+	if name == "" {
+		return nil, MissingParameterError("name")
+	}
+	parentId := atoiDefault(request.Get("ParentId"), DefaultDepartmentId)
+	if _, ok := s.get(kindOrganization, strconv.Itoa(parentId)); !ok {
+		return nil, ascmError("ErrorOrganizationNotFound", fmt.Sprintf("The parent organization %d does not exist.", parentId))
+	}
+	id := s.nextNumber()
+	s.put(kindOrganization, strconv.Itoa(id), newOrganization(id, name, parentId))
+	return ascmResponse(map[string]interface{}{"id": id, "name": name, "parentId": parentId}), nil
+}
+
+func updateOrganization(s *Server, request *Request) (interface{}, error) {
+	organization, ok := s.get(kindOrganization, request.Get("id"))
+	if !ok {
+		return nil, ascmError("ErrorOrganizationNotFound", fmt.Sprintf("The organization %s does not exist.", request.Get("id")))
+	}
+	if v := request.Get("name"); v != "" {
+		organization["name"] = v
+		organization["alias"] = v
+	}
+	return ascmResponse(nil), nil
+}
+
+func removeOrganization(s *Server, request *Request) (interface{}, error) {
+	id := request.Get("id")
+	if _, ok := s.get(kindOrganization, id); !ok {
+		return nil, ascmError("ErrorOrganizationNotFound", fmt.Sprintf("The organization %s does not exist.", id))
+	}
+	for _, organization := range s.list(kindOrganization, nil) {
+		if strconv.Itoa(organization["parentId"].(int)) == id {
+			return nil, ascmError("ErrorOrganizationHasChildren", fmt.Sprintf("The organization %s still has children.", id))
+		}
+	}
+	s.delete(kindOrganization, id)
+	return ascmResponse(nil), nil
+}
+
+func getQuota(s *Server, request *Request) (interface{}, error) {
+	id := strings.Join([]string{request.Get("productName"), request.Get("quotaType"), request.Get("quotaTypeId")}, ":")
+	quota, ok := s.get(kindQuota, id)
+	if !ok {
+		return nil, ascmError("ErrorQuotaNotFound", fmt.Sprintf("The quota %s does not exist.", id))
+	}
+	return ascmResponse(s.view(quota)), nil
+}
@@ -0,0 +1,60 @@
+package mockserver
+
+import (
+	"encoding/json"
+	"net/http"
+)
+
+// The tag actions of cs are common requests sent to the ecs endpoint with the Product CS, and their parameters are
+// json values in the lowercase names of the cs api.
+func registerCsHandlers(s *Server) {
+	s.Handle("cs", "TagResources", tagCsResources)
+	s.Handle("cs", "UntagResources", untagCsResources)
+	s.Handle("cs", "ListTagResources", listCsTagResources)
+}
+
+func tagCsResources(s *Server, request *Request) (interface{}, error) {
+	var body struct {
+		ResourceIds []string `json:"resource_ids"`
+		Tags        []struct {
+			Key   string `json:"key"`
+			Value string `json:"value"`
+		} `json:"tags"`
+	}
+	if err := json.Unmarshal([]byte(request.Get("X-acs-body")), &body); err != nil {
+		return nil, NewError(http.StatusBadRequest, "InvalidParameter", "The body of TagResources is invalid: "+err.Error())
+	}
+	tags := make(map[string]string)
+	for _, tag := range body.Tags {
+		tags[tag.Key] = tag.Value
+	}
+	for _, id := range body.ResourceIds {
+		s.setTags(id, tags)
+	}
+	return nil, nil
+}
+
+func untagCsResources(s *Server, request *Request) (interface{}, error) {
+	keys := request.List("tag_keys")
+	for _, id := range request.List("resource_ids") {
+		s.removeTags(id, keys)
+	}
+	return nil, nil
+}
+
+func listCsTagResources(s *Server, request *Request) (interface{}, error) {
+	resources := make([]map[string]interface{}, 0)
+	for _, id := range request.List("resource_ids") {
+		for _, tag := range s.tagList(id)["Tag"].([]map[string]interface{}) {
+			resources = append(resources, map[string]interface{}{
+				"resource_id":   id,
+				"resource_type": request.Get("resource_type"),
+				"tag_key":       tag["TagKey"],
+				"tag_value":     tag["TagValue"],
+			})
+		}
+	}
+	return map[string]interface{}{
+		"tag_resources": map[string]interface{}{"tag_resource": resources},
+	}, nil
+}
@@ -0,0 +1,581 @@
+package mockserver
+
+import (
+	"fmt"
+	"net/http"
+	"strconv"
+	"strings"
+)
+
+const (
+	kindSecurityGroup = "security_group"
+	kindInstance      = "instance"
+	kindDisk          = "disk"
+)
+
+func registerEcsHandlers(s *Server) {
+	s.Handle("ecs", "DescribeRegions", describeRegions)
+	s.Handle("ecs", "CreateSecurityGroup", createSecurityGroup)
+	s.Handle("ecs", "DescribeSecurityGroupAttribute", describeSecurityGroupAttribute)
+	s.Handle("ecs", "DescribeSecurityGroups", describeSecurityGroups)
+	s.Handle("ecs", "ModifySecurityGroupAttribute", modifySecurityGroupAttribute)
+	s.Handle("ecs", "ModifySecurityGroupPolicy", modifySecurityGroupPolicy)
+	s.Handle("ecs", "AuthorizeSecurityGroup", authorizeSecurityGroup("ingress"))
+	s.Handle("ecs", "AuthorizeSecurityGroupEgress", authorizeSecurityGroup("egress"))
+	s.Handle("ecs", "RevokeSecurityGroup", revokeSecurityGroup("ingress"))
+	s.Handle("ecs", "RevokeSecurityGroupEgress", revokeSecurityGroup("egress"))
+	s.Handle("ecs", "DeleteSecurityGroup", deleteSecurityGroup)
+	s.Handle("ecs", "RunInstances", runInstances)
+	s.Handle("ecs", "DescribeInstances", describeInstances)
+	s.Handle("ecs", "DescribeInstanceAttribute", describeInstanceAttribute)
+	s.Handle("ecs", "DescribeDisks", describeDisks)
+	s.Handle("ecs", "DescribeUserData", describeUserData)
+	s.Handle("ecs", "DescribeInstanceRamRole", describeInstanceRamRole)
+	s.Handle("ecs", "DescribeInstanceAutoRenewAttribute", describeInstanceAutoRenewAttribute)
+	s.Handle("ecs", "ModifyInstanceAttribute", modifyInstanceAttribute)
+	s.Handle("ecs", "StartInstance", setInstanceStatus("Running"))
+	s.Handle("ecs", "StopInstance", setInstanceStatus("Stopped"))
+	s.Handle("ecs", "RebootInstance", setInstanceStatus("Running"))
+	s.Handle("ecs", "JoinSecurityGroup", joinSecurityGroup)
+	s.Handle("ecs", "LeaveSecurityGroup", leaveSecurityGroup)
+	s.Handle("ecs", "DeleteInstance", deleteInstance)
+	s.Handle("ecs", "AddTags", addTags)
+	s.Handle("ecs", "RemoveTags", removeTags)
+	s.Handle("ecs", "DescribeTags", describeTags)
+	s.Handle("ecs", "TagResources", tagResources)
+	s.Handle("ecs", "UnTagResources", unTagResources)
+	s.Handle("ecs", "ListTagResources", listTagResources)
+}
+
+func describeRegions(s *Server, request *Request) (interface{}, error) {
+	return map[string]interface{}{
+		"Regions": map[string]interface{}{
+			"Region": []map[string]interface{}{{"RegionId": s.RegionId, "LocalName": s.RegionId, "Status": "available"}},
+		},
+	}, nil
+}
+
+func createSecurityGroup(s *Server, request *Request) (interface{}, error) {
+	if v := request.Get("VpcId"); v != "" {
+		if _, err := s.requireObject(kindVpc, v, "InvalidVpcId.NotFound"); err != nil {
+			return nil, err
+		}
+	}
+	id := s.NewId("sg")
+	s.put(kindSecurityGroup, id, map[string]interface{}{
+		"SecurityGroupId":   id,
+		"SecurityGroupName": request.Get("SecurityGroupName"),
+		"Description":       request.Get("Description"),
+		"VpcId":             request.Get("VpcId"),
+		"InnerAccessPolicy": "Accept",
+		"SecurityGroupType": "normal",
+		"RegionId":          s.RegionId,
+		"ResourceGroupId":   request.Get("ResourceGroupId"),
+		"CreationTime":      now(),
+		"_Permissions":      []map[string]interface{}{},
+	})
+	return map[string]interface{}{"SecurityGroupId": id}, nil
+}
+
+func describeSecurityGroupAttribute(s *Server, request *Request) (interface{}, error) {
+	group, err := s.requireObject(kindSecurityGroup, request.Get("SecurityGroupId"), "InvalidSecurityGroupId.NotFound")
+	if err != nil {
+		return nil, err
+	}
+	view := s.view(group)
+	permissions := make([]map[string]interface{}, 0)
+	for _, permission := range group["_Permissions"].([]map[string]interface{}) {
+		if v := request.Get("Direction"); v == "" || v == "all" || v == permission["Direction"] {
+			permissions = append(permissions, permission)
+		}
+	}
+	view["Permissions"] = map[string]interface{}{"Permission": permissions}
+	return view, nil
+}
+
+func describeSecurityGroups(s *Server, request *Request) (interface{}, error) {
+	groups := s.list(kindSecurityGroup, func(o map[string]interface{}) bool {
+		if v := request.Get("SecurityGroupId"); v != "" && o["SecurityGroupId"] != v {
+			return false
+		}
+		if v := request.List("SecurityGroupIds"); len(v) > 0 && !contains(v, o["SecurityGroupId"].(string)) {
+			return false
+		}
+		if v := request.Get("VpcId"); v != "" && o["VpcId"] != v {
+			return false
+		}
+		if v := request.Get("SecurityGroupName"); v != "" && o["SecurityGroupName"] != v {
+			return false
+		}
+		return true
+	})
+	items, number, size := page(request, groups)
+	views := make([]map[string]interface{}, 0, len(items))
+	for _, group := range items {
+		view := s.view(group)
+		view["Tags"] = s.tagList(group["SecurityGroupId"].(string))
+		views = append(views, view)
+	}
+	return map[string]interface{}{
+		"TotalCount":     len(groups),
+		"PageNumber":     number,
+		"PageSize":       size,
+		"RegionId":       s.RegionId,
+		"SecurityGroups": map[string]interface{}{"SecurityGroup": views},
+	}, nil
+}
+
+func modifySecurityGroupAttribute(s *Server, request *Request) (interface{}, error) {
+	group, err := s.requireObject(kindSecurityGroup, request.Get("SecurityGroupId"), "InvalidSecurityGroupId.NotFound")
+	if err != nil {
+		return nil, err
+	}
+	updateFields(group, request, "SecurityGroupName", "Description")
+	return nil, nil
+}
+
+func modifySecurityGroupPolicy(s *Server, request *Request) (interface{}, error) {
+	group, err := s.requireObject(kindSecurityGroup, request.Get("SecurityGroupId"), "InvalidSecurityGroupId.NotFound")
+	if err != nil {
+		return nil, err
+	}
+	updateFields(group, request, "InnerAccessPolicy")
+	return nil, nil
+}
+
+func securityGroupPermission(request *Request, direction string) map[string]interface{} {
+	permission := map[string]interface{}{
+		"Direction":    direction,
+		"IpProtocol":   strings.ToUpper(request.Get("IpProtocol")),
+		"PortRange":    request.Get("PortRange"),
+		"Policy":       request.Get("Policy"),
+		"NicType":      request.Get("NicType"),
+		"Priority":     request.Get("Priority"),
+		"Description":  request.Get("Description"),
+		"CreateTime":   now(),
+		"SourceCidrIp": request.Get("SourceCidrIp"),
+		"DestCidrIp":   request.Get("DestCidrIp"),
+	}
+	if direction == "ingress" {
+		permission["SourceGroupId"] = request.Get("SourceGroupId")
+	} else {
+		permission["DestGroupId"] = request.Get("DestGroupId")
+	}
+	if permission["Policy"] == "" {
+		permission["Policy"] = "Accept"
+	}
+	if permission["NicType"] == "" {
+		permission["NicType"] = "intranet"
+	}
+	if permission["Priority"] == "" {
+		permission["Priority"] = "1"
+	}
+	return permission
+}
+
+func samePermission(a, b map[string]interface{}) bool {
+	for _, key := range []string{"Direction", "IpProtocol", "PortRange", "Policy", "NicType", "SourceCidrIp", "DestCidrIp"} {
+		if !strings.EqualFold(fmt.Sprint(a[key]), fmt.Sprint(b[key])) {
+			return false
+		}
+	}
+	return true
+}
+
+func authorizeSecurityGroup(direction string) HandlerFunc {
+	return func(s *Server, request *Request) (interface{}, error) {
+		group, err := s.requireObject(kindSecurityGroup, request.Get("SecurityGroupId"), "InvalidSecurityGroupId.NotFound")
+		if err != nil {
+			return nil, err
+		}
+		permission := securityGroupPermission(request, direction)
+		permissions := group["_Permissions"].([]map[string]interface{})
+		for _, p := range permissions {
+			if samePermission(p, permission) {
+				return nil, NewError(http.StatusBadRequest, "InvalidPermission.Duplicate", "The specified rule exists.")
+			}
+		}
+		group["_Permissions"] = append(permissions, permission)
+		return nil, nil
+	}
+}
+
+func revokeSecurityGroup(direction string) HandlerFunc {
+	return func(s *Server, request *Request) (interface{}, error) {
+		group, err := s.requireObject(kindSecurityGroup, request.Get("SecurityGroupId"), "InvalidSecurityGroupId.NotFound")
+		if err != nil {
+			return nil, err
+		}
+		permission := securityGroupPermission(request, direction)
+		permissions := make([]map[string]interface{}, 0)
+		for _, p := range group["_Permissions"].([]map[string]interface{}) {
+			if !samePermission(p, permission) {
+				permissions = append(permissions, p)
+			}
+		}
+		group["_Permissions"] = permissions
+		return nil, nil
+	}
+}
+
+func deleteSecurityGroup(s *Server, request *Request) (interface{}, error) {
+	group, err := s.requireObject(kindSecurityGroup, request.Get("SecurityGroupId"), "InvalidSecurityGroupId.NotFound")
+	if err != nil {
+		return nil, err
+	}
+	id := group["SecurityGroupId"].(string)
+	if len(s.list(kindInstance, func(o map[string]interface{}) bool { return contains(o["_SecurityGroupIds"].([]string), id) })) > 0 {
+		return nil, NewError(http.StatusBadRequest, "DependencyViolation", "There is still instance(s) in the specified security group.")
+	}
+	s.delete(kindSecurityGroup, id)
+	return nil, nil
+}
+
+func runInstances(s *Server, request *Request) (interface{}, error) {
+	for _, name := range []string{"ImageId", "InstanceType"} {
+		if request.Get(name) == "" {
+			return nil, MissingParameterError(name)
+		}
+	}
+	securityGroupIds := request.List("SecurityGroupIds")
+	if v := request.Get("SecurityGroupId"); v != "" {
+		securityGroupIds = append([]string{v}, securityGroupIds...)
+	}
+	for _, id := range securityGroupIds {
+		if _, err := s.requireObject(kindSecurityGroup, id, "InvalidSecurityGroupId.NotFound"); err != nil {
+			return nil, err
+		}
+	}
+	zoneId, vpcId, vswitchId := request.Get("ZoneId"), "", request.Get("VSwitchId")
+	if vswitchId != "" {
+		vswitch, err := s.requireObject(kindVSwitch, vswitchId, "InvalidVSwitchId.NotFound")
+		if err != nil {
+			return nil, err
+		}
+		vpcId = vswitch["VpcId"].(string)
+		zoneId = vswitch["ZoneId"].(string)
+	}
+	if zoneId == "" {
+		zoneId = s.ZoneId
+	}
+	amount := 1
+	if v, err := strconv.Atoi(request.Get("Amount")); err == nil && v > 0 {
+		amount = v
+	}
+	chargeType := request.Get("InstanceChargeType")
+	if chargeType == "" {
+		chargeType = "PostPaid"
+	}
+
+	ids := make([]string, 0, amount)
+	for i := 0; i < amount; i++ {
+		id := s.NewId("i")
+		ip := request.Get("PrivateIpAddress")
+		if ip == "" || amount > 1 {
+			number := s.nextNumber()
+			ip = fmt.Sprintf("192.168.%d.%d", number/250%250, number%250+2)
+		}
+		name := request.Get("InstanceName")
+		if name == "" {
+			name = id
+		}
+		hostName := request.Get("HostName")
+		if hostName == "" {
+			hostName = id
+		}
+		s.put(kindInstance, id, map[string]interface{}{
+			"InstanceId":              id,
+			"InstanceName":            name,
+			"Description":             request.Get("Description"),
+			"HostName":                hostName,
+			"ImageId":                 request.Get("ImageId"),
+			"InstanceType":            request.Get("InstanceType"),
+			"Status":                  "Running",
+			"RegionId":                s.RegionId,
+			"ZoneId":                  zoneId,
+			"InstanceChargeType":      chargeType,
+			"InternetChargeType":      request.Get("InternetChargeType"),
+			"InternetMaxBandwidthIn":  atoi(request.Get("InternetMaxBandwidthIn")),
+			"InternetMaxBandwidthOut": atoi(request.Get("InternetMaxBandwidthOut")),
+			"KeyPairName":             request.Get("KeyPairName"),
+			"ResourceGroupId":         request.Get("ResourceGroupId"),
+			"InstanceNetworkType":     "vpc",
+			"IoOptimized":             true,
+			"OSType":                  "linux",
+			"Cpu":                     2,
+			"Memory":                  4096,
+			"CreationTime":            now(),
+			"_VpcId":                  vpcId,
+			"_VSwitchId":              vswitchId,
+			"_PrivateIpAddress":       ip,
+			"_SecurityGroupIds":       securityGroupIds,
+			"_UserData":               request.Get("UserData"),
+			"_RamRoleName":            request.Get("RamRoleName"),
+		})
+		s.setTags(id, requestTags(request))
+
+		diskId := s.NewId("d")
+		category := request.Get("SystemDisk.Category")
+		if category == "" {
+			category = "cloud_efficiency"
+		}
+		s.put(kindDisk, diskId, map[string]interface{}{
+			"DiskId":             diskId,
+			"DiskName":           request.Get("SystemDisk.DiskName"),
+			"Description":        request.Get("SystemDisk.Description"),
+			"InstanceId":         id,
+			"Type":               "system",
+			"Category":           category,
+			"Size":               atoiDefault(request.Get("SystemDisk.Size"), 40),
+			"ImageId":            request.Get("ImageId"),
+			"Status":             "In_use",
+			"RegionId":           s.RegionId,
+			"ZoneId":             zoneId,
+			"Portable":           false,
+			"DeleteWithInstance": true,
+			"CreationTime":       now(),
+		})
+		ids = append(ids, id)
+	}
+	return map[string]interface{}{
+		"InstanceIdSets": map[string]interface{}{"InstanceIdSet": ids},
+	}, nil
+}
+
+func (s *Server) instanceView(instance map[string]interface{}) map[string]interface{} {
+	view := s.view(instance)
+	view["VpcAttributes"] = map[string]interface{}{
+		"VpcId":            instance["_VpcId"],
+		"VSwitchId":        instance["_VSwitchId"],
+		"NatIpAddress":     "",
+		"PrivateIpAddress": map[string]interface{}{"IpAddress": []interface{}{instance["_PrivateIpAddress"]}},
+	}
+	view["InnerIpAddress"] = map[string]interface{}{"IpAddress": []string{}}
+	view["PublicIpAddress"] = map[string]interface{}{"IpAddress": []string{}}
+	view["EipAddress"] = map[string]interface{}{"AllocationId": "", "IpAddress": ""}
+	view["SecurityGroupIds"] = map[string]interface{}{"SecurityGroupId": instance["_SecurityGroupIds"]}
+	view["Tags"] = s.tagList(instance["InstanceId"].(string))
+	return view
+}
+
+func describeInstances(s *Server, request *Request) (interface{}, error) {
+	instances := s.list(kindInstance, func(o map[string]interface{}) bool {
+		if v := request.List("InstanceIds"); len(v) > 0 && !contains(v, o["InstanceId"].(string)) {
+			return false
+		}
+		if v := request.Get("VpcId"); v != "" && o["_VpcId"] != v {
+			return false
+		}
+		if v := request.Get("VSwitchId"); v != "" && o["_VSwitchId"] != v {
+			return false
+		}
+		if v := request.Get("InstanceName"); v != "" && o["InstanceName"] != v {
+			return false
+		}
+		if v := request.Get("Status"); v != "" && o["Status"] != v {
+			return false
+		}
+		return true
+	})
+	items, number, size := page(request, instances)
+	views := make([]map[string]interface{}, 0, len(items))
+	for _, instance := range items {
+		views = append(views, s.instanceView(instance))
+	}
+	return map[string]interface{}{
+		"TotalCount": len(instances),
+		"PageNumber": number,
+		"PageSize":   size,
+		"Instances":  map[string]interface{}{"Instance": views},
+	}, nil
+}
+
+func describeInstanceAttribute(s *Server, request *Request) (interface{}, error) {
+	instance, err := s.requireObject(kindInstance, request.Get("InstanceId"), "InvalidInstanceId.NotFound")
+	if err != nil {
+		return nil, err
+	}
+	return s.instanceView(instance), nil
+}
+
+func describeDisks(s *Server, request *Request) (interface{}, error) {
+	disks := s.list(kindDisk, func(o map[string]interface{}) bool {
+		if v := request.Get("InstanceId"); v != "" && o["InstanceId"] != v {
+			return false
+		}
+		if v := request.List("DiskIds"); len(v) > 0 && !contains(v, o["DiskId"].(string)) {
+			return false
+		}
+		if v := request.Get("DiskType"); v != "" && v != "all" && o["Type"] != v {
+			return false
+		}
+		return true
+	})
+	items, number, size := page(request, disks)
+	views := make([]map[string]interface{}, 0, len(items))
+	for _, disk := range items {
+		view := s.view(disk)
+		view["Tags"] = s.tagList(disk["DiskId"].(string))
+		views = append(views, view)
+	}
+	return map[string]interface{}{
+		"TotalCount": len(disks),
+		"PageNumber": number,
+		"PageSize":   size,
+		"Disks":      map[string]interface{}{"Disk": views},
+	}, nil
+}
+
+func describeUserData(s *Server, request *Request) (interface{}, error) {
+	instance, err := s.requireObject(kindInstance, request.Get("InstanceId"), "InvalidInstanceId.NotFound")
+	if err != nil {
+		return nil, err
+	}
+	return map[string]interface{}{
+		"InstanceId": instance["InstanceId"],
+		"RegionId":   s.RegionId,
+		"UserData":   instance["_UserData"],
+	}, nil
+}
+
+func describeInstanceRamRole(s *Server, request *Request) (interface{}, error) {
+	sets := make([]map[string]interface{}, 0)
+	for _, id := range request.List("InstanceIds") {
+		if instance, ok := s.get(kindInstance, id); ok && instance["_RamRoleName"] != "" {
+			sets = append(sets, map[string]interface{}{"InstanceId": id, "RamRoleName": instance["_RamRoleName"]})
+		}
+	}
+	return map[string]interface{}{
+		"TotalCount":          len(sets),
+		"InstanceRamRoleSets": map[string]interface{}{"InstanceRamRoleSet": sets},
+	}, nil
+}
+
+func describeInstanceAutoRenewAttribute(s *Server, request *Request) (interface{}, error) {
+	attributes := make([]map[string]interface{}, 0)
+	for _, id := range strings.Split(request.Get("InstanceId"), ",") {
+		if _, ok := s.get(kindInstance, id); ok {
+			attributes = append(attributes, map[string]interface{}{
+				"InstanceId":       id,
+				"AutoRenewEnabled": false,
+				"Duration":         0,
+				"PeriodUnit":       "Month",
+				"RenewalStatus":    "Normal",
+			})
+		}
+	}
+	return map[string]interface{}{
+		"TotalCount":              len(attributes),
+		"InstanceRenewAttributes": map[string]interface{}{"InstanceRenewAttribute": attributes},
+	}, nil
+}
+
+func modifyInstanceAttribute(s *Server, request *Request) (interface{}, error) {
+	instance, err := s.requireObject(kindInstance, request.Get("InstanceId"), "InvalidInstanceId.NotFound")
+	if err != nil {
+		return nil, err
+	}
+	updateFields(instance, request, "InstanceName", "Description", "HostName")
+	if v, ok := request.Params["UserData"]; ok {
+		instance["_UserData"] = v
+	}
+	return nil, nil
+}
+
+func setInstanceStatus(status string) HandlerFunc {
+	return func(s *Server, request *Request) (interface{}, error) {
+		instance, err := s.requireObject(kindInstance, request.Get("InstanceId"), "InvalidInstanceId.NotFound")
+		if err != nil {
+			return nil, err
+		}
+		instance["Status"] = status
+		return nil, nil
+	}
+}
+
+func joinSecurityGroup(s *Server, request *Request) (interface{}, error) {
+	instance, err := s.requireObject(kindInstance, request.Get("InstanceId"), "InvalidInstanceId.NotFound")
+	if err != nil {
+		return nil, err
+	}
+	id := request.Get("SecurityGroupId")
+	if _, err := s.requireObject(kindSecurityGroup, id, "InvalidSecurityGroupId.NotFound"); err != nil {
+		return nil, err
+	}
+	groups := instance["_SecurityGroupIds"].([]string)
+	if contains(groups, id) {
+		return nil, NewError(http.StatusBadRequest, "InvalidInstanceId.AlreadyExists", "The specified instance already exists in the specified security group.")
+	}
+	instance["_SecurityGroupIds"] = append(append([]string{}, groups...), id)
+	return nil, nil
+}
+
+func leaveSecurityGroup(s *Server, request *Request) (interface{}, error) {
+	instance, err := s.requireObject(kindInstance, request.Get("InstanceId"), "InvalidInstanceId.NotFound")
+	if err != nil {
+		return nil, err
+	}
+	id := request.Get("SecurityGroupId")
+	groups := make([]string, 0)
+	for _, group := range instance["_SecurityGroupIds"].([]string) {
+		if group != id {
+			groups = append(groups, group)
+		}
+	}
+	instance["_SecurityGroupIds"] = groups
+	return nil, nil
+}
+
+func deleteInstance(s *Server, request *Request) (interface{}, error) {
+	instance, err := s.requireObject(kindInstance, request.Get("InstanceId"), "InvalidInstanceId.NotFound")
+	if err != nil {
+		return nil, err
+	}
+	if instance["Status"] != "Stopped" && request.Get("Force") != "true" {
+		return nil, NewError(http.StatusForbidden, "IncorrectInstanceStatus", "The current status of the resource does not support this operation.")
+	}
+	id := instance["InstanceId"].(string)
+	for _, disk := range s.list(kindDisk, func(o map[string]interface{}) bool { return o["InstanceId"] == id }) {
+		s.delete(kindDisk, disk["DiskId"].(string))
+	}
+	s.delete(kindInstance, id)
+	return nil, nil
+}
+
+func addTags(s *Server, request *Request) (interface{}, error) {
+	s.setTags(request.Get("ResourceId"), requestTags(request))
+	return nil, nil
+}
+
+func removeTags(s *Server, request *Request) (interface{}, error) {
+	keys := make([]string, 0)
+	for key := range requestTags(request) {
+		keys = append(keys, key)
+	}
+	s.removeTags(request.Get("ResourceId"), keys)
+	return nil, nil
+}
+
+func describeTags(s *Server, request *Request) (interface{}, error) {
+	tags := make([]map[string]interface{}, 0)
+	if id := request.Get("ResourceId"); id != "" {
+		for _, tag := range s.tagList(id)["Tag"].([]map[string]interface{}) {
+			tags = append(tags, map[string]interface{}{"TagKey": tag["TagKey"], "TagValue": tag["TagValue"]})
+		}
+	}
+	return map[string]interface{}{
+		"TotalCount": len(tags),
+		"Tags":       map[string]interface{}{"Tag": tags},
+	}, nil
+}
+
+func atoi(value string) int {
+	return atoiDefault(value, 0)
+}
+
+func atoiDefault(value string, defaultValue int) int {
+	if v, err := strconv.Atoi(value); err == nil {
+		return v
+	}
+	return defaultValue
+}
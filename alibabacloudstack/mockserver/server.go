@@ -0,0 +1,439 @@
+// Package mockserver provides an in-process fake of the RPC style Apsara Stack OpenAPI.
+//
+// The server answers the requests sent by the aliyun sdk clients, the tea rpc clients and the common requests,
+// and keeps the created resources in memory, so the resources can run a full create, read, update and delete cycle
+// without a cloud environment. Start it by NewServer, then set the provider `domain` to Server.Domain(), the
+// `protocol` to HTTP and the `resource_group_set_name` to DefaultResourceSetName.
+//
+// The core ECS, VPC, SLB and ASCM actions and the tag actions of CS are supported by default, and more actions can be
+// added by Handle.
+package mockserver
+
+import (
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"log"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+)
+
+const (
+	DefaultRegionId = "cn-mock-1"
+	DefaultZoneId   = "cn-mock-1a"
+
+	DefaultResourceSetName = "tf-mock-resource-set"
+	DefaultDepartmentId    = 1
+	DefaultResourceGroupId = 1
+)
+
+// The products of the requests without a Product parameter are found by their api versions.
+var productVersions = map[string]string{
+	"2014-05-26": "ecs",
+	"2016-04-28": "vpc",
+	"2014-05-15": "slb",
+	"2019-05-10": "ascm",
+}
+
+// Request is a parsed api request. The parameters in the query string and the form body are merged.
+type Request struct {
+	Product string
+	Action  string
+	Version string
+	Params  map[string]string
+}
+
+// Get returns the parameter with the given name, or an empty string.
+func (r *Request) Get(name string) string {
+	return r.Params[name]
+}
+
+// List returns the values of a repeated parameter like SecurityGroupIds.1, SecurityGroupIds.2 or a json array
+// parameter like InstanceIds=["i-1","i-2"].
+func (r *Request) List(name string) []string {
+	if v := strings.TrimSpace(r.Params[name]); v != "" {
+		var values []string
+		if strings.HasPrefix(v, "[") && json.Unmarshal([]byte(v), &values) == nil {
+			return values
+		}
+		return strings.Split(v, ",")
+	}
+	var values []string
+	for i := 1; ; i++ {
+		v, ok := r.Params[fmt.Sprintf("%s.%d", name, i)]
+		if !ok {
+			return values
+		}
+		values = append(values, v)
+	}
+}
+
+// HandlerFunc handles an action. The returned value is written as the json response body, and the RequestId is
+// added when the value is a map. The server is locked while the handler is running.
+type HandlerFunc func(server *Server, request *Request) (interface{}, error)
+
+// Error is returned by the handlers to send an api error response.
+type Error struct {
+	StatusCode int
+	Code       string
+	Message    string
+}
+
+func (e *Error) Error() string {
+	return fmt.Sprintf("%s: %s", e.Code, e.Message)
+}
+
+func NewError(statusCode int, code, message string) *Error {
+	return &Error{StatusCode: statusCode, Code: code, Message: message}
+}
+
+func NotFoundError(code, id string) *Error {
+	return NewError(http.StatusNotFound, code, fmt.Sprintf("The specified resource %s does not exist.", id))
+}
+
+func MissingParameterError(name string) *Error {
+	return NewError(http.StatusBadRequest, "MissingParameter", fmt.Sprintf("The input parameter %s that is mandatory for processing this request is not supplied.", name))
+}
+
+// Server is an in-process fake of the Apsara Stack OpenAPI.
+type Server struct {
+	RegionId string
+	ZoneId   string
+
+	server   *httptest.Server
+	handlers map[string]HandlerFunc
+	objects  map[string]map[string]map[string]interface{}
+	tags     map[string]map[string]string
+	calls    []string
+	sequence int
+	mutex    sync.Mutex
+}
+
+// NewServer starts a server which handles the core ECS, VPC, SLB and ASCM actions and the tag actions of CS.
+func NewServer() *Server {
+	s := NewEmptyServer()
+	registerEcsHandlers(s)
+	registerVpcHandlers(s)
+	registerSlbHandlers(s)
+	registerAscmHandlers(s)
+	registerCsHandlers(s)
+	return s
+}
+
+// NewEmptyServer starts a server without any handler.
+func NewEmptyServer() *Server {
+	s := &Server{
+		RegionId: DefaultRegionId,
+		ZoneId:   DefaultZoneId,
+		handlers: make(map[string]HandlerFunc),
+		objects:  make(map[string]map[string]map[string]interface{}),
+		tags:     make(map[string]map[string]string),
+	}
+	s.server = httptest.NewServer(s)
+	return s
+}
+
+func (s *Server) Close() {
+	s.server.Close()
+}
+
+// Domain returns the address which can be used as the provider domain or the endpoint of a product.
+func (s *Server) Domain() string {
+	return strings.TrimPrefix(s.server.URL, "http://")
+}
+
+func (s *Server) URL() string {
+	return s.server.URL
+}
+
+// Handle registers the handler of an action of a product. The product is case insensitive.
+func (s *Server) Handle(product, action string, handler HandlerFunc) {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+	s.handlers[handlerKey(product, action)] = handler
+}
+
+// Calls returns the product and the action of the handled requests, like "vpc:CreateVpc".
+func (s *Server) Calls() []string {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+	return append([]string{}, s.calls...)
+}
+
+// Object returns a copy of a stored object, it can be used to check the state of the server in the tests.
+func (s *Server) Object(kind, id string) (map[string]interface{}, bool) {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+	object, ok := s.get(kind, id)
+	if !ok {
+		return nil, false
+	}
+	return copyObject(object), true
+}
+
+// Tags returns a copy of the tags of a resource, it can be used to check the tags applied by the tests.
+func (s *Server) Tags(id string) map[string]string {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+	tags := make(map[string]string)
+	for key, value := range s.tags[id] {
+		tags[key] = value
+	}
+	return tags
+}
+
+// Seed stores an object before running the tests, like an existed image or a resource set.
+func (s *Server) Seed(kind, id string, object map[string]interface{}) {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+	s.put(kind, id, object)
+}
+
+func (s *Server) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	request, err := parseRequest(r)
+	if err != nil {
+		writeError(w, NewError(http.StatusBadRequest, "InvalidParameter", err.Error()))
+		return
+	}
+
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+	s.calls = append(s.calls, fmt.Sprintf("%s:%s", request.Product, request.Action))
+	handler, ok := s.handlers[handlerKey(request.Product, request.Action)]
+	if !ok {
+		log.Printf("[WARN] the mock server does not support the action %s of %s", request.Action, request.Product)
+		writeError(w, NewError(http.StatusNotFound, "InvalidAction.NotFound", fmt.Sprintf("Specified api %s of %s is not found.", request.Action, request.Product)))
+		return
+	}
+	response, err := handler(s, request)
+	if err != nil {
+		if e, ok := err.(*Error); ok {
+			writeError(w, e)
+		} else {
+			writeError(w, NewError(http.StatusInternalServerError, "InternalError", err.Error()))
+		}
+		return
+	}
+	if response == nil {
+		response = map[string]interface{}{}
+	}
+	if m, ok := response.(map[string]interface{}); ok {
+		if _, ok := m["RequestId"]; !ok {
+			m["RequestId"] = s.newRequestId()
+		}
+	}
+	writeJson(w, http.StatusOK, response)
+}
+
+// NewId returns an unique id with the prefix, like vpc-mock00000001.
+func (s *Server) NewId(prefix string) string {
+	s.sequence++
+	return fmt.Sprintf("%s-mock%08d", prefix, s.sequence)
+}
+
+func (s *Server) newRequestId() string {
+	s.sequence++
+	return fmt.Sprintf("00000000-0000-0000-0000-%012d", s.sequence)
+}
+
+func (s *Server) nextNumber() int {
+	s.sequence++
+	return s.sequence
+}
+
+func (s *Server) put(kind, id string, object map[string]interface{}) {
+	if _, ok := s.objects[kind]; !ok {
+		s.objects[kind] = make(map[string]map[string]interface{})
+	}
+	object["_sequence"] = s.nextNumber()
+	s.objects[kind][id] = object
+}
+
+func (s *Server) get(kind, id string) (map[string]interface{}, bool) {
+	object, ok := s.objects[kind][id]
+	return object, ok
+}
+
+func (s *Server) delete(kind, id string) {
+	delete(s.objects[kind], id)
+	delete(s.tags, id)
+}
+
+// list returns the objects of the kind in the creation order which match the filter.
+func (s *Server) list(kind string, filter func(map[string]interface{}) bool) []map[string]interface{} {
+	result := make([]map[string]interface{}, 0)
+	for _, object := range s.objects[kind] {
+		if filter == nil || filter(object) {
+			result = append(result, object)
+		}
+	}
+	sort.Slice(result, func(i, j int) bool {
+		return result[i]["_sequence"].(int) < result[j]["_sequence"].(int)
+	})
+	return result
+}
+
+// view returns a copy of the object without the internal fields, which can be written into the response.
+func (s *Server) view(object map[string]interface{}) map[string]interface{} {
+	result := copyObject(object)
+	for key := range result {
+		if strings.HasPrefix(key, "_") {
+			delete(result, key)
+		}
+	}
+	return result
+}
+
+func (s *Server) views(objects []map[string]interface{}) []map[string]interface{} {
+	result := make([]map[string]interface{}, 0, len(objects))
+	for _, object := range objects {
+		result = append(result, s.view(object))
+	}
+	return result
+}
+
+func (s *Server) setTags(id string, tags map[string]string) {
+	if _, ok := s.tags[id]; !ok {
+		s.tags[id] = make(map[string]string)
+	}
+	for key, value := range tags {
+		s.tags[id][key] = value
+	}
+}
+
+func (s *Server) removeTags(id string, keys []string) {
+	for _, key := range keys {
+		delete(s.tags[id], key)
+	}
+}
+
+// tagList returns the tags of a resource in the format of {"Tag": [{"TagKey": "k", "TagValue": "v"}]}.
+func (s *Server) tagList(id string) map[string]interface{} {
+	keys := make([]string, 0, len(s.tags[id]))
+	for key := range s.tags[id] {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	tags := make([]map[string]interface{}, 0, len(keys))
+	for _, key := range keys {
+		tags = append(tags, map[string]interface{}{"TagKey": key, "TagValue": s.tags[id][key], "Key": key, "Value": s.tags[id][key]})
+	}
+	return map[string]interface{}{"Tag": tags}
+}
+
+// requestTags returns the tags in the request parameters like Tag.1.Key and Tag.1.Value.
+func requestTags(request *Request) map[string]string {
+	tags := make(map[string]string)
+	for i := 1; ; i++ {
+		key, ok := request.Params[fmt.Sprintf("Tag.%d.Key", i)]
+		if !ok {
+			return tags
+		}
+		tags[key] = request.Params[fmt.Sprintf("Tag.%d.Value", i)]
+	}
+}
+
+// page returns the objects in the page specified by the PageNumber and PageSize parameters.
+func page(request *Request, objects []map[string]interface{}) ([]map[string]interface{}, int, int) {
+	number, size := 1, 10
+	fmt.Sscan(request.Get("PageNumber"), &number)
+	fmt.Sscan(request.Get("PageSize"), &size)
+	if number < 1 {
+		number = 1
+	}
+	if size < 1 {
+		size = 10
+	}
+	start := (number - 1) * size
+	if start > len(objects) {
+		start = len(objects)
+	}
+	end := start + size
+	if end > len(objects) {
+		end = len(objects)
+	}
+	return objects[start:end], number, size
+}
+
+func parseRequest(r *http.Request) (*Request, error) {
+	params := make(map[string]string)
+	for key, values := range r.URL.Query() {
+		params[key] = values[len(values)-1]
+	}
+	if r.Body != nil {
+		body, err := ioutil.ReadAll(r.Body)
+		if err != nil {
+			return nil, err
+		}
+		if len(body) > 0 && strings.HasPrefix(r.Header.Get("Content-Type"), "application/x-www-form-urlencoded") {
+			values, err := url.ParseQuery(string(body))
+			if err != nil {
+				return nil, err
+			}
+			for key, value := range values {
+				params[key] = value[len(value)-1]
+			}
+		}
+	}
+
+	request := &Request{
+		Action:  params["Action"],
+		Version: params["Version"],
+		Params:  params,
+	}
+	if request.Action == "" {
+		request.Action = r.Header.Get("x-acs-action")
+	}
+	if request.Version == "" {
+		request.Version = r.Header.Get("x-acs-version")
+	}
+	request.Product = strings.ToLower(params["Product"])
+	if request.Product == "" {
+		request.Product = productVersions[request.Version]
+	}
+	if request.Action == "" {
+		return nil, fmt.Errorf("the Action is not specified")
+	}
+	return request, nil
+}
+
+func handlerKey(product, action string) string {
+	return strings.ToLower(product) + ":" + action
+}
+
+func writeError(w http.ResponseWriter, e *Error) {
+	writeJson(w, e.StatusCode, map[string]interface{}{
+		"RequestId": fmt.Sprintf("%d", time.Now().UnixNano()),
+		"Code":      e.Code,
+		"Message":   e.Message,
+		"HostId":    "mock",
+	})
+}
+
+func writeJson(w http.ResponseWriter, statusCode int, body interface{}) {
+	data, err := json.Marshal(body)
+	if err != nil {
+		statusCode = http.StatusInternalServerError
+		data = []byte(fmt.Sprintf(`{"Code":"InternalError","Message":%q}`, err.Error()))
+	}
+	w.Header().Set("Content-Type", "application/json;charset=utf-8")
+	w.WriteHeader(statusCode)
+	w.Write(data)
+}
+
+func copyObject(object map[string]interface{}) map[string]interface{} {
+	result := make(map[string]interface{}, len(object))
+	for key, value := range object {
+		result[key] = value
+	}
+	return result
+}
+
+func now() string {
+	return time.Now().UTC().Format("2006-01-02T15:04Z")
+}
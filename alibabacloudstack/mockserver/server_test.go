@@ -0,0 +1,77 @@
+package mockserver
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/url"
+	"testing"
+)
+
+func TestServerInstanceLifecycle(t *testing.T) {
+	server := NewServer()
+	defer server.Close()
+
+	vpc := doRequest(t, server, http.StatusOK, url.Values{"Action": {"CreateVpc"}, "Version": {"2016-04-28"}, "CidrBlock": {"10.0.0.0/8"}})
+	vswitch := doRequest(t, server, http.StatusOK, url.Values{"Action": {"CreateVSwitch"}, "Product": {"vpc"}, "VpcId": {vpc["VpcId"].(string)}, "CidrBlock": {"10.0.0.0/24"}})
+	group := doRequest(t, server, http.StatusOK, url.Values{"Action": {"CreateSecurityGroup"}, "Product": {"ecs"}, "VpcId": {vpc["VpcId"].(string)}})
+	run := doRequest(t, server, http.StatusOK, url.Values{
+		"Action":          {"RunInstances"},
+		"Product":         {"ecs"},
+		"ImageId":         {"centos_7"},
+		"InstanceType":    {"ecs.n4.large"},
+		"VSwitchId":       {vswitch["VSwitchId"].(string)},
+		"SecurityGroupId": {group["SecurityGroupId"].(string)},
+	})
+	instanceId := run["InstanceIdSets"].(map[string]interface{})["InstanceIdSet"].([]interface{})[0].(string)
+
+	instances := doRequest(t, server, http.StatusOK, url.Values{"Action": {"DescribeInstances"}, "Product": {"ecs"}, "InstanceIds": {`["` + instanceId + `"]`}})
+	instance := instances["Instances"].(map[string]interface{})["Instance"].([]interface{})[0].(map[string]interface{})
+	if instance["Status"] != "Running" || instance["VpcAttributes"].(map[string]interface{})["VSwitchId"] != vswitch["VSwitchId"] {
+		t.Fatalf("unexpected instance: %v", instance)
+	}
+
+	deleteGroup := doRequest(t, server, http.StatusBadRequest, url.Values{"Action": {"DeleteSecurityGroup"}, "Product": {"ecs"}, "SecurityGroupId": {group["SecurityGroupId"].(string)}})
+	if deleteGroup["Code"] != "DependencyViolation" {
+		t.Fatalf("expected the DependencyViolation error, got %v", deleteGroup)
+	}
+	doRequest(t, server, http.StatusOK, url.Values{"Action": {"DeleteInstance"}, "Product": {"ecs"}, "InstanceId": {instanceId}, "Force": {"true"}})
+	notFound := doRequest(t, server, http.StatusNotFound, url.Values{"Action": {"DescribeUserData"}, "Product": {"ecs"}, "InstanceId": {instanceId}})
+	if notFound["Code"] != "InvalidInstanceId.NotFound" {
+		t.Fatalf("expected the InvalidInstanceId.NotFound error, got %v", notFound)
+	}
+}
+
+func TestServerUnsupportedAction(t *testing.T) {
+	server := NewEmptyServer()
+	defer server.Close()
+
+	response := doRequest(t, server, http.StatusNotFound, url.Values{"Action": {"CreateVpc"}, "Product": {"vpc"}})
+	if response["Code"] != "InvalidAction.NotFound" {
+		t.Fatalf("expected the InvalidAction.NotFound error, got %v", response)
+	}
+	server.Handle("Vpc", "CreateVpc", func(server *Server, request *Request) (interface{}, error) {
+		return map[string]interface{}{"VpcId": "vpc-custom"}, nil
+	})
+	if response := doRequest(t, server, http.StatusOK, url.Values{"Action": {"CreateVpc"}, "Product": {"vpc"}}); response["VpcId"] != "vpc-custom" {
+		t.Fatalf("unexpected response of the custom handler: %v", response)
+	}
+	if calls := server.Calls(); len(calls) != 2 || calls[1] != "vpc:CreateVpc" {
+		t.Fatalf("unexpected calls: %v", calls)
+	}
+}
+
+func doRequest(t *testing.T, server *Server, statusCode int, params url.Values) map[string]interface{} {
+	resp, err := http.PostForm(server.URL(), params)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != statusCode {
+		t.Fatalf("%s: expected the status code %d, got %d", params.Get("Action"), statusCode, resp.StatusCode)
+	}
+	result := make(map[string]interface{})
+	if err := json.NewDecoder(resp.Body).Decode(&result); err != nil {
+		t.Fatal(err)
+	}
+	return result
+}
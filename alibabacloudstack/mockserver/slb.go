@@ -0,0 +1,200 @@
+package mockserver
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+)
+
+const kindLoadBalancer = "load_balancer"
+
+func registerSlbHandlers(s *Server) {
+	s.Handle("slb", "CreateLoadBalancer", createLoadBalancer)
+	s.Handle("slb", "DescribeLoadBalancerAttribute", describeLoadBalancerAttribute)
+	s.Handle("slb", "DescribeLoadBalancers", describeLoadBalancers)
+	s.Handle("slb", "SetLoadBalancerName", setLoadBalancerName)
+	s.Handle("slb", "ModifyLoadBalancerInstanceSpec", modifyLoadBalancerInstanceSpec)
+	s.Handle("slb", "SetLoadBalancerStatus", setLoadBalancerStatus)
+	s.Handle("slb", "DeleteLoadBalancer", deleteLoadBalancer)
+	s.Handle("slb", "AddTags", addSlbTags)
+	s.Handle("slb", "RemoveTags", removeSlbTags)
+	s.Handle("slb", "DescribeTags", describeSlbTags)
+}
+
+func createLoadBalancer(s *Server, request *Request) (interface{}, error) {
+	addressType := strings.ToLower(request.Get("AddressType"))
+	if addressType == "" {
+		addressType = "internet"
+	}
+	vpcId, vswitchId, networkType := "", request.Get("VSwitchId"), "classic"
+	if vswitchId != "" {
+		vswitch, err := s.requireObject(kindVSwitch, vswitchId, "InvalidVSwitchId.NotFound")
+		if err != nil {
+			return nil, err
+		}
+		vpcId, networkType = vswitch["VpcId"].(string), "vpc"
+	}
+	id := s.NewId("lb")
+	number := s.nextNumber()
+	address := fmt.Sprintf("10.0.%d.%d", number/250%250, number%250+2)
+	name := request.Get("LoadBalancerName")
+	if name == "" {
+		name = id
+	}
+	s.put(kindLoadBalancer, id, map[string]interface{}{
+		"LoadBalancerId":     id,
+		"LoadBalancerName":   name,
+		"LoadBalancerStatus": "active",
+		"LoadBalancerSpec":   request.Get("LoadBalancerSpec"),
+		"Address":            address,
+		"AddressType":        addressType,
+		"AddressIPVersion":   "ipv4",
+		"NetworkType":        networkType,
+		"VpcId":              vpcId,
+		"VSwitchId":          vswitchId,
+		"PayType":            "PayOnDemand",
+		"InternetChargeType": "paybytraffic",
+		"Bandwidth":          atoiDefault(request.Get("Bandwidth"), 1),
+		"RegionId":           s.RegionId,
+		"MasterZoneId":       s.ZoneId,
+		"ResourceGroupId":    request.Get("ResourceGroupId"),
+		"CreateTime":         now(),
+	})
+	return map[string]interface{}{
+		"LoadBalancerId":   id,
+		"LoadBalancerName": name,
+		"Address":          address,
+		"AddressIPVersion": "ipv4",
+		"NetworkType":      networkType,
+		"VpcId":            vpcId,
+		"VSwitchId":        vswitchId,
+		"ResourceGroupId":  request.Get("ResourceGroupId"),
+	}, nil
+}
+
+func describeLoadBalancerAttribute(s *Server, request *Request) (interface{}, error) {
+	lb, err := s.requireObject(kindLoadBalancer, request.Get("LoadBalancerId"), "InvalidLoadBalancerId.NotFound")
+	if err != nil {
+		return nil, err
+	}
+	view := s.view(lb)
+	view["ListenerPorts"] = map[string]interface{}{"ListenerPort": []int{}}
+	view["ListenerPortsAndProtocal"] = map[string]interface{}{"ListenerPortAndProtocal": []interface{}{}}
+	view["BackendServers"] = map[string]interface{}{"BackendServer": []interface{}{}}
+	return view, nil
+}
+
+func describeLoadBalancers(s *Server, request *Request) (interface{}, error) {
+	lbs := s.list(kindLoadBalancer, func(o map[string]interface{}) bool {
+		if v := request.Get("LoadBalancerId"); v != "" && !contains(strings.Split(v, ","), o["LoadBalancerId"].(string)) {
+			return false
+		}
+		if v := request.Get("LoadBalancerName"); v != "" && o["LoadBalancerName"] != v {
+			return false
+		}
+		if v := request.Get("VpcId"); v != "" && o["VpcId"] != v {
+			return false
+		}
+		if v := request.Get("VSwitchId"); v != "" && o["VSwitchId"] != v {
+			return false
+		}
+		return true
+	})
+	items, number, size := page(request, lbs)
+	return map[string]interface{}{
+		"TotalCount":    len(lbs),
+		"PageNumber":    number,
+		"PageSize":      size,
+		"LoadBalancers": map[string]interface{}{"LoadBalancer": s.views(items)},
+	}, nil
+}
+
+func setLoadBalancerName(s *Server, request *Request) (interface{}, error) {
+	lb, err := s.requireObject(kindLoadBalancer, request.Get("LoadBalancerId"), "InvalidLoadBalancerId.NotFound")
+	if err != nil {
+		return nil, err
+	}
+	updateFields(lb, request, "LoadBalancerName")
+	return nil, nil
+}
+
+func modifyLoadBalancerInstanceSpec(s *Server, request *Request) (interface{}, error) {
+	lb, err := s.requireObject(kindLoadBalancer, request.Get("LoadBalancerId"), "InvalidLoadBalancerId.NotFound")
+	if err != nil {
+		return nil, err
+	}
+	updateFields(lb, request, "LoadBalancerSpec")
+	return nil, nil
+}
+
+func setLoadBalancerStatus(s *Server, request *Request) (interface{}, error) {
+	lb, err := s.requireObject(kindLoadBalancer, request.Get("LoadBalancerId"), "InvalidLoadBalancerId.NotFound")
+	if err != nil {
+		return nil, err
+	}
+	updateFields(lb, request, "LoadBalancerStatus")
+	return nil, nil
+}
+
+func deleteLoadBalancer(s *Server, request *Request) (interface{}, error) {
+	lb, err := s.requireObject(kindLoadBalancer, request.Get("LoadBalancerId"), "InvalidLoadBalancerId.NotFound")
+	if err != nil {
+		return nil, err
+	}
+	s.delete(kindLoadBalancer, lb["LoadBalancerId"].(string))
+	return nil, nil
+}
+
+// slbTags parses the Tags parameter of the slb tag actions, like [{"TagKey":"k","TagValue":"v"}].
+func slbTags(request *Request) (map[string]string, error) {
+	var items []map[string]string
+	if err := json.Unmarshal([]byte(request.Get("Tags")), &items); err != nil {
+		return nil, NewError(http.StatusBadRequest, "InvalidParameter.Tags", err.Error())
+	}
+	tags := make(map[string]string)
+	for _, item := range items {
+		tags[item["TagKey"]] = item["TagValue"]
+	}
+	return tags, nil
+}
+
+func addSlbTags(s *Server, request *Request) (interface{}, error) {
+	if _, err := s.requireObject(kindLoadBalancer, request.Get("LoadBalancerId"), "InvalidLoadBalancerId.NotFound"); err != nil {
+		return nil, err
+	}
+	tags, err := slbTags(request)
+	if err != nil {
+		return nil, err
+	}
+	s.setTags(request.Get("LoadBalancerId"), tags)
+	return nil, nil
+}
+
+func removeSlbTags(s *Server, request *Request) (interface{}, error) {
+	tags, err := slbTags(request)
+	if err != nil {
+		return nil, err
+	}
+	keys := make([]string, 0, len(tags))
+	for key := range tags {
+		keys = append(keys, key)
+	}
+	s.removeTags(request.Get("LoadBalancerId"), keys)
+	return nil, nil
+}
+
+func describeSlbTags(s *Server, request *Request) (interface{}, error) {
+	sets := make([]map[string]interface{}, 0)
+	if id := request.Get("LoadBalancerId"); id != "" {
+		for _, tag := range s.tagList(id)["Tag"].([]map[string]interface{}) {
+			sets = append(sets, map[string]interface{}{"TagKey": tag["TagKey"], "TagValue": tag["TagValue"], "InstanceCount": 1})
+		}
+	}
+	return map[string]interface{}{
+		"TotalCount": len(sets),
+		"PageNumber": 1,
+		"PageSize":   len(sets),
+		"TagSets":    map[string]interface{}{"TagSet": sets},
+	}, nil
+}
@@ -0,0 +1,346 @@
+package mockserver
+
+import (
+	"fmt"
+	"net/http"
+	"strings"
+)
+
+const (
+	kindVpc        = "vpc"
+	kindVSwitch    = "vswitch"
+	kindRouteTable = "route_table"
+)
+
+func registerVpcHandlers(s *Server) {
+	s.Handle("vpc", "CreateVpc", createVpc)
+	s.Handle("vpc", "DescribeVpcs", describeVpcs)
+	s.Handle("vpc", "DescribeVpcAttribute", describeVpcAttribute)
+	s.Handle("vpc", "ModifyVpcAttribute", modifyVpcAttribute)
+	s.Handle("vpc", "DeleteVpc", deleteVpc)
+	s.Handle("vpc", "DescribeRouteTables", describeRouteTables)
+	s.Handle("vpc", "CreateVSwitch", createVSwitch)
+	s.Handle("vpc", "DescribeVSwitchAttributes", describeVSwitchAttributes)
+	s.Handle("vpc", "DescribeVSwitches", describeVSwitches)
+	s.Handle("vpc", "ModifyVSwitchAttribute", modifyVSwitchAttribute)
+	s.Handle("vpc", "DeleteVSwitch", deleteVSwitch)
+	s.Handle("vpc", "TagResources", tagResources)
+	s.Handle("vpc", "UnTagResources", unTagResources)
+	s.Handle("vpc", "ListTagResources", listTagResources)
+}
+
+func createVpc(s *Server, request *Request) (interface{}, error) {
+	cidrBlock := request.Get("CidrBlock")
+	if cidrBlock == "" {
+		cidrBlock = "172.16.0.0/12"
+	}
+	vpcId := s.NewId("vpc")
+	routerId := s.NewId("vrt")
+	routeTableId := s.NewId("vtb")
+	s.put(kindVpc, vpcId, map[string]interface{}{
+		"VpcId":               vpcId,
+		"VpcName":             request.Get("VpcName"),
+		"Description":         request.Get("Description"),
+		"CidrBlock":           cidrBlock,
+		"Ipv6CidrBlock":       "",
+		"Status":              "Available",
+		"VRouterId":           routerId,
+		"RegionId":            s.RegionId,
+		"ResourceGroupId":     request.Get("ResourceGroupId"),
+		"CreationTime":        now(),
+		"IsDefault":           false,
+		"UserCidrs":           map[string]interface{}{"UserCidr": request.List("UserCidr")},
+		"SecondaryCidrBlocks": map[string]interface{}{"SecondaryCidrBlock": []string{}},
+	})
+	s.put(kindRouteTable, routeTableId, map[string]interface{}{
+		"RouteTableId":    routeTableId,
+		"RouteTableType":  "System",
+		"RouteTableName":  "",
+		"VRouterId":       routerId,
+		"VpcId":           vpcId,
+		"Status":          "Available",
+		"ResourceGroupId": request.Get("ResourceGroupId"),
+		"CreationTime":    now(),
+	})
+	return map[string]interface{}{
+		"VpcId":           vpcId,
+		"VRouterId":       routerId,
+		"RouteTableId":    routeTableId,
+		"ResourceGroupId": request.Get("ResourceGroupId"),
+	}, nil
+}
+
+func (s *Server) vpcView(vpc map[string]interface{}) map[string]interface{} {
+	view := s.view(vpc)
+	vswitchIds := make([]string, 0)
+	for _, vswitch := range s.list(kindVSwitch, func(o map[string]interface{}) bool { return o["VpcId"] == vpc["VpcId"] }) {
+		vswitchIds = append(vswitchIds, vswitch["VSwitchId"].(string))
+	}
+	view["VSwitchIds"] = map[string]interface{}{"VSwitchId": vswitchIds}
+	view["Tags"] = s.tagList(vpc["VpcId"].(string))
+	return view
+}
+
+func describeVpcs(s *Server, request *Request) (interface{}, error) {
+	vpcs := s.list(kindVpc, func(o map[string]interface{}) bool {
+		if v := request.Get("VpcId"); v != "" && !contains(strings.Split(v, ","), o["VpcId"].(string)) {
+			return false
+		}
+		if v := request.Get("VpcName"); v != "" && o["VpcName"] != v {
+			return false
+		}
+		return true
+	})
+	items, number, size := page(request, vpcs)
+	views := make([]map[string]interface{}, 0, len(items))
+	for _, vpc := range items {
+		views = append(views, s.vpcView(vpc))
+	}
+	return map[string]interface{}{
+		"TotalCount": len(vpcs),
+		"PageNumber": number,
+		"PageSize":   size,
+		"Vpcs":       map[string]interface{}{"Vpc": views},
+	}, nil
+}
+
+func describeVpcAttribute(s *Server, request *Request) (interface{}, error) {
+	vpc, err := s.requireObject(kindVpc, request.Get("VpcId"), "InvalidVpcID.NotFound")
+	if err != nil {
+		return nil, err
+	}
+	return s.vpcView(vpc), nil
+}
+
+func modifyVpcAttribute(s *Server, request *Request) (interface{}, error) {
+	vpc, err := s.requireObject(kindVpc, request.Get("VpcId"), "InvalidVpcID.NotFound")
+	if err != nil {
+		return nil, err
+	}
+	updateFields(vpc, request, "VpcName", "Description", "CidrBlock")
+	return nil, nil
+}
+
+func deleteVpc(s *Server, request *Request) (interface{}, error) {
+	vpc, err := s.requireObject(kindVpc, request.Get("VpcId"), "InvalidVpcID.NotFound")
+	if err != nil {
+		return nil, err
+	}
+	if len(s.list(kindVSwitch, func(o map[string]interface{}) bool { return o["VpcId"] == vpc["VpcId"] })) > 0 {
+		return nil, NewError(http.StatusBadRequest, "DependencyViolation.VSwitch", "Specified VPC still has vswitches.")
+	}
+	if len(s.list(kindSecurityGroup, func(o map[string]interface{}) bool { return o["VpcId"] == vpc["VpcId"] })) > 0 {
+		return nil, NewError(http.StatusBadRequest, "DependencyViolation.SecurityGroup", "Specified VPC still has security groups.")
+	}
+	for _, table := range s.list(kindRouteTable, func(o map[string]interface{}) bool { return o["VpcId"] == vpc["VpcId"] }) {
+		s.delete(kindRouteTable, table["RouteTableId"].(string))
+	}
+	s.delete(kindVpc, vpc["VpcId"].(string))
+	return nil, nil
+}
+
+func describeRouteTables(s *Server, request *Request) (interface{}, error) {
+	tables := s.list(kindRouteTable, func(o map[string]interface{}) bool {
+		if v := request.Get("VRouterId"); v != "" && o["VRouterId"] != v {
+			return false
+		}
+		if v := request.Get("RouteTableId"); v != "" && o["RouteTableId"] != v {
+			return false
+		}
+		return true
+	})
+	items, number, size := page(request, tables)
+	views := make([]map[string]interface{}, 0, len(items))
+	for _, table := range items {
+		view := s.view(table)
+		vswitchIds := make([]string, 0)
+		for _, vswitch := range s.list(kindVSwitch, func(o map[string]interface{}) bool { return o["VpcId"] == table["VpcId"] }) {
+			vswitchIds = append(vswitchIds, vswitch["VSwitchId"].(string))
+		}
+		view["VSwitchIds"] = map[string]interface{}{"VSwitchId": vswitchIds}
+		view["RouteEntrys"] = map[string]interface{}{"RouteEntry": []interface{}{}}
+		views = append(views, view)
+	}
+	return map[string]interface{}{
+		"TotalCount":  len(tables),
+		"PageNumber":  number,
+		"PageSize":    size,
+		"RouteTables": map[string]interface{}{"RouteTable": views},
+	}, nil
+}
+
+func createVSwitch(s *Server, request *Request) (interface{}, error) {
+	vpc, err := s.requireObject(kindVpc, request.Get("VpcId"), "InvalidVpcID.NotFound")
+	if err != nil {
+		return nil, err
+	}
+	if request.Get("CidrBlock") == "" {
+		return nil, MissingParameterError("CidrBlock")
+	}
+	for _, vswitch := range s.list(kindVSwitch, func(o map[string]interface{}) bool { return o["VpcId"] == vpc["VpcId"] }) {
+		if vswitch["CidrBlock"] == request.Get("CidrBlock") {
+			return nil, NewError(http.StatusBadRequest, "InvalidCidrBlock.Overlapped", "Specified CIDR block overlapped with other subnets.")
+		}
+	}
+	zoneId := request.Get("ZoneId")
+	if zoneId == "" {
+		zoneId = s.ZoneId
+	}
+	vswitchId := s.NewId("vsw")
+	s.put(kindVSwitch, vswitchId, map[string]interface{}{
+		"VSwitchId":               vswitchId,
+		"VSwitchName":             request.Get("VSwitchName"),
+		"Description":             request.Get("Description"),
+		"VpcId":                   vpc["VpcId"],
+		"ZoneId":                  zoneId,
+		"CidrBlock":               request.Get("CidrBlock"),
+		"Status":                  "Available",
+		"AvailableIpAddressCount": 252,
+		"IsDefault":               false,
+		"ResourceGroupId":         vpc["ResourceGroupId"],
+		"CreationTime":            now(),
+	})
+	return map[string]interface{}{"VSwitchId": vswitchId}, nil
+}
+
+func describeVSwitchAttributes(s *Server, request *Request) (interface{}, error) {
+	vswitch, err := s.requireObject(kindVSwitch, request.Get("VSwitchId"), "InvalidVswitchID.NotFound")
+	if err != nil {
+		return nil, err
+	}
+	view := s.view(vswitch)
+	view["RouteTable"] = map[string]interface{}{"RouteTableId": s.systemRouteTableId(vswitch["VpcId"].(string)), "RouteTableType": "System"}
+	return view, nil
+}
+
+func describeVSwitches(s *Server, request *Request) (interface{}, error) {
+	vswitches := s.list(kindVSwitch, func(o map[string]interface{}) bool {
+		if v := request.Get("VSwitchId"); v != "" && !contains(strings.Split(v, ","), o["VSwitchId"].(string)) {
+			return false
+		}
+		if v := request.Get("VpcId"); v != "" && o["VpcId"] != v {
+			return false
+		}
+		if v := request.Get("ZoneId"); v != "" && o["ZoneId"] != v {
+			return false
+		}
+		if v := request.Get("VSwitchName"); v != "" && o["VSwitchName"] != v {
+			return false
+		}
+		return true
+	})
+	items, number, size := page(request, vswitches)
+	views := make([]map[string]interface{}, 0, len(items))
+	for _, vswitch := range items {
+		view := s.view(vswitch)
+		view["Tags"] = s.tagList(vswitch["VSwitchId"].(string))
+		views = append(views, view)
+	}
+	return map[string]interface{}{
+		"TotalCount": len(vswitches),
+		"PageNumber": number,
+		"PageSize":   size,
+		"VSwitches":  map[string]interface{}{"VSwitch": views},
+	}, nil
+}
+
+func modifyVSwitchAttribute(s *Server, request *Request) (interface{}, error) {
+	vswitch, err := s.requireObject(kindVSwitch, request.Get("VSwitchId"), "InvalidVswitchID.NotFound")
+	if err != nil {
+		return nil, err
+	}
+	updateFields(vswitch, request, "VSwitchName", "Description")
+	return nil, nil
+}
+
+func deleteVSwitch(s *Server, request *Request) (interface{}, error) {
+	vswitch, err := s.requireObject(kindVSwitch, request.Get("VSwitchId"), "InvalidVswitchID.NotFound")
+	if err != nil {
+		return nil, err
+	}
+	id := vswitch["VSwitchId"].(string)
+	if len(s.list(kindInstance, func(o map[string]interface{}) bool { return o["_VSwitchId"] == id })) > 0 {
+		return nil, NewError(http.StatusBadRequest, "DependencyViolation", "Specified vswitch still has instances.")
+	}
+	if len(s.list(kindLoadBalancer, func(o map[string]interface{}) bool { return o["VSwitchId"] == id })) > 0 {
+		return nil, NewError(http.StatusBadRequest, "DependencyViolation.SLB", "Specified vswitch still has load balancers.")
+	}
+	s.delete(kindVSwitch, id)
+	return nil, nil
+}
+
+func tagResources(s *Server, request *Request) (interface{}, error) {
+	tags := requestTags(request)
+	for _, id := range request.List("ResourceId") {
+		s.setTags(id, tags)
+	}
+	return nil, nil
+}
+
+func unTagResources(s *Server, request *Request) (interface{}, error) {
+	keys := request.List("TagKey")
+	for _, id := range request.List("ResourceId") {
+		if request.Get("All") == "true" {
+			delete(s.tags, id)
+			continue
+		}
+		s.removeTags(id, keys)
+	}
+	return nil, nil
+}
+
+func listTagResources(s *Server, request *Request) (interface{}, error) {
+	resources := make([]map[string]interface{}, 0)
+	for _, id := range request.List("ResourceId") {
+		for _, tag := range s.tagList(id)["Tag"].([]map[string]interface{}) {
+			resources = append(resources, map[string]interface{}{
+				"ResourceId":   id,
+				"ResourceType": request.Get("ResourceType"),
+				"TagKey":       tag["TagKey"],
+				"TagValue":     tag["TagValue"],
+			})
+		}
+	}
+	return map[string]interface{}{
+		"NextToken":    "",
+		"TagResources": map[string]interface{}{"TagResource": resources},
+	}, nil
+}
+
+func (s *Server) systemRouteTableId(vpcId string) string {
+	for _, table := range s.list(kindRouteTable, func(o map[string]interface{}) bool { return o["VpcId"] == vpcId }) {
+		if table["RouteTableType"] == "System" {
+			return table["RouteTableId"].(string)
+		}
+	}
+	return ""
+}
+
+func (s *Server) requireObject(kind, id, notFoundCode string) (map[string]interface{}, error) {
+	if id == "" {
+		return nil, NewError(http.StatusBadRequest, notFoundCode, fmt.Sprintf("The %s id is not specified.", kind))
+	}
+	object, ok := s.get(kind, id)
+	if !ok {
+		return nil, NotFoundError(notFoundCode, id)
+	}
+	return object, nil
+}
+
+// updateFields sets the fields of the object by the request parameters with the same names.
+func updateFields(object map[string]interface{}, request *Request, names ...string) {
+	for _, name := range names {
+		if v, ok := request.Params[name]; ok {
+			object[name] = v
+		}
+	}
+}
+
+func contains(values []string, value string) bool {
+	for _, v := range values {
+		if strings.TrimSpace(v) == value {
+			return true
+		}
+	}
+	return false
+}
@@ -2,6 +2,7 @@ package alibabacloudstack
 
 import (
 	"bytes"
+	"context"
 	"encoding/json"
 	"fmt"
 	"github.com/aliyun/alibaba-cloud-sdk-go/sdk"
@@ -12,11 +13,13 @@ import (
 	"github.com/aliyun/terraform-provider-alibabacloudstack/alibabacloudstack/connectivity"
 	"github.com/aliyun/terraform-provider-alibabacloudstack/alibabacloudstack/helper/hashcode"
 	"github.com/google/uuid"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/mitchellh/go-homedir"
 	"io/ioutil"
 	"log"
+	"net/http"
 	"os"
 	"runtime"
 	"strconv"
@@ -25,7 +28,7 @@ import (
 )
 
 func Provider() *schema.Provider {
-	return &schema.Provider{
+	provider := &schema.Provider{
 		Schema: map[string]*schema.Schema{
 			"access_key": {
 				Type:        schema.TypeString,
@@ -89,7 +92,9 @@ func Provider() *schema.Provider {
 				DefaultFunc: schema.EnvDefaultFunc("ALIBABACLOUDSTACK_INSECURE", nil),
 				Description: descriptions["insecure"],
 			},
-			"assume_role": assumeRoleSchema(),
+			"assume_role":  assumeRoleSchema(),
+			"default_tags": defaultTagsSchema(),
+			"ignore_tags":  ignoreTagsSchema(),
 			"fc": {
 				Type:       schema.TypeString,
 				Optional:   true,
@@ -114,6 +119,33 @@ func Provider() *schema.Provider {
 				DefaultFunc: schema.EnvDefaultFunc("CLIENT_CONNECT_TIMEOUT", 60000),
 				Description: descriptions["client_connect_timeout"],
 			},
+			"max_retries": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				DefaultFunc:  schema.EnvDefaultFunc("ALIBABACLOUDSTACK_MAX_RETRIES", connectivity.DefaultMaxRetries),
+				Description:  descriptions["max_retries"],
+				ValidateFunc: validation.IntAtLeast(0),
+			},
+			"retry_max_wait": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				DefaultFunc:  schema.EnvDefaultFunc("ALIBABACLOUDSTACK_RETRY_MAX_WAIT", connectivity.DefaultRetryMaxWait),
+				Description:  descriptions["retry_max_wait"],
+				ValidateFunc: validation.IntAtLeast(1),
+			},
+			"rate_limits": {
+				Type:        schema.TypeMap,
+				Optional:    true,
+				Elem:        &schema.Schema{Type: schema.TypeInt},
+				Description: descriptions["rate_limits"],
+			},
+			"quota_check": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				DefaultFunc:  schema.EnvDefaultFunc("ALIBABACLOUDSTACK_QUOTA_CHECK", connectivity.QuotaCheckOff),
+				Description:  descriptions["quota_check"],
+				ValidateFunc: validation.StringInSlice([]string{connectivity.QuotaCheckOff, connectivity.QuotaCheckError}, false),
+			},
 			"source_ip": {
 				Type:        schema.TypeString,
 				Optional:    true,
@@ -151,6 +183,12 @@ func Provider() *schema.Provider {
 				DefaultFunc: schema.EnvDefaultFunc("ALIBABACLOUDSTACK_DOMAIN", nil),
 				Description: descriptions["domain"],
 			},
+			"endpoints_manifest": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				DefaultFunc: schema.EnvDefaultFunc("ALIBABACLOUDSTACK_ENDPOINTS_MANIFEST", nil),
+				Description: descriptions["endpoints_manifest"],
+			},
 			"ossservice_domain": {
 				Type:        schema.TypeString,
 				Optional:    true,
@@ -229,6 +267,10 @@ func Provider() *schema.Provider {
 			"alibabacloudstack_adb_clusters":                         dataSourceAlibabacloudStackAdbDbClusters(),
 			"alibabacloudstack_adb_zones":                            dataSourceAlibabacloudStackAdbZones(),
 			"alibabacloudstack_adb_db_clusters":                      dataSourceAlibabacloudStackAdbDbClusters(),
+			"alibabacloudstack_alikafka_consumer_groups":             dataSourceAlibabacloudStackAlikafkaConsumerGroups(),
+			"alibabacloudstack_alikafka_instances":                   dataSourceAlibabacloudStackAlikafkaInstances(),
+			"alibabacloudstack_alikafka_topics":                      dataSourceAlibabacloudStackAlikafkaTopics(),
+			"alibabacloudstack_alikafka_sasl_users":                  dataSourceAlibabacloudStackAlikafkaSaslUsers(),
 			"alibabacloudstack_api_gateway_apis":                     dataSourceAlibabacloudStackApiGatewayApis(),
 			"alibabacloudstack_api_gateway_apps":                     dataSourceAlibabacloudStackApiGatewayApps(),
 			"alibabacloudstack_api_gateway_groups":                   dataSourceAlibabacloudStackApiGatewayGroups(),
@@ -247,8 +289,10 @@ func Provider() *schema.Provider {
 			"alibabacloudstack_ascm_environment_services_by_product": dataSourceAlibabacloudStackAscmEnvironmentServicesByProduct(),
 			"alibabacloudstack_ascm_password_policies":               dataSourceAlibabacloudStackAscmPasswordPolicies(),
 			"alibabacloudstack_ascm_quotas":                          dataSourceAlibabacloudStackQuotas(),
+			"alibabacloudstack_ascm_remaining_quotas":                dataSourceAlibabacloudStackAscmRemainingQuotas(),
 			"alibabacloudstack_ascm_metering_query_ecs":              dataSourceAlibabacloudstackAscmMeteringQueryEcs(),
 			"alibabacloudstack_ascm_roles":                           dataSourceAlibabacloudStackAscmRoles(),
+			"alibabacloudstack_ascm_custom_roles":                    dataSourceAlibabacloudStackAscmCustomRoles(),
 			"alibabacloudstack_ascm_ram_policies":                    dataSourceAlibabacloudStackAscmRamPolicies(),
 			"alibabacloudstack_ascm_ram_policies_for_user":           dataSourceAlibabacloudStackAscmRamPoliciesForUser(),
 			"alibabacloudstack_common_bandwidth_packages":            dataSourceAlibabacloudStackCommonBandwidthPackages(),
@@ -259,12 +303,24 @@ func Provider() *schema.Provider {
 			"alibabacloudstack_cr_namespaces":                        dataSourceAlibabacloudStackCRNamespaces(),
 			"alibabacloudstack_cr_repos":                             dataSourceAlibabacloudStackCRRepos(),
 			"alibabacloudstack_cs_kubernetes_clusters":               dataSourceAlibabacloudStackCSKubernetesClusters(),
+			"alibabacloudstack_cs_cluster_credential":                dataSourceAlibabacloudStackCSClusterCredential(),
+			"alibabacloudstack_cs_kubernetes_upgradable_versions":    dataSourceAlibabacloudStackCSKubernetesUpgradableVersions(),
+			"alibabacloudstack_cen_bandwidth_packages":               dataSourceAlibabacloudStackCenBandwidthPackages(),
+			"alibabacloudstack_cen_instance_attachments":             dataSourceAlibabacloudStackCenInstanceAttachments(),
+			"alibabacloudstack_cen_instances":                        dataSourceAlibabacloudStackCenInstances(),
+			"alibabacloudstack_cen_route_entries":                    dataSourceAlibabacloudStackCenRouteEntries(),
 			"alibabacloudstack_cms_alarm_contacts":                   dataSourceAlibabacloudstackCmsAlarmContacts(),
 			"alibabacloudstack_cms_alarm_contact_groups":             dataSourceAlibabacloudstackCmsAlarmContactGroups(),
 			"alibabacloudstack_cms_project_meta":                     dataSourceAlibabacloudstackCmsProjectMeta(),
 			"alibabacloudstack_cms_metric_metalist":                  dataSourceAlibabacloudstackCmsMetricMetalist(),
 			"alibabacloudstack_cms_alarms":                           dataSourceAlibabacloudstackCmsAlarms(),
 			"alibabacloudstack_datahub_service":                      dataSourceAlibabacloudStackDatahubService(),
+			"alibabacloudstack_datahub_projects":                     dataSourceAlibabacloudStackDatahubProjects(),
+			"alibabacloudstack_datahub_topics":                       dataSourceAlibabacloudStackDatahubTopics(),
+			"alibabacloudstack_datahub_subscriptions":                dataSourceAlibabacloudStackDatahubSubscriptions(),
+			"alibabacloudstack_data_works_projects":                  dataSourceAlibabacloudStackDataWorksProjects(),
+			"alibabacloudstack_data_works_folders":                   dataSourceAlibabacloudStackDataWorksFolders(),
+			"alibabacloudstack_dbs_backup_plans":                     dataSourceAlibabacloudStackDbsBackupPlans(),
 			"alibabacloudstack_db_instances":                         dataSourceAlibabacloudStackDBInstances(),
 			"alibabacloudstack_db_zones":                             dataSourceAlibabacloudStackDBZones(),
 			"alibabacloudstack_disks":                                dataSourceAlibabacloudStackDisks(),
@@ -274,6 +330,8 @@ func Provider() *schema.Provider {
 			"alibabacloudstack_drds_instances":                       dataSourceAlibabacloudStackDRDSInstances(),
 			"alibabacloudstack_dms_enterprise_instances":             dataSourceAlibabacloudStackDmsEnterpriseInstances(),
 			"alibabacloudstack_dms_enterprise_users":                 dataSourceAlibabacloudStackDmsEnterpriseUsers(),
+			"alibabacloudstack_dts_subscription_jobs":                dataSourceAlibabacloudStackDtsSubscriptionJobs(),
+			"alibabacloudstack_dts_synchronization_jobs":             dataSourceAlibabacloudStackDtsSynchronizationJobs(),
 			"alibabacloudstack_ecs_commands":                         dataSourceAlibabacloudStackEcsCommands(),
 			"alibabacloudstack_ecs_deployment_sets":                  dataSourceAlibabacloudStackEcsDeploymentSets(),
 			"alibabacloudstack_ecs_hpc_clusters":                     dataSourceAlibabacloudStackEcsHpcClusters(),
@@ -281,7 +339,9 @@ func Provider() *schema.Provider {
 			"alibabacloudstack_edas_deploy_groups":                   dataSourceAlibabacloudStackEdasDeployGroups(),
 			"alibabacloudstack_edas_clusters":                        dataSourceAlibabacloudStackEdasClusters(),
 			"alibabacloudstack_edas_applications":                    dataSourceAlibabacloudStackEdasApplications(),
+			"alibabacloudstack_edas_k8s_applications":                dataSourceAlibabacloudStackEdasK8sApplications(),
 			"alibabacloudstack_eips":                                 dataSourceAlibabacloudStackEips(),
+			"alibabacloudstack_endpoints":                            dataSourceAlibabacloudStackEndpoints(),
 			"alibabacloudstack_ess_scaling_configurations":           dataSourceAlibabacloudStackEssScalingConfigurations(),
 			"alibabacloudstack_ess_scaling_groups":                   dataSourceAlibabacloudStackEssScalingGroups(),
 			"alibabacloudstack_ess_lifecycle_hooks":                  dataSourceAlibabacloudStackEssLifecycleHooks(),
@@ -305,6 +365,9 @@ func Provider() *schema.Provider {
 			"alibabacloudstack_kvstore_zones":                        dataSourceAlibabacloudStackKVStoreZones(),
 			"alibabacloudstack_kvstore_instance_classes":             dataSourceAlibabacloudStackKVStoreInstanceClasses(),
 			"alibabacloudstack_kvstore_instance_engines":             dataSourceAlibabacloudStackKVStoreInstanceEngines(),
+			"alibabacloudstack_log_projects":                         dataSourceAlibabacloudStackLogProjects(),
+			"alibabacloudstack_log_stores":                           dataSourceAlibabacloudStackLogStores(),
+			"alibabacloudstack_log_machine_groups":                   dataSourceAlibabacloudStackLogMachineGroups(),
 			"alibabacloudstack_mongodb_instances":                    dataSourceAlibabacloudStackMongoDBInstances(),
 			"alibabacloudstack_mongodb_zones":                        dataSourceAlibabacloudStackMongoDBZones(),
 			"alibabacloudstack_maxcompute_cus":                       dataSourceAlibabacloudStackMaxcomputeCus(),
@@ -322,6 +385,7 @@ func Provider() *schema.Provider {
 			"alibabacloudstack_network_interfaces":                   dataSourceAlibabacloudStackNetworkInterfaces(),
 			"alibabacloudstack_oss_buckets":                          dataSourceAlibabacloudStackOssBuckets(),
 			"alibabacloudstack_oss_bucket_objects":                   dataSourceAlibabacloudStackOssBucketObjects(),
+			"alibabacloudstack_oss_bucket_vpc_attachments":           dataSourceAlibabacloudStackOssBucketVpcAttachments(),
 			"alibabacloudstack_ons_instances":                        dataSourceAlibabacloudStackOnsInstances(),
 			"alibabacloudstack_ons_topics":                           dataSourceAlibabacloudStackOnsTopics(),
 			"alibabacloudstack_ons_groups":                           dataSourceAlibabacloudStackOnsGroups(),
@@ -330,6 +394,8 @@ func Provider() *schema.Provider {
 			"alibabacloudstack_ots_instances_attachment":             dataSourceAlibabacloudStackOtsInstanceAttachments(),
 			"alibabacloudstack_ots_service":                          dataSourceAlibabacloudStackOtsService(),
 			"alibabacloudstack_quick_bi_users":                       dataSourceAlibabacloudStackQuickBiUsers(),
+			"alibabacloudstack_quick_bi_user_groups":                 dataSourceAlibabacloudStackQuickBiUserGroups(),
+			"alibabacloudstack_quick_bi_workspaces":                  dataSourceAlibabacloudStackQuickBiWorkspaces(),
 			"alibabacloudstack_router_interfaces":                    dataSourceAlibabacloudStackRouterInterfaces(),
 			"alibabacloudstack_ram_service_role_products":            dataSourceAlibabacloudstackRamServiceRoleProducts(),
 			"alibabacloudstack_route_tables":                         dataSourceAlibabacloudStackRouteTables(),
@@ -339,6 +405,7 @@ func Provider() *schema.Provider {
 			"alibabacloudstack_security_groups":                      dataSourceAlibabacloudStackSecurityGroups(),
 			"alibabacloudstack_security_group_rules":                 dataSourceAlibabacloudStackSecurityGroupRules(),
 			"alibabacloudstack_snapshots":                            dataSourceAlibabacloudStackSnapshots(),
+			"alibabacloudstack_snapshot_policies":                    dataSourceAlibabacloudStackSnapshotPolicies(),
 			"alibabacloudstack_slb_listeners":                        dataSourceAlibabacloudStackSlbListeners(),
 			"alibabacloudstack_slb_server_groups":                    dataSourceAlibabacloudStackSlbServerGroups(),
 			"alibabacloudstack_slb_acls":                             dataSourceAlibabacloudStackSlbAcls(),
@@ -352,9 +419,11 @@ func Provider() *schema.Provider {
 			"alibabacloudstack_slb_ca_certificates":                  dataSourceAlibabacloudStackSlbCACertificates(),
 			"alibabacloudstack_slb_backend_servers":                  dataSourceAlibabacloudStackSlbBackendServers(),
 			"alibabacloudstack_tsdb_zones":                           dataSourceAlibabacloudStackTsdbZones(),
+			"alibabacloudstack_tsdb_instances":                       dataSourceAlibabacloudStackTsdbInstances(),
 			"alibabacloudstack_vpn_gateways":                         dataSourceAlibabacloudStackVpnGateways(),
 			"alibabacloudstack_vpn_customer_gateways":                dataSourceAlibabacloudStackVpnCustomerGateways(),
 			"alibabacloudstack_vpn_connections":                      dataSourceAlibabacloudStackVpnConnections(),
+			"alibabacloudstack_vpn_route_entries":                    dataSourceAlibabacloudStackVpnRouteEntries(),
 			"alibabacloudstack_vpc_ipv6_gateways":                    dataSourceAlibabacloudStackVpcIpv6Gateways(),
 			"alibabacloudstack_vpc_ipv6_egress_rules":                dataSourceAlibabacloudStackVpcIpv6EgressRules(),
 			"alibabacloudstack_vpc_ipv6_addresses":                   dataSourceAlibabacloudStackVpcIpv6Addresses(),
@@ -372,6 +441,8 @@ func Provider() *schema.Provider {
 			"alibabacloudstack_adb_cluster":                          resourceAlibabacloudStackAdbDbCluster(),
 			"alibabacloudstack_adb_connection":                       resourceAlibabacloudStackAdbConnection(),
 			"alibabacloudstack_adb_db_cluster":                       resourceAlibabacloudStackAdbDbCluster(),
+			"alibabacloudstack_alikafka_consumer_group":              resourceAlibabacloudStackAlikafkaConsumerGroup(),
+			"alibabacloudstack_alikafka_instance":                    resourceAlibabacloudStackAlikafkaInstance(),
 			"alibabacloudstack_alikafka_sasl_acl":                    resourceAlibabacloudStackAlikafkaSaslAcl(),
 			"alibabacloudstack_alikafka_sasl_user":                   resourceAlibabacloudStackAlikafkaSaslUser(),
 			"alibabacloudstack_alikafka_topic":                       resourceAlibabacloudStackAlikafkaTopic(),
@@ -396,6 +467,10 @@ func Provider() *schema.Provider {
 			"alibabacloudstack_ascm_user_group_role_binding":         resourceAlibabacloudStackAscmUserGroupRoleBinding(),
 			"alibabacloudstack_ascm_user_role_binding":               resourceAlibabacloudStackAscmUserRoleBinding(),
 			"alibabacloudstack_ascm_usergroup_user":                  resourceAlibabacloudStackAscmUserGroupUser(),
+			"alibabacloudstack_cen_bandwidth_package":                resourceAlibabacloudStackCenBandwidthPackage(),
+			"alibabacloudstack_cen_instance":                         resourceAlibabacloudStackCenInstance(),
+			"alibabacloudstack_cen_instance_attachment":              resourceAlibabacloudStackCenInstanceAttachment(),
+			"alibabacloudstack_cen_route_entry":                      resourceAlibabacloudStackCenRouteEntry(),
 			"alibabacloudstack_cms_alarm":                            resourceAlibabacloudStackCmsAlarm(),
 			"alibabacloudstack_cms_alarm_contact":                    resourceAlibabacloudstackCmsAlarmContact(),
 			"alibabacloudstack_cms_alarm_contact_group":              resourceAlibabacloudstackCmsAlarmContactGroup(),
@@ -437,6 +512,7 @@ func Provider() *schema.Provider {
 			"alibabacloudstack_ecs_deployment_set":                   resourceAlibabacloudStackEcsDeploymentSet(),
 			"alibabacloudstack_ecs_hpc_cluster":                      resourceAlibabacloudStackEcsHpcCluster(),
 			"alibabacloudstack_ecs_ebs_storage_set":                  resourceAlibabacloudStackEcsEbsStorageSets(),
+			"alibabacloudstack_ecs_invocation":                       resourceAlibabacloudStackEcsInvocation(),
 			"alibabacloudstack_edas_application":                     resourceAlibabacloudStackEdasApplication(),
 			"alibabacloudstack_edas_application_scale":               resourceAlibabacloudStackEdasInstanceApplicationAttachment(),
 			"alibabacloudstack_edas_cluster":                         resourceAlibabacloudStackEdasCluster(),
@@ -478,6 +554,8 @@ func Provider() *schema.Provider {
 			"alibabacloudstack_kvstore_connection":                   resourceAlibabacloudStackKvstoreConnection(),
 			"alibabacloudstack_kvstore_instance":                     resourceAlibabacloudStackKVStoreInstance(),
 			"alibabacloudstack_launch_template":                      resourceAlibabacloudStackLaunchTemplate(),
+			"alibabacloudstack_log_alert":                            resourceAlibabacloudStackLogAlert(),
+			"alibabacloudstack_log_dashboard":                        resourceAlibabacloudStackLogDashboard(),
 			"alibabacloudstack_log_machine_group":                    resourceAlibabacloudStackLogMachineGroup(),
 			"alibabacloudstack_log_project":                          resourceAlibabacloudStackLogProject(),
 			"alibabacloudstack_log_store":                            resourceAlibabacloudStackLogStore(),
@@ -503,8 +581,12 @@ func Provider() *schema.Provider {
 			"alibabacloudstack_ons_instance":                         resourceAlibabacloudStackOnsInstance(),
 			"alibabacloudstack_ons_topic":                            resourceAlibabacloudStackOnsTopic(),
 			"alibabacloudstack_oss_bucket":                           resourceAlibabacloudStackOssBucket(),
+			"alibabacloudstack_oss_bucket_inventory":                 resourceAlibabacloudStackOssBucketInventory(),
 			"alibabacloudstack_oss_bucket_kms":                       resourceAlibabacloudStackOssBucketKms(),
 			"alibabacloudstack_oss_bucket_object":                    resourceAlibabacloudStackOssBucketObject(),
+			"alibabacloudstack_oss_bucket_replication":               resourceAlibabacloudStackOssBucketReplication(),
+			"alibabacloudstack_oss_bucket_vpc_attachment":            resourceAlibabacloudStackOssBucketVpcAttachment(),
+			"alibabacloudstack_oss_bucket_worm":                      resourceAlibabacloudStackOssBucketWorm(),
 			"alibabacloudstack_ots_instance":                         resourceAlibabacloudStackOtsInstance(),
 			"alibabacloudstack_ots_instance_attachment":              resourceAlibabacloudStackOtsInstanceAttachment(),
 			"alibabacloudstack_ots_table":                            resourceAlibabacloudStackOtsTable(),
@@ -513,7 +595,10 @@ func Provider() *schema.Provider {
 			"alibabacloudstack_quick_bi_workspace":                   resourceAlibabacloudStackQuickBiWorkspace(),
 			"alibabacloudstack_ram_role_attachment":                  resourceAlibabacloudStackRamRoleAttachment(),
 			"alibabacloudstack_reserved_instance":                    resourceAlibabacloudStackReservedInstance(),
+			"alibabacloudstack_ros_change_set":                       resourceAlibabacloudStackRosChangeSet(),
 			"alibabacloudstack_ros_stack":                            resourceAlibabacloudStackRosStack(),
+			"alibabacloudstack_ros_stack_group":                      resourceAlibabacloudStackRosStackGroup(),
+			"alibabacloudstack_ros_stack_instance":                   resourceAlibabacloudStackRosStackInstance(),
 			"alibabacloudstack_ros_template":                         resourceAlibabacloudStackRosTemplate(),
 			"alibabacloudstack_route_entry":                          resourceAlibabacloudStackRouteEntry(),
 			"alibabacloudstack_route_table":                          resourceAlibabacloudStackRouteTable(),
@@ -534,7 +619,9 @@ func Provider() *schema.Provider {
 			"alibabacloudstack_slb_server_group":                     resourceAlibabacloudStackSlbServerGroup(),
 			"alibabacloudstack_snapshot":                             resourceAlibabacloudStackSnapshot(),
 			"alibabacloudstack_snapshot_policy":                      resourceAlibabacloudStackSnapshotPolicy(),
+			"alibabacloudstack_snapshot_policy_attachment":           resourceAlibabacloudStackSnapshotPolicyAttachment(),
 			"alibabacloudstack_snat_entry":                           resourceAlibabacloudStackSnatEntry(),
+			"alibabacloudstack_tsdb_instance":                        resourceAlibabacloudStackTsdbInstance(),
 			"alibabacloudstack_vpc":                                  resourceAlibabacloudStackVpc(),
 			"alibabacloudstack_vpc_ipv6_egress_rule":                 resourceAlibabacloudStackVpcIpv6EgressRule(),
 			"alibabacloudstack_vpc_ipv6_gateway":                     resourceAlibabacloudStackVpcIpv6Gateway(),
@@ -553,13 +640,19 @@ func Provider() *schema.Provider {
 			"alibabacloudstack_elasticsearch_instance":               resourceAlibabacloudStackElasticsearch(),
 			"alibabacloudstack_dbs_backup_plan":                      resourceAlibabacloudStackDbsBackupPlan(),
 		},
-		ConfigureFunc: providerConfigure,
+		ConfigureContextFunc: providerConfigure,
 	}
+	for name, r := range provider.ResourcesMap {
+		if _, ok := r.Schema["tags"]; ok && !tagsAllUnsupportedResources[name] {
+			withTagsAll(r)
+		}
+	}
+	return provider
 }
 
 var providerConfig map[string]interface{}
 
-func providerConfigure(d *schema.ResourceData) (interface{}, error) {
+func providerConfigure(ctx context.Context, d *schema.ResourceData) (interface{}, diag.Diagnostics) {
 	var getProviderConfig = func(str string, key string) string {
 		if str == "" {
 			value, err := getConfigFromProfile(d, key)
@@ -597,6 +690,27 @@ func providerConfigure(d *schema.ResourceData) (interface{}, error) {
 		ResourceSetName:      d.Get("resource_group_set_name").(string),
 		SourceIp:             strings.TrimSpace(d.Get("source_ip").(string)),
 		SecureTransport:      strings.TrimSpace(d.Get("secure_transport").(string)),
+		MaxRetries:           d.Get("max_retries").(int),
+		RetryMaxWait:         d.Get("retry_max_wait").(int),
+		RateLimits:           make(map[string]int),
+		QuotaCheck:           d.Get("quota_check").(string),
+	}
+	for product, limit := range d.Get("rate_limits").(map[string]interface{}) {
+		config.RateLimits[product] = limit.(int)
+	}
+	if v, ok := d.GetOk("default_tags"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+		config.DefaultTags = make(map[string]string)
+		for key, value := range v.([]interface{})[0].(map[string]interface{})["tags"].(map[string]interface{}) {
+			config.DefaultTags[key] = value.(string)
+		}
+	}
+	if v, ok := d.GetOk("ignore_tags"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+		ignoreTags := v.([]interface{})[0].(map[string]interface{})
+		config.IgnoreTagKeys = expandStringList(ignoreTags["keys"].(*schema.Set).List())
+		config.IgnoreTagKeyPrefixes = expandStringList(ignoreTags["key_prefixes"].(*schema.Set).List())
+	}
+	if stop, ok := schema.StopContext(ctx); ok {
+		config.StopContext = stop
 	}
 	if v, ok := d.GetOk("security_transport"); config.SecureTransport == "" && ok && v.(string) != "" {
 		config.SecureTransport = v.(string)
@@ -644,87 +758,17 @@ func providerConfigure(d *schema.ResourceData) (interface{}, error) {
 	}
 
 	if err := config.MakeConfigByEcsRoleName(); err != nil {
-		return nil, err
+		return nil, diag.FromErr(err)
 	}
 	ossServicedomain := d.Get("ossservice_domain").(string)
 	if ossServicedomain != "" {
 		config.OssServerEndpoint = ossServicedomain
 	}
-	domain := d.Get("domain").(string)
-	if domain != "" {
-		config.EcsEndpoint = domain
-		config.VpcEndpoint = domain
-		config.SlbEndpoint = domain
-		config.OssEndpoint = domain
-		config.AscmEndpoint = domain
-		config.RdsEndpoint = domain
-		config.OnsEndpoint = domain
-		config.KmsEndpoint = domain
-		config.LogEndpoint = domain
-		config.CrEndpoint = domain
-		config.EssEndpoint = domain
-		config.DnsEndpoint = domain
-		config.KVStoreEndpoint = domain
-		config.GpdbEndpoint = domain
-		config.DdsEndpoint = domain
-		config.CsEndpoint = domain
-		config.CmsEndpoint = domain
-		config.HitsdbEndpoint = domain
-		config.MaxComputeEndpoint = domain
-		config.OtsEndpoint = domain
-		config.DatahubEndpoint = domain
-		config.EdasEndpoint = domain
-		config.AdbEndpoint = domain
-		config.RosEndpoint = domain
-		config.DtsEndpoint = domain
-		config.AlikafkaEndpoint = domain
-		config.NasEndpoint = domain
-		config.ApigatewayEndpoint = domain
-		config.DmsEnterpriseEndpoint = domain
-		config.HBaseEndpoint = domain
-		config.DrdsEndpoint = domain
-		config.QuickbiEndpoint = domain
-		config.ElasticsearchEndpoint = domain
-		config.DataworkspublicEndpoint = domain
-		config.DbsEndpoint = domain
-	} else {
-
-		endpointsSet := d.Get("endpoints").(*schema.Set)
-
-		for _, endpointsSetI := range endpointsSet.List() {
-			endpoints := endpointsSetI.(map[string]interface{})
-			config.EcsEndpoint = strings.TrimSpace(endpoints["ecs"].(string))
-			config.VpcEndpoint = strings.TrimSpace(endpoints["vpc"].(string))
-			config.AscmEndpoint = strings.TrimSpace(endpoints["ascm"].(string))
-			config.RdsEndpoint = strings.TrimSpace(endpoints["rds"].(string))
-			config.OssEndpoint = strings.TrimSpace(endpoints["oss"].(string))
-			config.OnsEndpoint = strings.TrimSpace(endpoints["ons"].(string))
-			config.KmsEndpoint = strings.TrimSpace(endpoints["kms"].(string))
-			config.LogEndpoint = strings.TrimSpace(endpoints["log"].(string))
-			config.SlbEndpoint = strings.TrimSpace(endpoints["slb"].(string))
-			config.CrEndpoint = strings.TrimSpace(endpoints["cr"].(string))
-			config.EssEndpoint = strings.TrimSpace(endpoints["ess"].(string))
-			config.DnsEndpoint = strings.TrimSpace(endpoints["dns"].(string))
-			config.KVStoreEndpoint = strings.TrimSpace(endpoints["kvstore"].(string))
-			config.GpdbEndpoint = strings.TrimSpace(endpoints["gpdb"].(string))
-			config.DdsEndpoint = strings.TrimSpace(endpoints["dds"].(string))
-			config.CsEndpoint = strings.TrimSpace(endpoints["cs"].(string))
-			config.CmsEndpoint = strings.TrimSpace(endpoints["cms"].(string))
-			config.OtsEndpoint = strings.TrimSpace(endpoints["ots"].(string))
-			config.DatahubEndpoint = strings.TrimSpace(endpoints["datahub"].(string))
-			config.AdbEndpoint = strings.TrimSpace(endpoints["adb"].(string))
-			config.StsEndpoint = strings.TrimSpace(endpoints["sts"].(string))
-			config.RosEndpoint = strings.TrimSpace(endpoints["ros"].(string))
-			config.DtsEndpoint = strings.TrimSpace(endpoints["dts"].(string))
-			config.AlikafkaEndpoint = strings.TrimSpace(endpoints["alikafka"].(string))
-			config.NasEndpoint = strings.TrimSpace(endpoints["nas"].(string))
-			config.ApigatewayEndpoint = strings.TrimSpace(endpoints["apigateway"].(string))
-			config.DmsEnterpriseEndpoint = strings.TrimSpace(endpoints["dms_enterprise"].(string))
-			config.HBaseEndpoint = strings.TrimSpace(endpoints["hbase"].(string))
-			config.DrdsEndpoint = strings.TrimSpace(endpoints["drds"].(string))
-			config.QuickbiEndpoint = strings.TrimSpace(endpoints["quickbi"].(string))
-			config.DataworkspublicEndpoint = strings.TrimSpace(endpoints["dataworkspublic"].(string))
-			config.DbsEndpoint = strings.TrimSpace(endpoints["dbs"].(string))
+	for _, endpointsSetI := range d.Get("endpoints").(*schema.Set).List() {
+		for product, endpoint := range endpointsSetI.(map[string]interface{}) {
+			if endpoint.(string) != "" {
+				config.SetEndpoint(product, endpoint.(string))
+			}
 		}
 	}
 	DbsEndpoint := d.Get("dbs_endpoint").(string)
@@ -759,6 +803,14 @@ func providerConfigure(d *schema.ResourceData) (interface{}, error) {
 	if slsOpenAPIEndpoint != "" {
 		config.SLSOpenAPIEndpoint = slsOpenAPIEndpoint
 	}
+	var manifest map[connectivity.ServiceCode]string
+	if path := d.Get("endpoints_manifest").(string); path != "" {
+		if manifest, err = connectivity.LoadEndpointsManifest(path, config.RegionId); err != nil {
+			return nil, diag.FromErr(err)
+		}
+	}
+	config.Domain = d.Get("domain").(string)
+	config.ResolveEndpoints(manifest, config.Domain)
 	if strings.ToLower(config.Protocol) == "https" {
 		config.Protocol = "HTTPS"
 	} else {
@@ -769,7 +821,7 @@ func providerConfigure(d *schema.ResourceData) (interface{}, error) {
 	if config.Department == "" || config.ResourceGroup == "" {
 		dept, rg, err := getResourceCredentials(config)
 		if err != nil {
-			return nil, err
+			return nil, diag.FromErr(err)
 		}
 		config.Department = dept
 		config.ResourceGroup = rg
@@ -778,7 +830,7 @@ func providerConfigure(d *schema.ResourceData) (interface{}, error) {
 	if config.RamRoleArn != "" {
 		config.AccessKey, config.SecretKey, config.SecurityToken, err = getAssumeRoleAK(config)
 		if err != nil {
-			return nil, err
+			return nil, diag.FromErr(err)
 		}
 	}
 
@@ -799,7 +851,7 @@ func providerConfigure(d *schema.ResourceData) (interface{}, error) {
 	}
 	client, err := config.Client()
 	if err != nil {
-		return nil, err
+		return nil, diag.FromErr(err)
 	}
 
 	return client, nil
@@ -820,6 +872,24 @@ func init() {
 		"proxy": "Use this to set proxy connection",
 
 		"domain": "Use this to override the default domain. It's typically used to connect to custom domain.",
+
+		"skip_region_validation": "Skip the validation of the region. When it is false, the region is checked with the regions described from the ECS of the stack.",
+
+		"default_tags": "The tags which are merged into the tags of every taggable resource. The resource tags win when the keys are the same.",
+
+		"ignore_tags_keys": "The tag keys which are ignored by all resources, so that the tags added outside of terraform do not show as a drift.",
+
+		"ignore_tags_key_prefixes": "The tag key prefixes which are ignored by all resources, so that the tags added outside of terraform do not show as a drift.",
+
+		"endpoints_manifest": "The path of the endpoints manifest provided by the stack, in the format of endpoints.xml, JSON or YAML. It's used for the products whose endpoints are not set by the provider arguments or the environment variables.",
+
+		"max_retries": "The max times to retry an API request which is throttled, like a Throttling or ServiceUnavailable error. It applies to the requests of all the products. Default to 3.",
+
+		"retry_max_wait": "The max seconds to wait before retrying a throttled API request. The wait is doubled for every retry until it reaches this value. Default to 30.",
+
+		"rate_limits": "The max requests per second of every product, like `{ ecs = 10, ascm = 5 }`. The key `default` applies to the products which are not in the map.",
+
+		"quota_check": "Whether to check the ECS instances, disks, SLBs and EIPs to create in the plan against the remaining ASCM quota of the resource group and the organization. Valid values: `off` and `error`. Default to `off`.",
 	}
 }
 func endpointsSchema() *schema.Schema {
@@ -1241,6 +1311,48 @@ func getConfigFromProfile(d *schema.ResourceData, ProfileKey string) (interface{
 
 	return providerConfig[ProfileKey], nil
 }
+func defaultTagsSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:     schema.TypeList,
+		Optional: true,
+		MaxItems: 1,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"tags": {
+					Type:        schema.TypeMap,
+					Optional:    true,
+					Elem:        &schema.Schema{Type: schema.TypeString},
+					Description: descriptions["default_tags"],
+				},
+			},
+		},
+	}
+}
+
+func ignoreTagsSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:     schema.TypeList,
+		Optional: true,
+		MaxItems: 1,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"keys": {
+					Type:        schema.TypeSet,
+					Optional:    true,
+					Elem:        &schema.Schema{Type: schema.TypeString},
+					Description: descriptions["ignore_tags_keys"],
+				},
+				"key_prefixes": {
+					Type:        schema.TypeSet,
+					Optional:    true,
+					Elem:        &schema.Schema{Type: schema.TypeString},
+					Description: descriptions["ignore_tags_key_prefixes"],
+				},
+			},
+		},
+	}
+}
+
 func assumeRoleSchema() *schema.Schema {
 	return &schema.Schema{
 		Type:     schema.TypeSet,
@@ -1311,6 +1423,7 @@ func getAssumeRoleAK(config *connectivity.Config) (string, string, string, error
 	if config.Proxy != "" {
 		client.SetHttpProxy(config.Proxy)
 	}
+	client.SetTransport(connectivity.WrapTransport(&http.Transport{}, config))
 	response, err := client.AssumeRole(request)
 	if err != nil {
 		return config.AccessKey, config.SecretKey, config.SecurityToken, err
@@ -1340,6 +1453,7 @@ func getResourceCredentials(config *connectivity.Config) (string, string, error)
 	if config.Proxy != "" {
 		ascmClient.SetHttpProxy(config.Proxy)
 	}
+	ascmClient.SetTransport(connectivity.WrapTransport(&http.Transport{}, config))
 	if config.ResourceSetName == "" {
 		return "", "", fmt.Errorf("errror while fetching resource group details, resource group set name can not be empty")
 	}
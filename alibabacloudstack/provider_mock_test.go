@@ -0,0 +1,386 @@
+package alibabacloudstack
+
+import (
+	"context"
+	"os"
+	"strconv"
+	"strings"
+	"testing"
+
+	"github.com/aliyun/terraform-provider-alibabacloudstack/alibabacloudstack/connectivity"
+	"github.com/aliyun/terraform-provider-alibabacloudstack/alibabacloudstack/mockserver"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func TestMockVpcVSwitchSecurityGroup(t *testing.T) {
+	server := mockserver.NewServer()
+	defer server.Close()
+	client := testMockClient(t, server)
+
+	vpcResource := resourceAlibabacloudStackVpc()
+	vpcState := testMockApply(t, vpcResource, client, nil, map[string]interface{}{
+		"vpc_name":   "tf-testacc-mock-vpc",
+		"cidr_block": "172.16.0.0/12",
+	})
+	if vpcState.Attributes["router_id"] == "" || vpcState.Attributes["route_table_id"] == "" {
+		t.Fatalf("the router_id and route_table_id of the vpc should be set: %v", vpcState.Attributes)
+	}
+	vswitchState := testMockApply(t, resourceAlibabacloudStackSwitch(), client, nil, map[string]interface{}{
+		"name":              "tf-testacc-mock-vswitch",
+		"vpc_id":            vpcState.ID,
+		"cidr_block":        "172.16.0.0/24",
+		"availability_zone": mockserver.DefaultZoneId,
+	})
+	groupState := testMockApply(t, resourceAlibabacloudStackSecurityGroup(), client, nil, map[string]interface{}{
+		"name":   "tf-testacc-mock-sg",
+		"vpc_id": vpcState.ID,
+	})
+
+	vpcState = testMockApply(t, vpcResource, client, vpcState, map[string]interface{}{
+		"vpc_name":    "tf-testacc-mock-vpc-update",
+		"cidr_block":  "172.16.0.0/12",
+		"description": "updated",
+	})
+	if vpcState.Attributes["vpc_name"] != "tf-testacc-mock-vpc-update" || vpcState.Attributes["description"] != "updated" {
+		t.Fatalf("the vpc is not updated: %v", vpcState.Attributes)
+	}
+	testMockPlanEmpty(t, vpcResource, client, vpcState, map[string]interface{}{
+		"vpc_name":    "tf-testacc-mock-vpc-update",
+		"cidr_block":  "172.16.0.0/12",
+		"description": "updated",
+	})
+
+	testMockDestroy(t, resourceAlibabacloudStackSecurityGroup(), client, groupState)
+	testMockDestroy(t, resourceAlibabacloudStackSwitch(), client, vswitchState)
+	testMockDestroy(t, vpcResource, client, vpcState)
+	for kind, id := range map[string]string{"vpc": vpcState.ID, "vswitch": vswitchState.ID, "security_group": groupState.ID} {
+		if _, ok := server.Object(kind, id); ok {
+			t.Fatalf("the %s %s is not deleted", kind, id)
+		}
+	}
+}
+
+func TestMockVpcTags(t *testing.T) {
+	server := mockserver.NewServer()
+	defer server.Close()
+	client := testMockClient(t, server)
+
+	r := Provider().ResourcesMap["alibabacloudstack_vpc"]
+	config := map[string]interface{}{
+		"vpc_name":   "tf-testacc-mock-vpc",
+		"cidr_block": "172.16.0.0/12",
+		"tags": map[string]interface{}{
+			"Created": "TF",
+			"For":     "acceptance",
+		},
+	}
+	state := testMockApply(t, r, client, nil, config)
+	testMockPlanEmpty(t, r, client, state, config)
+
+	// The value of a tag is changed and another tag is removed.
+	config["tags"] = map[string]interface{}{"Created": "TF-update"}
+	state = testMockApply(t, r, client, state, config)
+	if tags := server.Tags(state.ID); len(tags) != 1 || tags["Created"] != "TF-update" {
+		t.Fatalf("expected the tag to be changed and the other tag to be removed, got %v", tags)
+	}
+	testMockPlanEmpty(t, r, client, state, config)
+
+	delete(config, "tags")
+	state = testMockApply(t, r, client, state, config)
+	if tags := server.Tags(state.ID); len(tags) != 0 {
+		t.Fatalf("expected all the tags to be removed, got %v", tags)
+	}
+	if state.Attributes["tags.%"] != "0" {
+		t.Fatalf("expected no tags in the state, got %v", state.Attributes)
+	}
+	testMockPlanEmpty(t, r, client, state, config)
+
+	testMockDestroy(t, r, client, state)
+}
+
+func TestMockInstance(t *testing.T) {
+	if testing.Short() {
+		t.Skip("The creation of the instance waits 2 minutes before checking its status.")
+	}
+	server := mockserver.NewServer()
+	defer server.Close()
+	client := testMockClient(t, server)
+
+	vpcState := testMockApply(t, resourceAlibabacloudStackVpc(), client, nil, map[string]interface{}{
+		"vpc_name":   "tf-testacc-mock-vpc",
+		"cidr_block": "172.16.0.0/12",
+	})
+	vswitchState := testMockApply(t, resourceAlibabacloudStackSwitch(), client, nil, map[string]interface{}{
+		"name":              "tf-testacc-mock-vswitch",
+		"vpc_id":            vpcState.ID,
+		"cidr_block":        "172.16.0.0/24",
+		"availability_zone": mockserver.DefaultZoneId,
+	})
+	groupState := testMockApply(t, resourceAlibabacloudStackSecurityGroup(), client, nil, map[string]interface{}{
+		"name":   "tf-testacc-mock-sg",
+		"vpc_id": vpcState.ID,
+	})
+
+	r := resourceAlibabacloudStackInstance()
+	config := map[string]interface{}{
+		"image_id":        "centos_7_06_64_20G_alibase_20190218.vhd",
+		"instance_type":   "ecs.n4.large",
+		"instance_name":   "tf-testacc-mock-instance",
+		"security_groups": []interface{}{groupState.ID},
+		"vswitch_id":      vswitchState.ID,
+		"tags": map[string]interface{}{
+			"Created": "TF",
+		},
+	}
+	state := testMockApply(t, r, client, nil, config)
+	instance, ok := server.Object("instance", state.ID)
+	if !ok {
+		t.Fatalf("the instance %s is not created", state.ID)
+	}
+	if instance["InstanceName"] != "tf-testacc-mock-instance" || state.Attributes["availability_zone"] != mockserver.DefaultZoneId {
+		t.Fatalf("the instance is not created with the config: %v", state.Attributes)
+	}
+	testMockPlanEmpty(t, r, client, state, config)
+
+	config["instance_name"] = "tf-testacc-mock-instance-update"
+	config["description"] = "updated"
+	state = testMockApply(t, r, client, state, config)
+	if state.Attributes["instance_name"] != "tf-testacc-mock-instance-update" || state.Attributes["description"] != "updated" {
+		t.Fatalf("the instance is not updated: %v", state.Attributes)
+	}
+	testMockPlanEmpty(t, r, client, state, config)
+
+	testMockDestroy(t, r, client, state)
+	if _, ok := server.Object("instance", state.ID); ok {
+		t.Fatalf("the instance %s is not deleted", state.ID)
+	}
+	testMockDestroy(t, resourceAlibabacloudStackSecurityGroup(), client, groupState)
+	testMockDestroy(t, resourceAlibabacloudStackSwitch(), client, vswitchState)
+	testMockDestroy(t, resourceAlibabacloudStackVpc(), client, vpcState)
+}
+
+// TestRecordedVpcVSwitch replays the cassette testdata/recordings/TestRecordedVpcVSwitch.json. The cassette is recorded
+// against the mock server by running the test with ALIBABACLOUDSTACK_RECORD_MODE=record.
+func TestRecordedVpcVSwitch(t *testing.T) {
+	envs := append([]string{connectivity.RecordModeEnv, "ALIBABACLOUDSTACK_ACCESS_KEY", "ALIBABACLOUDSTACK_SECRET_KEY"}, connectivity.RecordedEnvs...)
+	for _, key := range envs {
+		if value, ok := os.LookupEnv(key); ok {
+			defer os.Setenv(key, value)
+		} else {
+			defer os.Unsetenv(key)
+		}
+	}
+	if os.Getenv(connectivity.RecordModeEnv) == "" {
+		os.Setenv(connectivity.RecordModeEnv, string(connectivity.RecordModeReplay))
+	}
+	if connectivity.RecordMode(os.Getenv(connectivity.RecordModeEnv)) == connectivity.RecordModeRecord {
+		server := mockserver.NewServer()
+		defer server.Close()
+		os.Setenv("ALIBABACLOUDSTACK_REGION", server.RegionId)
+		os.Setenv("ALIBABACLOUDSTACK_DOMAIN", server.Domain())
+		os.Setenv("ALIBABACLOUDSTACK_ACCESS_KEY", "mock-access-key")
+		os.Setenv("ALIBABACLOUDSTACK_SECRET_KEY", "mock-secret-key")
+	}
+	testAccStartRecorder(t)
+
+	d := schema.TestResourceDataRaw(t, Provider().Schema, map[string]interface{}{
+		"access_key":              os.Getenv("ALIBABACLOUDSTACK_ACCESS_KEY"),
+		"secret_key":              os.Getenv("ALIBABACLOUDSTACK_SECRET_KEY"),
+		"region":                  os.Getenv("ALIBABACLOUDSTACK_REGION"),
+		"domain":                  os.Getenv("ALIBABACLOUDSTACK_DOMAIN"),
+		"protocol":                "HTTP",
+		"resource_group_set_name": mockserver.DefaultResourceSetName,
+	})
+	meta, diags := providerConfigure(context.Background(), d)
+	if diags.HasError() {
+		t.Fatalf("configuring the provider got an error: %v", diags)
+	}
+	client := meta.(*connectivity.AlibabacloudStackClient)
+
+	vpcResource := resourceAlibabacloudStackVpc()
+	vpcConfig := map[string]interface{}{
+		"vpc_name":   "tf-testacc-recorded-vpc",
+		"cidr_block": "172.16.0.0/12",
+	}
+	vpcState := testMockApply(t, vpcResource, client, nil, vpcConfig)
+	vswitchConfig := map[string]interface{}{
+		"name":              "tf-testacc-recorded-vswitch",
+		"vpc_id":            vpcState.ID,
+		"cidr_block":        "172.16.0.0/24",
+		"availability_zone": mockserver.DefaultZoneId,
+	}
+	vswitchState := testMockApply(t, resourceAlibabacloudStackSwitch(), client, nil, vswitchConfig)
+	testMockPlanEmpty(t, vpcResource, client, vpcState, vpcConfig)
+	testMockPlanEmpty(t, resourceAlibabacloudStackSwitch(), client, vswitchState, vswitchConfig)
+	testMockDestroy(t, resourceAlibabacloudStackSwitch(), client, vswitchState)
+	testMockDestroy(t, vpcResource, client, vpcState)
+}
+
+func TestMockRegionValidation(t *testing.T) {
+	server := mockserver.NewServer()
+	defer server.Close()
+	configure := func(region string) diag.Diagnostics {
+		d := schema.TestResourceDataRaw(t, Provider().Schema, map[string]interface{}{
+			"access_key":              "mock-access-key",
+			"secret_key":              "mock-secret-key",
+			"region":                  region,
+			"domain":                  server.Domain(),
+			"protocol":                "HTTP",
+			"resource_group_set_name": mockserver.DefaultResourceSetName,
+			"skip_region_validation":  false,
+		})
+		_, diags := providerConfigure(context.Background(), d)
+		return diags
+	}
+
+	if diags := configure(server.RegionId); diags.HasError() {
+		t.Fatalf("expected the region of the stack to be valid, got %v", diags)
+	}
+	diags := configure("cn-unknown-1")
+	if !diags.HasError() || !strings.Contains(diags[0].Summary, "The available regions of the stack are: "+server.RegionId) {
+		t.Fatalf("expected the error to list the regions of the stack, got %v", diags)
+	}
+	described := 0
+	for _, call := range server.Calls() {
+		if call == "ecs:DescribeRegions" {
+			described++
+		}
+	}
+	if described != 1 {
+		t.Fatalf("expected the regions to be described once and cached, got %d calls", described)
+	}
+}
+
+// testMockClient configures the provider with the domain of the mock server.
+func testMockClient(t *testing.T, server *mockserver.Server) *connectivity.AlibabacloudStackClient {
+	d := schema.TestResourceDataRaw(t, Provider().Schema, map[string]interface{}{
+		"access_key":              "mock-access-key",
+		"secret_key":              "mock-secret-key",
+		"region":                  server.RegionId,
+		"domain":                  server.Domain(),
+		"protocol":                "HTTP",
+		"resource_group_set_name": mockserver.DefaultResourceSetName,
+	})
+	client, diags := providerConfigure(context.Background(), d)
+	if diags.HasError() {
+		t.Fatalf("configuring the provider got an error: %v", diags)
+	}
+	return client.(*connectivity.AlibabacloudStackClient)
+}
+
+// testMockApply creates the resource when the state is nil, or updates it to the config.
+func testMockApply(t *testing.T, r *schema.Resource, client *connectivity.AlibabacloudStackClient, state *terraform.InstanceState, raw map[string]interface{}) *terraform.InstanceState {
+	diff, err := r.Diff(context.Background(), state, terraform.NewResourceConfigRaw(raw), client)
+	if err != nil {
+		t.Fatalf("planning the resource got an error: %#v", err)
+	}
+	if diff == nil {
+		return state
+	}
+	newState, diags := r.Apply(context.Background(), state, diff, client)
+	if diags.HasError() {
+		t.Fatalf("applying the resource got an error: %v", diags)
+	}
+	if newState == nil || newState.ID == "" {
+		t.Fatalf("the resource is not created")
+	}
+	return newState
+}
+
+// testMockPlanEmpty refreshes the resource and checks there is no difference from the config.
+func testMockPlanEmpty(t *testing.T, r *schema.Resource, client *connectivity.AlibabacloudStackClient, state *terraform.InstanceState, raw map[string]interface{}) {
+	state, diags := r.RefreshWithoutUpgrade(context.Background(), state, client)
+	if diags.HasError() {
+		t.Fatalf("refreshing the resource got an error: %v", diags)
+	}
+	diff, err := r.Diff(context.Background(), state, terraform.NewResourceConfigRaw(raw), client)
+	if err != nil {
+		t.Fatalf("planning the resource got an error: %#v", err)
+	}
+	if !diff.Empty() {
+		t.Fatalf("expected an empty plan after refreshing, got: %v", diff.Attributes)
+	}
+}
+
+func testMockDestroy(t *testing.T, r *schema.Resource, client *connectivity.AlibabacloudStackClient, state *terraform.InstanceState) {
+	if _, diags := r.Apply(context.Background(), state, &terraform.InstanceDiff{Destroy: true}, client); diags.HasError() {
+		t.Fatalf("destroying the resource got an error: %v", diags)
+	}
+}
+
+func TestMockAscmOrganizationImportByPath(t *testing.T) {
+	server := mockserver.NewServer()
+	defer server.Close()
+	client := testMockClient(t, server)
+
+	// The team-b under the root has the same name as the one under dept-a, which is only told apart by the path.
+	for id, organization := range map[int][]interface{}{
+		101: {"dept-a", mockserver.DefaultDepartmentId},
+		102: {"team-b", 101},
+		103: {"team-b", mockserver.DefaultDepartmentId},
+	} {
+		server.Seed("organization", strconv.Itoa(id), map[string]interface{}{
+			"id":       id,
+			"name":     organization[0],
+			"alias":    organization[0],
+			"parentId": organization[1],
+		})
+	}
+
+	r := resourceAlibabacloudStackAscmOrganization()
+	d := r.Data(&terraform.InstanceState{ID: "root/dept-a/team-b"})
+	imported, err := r.Importer.StateContext(context.Background(), d, client)
+	if err != nil {
+		t.Fatalf("importing the organization by the path got an error: %v", err)
+	}
+	if imported[0].Id() != "team-b:102" {
+		t.Fatalf("expected the organization to be imported as team-b:102, got %s", imported[0].Id())
+	}
+	testMockPlanEmpty(t, r, client, imported[0].State(), map[string]interface{}{
+		"name":      "team-b",
+		"parent_id": "101",
+	})
+
+	d = r.Data(&terraform.InstanceState{ID: "team-b"})
+	if _, err := r.Importer.StateContext(context.Background(), d, client); err == nil || !strings.Contains(err.Error(), "please use the ID instead") {
+		t.Fatalf("expected importing the ambiguous name to fail, got %v", err)
+	}
+}
+
+func TestMockQuotaCheck(t *testing.T) {
+	server := mockserver.NewServer()
+	defer server.Close()
+	client := testMockClient(t, server)
+	client.Config.QuotaCheck = connectivity.QuotaCheckError
+
+	server.Seed("quota", "ECS:resourceGroup:"+strconv.Itoa(mockserver.DefaultResourceGroupId), map[string]interface{}{
+		"id":                  1,
+		"totalDisk_cloud_ssd": 100,
+		"usedDisk_cloud_ssd":  20,
+	})
+	config := terraform.NewResourceConfigRaw(map[string]interface{}{
+		"availability_zone": mockserver.DefaultZoneId,
+		"category":          "cloud_ssd",
+		"size":              50,
+	})
+
+	// The plan of terraform runs the SimpleDiff, which customizes the diff once for every resource.
+	r := resourceAlibabacloudStackDisk()
+	if _, err := r.SimpleDiff(context.Background(), nil, config, client); err != nil {
+		t.Fatalf("expected the first disk to fit in the quota, got %v", err)
+	}
+	_, err := r.SimpleDiff(context.Background(), nil, config, client)
+	if err == nil || !strings.Contains(err.Error(), "disk_cloud_ssd requested 100 but 80 remaining") {
+		t.Fatalf("expected the second disk to exceed the quota, got %v", err)
+	}
+	// The organization has no ECS quota, so that it does not limit the disks.
+	if _, err := r.SimpleDiff(context.Background(), nil, terraform.NewResourceConfigRaw(map[string]interface{}{
+		"availability_zone": mockserver.DefaultZoneId,
+		"category":          "cloud_efficiency",
+		"size":              500,
+	}), client); err != nil {
+		t.Fatalf("expected the disk without the quota to be planned, got %v", err)
+	}
+}
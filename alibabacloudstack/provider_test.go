@@ -40,7 +40,16 @@ func TestProvider_impl(t *testing.T) {
 	var _ *schema.Provider = Provider()
 }
 
+func TestProviderResourcesImporter(t *testing.T) {
+	for name, r := range Provider().ResourcesMap {
+		if r.Importer == nil {
+			t.Errorf("resource %s does not support import", name)
+		}
+	}
+}
+
 func testAccPreCheck(t *testing.T) {
+	testAccStartRecorder(t)
 	if v := os.Getenv("ALIBABACLOUDSTACK_ACCESS_KEY"); v == "" {
 		t.Fatal("ALIBABACLOUDSTACK_ACCESS_KEY must be set for acceptance tests")
 	}
@@ -342,3 +351,40 @@ provider "alibabacloudstack" {
 	assume_role {}
 }
 `
+
+// testAccStartRecorder records the api traffic of the test into, or replays it from, a cassette when
+// ALIBABACLOUDSTACK_RECORD_MODE is set to record or replay. When replaying, the provider settings are
+// restored from the cassette and the credentials are filled with fake values.
+func testAccStartRecorder(t *testing.T) {
+	if recorder := connectivity.ActiveRecorder(); recorder != nil {
+		return
+	}
+	recorder, err := connectivity.StartRecorder(t.Name())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if recorder == nil {
+		return
+	}
+	t.Cleanup(func() {
+		if err := recorder.Stop(); err != nil {
+			t.Errorf("saving the cassette of %s got an error: %#v", t.Name(), err)
+		}
+	})
+	if recorder.Mode() != connectivity.RecordModeReplay {
+		return
+	}
+	for key, value := range map[string]string{
+		"ALIBABACLOUDSTACK_ACCESS_KEY": "replay-access-key",
+		"ALIBABACLOUDSTACK_SECRET_KEY": "replay-secret-key",
+		"ALIBABACLOUDSTACK_INSECURE":   "true",
+	} {
+		if os.Getenv(key) == "" {
+			os.Setenv(key, value)
+		}
+	}
+	for key, value := range recorder.Env() {
+		os.Setenv(key, value)
+	}
+	defaultRegionToTest = os.Getenv("ALIBABACLOUDSTACK_REGION")
+}
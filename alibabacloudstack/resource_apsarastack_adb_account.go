@@ -1,6 +1,7 @@
 package alibabacloudstack
 
 import (
+	"context"
 	"fmt"
 	"strings"
 	"time"
@@ -15,12 +16,12 @@ import (
 
 func resourceAlibabacloudStackAdbAccount() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceAlibabacloudStackAdbAccountCreate,
-		Read:   resourceAlibabacloudStackAdbAccountRead,
-		Update: resourceAlibabacloudStackAdbAccountUpdate,
-		Delete: resourceAlibabacloudStackAdbAccountDelete,
+		CreateContext: withDiagnostics(resourceAlibabacloudStackAdbAccountCreate),
+		ReadContext:   withDiagnostics(resourceAlibabacloudStackAdbAccountRead),
+		UpdateContext: withDiagnostics(resourceAlibabacloudStackAdbAccountUpdate),
+		DeleteContext: withDiagnostics(resourceAlibabacloudStackAdbAccountDelete),
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			StateContext: schema.ImportStatePassthroughContext,
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -74,7 +75,7 @@ func resourceAlibabacloudStackAdbAccount() *schema.Resource {
 	}
 }
 
-func resourceAlibabacloudStackAdbAccountCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceAlibabacloudStackAdbAccountCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*connectivity.AlibabacloudStackClient)
 	adbService := AdbService{client}
 	request := adb.CreateCreateAccountRequest()
@@ -106,7 +107,7 @@ func resourceAlibabacloudStackAdbAccountCreate(d *schema.ResourceData, meta inte
 	}
 	request.Headers["x-ascm-product-name"] = "adb"
 	request.Headers["x-acs-organizationid"] = client.Department
-	err := resource.Retry(5*time.Minute, func() *resource.RetryError {
+	err := resource.RetryContext(ctx, 5*time.Minute, func() *resource.RetryError {
 		raw, err := client.WithAdbClient(func(adbClient *adb.Client) (interface{}, error) {
 			return adbClient.CreateAccount(request)
 		})
@@ -131,10 +132,10 @@ func resourceAlibabacloudStackAdbAccountCreate(d *schema.ResourceData, meta inte
 		return WrapError(err)
 	}
 
-	return resourceAlibabacloudStackAdbAccountRead(d, meta)
+	return resourceAlibabacloudStackAdbAccountRead(ctx, d, meta)
 }
 
-func resourceAlibabacloudStackAdbAccountRead(d *schema.ResourceData, meta interface{}) error {
+func resourceAlibabacloudStackAdbAccountRead(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*connectivity.AlibabacloudStackClient)
 	adbService := AdbService{client}
 	object, err := adbService.DescribeAdbAccount(d.Id())
@@ -159,7 +160,7 @@ func resourceAlibabacloudStackAdbAccountRead(d *schema.ResourceData, meta interf
 	return nil
 }
 
-func resourceAlibabacloudStackAdbAccountUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceAlibabacloudStackAdbAccountUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*connectivity.AlibabacloudStackClient)
 	adbService := AdbService{client}
 	d.Partial(true)
@@ -227,10 +228,10 @@ func resourceAlibabacloudStackAdbAccountUpdate(d *schema.ResourceData, meta inte
 	}
 
 	d.Partial(false)
-	return resourceAlibabacloudStackAdbAccountRead(d, meta)
+	return resourceAlibabacloudStackAdbAccountRead(ctx, d, meta)
 }
 
-func resourceAlibabacloudStackAdbAccountDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceAlibabacloudStackAdbAccountDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*connectivity.AlibabacloudStackClient)
 	adbService := AdbService{client}
 	parts, err := ParseResourceId(d.Id(), 2)
@@ -1,6 +1,7 @@
 package alibabacloudstack
 
 import (
+	"context"
 	"fmt"
 	"regexp"
 	"strings"
@@ -17,11 +18,11 @@ import (
 
 func resourceAlibabacloudStackAdbConnection() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceAlibabacloudStackAdbConnectionCreate,
-		Read:   resourceAlibabacloudStackAdbConnectionRead,
-		Delete: resourceAlibabacloudStackAdbConnectionDelete,
+		CreateContext: withDiagnostics(resourceAlibabacloudStackAdbConnectionCreate),
+		ReadContext:   withDiagnostics(resourceAlibabacloudStackAdbConnectionRead),
+		DeleteContext: withDiagnostics(resourceAlibabacloudStackAdbConnectionDelete),
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			StateContext: schema.ImportStatePassthroughContext,
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -53,7 +54,7 @@ func resourceAlibabacloudStackAdbConnection() *schema.Resource {
 	}
 }
 
-func resourceAlibabacloudStackAdbConnectionCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceAlibabacloudStackAdbConnectionCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*connectivity.AlibabacloudStackClient)
 	adbService := AdbService{client}
 	dbClusterId := d.Get("db_cluster_id").(string)
@@ -70,7 +71,7 @@ func resourceAlibabacloudStackAdbConnectionCreate(d *schema.ResourceData, meta i
 	request.Headers["x-acs-organizationid"] = client.Department
 	var raw interface{}
 	var err error
-	err = resource.Retry(8*time.Minute, func() *resource.RetryError {
+	err = resource.RetryContext(ctx, 8*time.Minute, func() *resource.RetryError {
 		raw, err = client.WithAdbClient(func(adbClient *adb.Client) (interface{}, error) {
 			return adbClient.AllocateClusterPublicConnection(request)
 		})
@@ -99,10 +100,10 @@ func resourceAlibabacloudStackAdbConnectionCreate(d *schema.ResourceData, meta i
 		return WrapError(err)
 	}
 
-	return resourceAlibabacloudStackAdbConnectionRead(d, meta)
+	return resourceAlibabacloudStackAdbConnectionRead(ctx, d, meta)
 }
 
-func resourceAlibabacloudStackAdbConnectionRead(d *schema.ResourceData, meta interface{}) error {
+func resourceAlibabacloudStackAdbConnectionRead(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*connectivity.AlibabacloudStackClient)
 	adbService := AdbService{client}
 	object, err := adbService.DescribeAdbConnection(d.Id())
@@ -127,7 +128,7 @@ func resourceAlibabacloudStackAdbConnectionRead(d *schema.ResourceData, meta int
 	return nil
 }
 
-func resourceAlibabacloudStackAdbConnectionDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceAlibabacloudStackAdbConnectionDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*connectivity.AlibabacloudStackClient)
 	adbService := AdbService{client}
 
@@ -138,7 +139,7 @@ func resourceAlibabacloudStackAdbConnectionDelete(d *schema.ResourceData, meta i
 	request.Headers["x-ascm-product-name"] = "adb"
 	request.Headers["x-acs-organizationid"] = client.Department
 
-	err := resource.Retry(5*time.Minute, func() *resource.RetryError {
+	err := resource.RetryContext(ctx, 5*time.Minute, func() *resource.RetryError {
 		var raw interface{}
 		raw, err := client.WithAdbClient(func(adbClient *adb.Client) (interface{}, error) {
 			return adbClient.ReleaseClusterPublicConnection(request)
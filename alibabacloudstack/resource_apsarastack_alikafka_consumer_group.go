@@ -0,0 +1,138 @@
+package alibabacloudstack
+
+import (
+	"context"
+	"time"
+
+	"github.com/aliyun/alibaba-cloud-sdk-go/services/alikafka"
+	"github.com/aliyun/terraform-provider-alibabacloudstack/alibabacloudstack/connectivity"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+func resourceAlibabacloudStackAlikafkaConsumerGroup() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: withDiagnostics(resourceAlibabacloudStackAlikafkaConsumerGroupCreate),
+		ReadContext:   withDiagnostics(resourceAlibabacloudStackAlikafkaConsumerGroupRead),
+		UpdateContext: withDiagnostics(resourceAlibabacloudStackAlikafkaConsumerGroupUpdate),
+		DeleteContext: withDiagnostics(resourceAlibabacloudStackAlikafkaConsumerGroupDelete),
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"instance_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"consumer_id": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringLenBetween(1, 64),
+			},
+			"description": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringLenBetween(1, 256),
+			},
+			"tags": tagsSchema(),
+		},
+	}
+}
+
+func resourceAlibabacloudStackAlikafkaConsumerGroupCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*connectivity.AlibabacloudStackClient)
+	alikafkaService := AlikafkaService{client}
+
+	instanceId := d.Get("instance_id").(string)
+	consumerId := d.Get("consumer_id").(string)
+
+	request := alikafka.CreateCreateConsumerGroupRequest()
+	request.RegionId = client.RegionId
+	request.Domain = client.Config.AlikafkaOpenAPIEndpoint
+	request.InstanceId = instanceId
+	request.ConsumerId = consumerId
+	if v, ok := d.GetOk("description"); ok {
+		request.Remark = v.(string)
+	}
+	if err := alikafkaService.invokeAlikafkaRequest(ctx, "alibabacloudstack_alikafka_consumer_group", request.GetActionName(), func(alikafkaClient *alikafka.Client) (interface{}, error) {
+		return alikafkaClient.CreateConsumerGroup(request)
+	}, request.RpcRequest); err != nil {
+		return WrapError(err)
+	}
+
+	d.SetId(instanceId + COLON_SEPARATED + consumerId)
+
+	if err := alikafkaService.WaitForAlikafkaConsumerGroup(d.Id(), Running, int(d.Timeout(schema.TimeoutCreate).Seconds())); err != nil {
+		return WrapError(err)
+	}
+
+	return resourceAlibabacloudStackAlikafkaConsumerGroupUpdate(ctx, d, meta)
+}
+
+func resourceAlibabacloudStackAlikafkaConsumerGroupUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*connectivity.AlibabacloudStackClient)
+	alikafkaService := AlikafkaService{client}
+	d.Partial(true)
+	if err := alikafkaService.setInstanceTags(ctx, d, TagResourceConsumerGroup); err != nil {
+		return WrapError(err)
+	}
+	d.Partial(false)
+	return resourceAlibabacloudStackAlikafkaConsumerGroupRead(ctx, d, meta)
+}
+
+func resourceAlibabacloudStackAlikafkaConsumerGroupRead(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*connectivity.AlibabacloudStackClient)
+	alikafkaService := AlikafkaService{client}
+
+	object, err := alikafkaService.DescribeAlikafkaConsumerGroup(d.Id())
+	if err != nil {
+		if NotFoundError(err) {
+			d.SetId("")
+			return nil
+		}
+		return WrapError(err)
+	}
+
+	d.Set("instance_id", object.InstanceId)
+	d.Set("consumer_id", object.ConsumerId)
+	d.Set("description", object.Remark)
+
+	tags, err := alikafkaService.DescribeTags(ctx, d.Id(), nil, TagResourceConsumerGroup)
+	if err != nil {
+		return WrapError(err)
+	}
+	d.Set("tags", alikafkaService.tagsToMap(tags))
+
+	return nil
+}
+
+func resourceAlibabacloudStackAlikafkaConsumerGroupDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*connectivity.AlibabacloudStackClient)
+	alikafkaService := AlikafkaService{client}
+
+	parts, err := ParseResourceId(d.Id(), 2)
+	if err != nil {
+		return WrapError(err)
+	}
+
+	request := alikafka.CreateDeleteConsumerGroupRequest()
+	request.RegionId = client.RegionId
+	request.Domain = client.Config.AlikafkaOpenAPIEndpoint
+	request.InstanceId = parts[0]
+	request.ConsumerId = parts[1]
+	if err := alikafkaService.invokeAlikafkaRequest(ctx, d.Id(), request.GetActionName(), func(alikafkaClient *alikafka.Client) (interface{}, error) {
+		return alikafkaClient.DeleteConsumerGroup(request)
+	}, request.RpcRequest); err != nil {
+		return WrapError(err)
+	}
+
+	return WrapError(alikafkaService.WaitForAlikafkaConsumerGroup(d.Id(), Deleted, DefaultTimeoutMedium))
+}
@@ -0,0 +1,326 @@
+package alibabacloudstack
+
+import (
+	"context"
+	"strconv"
+	"time"
+
+	"github.com/aliyun/alibaba-cloud-sdk-go/sdk/requests"
+	"github.com/aliyun/alibaba-cloud-sdk-go/services/alikafka"
+	"github.com/aliyun/terraform-provider-alibabacloudstack/alibabacloudstack/connectivity"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+func resourceAlibabacloudStackAlikafkaInstance() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: withDiagnostics(resourceAlibabacloudStackAlikafkaInstanceCreate),
+		ReadContext:   withDiagnostics(resourceAlibabacloudStackAlikafkaInstanceRead),
+		UpdateContext: withDiagnostics(resourceAlibabacloudStackAlikafkaInstanceUpdate),
+		DeleteContext: withDiagnostics(resourceAlibabacloudStackAlikafkaInstanceDelete),
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(60 * time.Minute),
+			Update: schema.DefaultTimeout(120 * time.Minute),
+			Delete: schema.DefaultTimeout(30 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validation.StringLenBetween(3, 64),
+			},
+			"topic_quota": {
+				Type:     schema.TypeInt,
+				Required: true,
+			},
+			"disk_type": {
+				Type:         schema.TypeInt,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.IntInSlice([]int{0, 1}),
+			},
+			"disk_size": {
+				Type:     schema.TypeInt,
+				Required: true,
+			},
+			"deploy_type": {
+				Type:         schema.TypeInt,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.IntInSlice([]int{4, 5}),
+			},
+			"io_max": {
+				Type:     schema.TypeInt,
+				Required: true,
+			},
+			"eip_max": {
+				Type:     schema.TypeInt,
+				Optional: true,
+				Computed: true,
+			},
+			"spec_type": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      "normal",
+				ValidateFunc: validation.StringInSlice([]string{"normal", "professional"}, false),
+			},
+			"vswitch_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"security_group": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+			"vpc_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"zone_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"end_point": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"tags": tagsSchema(),
+		},
+	}
+}
+
+func resourceAlibabacloudStackAlikafkaInstanceCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*connectivity.AlibabacloudStackClient)
+	alikafkaService := AlikafkaService{client}
+	vpcService := VpcService{client}
+
+	// The stack only supports the post paid instances, which are ordered and then started in the vswitch.
+	createOrderReq := alikafka.CreateCreatePostPayOrderRequest()
+	createOrderReq.RegionId = client.RegionId
+	createOrderReq.Domain = client.Config.AlikafkaOpenAPIEndpoint
+	createOrderReq.TopicQuota = requests.NewInteger(d.Get("topic_quota").(int))
+	createOrderReq.DiskType = strconv.Itoa(d.Get("disk_type").(int))
+	createOrderReq.DiskSize = requests.NewInteger(d.Get("disk_size").(int))
+	createOrderReq.DeployType = requests.NewInteger(d.Get("deploy_type").(int))
+	createOrderReq.IoMax = requests.NewInteger(d.Get("io_max").(int))
+	createOrderReq.SpecType = d.Get("spec_type").(string)
+	if v, ok := d.GetOk("eip_max"); ok {
+		createOrderReq.EipMax = requests.NewInteger(v.(int))
+	}
+
+	var raw interface{}
+	err := resource.RetryContext(ctx, 5*time.Minute, func() *resource.RetryError {
+		var err error
+		raw, err = alikafkaService.client.WithAlikafkaClient(func(alikafkaClient *alikafka.Client) (interface{}, error) {
+			return alikafkaClient.CreatePostPayOrder(createOrderReq)
+		})
+		if err != nil {
+			if IsExpectedErrors(err, []string{ThrottlingUser, "ONS_SYSTEM_FLOW_CONTROL"}) {
+				time.Sleep(10 * time.Second)
+				return resource.RetryableError(err)
+			}
+			return resource.NonRetryableError(err)
+		}
+		addDebug(createOrderReq.GetActionName(), raw, createOrderReq.RpcRequest, createOrderReq)
+		return nil
+	})
+	if err != nil {
+		return WrapErrorf(err, DefaultErrorMsg, "alibabacloudstack_alikafka_instance", createOrderReq.GetActionName(), AlibabacloudStackSdkGoERROR)
+	}
+	createOrderResp, _ := raw.(*alikafka.CreatePostPayOrderResponse)
+	if !createOrderResp.Success {
+		return WrapErrorf(Error(createOrderResp.Message), DefaultErrorMsg, "alibabacloudstack_alikafka_instance", createOrderReq.GetActionName(), AlibabacloudStackSdkGoERROR)
+	}
+
+	alikafkaInstance, err := alikafkaService.DescribeAlikafkaInstanceByOrderId(ctx, createOrderResp.OrderId, 60)
+	if err != nil {
+		return WrapError(err)
+	}
+	d.SetId(alikafkaInstance.InstanceId)
+
+	vswitchId := d.Get("vswitch_id").(string)
+	vswitch, err := vpcService.DescribeVSwitch(vswitchId)
+	if err != nil {
+		return WrapError(err)
+	}
+
+	startInstanceReq := alikafka.CreateStartInstanceRequest()
+	startInstanceReq.RegionId = client.RegionId
+	startInstanceReq.Domain = client.Config.AlikafkaOpenAPIEndpoint
+	startInstanceReq.InstanceId = d.Id()
+	startInstanceReq.VpcId = vswitch.VpcId
+	startInstanceReq.VSwitchId = vswitchId
+	startInstanceReq.ZoneId = vswitch.ZoneId
+	if v, ok := d.GetOk("name"); ok {
+		startInstanceReq.Name = v.(string)
+	}
+	if v, ok := d.GetOk("security_group"); ok {
+		startInstanceReq.SecurityGroup = v.(string)
+	}
+	if d.Get("deploy_type").(int) == 4 {
+		startInstanceReq.IsEipInner = requests.NewBoolean(true)
+	}
+
+	err = resource.RetryContext(ctx, 5*time.Minute, func() *resource.RetryError {
+		raw, err := alikafkaService.client.WithAlikafkaClient(func(alikafkaClient *alikafka.Client) (interface{}, error) {
+			return alikafkaClient.StartInstance(startInstanceReq)
+		})
+		if err != nil {
+			if IsExpectedErrors(err, []string{ThrottlingUser, "ONS_SYSTEM_FLOW_CONTROL"}) {
+				time.Sleep(10 * time.Second)
+				return resource.RetryableError(err)
+			}
+			return resource.NonRetryableError(err)
+		}
+		addDebug(startInstanceReq.GetActionName(), raw, startInstanceReq.RpcRequest, startInstanceReq)
+		return nil
+	})
+	if err != nil {
+		return WrapErrorf(err, DefaultErrorMsg, d.Id(), startInstanceReq.GetActionName(), AlibabacloudStackSdkGoERROR)
+	}
+
+	if err := alikafkaService.WaitForAlikafkaInstance(ctx, d.Id(), Running, int(d.Timeout(schema.TimeoutCreate).Seconds())); err != nil {
+		return WrapError(err)
+	}
+
+	return resourceAlibabacloudStackAlikafkaInstanceUpdate(ctx, d, meta)
+}
+
+func resourceAlibabacloudStackAlikafkaInstanceRead(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*connectivity.AlibabacloudStackClient)
+	alikafkaService := AlikafkaService{client}
+
+	object, err := alikafkaService.DescribeAlikafkaInstance(d.Id())
+	if err != nil {
+		if NotFoundError(err) {
+			d.SetId("")
+			return nil
+		}
+		return WrapError(err)
+	}
+
+	d.Set("name", object.Name)
+	d.Set("topic_quota", object.TopicNumLimit)
+	d.Set("disk_type", object.DiskType)
+	d.Set("disk_size", object.DiskSize)
+	d.Set("deploy_type", object.DeployType)
+	d.Set("io_max", object.IoMax)
+	d.Set("eip_max", object.EipMax)
+	d.Set("spec_type", object.SpecType)
+	d.Set("vpc_id", object.VpcId)
+	d.Set("vswitch_id", object.VSwitchId)
+	d.Set("zone_id", object.ZoneId)
+	d.Set("security_group", object.SecurityGroup)
+	d.Set("end_point", object.EndPoint)
+
+	tags, err := alikafkaService.DescribeTags(ctx, d.Id(), nil, TagResourceInstance)
+	if err != nil {
+		return WrapError(err)
+	}
+	d.Set("tags", alikafkaService.tagsToMap(tags))
+
+	return nil
+}
+
+func resourceAlibabacloudStackAlikafkaInstanceUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*connectivity.AlibabacloudStackClient)
+	alikafkaService := AlikafkaService{client}
+	d.Partial(true)
+
+	if err := alikafkaService.setInstanceTags(ctx, d, TagResourceInstance); err != nil {
+		return WrapError(err)
+	}
+	if d.IsNewResource() {
+		d.Partial(false)
+		return resourceAlibabacloudStackAlikafkaInstanceRead(ctx, d, meta)
+	}
+
+	if d.HasChange("name") {
+		request := alikafka.CreateModifyInstanceNameRequest()
+		request.RegionId = client.RegionId
+		request.Domain = client.Config.AlikafkaOpenAPIEndpoint
+		request.InstanceId = d.Id()
+		request.InstanceName = d.Get("name").(string)
+		if err := alikafkaService.invokeAlikafkaRequest(ctx, d.Id(), request.GetActionName(), func(alikafkaClient *alikafka.Client) (interface{}, error) {
+			return alikafkaClient.ModifyInstanceName(request)
+		}, request.RpcRequest); err != nil {
+			return WrapError(err)
+		}
+	}
+
+	// The quotas of the instance are upgraded in place, and the instance keeps serving during the upgrade.
+	if d.HasChanges("topic_quota", "disk_size", "io_max", "eip_max", "spec_type") {
+		object, err := alikafkaService.DescribeAlikafkaInstance(d.Id())
+		if err != nil {
+			return WrapError(err)
+		}
+		request := alikafka.CreateUpgradePostPayOrderRequest()
+		request.RegionId = client.RegionId
+		request.Domain = client.Config.AlikafkaOpenAPIEndpoint
+		request.InstanceId = d.Id()
+		request.TopicQuota = requests.NewInteger(d.Get("topic_quota").(int))
+		request.DiskSize = requests.NewInteger(d.Get("disk_size").(int))
+		request.IoMax = requests.NewInteger(d.Get("io_max").(int))
+		request.SpecType = d.Get("spec_type").(string)
+		eipMax := d.Get("eip_max").(int)
+		if d.Get("deploy_type").(int) == 4 {
+			request.EipMax = requests.NewInteger(eipMax)
+		} else {
+			eipMax = object.EipMax
+		}
+		if err := alikafkaService.invokeAlikafkaRequest(ctx, d.Id(), request.GetActionName(), func(alikafkaClient *alikafka.Client) (interface{}, error) {
+			return alikafkaClient.UpgradePostPayOrder(request)
+		}, request.RpcRequest); err != nil {
+			return WrapError(err)
+		}
+		if err := alikafkaService.WaitForAlikafkaInstanceUpdated(ctx, d.Id(), d.Get("topic_quota").(int), d.Get("disk_size").(int), d.Get("io_max").(int),
+			eipMax, object.PaidType, d.Get("spec_type").(string), int(d.Timeout(schema.TimeoutUpdate).Seconds())); err != nil {
+			return WrapError(err)
+		}
+	}
+
+	d.Partial(false)
+	return resourceAlibabacloudStackAlikafkaInstanceRead(ctx, d, meta)
+}
+
+func resourceAlibabacloudStackAlikafkaInstanceDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*connectivity.AlibabacloudStackClient)
+	alikafkaService := AlikafkaService{client}
+
+	// The instance is released first, and then deleted after all of its nodes are released.
+	releaseReq := alikafka.CreateReleaseInstanceRequest()
+	releaseReq.RegionId = client.RegionId
+	releaseReq.Domain = client.Config.AlikafkaOpenAPIEndpoint
+	releaseReq.InstanceId = d.Id()
+	releaseReq.ForceDeleteInstance = requests.NewBoolean(true)
+	if err := alikafkaService.invokeAlikafkaRequest(ctx, d.Id(), releaseReq.GetActionName(), func(alikafkaClient *alikafka.Client) (interface{}, error) {
+		return alikafkaClient.ReleaseInstance(releaseReq)
+	}, releaseReq.RpcRequest); err != nil {
+		return WrapError(err)
+	}
+	if err := alikafkaService.WaitForAllAlikafkaNodeRelease(d.Id(), "released", int(d.Timeout(schema.TimeoutDelete).Seconds())); err != nil {
+		return WrapError(err)
+	}
+
+	deleteReq := alikafka.CreateDeleteInstanceRequest()
+	deleteReq.RegionId = client.RegionId
+	deleteReq.Domain = client.Config.AlikafkaOpenAPIEndpoint
+	deleteReq.InstanceId = d.Id()
+	if err := alikafkaService.invokeAlikafkaRequest(ctx, d.Id(), deleteReq.GetActionName(), func(alikafkaClient *alikafka.Client) (interface{}, error) {
+		return alikafkaClient.DeleteInstance(deleteReq)
+	}, deleteReq.RpcRequest); err != nil {
+		return WrapError(err)
+	}
+
+	return WrapError(alikafkaService.WaitForAlikafkaInstance(ctx, d.Id(), Deleted, int(d.Timeout(schema.TimeoutDelete).Seconds())))
+}
@@ -0,0 +1,136 @@
+package alibabacloudstack
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/aliyun/alibaba-cloud-sdk-go/services/alikafka"
+	"github.com/aliyun/terraform-provider-alibabacloudstack/alibabacloudstack/connectivity"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccAlibabacloudStackAlikafkaInstance_basic(t *testing.T) {
+
+	var v *alikafka.InstanceVO
+	resourceId := "alibabacloudstack_alikafka_instance.default"
+	ra := resourceAttrInit(resourceId, alikafkaInstanceBasicMap)
+	serviceFunc := func() interface{} {
+		return &AlikafkaService{testAccProvider.Meta().(*connectivity.AlibabacloudStackClient)}
+	}
+	rc := resourceCheckInit(resourceId, &v, serviceFunc)
+	rac := resourceAttrCheckInit(rc, ra)
+
+	rand := acctest.RandInt()
+	testAccCheck := rac.resourceAttrMapUpdateSet()
+	name := fmt.Sprintf("tf-testacc-alikafkainstance%v", rand)
+	testAccConfig := resourceTestAccConfigFunc(resourceId, name, resourceAlikafkaInstanceConfigDependence)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheckWithRegions(t, true, connectivity.AlikafkaSupportedRegions)
+			testAccPreCheck(t)
+		},
+		IDRefreshName: resourceId,
+		Providers:     testAccProviders,
+		CheckDestroy:  rac.checkResourceDestroy(),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccConfig(map[string]interface{}{
+					"name":        "${var.name}",
+					"topic_quota": "50",
+					"disk_type":   "1",
+					"disk_size":   "500",
+					"deploy_type": "5",
+					"io_max":      "20",
+					"vswitch_id":  "${alibabacloudstack_vswitch.default.id}",
+				}),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheck(map[string]string{
+						"name": name,
+					}),
+				),
+			},
+			{
+				ResourceName:      resourceId,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccConfig(map[string]interface{}{
+					"name": "${var.name}_update",
+				}),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheck(map[string]string{
+						"name": name + "_update",
+					}),
+				),
+			},
+			{
+				Config: testAccConfig(map[string]interface{}{
+					"topic_quota": "100",
+					"disk_size":   "600",
+					"io_max":      "30",
+				}),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheck(map[string]string{
+						"topic_quota": "100",
+						"disk_size":   "600",
+						"io_max":      "30",
+					}),
+				),
+			},
+			{
+				Config: testAccConfig(map[string]interface{}{
+					"tags": map[string]string{
+						"Created": "TF",
+						"For":     "acceptance test",
+					},
+				}),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheck(map[string]string{
+						"tags.%":       "2",
+						"tags.Created": "TF",
+						"tags.For":     "acceptance test",
+					}),
+				),
+			},
+		},
+	})
+}
+
+func resourceAlikafkaInstanceConfigDependence(name string) string {
+	return fmt.Sprintf(`
+variable "name" {
+  default = "%v"
+}
+
+data "alibabacloudstack_zones" "default" {
+  available_resource_creation = "VSwitch"
+}
+
+resource "alibabacloudstack_vpc" "default" {
+  name       = var.name
+  cidr_block = "172.16.0.0/16"
+}
+
+resource "alibabacloudstack_vswitch" "default" {
+  vpc_id            = alibabacloudstack_vpc.default.id
+  cidr_block        = "172.16.0.0/24"
+  availability_zone = data.alibabacloudstack_zones.default.zones.0.id
+  name              = var.name
+}
+`, name)
+}
+
+var alikafkaInstanceBasicMap = map[string]string{
+	"topic_quota": "50",
+	"disk_type":   "1",
+	"disk_size":   "500",
+	"deploy_type": "5",
+	"io_max":      "20",
+	"spec_type":   "normal",
+	"vswitch_id":  CHECKSET,
+	"vpc_id":      CHECKSET,
+	"zone_id":     CHECKSET,
+}
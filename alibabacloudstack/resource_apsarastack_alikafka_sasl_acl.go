@@ -1,6 +1,7 @@
 package alibabacloudstack
 
 import (
+	"context"
 	"fmt"
 	"time"
 
@@ -13,11 +14,11 @@ import (
 
 func resourceAlibabacloudStackAlikafkaSaslAcl() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceAlibabacloudStackAlikafkaSaslAclCreate,
-		Read:   resourceAlibabacloudStackAlikafkaSaslAclRead,
-		Delete: resourceAlibabacloudStackAlikafkaSaslAclDelete,
+		CreateContext: withDiagnostics(resourceAlibabacloudStackAlikafkaSaslAclCreate),
+		ReadContext:   withDiagnostics(resourceAlibabacloudStackAlikafkaSaslAclRead),
+		DeleteContext: withDiagnostics(resourceAlibabacloudStackAlikafkaSaslAclDelete),
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			StateContext: schema.ImportStatePassthroughContext,
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -64,7 +65,7 @@ func resourceAlibabacloudStackAlikafkaSaslAcl() *schema.Resource {
 	}
 }
 
-func resourceAlibabacloudStackAlikafkaSaslAclCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceAlibabacloudStackAlikafkaSaslAclCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 
 	client := meta.(*connectivity.AlibabacloudStackClient)
 	alikafkaService := AlikafkaService{client}
@@ -86,7 +87,7 @@ func resourceAlibabacloudStackAlikafkaSaslAclCreate(d *schema.ResourceData, meta
 	request.AclResourcePatternType = aclResourcePatternType
 	request.AclOperationType = aclOperationType
 	request.Domain = client.Config.AlikafkaOpenAPIEndpoint
-	err := resource.Retry(5*time.Minute, func() *resource.RetryError {
+	err := resource.RetryContext(ctx, 5*time.Minute, func() *resource.RetryError {
 		raw, err := alikafkaService.client.WithAlikafkaClient(func(alikafkaClient *alikafka.Client) (interface{}, error) {
 			return alikafkaClient.CreateAcl(request)
 		})
@@ -108,10 +109,10 @@ func resourceAlibabacloudStackAlikafkaSaslAclCreate(d *schema.ResourceData, meta
 	// Server may have cache, sleep a while.
 	time.Sleep(60 * time.Second)
 	d.SetId(fmt.Sprintf("%s:%s:%s:%s:%s:%s", instanceId, username, aclResourceType, aclResourceName, aclResourcePatternType, aclOperationType))
-	return resourceAlibabacloudStackAlikafkaSaslAclRead(d, meta)
+	return resourceAlibabacloudStackAlikafkaSaslAclRead(ctx, d, meta)
 }
 
-func resourceAlibabacloudStackAlikafkaSaslAclRead(d *schema.ResourceData, meta interface{}) error {
+func resourceAlibabacloudStackAlikafkaSaslAclRead(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 
 	client := meta.(*connectivity.AlibabacloudStackClient)
 	alikafkaService := AlikafkaService{client}
@@ -141,7 +142,7 @@ func resourceAlibabacloudStackAlikafkaSaslAclRead(d *schema.ResourceData, meta i
 	return nil
 }
 
-func resourceAlibabacloudStackAlikafkaSaslAclDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceAlibabacloudStackAlikafkaSaslAclDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 
 	client := meta.(*connectivity.AlibabacloudStackClient)
 	alikafkaService := AlikafkaService{client}
@@ -166,7 +167,7 @@ func resourceAlibabacloudStackAlikafkaSaslAclDelete(d *schema.ResourceData, meta
 	request.AclResourcePatternType = aclResourcePatternType
 	request.AclOperationType = aclOperationType
 	request.Domain = client.Config.AlikafkaOpenAPIEndpoint
-	err = resource.Retry(5*time.Minute, func() *resource.RetryError {
+	err = resource.RetryContext(ctx, 5*time.Minute, func() *resource.RetryError {
 		raw, err := alikafkaService.client.WithAlikafkaClient(func(alikafkaClient *alikafka.Client) (interface{}, error) {
 			return alikafkaClient.DeleteAcl(request)
 		})
@@ -1,6 +1,7 @@
 package alibabacloudstack
 
 import (
+	"context"
 	"time"
 
 	"github.com/aliyun/alibaba-cloud-sdk-go/services/alikafka"
@@ -12,12 +13,12 @@ import (
 
 func resourceAlibabacloudStackAlikafkaSaslUser() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceAlibabacloudStackAlikafkaSaslUserCreate,
-		Read:   resourceAlibabacloudStackAlikafkaSaslUserRead,
-		Update: resourceAlibabacloudStackAlikafkaSaslUserUpdate,
-		Delete: resourceAlibabacloudStackAlikafkaSaslUserDelete,
+		CreateContext: withDiagnostics(resourceAlibabacloudStackAlikafkaSaslUserCreate),
+		ReadContext:   withDiagnostics(resourceAlibabacloudStackAlikafkaSaslUserRead),
+		UpdateContext: withDiagnostics(resourceAlibabacloudStackAlikafkaSaslUserUpdate),
+		DeleteContext: withDiagnostics(resourceAlibabacloudStackAlikafkaSaslUserDelete),
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			StateContext: schema.ImportStatePassthroughContext,
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -64,7 +65,7 @@ func resourceAlibabacloudStackAlikafkaSaslUser() *schema.Resource {
 	}
 }
 
-func resourceAlibabacloudStackAlikafkaSaslUserCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceAlibabacloudStackAlikafkaSaslUserCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 
 	client := meta.(*connectivity.AlibabacloudStackClient)
 	alikafkaService := AlikafkaService{client}
@@ -90,14 +91,14 @@ func resourceAlibabacloudStackAlikafkaSaslUserCreate(d *schema.ResourceData, met
 		request.Password = password
 	} else {
 		kmsService := KmsService{client}
-		decryptResp, err := kmsService.Decrypt2(kmsPassword, d.Get("kms_encryption_context").(map[string]interface{}))
+		decryptResp, err := kmsService.Decrypt2(ctx, kmsPassword, d.Get("kms_encryption_context").(map[string]interface{}))
 		if err != nil {
 			return WrapError(err)
 		}
 		request.Password = decryptResp
 	}
 
-	err := resource.Retry(5*time.Minute, func() *resource.RetryError {
+	err := resource.RetryContext(ctx, 5*time.Minute, func() *resource.RetryError {
 		raw, err := alikafkaService.client.WithAlikafkaClient(func(alikafkaClient *alikafka.Client) (interface{}, error) {
 			return alikafkaClient.CreateSaslUser(request)
 		})
@@ -119,10 +120,10 @@ func resourceAlibabacloudStackAlikafkaSaslUserCreate(d *schema.ResourceData, met
 	// Server may have cache, sleep a while.
 	time.Sleep(2 * time.Second)
 	d.SetId(instanceId + ":" + username + ":" + usertype)
-	return resourceAlibabacloudStackAlikafkaSaslUserUpdate(d, meta)
+	return resourceAlibabacloudStackAlikafkaSaslUserUpdate(ctx, d, meta)
 }
 
-func resourceAlibabacloudStackAlikafkaSaslUserRead(d *schema.ResourceData, meta interface{}) error {
+func resourceAlibabacloudStackAlikafkaSaslUserRead(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 
 	client := meta.(*connectivity.AlibabacloudStackClient)
 	alikafkaService := AlikafkaService{client}
@@ -149,7 +150,7 @@ func resourceAlibabacloudStackAlikafkaSaslUserRead(d *schema.ResourceData, meta
 	return nil
 }
 
-func resourceAlibabacloudStackAlikafkaSaslUserUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceAlibabacloudStackAlikafkaSaslUserUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 
 	client := meta.(*connectivity.AlibabacloudStackClient)
 	alikafkaService := AlikafkaService{client}
@@ -181,14 +182,14 @@ func resourceAlibabacloudStackAlikafkaSaslUserUpdate(d *schema.ResourceData, met
 			request.Password = password
 		} else {
 			kmsService := KmsService{client}
-			decryptResp, err := kmsService.Decrypt2(kmsPassword, d.Get("kms_encryption_context").(map[string]interface{}))
+			decryptResp, err := kmsService.Decrypt2(ctx, kmsPassword, d.Get("kms_encryption_context").(map[string]interface{}))
 			if err != nil {
 				return WrapError(err)
 			}
 			request.Password = decryptResp
 		}
 
-		err := resource.Retry(5*time.Minute, func() *resource.RetryError {
+		err := resource.RetryContext(ctx, 5*time.Minute, func() *resource.RetryError {
 			raw, err := alikafkaService.client.WithAlikafkaClient(func(alikafkaClient *alikafka.Client) (interface{}, error) {
 				return alikafkaClient.CreateSaslUser(request)
 			})
@@ -210,10 +211,10 @@ func resourceAlibabacloudStackAlikafkaSaslUserUpdate(d *schema.ResourceData, met
 		// Server may have cache, sleep a while.
 		time.Sleep(1000)
 	}
-	return resourceAlibabacloudStackAlikafkaSaslUserRead(d, meta)
+	return resourceAlibabacloudStackAlikafkaSaslUserRead(ctx, d, meta)
 }
 
-func resourceAlibabacloudStackAlikafkaSaslUserDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceAlibabacloudStackAlikafkaSaslUserDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 
 	client := meta.(*connectivity.AlibabacloudStackClient)
 	alikafkaService := AlikafkaService{client}
@@ -232,7 +233,7 @@ func resourceAlibabacloudStackAlikafkaSaslUserDelete(d *schema.ResourceData, met
 	request.Username = username
 	request.Type = usertype
 	request.Domain = client.Config.AlikafkaOpenAPIEndpoint
-	err = resource.Retry(5*time.Minute, func() *resource.RetryError {
+	err = resource.RetryContext(ctx, 5*time.Minute, func() *resource.RetryError {
 		raw, err := alikafkaService.client.WithAlikafkaClient(func(alikafkaClient *alikafka.Client) (interface{}, error) {
 			return alikafkaClient.DeleteSaslUser(request)
 		})
@@ -1,6 +1,7 @@
 package alibabacloudstack
 
 import (
+	"context"
 	"errors"
 	"strconv"
 
@@ -16,12 +17,12 @@ import (
 
 func resourceAlibabacloudStackAlikafkaTopic() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceAlibabacloudStackAlikafkaTopicCreate,
-		Update: resourceAlibabacloudStackAlikafkaTopicUpdate,
-		Read:   resourceAlibabacloudStackAlikafkaTopicRead,
-		Delete: resourceAlibabacloudStackAlikafkaTopicDelete,
+		CreateContext: withDiagnostics(resourceAlibabacloudStackAlikafkaTopicCreate),
+		UpdateContext: withDiagnostics(resourceAlibabacloudStackAlikafkaTopicUpdate),
+		ReadContext:   withDiagnostics(resourceAlibabacloudStackAlikafkaTopicRead),
+		DeleteContext: withDiagnostics(resourceAlibabacloudStackAlikafkaTopicDelete),
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			StateContext: schema.ImportStatePassthroughContext,
 		},
 
 		Timeouts: &schema.ResourceTimeout{
@@ -68,7 +69,7 @@ func resourceAlibabacloudStackAlikafkaTopic() *schema.Resource {
 	}
 }
 
-func resourceAlibabacloudStackAlikafkaTopicCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceAlibabacloudStackAlikafkaTopicCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*connectivity.AlibabacloudStackClient)
 	alikafkaService := AlikafkaService{client}
 
@@ -93,7 +94,7 @@ func resourceAlibabacloudStackAlikafkaTopicCreate(d *schema.ResourceData, meta i
 		request.Remark = v.(string)
 	}
 	request.Domain = client.Config.AlikafkaOpenAPIEndpoint
-	err := resource.Retry(5*time.Minute, func() *resource.RetryError {
+	err := resource.RetryContext(ctx, 5*time.Minute, func() *resource.RetryError {
 		raw, err := alikafkaService.client.WithAlikafkaClient(func(alikafkaClient *alikafka.Client) (interface{}, error) {
 			return alikafkaClient.CreateTopic(request)
 		})
@@ -114,20 +115,20 @@ func resourceAlibabacloudStackAlikafkaTopicCreate(d *schema.ResourceData, meta i
 
 	d.SetId(instanceId + ":" + topic)
 
-	return resourceAlibabacloudStackAlikafkaTopicUpdate(d, meta)
+	return resourceAlibabacloudStackAlikafkaTopicUpdate(ctx, d, meta)
 }
 
-func resourceAlibabacloudStackAlikafkaTopicUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceAlibabacloudStackAlikafkaTopicUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 
 	client := meta.(*connectivity.AlibabacloudStackClient)
 	alikafkaService := AlikafkaService{client}
 	d.Partial(true)
-	if err := alikafkaService.setInstanceTags(d, TagResourceTopic); err != nil {
+	if err := alikafkaService.setInstanceTags(ctx, d, TagResourceTopic); err != nil {
 		return WrapError(err)
 	}
 	if d.IsNewResource() {
 		d.Partial(false)
-		return resourceAlibabacloudStackAlikafkaTopicRead(d, meta)
+		return resourceAlibabacloudStackAlikafkaTopicRead(ctx, d, meta)
 	}
 
 	instanceId := d.Get("instance_id").(string)
@@ -139,7 +140,7 @@ func resourceAlibabacloudStackAlikafkaTopicUpdate(d *schema.ResourceData, meta i
 		modifyRemarkRequest.RegionId = client.RegionId
 		modifyRemarkRequest.Topic = topic
 		modifyRemarkRequest.Remark = remark
-		err := resource.Retry(5*time.Minute, func() *resource.RetryError {
+		err := resource.RetryContext(ctx, 5*time.Minute, func() *resource.RetryError {
 			raw, err := alikafkaService.client.WithAlikafkaClient(func(alikafkaClient *alikafka.Client) (interface{}, error) {
 				return alikafkaClient.ModifyTopicRemark(modifyRemarkRequest)
 			})
@@ -174,7 +175,7 @@ func resourceAlibabacloudStackAlikafkaTopicUpdate(d *schema.ResourceData, meta i
 			modifyPartitionReq.Topic = topic
 			modifyPartitionReq.AddPartitionNum = requests.NewInteger(newPartitionNum - oldPartitionNum)
 
-			err := resource.Retry(5*time.Minute, func() *resource.RetryError {
+			err := resource.RetryContext(ctx, 5*time.Minute, func() *resource.RetryError {
 				raw, err := alikafkaService.client.WithAlikafkaClient(func(alikafkaClient *alikafka.Client) (interface{}, error) {
 					return alikafkaClient.ModifyPartitionNum(modifyPartitionReq)
 				})
@@ -196,10 +197,10 @@ func resourceAlibabacloudStackAlikafkaTopicUpdate(d *schema.ResourceData, meta i
 	}
 
 	d.Partial(false)
-	return resourceAlibabacloudStackAlikafkaTopicRead(d, meta)
+	return resourceAlibabacloudStackAlikafkaTopicRead(ctx, d, meta)
 }
 
-func resourceAlibabacloudStackAlikafkaTopicRead(d *schema.ResourceData, meta interface{}) error {
+func resourceAlibabacloudStackAlikafkaTopicRead(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*connectivity.AlibabacloudStackClient)
 	alikafkaService := AlikafkaService{client}
 
@@ -229,7 +230,7 @@ func resourceAlibabacloudStackAlikafkaTopicRead(d *schema.ResourceData, meta int
 	return nil
 }
 
-func resourceAlibabacloudStackAlikafkaTopicDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceAlibabacloudStackAlikafkaTopicDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*connectivity.AlibabacloudStackClient)
 	alikafkaService := AlikafkaService{client}
 
@@ -245,7 +246,7 @@ func resourceAlibabacloudStackAlikafkaTopicDelete(d *schema.ResourceData, meta i
 	request.InstanceId = instanceId
 	request.RegionId = client.RegionId
 	request.Domain = client.Config.AlikafkaOpenAPIEndpoint
-	err = resource.Retry(5*time.Minute, func() *resource.RetryError {
+	err = resource.RetryContext(ctx, 5*time.Minute, func() *resource.RetryError {
 		raw, err := alikafkaService.client.WithAlikafkaClient(func(alikafkaClient *alikafka.Client) (interface{}, error) {
 			return alikafkaClient.DeleteTopic(request)
 		})
@@ -1,6 +1,7 @@
 package alibabacloudstack
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
 	"strconv"
@@ -19,12 +20,12 @@ import (
 
 func resourceAlibabacloudStackApigatewayApi() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceAlibabacloudStackApigatewayApiCreate,
-		Read:   resourceAlibabacloudStackApigatewayApiRead,
-		Update: resourceAlibabacloudStackApigatewayApiUpdate,
-		Delete: resourceAlibabacloudStackApigatewayApiDelete,
+		CreateContext: withDiagnostics(resourceAlibabacloudStackApigatewayApiCreate),
+		ReadContext:   withDiagnostics(resourceAlibabacloudStackApigatewayApiRead),
+		UpdateContext: withDiagnostics(resourceAlibabacloudStackApigatewayApiUpdate),
+		DeleteContext: withDiagnostics(resourceAlibabacloudStackApigatewayApiDelete),
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			StateContext: schema.ImportStatePassthroughContext,
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -302,7 +303,7 @@ func resourceAlibabacloudStackApigatewayApi() *schema.Resource {
 	}
 }
 
-func resourceAlibabacloudStackApigatewayApiCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceAlibabacloudStackApigatewayApiCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*connectivity.AlibabacloudStackClient)
 	request, err := buildAlibabacloudStackApiArgs(d, meta)
 	request.Headers = map[string]string{
@@ -341,10 +342,10 @@ func resourceAlibabacloudStackApigatewayApiCreate(d *schema.ResourceData, meta i
 		}
 	}
 
-	return resourceAlibabacloudStackApigatewayApiRead(d, meta)
+	return resourceAlibabacloudStackApigatewayApiRead(ctx, d, meta)
 }
 
-func resourceAlibabacloudStackApigatewayApiRead(d *schema.ResourceData, meta interface{}) error {
+func resourceAlibabacloudStackApigatewayApiRead(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*connectivity.AlibabacloudStackClient)
 	cloudApiService := CloudApiService{client}
 	request := cloudapi.CreateDescribeApiRequest()
@@ -494,7 +495,7 @@ func resourceAlibabacloudStackApigatewayApiRead(d *schema.ResourceData, meta int
 	return nil
 }
 
-func resourceAlibabacloudStackApigatewayApiUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceAlibabacloudStackApigatewayApiUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*connectivity.AlibabacloudStackClient)
 	request := cloudapi.CreateModifyApiRequest()
 	parts, err := ParseResourceId(d.Id(), 2)
@@ -605,10 +606,10 @@ func resourceAlibabacloudStackApigatewayApiUpdate(d *schema.ResourceData, meta i
 
 	d.Partial(false)
 
-	return resourceAlibabacloudStackApigatewayApiRead(d, meta)
+	return resourceAlibabacloudStackApigatewayApiRead(ctx, d, meta)
 }
 
-func resourceAlibabacloudStackApigatewayApiDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceAlibabacloudStackApigatewayApiDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*connectivity.AlibabacloudStackClient)
 	cloudApiService := CloudApiService{client}
 	request := cloudapi.CreateDeleteApiRequest()
@@ -632,7 +633,7 @@ func resourceAlibabacloudStackApigatewayApiDelete(d *schema.ResourceData, meta i
 		"Version":         "2016-07-14",
 	}
 	for _, stageName := range ApiGatewayStageNames {
-		err = resource.Retry(5*time.Minute, func() *resource.RetryError {
+		err = resource.RetryContext(ctx, 5*time.Minute, func() *resource.RetryError {
 			err := cloudApiService.AbolishApi(d.Id(), stageName)
 			if err != nil {
 				if IsExpectedErrors(err, []string{"ConcurrencyLockTimeout"}) {
@@ -1,6 +1,7 @@
 package alibabacloudstack
 
 import (
+	"context"
 	"strconv"
 	"time"
 
@@ -15,12 +16,12 @@ import (
 
 func resourceAlibabacloudStackApigatewayApp() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceAlibabacloudStackApigatewayAppCreate,
-		Read:   resourceAlibabacloudStackApigatewayAppRead,
-		Update: resourceAlibabacloudStackApigatewayAppUpdate,
-		Delete: resourceAlibabacloudStackApigatewayAppDelete,
+		CreateContext: withDiagnostics(resourceAlibabacloudStackApigatewayAppCreate),
+		ReadContext:   withDiagnostics(resourceAlibabacloudStackApigatewayAppRead),
+		UpdateContext: withDiagnostics(resourceAlibabacloudStackApigatewayAppUpdate),
+		DeleteContext: withDiagnostics(resourceAlibabacloudStackApigatewayAppDelete),
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			StateContext: schema.ImportStatePassthroughContext,
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -38,7 +39,7 @@ func resourceAlibabacloudStackApigatewayApp() *schema.Resource {
 	}
 }
 
-func resourceAlibabacloudStackApigatewayAppCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceAlibabacloudStackApigatewayAppCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*connectivity.AlibabacloudStackClient)
 
 	request := cloudapi.CreateCreateAppRequest()
@@ -61,7 +62,7 @@ func resourceAlibabacloudStackApigatewayAppCreate(d *schema.ResourceData, meta i
 		"Action":          "CreateApp",
 		"Version":         "2016-07-14",
 	}
-	if err := resource.Retry(5*time.Minute, func() *resource.RetryError {
+	if err := resource.RetryContext(ctx, 5*time.Minute, func() *resource.RetryError {
 		raw, err := client.WithCloudApiClient(func(cloudApiClient *cloudapi.Client) (interface{}, error) {
 			return cloudApiClient.CreateApp(request)
 		})
@@ -78,15 +79,15 @@ func resourceAlibabacloudStackApigatewayAppCreate(d *schema.ResourceData, meta i
 	}); err != nil {
 		return WrapErrorf(err, DefaultErrorMsg, "alibabacloudstack_apigateway_app", request.GetActionName(), AlibabacloudStackSdkGoERROR)
 	}
-	return resourceAlibabacloudStackApigatewayAppUpdate(d, meta)
+	return resourceAlibabacloudStackApigatewayAppUpdate(ctx, d, meta)
 }
 
-func resourceAlibabacloudStackApigatewayAppRead(d *schema.ResourceData, meta interface{}) error {
+func resourceAlibabacloudStackApigatewayAppRead(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*connectivity.AlibabacloudStackClient)
 	cloudApiService := CloudApiService{client}
 
 	wait := incrementalWait(3*time.Second, 5*time.Second)
-	if err := resource.Retry(5*time.Minute, func() *resource.RetryError {
+	if err := resource.RetryContext(ctx, 5*time.Minute, func() *resource.RetryError {
 		tags, err := cloudApiService.DescribeTags(d.Id(), nil, TagResourceApp)
 		if err != nil {
 			if IsExpectedErrors(err, []string{"NotFoundResourceId"}) {
@@ -101,7 +102,7 @@ func resourceAlibabacloudStackApigatewayAppRead(d *schema.ResourceData, meta int
 		return WrapError(err)
 	}
 
-	if err := resource.Retry(3*time.Second, func() *resource.RetryError {
+	if err := resource.RetryContext(ctx, 3*time.Second, func() *resource.RetryError {
 		object, err := cloudApiService.DescribeApiGatewayApp(d.Id())
 		if err != nil {
 			if NotFoundError(err) {
@@ -119,7 +120,7 @@ func resourceAlibabacloudStackApigatewayAppRead(d *schema.ResourceData, meta int
 	return nil
 }
 
-func resourceAlibabacloudStackApigatewayAppUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceAlibabacloudStackApigatewayAppUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*connectivity.AlibabacloudStackClient)
 	cloudApiService := CloudApiService{client}
 	if err := cloudApiService.setInstanceTags(d, TagResourceApp); err != nil {
@@ -127,7 +128,7 @@ func resourceAlibabacloudStackApigatewayAppUpdate(d *schema.ResourceData, meta i
 	}
 	if d.IsNewResource() {
 		d.Partial(false)
-		return resourceAlibabacloudStackApigatewayAppRead(d, meta)
+		return resourceAlibabacloudStackApigatewayAppRead(ctx, d, meta)
 	}
 	if d.HasChange("name") || d.HasChange("description") {
 		request := cloudapi.CreateModifyAppRequest()
@@ -159,10 +160,10 @@ func resourceAlibabacloudStackApigatewayAppUpdate(d *schema.ResourceData, meta i
 		addDebug(request.GetActionName(), raw, request.RpcRequest, request)
 	}
 	time.Sleep(3 * time.Second)
-	return resourceAlibabacloudStackApigatewayAppRead(d, meta)
+	return resourceAlibabacloudStackApigatewayAppRead(ctx, d, meta)
 }
 
-func resourceAlibabacloudStackApigatewayAppDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceAlibabacloudStackApigatewayAppDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*connectivity.AlibabacloudStackClient)
 	cloudApiService := CloudApiService{client}
 
@@ -1,6 +1,7 @@
 package alibabacloudstack
 
 import (
+	"context"
 	"fmt"
 
 	"github.com/aliyun/alibaba-cloud-sdk-go/services/cloudapi"
@@ -11,9 +12,12 @@ import (
 
 func resourceAliyunApigatewayAppAttachment() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceAliyunApigatewayAppAttachmentCreate,
-		Read:   resourceAliyunApigatewayAppAttachmentRead,
-		Delete: resourceAliyunApigatewayAppAttachmentDelete,
+		CreateContext: withDiagnostics(resourceAliyunApigatewayAppAttachmentCreate),
+		ReadContext:   withDiagnostics(resourceAliyunApigatewayAppAttachmentRead),
+		DeleteContext: withDiagnostics(resourceAliyunApigatewayAppAttachmentDelete),
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
 
 		Schema: map[string]*schema.Schema{
 
@@ -45,7 +49,7 @@ func resourceAliyunApigatewayAppAttachment() *schema.Resource {
 	}
 }
 
-func resourceAliyunApigatewayAppAttachmentCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceAliyunApigatewayAppAttachmentCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*connectivity.AlibabacloudStackClient)
 	cloudApiService := CloudApiService{client}
 
@@ -88,15 +92,19 @@ func resourceAliyunApigatewayAppAttachmentCreate(d *schema.ResourceData, meta in
 	}
 
 	d.SetId(id)
-	return resourceAliyunApigatewayAppAttachmentRead(d, meta)
+	return resourceAliyunApigatewayAppAttachmentRead(ctx, d, meta)
 }
 
-func resourceAliyunApigatewayAppAttachmentRead(d *schema.ResourceData, meta interface{}) error {
+func resourceAliyunApigatewayAppAttachmentRead(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*connectivity.AlibabacloudStackClient)
 	cloudApiService := CloudApiService{client}
 
 	_, err := cloudApiService.DescribeApiGatewayAppAttachment(d.Id())
 	if err != nil {
+		if NotFoundError(err) {
+			d.SetId("")
+			return nil
+		}
 		return WrapError(err)
 	}
 
@@ -112,7 +120,7 @@ func resourceAliyunApigatewayAppAttachmentRead(d *schema.ResourceData, meta inte
 	return nil
 }
 
-func resourceAliyunApigatewayAppAttachmentDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceAliyunApigatewayAppAttachmentDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*connectivity.AlibabacloudStackClient)
 	cloudApiService := CloudApiService{client}
 	request := cloudapi.CreateRemoveAppsAuthoritiesRequest()
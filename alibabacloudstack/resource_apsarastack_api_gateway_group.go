@@ -1,6 +1,7 @@
 package alibabacloudstack
 
 import (
+	"context"
 	"time"
 
 	"github.com/aliyun/alibaba-cloud-sdk-go/services/cloudapi"
@@ -11,12 +12,12 @@ import (
 
 func resourceAlibabacloudStackApigatewayGroup() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceAlibabacloudStackApigatewayGroupCreate,
-		Read:   resourceAlibabacloudStackApigatewayGroupRead,
-		Update: resourceAlibabacloudStackApigatewayGroupUpdate,
-		Delete: resourceAlibabacloudStackApigatewayGroupDelete,
+		CreateContext: withDiagnostics(resourceAlibabacloudStackApigatewayGroupCreate),
+		ReadContext:   withDiagnostics(resourceAlibabacloudStackApigatewayGroupRead),
+		UpdateContext: withDiagnostics(resourceAlibabacloudStackApigatewayGroupUpdate),
+		DeleteContext: withDiagnostics(resourceAlibabacloudStackApigatewayGroupDelete),
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			StateContext: schema.ImportStatePassthroughContext,
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -41,7 +42,7 @@ func resourceAlibabacloudStackApigatewayGroup() *schema.Resource {
 	}
 }
 
-func resourceAlibabacloudStackApigatewayGroupCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceAlibabacloudStackApigatewayGroupCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*connectivity.AlibabacloudStackClient)
 
 	request := cloudapi.CreateCreateApiGroupRequest()
@@ -62,7 +63,7 @@ func resourceAlibabacloudStackApigatewayGroupCreate(d *schema.ResourceData, meta
 	request.GroupName = d.Get("name").(string)
 	request.Description = d.Get("description").(string)
 
-	if err := resource.Retry(5*time.Minute, func() *resource.RetryError {
+	if err := resource.RetryContext(ctx, 5*time.Minute, func() *resource.RetryError {
 		raw, err := client.WithCloudApiClient(func(cloudApiClient *cloudapi.Client) (interface{}, error) {
 			return cloudApiClient.CreateApiGroup(request)
 		})
@@ -80,10 +81,10 @@ func resourceAlibabacloudStackApigatewayGroupCreate(d *schema.ResourceData, meta
 		return WrapErrorf(err, DefaultErrorMsg, "alibabacloudstack_api_gateway_group", request.GetActionName(), AlibabacloudStackSdkGoERROR)
 	}
 
-	return resourceAlibabacloudStackApigatewayGroupRead(d, meta)
+	return resourceAlibabacloudStackApigatewayGroupRead(ctx, d, meta)
 }
 
-func resourceAlibabacloudStackApigatewayGroupRead(d *schema.ResourceData, meta interface{}) error {
+func resourceAlibabacloudStackApigatewayGroupRead(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*connectivity.AlibabacloudStackClient)
 	cloudApiService := CloudApiService{client}
 	apiGroup, err := cloudApiService.DescribeApiGatewayGroup(d.Id())
@@ -103,7 +104,7 @@ func resourceAlibabacloudStackApigatewayGroupRead(d *schema.ResourceData, meta i
 	return nil
 }
 
-func resourceAlibabacloudStackApigatewayGroupUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceAlibabacloudStackApigatewayGroupUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*connectivity.AlibabacloudStackClient)
 
 	if d.HasChange("name") || d.HasChange("description") {
@@ -133,10 +134,10 @@ func resourceAlibabacloudStackApigatewayGroupUpdate(d *schema.ResourceData, meta
 		}
 		addDebug(request.GetActionName(), raw, request.RpcRequest, request)
 	}
-	return resourceAlibabacloudStackApigatewayGroupRead(d, meta)
+	return resourceAlibabacloudStackApigatewayGroupRead(ctx, d, meta)
 }
 
-func resourceAlibabacloudStackApigatewayGroupDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceAlibabacloudStackApigatewayGroupDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*connectivity.AlibabacloudStackClient)
 	cloudApiService := CloudApiService{client}
 	request := cloudapi.CreateDeleteApiGroupRequest()
@@ -1,6 +1,7 @@
 package alibabacloudstack
 
 import (
+	"context"
 	"fmt"
 	util "github.com/alibabacloud-go/tea-utils/service"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
@@ -15,11 +16,11 @@ import (
 
 func resourceAlibabacloudStackApigatewayVpc() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceAlibabacloudStackApigatewayVpcAccessCreate,
-		Read:   resourceAlibabacloudStackApigatewayVpcAccessRead,
-		Delete: resourceAlibabacloudStackApigatewayVpcAccessDelete,
+		CreateContext: withDiagnostics(resourceAlibabacloudStackApigatewayVpcAccessCreate),
+		ReadContext:   withDiagnostics(resourceAlibabacloudStackApigatewayVpcAccessRead),
+		DeleteContext: withDiagnostics(resourceAlibabacloudStackApigatewayVpcAccessDelete),
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			StateContext: schema.ImportStatePassthroughContext,
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -47,7 +48,7 @@ func resourceAlibabacloudStackApigatewayVpc() *schema.Resource {
 	}
 }
 
-func resourceAlibabacloudStackApigatewayVpcAccessCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceAlibabacloudStackApigatewayVpcAccessCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 	//client := meta.(*connectivity.AlibabacloudStackClient)
 	//
 	//request := cloudapi.CreateSetVpcAccessRequest()
@@ -100,7 +101,7 @@ func resourceAlibabacloudStackApigatewayVpcAccessCreate(d *schema.ResourceData,
 	runtime := util.RuntimeOptions{}
 	runtime.SetAutoretry(true)
 	wait := incrementalWait(3*time.Second, 3*time.Second)
-	err = resource.Retry(d.Timeout(schema.TimeoutCreate), func() *resource.RetryError {
+	err = resource.RetryContext(ctx, d.Timeout(schema.TimeoutCreate), func() *resource.RetryError {
 		response, err = conn.DoRequesttowpoint1(StringPointer(action), nil, StringPointer("POST"), StringPointer("2016-07-14"), StringPointer("AK"), nil, request, &runtime)
 		if err != nil {
 			if NeedRetry(err) {
@@ -113,10 +114,10 @@ func resourceAlibabacloudStackApigatewayVpcAccessCreate(d *schema.ResourceData,
 	})
 	addDebug(action, response, request)
 	d.SetId(fmt.Sprintf("%s%s%s%s%s%s%s", request["Name"], COLON_SEPARATED, request["VpcId"], COLON_SEPARATED, request["InstanceId"], COLON_SEPARATED, request["Port"]))
-	return resourceAlibabacloudStackApigatewayVpcAccessRead(d, meta)
+	return resourceAlibabacloudStackApigatewayVpcAccessRead(ctx, d, meta)
 }
 
-func resourceAlibabacloudStackApigatewayVpcAccessRead(d *schema.ResourceData, meta interface{}) error {
+func resourceAlibabacloudStackApigatewayVpcAccessRead(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*connectivity.AlibabacloudStackClient)
 	cloudApiService := CloudApiService{client}
 
@@ -137,7 +138,7 @@ func resourceAlibabacloudStackApigatewayVpcAccessRead(d *schema.ResourceData, me
 	return nil
 }
 
-func resourceAlibabacloudStackApigatewayVpcAccessDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceAlibabacloudStackApigatewayVpcAccessDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*connectivity.AlibabacloudStackClient)
 	request := cloudapi.CreateRemoveVpcAccessRequest()
 	request.RegionId = client.RegionId
@@ -1,6 +1,7 @@
 package alibabacloudstack
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
 	"github.com/aliyun/alibaba-cloud-sdk-go/sdk/requests"
@@ -17,12 +18,12 @@ import (
 
 func resourceAlibabacloudStackLogonPolicy() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceAlibabacloudStackLogonPolicyCreate,
-		Read:   resourceAlibabacloudStackLogonPolicyRead,
-		Update: resourceAlibabacloudStackLogonPolicyUpdate,
-		Delete: resourceAlibabacloudStackLogonPolicyDelete,
+		CreateContext: withDiagnostics(resourceAlibabacloudStackLogonPolicyCreate),
+		ReadContext:   withDiagnostics(resourceAlibabacloudStackLogonPolicyRead),
+		UpdateContext: withDiagnostics(resourceAlibabacloudStackLogonPolicyUpdate),
+		DeleteContext: withDiagnostics(resourceAlibabacloudStackLogonPolicyDelete),
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			StateContext: schema.ImportStatePassthroughContext,
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -70,7 +71,7 @@ func resourceAlibabacloudStackLogonPolicy() *schema.Resource {
 		},
 	}
 }
-func resourceAlibabacloudStackLogonPolicyCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceAlibabacloudStackLogonPolicyCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*connectivity.AlibabacloudStackClient)
 	var requestInfo *ecs.Client
 	ascmService := AscmService{client}
@@ -134,7 +135,7 @@ func resourceAlibabacloudStackLogonPolicyCreate(d *schema.ResourceData, meta int
 		addDebug("AddLoginPolicy", raw, requestInfo, bresponse.GetHttpContentString())
 	}
 
-	err = resource.Retry(1*time.Minute, func() *resource.RetryError {
+	err = resource.RetryContext(ctx, 1*time.Minute, func() *resource.RetryError {
 		object, err = ascmService.DescribeAscmLogonPolicy(name)
 		if err != nil {
 			return resource.NonRetryableError(err)
@@ -150,10 +151,10 @@ func resourceAlibabacloudStackLogonPolicyCreate(d *schema.ResourceData, meta int
 
 	d.SetId(object.Data[0].Name)
 
-	return resourceAlibabacloudStackLogonPolicyUpdate(d, meta)
+	return resourceAlibabacloudStackLogonPolicyUpdate(ctx, d, meta)
 }
 
-func resourceAlibabacloudStackLogonPolicyUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceAlibabacloudStackLogonPolicyUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*connectivity.AlibabacloudStackClient)
 	ascmService := AscmService{client}
 	request := requests.NewCommonRequest()
@@ -221,9 +222,9 @@ func resourceAlibabacloudStackLogonPolicyUpdate(d *schema.ResourceData, meta int
 
 	d.SetId(object.Data[0].Name)
 
-	return resourceAlibabacloudStackLogonPolicyRead(d, meta)
+	return resourceAlibabacloudStackLogonPolicyRead(ctx, d, meta)
 }
-func resourceAlibabacloudStackLogonPolicyRead(d *schema.ResourceData, meta interface{}) error {
+func resourceAlibabacloudStackLogonPolicyRead(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 	wiatSecondsIfWithTest(1)
 	client := meta.(*connectivity.AlibabacloudStackClient)
 	ascmService := AscmService{client}
@@ -242,7 +243,7 @@ func resourceAlibabacloudStackLogonPolicyRead(d *schema.ResourceData, meta inter
 	d.Set("rule", object.Data[0].Rule)
 	return nil
 }
-func resourceAlibabacloudStackLogonPolicyDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceAlibabacloudStackLogonPolicyDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*connectivity.AlibabacloudStackClient)
 	ascmService := AscmService{client}
 	var requestInfo *ecs.Client
@@ -254,7 +255,7 @@ func resourceAlibabacloudStackLogonPolicyDelete(d *schema.ResourceData, meta int
 		return WrapErrorf(err, DefaultErrorMsg, d.Id(), "IsLoginPolicyExist", AlibabacloudStackSdkGoERROR)
 	}
 	addDebug("IsLoginPolicyExist", check, requestInfo, map[string]string{"loginpolicyName": d.Id()})
-	err = resource.Retry(5*time.Minute, func() *resource.RetryError {
+	err = resource.RetryContext(ctx, 5*time.Minute, func() *resource.RetryError {
 		request := requests.NewCommonRequest()
 		if strings.ToLower(client.Config.Protocol) == "https" {
 			request.Scheme = "https"
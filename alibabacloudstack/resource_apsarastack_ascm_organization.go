@@ -1,6 +1,7 @@
 package alibabacloudstack
 
 import (
+	"context"
 	"fmt"
 	"github.com/aliyun/alibaba-cloud-sdk-go/sdk/requests"
 	"github.com/aliyun/alibaba-cloud-sdk-go/sdk/responses"
@@ -17,10 +18,13 @@ import (
 
 func resourceAlibabacloudStackAscmOrganization() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceAlibabacloudStackAscmOrganizationCreate,
-		Read:   resourceAlibabacloudStackAscmOrganizationRead,
-		Update: resourceAlibabacloudStackAscmOrganizationUpdate,
-		Delete: resourceAlibabacloudStackAscmOrganizationDelete,
+		CreateContext: withDiagnostics(resourceAlibabacloudStackAscmOrganizationCreate),
+		ReadContext:   withDiagnostics(resourceAlibabacloudStackAscmOrganizationRead),
+		UpdateContext: withDiagnostics(resourceAlibabacloudStackAscmOrganizationUpdate),
+		DeleteContext: withDiagnostics(resourceAlibabacloudStackAscmOrganizationDelete),
+		Importer: &schema.ResourceImporter{
+			StateContext: ascmImportByName((*AscmService).DescribeAscmOrganizationByPath),
+		},
 		Schema: map[string]*schema.Schema{
 			"org_id": {
 				Type:     schema.TypeString,
@@ -48,7 +52,7 @@ func resourceAlibabacloudStackAscmOrganization() *schema.Resource {
 	}
 }
 
-func resourceAlibabacloudStackAscmOrganizationCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceAlibabacloudStackAscmOrganizationCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*connectivity.AlibabacloudStackClient)
 	ascmService := AscmService{client}
 
@@ -105,7 +109,7 @@ func resourceAlibabacloudStackAscmOrganizationCreate(d *schema.ResourceData, met
 		addDebug("CreateOrganization", raw, requestInfo, bresponse.GetHttpContentString())
 
 	}
-	err = resource.Retry(5*time.Minute, func() *resource.RetryError {
+	err = resource.RetryContext(ctx, 5*time.Minute, func() *resource.RetryError {
 		check, err = ascmService.DescribeAscmOrganization(name)
 		if err != nil {
 			return resource.NonRetryableError(err)
@@ -115,11 +119,11 @@ func resourceAlibabacloudStackAscmOrganizationCreate(d *schema.ResourceData, met
 
 	d.SetId(check.Data[0].Name + COLON_SEPARATED + fmt.Sprint(check.Data[0].ID))
 
-	return resourceAlibabacloudStackAscmOrganizationUpdate(d, meta)
+	return resourceAlibabacloudStackAscmOrganizationUpdate(ctx, d, meta)
 
 }
 
-func resourceAlibabacloudStackAscmOrganizationUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceAlibabacloudStackAscmOrganizationUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*connectivity.AlibabacloudStackClient)
 	ascmService := AscmService{client}
 	name := d.Get("name").(string)
@@ -183,16 +187,19 @@ func resourceAlibabacloudStackAscmOrganizationUpdate(d *schema.ResourceData, met
 
 	d.SetId(name + COLON_SEPARATED + fmt.Sprint(check.Data[0].ID))
 
-	return resourceAlibabacloudStackAscmOrganizationRead(d, meta)
+	return resourceAlibabacloudStackAscmOrganizationRead(ctx, d, meta)
 
 }
 
-func resourceAlibabacloudStackAscmOrganizationRead(d *schema.ResourceData, meta interface{}) error {
+func resourceAlibabacloudStackAscmOrganizationRead(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 	wiatSecondsIfWithTest(1)
 	client := meta.(*connectivity.AlibabacloudStackClient)
 	ascmService := AscmService{client}
+	did, err := ParseResourceId(d.Id(), 2)
+	if err != nil {
+		return WrapError(err)
+	}
 	object, err := ascmService.DescribeAscmOrganization(d.Id())
-	did := strings.Split(d.Id(), COLON_SEPARATED)
 	if err != nil {
 		if NotFoundError(err) {
 			d.SetId("")
@@ -200,19 +207,27 @@ func resourceAlibabacloudStackAscmOrganizationRead(d *schema.ResourceData, meta
 		}
 		return WrapError(err)
 	}
-	if len(object.Data) == 0 {
+	// The organizations are listed by the name, which is unique only among the children of the same parent.
+	found := false
+	for _, org := range object.Data {
+		if strconv.Itoa(org.ID) == did[1] {
+			d.Set("parent_id", strconv.Itoa(org.ParentID))
+			found = true
+			break
+		}
+	}
+	if !found {
 		d.SetId("")
 		return nil
 	}
 
 	d.Set("org_id", did[1])
 	d.Set("name", did[0])
-	d.Set("parent_id", strconv.Itoa(object.Data[0].ParentID))
 
 	return nil
 
 }
-func resourceAlibabacloudStackAscmOrganizationDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceAlibabacloudStackAscmOrganizationDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 
 	client := meta.(*connectivity.AlibabacloudStackClient)
 	ascmService := AscmService{client}
@@ -224,7 +239,7 @@ func resourceAlibabacloudStackAscmOrganizationDelete(d *schema.ResourceData, met
 	}
 
 	addDebug("IsOrganizationExist", check, requestInfo, map[string]string{"id": did[1]})
-	err = resource.Retry(1*time.Minute, func() *resource.RetryError {
+	err = resource.RetryContext(ctx, 1*time.Minute, func() *resource.RetryError {
 		if len(check.Data) != 0 {
 			request := requests.NewCommonRequest()
 			if client.Config.Insecure {
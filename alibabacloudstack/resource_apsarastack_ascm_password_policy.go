@@ -1,6 +1,7 @@
 package alibabacloudstack
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
 	"github.com/aliyun/alibaba-cloud-sdk-go/sdk/requests"
@@ -18,10 +19,13 @@ import (
 
 func resourceAlibabacloudStackAscmPasswordPolicy() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceAlibabacloudStackAscmPasswordPolicyCreate,
-		Read:   resourceAlibabacloudStackAscmPasswordPolicyRead,
-		Update: resourceAlibabacloudStackAscmPasswordPolicyUpdate,
-		Delete: resourceAlibabacloudStackAscmPasswordPolicyDelete,
+		CreateContext: withDiagnostics(resourceAlibabacloudStackAscmPasswordPolicyCreate),
+		ReadContext:   withDiagnostics(resourceAlibabacloudStackAscmPasswordPolicyRead),
+		UpdateContext: withDiagnostics(resourceAlibabacloudStackAscmPasswordPolicyUpdate),
+		DeleteContext: withDiagnostics(resourceAlibabacloudStackAscmPasswordPolicyDelete),
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
 		Schema: map[string]*schema.Schema{
 			"hard_expiry": {
 				Type:     schema.TypeBool,
@@ -64,7 +68,7 @@ func resourceAlibabacloudStackAscmPasswordPolicy() *schema.Resource {
 	}
 }
 
-func resourceAlibabacloudStackAscmPasswordPolicyCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceAlibabacloudStackAscmPasswordPolicyCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*connectivity.AlibabacloudStackClient)
 	var requestInfo *ecs.Client
 	value123 := strconv.Itoa(d.Get("minimum_password_length").(int))
@@ -117,10 +121,10 @@ func resourceAlibabacloudStackAscmPasswordPolicyCreate(d *schema.ResourceData, m
 
 	d.SetId(fmt.Sprint(response.Data.ID))
 
-	return resourceAlibabacloudStackAscmPasswordPolicyUpdate(d, meta)
+	return resourceAlibabacloudStackAscmPasswordPolicyUpdate(ctx, d, meta)
 }
 
-func resourceAlibabacloudStackAscmPasswordPolicyRead(d *schema.ResourceData, meta interface{}) error {
+func resourceAlibabacloudStackAscmPasswordPolicyRead(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 	wiatSecondsIfWithTest(1)
 	client := meta.(*connectivity.AlibabacloudStackClient)
 	ascmService := AscmService{client}
@@ -145,11 +149,11 @@ func resourceAlibabacloudStackAscmPasswordPolicyRead(d *schema.ResourceData, met
 	return nil
 }
 
-func resourceAlibabacloudStackAscmPasswordPolicyUpdate(d *schema.ResourceData, meta interface{}) error {
-	return resourceAlibabacloudStackAscmPasswordPolicyRead(d, meta)
+func resourceAlibabacloudStackAscmPasswordPolicyUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
+	return resourceAlibabacloudStackAscmPasswordPolicyRead(ctx, d, meta)
 }
 
-func resourceAlibabacloudStackAscmPasswordPolicyDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceAlibabacloudStackAscmPasswordPolicyDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*connectivity.AlibabacloudStackClient)
 	ascmService := AscmService{client}
 	var requestInfo *ecs.Client
@@ -159,7 +163,7 @@ func resourceAlibabacloudStackAscmPasswordPolicyDelete(d *schema.ResourceData, m
 		return WrapErrorf(err, DefaultErrorMsg, d.Id(), "IsResourceGroupExist", AlibabacloudStackSdkGoERROR)
 	}
 	addDebug("IsResourceGroupExist", check, requestInfo, map[string]string{"resourceGroupName": d.Id()})
-	err = resource.Retry(1*time.Minute, func() *resource.RetryError {
+	err = resource.RetryContext(ctx, 1*time.Minute, func() *resource.RetryError {
 
 		request := requests.NewCommonRequest()
 		if client.Config.Insecure {
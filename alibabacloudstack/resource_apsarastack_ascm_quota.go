@@ -1,6 +1,7 @@
 package alibabacloudstack
 
 import (
+	"context"
 	"fmt"
 	"github.com/aliyun/alibaba-cloud-sdk-go/sdk/requests"
 	"github.com/aliyun/alibaba-cloud-sdk-go/sdk/responses"
@@ -10,16 +11,20 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"log"
+	"strconv"
 	"strings"
 	"time"
 )
 
 func resourceAlibabacloudStackAscmQuota() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceAlibabacloudStackAscmQuotaCreate,
-		Read:   resourceAlibabacloudStackAscmQuotaRead,
-		Update: resourceAlibabacloudStackAscmQuotaUpdate,
-		Delete: resourceAlibabacloudStackAscmQuotaDelete,
+		CreateContext: withDiagnostics(resourceAlibabacloudStackAscmQuotaCreate),
+		ReadContext:   withDiagnostics(resourceAlibabacloudStackAscmQuotaRead),
+		UpdateContext: withDiagnostics(resourceAlibabacloudStackAscmQuotaUpdate),
+		DeleteContext: withDiagnostics(resourceAlibabacloudStackAscmQuotaDelete),
+		Importer: &schema.ResourceImporter{
+			StateContext: resourceAlibabacloudStackAscmQuotaImport,
+		},
 		Schema: map[string]*schema.Schema{
 			"product_name": {
 				Type:     schema.TypeString,
@@ -106,7 +111,34 @@ func resourceAlibabacloudStackAscmQuota() *schema.Resource {
 	}
 }
 
-func resourceAlibabacloudStackAscmQuotaCreate(d *schema.ResourceData, meta interface{}) error {
+// resourceAlibabacloudStackAscmQuotaImport accepts the path of the organization or the name of the
+// resource group in place of the quota type ID, such as "ECS:organization:root/dept-a".
+func resourceAlibabacloudStackAscmQuotaImport(ctx context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	parts, err := ParseResourceId(d.Id(), 3)
+	if err != nil {
+		return nil, WrapError(err)
+	}
+	if _, err := strconv.Atoi(parts[2]); err == nil {
+		return []*schema.ResourceData{d}, nil
+	}
+	ascmService := AscmService{meta.(*connectivity.AlibabacloudStackClient)}
+	var quotaTypeId int
+	switch parts[1] {
+	case "organization":
+		quotaTypeId, err = ascmService.DescribeAscmOrganizationByPath(parts[2])
+	case "resourceGroup":
+		quotaTypeId, err = ascmService.DescribeAscmResourceGroupIdByName(parts[2])
+	default:
+		err = Error("Invalid quota type %s in the Resource Id %s.", parts[1], d.Id())
+	}
+	if err != nil {
+		return nil, WrapError(err)
+	}
+	d.SetId(strings.Join([]string{parts[0], parts[1], strconv.Itoa(quotaTypeId)}, COLON_SEPARATED))
+	return []*schema.ResourceData{d}, nil
+}
+
+func resourceAlibabacloudStackAscmQuotaCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*connectivity.AlibabacloudStackClient)
 	var requestInfo *ecs.Client
 	productName := strings.ToUpper(d.Get("product_name").(string))
@@ -594,11 +626,11 @@ func resourceAlibabacloudStackAscmQuotaCreate(d *schema.ResourceData, meta inter
 
 	d.SetId(productName + COLON_SEPARATED + quotaType + COLON_SEPARATED + quotaTypeId)
 
-	return resourceAlibabacloudStackAscmQuotaUpdate(d, meta)
+	return resourceAlibabacloudStackAscmQuotaUpdate(ctx, d, meta)
 
 }
 
-func resourceAlibabacloudStackAscmQuotaUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceAlibabacloudStackAscmQuotaUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*connectivity.AlibabacloudStackClient)
 	ascmService := AscmService{client}
 	attributeUpdate := false
@@ -1298,16 +1330,19 @@ func resourceAlibabacloudStackAscmQuotaUpdate(d *schema.ResourceData, meta inter
 		}
 	}
 
-	return resourceAlibabacloudStackAscmQuotaRead(d, meta)
+	return resourceAlibabacloudStackAscmQuotaRead(ctx, d, meta)
 
 }
 
-func resourceAlibabacloudStackAscmQuotaRead(d *schema.ResourceData, meta interface{}) error {
+func resourceAlibabacloudStackAscmQuotaRead(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 	wiatSecondsIfWithTest(1)
 	client := meta.(*connectivity.AlibabacloudStackClient)
 	ascmService := AscmService{client}
+	did, err := ParseResourceId(d.Id(), 3)
+	if err != nil {
+		return WrapError(err)
+	}
 	object, err := ascmService.DescribeAscmQuota(d.Id())
-	did := strings.Split(d.Id(), COLON_SEPARATED)
 
 	if err != nil {
 		if NotFoundError(err) {
@@ -1321,67 +1356,38 @@ func resourceAlibabacloudStackAscmQuotaRead(d *schema.ResourceData, meta interfa
 		return nil
 	}
 
-	if did[0] == "VPC" {
-		d.Set("quota_id", object.Data.ID)
-		d.Set("quota_type", did[1])
-		d.Set("quota_type_id", did[2])
-		return nil
-	} else if did[0] == "OSS" {
-		d.Set("quota_id", object.Data.ID)
-		d.Set("quota_type", did[1])
-		d.Set("quota_type_id", did[2])
-		return nil
-	} else if did[0] == "ECS" {
-		d.Set("quota_id", object.Data.ID)
-		d.Set("quota_type", did[1])
-		d.Set("quota_type_id", did[2])
-		return nil
-	} else if did[0] == "SLB" {
-		d.Set("quota_id", object.Data.ID)
-		d.Set("quota_type", did[1])
-		d.Set("quota_type_id", did[2])
-
-		return nil
-	} else if did[0] == "EIP" {
-		d.Set("quota_id", object.Data.ID)
-		d.Set("quota_type", did[1])
-		d.Set("quota_type_id", did[2])
-
-		return nil
-	} else if did[0] == "RDS" {
-		d.Set("quota_id", object.Data.ID)
-		d.Set("quota_type", did[1])
-		d.Set("quota_type_id", did[2])
-
-		return nil
-	} else if did[0] == "ODPS" {
-		d.Set("quota_id", object.Data.ID)
-		d.Set("quota_type", did[1])
-		d.Set("quota_type_id", did[2])
-
-		return nil
-	} else if did[0] == "GPDB" {
-		d.Set("quota_id", object.Data.ID)
-		d.Set("quota_type", did[1])
-		d.Set("quota_type_id", did[2])
-
-		return nil
-	} else if did[0] == "R-KVSTORE" {
-		d.Set("quota_id", object.Data.ID)
-		d.Set("quota_type", did[1])
-		d.Set("quota_type_id", did[2])
-
-		return nil
-	} else if did[0] == "DDS" {
-		d.Set("quota_id", object.Data.ID)
-		d.Set("quota_type", did[1])
-		d.Set("quota_type_id", did[2])
-
-		return nil
-	} else {
+	d.Set("product_name", did[0])
+	d.Set("quota_type", did[1])
+	d.Set("quota_type_id", did[2])
+	d.Set("quota_id", object.Data.ID)
 
+	switch did[0] {
+	case "ECS":
+		d.Set("total_cpu", object.Data.TotalCPU)
+		d.Set("total_mem", object.Data.TotalMem)
+		d.Set("total_gpu", object.Data.TotalGpu)
+		d.Set("total_disk_cloud_ssd", object.Data.TotalDiskCloudSsd)
+		d.Set("total_disk_cloud_efficiency", object.Data.TotalDiskCloudEfficiency)
+	case "OSS":
+		d.Set("total_amount", object.Data.TotalAmount)
+	case "EIP":
+		d.Set("total_eip", object.Data.TotalEIP)
+	case "SLB":
+		d.Set("total_vip_public", object.Data.TotalVipPublic)
+		d.Set("total_vip_internal", object.Data.TotalVipInternal)
+	case "ODPS":
+		d.Set("total_cu", object.Data.TotalCU)
+		d.Set("total_disk", object.Data.TotalDisk)
+	case "RDS", "GPDB", "DDS":
+		d.Set("total_cpu", object.Data.TotalCPU)
+		d.Set("total_mem", object.Data.TotalMem)
+		d.Set("total_disk", object.Data.TotalDisk)
+	case "R-KVSTORE":
+		d.Set("total_mem", object.Data.TotalMem)
+	case "VPC":
+		d.Set("total_vpc", object.Data.TotalVPC)
+	default:
 		d.Set("region_name", object.Data.RegionName)
-		d.Set("product_name", object.Data.ProductName)
 		d.Set("cluster_name", object.Data.Cluster)
 		d.Set("total_cpu", object.Data.TotalCPU)
 		d.Set("total_mem", object.Data.TotalMem)
@@ -1393,7 +1399,7 @@ func resourceAlibabacloudStackAscmQuotaRead(d *schema.ResourceData, meta interfa
 
 	return nil
 }
-func resourceAlibabacloudStackAscmQuotaDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceAlibabacloudStackAscmQuotaDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 
 	client := meta.(*connectivity.AlibabacloudStackClient)
 	ascmService := AscmService{client}
@@ -1406,7 +1412,7 @@ func resourceAlibabacloudStackAscmQuotaDelete(d *schema.ResourceData, meta inter
 	}
 
 	addDebug("IsQuotaExist", check, requestInfo, map[string]string{"productName": did[0]})
-	err = resource.Retry(5*time.Minute, func() *resource.RetryError {
+	err = resource.RetryContext(ctx, 5*time.Minute, func() *resource.RetryError {
 
 		request := requests.NewCommonRequest()
 		request.QueryParams = map[string]string{
@@ -1,6 +1,7 @@
 package alibabacloudstack
 
 import (
+	"context"
 	"fmt"
 	"github.com/aliyun/alibaba-cloud-sdk-go/sdk/requests"
 	"github.com/aliyun/alibaba-cloud-sdk-go/sdk/responses"
@@ -16,12 +17,12 @@ import (
 
 func resourceAlibabacloudStackAscmRamPolicy() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceAlibabacloudStackAscmRamPolicyCreate,
-		Read:   resourceAlibabacloudStackAscmRamPolicyRead,
-		Update: resourceAlibabacloudStackAscmRamPolicyUpdate,
-		Delete: resourceAlibabacloudStackAscmRamPolicyDelete,
+		CreateContext: withDiagnostics(resourceAlibabacloudStackAscmRamPolicyCreate),
+		ReadContext:   withDiagnostics(resourceAlibabacloudStackAscmRamPolicyRead),
+		UpdateContext: withDiagnostics(resourceAlibabacloudStackAscmRamPolicyUpdate),
+		DeleteContext: withDiagnostics(resourceAlibabacloudStackAscmRamPolicyDelete),
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			StateContext: schema.ImportStatePassthroughContext,
 		},
 		Schema: map[string]*schema.Schema{
 			"name": {
@@ -46,7 +47,7 @@ func resourceAlibabacloudStackAscmRamPolicy() *schema.Resource {
 	}
 }
 
-func resourceAlibabacloudStackAscmRamPolicyCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceAlibabacloudStackAscmRamPolicyCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*connectivity.AlibabacloudStackClient)
 	var requestInfo *ecs.Client
 	ascmService := AscmService{client}
@@ -102,7 +103,7 @@ func resourceAlibabacloudStackAscmRamPolicyCreate(d *schema.ResourceData, meta i
 		addDebug("CreateRAMPolicy", raw, requestInfo, bresponse.GetHttpContentString())
 	}
 
-	err = resource.Retry(1*time.Minute, func() *resource.RetryError {
+	err = resource.RetryContext(ctx, 1*time.Minute, func() *resource.RetryError {
 		check, err = ascmService.DescribeAscmRamPolicy(name)
 		if err != nil {
 			return resource.NonRetryableError(err)
@@ -111,10 +112,10 @@ func resourceAlibabacloudStackAscmRamPolicyCreate(d *schema.ResourceData, meta i
 	})
 	d.SetId(check.Data[0].PolicyName + COLON_SEPARATED + fmt.Sprint(check.Data[0].ID))
 
-	return resourceAlibabacloudStackAscmRamPolicyUpdate(d, meta)
+	return resourceAlibabacloudStackAscmRamPolicyUpdate(ctx, d, meta)
 }
 
-func resourceAlibabacloudStackAscmRamPolicyRead(d *schema.ResourceData, meta interface{}) error {
+func resourceAlibabacloudStackAscmRamPolicyRead(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 	wiatSecondsIfWithTest(1)
 	client := meta.(*connectivity.AlibabacloudStackClient)
 	ascmService := AscmService{client}
@@ -138,7 +139,7 @@ func resourceAlibabacloudStackAscmRamPolicyRead(d *schema.ResourceData, meta int
 	return nil
 }
 
-func resourceAlibabacloudStackAscmRamPolicyUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceAlibabacloudStackAscmRamPolicyUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*connectivity.AlibabacloudStackClient)
 	ascmService := AscmService{client}
 	attributeUpdate := false
@@ -238,10 +239,10 @@ func resourceAlibabacloudStackAscmRamPolicyUpdate(d *schema.ResourceData, meta i
 	}
 	d.SetId(name + COLON_SEPARATED + fmt.Sprint(check.Data[0].ID))
 
-	return resourceAlibabacloudStackAscmRamPolicyRead(d, meta)
+	return resourceAlibabacloudStackAscmRamPolicyRead(ctx, d, meta)
 }
 
-func resourceAlibabacloudStackAscmRamPolicyDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceAlibabacloudStackAscmRamPolicyDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*connectivity.AlibabacloudStackClient)
 	ascmService := AscmService{client}
 	var requestInfo *ecs.Client
@@ -252,7 +253,7 @@ func resourceAlibabacloudStackAscmRamPolicyDelete(d *schema.ResourceData, meta i
 		return WrapErrorf(err, DefaultErrorMsg, d.Id(), "IsPolicyExist", AlibabacloudStackSdkGoERROR)
 	}
 	addDebug("IsPolicyExist", check, requestInfo, map[string]string{"policyName": did[0]})
-	err = resource.Retry(2*time.Minute, func() *resource.RetryError {
+	err = resource.RetryContext(ctx, 2*time.Minute, func() *resource.RetryError {
 
 		request := requests.NewCommonRequest()
 		request.QueryParams = map[string]string{
@@ -1,6 +1,7 @@
 package alibabacloudstack
 
 import (
+	"context"
 	"fmt"
 	"github.com/aliyun/alibaba-cloud-sdk-go/sdk/requests"
 	"github.com/aliyun/alibaba-cloud-sdk-go/sdk/responses"
@@ -15,10 +16,13 @@ import (
 
 func resourceAlibabacloudStackAscmRamPolicyForRole() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceAlibabacloudStackAscmRamPolicyForRoleCreate,
-		Read:   resourceAlibabacloudStackAscmRamPolicyForRoleRead,
-		Update: resourceAlibabacloudStackAscmRamPolicyForRoleUpdate,
-		Delete: resourceAlibabacloudStackAscmRamPolicyForRoleDelete,
+		CreateContext: withDiagnostics(resourceAlibabacloudStackAscmRamPolicyForRoleCreate),
+		ReadContext:   withDiagnostics(resourceAlibabacloudStackAscmRamPolicyForRoleRead),
+		UpdateContext: withDiagnostics(resourceAlibabacloudStackAscmRamPolicyForRoleUpdate),
+		DeleteContext: withDiagnostics(resourceAlibabacloudStackAscmRamPolicyForRoleDelete),
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
 		Schema: map[string]*schema.Schema{
 			"ram_policy_id": {
 				Type:     schema.TypeString,
@@ -32,7 +36,7 @@ func resourceAlibabacloudStackAscmRamPolicyForRole() *schema.Resource {
 	}
 }
 
-func resourceAlibabacloudStackAscmRamPolicyForRoleCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceAlibabacloudStackAscmRamPolicyForRoleCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*connectivity.AlibabacloudStackClient)
 	var requestInfo *ecs.Client
 	ram_id := d.Get("ram_policy_id").(string)
@@ -82,15 +86,18 @@ func resourceAlibabacloudStackAscmRamPolicyForRoleCreate(d *schema.ResourceData,
 
 	d.SetId(ram_id + COLON_SEPARATED + fmt.Sprint(roleid))
 
-	return resourceAlibabacloudStackAscmRamPolicyForRoleRead(d, meta)
+	return resourceAlibabacloudStackAscmRamPolicyForRoleRead(ctx, d, meta)
 }
 
-func resourceAlibabacloudStackAscmRamPolicyForRoleRead(d *schema.ResourceData, meta interface{}) error {
+func resourceAlibabacloudStackAscmRamPolicyForRoleRead(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 	wiatSecondsIfWithTest(1)
 	client := meta.(*connectivity.AlibabacloudStackClient)
 	ascmService := AscmService{client}
-	_, err := ascmService.DescribeAscmRamPolicyForRole(d.Id())
-	did := strings.Split(d.Id(), COLON_SEPARATED)
+	did, err := ParseResourceId(d.Id(), 2)
+	if err != nil {
+		return WrapError(err)
+	}
+	_, err = ascmService.DescribeAscmRamPolicyForRole(d.Id())
 
 	if err != nil {
 		if NotFoundError(err) {
@@ -106,12 +113,12 @@ func resourceAlibabacloudStackAscmRamPolicyForRoleRead(d *schema.ResourceData, m
 	return nil
 }
 
-func resourceAlibabacloudStackAscmRamPolicyForRoleUpdate(d *schema.ResourceData, meta interface{}) error {
-	return resourceAlibabacloudStackAscmRamPolicyForRoleCreate(d, meta)
+func resourceAlibabacloudStackAscmRamPolicyForRoleUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
+	return resourceAlibabacloudStackAscmRamPolicyForRoleCreate(ctx, d, meta)
 
 }
 
-func resourceAlibabacloudStackAscmRamPolicyForRoleDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceAlibabacloudStackAscmRamPolicyForRoleDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 
 	client := meta.(*connectivity.AlibabacloudStackClient)
 	ascmService := AscmService{client}
@@ -124,7 +131,7 @@ func resourceAlibabacloudStackAscmRamPolicyForRoleDelete(d *schema.ResourceData,
 		return WrapErrorf(err, DefaultErrorMsg, d.Id(), "IsBindingExist", AlibabacloudStackSdkGoERROR)
 	}
 	addDebug("IsBindingExist", check, requestInfo, map[string]string{"ramPolicyId": did[0]})
-	err = resource.Retry(2*time.Minute, func() *resource.RetryError {
+	err = resource.RetryContext(ctx, 2*time.Minute, func() *resource.RetryError {
 
 		request := requests.NewCommonRequest()
 		if client.Config.Insecure {
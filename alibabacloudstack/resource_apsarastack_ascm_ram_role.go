@@ -1,6 +1,7 @@
 package alibabacloudstack
 
 import (
+	"context"
 	"fmt"
 	"github.com/aliyun/alibaba-cloud-sdk-go/sdk/requests"
 	"github.com/aliyun/alibaba-cloud-sdk-go/sdk/responses"
@@ -16,10 +17,13 @@ import (
 
 func resourceAlibabacloudStackAscmRamRole() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceAlibabacloudStackAscmRamRoleCreate,
-		Read:   resourceAlibabacloudStackAscmRamRoleRead,
-		Update: resourceAlibabacloudStackAscmRamRoleUpdate,
-		Delete: resourceAlibabacloudStackAscmRamRoleDelete,
+		CreateContext: withDiagnostics(resourceAlibabacloudStackAscmRamRoleCreate),
+		ReadContext:   withDiagnostics(resourceAlibabacloudStackAscmRamRoleRead),
+		UpdateContext: withDiagnostics(resourceAlibabacloudStackAscmRamRoleUpdate),
+		DeleteContext: withDiagnostics(resourceAlibabacloudStackAscmRamRoleDelete),
+		Importer: &schema.ResourceImporter{
+			StateContext: ascmImportByName((*AscmService).DescribeAscmRamRoleIdByName),
+		},
 		Schema: map[string]*schema.Schema{
 			"role_name": {
 				Type:         schema.TypeString,
@@ -46,7 +50,7 @@ func resourceAlibabacloudStackAscmRamRole() *schema.Resource {
 		},
 	}
 }
-func resourceAlibabacloudStackAscmRamRoleCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceAlibabacloudStackAscmRamRoleCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*connectivity.AlibabacloudStackClient)
 	var requestInfo *ecs.Client
 	ascmService := AscmService{client}
@@ -109,7 +113,7 @@ func resourceAlibabacloudStackAscmRamRoleCreate(d *schema.ResourceData, meta int
 		addDebug("CreateRole", raw, requestInfo, bresponse.GetHttpContentString())
 	}
 	wait := incrementalWait(1*time.Second, 1*time.Second)
-	err = resource.Retry(1*time.Minute, func() *resource.RetryError {
+	err = resource.RetryContext(ctx, 1*time.Minute, func() *resource.RetryError {
 		check, err = ascmService.DescribeAscmRamRole(name)
 		if err != nil {
 			if IsThrottling(err) {
@@ -124,20 +128,23 @@ func resourceAlibabacloudStackAscmRamRoleCreate(d *schema.ResourceData, meta int
 		return WrapErrorf(err, DefaultErrorMsg, d.Id(), "DescribeAscmRamRole", AlibabacloudStackSdkGoERROR)
 	}
 	d.SetId(name + COLON_SEPARATED + fmt.Sprint(check.Data[0].ID))
-	return resourceAlibabacloudStackAscmRamRoleUpdate(d, meta)
+	return resourceAlibabacloudStackAscmRamRoleUpdate(ctx, d, meta)
 
 }
 
-func resourceAlibabacloudStackAscmRamRoleUpdate(d *schema.ResourceData, meta interface{}) error {
-	return resourceAlibabacloudStackAscmRamRoleRead(d, meta)
+func resourceAlibabacloudStackAscmRamRoleUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
+	return resourceAlibabacloudStackAscmRamRoleRead(ctx, d, meta)
 }
 
-func resourceAlibabacloudStackAscmRamRoleRead(d *schema.ResourceData, meta interface{}) error {
+func resourceAlibabacloudStackAscmRamRoleRead(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 	wiatSecondsIfWithTest(1)
 	client := meta.(*connectivity.AlibabacloudStackClient)
 	ascmService := AscmService{client}
+	did, err := ParseResourceId(d.Id(), 2)
+	if err != nil {
+		return WrapError(err)
+	}
 	object, err := ascmService.DescribeAscmRamRole(d.Id())
-	did := strings.Split(d.Id(), COLON_SEPARATED)
 	if err != nil {
 		if NotFoundError(err) {
 			d.SetId("")
@@ -145,6 +152,10 @@ func resourceAlibabacloudStackAscmRamRoleRead(d *schema.ResourceData, meta inter
 		}
 		return WrapError(err)
 	}
+	if len(object.Data) == 0 {
+		d.SetId("")
+		return nil
+	}
 	if strings.Contains(object.Data[0].OrganizationVisibility, "organizationVisibility.") {
 		object.Data[0].OrganizationVisibility = strings.TrimPrefix(object.Data[0].OrganizationVisibility, "organizationVisibility.")
 	}
@@ -152,10 +163,11 @@ func resourceAlibabacloudStackAscmRamRoleRead(d *schema.ResourceData, meta inter
 	d.Set("organization_visibility", object.Data[0].OrganizationVisibility)
 	d.Set("role_id", object.Data[0].ID)
 	d.Set("description", object.Data[0].Description)
+	d.Set("role_range", object.Data[0].RoleRange)
 	return nil
 }
 
-func resourceAlibabacloudStackAscmRamRoleDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceAlibabacloudStackAscmRamRoleDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 
 	client := meta.(*connectivity.AlibabacloudStackClient)
 	ascmService := AscmService{client}
@@ -167,7 +179,7 @@ func resourceAlibabacloudStackAscmRamRoleDelete(d *schema.ResourceData, meta int
 		return WrapErrorf(err, DefaultErrorMsg, d.Id(), "IsRamRoleExist", AlibabacloudStackSdkGoERROR)
 	}
 	addDebug("IsRamRoleExist", check, requestInfo, map[string]string{"roleName": did[0]})
-	err = resource.Retry(1*time.Minute, func() *resource.RetryError {
+	err = resource.RetryContext(ctx, 1*time.Minute, func() *resource.RetryError {
 
 		request := requests.NewCommonRequest()
 		if client.Config.Insecure {
@@ -1,6 +1,7 @@
 package alibabacloudstack
 
 import (
+	"context"
 	"fmt"
 	"github.com/aliyun/alibaba-cloud-sdk-go/sdk/requests"
 	"github.com/aliyun/alibaba-cloud-sdk-go/sdk/responses"
@@ -17,10 +18,13 @@ import (
 
 func resourceAlibabacloudStackAscmResourceGroup() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceAlibabacloudStackAscmResourceGroupCreate,
-		Read:   resourceAlibabacloudStackAscmResourceGroupRead,
-		Update: resourceAlibabacloudStackAscmResourceGroupUpdate,
-		Delete: resourceAlibabacloudStackAscmResourceGroupDelete,
+		CreateContext: withDiagnostics(resourceAlibabacloudStackAscmResourceGroupCreate),
+		ReadContext:   withDiagnostics(resourceAlibabacloudStackAscmResourceGroupRead),
+		UpdateContext: withDiagnostics(resourceAlibabacloudStackAscmResourceGroupUpdate),
+		DeleteContext: withDiagnostics(resourceAlibabacloudStackAscmResourceGroupDelete),
+		Importer: &schema.ResourceImporter{
+			StateContext: ascmImportByName((*AscmService).DescribeAscmResourceGroupIdByName),
+		},
 		Schema: map[string]*schema.Schema{
 			"name": {
 				Type:         schema.TypeString,
@@ -39,7 +43,7 @@ func resourceAlibabacloudStackAscmResourceGroup() *schema.Resource {
 	}
 }
 
-func resourceAlibabacloudStackAscmResourceGroupCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceAlibabacloudStackAscmResourceGroupCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*connectivity.AlibabacloudStackClient)
 	ascmService := AscmService{client}
 	var requestInfo *ecs.Client
@@ -98,7 +102,7 @@ func resourceAlibabacloudStackAscmResourceGroupCreate(d *schema.ResourceData, me
 		addDebug("CreateResourceGroup", raw, requestInfo, bresponse.GetHttpContentString())
 	}
 
-	err = resource.Retry(5*time.Minute, func() *resource.RetryError {
+	err = resource.RetryContext(ctx, 5*time.Minute, func() *resource.RetryError {
 		check, err = ascmService.DescribeAscmResourceGroup(name)
 		if err != nil {
 			return resource.NonRetryableError(err)
@@ -107,11 +111,11 @@ func resourceAlibabacloudStackAscmResourceGroupCreate(d *schema.ResourceData, me
 	})
 	d.SetId(check.Data[0].ResourceGroupName + COLON_SEPARATED + fmt.Sprint(check.Data[0].ID))
 
-	return resourceAlibabacloudStackAscmResourceGroupUpdate(d, meta)
+	return resourceAlibabacloudStackAscmResourceGroupUpdate(ctx, d, meta)
 
 }
 
-func resourceAlibabacloudStackAscmResourceGroupUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceAlibabacloudStackAscmResourceGroupUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*connectivity.AlibabacloudStackClient)
 	ascmService := AscmService{client}
 	name := d.Get("name").(string)
@@ -177,17 +181,20 @@ func resourceAlibabacloudStackAscmResourceGroupUpdate(d *schema.ResourceData, me
 	}
 	d.SetId(name + COLON_SEPARATED + fmt.Sprint(check.Data[0].ID))
 
-	return resourceAlibabacloudStackAscmResourceGroupRead(d, meta)
+	return resourceAlibabacloudStackAscmResourceGroupRead(ctx, d, meta)
 
 }
 
-func resourceAlibabacloudStackAscmResourceGroupRead(d *schema.ResourceData, meta interface{}) error {
+func resourceAlibabacloudStackAscmResourceGroupRead(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 	wiatSecondsIfWithTest(1)
 
 	client := meta.(*connectivity.AlibabacloudStackClient)
 	ascmService := AscmService{client}
+	did, err := ParseResourceId(d.Id(), 2)
+	if err != nil {
+		return WrapError(err)
+	}
 	object, err := ascmService.DescribeAscmResourceGroup(d.Id())
-	did := strings.Split(d.Id(), COLON_SEPARATED)
 	if err != nil {
 		if NotFoundError(err) {
 			d.SetId("")
@@ -206,7 +213,7 @@ func resourceAlibabacloudStackAscmResourceGroupRead(d *schema.ResourceData, meta
 
 	return nil
 }
-func resourceAlibabacloudStackAscmResourceGroupDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceAlibabacloudStackAscmResourceGroupDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 
 	client := meta.(*connectivity.AlibabacloudStackClient)
 	ascmService := AscmService{client}
@@ -218,7 +225,7 @@ func resourceAlibabacloudStackAscmResourceGroupDelete(d *schema.ResourceData, me
 		return WrapErrorf(err, DefaultErrorMsg, d.Id(), "IsResourceGroupExist", AlibabacloudStackSdkGoERROR)
 	}
 	addDebug("IsResourceGroupExist", check, requestInfo, map[string]string{"resourceGroupName": did[0]})
-	err = resource.Retry(1*time.Minute, func() *resource.RetryError {
+	err = resource.RetryContext(ctx, 1*time.Minute, func() *resource.RetryError {
 
 		request := requests.NewCommonRequest()
 		if client.Config.Insecure {
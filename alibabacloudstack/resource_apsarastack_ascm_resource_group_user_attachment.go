@@ -1,6 +1,7 @@
 package alibabacloudstack
 
 import (
+	"context"
 	"fmt"
 	"github.com/aliyun/alibaba-cloud-sdk-go/sdk/requests"
 	"github.com/aliyun/alibaba-cloud-sdk-go/sdk/responses"
@@ -15,11 +16,11 @@ import (
 
 func resourceAlibabacloudStackAscmResourceGroupUserAttachment() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceAlibabacloudStackAscmResourceGroupUserAttachmentCreate,
-		Read:   resourceAlibabacloudStackAscmResourceGroupUserAttachmentRead,
-		Delete: resourceAlibabacloudStackAscmResourceGroupUserAttachmentDelete,
+		CreateContext: withDiagnostics(resourceAlibabacloudStackAscmResourceGroupUserAttachmentCreate),
+		ReadContext:   withDiagnostics(resourceAlibabacloudStackAscmResourceGroupUserAttachmentRead),
+		DeleteContext: withDiagnostics(resourceAlibabacloudStackAscmResourceGroupUserAttachmentDelete),
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			StateContext: schema.ImportStatePassthroughContext,
 		},
 		Schema: map[string]*schema.Schema{
 			"rg_id": {
@@ -44,7 +45,7 @@ func resourceAlibabacloudStackAscmResourceGroupUserAttachment() *schema.Resource
 	}
 }
 
-func resourceAlibabacloudStackAscmResourceGroupUserAttachmentCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceAlibabacloudStackAscmResourceGroupUserAttachmentCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*connectivity.AlibabacloudStackClient)
 	var requestInfo *ecs.Client
 	//ascmService := AscmService{client}
@@ -119,10 +120,10 @@ func resourceAlibabacloudStackAscmResourceGroupUserAttachmentCreate(d *schema.Re
 	//})
 	//log.Printf("CreateOrganization Test %+v", check)
 	d.SetId(RgId)
-	return resourceAlibabacloudStackAscmResourceGroupUserAttachmentRead(d, meta)
+	return resourceAlibabacloudStackAscmResourceGroupUserAttachmentRead(ctx, d, meta)
 }
 
-func resourceAlibabacloudStackAscmResourceGroupUserAttachmentRead(d *schema.ResourceData, meta interface{}) error {
+func resourceAlibabacloudStackAscmResourceGroupUserAttachmentRead(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 	wiatSecondsIfWithTest(1)
 	client := meta.(*connectivity.AlibabacloudStackClient)
 
@@ -140,7 +141,7 @@ func resourceAlibabacloudStackAscmResourceGroupUserAttachmentRead(d *schema.Reso
 
 	return nil
 }
-func resourceAlibabacloudStackAscmResourceGroupUserAttachmentDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceAlibabacloudStackAscmResourceGroupUserAttachmentDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*connectivity.AlibabacloudStackClient)
 	ascmService := AscmService{client}
 	var requestInfo *ecs.Client
@@ -149,7 +150,7 @@ func resourceAlibabacloudStackAscmResourceGroupUserAttachmentDelete(d *schema.Re
 		return WrapErrorf(err, DefaultErrorMsg, d.Id(), "IsBindingExist", AlibabacloudStackSdkGoERROR)
 	}
 	addDebug("IsBindingExist", check, requestInfo, map[string]string{"resourceGroupId": d.Id()})
-	err = resource.Retry(1*time.Minute, func() *resource.RetryError {
+	err = resource.RetryContext(ctx, 1*time.Minute, func() *resource.RetryError {
 
 		request := requests.NewCommonRequest()
 		if client.Config.Insecure {
@@ -1,6 +1,7 @@
 package alibabacloudstack
 
 import (
+	"context"
 	"github.com/aliyun/alibaba-cloud-sdk-go/sdk/requests"
 	"github.com/aliyun/alibaba-cloud-sdk-go/sdk/responses"
 	"github.com/aliyun/alibaba-cloud-sdk-go/services/ecs"
@@ -15,10 +16,13 @@ import (
 
 func resourceAlibabacloudStackAscmUserGroup() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceAlibabacloudStackAscmUserGroupCreate,
-		Read:   resourceAlibabacloudStackAscmUserGroupRead,
-		Update: resourceAlibabacloudStackAscmUserGroupUpdate,
-		Delete: resourceAlibabacloudStackAscmUserGroupDelete,
+		CreateContext: withDiagnostics(resourceAlibabacloudStackAscmUserGroupCreate),
+		ReadContext:   withDiagnostics(resourceAlibabacloudStackAscmUserGroupRead),
+		UpdateContext: withDiagnostics(resourceAlibabacloudStackAscmUserGroupUpdate),
+		DeleteContext: withDiagnostics(resourceAlibabacloudStackAscmUserGroupDelete),
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
 		Schema: map[string]*schema.Schema{
 			"group_name": {
 				Type:     schema.TypeString,
@@ -46,7 +50,7 @@ func resourceAlibabacloudStackAscmUserGroup() *schema.Resource {
 	}
 }
 
-func resourceAlibabacloudStackAscmUserGroupCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceAlibabacloudStackAscmUserGroupCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*connectivity.AlibabacloudStackClient)
 	var requestInfo *ecs.Client
 
@@ -107,15 +111,15 @@ func resourceAlibabacloudStackAscmUserGroupCreate(d *schema.ResourceData, meta i
 
 	d.SetId(groupName)
 
-	return resourceAlibabacloudStackAscmUserGroupUpdate(d, meta)
+	return resourceAlibabacloudStackAscmUserGroupUpdate(ctx, d, meta)
 }
 
-func resourceAlibabacloudStackAscmUserGroupUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceAlibabacloudStackAscmUserGroupUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 
-	return resourceAlibabacloudStackAscmUserGroupRead(d, meta)
+	return resourceAlibabacloudStackAscmUserGroupRead(ctx, d, meta)
 }
 
-func resourceAlibabacloudStackAscmUserGroupRead(d *schema.ResourceData, meta interface{}) error {
+func resourceAlibabacloudStackAscmUserGroupRead(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 	wiatSecondsIfWithTest(1)
 
 	client := meta.(*connectivity.AlibabacloudStackClient)
@@ -146,7 +150,7 @@ func resourceAlibabacloudStackAscmUserGroupRead(d *schema.ResourceData, meta int
 	return nil
 }
 
-func resourceAlibabacloudStackAscmUserGroupDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceAlibabacloudStackAscmUserGroupDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 
 	client := meta.(*connectivity.AlibabacloudStackClient)
 	ascmService := AscmService{client}
@@ -157,7 +161,7 @@ func resourceAlibabacloudStackAscmUserGroupDelete(d *schema.ResourceData, meta i
 		return WrapErrorf(err, DefaultErrorMsg, d.Id(), "IsUserGroupExist", AlibabacloudStackSdkGoERROR)
 	}
 	addDebug("IsUserGroupExist", check, requestInfo, map[string]string{"groupName": d.Id()})
-	err = resource.Retry(2*time.Minute, func() *resource.RetryError {
+	err = resource.RetryContext(ctx, 2*time.Minute, func() *resource.RetryError {
 
 		request := requests.NewCommonRequest()
 		if client.Config.Insecure {
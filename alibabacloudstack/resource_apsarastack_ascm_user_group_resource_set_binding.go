@@ -1,6 +1,7 @@
 package alibabacloudstack
 
 import (
+	"context"
 	"github.com/aliyun/alibaba-cloud-sdk-go/sdk/requests"
 	"github.com/aliyun/alibaba-cloud-sdk-go/sdk/responses"
 	"github.com/aliyun/alibaba-cloud-sdk-go/services/ecs"
@@ -15,11 +16,11 @@ import (
 
 func resourceAlibabacloudStackAscmUserGroupResourceSetBinding() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceAlibabacloudStackAscmUserGroupResourceSetBindingCreate,
-		Read:   resourceAlibabacloudStackAscmUserGroupResourceSetBindingRead,
-		Delete: resourceAlibabacloudStackAscmUserGroupResourceSetBindingDelete,
+		CreateContext: withDiagnostics(resourceAlibabacloudStackAscmUserGroupResourceSetBindingCreate),
+		ReadContext:   withDiagnostics(resourceAlibabacloudStackAscmUserGroupResourceSetBindingRead),
+		DeleteContext: withDiagnostics(resourceAlibabacloudStackAscmUserGroupResourceSetBindingDelete),
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			StateContext: schema.ImportStatePassthroughContext,
 		},
 		Schema: map[string]*schema.Schema{
 			"resource_set_id": {
@@ -36,7 +37,7 @@ func resourceAlibabacloudStackAscmUserGroupResourceSetBinding() *schema.Resource
 	}
 }
 
-func resourceAlibabacloudStackAscmUserGroupResourceSetBindingCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceAlibabacloudStackAscmUserGroupResourceSetBindingCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*connectivity.AlibabacloudStackClient)
 	var requestInfo *ecs.Client
 	//ascmService := AscmService{client}
@@ -111,10 +112,10 @@ func resourceAlibabacloudStackAscmUserGroupResourceSetBindingCreate(d *schema.Re
 	//})
 	//log.Printf("CreateOrganization Test %+v", check)
 	d.SetId(resourceSetId)
-	return resourceAlibabacloudStackAscmUserGroupResourceSetBindingRead(d, meta)
+	return resourceAlibabacloudStackAscmUserGroupResourceSetBindingRead(ctx, d, meta)
 }
 
-func resourceAlibabacloudStackAscmUserGroupResourceSetBindingRead(d *schema.ResourceData, meta interface{}) error {
+func resourceAlibabacloudStackAscmUserGroupResourceSetBindingRead(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 	wiatSecondsIfWithTest(1)
 	client := meta.(*connectivity.AlibabacloudStackClient)
 
@@ -131,7 +132,7 @@ func resourceAlibabacloudStackAscmUserGroupResourceSetBindingRead(d *schema.Reso
 
 	return nil
 }
-func resourceAlibabacloudStackAscmUserGroupResourceSetBindingDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceAlibabacloudStackAscmUserGroupResourceSetBindingDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*connectivity.AlibabacloudStackClient)
 	ascmService := AscmService{client}
 	var requestInfo *ecs.Client
@@ -141,7 +142,7 @@ func resourceAlibabacloudStackAscmUserGroupResourceSetBindingDelete(d *schema.Re
 	}
 	addDebug("IsBindingExist", check, requestInfo, map[string]string{"resourceGroupId": d.Id()})
 	userGroupId := d.Get("user_group_id").(string)
-	err = resource.Retry(1*time.Minute, func() *resource.RetryError {
+	err = resource.RetryContext(ctx, 1*time.Minute, func() *resource.RetryError {
 
 		request := requests.NewCommonRequest()
 		if client.Config.Insecure {
@@ -1,6 +1,7 @@
 package alibabacloudstack
 
 import (
+	"context"
 	"fmt"
 	"github.com/aliyun/alibaba-cloud-sdk-go/sdk/requests"
 	"github.com/aliyun/alibaba-cloud-sdk-go/sdk/responses"
@@ -16,10 +17,13 @@ import (
 
 func resourceAlibabacloudStackAscmUserGroupRoleBinding() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceAlibabacloudStackAscmUserGroupRoleBindingCreate,
-		Read:   resourceAlibabacloudStackAscmUserGroupRoleBindingRead,
-		Update: resourceAlibabacloudStackAscmUserGroupRoleBindingUpdate,
-		Delete: resourceAlibabacloudStackAscmUserGroupRoleBindingDelete,
+		CreateContext: withDiagnostics(resourceAlibabacloudStackAscmUserGroupRoleBindingCreate),
+		ReadContext:   withDiagnostics(resourceAlibabacloudStackAscmUserGroupRoleBindingRead),
+		UpdateContext: withDiagnostics(resourceAlibabacloudStackAscmUserGroupRoleBindingUpdate),
+		DeleteContext: withDiagnostics(resourceAlibabacloudStackAscmUserGroupRoleBindingDelete),
+		Importer: &schema.ResourceImporter{
+			StateContext: ascmImportUserGroupId(),
+		},
 		Schema: map[string]*schema.Schema{
 			"user_group_id": {
 				Type:     schema.TypeInt,
@@ -34,7 +38,7 @@ func resourceAlibabacloudStackAscmUserGroupRoleBinding() *schema.Resource {
 	}
 }
 
-func resourceAlibabacloudStackAscmUserGroupRoleBindingCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceAlibabacloudStackAscmUserGroupRoleBindingCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*connectivity.AlibabacloudStackClient)
 	var requestInfo *ecs.Client
 	userGroupId := d.Get("user_group_id").(int)
@@ -97,10 +101,10 @@ func resourceAlibabacloudStackAscmUserGroupRoleBindingCreate(d *schema.ResourceD
 
 	d.SetId(strconv.Itoa(userGroupId))
 
-	return resourceAlibabacloudStackAscmUserGroupRoleBindingRead(d, meta)
+	return resourceAlibabacloudStackAscmUserGroupRoleBindingRead(ctx, d, meta)
 }
 
-func resourceAlibabacloudStackAscmUserGroupRoleBindingRead(d *schema.ResourceData, meta interface{}) error {
+func resourceAlibabacloudStackAscmUserGroupRoleBindingRead(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 	wiatSecondsIfWithTest(1)
 	client := meta.(*connectivity.AlibabacloudStackClient)
 	ascmService := AscmService{client}
@@ -117,17 +121,26 @@ func resourceAlibabacloudStackAscmUserGroupRoleBindingRead(d *schema.ResourceDat
 		return nil
 	}
 	atoi, err := strconv.Atoi(d.Id())
+	if err != nil {
+		return WrapError(err)
+	}
 	d.Set("user_group_id", atoi)
 
+	var roleIds []int
+	for _, role := range object.Data[0].Roles {
+		roleIds = append(roleIds, role.Id)
+	}
+	d.Set("role_ids", roleIds)
+
 	return nil
 }
 
-func resourceAlibabacloudStackAscmUserGroupRoleBindingUpdate(d *schema.ResourceData, meta interface{}) error {
-	return resourceAlibabacloudStackAscmUserGroupRoleBindingCreate(d, meta)
+func resourceAlibabacloudStackAscmUserGroupRoleBindingUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
+	return resourceAlibabacloudStackAscmUserGroupRoleBindingCreate(ctx, d, meta)
 
 }
 
-func resourceAlibabacloudStackAscmUserGroupRoleBindingDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceAlibabacloudStackAscmUserGroupRoleBindingDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 
 	client := meta.(*connectivity.AlibabacloudStackClient)
 	ascmService := AscmService{client}
@@ -156,7 +169,7 @@ func resourceAlibabacloudStackAscmUserGroupRoleBindingDelete(d *schema.ResourceD
 	}
 
 	addDebug("IsBindingExist", check, requestInfo, map[string]string{"userGroupId": d.Id()})
-	err = resource.Retry(2*time.Minute, func() *resource.RetryError {
+	err = resource.RetryContext(ctx, 2*time.Minute, func() *resource.RetryError {
 		if flag {
 			request := requests.NewCommonRequest()
 			if client.Config.Insecure {
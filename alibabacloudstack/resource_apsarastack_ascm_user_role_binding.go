@@ -1,6 +1,7 @@
 package alibabacloudstack
 
 import (
+	"context"
 	"fmt"
 	"github.com/aliyun/alibaba-cloud-sdk-go/sdk/requests"
 	"github.com/aliyun/alibaba-cloud-sdk-go/sdk/responses"
@@ -15,10 +16,13 @@ import (
 
 func resourceAlibabacloudStackAscmUserRoleBinding() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceAlibabacloudStackAscmUserRoleBindingCreate,
-		Read:   resourceAlibabacloudStackAscmUserRoleBindingRead,
-		Update: resourceAlibabacloudStackAscmUserRoleBindingUpdate,
-		Delete: resourceAlibabacloudStackAscmUserRoleBindingDelete,
+		CreateContext: withDiagnostics(resourceAlibabacloudStackAscmUserRoleBindingCreate),
+		ReadContext:   withDiagnostics(resourceAlibabacloudStackAscmUserRoleBindingRead),
+		UpdateContext: withDiagnostics(resourceAlibabacloudStackAscmUserRoleBindingUpdate),
+		DeleteContext: withDiagnostics(resourceAlibabacloudStackAscmUserRoleBindingDelete),
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
 		Schema: map[string]*schema.Schema{
 			"login_name": {
 				Type:     schema.TypeString,
@@ -33,7 +37,7 @@ func resourceAlibabacloudStackAscmUserRoleBinding() *schema.Resource {
 	}
 }
 
-func resourceAlibabacloudStackAscmUserRoleBindingCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceAlibabacloudStackAscmUserRoleBindingCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*connectivity.AlibabacloudStackClient)
 	var requestInfo *ecs.Client
 	lname := d.Get("login_name").(string)
@@ -96,10 +100,10 @@ func resourceAlibabacloudStackAscmUserRoleBindingCreate(d *schema.ResourceData,
 
 	d.SetId(lname)
 
-	return resourceAlibabacloudStackAscmUserRoleBindingRead(d, meta)
+	return resourceAlibabacloudStackAscmUserRoleBindingRead(ctx, d, meta)
 }
 
-func resourceAlibabacloudStackAscmUserRoleBindingRead(d *schema.ResourceData, meta interface{}) error {
+func resourceAlibabacloudStackAscmUserRoleBindingRead(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 	wiatSecondsIfWithTest(1)
 	client := meta.(*connectivity.AlibabacloudStackClient)
 	ascmService := AscmService{client}
@@ -117,15 +121,21 @@ func resourceAlibabacloudStackAscmUserRoleBindingRead(d *schema.ResourceData, me
 	}
 	d.Set("login_name", object.Data[0].LoginName)
 
+	var roleIds []int
+	for _, role := range object.Data[0].UserRoles {
+		roleIds = append(roleIds, role.ID)
+	}
+	d.Set("role_ids", roleIds)
+
 	return nil
 }
 
-func resourceAlibabacloudStackAscmUserRoleBindingUpdate(d *schema.ResourceData, meta interface{}) error {
-	return resourceAlibabacloudStackAscmUserRoleBindingCreate(d, meta)
+func resourceAlibabacloudStackAscmUserRoleBindingUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
+	return resourceAlibabacloudStackAscmUserRoleBindingCreate(ctx, d, meta)
 
 }
 
-func resourceAlibabacloudStackAscmUserRoleBindingDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceAlibabacloudStackAscmUserRoleBindingDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 
 	client := meta.(*connectivity.AlibabacloudStackClient)
 	ascmService := AscmService{client}
@@ -153,7 +163,7 @@ func resourceAlibabacloudStackAscmUserRoleBindingDelete(d *schema.ResourceData,
 		return WrapErrorf(err, DefaultErrorMsg, d.Id(), "IsBindingExist", AlibabacloudStackSdkGoERROR)
 	}
 	addDebug("IsBindingExist", check, requestInfo, map[string]string{"loginName": d.Id()})
-	err = resource.Retry(2*time.Minute, func() *resource.RetryError {
+	err = resource.RetryContext(ctx, 2*time.Minute, func() *resource.RetryError {
 		if flag {
 			request := requests.NewCommonRequest()
 			if client.Config.Insecure {
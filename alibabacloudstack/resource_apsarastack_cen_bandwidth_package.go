@@ -0,0 +1,166 @@
+package alibabacloudstack
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/aliyun/terraform-provider-alibabacloudstack/alibabacloudstack/connectivity"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+func resourceAlibabacloudStackCenBandwidthPackage() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: withDiagnostics(resourceAlibabacloudStackCenBandwidthPackageCreate),
+		ReadContext:   withDiagnostics(resourceAlibabacloudStackCenBandwidthPackageRead),
+		UpdateContext: withDiagnostics(resourceAlibabacloudStackCenBandwidthPackageUpdate),
+		DeleteContext: withDiagnostics(resourceAlibabacloudStackCenBandwidthPackageDelete),
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(6 * time.Minute),
+			Delete: schema.DefaultTimeout(6 * time.Minute),
+		},
+		Schema: map[string]*schema.Schema{
+			"bandwidth": {
+				Type:         schema.TypeInt,
+				Required:     true,
+				ValidateFunc: validation.IntAtLeast(1),
+			},
+			"geographic_region_a_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"geographic_region_b_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"name": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringLenBetween(2, 128),
+			},
+			"description": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringLenBetween(2, 256),
+			},
+			"status": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"expired_time": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceAlibabacloudStackCenBandwidthPackageCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*connectivity.AlibabacloudStackClient)
+	cenService := CenService{client}
+	action := "CreateCenBandwidthPackage"
+	request := map[string]interface{}{
+		"ClientToken":                buildClientToken(action),
+		"Bandwidth":                  d.Get("bandwidth"),
+		"GeographicRegionAId":        d.Get("geographic_region_a_id"),
+		"GeographicRegionBId":        d.Get("geographic_region_b_id"),
+		"BandwidthPackageChargeType": "POSTPAY",
+		"AutoPay":                    true,
+	}
+	if v, ok := d.GetOk("name"); ok {
+		request["Name"] = v
+	}
+	if v, ok := d.GetOk("description"); ok {
+		request["Description"] = v
+	}
+	response, err := cenService.DoCenRequest(ctx, action, request, d.Timeout(schema.TimeoutCreate))
+	if err != nil {
+		return WrapErrorf(err, DefaultErrorMsg, "alibabacloudstack_cen_bandwidth_package", action, AlibabacloudStackSdkGoERROR)
+	}
+	d.SetId(fmt.Sprint(response["CenBandwidthPackageId"]))
+
+	stateConf := BuildStateConf([]string{}, []string{"Idle", "InUse"}, d.Timeout(schema.TimeoutCreate), 3*time.Second, cenService.CenBandwidthPackageStateRefreshFunc(d.Id(), []string{}))
+	if _, err := stateConf.WaitForStateContext(ctx); err != nil {
+		return WrapErrorf(err, IdMsg, d.Id())
+	}
+
+	return resourceAlibabacloudStackCenBandwidthPackageRead(ctx, d, meta)
+}
+
+func resourceAlibabacloudStackCenBandwidthPackageRead(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*connectivity.AlibabacloudStackClient)
+	cenService := CenService{client}
+	object, err := cenService.DescribeCenBandwidthPackage(d.Id())
+	if err != nil {
+		if NotFoundError(err) {
+			d.SetId("")
+			return nil
+		}
+		return WrapError(err)
+	}
+
+	d.Set("bandwidth", formatInt(object["Bandwidth"]))
+	d.Set("geographic_region_a_id", object["GeographicRegionAId"])
+	d.Set("geographic_region_b_id", object["GeographicRegionBId"])
+	d.Set("name", object["Name"])
+	d.Set("description", object["Description"])
+	d.Set("status", object["Status"])
+	d.Set("expired_time", object["ExpiredTime"])
+	return nil
+}
+
+func resourceAlibabacloudStackCenBandwidthPackageUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*connectivity.AlibabacloudStackClient)
+	cenService := CenService{client}
+	d.Partial(true)
+	if d.HasChanges("name", "description") {
+		action := "ModifyCenBandwidthPackageAttribute"
+		request := map[string]interface{}{
+			"CenBandwidthPackageId": d.Id(),
+			"Name":                  d.Get("name"),
+			"Description":           d.Get("description"),
+		}
+		if _, err := cenService.DoCenRequest(ctx, action, request, d.Timeout(schema.TimeoutUpdate)); err != nil {
+			return WrapErrorf(err, DefaultErrorMsg, d.Id(), action, AlibabacloudStackSdkGoERROR)
+		}
+	}
+	if d.HasChange("bandwidth") {
+		action := "ModifyCenBandwidthPackageSpec"
+		request := map[string]interface{}{
+			"CenBandwidthPackageId": d.Id(),
+			"Bandwidth":             d.Get("bandwidth"),
+		}
+		if _, err := cenService.DoCenRequest(ctx, action, request, d.Timeout(schema.TimeoutUpdate)); err != nil {
+			return WrapErrorf(err, DefaultErrorMsg, d.Id(), action, AlibabacloudStackSdkGoERROR)
+		}
+	}
+	d.Partial(false)
+	return resourceAlibabacloudStackCenBandwidthPackageRead(ctx, d, meta)
+}
+
+func resourceAlibabacloudStackCenBandwidthPackageDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*connectivity.AlibabacloudStackClient)
+	cenService := CenService{client}
+	action := "DeleteCenBandwidthPackage"
+	request := map[string]interface{}{
+		"CenBandwidthPackageId": d.Id(),
+	}
+	if _, err := cenService.DoCenRequest(ctx, action, request, d.Timeout(schema.TimeoutDelete)); err != nil {
+		if IsExpectedErrors(err, []string{"ParameterBwpInstanceId", "Instance.NotExist"}) {
+			return nil
+		}
+		return WrapErrorf(err, DefaultErrorMsg, d.Id(), action, AlibabacloudStackSdkGoERROR)
+	}
+
+	stateConf := BuildStateConf([]string{"Idle", "Deleting"}, []string{}, d.Timeout(schema.TimeoutDelete), 3*time.Second, cenService.CenBandwidthPackageStateRefreshFunc(d.Id(), []string{}))
+	if _, err := stateConf.WaitForStateContext(ctx); err != nil {
+		return WrapErrorf(err, IdMsg, d.Id())
+	}
+	return nil
+}
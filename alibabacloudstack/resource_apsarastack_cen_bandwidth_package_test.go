@@ -0,0 +1,74 @@
+package alibabacloudstack
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/aliyun/terraform-provider-alibabacloudstack/alibabacloudstack/connectivity"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccAlibabacloudStackCenBandwidthPackage_basic(t *testing.T) {
+	var v map[string]interface{}
+	resourceId := "alibabacloudstack_cen_bandwidth_package.default"
+	ra := resourceAttrInit(resourceId, cenBandwidthPackageBasicMap)
+	serviceFunc := func() interface{} {
+		return &CenService{testAccProvider.Meta().(*connectivity.AlibabacloudStackClient)}
+	}
+	rc := resourceCheckInit(resourceId, &v, serviceFunc)
+	rac := resourceAttrCheckInit(rc, ra)
+
+	rand := acctest.RandIntRange(10000, 99999)
+	testAccCheck := rac.resourceAttrMapUpdateSet()
+	name := fmt.Sprintf("tf-testacc-cenbwp%v", rand)
+	testAccConfig := resourceTestAccConfigFunc(resourceId, name, resourceCenInstanceConfigDependence)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+		},
+		IDRefreshName: resourceId,
+		Providers:     testAccProviders,
+		CheckDestroy:  rac.checkResourceDestroy(),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccConfig(map[string]interface{}{
+					"name":                   "${var.name}",
+					"bandwidth":              "5",
+					"geographic_region_a_id": "China",
+					"geographic_region_b_id": "China",
+				}),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheck(map[string]string{
+						"name":      name,
+						"bandwidth": "5",
+					}),
+				),
+			},
+			{
+				ResourceName:      resourceId,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccConfig(map[string]interface{}{
+					"bandwidth":   "10",
+					"description": "tf-testacc-cen-bwp-description",
+				}),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheck(map[string]string{
+						"bandwidth":   "10",
+						"description": "tf-testacc-cen-bwp-description",
+					}),
+				),
+			},
+		},
+	})
+}
+
+var cenBandwidthPackageBasicMap = map[string]string{
+	"geographic_region_a_id": "China",
+	"geographic_region_b_id": "China",
+	"status":                 "Idle",
+}
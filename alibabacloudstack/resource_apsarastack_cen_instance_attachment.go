@@ -0,0 +1,153 @@
+package alibabacloudstack
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/aliyun/terraform-provider-alibabacloudstack/alibabacloudstack/connectivity"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+func resourceAlibabacloudStackCenInstanceAttachment() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: withDiagnostics(resourceAlibabacloudStackCenInstanceAttachmentCreate),
+		ReadContext:   withDiagnostics(resourceAlibabacloudStackCenInstanceAttachmentRead),
+		DeleteContext: withDiagnostics(resourceAlibabacloudStackCenInstanceAttachmentDelete),
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+			Delete: schema.DefaultTimeout(10 * time.Minute),
+		},
+		Schema: map[string]*schema.Schema{
+			"instance_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"child_instance_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"child_instance_type": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringInSlice([]string{ChildInstanceTypeVpc, ChildInstanceTypeVbr, ChildInstanceTypeCcn}, false),
+			},
+			"child_instance_region_id": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+			"child_instance_owner_id": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+			"status": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceAlibabacloudStackCenInstanceAttachmentCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*connectivity.AlibabacloudStackClient)
+	cenService := CenService{client}
+	cenId := d.Get("instance_id").(string)
+	childInstanceId := d.Get("child_instance_id").(string)
+	childInstanceType := d.Get("child_instance_type").(string)
+	if childInstanceType == "" {
+		t, err := GetCenChildInstanceType(childInstanceId)
+		if err != nil {
+			return WrapError(err)
+		}
+		childInstanceType = t
+	}
+	childInstanceRegionId := d.Get("child_instance_region_id").(string)
+	if childInstanceRegionId == "" {
+		childInstanceRegionId = client.RegionId
+	}
+
+	action := "AttachCenChildInstance"
+	request := map[string]interface{}{
+		"CenId":                 cenId,
+		"ChildInstanceId":       childInstanceId,
+		"ChildInstanceType":     childInstanceType,
+		"ChildInstanceRegionId": childInstanceRegionId,
+	}
+	// The child instance of another department or account should be granted to the cen instance by its owner.
+	if v, ok := d.GetOk("child_instance_owner_id"); ok {
+		request["ChildInstanceOwnerId"] = v
+	}
+	if _, err := cenService.DoCenRequest(ctx, action, request, d.Timeout(schema.TimeoutCreate)); err != nil {
+		return WrapErrorf(err, DefaultErrorMsg, "alibabacloudstack_cen_instance_attachment", action, AlibabacloudStackSdkGoERROR)
+	}
+	d.SetId(cenId + COLON_SEPARATED + childInstanceId)
+
+	stateConf := BuildStateConf([]string{"Attaching"}, []string{"Attached"}, d.Timeout(schema.TimeoutCreate), 3*time.Second, cenService.CenInstanceAttachmentStateRefreshFunc(d.Id(), []string{}))
+	if _, err := stateConf.WaitForStateContext(ctx); err != nil {
+		return WrapErrorf(err, IdMsg, d.Id())
+	}
+
+	return resourceAlibabacloudStackCenInstanceAttachmentRead(ctx, d, meta)
+}
+
+func resourceAlibabacloudStackCenInstanceAttachmentRead(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*connectivity.AlibabacloudStackClient)
+	cenService := CenService{client}
+	object, err := cenService.DescribeCenInstanceAttachment(d.Id())
+	if err != nil {
+		if NotFoundError(err) {
+			d.SetId("")
+			return nil
+		}
+		return WrapError(err)
+	}
+
+	d.Set("instance_id", object["CenId"])
+	d.Set("child_instance_id", object["ChildInstanceId"])
+	d.Set("child_instance_type", object["ChildInstanceType"])
+	d.Set("child_instance_region_id", object["ChildInstanceRegionId"])
+	if v, ok := object["ChildInstanceOwnerId"]; ok && v != nil {
+		d.Set("child_instance_owner_id", fmt.Sprint(v))
+	}
+	d.Set("status", object["Status"])
+	return nil
+}
+
+func resourceAlibabacloudStackCenInstanceAttachmentDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*connectivity.AlibabacloudStackClient)
+	cenService := CenService{client}
+	action := "DetachCenChildInstance"
+	request := map[string]interface{}{
+		"CenId":                 d.Get("instance_id"),
+		"ChildInstanceId":       d.Get("child_instance_id"),
+		"ChildInstanceType":     d.Get("child_instance_type"),
+		"ChildInstanceRegionId": d.Get("child_instance_region_id"),
+	}
+	if v, ok := d.GetOk("child_instance_owner_id"); ok {
+		request["ChildInstanceOwnerId"] = v
+	}
+	if _, err := cenService.DoCenRequest(ctx, action, request, d.Timeout(schema.TimeoutDelete)); err != nil {
+		if IsExpectedErrors(err, []string{"ParameterCenInstanceId", "Instance.NotExist", "InvalidOperation.ChildInstanceNotExist"}) {
+			return nil
+		}
+		return WrapErrorf(err, DefaultErrorMsg, d.Id(), action, AlibabacloudStackSdkGoERROR)
+	}
+
+	stateConf := BuildStateConf([]string{"Attached", "Detaching"}, []string{}, d.Timeout(schema.TimeoutDelete), 3*time.Second, cenService.CenInstanceAttachmentStateRefreshFunc(d.Id(), []string{}))
+	if _, err := stateConf.WaitForStateContext(ctx); err != nil {
+		return WrapErrorf(err, IdMsg, d.Id())
+	}
+	return nil
+}
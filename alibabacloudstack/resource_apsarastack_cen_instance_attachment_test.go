@@ -0,0 +1,77 @@
+package alibabacloudstack
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/aliyun/terraform-provider-alibabacloudstack/alibabacloudstack/connectivity"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccAlibabacloudStackCenInstanceAttachment_basic(t *testing.T) {
+	var v map[string]interface{}
+	resourceId := "alibabacloudstack_cen_instance_attachment.default"
+	ra := resourceAttrInit(resourceId, cenInstanceAttachmentBasicMap)
+	serviceFunc := func() interface{} {
+		return &CenService{testAccProvider.Meta().(*connectivity.AlibabacloudStackClient)}
+	}
+	rc := resourceCheckInit(resourceId, &v, serviceFunc)
+	rac := resourceAttrCheckInit(rc, ra)
+
+	rand := acctest.RandIntRange(10000, 99999)
+	testAccCheck := rac.resourceAttrMapUpdateSet()
+	name := fmt.Sprintf("tf-testacc-cenattachment%v", rand)
+	testAccConfig := resourceTestAccConfigFunc(resourceId, name, resourceCenInstanceAttachmentConfigDependence)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+		},
+		IDRefreshName: resourceId,
+		Providers:     testAccProviders,
+		CheckDestroy:  rac.checkResourceDestroy(),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccConfig(map[string]interface{}{
+					"instance_id":       "${alibabacloudstack_cen_instance.default.id}",
+					"child_instance_id": "${alibabacloudstack_vpc.default.id}",
+				}),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheck(map[string]string{
+						"instance_id":       CHECKSET,
+						"child_instance_id": CHECKSET,
+					}),
+				),
+			},
+			{
+				ResourceName:      resourceId,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func resourceCenInstanceAttachmentConfigDependence(name string) string {
+	return fmt.Sprintf(`
+variable "name" {
+  default = "%v"
+}
+
+resource "alibabacloudstack_cen_instance" "default" {
+  name = var.name
+}
+
+resource "alibabacloudstack_vpc" "default" {
+  name       = var.name
+  cidr_block = "192.168.0.0/16"
+}
+`, name)
+}
+
+var cenInstanceAttachmentBasicMap = map[string]string{
+	"child_instance_type":      "VPC",
+	"child_instance_region_id": CHECKSET,
+	"status":                   "Attached",
+}
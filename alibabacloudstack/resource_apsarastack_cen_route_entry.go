@@ -0,0 +1,119 @@
+package alibabacloudstack
+
+import (
+	"context"
+	"strings"
+	"time"
+
+	"github.com/aliyun/terraform-provider-alibabacloudstack/alibabacloudstack/connectivity"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func resourceAlibabacloudStackCenRouteEntry() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: withDiagnostics(resourceAlibabacloudStackCenRouteEntryCreate),
+		ReadContext:   withDiagnostics(resourceAlibabacloudStackCenRouteEntryRead),
+		DeleteContext: withDiagnostics(resourceAlibabacloudStackCenRouteEntryDelete),
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+			Delete: schema.DefaultTimeout(10 * time.Minute),
+		},
+		Schema: map[string]*schema.Schema{
+			"instance_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"route_table_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"cidr_block": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+		},
+	}
+}
+
+func resourceAlibabacloudStackCenRouteEntryCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*connectivity.AlibabacloudStackClient)
+	cenService := CenService{client}
+	cenId := d.Get("instance_id").(string)
+	routeTableId := d.Get("route_table_id").(string)
+	cidrBlock := d.Get("cidr_block").(string)
+
+	action := "PublishRouteEntries"
+	request, err := cenService.cenRouteTableChild(cenId, routeTableId)
+	if err != nil {
+		return WrapError(err)
+	}
+	request["DestinationCidrBlock"] = cidrBlock
+	if _, err := cenService.DoCenRequest(ctx, action, request, d.Timeout(schema.TimeoutCreate)); err != nil {
+		return WrapErrorf(err, DefaultErrorMsg, "alibabacloudstack_cen_route_entry", action, AlibabacloudStackSdkGoERROR)
+	}
+	d.SetId(strings.Join([]string{cenId, routeTableId, cidrBlock}, COLON_SEPARATED))
+
+	stateConf := BuildStateConf([]string{"Publishing"}, []string{"Published"}, d.Timeout(schema.TimeoutCreate), 3*time.Second, cenService.CenRouteEntryStateRefreshFunc(d.Id(), []string{}))
+	if _, err := stateConf.WaitForStateContext(ctx); err != nil {
+		return WrapErrorf(err, IdMsg, d.Id())
+	}
+
+	return resourceAlibabacloudStackCenRouteEntryRead(ctx, d, meta)
+}
+
+func resourceAlibabacloudStackCenRouteEntryRead(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*connectivity.AlibabacloudStackClient)
+	cenService := CenService{client}
+	if _, err := cenService.DescribeCenRouteEntry(d.Id()); err != nil {
+		if NotFoundError(err) {
+			d.SetId("")
+			return nil
+		}
+		return WrapError(err)
+	}
+	parts, err := ParseResourceId(d.Id(), 3)
+	if err != nil {
+		return WrapError(err)
+	}
+	d.Set("instance_id", parts[0])
+	d.Set("route_table_id", parts[1])
+	d.Set("cidr_block", parts[2])
+	return nil
+}
+
+func resourceAlibabacloudStackCenRouteEntryDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*connectivity.AlibabacloudStackClient)
+	cenService := CenService{client}
+	parts, err := ParseResourceId(d.Id(), 3)
+	if err != nil {
+		return WrapError(err)
+	}
+
+	action := "WithdrawPublishedRouteEntries"
+	request, err := cenService.cenRouteTableChild(parts[0], parts[1])
+	if err != nil {
+		if NotFoundError(err) {
+			return nil
+		}
+		return WrapError(err)
+	}
+	request["DestinationCidrBlock"] = parts[2]
+	if _, err := cenService.DoCenRequest(ctx, action, request, d.Timeout(schema.TimeoutDelete)); err != nil {
+		if IsExpectedErrors(err, []string{"ParameterCenInstanceId", "Instance.NotExist", "InvalidOperation.NotExist"}) {
+			return nil
+		}
+		return WrapErrorf(err, DefaultErrorMsg, d.Id(), action, AlibabacloudStackSdkGoERROR)
+	}
+
+	stateConf := BuildStateConf([]string{"Published", "Withdrawing"}, []string{}, d.Timeout(schema.TimeoutDelete), 3*time.Second, cenService.CenRouteEntryStateRefreshFunc(d.Id(), []string{}))
+	if _, err := stateConf.WaitForStateContext(ctx); err != nil {
+		return WrapErrorf(err, IdMsg, d.Id())
+	}
+	return nil
+}
@@ -0,0 +1,95 @@
+package alibabacloudstack
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/aliyun/terraform-provider-alibabacloudstack/alibabacloudstack/connectivity"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccAlibabacloudStackCenRouteEntry_basic(t *testing.T) {
+	var v map[string]interface{}
+	resourceId := "alibabacloudstack_cen_route_entry.default"
+	ra := resourceAttrInit(resourceId, map[string]string{})
+	serviceFunc := func() interface{} {
+		return &CenService{testAccProvider.Meta().(*connectivity.AlibabacloudStackClient)}
+	}
+	rc := resourceCheckInit(resourceId, &v, serviceFunc)
+	rac := resourceAttrCheckInit(rc, ra)
+
+	rand := acctest.RandIntRange(10000, 99999)
+	testAccCheck := rac.resourceAttrMapUpdateSet()
+	name := fmt.Sprintf("tf-testacc-cenrouteentry%v", rand)
+	testAccConfig := resourceTestAccConfigFunc(resourceId, name, resourceCenRouteEntryConfigDependence)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+		},
+		IDRefreshName: resourceId,
+		Providers:     testAccProviders,
+		CheckDestroy:  rac.checkResourceDestroy(),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccConfig(map[string]interface{}{
+					"instance_id":    "${alibabacloudstack_cen_instance_attachment.default.instance_id}",
+					"route_table_id": "${alibabacloudstack_vpc.default.route_table_id}",
+					"cidr_block":     "${alibabacloudstack_route_entry.default.destination_cidrblock}",
+				}),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheck(map[string]string{
+						"instance_id":    CHECKSET,
+						"route_table_id": CHECKSET,
+						"cidr_block":     "11.0.0.0/16",
+					}),
+				),
+			},
+			{
+				ResourceName:      resourceId,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func resourceCenRouteEntryConfigDependence(name string) string {
+	return fmt.Sprintf(`
+%s
+
+data "alibabacloudstack_zones" "default" {
+  available_resource_creation = "VSwitch"
+}
+
+resource "alibabacloudstack_vswitch" "default" {
+  vpc_id            = alibabacloudstack_vpc.default.id
+  cidr_block        = "192.168.0.0/24"
+  availability_zone = data.alibabacloudstack_zones.default.zones.0.id
+}
+
+resource "alibabacloudstack_network_interface" "default" {
+  name            = var.name
+  vswitch_id      = alibabacloudstack_vswitch.default.id
+  security_groups = [alibabacloudstack_security_group.default.id]
+}
+
+resource "alibabacloudstack_security_group" "default" {
+  name   = var.name
+  vpc_id = alibabacloudstack_vpc.default.id
+}
+
+resource "alibabacloudstack_route_entry" "default" {
+  route_table_id        = alibabacloudstack_vpc.default.route_table_id
+  destination_cidrblock = "11.0.0.0/16"
+  nexthop_type          = "NetworkInterface"
+  nexthop_id            = alibabacloudstack_network_interface.default.id
+}
+
+resource "alibabacloudstack_cen_instance_attachment" "default" {
+  instance_id       = alibabacloudstack_cen_instance.default.id
+  child_instance_id = alibabacloudstack_vpc.default.id
+}
+`, resourceCenInstanceAttachmentConfigDependence(name))
+}
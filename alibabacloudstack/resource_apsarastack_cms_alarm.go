@@ -1,6 +1,7 @@
 package alibabacloudstack
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
 	"github.com/aliyun/alibaba-cloud-sdk-go/sdk/requests"
@@ -21,12 +22,12 @@ import (
 
 func resourceAlibabacloudStackCmsAlarm() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceAlibabacloudStackCmsAlarmCreate,
-		Read:   resourceAlibabacloudStackCmsAlarmRead,
-		Update: resourceAlibabacloudStackCmsAlarmUpdate,
-		Delete: resourceAlibabacloudStackCmsAlarmDelete,
+		CreateContext: withDiagnostics(resourceAlibabacloudStackCmsAlarmCreate),
+		ReadContext:   withDiagnostics(resourceAlibabacloudStackCmsAlarmRead),
+		UpdateContext: withDiagnostics(resourceAlibabacloudStackCmsAlarmUpdate),
+		DeleteContext: withDiagnostics(resourceAlibabacloudStackCmsAlarmDelete),
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			StateContext: schema.ImportStatePassthroughContext,
 		},
 		Schema: map[string]*schema.Schema{
 			"name": {
@@ -186,7 +187,7 @@ func resourceAlibabacloudStackCmsAlarm() *schema.Resource {
 	}
 }
 
-func resourceAlibabacloudStackCmsAlarmCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceAlibabacloudStackCmsAlarmCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 
 	client := meta.(*connectivity.AlibabacloudStackClient)
 	cmsService := CmsService{client}
@@ -348,7 +349,7 @@ func resourceAlibabacloudStackCmsAlarmCreate(d *schema.ResourceData, meta interf
 		request.QueryParams = map[string]string{"AccessKeySecret": client.SecretKey, "Product": "cms", "Department": client.Department, "ResourceGroup": client.ResourceGroup}
 
 		wait := incrementalWait(1*time.Second, 2*time.Second)
-		err = resource.Retry(5*time.Minute, func() *resource.RetryError {
+		err = resource.RetryContext(ctx, 5*time.Minute, func() *resource.RetryError {
 			_, err := client.WithCmsClient(func(cmsClient *cms.Client) (interface{}, error) {
 				return cmsClient.EnableMetricRules(request)
 			})
@@ -372,7 +373,7 @@ func resourceAlibabacloudStackCmsAlarmCreate(d *schema.ResourceData, meta interf
 		request.QueryParams = map[string]string{"AccessKeySecret": client.SecretKey, "Product": "cms", "Department": client.Department, "ResourceGroup": client.ResourceGroup}
 
 		wait := incrementalWait(1*time.Second, 2*time.Second)
-		err = resource.Retry(5*time.Minute, func() *resource.RetryError {
+		err = resource.RetryContext(ctx, 5*time.Minute, func() *resource.RetryError {
 			_, err := client.WithCmsClient(func(cmsClient *cms.Client) (interface{}, error) {
 				return cmsClient.DisableMetricRules(request)
 			})
@@ -396,10 +397,10 @@ func resourceAlibabacloudStackCmsAlarmCreate(d *schema.ResourceData, meta interf
 
 	d.Partial(false)
 
-	return resourceAlibabacloudStackCmsAlarmUpdate(d, meta)
+	return resourceAlibabacloudStackCmsAlarmUpdate(ctx, d, meta)
 }
 
-func resourceAlibabacloudStackCmsAlarmRead(d *schema.ResourceData, meta interface{}) error {
+func resourceAlibabacloudStackCmsAlarmRead(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 	wiatSecondsIfWithTest(1)
 	client := meta.(*connectivity.AlibabacloudStackClient)
 	cmsService := CmsService{client}
@@ -492,12 +493,12 @@ func resourceAlibabacloudStackCmsAlarmRead(d *schema.ResourceData, meta interfac
 	return nil
 }
 
-func resourceAlibabacloudStackCmsAlarmUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceAlibabacloudStackCmsAlarmUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 
-	return resourceAlibabacloudStackCmsAlarmRead(d, meta)
+	return resourceAlibabacloudStackCmsAlarmRead(ctx, d, meta)
 }
 
-func resourceAlibabacloudStackCmsAlarmDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceAlibabacloudStackCmsAlarmDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*connectivity.AlibabacloudStackClient)
 	cmsService := CmsService{client}
 	parts, err := ParseResourceId(d.Id(), 2)
@@ -511,7 +512,7 @@ func resourceAlibabacloudStackCmsAlarmDelete(d *schema.ResourceData, meta interf
 	request.Id = &[]string{parts[0]}
 
 	wait := incrementalWait(1*time.Second, 2*time.Second)
-	return resource.Retry(10*time.Minute, func() *resource.RetryError {
+	return resource.RetryContext(ctx, 10*time.Minute, func() *resource.RetryError {
 		_, err := client.WithCmsClient(func(cmsClient *cms.Client) (interface{}, error) {
 			return cmsClient.DeleteMetricRules(request)
 		})
@@ -1,6 +1,7 @@
 package alibabacloudstack
 
 import (
+	"context"
 	"fmt"
 	"log"
 	"strings"
@@ -13,12 +14,12 @@ import (
 
 func resourceAlibabacloudstackCmsAlarmContact() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceAlibabacloudstackCmsAlarmContactCreate,
-		Read:   resourceAlibabacloudstackCmsAlarmContactRead,
-		Update: resourceAlibabacloudstackCmsAlarmContactUpdate,
-		Delete: resourceAlibabacloudstackCmsAlarmContactDelete,
+		CreateContext: withDiagnostics(resourceAlibabacloudstackCmsAlarmContactCreate),
+		ReadContext:   withDiagnostics(resourceAlibabacloudstackCmsAlarmContactRead),
+		UpdateContext: withDiagnostics(resourceAlibabacloudstackCmsAlarmContactUpdate),
+		DeleteContext: withDiagnostics(resourceAlibabacloudstackCmsAlarmContactDelete),
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			StateContext: schema.ImportStatePassthroughContext,
 		},
 		Schema: map[string]*schema.Schema{
 			"alarm_contact_name": {
@@ -55,7 +56,7 @@ func resourceAlibabacloudstackCmsAlarmContact() *schema.Resource {
 	}
 }
 
-func resourceAlibabacloudstackCmsAlarmContactCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceAlibabacloudstackCmsAlarmContactCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*connectivity.AlibabacloudStackClient)
 
 	request := cms.CreatePutContactRequest()
@@ -103,9 +104,9 @@ func resourceAlibabacloudstackCmsAlarmContactCreate(d *schema.ResourceData, meta
 	}
 	d.SetId(fmt.Sprintf("%v", request.ContactName))
 
-	return resourceAlibabacloudstackCmsAlarmContactRead(d, meta)
+	return resourceAlibabacloudstackCmsAlarmContactRead(ctx, d, meta)
 }
-func resourceAlibabacloudstackCmsAlarmContactRead(d *schema.ResourceData, meta interface{}) error {
+func resourceAlibabacloudstackCmsAlarmContactRead(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 	wiatSecondsIfWithTest(1)
 	client := meta.(*connectivity.AlibabacloudStackClient)
 	cmsService := CmsService{client}
@@ -129,7 +130,7 @@ func resourceAlibabacloudstackCmsAlarmContactRead(d *schema.ResourceData, meta i
 	return nil
 }
 
-func resourceAlibabacloudstackCmsAlarmContactUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceAlibabacloudstackCmsAlarmContactUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*connectivity.AlibabacloudStackClient)
 	update := false
 	request := cms.CreatePutContactRequest()
@@ -172,9 +173,9 @@ func resourceAlibabacloudstackCmsAlarmContactUpdate(d *schema.ResourceData, meta
 			return WrapErrorf(err, DefaultErrorMsg, d.Id(), request.GetActionName(), AlibabacloudStackSdkGoERROR)
 		}
 	}
-	return resourceAlibabacloudstackCmsAlarmContactRead(d, meta)
+	return resourceAlibabacloudstackCmsAlarmContactRead(ctx, d, meta)
 }
-func resourceAlibabacloudstackCmsAlarmContactDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceAlibabacloudstackCmsAlarmContactDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*connectivity.AlibabacloudStackClient)
 	request := cms.CreateDeleteContactRequest()
 	request.ContactName = d.Id()
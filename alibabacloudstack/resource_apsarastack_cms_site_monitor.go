@@ -2,6 +2,7 @@ package alibabacloudstack
 
 import (
 	"bytes"
+	"context"
 	"encoding/json"
 	"fmt"
 	"strconv"
@@ -18,12 +19,12 @@ import (
 
 func resourceAlibabacloudStackCmsSiteMonitor() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceAlibabacloudStackCmsSiteMonitorCreate,
-		Read:   resourceAlibabacloudStackCmsSiteMonitorRead,
-		Update: resourceAlibabacloudStackCmsSiteMonitorUpdate,
-		Delete: resourceAlibabacloudStackCmsSiteMonitorDelete,
+		CreateContext: withDiagnostics(resourceAlibabacloudStackCmsSiteMonitorCreate),
+		ReadContext:   withDiagnostics(resourceAlibabacloudStackCmsSiteMonitorRead),
+		UpdateContext: withDiagnostics(resourceAlibabacloudStackCmsSiteMonitorUpdate),
+		DeleteContext: withDiagnostics(resourceAlibabacloudStackCmsSiteMonitorDelete),
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			StateContext: schema.ImportStatePassthroughContext,
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -89,7 +90,7 @@ func resourceAlibabacloudStackCmsSiteMonitor() *schema.Resource {
 	}
 }
 
-func resourceAlibabacloudStackCmsSiteMonitorCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceAlibabacloudStackCmsSiteMonitorCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*connectivity.AlibabacloudStackClient)
 	cmsService := CmsService{client}
 
@@ -135,10 +136,10 @@ func resourceAlibabacloudStackCmsSiteMonitorCreate(d *schema.ResourceData, meta
 
 	d.SetId(siteMonitor.TaskId)
 
-	return resourceAlibabacloudStackCmsSiteMonitorRead(d, meta)
+	return resourceAlibabacloudStackCmsSiteMonitorRead(ctx, d, meta)
 }
 
-func resourceAlibabacloudStackCmsSiteMonitorRead(d *schema.ResourceData, meta interface{}) error {
+func resourceAlibabacloudStackCmsSiteMonitorRead(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 	wiatSecondsIfWithTest(1)
 	client := meta.(*connectivity.AlibabacloudStackClient)
 	cmsService := CmsService{client}
@@ -175,7 +176,7 @@ func resourceAlibabacloudStackCmsSiteMonitorRead(d *schema.ResourceData, meta in
 	return nil
 }
 
-func resourceAlibabacloudStackCmsSiteMonitorUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceAlibabacloudStackCmsSiteMonitorUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*connectivity.AlibabacloudStackClient)
 
 	request := cms.CreateModifySiteMonitorRequest()
@@ -212,10 +213,10 @@ func resourceAlibabacloudStackCmsSiteMonitorUpdate(d *schema.ResourceData, meta
 		return WrapError(err)
 	}
 
-	return resourceAlibabacloudStackCmsSiteMonitorRead(d, meta)
+	return resourceAlibabacloudStackCmsSiteMonitorRead(ctx, d, meta)
 }
 
-func resourceAlibabacloudStackCmsSiteMonitorDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceAlibabacloudStackCmsSiteMonitorDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*connectivity.AlibabacloudStackClient)
 	cmsService := CmsService{client}
 	request := cms.CreateDeleteSiteMonitorsRequest()
@@ -225,7 +226,7 @@ func resourceAlibabacloudStackCmsSiteMonitorDelete(d *schema.ResourceData, meta
 	request.TaskIds = d.Id()
 	request.IsDeleteAlarms = "false"
 
-	return resource.Retry(3*time.Minute, func() *resource.RetryError {
+	return resource.RetryContext(ctx, 3*time.Minute, func() *resource.RetryError {
 		_, err := client.WithCmsClient(func(cmsClient *cms.Client) (interface{}, error) {
 			return cmsClient.DeleteSiteMonitors(request)
 		})
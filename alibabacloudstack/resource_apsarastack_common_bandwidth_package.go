@@ -1,6 +1,7 @@
 package alibabacloudstack
 
 import (
+	"context"
 	"strconv"
 	"strings"
 	"time"
@@ -16,12 +17,12 @@ import (
 
 func resourceAlibabacloudStackCommonBandwidthPackage() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceAlibabacloudStackCommonBandwidthPackageCreate,
-		Read:   resourceAlibabacloudStackCommonBandwidthPackageRead,
-		Update: resourceAlibabacloudStackCommonBandwidthPackageUpdate,
-		Delete: resourceAlibabacloudStackCommonBandwidthPackageDelete,
+		CreateContext: withDiagnostics(resourceAlibabacloudStackCommonBandwidthPackageCreate),
+		ReadContext:   withDiagnostics(resourceAlibabacloudStackCommonBandwidthPackageRead),
+		UpdateContext: withDiagnostics(resourceAlibabacloudStackCommonBandwidthPackageUpdate),
+		DeleteContext: withDiagnostics(resourceAlibabacloudStackCommonBandwidthPackageDelete),
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			StateContext: schema.ImportStatePassthroughContext,
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -63,7 +64,7 @@ func resourceAlibabacloudStackCommonBandwidthPackage() *schema.Resource {
 	}
 }
 
-func resourceAlibabacloudStackCommonBandwidthPackageCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceAlibabacloudStackCommonBandwidthPackageCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*connectivity.AlibabacloudStackClient)
 	vpcService := VpcService{client}
 
@@ -85,7 +86,7 @@ func resourceAlibabacloudStackCommonBandwidthPackageCreate(d *schema.ResourceDat
 	request.Ratio = requests.NewInteger(d.Get("ratio").(int))
 
 	wait := incrementalWait(1*time.Second, 1*time.Second)
-	err := resource.Retry(10*time.Minute, func() *resource.RetryError {
+	err := resource.RetryContext(ctx, 10*time.Minute, func() *resource.RetryError {
 		request.ClientToken = buildClientToken(request.GetActionName())
 		raw, err := client.WithVpcClient(func(vpcClient *vpc.Client) (interface{}, error) {
 			return vpcClient.CreateCommonBandwidthPackage(request)
@@ -111,10 +112,10 @@ func resourceAlibabacloudStackCommonBandwidthPackageCreate(d *schema.ResourceDat
 		return WrapError(err)
 	}
 
-	return resourceAlibabacloudStackCommonBandwidthPackageRead(d, meta)
+	return resourceAlibabacloudStackCommonBandwidthPackageRead(ctx, d, meta)
 }
 
-func resourceAlibabacloudStackCommonBandwidthPackageRead(d *schema.ResourceData, meta interface{}) error {
+func resourceAlibabacloudStackCommonBandwidthPackageRead(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 	wiatSecondsIfWithTest(1)
 	client := meta.(*connectivity.AlibabacloudStackClient)
 	vpcService := VpcService{client}
@@ -139,7 +140,7 @@ func resourceAlibabacloudStackCommonBandwidthPackageRead(d *schema.ResourceData,
 	return nil
 }
 
-func resourceAlibabacloudStackCommonBandwidthPackageUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceAlibabacloudStackCommonBandwidthPackageUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*connectivity.AlibabacloudStackClient)
 
 	d.Partial(true)
@@ -201,10 +202,10 @@ func resourceAlibabacloudStackCommonBandwidthPackageUpdate(d *schema.ResourceDat
 	}
 
 	d.Partial(false)
-	return resourceAlibabacloudStackCommonBandwidthPackageRead(d, meta)
+	return resourceAlibabacloudStackCommonBandwidthPackageRead(ctx, d, meta)
 }
 
-func resourceAlibabacloudStackCommonBandwidthPackageDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceAlibabacloudStackCommonBandwidthPackageDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*connectivity.AlibabacloudStackClient)
 	vpcService := VpcService{client}
 
@@ -1,6 +1,7 @@
 package alibabacloudstack
 
 import (
+	"context"
 	"strings"
 	"time"
 
@@ -12,11 +13,11 @@ import (
 
 func resourceAlibabacloudStackCommonBandwidthPackageAttachment() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceAlibabacloudStackCommonBandwidthPackageAttachmentCreate,
-		Read:   resourceAlibabacloudStackCommonBandwidthPackageAttachmentRead,
-		Delete: resourceAlibabacloudStackCommonBandwidthPackageAttachmentDelete,
+		CreateContext: withDiagnostics(resourceAlibabacloudStackCommonBandwidthPackageAttachmentCreate),
+		ReadContext:   withDiagnostics(resourceAlibabacloudStackCommonBandwidthPackageAttachmentRead),
+		DeleteContext: withDiagnostics(resourceAlibabacloudStackCommonBandwidthPackageAttachmentDelete),
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			StateContext: schema.ImportStatePassthroughContext,
 		},
 		Schema: map[string]*schema.Schema{
 			"bandwidth_package_id": {
@@ -34,7 +35,7 @@ func resourceAlibabacloudStackCommonBandwidthPackageAttachment() *schema.Resourc
 	}
 }
 
-func resourceAlibabacloudStackCommonBandwidthPackageAttachmentCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceAlibabacloudStackCommonBandwidthPackageAttachmentCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*connectivity.AlibabacloudStackClient)
 	vpcService := VpcService{client}
 	request := vpc.CreateAddCommonBandwidthPackageIpRequest()
@@ -61,10 +62,10 @@ func resourceAlibabacloudStackCommonBandwidthPackageAttachmentCreate(d *schema.R
 	if err := vpcService.WaitForCommonBandwidthPackageAttachment(d.Id(), Available, 5*DefaultTimeout); err != nil {
 		return WrapError(err)
 	}
-	return resourceAlibabacloudStackCommonBandwidthPackageAttachmentRead(d, meta)
+	return resourceAlibabacloudStackCommonBandwidthPackageAttachmentRead(ctx, d, meta)
 }
 
-func resourceAlibabacloudStackCommonBandwidthPackageAttachmentRead(d *schema.ResourceData, meta interface{}) error {
+func resourceAlibabacloudStackCommonBandwidthPackageAttachmentRead(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 	wiatSecondsIfWithTest(1)
 	client := meta.(*connectivity.AlibabacloudStackClient)
 	vpcService := VpcService{client}
@@ -88,7 +89,7 @@ func resourceAlibabacloudStackCommonBandwidthPackageAttachmentRead(d *schema.Res
 	return nil
 }
 
-func resourceAlibabacloudStackCommonBandwidthPackageAttachmentDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceAlibabacloudStackCommonBandwidthPackageAttachmentDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*connectivity.AlibabacloudStackClient)
 	vpcService := VpcService{client}
 	parts, err := ParseResourceId(d.Id(), 2)
@@ -109,7 +110,7 @@ func resourceAlibabacloudStackCommonBandwidthPackageAttachmentDelete(d *schema.R
 	request.BandwidthPackageId = bandwidthPackageId
 	request.IpInstanceId = ipInstanceId
 
-	err = resource.Retry(5*time.Minute, func() *resource.RetryError {
+	err = resource.RetryContext(ctx, 5*time.Minute, func() *resource.RetryError {
 		raw, err := client.WithVpcClient(func(vpcClient *vpc.Client) (interface{}, error) {
 			return vpcClient.RemoveCommonBandwidthPackageIp(request)
 		})
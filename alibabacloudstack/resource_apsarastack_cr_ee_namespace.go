@@ -1,6 +1,7 @@
 package alibabacloudstack
 
 import (
+	"context"
 	"github.com/aliyun/alibaba-cloud-sdk-go/sdk/requests"
 	"github.com/aliyun/alibaba-cloud-sdk-go/services/cr_ee"
 	"github.com/aliyun/terraform-provider-alibabacloudstack/alibabacloudstack/connectivity"
@@ -10,12 +11,12 @@ import (
 
 func resourceAlibabacloudStackCrEENamespace() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceAlibabacloudStackCrEENamespaceCreate,
-		Read:   resourceAlibabacloudStackCrEENamespaceRead,
-		Update: resourceAlibabacloudStackCrEENamespaceUpdate,
-		Delete: resourceAlibabacloudStackCrEENamespaceDelete,
+		CreateContext: withDiagnostics(resourceAlibabacloudStackCrEENamespaceCreate),
+		ReadContext:   withDiagnostics(resourceAlibabacloudStackCrEENamespaceRead),
+		UpdateContext: withDiagnostics(resourceAlibabacloudStackCrEENamespaceUpdate),
+		DeleteContext: withDiagnostics(resourceAlibabacloudStackCrEENamespaceDelete),
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			StateContext: schema.ImportStatePassthroughContext,
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -43,7 +44,7 @@ func resourceAlibabacloudStackCrEENamespace() *schema.Resource {
 	}
 }
 
-func resourceAlibabacloudStackCrEENamespaceCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceAlibabacloudStackCrEENamespaceCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*connectivity.AlibabacloudStackClient)
 	crService := &CrService{client}
 	instanceId := d.Get("instance_id").(string)
@@ -79,10 +80,10 @@ func resourceAlibabacloudStackCrEENamespaceCreate(d *schema.ResourceData, meta i
 
 	d.SetId(crService.GenResourceId(instanceId, namespace))
 
-	return resourceAlibabacloudStackCrEENamespaceRead(d, meta)
+	return resourceAlibabacloudStackCrEENamespaceRead(ctx, d, meta)
 }
 
-func resourceAlibabacloudStackCrEENamespaceRead(d *schema.ResourceData, meta interface{}) error {
+func resourceAlibabacloudStackCrEENamespaceRead(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 	wiatSecondsIfWithTest(1)
 	client := meta.(*connectivity.AlibabacloudStackClient)
 	crService := &CrService{client}
@@ -103,7 +104,7 @@ func resourceAlibabacloudStackCrEENamespaceRead(d *schema.ResourceData, meta int
 	return nil
 }
 
-func resourceAlibabacloudStackCrEENamespaceUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceAlibabacloudStackCrEENamespaceUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*connectivity.AlibabacloudStackClient)
 	crService := &CrService{client}
 	instanceId := d.Get("instance_id").(string)
@@ -137,10 +138,10 @@ func resourceAlibabacloudStackCrEENamespaceUpdate(d *schema.ResourceData, meta i
 		}
 	}
 
-	return resourceAlibabacloudStackCrEENamespaceRead(d, meta)
+	return resourceAlibabacloudStackCrEENamespaceRead(ctx, d, meta)
 }
 
-func resourceAlibabacloudStackCrEENamespaceDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceAlibabacloudStackCrEENamespaceDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*connectivity.AlibabacloudStackClient)
 	crService := &CrService{client}
 	instanceId := d.Get("instance_id").(string)
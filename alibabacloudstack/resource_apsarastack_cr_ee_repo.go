@@ -1,6 +1,7 @@
 package alibabacloudstack
 
 import (
+	"context"
 	"github.com/aliyun/alibaba-cloud-sdk-go/services/cr_ee"
 	"github.com/aliyun/terraform-provider-alibabacloudstack/alibabacloudstack/connectivity"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
@@ -9,12 +10,12 @@ import (
 
 func resourceAlibabacloudStackCrEERepo() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceAlibabacloudStackCrEERepoCreate,
-		Read:   resourceAlibabacloudStackCrEERepoRead,
-		Update: resourceAlibabacloudStackCrEERepoUpdate,
-		Delete: resourceAlibabacloudStackCrEERepoDelete,
+		CreateContext: withDiagnostics(resourceAlibabacloudStackCrEERepoCreate),
+		ReadContext:   withDiagnostics(resourceAlibabacloudStackCrEERepoRead),
+		UpdateContext: withDiagnostics(resourceAlibabacloudStackCrEERepoUpdate),
+		DeleteContext: withDiagnostics(resourceAlibabacloudStackCrEERepoDelete),
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			StateContext: schema.ImportStatePassthroughContext,
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -60,7 +61,7 @@ func resourceAlibabacloudStackCrEERepo() *schema.Resource {
 	}
 }
 
-func resourceAlibabacloudStackCrEERepoCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceAlibabacloudStackCrEERepoCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*connectivity.AlibabacloudStackClient)
 	crService := &CrService{client}
 	instanceId := d.Get("instance_id").(string)
@@ -101,10 +102,10 @@ func resourceAlibabacloudStackCrEERepoCreate(d *schema.ResourceData, meta interf
 
 	d.SetId(crService.GenResourceId(instanceId, namespace, repoName))
 
-	return resourceAlibabacloudStackCrEERepoRead(d, meta)
+	return resourceAlibabacloudStackCrEERepoRead(ctx, d, meta)
 }
 
-func resourceAlibabacloudStackCrEERepoRead(d *schema.ResourceData, meta interface{}) error {
+func resourceAlibabacloudStackCrEERepoRead(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 	wiatSecondsIfWithTest(1)
 	client := meta.(*connectivity.AlibabacloudStackClient)
 	crService := &CrService{client}
@@ -128,7 +129,7 @@ func resourceAlibabacloudStackCrEERepoRead(d *schema.ResourceData, meta interfac
 	return nil
 }
 
-func resourceAlibabacloudStackCrEERepoUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceAlibabacloudStackCrEERepoUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*connectivity.AlibabacloudStackClient)
 	crService := &CrService{client}
 	instanceId := d.Get("instance_id").(string)
@@ -165,10 +166,10 @@ func resourceAlibabacloudStackCrEERepoUpdate(d *schema.ResourceData, meta interf
 		}
 	}
 
-	return resourceAlibabacloudStackCrEERepoRead(d, meta)
+	return resourceAlibabacloudStackCrEERepoRead(ctx, d, meta)
 }
 
-func resourceAlibabacloudStackCrEERepoDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceAlibabacloudStackCrEERepoDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*connectivity.AlibabacloudStackClient)
 	crService := &CrService{client}
 	instanceId := d.Get("instance_id").(string)
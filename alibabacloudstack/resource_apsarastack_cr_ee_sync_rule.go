@@ -1,6 +1,7 @@
 package alibabacloudstack
 
 import (
+	"context"
 	"github.com/aliyun/alibaba-cloud-sdk-go/services/cr_ee"
 	"github.com/aliyun/terraform-provider-alibabacloudstack/alibabacloudstack/connectivity"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
@@ -9,11 +10,11 @@ import (
 
 func resourceAlibabacloudStackCrEESyncRule() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceAlibabacloudStackCrEESyncRuleCreate,
-		Read:   resourceAlibabacloudStackCrEESyncRuleRead,
-		Delete: resourceAlibabacloudStackCrEESyncRuleDelete,
+		CreateContext: withDiagnostics(resourceAlibabacloudStackCrEESyncRuleCreate),
+		ReadContext:   withDiagnostics(resourceAlibabacloudStackCrEESyncRuleRead),
+		DeleteContext: withDiagnostics(resourceAlibabacloudStackCrEESyncRuleDelete),
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			StateContext: schema.ImportStatePassthroughContext,
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -83,7 +84,7 @@ func resourceAlibabacloudStackCrEESyncRule() *schema.Resource {
 	}
 }
 
-func resourceAlibabacloudStackCrEESyncRuleCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceAlibabacloudStackCrEESyncRuleCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*connectivity.AlibabacloudStackClient)
 	crService := &CrService{client}
 	syncRuleName := d.Get("name").(string)
@@ -142,10 +143,10 @@ func resourceAlibabacloudStackCrEESyncRuleCreate(d *schema.ResourceData, meta in
 
 	d.SetId(crService.GenResourceId(instanceId, namespaceName, response.SyncRuleId))
 
-	return resourceAlibabacloudStackCrEESyncRuleRead(d, meta)
+	return resourceAlibabacloudStackCrEESyncRuleRead(ctx, d, meta)
 }
 
-func resourceAlibabacloudStackCrEESyncRuleRead(d *schema.ResourceData, meta interface{}) error {
+func resourceAlibabacloudStackCrEESyncRuleRead(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 	wiatSecondsIfWithTest(1)
 	client := meta.(*connectivity.AlibabacloudStackClient)
 	crService := &CrService{client}
@@ -174,7 +175,7 @@ func resourceAlibabacloudStackCrEESyncRuleRead(d *schema.ResourceData, meta inte
 	return nil
 }
 
-func resourceAlibabacloudStackCrEESyncRuleDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceAlibabacloudStackCrEESyncRuleDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*connectivity.AlibabacloudStackClient)
 	crService := &CrService{client}
 	instanceId := d.Get("instance_id").(string)
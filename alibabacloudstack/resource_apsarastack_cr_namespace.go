@@ -1,6 +1,7 @@
 package alibabacloudstack
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
 	"github.com/aliyun/alibaba-cloud-sdk-go/sdk/requests"
@@ -16,12 +17,12 @@ import (
 
 func resourceAlibabacloudStackCRNamespace() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceAlibabacloudStackCRNamespaceCreate,
-		Read:   resourceAlibabacloudStackCRNamespaceRead,
-		Update: resourceAlibabacloudStackCRNamespaceUpdate,
-		Delete: resourceAlibabacloudStackCRNamespaceDelete,
+		CreateContext: withDiagnostics(resourceAlibabacloudStackCRNamespaceCreate),
+		ReadContext:   withDiagnostics(resourceAlibabacloudStackCRNamespaceRead),
+		UpdateContext: withDiagnostics(resourceAlibabacloudStackCRNamespaceUpdate),
+		DeleteContext: withDiagnostics(resourceAlibabacloudStackCRNamespaceDelete),
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			StateContext: schema.ImportStatePassthroughContext,
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -44,7 +45,7 @@ func resourceAlibabacloudStackCRNamespace() *schema.Resource {
 	}
 }
 
-func resourceAlibabacloudStackCRNamespaceCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceAlibabacloudStackCRNamespaceCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*connectivity.AlibabacloudStackClient)
 	var uresponse, bresponse *responses.CommonResponse
 	resp := crResponse{}
@@ -121,10 +122,10 @@ func resourceAlibabacloudStackCRNamespaceCreate(d *schema.ResourceData, meta int
 
 	d.SetId(namespaceName)
 
-	return resourceAlibabacloudStackCRNamespaceUpdate(d, meta)
+	return resourceAlibabacloudStackCRNamespaceUpdate(ctx, d, meta)
 }
 
-func resourceAlibabacloudStackCRNamespaceUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceAlibabacloudStackCRNamespaceUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*connectivity.AlibabacloudStackClient)
 	//
 	create := d.Get("auto_create").(bool)
@@ -163,10 +164,10 @@ func resourceAlibabacloudStackCRNamespaceUpdate(d *schema.ResourceData, meta int
 		addDebug(request.GetActionName(), raw, request)
 	}
 
-	return resourceAlibabacloudStackCRNamespaceRead(d, meta)
+	return resourceAlibabacloudStackCRNamespaceRead(ctx, d, meta)
 }
 
-func resourceAlibabacloudStackCRNamespaceRead(d *schema.ResourceData, meta interface{}) error {
+func resourceAlibabacloudStackCRNamespaceRead(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 	wiatSecondsIfWithTest(1)
 	client := meta.(*connectivity.AlibabacloudStackClient)
 	crService := CrService{client}
@@ -187,7 +188,7 @@ func resourceAlibabacloudStackCRNamespaceRead(d *schema.ResourceData, meta inter
 	return nil
 }
 
-func resourceAlibabacloudStackCRNamespaceDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceAlibabacloudStackCRNamespaceDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*connectivity.AlibabacloudStackClient)
 	resp := crResponse{}
 	request := requests.NewCommonRequest()
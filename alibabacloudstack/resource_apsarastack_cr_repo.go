@@ -1,6 +1,7 @@
 package alibabacloudstack
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
 	"github.com/aliyun/alibaba-cloud-sdk-go/sdk/requests"
@@ -16,12 +17,12 @@ import (
 
 func resourceAlibabacloudStackCRRepo() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceAlibabacloudStackCRRepoCreate,
-		Read:   resourceAlibabacloudStackCRRepoRead,
-		Update: resourceAlibabacloudStackCRRepoUpdate,
-		Delete: resourceAlibabacloudStackCRRepoDelete,
+		CreateContext: withDiagnostics(resourceAlibabacloudStackCRRepoCreate),
+		ReadContext:   withDiagnostics(resourceAlibabacloudStackCRRepoRead),
+		UpdateContext: withDiagnostics(resourceAlibabacloudStackCRRepoUpdate),
+		DeleteContext: withDiagnostics(resourceAlibabacloudStackCRRepoDelete),
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			StateContext: schema.ImportStatePassthroughContext,
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -77,7 +78,7 @@ func resourceAlibabacloudStackCRRepo() *schema.Resource {
 	}
 }
 
-func resourceAlibabacloudStackCRRepoCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceAlibabacloudStackCRRepoCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*connectivity.AlibabacloudStackClient)
 
 	resp := ResponseCr{}
@@ -122,7 +123,7 @@ func resourceAlibabacloudStackCRRepoCreate(d *schema.ResourceData, meta interfac
 	addDebug(request.GetActionName(), raw, request)
 	d.SetId(fmt.Sprintf("%s%s%s", repoNamespace, SLASH_SEPARATED, repoName))
 
-	return resourceAlibabacloudStackCRRepoRead(d, meta)
+	return resourceAlibabacloudStackCRRepoRead(ctx, d, meta)
 }
 
 type ResponseCr struct {
@@ -135,7 +136,7 @@ type ResponseCr struct {
 	SuccessResponse bool `json:"successResponse"`
 }
 
-func resourceAlibabacloudStackCRRepoUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceAlibabacloudStackCRRepoUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*connectivity.AlibabacloudStackClient)
 	resp := ResponseCr{}
 	repoNamespace := d.Get("namespace").(string)
@@ -180,10 +181,10 @@ func resourceAlibabacloudStackCRRepoUpdate(d *schema.ResourceData, meta interfac
 		log.Printf("repo create unmarshalled response %v", &resp)
 		addDebug(request.GetActionName(), raw, request)
 	}
-	return resourceAlibabacloudStackCRRepoRead(d, meta)
+	return resourceAlibabacloudStackCRRepoRead(ctx, d, meta)
 }
 
-func resourceAlibabacloudStackCRRepoRead(d *schema.ResourceData, meta interface{}) error {
+func resourceAlibabacloudStackCRRepoRead(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 	wiatSecondsIfWithTest(1)
 	client := meta.(*connectivity.AlibabacloudStackClient)
 	crService := CrService{client}
@@ -213,7 +214,7 @@ func resourceAlibabacloudStackCRRepoRead(d *schema.ResourceData, meta interface{
 	return nil
 }
 
-func resourceAlibabacloudStackCRRepoDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceAlibabacloudStackCRRepoDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*connectivity.AlibabacloudStackClient)
 
 	sli := strings.Split(d.Id(), SLASH_SEPARATED)
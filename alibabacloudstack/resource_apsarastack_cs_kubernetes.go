@@ -1,6 +1,7 @@
 package alibabacloudstack
 
 import (
+	"context"
 	//	"encoding/base64"
 	"encoding/json"
 	"fmt"
@@ -40,13 +41,22 @@ var (
 
 func resourceAlibabacloudStackCSKubernetes() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceAlibabacloudStackCSKubernetesCreate,
-		Read:   resourceAlibabacloudStackCSKubernetesRead,
-		Update: resourceAlibabacloudStackCSKubernetesUpdate,
-		Delete: resourceAlibabacloudStackCSKubernetesDelete,
+		CreateContext: withDiagnostics(resourceAlibabacloudStackCSKubernetesCreate),
+		ReadContext:   withDiagnostics(resourceAlibabacloudStackCSKubernetesRead),
+		UpdateContext: withDiagnostics(resourceAlibabacloudStackCSKubernetesUpdate),
+		DeleteContext: withDiagnostics(resourceAlibabacloudStackCSKubernetesDelete),
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			StateContext: schema.ImportStatePassthroughContext,
 		},
+		CustomizeDiff: customizeDiffAll(
+			csKubernetesCidrCustomizeDiff,
+			csKubernetesVersionCustomizeDiff,
+			// The network of the cluster can not be modified, so the changes which are allowed by force_update
+			// recreate the cluster only when it is asked by recreate_on_network_change.
+			forceNewIfChange(func(d *schema.ResourceDiff) bool {
+				return d.Get("force_update").(bool) && d.Get("recreate_on_network_change").(bool)
+			}, "pod_cidr", "service_cidr", "node_cidr_mask"),
+		),
 
 		Timeouts: &schema.ResourceTimeout{
 			Create: schema.DefaultTimeout(90 * time.Minute),
@@ -521,6 +531,12 @@ func resourceAlibabacloudStackCSKubernetes() *schema.Resource {
 				Default:  false,
 				//Removed:  "Field 'force_update' has been removed from provider version 1.75.0.",
 			},
+			"recreate_on_network_change": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+			"tags": tagsSchema(),
 			"availability_zone": {
 				Type:     schema.TypeString,
 				Optional: true,
@@ -609,7 +625,7 @@ type ClusterCommonResponse struct {
 	InstanceId string `json:"instanceId"`
 }
 
-func resourceAlibabacloudStackCSKubernetesCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceAlibabacloudStackCSKubernetesCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 	log.Printf("check meta %v", meta)
 	client := meta.(*connectivity.AlibabacloudStackClient)
 	csService := CsService{client}
@@ -972,14 +988,14 @@ func resourceAlibabacloudStackCSKubernetesCreate(d *schema.ResourceData, meta in
 	d.SetId(clusterresponse.ClusterID)
 
 	stateConf := BuildStateConf([]string{"initial", " "}, []string{"running"}, d.Timeout(schema.TimeoutCreate), 15*time.Minute, csService.CsKubernetesInstanceStateRefreshFunc(d.Id(), []string{"deleting", "failed"}))
-	if _, err := stateConf.WaitForState(); err != nil {
+	if _, err := stateConf.WaitForStateContext(ctx); err != nil {
 		return WrapErrorf(err, IdMsg, d.Id())
 	}
 
-	return resourceAlibabacloudStackCSKubernetesUpdate(d, meta)
+	return resourceAlibabacloudStackCSKubernetesUpdate(ctx, d, meta)
 }
 
-func resourceAlibabacloudStackCSKubernetesUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceAlibabacloudStackCSKubernetesUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 
 	client := meta.(*connectivity.AlibabacloudStackClient)
 	csService := CsService{client}
@@ -995,6 +1011,9 @@ func resourceAlibabacloudStackCSKubernetesUpdate(d *schema.ResourceData, meta in
 	for _, k := range nodepool.Nodepools {
 		nodepoolid = k.NodepoolInfo.NodepoolID
 	}
+	if err := csService.SetResourceTags(d, TagResourceCluster); err != nil {
+		return WrapError(err)
+	}
 	if d.HasChange("num_of_nodes") && !d.IsNewResource() {
 		password := d.Get("password").(string)
 		if password == "" {
@@ -1075,18 +1094,82 @@ func resourceAlibabacloudStackCSKubernetesUpdate(d *schema.ResourceData, meta in
 
 		stateConf := BuildStateConf([]string{"scaling"}, []string{"running"}, d.Timeout(schema.TimeoutUpdate), 10*time.Second, csService.CsKubernetesInstanceStateRefreshFunc(d.Id(), []string{"deleting", "failed"}))
 
-		if _, err := stateConf.WaitForState(); err != nil {
+		if _, err := stateConf.WaitForStateContext(ctx); err != nil {
 			return WrapErrorf(err, IdMsg, d.Id())
 		}
 		//d.SetPartial("num_of_nodes")
 	}
+	if d.HasChange("version") && !d.IsNewResource() {
+		if err := upgradeCsKubernetes(ctx, d, meta); err != nil {
+			return WrapError(err)
+		}
+	}
 
 	d.Partial(false)
-	return resourceAlibabacloudStackCSKubernetesRead(d, meta)
+	return resourceAlibabacloudStackCSKubernetesRead(ctx, d, meta)
 
 }
 
-func resourceAlibabacloudStackCSKubernetesRead(d *schema.ResourceData, meta interface{}) error {
+// upgradeCsKubernetes upgrades the control plane in place, and then upgrades the node pools one by one, so that only the
+// nodes of one node pool are drained at the same time. The nodes which are not in any node pool are not upgraded.
+func upgradeCsKubernetes(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*connectivity.AlibabacloudStackClient)
+	csService := CsService{client}
+	nextVersion := d.Get("version").(string)
+	timeout := d.Timeout(schema.TimeoutUpdate)
+
+	object, err := csService.DescribeCsKubernetes(d.Id())
+	if err != nil {
+		return WrapError(err)
+	}
+	version := object.CurrentVersion
+	if version == "" {
+		version = object.InitVersion
+	}
+	// The control plane has been upgraded if the previous apply failed when upgrading the node pools.
+	if compareCsKubernetesVersion(version, nextVersion) < 0 {
+		if err := csService.UpgradeCsKubernetesControlPlane(ctx, d.Id(), version, nextVersion, timeout); err != nil {
+			return WrapError(err)
+		}
+	}
+	stateConf := BuildStateConf([]string{"upgrading", "updating"}, []string{"running"}, timeout, 10*time.Second, csService.CsKubernetesInstanceStateRefreshFunc(d.Id(), []string{"deleting", "failed"}))
+	if _, err := stateConf.WaitForStateContext(ctx); err != nil {
+		return WrapErrorf(err, IdMsg, d.Id())
+	}
+
+	nodePools, err := csService.DescribeClusterNodePools(d.Id())
+	if err != nil {
+		return WrapError(err)
+	}
+	nodePoolIds := make([]string, 0, len(nodePools.Nodepools))
+	for _, nodePool := range nodePools.Nodepools {
+		if nodePool.Status.TotalNodes == 0 {
+			continue
+		}
+		// The node pool has been upgraded if the previous apply failed when upgrading the other node pools.
+		if version := nodePool.KubernetesConfig.KubernetesVersion; version != "" && compareCsKubernetesVersion(version, nextVersion) >= 0 {
+			continue
+		}
+		// The default node pool is upgraded first, which has the system components in general.
+		if nodePool.NodepoolInfo.IsDefault {
+			nodePoolIds = append([]string{nodePool.NodepoolInfo.NodepoolID}, nodePoolIds...)
+		} else {
+			nodePoolIds = append(nodePoolIds, nodePool.NodepoolInfo.NodepoolID)
+		}
+	}
+	for _, nodePoolId := range nodePoolIds {
+		if err := csService.UpgradeCsKubernetesNodePool(ctx, d.Id(), nodePoolId, nextVersion, timeout); err != nil {
+			return WrapError(err)
+		}
+		stateConf := BuildStateConf([]string{"scaling", "updating", "upgrading"}, []string{"active"}, timeout, 30*time.Second, csService.CsKubernetesNodePoolStateRefreshFunc(fmt.Sprintf("%s%s%s", d.Id(), COLON_SEPARATED, nodePoolId), []string{"deleting", "failed"}))
+		if _, err := stateConf.WaitForStateContext(ctx); err != nil {
+			return WrapErrorf(err, IdMsg, d.Id())
+		}
+	}
+	return nil
+}
+
+func resourceAlibabacloudStackCSKubernetesRead(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 	wiatSecondsIfWithTest(1)
 
 	client := meta.(*connectivity.AlibabacloudStackClient)
@@ -1107,9 +1190,13 @@ func resourceAlibabacloudStackCSKubernetesRead(d *schema.ResourceData, meta inte
 	d.Set("vpc_id", object.VpcId)
 	//d.Set("resource_group_id", object.ResourceGroupId)
 	d.Set("pod_cidr", object.ContainerCIDR)
-	d.Set("version", object.CurrentVersion)
+	// The current version is changed by the upgrade, and it is empty before the cluster is upgraded for the first time.
+	if object.CurrentVersion != "" {
+		d.Set("version", object.CurrentVersion)
+	} else {
+		d.Set("version", object.InitVersion)
+	}
 	d.Set("delete_protection", object.DeletionProtection)
-	d.Set("version", object.InitVersion)
 	var smaster, sworker []map[string]interface{}
 	//var MasterNodes, WorkerNodes map[string]interface{}
 	for _, k := range clusternode.Nodes {
@@ -1133,10 +1220,15 @@ func resourceAlibabacloudStackCSKubernetesRead(d *schema.ResourceData, meta inte
 	d.Set("master_nodes", smaster)
 	d.Set("worker_nodes", sworker)
 
+	tags, err := csService.ListTagResources(d.Id(), TagResourceCluster)
+	if err != nil {
+		return WrapError(err)
+	}
+	d.Set("tags", tags)
 	return nil
 }
 
-func resourceAlibabacloudStackCSKubernetesDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceAlibabacloudStackCSKubernetesDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*connectivity.AlibabacloudStackClient)
 	csService := CsService{client}
 	invoker := NewInvoker()
@@ -1169,7 +1261,7 @@ func resourceAlibabacloudStackCSKubernetesDelete(d *schema.ResourceData, meta in
 	request.ApiName = "DeleteCluster"
 	request.Headers = map[string]string{"RegionId": client.RegionId}
 	var response interface{}
-	err := resource.Retry(30*time.Minute, func() *resource.RetryError {
+	err := resource.RetryContext(ctx, 30*time.Minute, func() *resource.RetryError {
 		if err := invoker.Run(func() error {
 			raw, err := client.WithEcsClient(func(csClient *ecs.Client) (interface{}, error) {
 				return csClient.ProcessCommonRequest(request)
@@ -1194,7 +1286,7 @@ func resourceAlibabacloudStackCSKubernetesDelete(d *schema.ResourceData, meta in
 	}
 
 	stateConf := BuildStateConf([]string{"running", "deleting", "initial"}, []string{}, d.Timeout(schema.TimeoutDelete), 10*time.Minute, csService.CsKubernetesInstanceStateRefreshFunc(d.Id(), []string{"delete_failed"}))
-	if _, err := stateConf.WaitForState(); err != nil {
+	if _, err := stateConf.WaitForStateContext(ctx); err != nil {
 		return WrapErrorf(err, IdMsg, d.Id())
 	}
 	return nil
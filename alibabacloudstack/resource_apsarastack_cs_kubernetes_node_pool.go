@@ -1,6 +1,7 @@
 package alibabacloudstack
 
 import (
+	"context"
 	"encoding/base64"
 	"fmt"
 	"regexp"
@@ -23,12 +24,12 @@ const ResourceName = "resource_alibabacloudstack_cs_kubernetes_permissions"
 
 func resourceAlibabacloudStackCSKubernetesNodePool() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceAlibabacloudStackCSKubernetesNodePoolCreate,
-		Read:   resourceAlibabacloudStackCSNodePoolRead,
-		Update: resourceAlibabacloudStackCSNodePoolUpdate,
-		Delete: resourceAlibabacloudStackCSNodePoolDelete,
+		CreateContext: withDiagnostics(resourceAlibabacloudStackCSKubernetesNodePoolCreate),
+		ReadContext:   withDiagnostics(resourceAlibabacloudStackCSNodePoolRead),
+		UpdateContext: withDiagnostics(resourceAlibabacloudStackCSNodePoolUpdate),
+		DeleteContext: withDiagnostics(resourceAlibabacloudStackCSNodePoolDelete),
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			StateContext: schema.ImportStatePassthroughContext,
 		},
 
 		Timeouts: &schema.ResourceTimeout{
@@ -411,7 +412,7 @@ func resourceAlibabacloudStackCSKubernetesNodePool() *schema.Resource {
 	}
 }
 
-func resourceAlibabacloudStackCSKubernetesNodePoolCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceAlibabacloudStackCSKubernetesNodePoolCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*connectivity.AlibabacloudStackClient)
 	csService := CsService{client}
 	invoker := NewInvoker()
@@ -421,7 +422,7 @@ func resourceAlibabacloudStackCSKubernetesNodePoolCreate(d *schema.ResourceData,
 
 	clusterId := d.Get("cluster_id").(string)
 	// prepare args and set default value
-	args, err := buildNodePoolArgs(d, meta)
+	args, err := buildNodePoolArgs(ctx, d, meta)
 	if err != nil {
 		return WrapErrorf(err, DefaultErrorMsg, "alibabacloudstack_cs_kubernetes_node_pool", "PrepareKubernetesNodePoolArgs", err)
 	}
@@ -451,19 +452,19 @@ func resourceAlibabacloudStackCSKubernetesNodePoolCreate(d *schema.ResourceData,
 
 	// reset interval to 10s
 	stateConf := BuildStateConf([]string{"initial", "scaling"}, []string{"active"}, d.Timeout(schema.TimeoutCreate), 30*time.Second, csService.CsKubernetesNodePoolStateRefreshFunc(d.Id(), []string{"deleting", "failed"}))
-	if _, err := stateConf.WaitForState(); err != nil {
+	if _, err := stateConf.WaitForStateContext(ctx); err != nil {
 		return WrapErrorf(err, "ResourceID:%s , TaskID:%s ", d.Id(), nodePool.TaskID)
 	}
 
 	// attach existing node
 	if v, ok := d.GetOk("instances"); ok && v != nil {
-		attachExistingInstance(d, meta)
+		attachExistingInstance(ctx, d, meta)
 	}
 
-	return resourceAlibabacloudStackCSNodePoolRead(d, meta)
+	return resourceAlibabacloudStackCSNodePoolRead(ctx, d, meta)
 }
 
-func resourceAlibabacloudStackCSNodePoolUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceAlibabacloudStackCSNodePoolUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*connectivity.AlibabacloudStackClient)
 	csService := CsService{client}
 	vpcService := VpcService{client}
@@ -496,10 +497,10 @@ func resourceAlibabacloudStackCSNodePoolUpdate(d *schema.ResourceData, meta inte
 		}
 
 		if newValue < oldValue {
-			removeNodePoolNodes(d, meta, parts, nil, nil)
+			removeNodePoolNodes(ctx, d, meta, parts, nil, nil)
 			// The removal of a node is logically independent.
 			// The removal of a node should not involve parameter changes.
-			return resourceAlibabacloudStackCSNodePoolRead(d, meta)
+			return resourceAlibabacloudStackCSNodePoolRead(ctx, d, meta)
 		}
 		update = true
 		args.Count = int64(newValue) - int64(oldValue)
@@ -599,9 +600,9 @@ func resourceAlibabacloudStackCSNodePoolUpdate(d *schema.ResourceData, meta inte
 		setNodePoolDataDisks(&args.ScalingGroup, d)
 	}
 
-	if d.HasChange("tags") {
+	if hasTagsAllChange(d, client) {
 		update = true
-		setNodePoolTags(&args.ScalingGroup, d)
+		setNodePoolTags(&args.ScalingGroup, d, client)
 	}
 
 	if d.HasChange("labels") {
@@ -696,7 +697,7 @@ func resourceAlibabacloudStackCSNodePoolUpdate(d *schema.ResourceData, meta inte
 
 		stateConf := BuildStateConf([]string{"scaling", "updating"}, []string{"active"}, d.Timeout(schema.TimeoutUpdate), 30*time.Second, csService.CsKubernetesNodePoolStateRefreshFunc(d.Id(), []string{"deleting", "failed"}))
 
-		if _, err := stateConf.WaitForState(); err != nil {
+		if _, err := stateConf.WaitForStateContext(ctx); err != nil {
 			return WrapErrorf(err, IdMsg, d.Id())
 		}
 	}
@@ -714,18 +715,18 @@ func resourceAlibabacloudStackCSNodePoolUpdate(d *schema.ResourceData, meta inte
 		}
 
 		if len(newValue) > len(oldValue) {
-			attachExistingInstance(d, meta)
+			attachExistingInstance(ctx, d, meta)
 		} else {
-			removeNodePoolNodes(d, meta, parts, oldValue, newValue)
+			removeNodePoolNodes(ctx, d, meta, parts, oldValue, newValue)
 		}
 	}
 
 	update = false
 	d.Partial(false)
-	return resourceAlibabacloudStackCSNodePoolRead(d, meta)
+	return resourceAlibabacloudStackCSNodePoolRead(ctx, d, meta)
 }
 
-func resourceAlibabacloudStackCSNodePoolRead(d *schema.ResourceData, meta interface{}) error {
+func resourceAlibabacloudStackCSNodePoolRead(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*connectivity.AlibabacloudStackClient)
 	csService := CsService{client}
 
@@ -813,7 +814,7 @@ func resourceAlibabacloudStackCSNodePoolRead(d *schema.ResourceData, meta interf
 	return nil
 }
 
-func resourceAlibabacloudStackCSNodePoolDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceAlibabacloudStackCSNodePoolDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*connectivity.AlibabacloudStackClient)
 	csService := CsService{client}
 	invoker := NewInvoker()
@@ -824,10 +825,10 @@ func resourceAlibabacloudStackCSNodePoolDelete(d *schema.ResourceData, meta inte
 	}
 
 	// delete all nodes
-	removeNodePoolNodes(d, meta, parts, nil, nil)
+	removeNodePoolNodes(ctx, d, meta, parts, nil, nil)
 
 	var response interface{}
-	err = resource.Retry(30*time.Minute, func() *resource.RetryError {
+	err = resource.RetryContext(ctx, 30*time.Minute, func() *resource.RetryError {
 		if err := invoker.Run(func() error {
 			raw, err := client.WithCsClient(func(csClient *cs.Client) (interface{}, error) {
 				return nil, csClient.DeleteNodePool(parts[0], parts[1])
@@ -853,13 +854,13 @@ func resourceAlibabacloudStackCSNodePoolDelete(d *schema.ResourceData, meta inte
 	}
 
 	stateConf := BuildStateConf([]string{"active", "deleting"}, []string{}, d.Timeout(schema.TimeoutDelete), 30*time.Second, csService.CsKubernetesNodePoolStateRefreshFunc(d.Id(), []string{}))
-	if _, err := stateConf.WaitForState(); err != nil {
+	if _, err := stateConf.WaitForStateContext(ctx); err != nil {
 		return WrapErrorf(err, IdMsg, d.Id())
 	}
 	return nil
 }
 
-func buildNodePoolArgs(d *schema.ResourceData, meta interface{}) (*cs.CreateNodePoolRequest, error) {
+func buildNodePoolArgs(ctx context.Context, d *schema.ResourceData, meta interface{}) (*cs.CreateNodePoolRequest, error) {
 	client := meta.(*connectivity.AlibabacloudStackClient)
 
 	vpcService := VpcService{client}
@@ -884,7 +885,7 @@ func buildNodePoolArgs(d *schema.ResourceData, meta interface{}) (*cs.CreateNode
 	if password == "" {
 		if v := d.Get("kms_encrypted_password").(string); v != "" {
 			kmsService := KmsService{client}
-			decryptResp, err := kmsService.Decrypt2(v, d.Get("kms_encryption_context").(map[string]interface{}))
+			decryptResp, err := kmsService.Decrypt2(ctx, v, d.Get("kms_encryption_context").(map[string]interface{}))
 			if err != nil {
 				return nil, WrapError(err)
 			}
@@ -917,7 +918,7 @@ func buildNodePoolArgs(d *schema.ResourceData, meta interface{}) (*cs.CreateNode
 	}
 
 	setNodePoolDataDisks(&creationArgs.ScalingGroup, d)
-	setNodePoolTags(&creationArgs.ScalingGroup, d)
+	setNodePoolTags(&creationArgs.ScalingGroup, d, client)
 	setNodePoolTaints(&creationArgs.KubernetesConfig, d)
 	setNodePoolLabels(&creationArgs.KubernetesConfig, d)
 
@@ -992,18 +993,15 @@ func buildNodePoolArgs(d *schema.ResourceData, meta interface{}) (*cs.CreateNode
 	return creationArgs, nil
 }
 
-func ConvertCsTags(d *schema.ResourceData) ([]cs.Tag, error) {
+func ConvertCsTags(d *schema.ResourceData, client *connectivity.AlibabacloudStackClient) ([]cs.Tag, error) {
 	tags := make([]cs.Tag, 0)
-	tagsMap, ok := d.Get("tags").(map[string]interface{})
-	if ok {
-		for key, value := range tagsMap {
-			if value != nil {
-				if v, ok := value.(string); ok {
-					tags = append(tags, cs.Tag{
-						Key:   key,
-						Value: v,
-					})
-				}
+	for key, value := range getTagsAll(d, client) {
+		if value != nil {
+			if v, ok := value.(string); ok {
+				tags = append(tags, cs.Tag{
+					Key:   key,
+					Value: v,
+				})
 			}
 		}
 	}
@@ -1011,9 +1009,9 @@ func ConvertCsTags(d *schema.ResourceData) ([]cs.Tag, error) {
 	return tags, nil
 }
 
-func setNodePoolTags(scalingGroup *cs.ScalingGroup, d *schema.ResourceData) error {
-	if _, ok := d.GetOk("tags"); ok {
-		if tags, err := ConvertCsTags(d); err == nil {
+func setNodePoolTags(scalingGroup *cs.ScalingGroup, d *schema.ResourceData, client *connectivity.AlibabacloudStackClient) error {
+	if _, ok := getTagsAllOk(d, client); ok {
+		if tags, err := ConvertCsTags(d, client); err == nil {
 			scalingGroup.Tags = tags
 		}
 	}
@@ -1270,7 +1268,7 @@ func flattenTagsConfig(config []cs.Tag) map[string]string {
 	return m
 }
 
-func removeNodePoolNodes(d *schema.ResourceData, meta interface{}, parseId []string, oldNodes []interface{}, newNodes []interface{}) error {
+func removeNodePoolNodes(ctx context.Context, d *schema.ResourceData, meta interface{}, parseId []string, oldNodes []interface{}, newNodes []interface{}) error {
 	client := meta.(*connectivity.AlibabacloudStackClient)
 	csService := CsService{client}
 	invoker := NewInvoker()
@@ -1341,7 +1339,7 @@ func removeNodePoolNodes(d *schema.ResourceData, meta interface{}, parseId []str
 	}
 
 	stateConf := BuildStateConf([]string{"removing"}, []string{"active"}, d.Timeout(schema.TimeoutUpdate), 30*time.Second, csService.CsKubernetesNodePoolStateRefreshFunc(d.Id(), []string{"deleting", "failed"}))
-	if _, err := stateConf.WaitForState(); err != nil {
+	if _, err := stateConf.WaitForStateContext(ctx); err != nil {
 		return WrapErrorf(err, IdMsg, d.Id())
 	}
 
@@ -1372,7 +1370,7 @@ func difference(slice1 []string, slice2 []string) []string {
 
 	return diff
 }
-func attachExistingInstance(d *schema.ResourceData, meta interface{}) error {
+func attachExistingInstance(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 	csService := CsService{meta.(*connectivity.AlibabacloudStackClient)}
 	client, err := meta.(*connectivity.AlibabacloudStackClient).NewRoaCsClient()
 	if err != nil {
@@ -1422,7 +1420,7 @@ func attachExistingInstance(d *schema.ResourceData, meta interface{}) error {
 	}
 
 	stateConf := BuildStateConf([]string{"scaling"}, []string{"active"}, d.Timeout(schema.TimeoutUpdate), 30*time.Second, csService.CsKubernetesNodePoolStateRefreshFunc(d.Id(), []string{"deleting", "failed"}))
-	if _, err := stateConf.WaitForState(); err != nil {
+	if _, err := stateConf.WaitForStateContext(ctx); err != nil {
 		return WrapErrorf(err, IdMsg, d.Id())
 	}
 
@@ -115,6 +115,16 @@ func TestAccAlibabacloudStackCsK8s_Basic(t *testing.T) {
 				ImportState:       true,
 				ImportStateVerify: true,
 			},
+			{
+				Config: testAccConfig(map[string]interface{}{
+					"version": "1.20.11-aliyun.1",
+				}),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheck(map[string]string{
+						"version": "1.20.11-aliyun.1",
+					}),
+				),
+			},
 			{
 				Config: testAccConfig(map[string]interface{}{
 					"detail": "detail",
@@ -1,6 +1,7 @@
 package alibabacloudstack
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
@@ -15,12 +16,12 @@ import (
 
 func resourceAlibabacloudStackDataWorksConnection() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceAlibabacloudStackDataWorksConnectionCreate,
-		Read:   resourceAlibabacloudStackDataWorksConnectionRead,
-		Update: resourceAlibabacloudStackDataWorksConnectionUpdate,
-		Delete: resourceAlibabacloudStackDataWorksConnectionDelete,
+		CreateContext: withDiagnostics(resourceAlibabacloudStackDataWorksConnectionCreate),
+		ReadContext:   withDiagnostics(resourceAlibabacloudStackDataWorksConnectionRead),
+		UpdateContext: withDiagnostics(resourceAlibabacloudStackDataWorksConnectionUpdate),
+		DeleteContext: withDiagnostics(resourceAlibabacloudStackDataWorksConnectionDelete),
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			StateContext: schema.ImportStatePassthroughContext,
 		},
 		Schema: map[string]*schema.Schema{
 			"connection_id": {
@@ -65,7 +66,7 @@ func resourceAlibabacloudStackDataWorksConnection() *schema.Resource {
 	}
 }
 
-func resourceAlibabacloudStackDataWorksConnectionCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceAlibabacloudStackDataWorksConnectionCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*connectivity.AlibabacloudStackClient)
 	var response map[string]interface{}
 	action := "CreateConnection"
@@ -105,7 +106,7 @@ func resourceAlibabacloudStackDataWorksConnectionCreate(d *schema.ResourceData,
 
 	request["RegionId"] = "default"
 	wait := incrementalWait(3*time.Second, 3*time.Second)
-	err = resource.Retry(d.Timeout(schema.TimeoutCreate), func() *resource.RetryError {
+	err = resource.RetryContext(ctx, d.Timeout(schema.TimeoutCreate), func() *resource.RetryError {
 		response, err = conn.DoRequest(StringPointer(action), nil, StringPointer("POST"), StringPointer("2020-05-18"), StringPointer("AK"), nil, request, &util.RuntimeOptions{})
 		if err != nil {
 			if NeedRetry(err) {
@@ -123,9 +124,9 @@ func resourceAlibabacloudStackDataWorksConnectionCreate(d *schema.ResourceData,
 
 	d.SetId(fmt.Sprint(response["Data"], ":", request["ProjectId"], ":", request["Name"]))
 
-	return resourceAlibabacloudStackDataWorksConnectionRead(d, meta)
+	return resourceAlibabacloudStackDataWorksConnectionRead(ctx, d, meta)
 }
-func resourceAlibabacloudStackDataWorksConnectionRead(d *schema.ResourceData, meta interface{}) error {
+func resourceAlibabacloudStackDataWorksConnectionRead(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*connectivity.AlibabacloudStackClient)
 	dataworksPublicService := DataworksPublicService{client}
 	object, err := dataworksPublicService.DescribeDataWorksConnection(d.Id())
@@ -152,7 +153,7 @@ func resourceAlibabacloudStackDataWorksConnectionRead(d *schema.ResourceData, me
 	//d.Set("content", tempMap)
 	return nil
 }
-func resourceAlibabacloudStackDataWorksConnectionUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceAlibabacloudStackDataWorksConnectionUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*connectivity.AlibabacloudStackClient)
 	var response map[string]interface{}
 	parts, err := ParseResourceId(d.Id(), 3)
@@ -186,7 +187,7 @@ func resourceAlibabacloudStackDataWorksConnectionUpdate(d *schema.ResourceData,
 		return WrapError(err)
 	}
 	wait := incrementalWait(3*time.Second, 3*time.Second)
-	err = resource.Retry(d.Timeout(schema.TimeoutUpdate), func() *resource.RetryError {
+	err = resource.RetryContext(ctx, d.Timeout(schema.TimeoutUpdate), func() *resource.RetryError {
 		response, err = conn.DoRequest(StringPointer(action), nil, StringPointer("PUT"), StringPointer("2020-05-18"), StringPointer("AK"), nil, request, &util.RuntimeOptions{})
 		if err != nil {
 			if NeedRetry(err) {
@@ -201,9 +202,9 @@ func resourceAlibabacloudStackDataWorksConnectionUpdate(d *schema.ResourceData,
 	if err != nil {
 		return WrapErrorf(err, DefaultErrorMsg, d.Id(), action, AlibabacloudStackSdkGoERROR)
 	}
-	return resourceAlibabacloudStackDataWorksConnectionRead(d, meta)
+	return resourceAlibabacloudStackDataWorksConnectionRead(ctx, d, meta)
 }
-func resourceAlibabacloudStackDataWorksConnectionDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceAlibabacloudStackDataWorksConnectionDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*connectivity.AlibabacloudStackClient)
 	parts, err := ParseResourceId(d.Id(), 3)
 	if err != nil {
@@ -221,7 +222,7 @@ func resourceAlibabacloudStackDataWorksConnectionDelete(d *schema.ResourceData,
 
 	request["RegionId"] = "default"
 	wait := incrementalWait(3*time.Second, 3*time.Second)
-	err = resource.Retry(d.Timeout(schema.TimeoutDelete), func() *resource.RetryError {
+	err = resource.RetryContext(ctx, d.Timeout(schema.TimeoutDelete), func() *resource.RetryError {
 		response, err = conn.DoRequest(StringPointer(action), nil, StringPointer("POST"), StringPointer("2020-05-18"), StringPointer("AK"), nil, request, &util.RuntimeOptions{})
 		if err != nil {
 			if NeedRetry(err) {
@@ -1,6 +1,7 @@
 package alibabacloudstack
 
 import (
+	"context"
 	"fmt"
 	"log"
 	"path"
@@ -15,12 +16,12 @@ import (
 
 func resourceAlibabacloudStackDataWorksFolder() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceAlibabacloudStackDataWorksFolderCreate,
-		Read:   resourceAlibabacloudStackDataWorksFolderRead,
-		Update: resourceAlibabacloudStackDataWorksFolderUpdate,
-		Delete: resourceAlibabacloudStackDataWorksFolderDelete,
+		CreateContext: withDiagnostics(resourceAlibabacloudStackDataWorksFolderCreate),
+		ReadContext:   withDiagnostics(resourceAlibabacloudStackDataWorksFolderRead),
+		UpdateContext: withDiagnostics(resourceAlibabacloudStackDataWorksFolderUpdate),
+		DeleteContext: withDiagnostics(resourceAlibabacloudStackDataWorksFolderDelete),
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			StateContext: schema.ImportStatePassthroughContext,
 		},
 		Schema: map[string]*schema.Schema{
 			"folder_id": {
@@ -45,7 +46,7 @@ func resourceAlibabacloudStackDataWorksFolder() *schema.Resource {
 	}
 }
 
-func resourceAlibabacloudStackDataWorksFolderCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceAlibabacloudStackDataWorksFolderCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*connectivity.AlibabacloudStackClient)
 	var response map[string]interface{}
 	action := "CreateFolder"
@@ -64,7 +65,7 @@ func resourceAlibabacloudStackDataWorksFolderCreate(d *schema.ResourceData, meta
 	}
 	request["RegionId"] = "default"
 	wait := incrementalWait(3*time.Second, 3*time.Second)
-	err = resource.Retry(d.Timeout(schema.TimeoutCreate), func() *resource.RetryError {
+	err = resource.RetryContext(ctx, d.Timeout(schema.TimeoutCreate), func() *resource.RetryError {
 		response, err = conn.DoRequest(StringPointer(action), nil, StringPointer("POST"), StringPointer("2020-05-18"), StringPointer("AK"), nil, request, &util.RuntimeOptions{})
 		if err != nil {
 			if NeedRetry(err) {
@@ -82,9 +83,9 @@ func resourceAlibabacloudStackDataWorksFolderCreate(d *schema.ResourceData, meta
 
 	d.SetId(fmt.Sprint(response["Data"], ":", request["ProjectId"]))
 
-	return resourceAlibabacloudStackDataWorksFolderRead(d, meta)
+	return resourceAlibabacloudStackDataWorksFolderRead(ctx, d, meta)
 }
-func resourceAlibabacloudStackDataWorksFolderRead(d *schema.ResourceData, meta interface{}) error {
+func resourceAlibabacloudStackDataWorksFolderRead(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*connectivity.AlibabacloudStackClient)
 	dataworksPublicService := DataworksPublicService{client}
 	object, err := dataworksPublicService.DescribeDataWorksFolder(d.Id())
@@ -105,7 +106,7 @@ func resourceAlibabacloudStackDataWorksFolderRead(d *schema.ResourceData, meta i
 	d.Set("folder_path", object["FolderPath"].(string))
 	return nil
 }
-func resourceAlibabacloudStackDataWorksFolderUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceAlibabacloudStackDataWorksFolderUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*connectivity.AlibabacloudStackClient)
 	var response map[string]interface{}
 	parts, err := ParseResourceId(d.Id(), 2)
@@ -132,7 +133,7 @@ func resourceAlibabacloudStackDataWorksFolderUpdate(d *schema.ResourceData, meta
 		return WrapError(err)
 	}
 	wait := incrementalWait(3*time.Second, 3*time.Second)
-	err = resource.Retry(d.Timeout(schema.TimeoutUpdate), func() *resource.RetryError {
+	err = resource.RetryContext(ctx, d.Timeout(schema.TimeoutUpdate), func() *resource.RetryError {
 		response, err = conn.DoRequest(StringPointer(action), nil, StringPointer("POST"), StringPointer("2020-05-18"), StringPointer("AK"), nil, request, &util.RuntimeOptions{})
 		if err != nil {
 			if NeedRetry(err) {
@@ -147,9 +148,9 @@ func resourceAlibabacloudStackDataWorksFolderUpdate(d *schema.ResourceData, meta
 	if err != nil {
 		return WrapErrorf(err, DefaultErrorMsg, d.Id(), action, AlibabacloudStackSdkGoERROR)
 	}
-	return resourceAlibabacloudStackDataWorksFolderRead(d, meta)
+	return resourceAlibabacloudStackDataWorksFolderRead(ctx, d, meta)
 }
-func resourceAlibabacloudStackDataWorksFolderDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceAlibabacloudStackDataWorksFolderDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*connectivity.AlibabacloudStackClient)
 	parts, err := ParseResourceId(d.Id(), 2)
 	if err != nil {
@@ -171,7 +172,7 @@ func resourceAlibabacloudStackDataWorksFolderDelete(d *schema.ResourceData, meta
 	}
 	request["RegionId"] = "default"
 	wait := incrementalWait(3*time.Second, 3*time.Second)
-	err = resource.Retry(d.Timeout(schema.TimeoutDelete), func() *resource.RetryError {
+	err = resource.RetryContext(ctx, d.Timeout(schema.TimeoutDelete), func() *resource.RetryError {
 		response, err = conn.DoRequest(StringPointer(action), nil, StringPointer("POST"), StringPointer("2020-05-18"), StringPointer("AK"), nil, request, &util.RuntimeOptions{})
 		if err != nil {
 			if NeedRetry(err) {
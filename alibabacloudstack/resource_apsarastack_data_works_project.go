@@ -1,6 +1,7 @@
 package alibabacloudstack
 
 import (
+	"context"
 	"fmt"
 	"log"
 	"time"
@@ -13,12 +14,12 @@ import (
 
 func resourceAlibabacloudStackDataWorksProject() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceAlibabacloudStackDataWorksProjectCreate,
-		Read:   resourceAlibabacloudStackDataWorksProjectRead,
-		Update: resourceAlibabacloudStackDataWorksProjectUpdate,
-		Delete: resourceAlibabacloudStackDataWorksProjectDelete,
+		CreateContext: withDiagnostics(resourceAlibabacloudStackDataWorksProjectCreate),
+		ReadContext:   withDiagnostics(resourceAlibabacloudStackDataWorksProjectRead),
+		UpdateContext: withDiagnostics(resourceAlibabacloudStackDataWorksProjectUpdate),
+		DeleteContext: withDiagnostics(resourceAlibabacloudStackDataWorksProjectDelete),
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			StateContext: schema.ImportStatePassthroughContext,
 		},
 		Schema: map[string]*schema.Schema{
 			"project_id": {
@@ -38,7 +39,7 @@ func resourceAlibabacloudStackDataWorksProject() *schema.Resource {
 	}
 }
 
-func resourceAlibabacloudStackDataWorksProjectCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceAlibabacloudStackDataWorksProjectCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*connectivity.AlibabacloudStackClient)
 	var response map[string]interface{}
 	action := "CreateProject"
@@ -59,7 +60,7 @@ func resourceAlibabacloudStackDataWorksProjectCreate(d *schema.ResourceData, met
 
 	request["RegionId"] = "default"
 	wait := incrementalWait(3*time.Second, 3*time.Second)
-	err = resource.Retry(d.Timeout(schema.TimeoutCreate), func() *resource.RetryError {
+	err = resource.RetryContext(ctx, d.Timeout(schema.TimeoutCreate), func() *resource.RetryError {
 		response, err = conn.DoRequest(StringPointer(action), nil, StringPointer("POST"), StringPointer("2019-01-17"), StringPointer("AK"), nil, request, &util.RuntimeOptions{})
 		if err != nil {
 			if NeedRetry(err) {
@@ -77,9 +78,9 @@ func resourceAlibabacloudStackDataWorksProjectCreate(d *schema.ResourceData, met
 
 	d.SetId(fmt.Sprint(response["RequestId"], ":", response["Data"]))
 
-	return resourceAlibabacloudStackDataWorksProjectRead(d, meta)
+	return resourceAlibabacloudStackDataWorksProjectRead(ctx, d, meta)
 }
-func resourceAlibabacloudStackDataWorksProjectRead(d *schema.ResourceData, meta interface{}) error {
+func resourceAlibabacloudStackDataWorksProjectRead(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*connectivity.AlibabacloudStackClient)
 	dataworksPublicService := DataworksPublicService{client}
 	object, err := dataworksPublicService.DescribeDataWorksProject(d.Id())
@@ -100,11 +101,11 @@ func resourceAlibabacloudStackDataWorksProjectRead(d *schema.ResourceData, meta
 
 	return nil
 }
-func resourceAlibabacloudStackDataWorksProjectUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceAlibabacloudStackDataWorksProjectUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 	// 没有对应 API
-	return resourceAlibabacloudStackDataWorksProjectRead(d, meta)
+	return resourceAlibabacloudStackDataWorksProjectRead(ctx, d, meta)
 }
-func resourceAlibabacloudStackDataWorksProjectDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceAlibabacloudStackDataWorksProjectDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 	// 没有对应 API
 	return nil
 }
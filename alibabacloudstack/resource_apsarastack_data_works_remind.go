@@ -1,6 +1,7 @@
 package alibabacloudstack
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
@@ -16,12 +17,12 @@ import (
 
 func resourceAlibabacloudStackDataWorksRemind() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceAlibabacloudStackDataWorksRemindCreate,
-		Read:   resourceAlibabacloudStackDataWorksRemindRead,
-		Update: resourceAlibabacloudStackDataWorksRemindUpdate,
-		Delete: resourceAlibabacloudStackDataWorksRemindDelete,
+		CreateContext: withDiagnostics(resourceAlibabacloudStackDataWorksRemindCreate),
+		ReadContext:   withDiagnostics(resourceAlibabacloudStackDataWorksRemindRead),
+		UpdateContext: withDiagnostics(resourceAlibabacloudStackDataWorksRemindUpdate),
+		DeleteContext: withDiagnostics(resourceAlibabacloudStackDataWorksRemindDelete),
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			StateContext: schema.ImportStatePassthroughContext,
 		},
 		Schema: map[string]*schema.Schema{
 			"remind_id": {
@@ -111,7 +112,7 @@ func resourceAlibabacloudStackDataWorksRemind() *schema.Resource {
 var RemindUnit string
 var RemindType string
 
-func resourceAlibabacloudStackDataWorksRemindCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceAlibabacloudStackDataWorksRemindCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*connectivity.AlibabacloudStackClient)
 	var response map[string]interface{}
 	action := "CreateRemind"
@@ -122,7 +123,7 @@ func resourceAlibabacloudStackDataWorksRemindCreate(d *schema.ResourceData, meta
 
 	request := buildRemindArgs(d)
 	wait := incrementalWait(3*time.Second, 3*time.Second)
-	err = resource.Retry(d.Timeout(schema.TimeoutCreate), func() *resource.RetryError {
+	err = resource.RetryContext(ctx, d.Timeout(schema.TimeoutCreate), func() *resource.RetryError {
 		response, err = conn.DoRequest(StringPointer(action), nil, StringPointer("POST"), StringPointer("2020-05-18"), StringPointer("AK"), nil, request, &util.RuntimeOptions{})
 		if err != nil {
 			if NeedRetry(err) {
@@ -140,9 +141,9 @@ func resourceAlibabacloudStackDataWorksRemindCreate(d *schema.ResourceData, meta
 
 	d.SetId(fmt.Sprint(response["Data"]))
 
-	return resourceAlibabacloudStackDataWorksRemindRead(d, meta)
+	return resourceAlibabacloudStackDataWorksRemindRead(ctx, d, meta)
 }
-func resourceAlibabacloudStackDataWorksRemindRead(d *schema.ResourceData, meta interface{}) error {
+func resourceAlibabacloudStackDataWorksRemindRead(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*connectivity.AlibabacloudStackClient)
 	dataworksPublicService := DataworksPublicService{client}
 	object, err := dataworksPublicService.DescribeDataWorksRemind(d.Id())
@@ -195,7 +196,7 @@ func resourceAlibabacloudStackDataWorksRemindRead(d *schema.ResourceData, meta i
 	return nil
 }
 
-func resourceAlibabacloudStackDataWorksRemindUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceAlibabacloudStackDataWorksRemindUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*connectivity.AlibabacloudStackClient)
 	request := make(map[string]interface{})
 	var response map[string]interface{}
@@ -255,7 +256,7 @@ func resourceAlibabacloudStackDataWorksRemindUpdate(d *schema.ResourceData, meta
 		return WrapError(err)
 	}
 	wait := incrementalWait(3*time.Second, 3*time.Second)
-	err = resource.Retry(d.Timeout(schema.TimeoutUpdate), func() *resource.RetryError {
+	err = resource.RetryContext(ctx, d.Timeout(schema.TimeoutUpdate), func() *resource.RetryError {
 		response, err = conn.DoRequest(StringPointer(action), nil, StringPointer("POST"), StringPointer("2020-05-18"), StringPointer("AK"), nil, request, &util.RuntimeOptions{})
 		if err != nil {
 			if NeedRetry(err) {
@@ -270,10 +271,10 @@ func resourceAlibabacloudStackDataWorksRemindUpdate(d *schema.ResourceData, meta
 	if err != nil {
 		return WrapErrorf(err, DefaultErrorMsg, d.Id(), action, AlibabacloudStackSdkGoERROR)
 	}
-	return resourceAlibabacloudStackDataWorksRemindRead(d, meta)
+	return resourceAlibabacloudStackDataWorksRemindRead(ctx, d, meta)
 }
 
-func resourceAlibabacloudStackDataWorksRemindDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceAlibabacloudStackDataWorksRemindDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*connectivity.AlibabacloudStackClient)
 
 	action := "DeleteRemind"
@@ -288,7 +289,7 @@ func resourceAlibabacloudStackDataWorksRemindDelete(d *schema.ResourceData, meta
 	}
 
 	wait := incrementalWait(3*time.Second, 3*time.Second)
-	err = resource.Retry(d.Timeout(schema.TimeoutDelete), func() *resource.RetryError {
+	err = resource.RetryContext(ctx, d.Timeout(schema.TimeoutDelete), func() *resource.RetryError {
 		response, err = conn.DoRequest(StringPointer(action), nil, StringPointer("POST"), StringPointer("2020-05-18"), StringPointer("AK"), nil, request, &util.RuntimeOptions{})
 		if err != nil {
 			if NeedRetry(err) {
@@ -1,6 +1,7 @@
 package alibabacloudstack
 
 import (
+	"context"
 	"fmt"
 	"github.com/google/uuid"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
@@ -15,12 +16,12 @@ import (
 
 func resourceAlibabacloudStackDataWorksUser() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceAlibabacloudStackDataWorksUserCreate,
-		Read:   resourceAlibabacloudStackDataWorksUserRead,
-		Update: resourceAlibabacloudStackDataWorksUserUpdate,
-		Delete: resourceAlibabacloudStackDataWorksUserDelete,
+		CreateContext: withDiagnostics(resourceAlibabacloudStackDataWorksUserCreate),
+		ReadContext:   withDiagnostics(resourceAlibabacloudStackDataWorksUserRead),
+		UpdateContext: withDiagnostics(resourceAlibabacloudStackDataWorksUserUpdate),
+		DeleteContext: withDiagnostics(resourceAlibabacloudStackDataWorksUserDelete),
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			StateContext: schema.ImportStatePassthroughContext,
 		},
 		Schema: map[string]*schema.Schema{
 			"project_id": {
@@ -40,7 +41,7 @@ func resourceAlibabacloudStackDataWorksUser() *schema.Resource {
 	}
 }
 
-func resourceAlibabacloudStackDataWorksUserCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceAlibabacloudStackDataWorksUserCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*connectivity.AlibabacloudStackClient)
 	var response map[string]interface{}
 	action := "CreateProjectMember"
@@ -64,7 +65,7 @@ func resourceAlibabacloudStackDataWorksUserCreate(d *schema.ResourceData, meta i
 	request["RegionId"] = "default"
 	request["ClientToken"] = fmt.Sprint(uuid.NewRandom())
 	wait := incrementalWait(3*time.Second, 3*time.Second)
-	err = resource.Retry(d.Timeout(schema.TimeoutCreate), func() *resource.RetryError {
+	err = resource.RetryContext(ctx, d.Timeout(schema.TimeoutCreate), func() *resource.RetryError {
 		response, err = conn.DoRequest(StringPointer(action), nil, StringPointer("POST"), StringPointer("2020-05-18"), StringPointer("AK"), nil, request, &util.RuntimeOptions{})
 		if err != nil {
 			if NeedRetry(err) {
@@ -82,9 +83,9 @@ func resourceAlibabacloudStackDataWorksUserCreate(d *schema.ResourceData, meta i
 
 	d.SetId(fmt.Sprint(response["RequestId"], ":", request["ProjectId"], ":", request["UserId"]))
 
-	return resourceAlibabacloudStackDataWorksUserRead(d, meta)
+	return resourceAlibabacloudStackDataWorksUserRead(ctx, d, meta)
 }
-func resourceAlibabacloudStackDataWorksUserRead(d *schema.ResourceData, meta interface{}) error {
+func resourceAlibabacloudStackDataWorksUserRead(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*connectivity.AlibabacloudStackClient)
 	dataworksPublicService := DataworksPublicService{client}
 	object, err := dataworksPublicService.DescribeDataWorksUser(d.Id())
@@ -106,11 +107,11 @@ func resourceAlibabacloudStackDataWorksUserRead(d *schema.ResourceData, meta int
 
 	return nil
 }
-func resourceAlibabacloudStackDataWorksUserUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceAlibabacloudStackDataWorksUserUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 	// 没有对应 API
-	return resourceAlibabacloudStackDataWorksUserRead(d, meta)
+	return resourceAlibabacloudStackDataWorksUserRead(ctx, d, meta)
 }
-func resourceAlibabacloudStackDataWorksUserDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceAlibabacloudStackDataWorksUserDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*connectivity.AlibabacloudStackClient)
 	parts, err := ParseResourceId(d.Id(), 3)
 	if err != nil {
@@ -129,7 +130,7 @@ func resourceAlibabacloudStackDataWorksUserDelete(d *schema.ResourceData, meta i
 
 	request["RegionId"] = "default"
 	wait := incrementalWait(3*time.Second, 3*time.Second)
-	err = resource.Retry(d.Timeout(schema.TimeoutDelete), func() *resource.RetryError {
+	err = resource.RetryContext(ctx, d.Timeout(schema.TimeoutDelete), func() *resource.RetryError {
 		response, err = conn.DoRequest(StringPointer(action), nil, StringPointer("POST"), StringPointer("2020-05-18"), StringPointer("AK"), nil, request, &util.RuntimeOptions{})
 		if err != nil {
 			if NeedRetry(err) {
@@ -1,6 +1,7 @@
 package alibabacloudstack
 
 import (
+	"context"
 	"fmt"
 	"github.com/google/uuid"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
@@ -15,12 +16,12 @@ import (
 
 func resourceAlibabacloudStackDataWorksUserRoleBinding() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceAlibabacloudStackDataWorksUserRoleBindingCreate,
-		Read:   resourceAlibabacloudStackDataWorksUserRoleBindingRead,
-		Update: resourceAlibabacloudStackDataWorksUserRoleBindingUpdate,
-		Delete: resourceAlibabacloudStackDataWorksUserRoleBindingDelete,
+		CreateContext: withDiagnostics(resourceAlibabacloudStackDataWorksUserRoleBindingCreate),
+		ReadContext:   withDiagnostics(resourceAlibabacloudStackDataWorksUserRoleBindingRead),
+		UpdateContext: withDiagnostics(resourceAlibabacloudStackDataWorksUserRoleBindingUpdate),
+		DeleteContext: withDiagnostics(resourceAlibabacloudStackDataWorksUserRoleBindingDelete),
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			StateContext: schema.ImportStatePassthroughContext,
 		},
 		Schema: map[string]*schema.Schema{
 			"project_id": {
@@ -40,7 +41,7 @@ func resourceAlibabacloudStackDataWorksUserRoleBinding() *schema.Resource {
 	}
 }
 
-func resourceAlibabacloudStackDataWorksUserRoleBindingCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceAlibabacloudStackDataWorksUserRoleBindingCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*connectivity.AlibabacloudStackClient)
 	var response map[string]interface{}
 	action := "AddProjectMemberToRole"
@@ -64,7 +65,7 @@ func resourceAlibabacloudStackDataWorksUserRoleBindingCreate(d *schema.ResourceD
 	request["RegionId"] = "default"
 	request["ClientToken"] = fmt.Sprint(uuid.NewRandom())
 	wait := incrementalWait(3*time.Second, 3*time.Second)
-	err = resource.Retry(d.Timeout(schema.TimeoutCreate), func() *resource.RetryError {
+	err = resource.RetryContext(ctx, d.Timeout(schema.TimeoutCreate), func() *resource.RetryError {
 		response, err = conn.DoRequest(StringPointer(action), nil, StringPointer("POST"), StringPointer("2020-05-18"), StringPointer("AK"), nil, request, &util.RuntimeOptions{})
 		if err != nil {
 			if NeedRetry(err) {
@@ -82,9 +83,9 @@ func resourceAlibabacloudStackDataWorksUserRoleBindingCreate(d *schema.ResourceD
 
 	d.SetId(fmt.Sprint(request["RoleCode"], ":", request["ProjectId"], ":", request["UserId"]))
 
-	return resourceAlibabacloudStackDataWorksUserRoleBindingRead(d, meta)
+	return resourceAlibabacloudStackDataWorksUserRoleBindingRead(ctx, d, meta)
 }
-func resourceAlibabacloudStackDataWorksUserRoleBindingRead(d *schema.ResourceData, meta interface{}) error {
+func resourceAlibabacloudStackDataWorksUserRoleBindingRead(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*connectivity.AlibabacloudStackClient)
 	dataworksPublicService := DataworksPublicService{client}
 	object, err := dataworksPublicService.DescribeDataWorksUserRoleBinding(d.Id())
@@ -107,11 +108,11 @@ func resourceAlibabacloudStackDataWorksUserRoleBindingRead(d *schema.ResourceDat
 
 	return nil
 }
-func resourceAlibabacloudStackDataWorksUserRoleBindingUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceAlibabacloudStackDataWorksUserRoleBindingUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 	// 没有对应 API
-	return resourceAlibabacloudStackDataWorksUserRoleBindingRead(d, meta)
+	return resourceAlibabacloudStackDataWorksUserRoleBindingRead(ctx, d, meta)
 }
-func resourceAlibabacloudStackDataWorksUserRoleBindingDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceAlibabacloudStackDataWorksUserRoleBindingDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*connectivity.AlibabacloudStackClient)
 	parts, err := ParseResourceId(d.Id(), 3)
 	if err != nil {
@@ -131,7 +132,7 @@ func resourceAlibabacloudStackDataWorksUserRoleBindingDelete(d *schema.ResourceD
 
 	request["RegionId"] = "default"
 	wait := incrementalWait(3*time.Second, 3*time.Second)
-	err = resource.Retry(d.Timeout(schema.TimeoutDelete), func() *resource.RetryError {
+	err = resource.RetryContext(ctx, d.Timeout(schema.TimeoutDelete), func() *resource.RetryError {
 		response, err = conn.DoRequest(StringPointer(action), nil, StringPointer("POST"), StringPointer("2020-05-18"), StringPointer("AK"), nil, request, &util.RuntimeOptions{})
 		if err != nil {
 			if NeedRetry(err) {
@@ -1,6 +1,7 @@
 package alibabacloudstack
 
 import (
+	"context"
 	"github.com/aliyun/alibaba-cloud-sdk-go/sdk/requests"
 	"github.com/aliyun/alibaba-cloud-sdk-go/services/ecs"
 	"strconv"
@@ -18,12 +19,12 @@ import (
 
 func resourceAlibabacloudStackDatahubProject() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceAlibabacloudStackDatahubProjectCreate,
-		Read:   resourceAlibabacloudStackDatahubProjectRead,
-		Update: resourceAlibabacloudStackDatahubProjectUpdate,
-		Delete: resourceAlibabacloudStackDatahubProjectDelete,
+		CreateContext: withDiagnostics(resourceAlibabacloudStackDatahubProjectCreate),
+		ReadContext:   withDiagnostics(resourceAlibabacloudStackDatahubProjectRead),
+		UpdateContext: withDiagnostics(resourceAlibabacloudStackDatahubProjectUpdate),
+		DeleteContext: withDiagnostics(resourceAlibabacloudStackDatahubProjectDelete),
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			StateContext: schema.ImportStatePassthroughContext,
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -57,7 +58,7 @@ func resourceAlibabacloudStackDatahubProject() *schema.Resource {
 	}
 }
 
-func resourceAlibabacloudStackDatahubProjectCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceAlibabacloudStackDatahubProjectCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*connectivity.AlibabacloudStackClient)
 	//var dataService DatahubService = DatahubService{client: client}
 	projectName := d.Get("name").(string)
@@ -101,10 +102,10 @@ func resourceAlibabacloudStackDatahubProjectCreate(d *schema.ResourceData, meta
 	}
 
 	d.SetId(strings.ToLower(projectName))
-	return resourceAlibabacloudStackDatahubProjectRead(d, meta)
+	return resourceAlibabacloudStackDatahubProjectRead(ctx, d, meta)
 }
 
-func resourceAlibabacloudStackDatahubProjectRead(d *schema.ResourceData, meta interface{}) error {
+func resourceAlibabacloudStackDatahubProjectRead(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*connectivity.AlibabacloudStackClient)
 	datahubService := DatahubService{client}
 	object, err := datahubService.DescribeDatahubProject(d.Id())
@@ -124,7 +125,7 @@ func resourceAlibabacloudStackDatahubProjectRead(d *schema.ResourceData, meta in
 	return nil
 }
 
-func resourceAlibabacloudStackDatahubProjectUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceAlibabacloudStackDatahubProjectUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 	/*
 		client := meta.(*connectivity.AlibabacloudStackClient)
 
@@ -152,10 +153,10 @@ func resourceAlibabacloudStackDatahubProjectUpdate(d *schema.ResourceData, meta
 
 	*/
 
-	return resourceAlibabacloudStackDatahubProjectRead(d, meta)
+	return resourceAlibabacloudStackDatahubProjectRead(ctx, d, meta)
 }
 
-func resourceAlibabacloudStackDatahubProjectDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceAlibabacloudStackDatahubProjectDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*connectivity.AlibabacloudStackClient)
 	datahubService := DatahubService{client}
 	projectName := d.Id()
@@ -184,7 +185,7 @@ func resourceAlibabacloudStackDatahubProjectDelete(d *schema.ResourceData, meta
 	}
 
 	var requestInfo *datahub.DataHub
-	err := resource.Retry(3*time.Minute, func() *resource.RetryError {
+	err := resource.RetryContext(ctx, 3*time.Minute, func() *resource.RetryError {
 		raw, err := client.WithEcsClient(func(dataHubClient *ecs.Client) (interface{}, error) {
 			return dataHubClient.ProcessCommonRequest(request)
 		})
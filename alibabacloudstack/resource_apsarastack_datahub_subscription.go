@@ -1,6 +1,7 @@
 package alibabacloudstack
 
 import (
+	"context"
 	"encoding/json"
 	//"encoding/json"
 	"fmt"
@@ -22,12 +23,12 @@ import (
 
 func resourceAlibabacloudStackDatahubSubscription() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceAlibabacloudStackDatahubSubscriptionCreate,
-		Read:   resourceAlibabacloudStackDatahubSubscriptionRead,
-		Update: resourceAlibabacloudStackDatahubSubscriptionUpdate,
-		Delete: resourceAlibabacloudStackDatahubSubscriptionDelete,
+		CreateContext: withDiagnostics(resourceAlibabacloudStackDatahubSubscriptionCreate),
+		ReadContext:   withDiagnostics(resourceAlibabacloudStackDatahubSubscriptionRead),
+		UpdateContext: withDiagnostics(resourceAlibabacloudStackDatahubSubscriptionUpdate),
+		DeleteContext: withDiagnostics(resourceAlibabacloudStackDatahubSubscriptionDelete),
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			StateContext: schema.ImportStatePassthroughContext,
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -74,7 +75,7 @@ func resourceAlibabacloudStackDatahubSubscription() *schema.Resource {
 	}
 }
 
-func resourceAlibabacloudStackDatahubSubscriptionCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceAlibabacloudStackDatahubSubscriptionCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*connectivity.AlibabacloudStackClient)
 	subscription := &datahub.SubscriptionCreate{}
 	projectName := d.Get("project_name").(string)
@@ -126,10 +127,10 @@ func resourceAlibabacloudStackDatahubSubscriptionCreate(d *schema.ResourceData,
 		addDebug("CreateSubscription", raw, requestInfo, requestMap)
 	}
 	d.SetId(fmt.Sprintf("%s%s%s%s%s", strings.ToLower(projectName), COLON_SEPARATED, strings.ToLower(topicName), COLON_SEPARATED, subscription.SubId))
-	return resourceAlibabacloudStackDatahubSubscriptionRead(d, meta)
+	return resourceAlibabacloudStackDatahubSubscriptionRead(ctx, d, meta)
 }
 
-func resourceAlibabacloudStackDatahubSubscriptionRead(d *schema.ResourceData, meta interface{}) error {
+func resourceAlibabacloudStackDatahubSubscriptionRead(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*connectivity.AlibabacloudStackClient)
 	datahubService := DatahubService{client}
 	parts, err := ParseResourceId(d.Id(), 3)
@@ -159,7 +160,7 @@ func resourceAlibabacloudStackDatahubSubscriptionRead(d *schema.ResourceData, me
 	return nil
 }
 
-func resourceAlibabacloudStackDatahubSubscriptionUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceAlibabacloudStackDatahubSubscriptionUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 	//parts, err := ParseResourceId(d.Id(), 3)
 	//if err != nil {
 	//	return WrapError(err)
@@ -189,10 +190,10 @@ func resourceAlibabacloudStackDatahubSubscriptionUpdate(d *schema.ResourceData,
 	//	}
 	//}
 
-	return resourceAlibabacloudStackDatahubSubscriptionRead(d, meta)
+	return resourceAlibabacloudStackDatahubSubscriptionRead(ctx, d, meta)
 }
 
-func resourceAlibabacloudStackDatahubSubscriptionDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceAlibabacloudStackDatahubSubscriptionDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*connectivity.AlibabacloudStackClient)
 	datahubService := DatahubService{client}
 
@@ -230,7 +231,7 @@ func resourceAlibabacloudStackDatahubSubscriptionDelete(d *schema.ResourceData,
 
 	var requestInfo *datahub.DataHub
 
-	err = resource.Retry(3*time.Minute, func() *resource.RetryError {
+	err = resource.RetryContext(ctx, 3*time.Minute, func() *resource.RetryError {
 		raw, err := client.WithEcsClient(func(dataHubClient *ecs.Client) (interface{}, error) {
 			return dataHubClient.ProcessCommonRequest(request)
 		})
@@ -1,6 +1,7 @@
 package alibabacloudstack
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
 
@@ -22,12 +23,12 @@ import (
 
 func resourceAlibabacloudStackDatahubTopic() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceAlibabacloudStackDatahubTopicCreate,
-		Read:   resourceAlibabacloudStackDatahubTopicRead,
-		Update: resourceAlibabacloudStackDatahubTopicUpdate,
-		Delete: resourceAlibabacloudStackDatahubTopicDelete,
+		CreateContext: withDiagnostics(resourceAlibabacloudStackDatahubTopicCreate),
+		ReadContext:   withDiagnostics(resourceAlibabacloudStackDatahubTopicRead),
+		UpdateContext: withDiagnostics(resourceAlibabacloudStackDatahubTopicUpdate),
+		DeleteContext: withDiagnostics(resourceAlibabacloudStackDatahubTopicDelete),
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			StateContext: schema.ImportStatePassthroughContext,
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -102,7 +103,7 @@ func resourceAlibabacloudStackDatahubTopic() *schema.Resource {
 	}
 }
 
-func resourceAlibabacloudStackDatahubTopicCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceAlibabacloudStackDatahubTopicCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*connectivity.AlibabacloudStackClient)
 	t := &datahub.GetTopicResult{
 		ProjectName: d.Get("project_name").(string),
@@ -168,10 +169,10 @@ func resourceAlibabacloudStackDatahubTopicCreate(d *schema.ResourceData, meta in
 	addDebug("CreateTopic", raw, requestInfo, t)
 
 	d.SetId(strings.ToLower(fmt.Sprintf("%s%s%s", t.ProjectName, COLON_SEPARATED, t.TopicName)))
-	return resourceAlibabacloudStackDatahubTopicRead(d, meta)
+	return resourceAlibabacloudStackDatahubTopicRead(ctx, d, meta)
 }
 
-func resourceAlibabacloudStackDatahubTopicRead(d *schema.ResourceData, meta interface{}) error {
+func resourceAlibabacloudStackDatahubTopicRead(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 
 	client := meta.(*connectivity.AlibabacloudStackClient)
 	datahubService := DatahubService{client}
@@ -202,7 +203,7 @@ func resourceAlibabacloudStackDatahubTopicRead(d *schema.ResourceData, meta inte
 	return nil
 }
 
-func resourceAlibabacloudStackDatahubTopicUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceAlibabacloudStackDatahubTopicUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 	//parts, err := ParseResourceId(d.Id(), 2)
 	//if err != nil {
 	//	return WrapError(err)
@@ -249,10 +250,10 @@ func resourceAlibabacloudStackDatahubTopicUpdate(d *schema.ResourceData, meta in
 	//	}
 	//}
 
-	return resourceAlibabacloudStackDatahubTopicRead(d, meta)
+	return resourceAlibabacloudStackDatahubTopicRead(ctx, d, meta)
 }
 
-func resourceAlibabacloudStackDatahubTopicDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceAlibabacloudStackDatahubTopicDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 	parts, err := ParseResourceId(d.Id(), 2)
 	if err != nil {
 		return WrapError(err)
@@ -288,7 +289,7 @@ func resourceAlibabacloudStackDatahubTopicDelete(d *schema.ResourceData, meta in
 		"TopicName":       topicName,
 	}
 
-	err = resource.Retry(3*time.Minute, func() *resource.RetryError {
+	err = resource.RetryContext(ctx, 3*time.Minute, func() *resource.RetryError {
 		raw, err := client.WithEcsClient(func(dataHubClient *ecs.Client) (interface{}, error) {
 			return dataHubClient.ProcessCommonRequest(request)
 		})
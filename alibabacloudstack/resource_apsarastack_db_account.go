@@ -1,6 +1,7 @@
 package alibabacloudstack
 
 import (
+	"context"
 	"fmt"
 	"strings"
 	"time"
@@ -15,12 +16,12 @@ import (
 
 func resourceAlibabacloudStackDBAccount() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceAlibabacloudStackDBAccountCreate,
-		Read:   resourceAlibabacloudStackDBAccountRead,
-		Update: resourceAlibabacloudStackDBAccountUpdate,
-		Delete: resourceAlibabacloudStackDBAccountDelete,
+		CreateContext: withDiagnostics(resourceAlibabacloudStackDBAccountCreate),
+		ReadContext:   withDiagnostics(resourceAlibabacloudStackDBAccountRead),
+		UpdateContext: withDiagnostics(resourceAlibabacloudStackDBAccountUpdate),
+		DeleteContext: withDiagnostics(resourceAlibabacloudStackDBAccountDelete),
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			StateContext: schema.ImportStatePassthroughContext,
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -71,7 +72,7 @@ func resourceAlibabacloudStackDBAccount() *schema.Resource {
 	}
 }
 
-func resourceAlibabacloudStackDBAccountCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceAlibabacloudStackDBAccountCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*connectivity.AlibabacloudStackClient)
 	rdsService := RdsService{client}
 	request := rds.CreateCreateAccountRequest()
@@ -113,7 +114,7 @@ func resourceAlibabacloudStackDBAccountCreate(d *schema.ResourceData, meta inter
 	if err := rdsService.WaitForDBInstance(request.DBInstanceId, Running, DefaultTimeoutMedium); err != nil {
 		return WrapError(err)
 	}
-	err := resource.Retry(5*time.Minute, func() *resource.RetryError {
+	err := resource.RetryContext(ctx, 5*time.Minute, func() *resource.RetryError {
 		raw, err := client.WithRdsClient(func(rdsClient *rds.Client) (interface{}, error) {
 			return rdsClient.CreateAccount(request)
 		})
@@ -137,10 +138,10 @@ func resourceAlibabacloudStackDBAccountCreate(d *schema.ResourceData, meta inter
 		return WrapError(err)
 	}
 
-	return resourceAlibabacloudStackDBAccountRead(d, meta)
+	return resourceAlibabacloudStackDBAccountRead(ctx, d, meta)
 }
 
-func resourceAlibabacloudStackDBAccountRead(d *schema.ResourceData, meta interface{}) error {
+func resourceAlibabacloudStackDBAccountRead(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 	wiatSecondsIfWithTest(1)
 	client := meta.(*connectivity.AlibabacloudStackClient)
 	rdsService := RdsService{client}
@@ -161,7 +162,7 @@ func resourceAlibabacloudStackDBAccountRead(d *schema.ResourceData, meta interfa
 	return nil
 }
 
-func resourceAlibabacloudStackDBAccountUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceAlibabacloudStackDBAccountUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*connectivity.AlibabacloudStackClient)
 	rdsService := RdsService{client}
 	d.Partial(true)
@@ -243,10 +244,10 @@ func resourceAlibabacloudStackDBAccountUpdate(d *schema.ResourceData, meta inter
 	}
 
 	d.Partial(false)
-	return resourceAlibabacloudStackDBAccountRead(d, meta)
+	return resourceAlibabacloudStackDBAccountRead(ctx, d, meta)
 }
 
-func resourceAlibabacloudStackDBAccountDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceAlibabacloudStackDBAccountDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*connectivity.AlibabacloudStackClient)
 	rdsService := RdsService{client}
 	parts, err := ParseResourceId(d.Id(), 2)
@@ -1,6 +1,7 @@
 package alibabacloudstack
 
 import (
+	"context"
 	"fmt"
 	"strings"
 	"time"
@@ -16,12 +17,12 @@ import (
 
 func resourceAlibabacloudStackDBAccountPrivilege() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceAlibabacloudStackDBAccountPrivilegeCreate,
-		Read:   resourceAlibabacloudStackDBAccountPrivilegeRead,
-		Update: resourceAlibabacloudStackDBAccountPrivilegeUpdate,
-		Delete: resourceAlibabacloudStackDBAccountPrivilegeDelete,
+		CreateContext: withDiagnostics(resourceAlibabacloudStackDBAccountPrivilegeCreate),
+		ReadContext:   withDiagnostics(resourceAlibabacloudStackDBAccountPrivilegeRead),
+		UpdateContext: withDiagnostics(resourceAlibabacloudStackDBAccountPrivilegeUpdate),
+		DeleteContext: withDiagnostics(resourceAlibabacloudStackDBAccountPrivilegeDelete),
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			StateContext: schema.ImportStatePassthroughContext,
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -54,7 +55,7 @@ func resourceAlibabacloudStackDBAccountPrivilege() *schema.Resource {
 	}
 }
 
-func resourceAlibabacloudStackDBAccountPrivilegeCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceAlibabacloudStackDBAccountPrivilegeCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*connectivity.AlibabacloudStackClient)
 	rdsService := RdsService{client}
 	instanceId := d.Get("instance_id").(string)
@@ -69,8 +70,8 @@ func resourceAlibabacloudStackDBAccountPrivilegeCreate(d *schema.ResourceData, m
 
 	if len(dbList) > 0 {
 		for _, db := range dbList {
-			if err := resource.Retry(10*time.Minute, func() *resource.RetryError {
-				if err := rdsService.GrantAccountPrivilege(d.Id(), db.(string)); err != nil {
+			if err := resource.RetryContext(ctx, 10*time.Minute, func() *resource.RetryError {
+				if err := rdsService.GrantAccountPrivilege(ctx, d.Id(), db.(string)); err != nil {
 					if IsExpectedErrors(err, OperationDeniedDBStatus) {
 						return resource.RetryableError(err)
 					}
@@ -83,10 +84,10 @@ func resourceAlibabacloudStackDBAccountPrivilegeCreate(d *schema.ResourceData, m
 		}
 	}
 
-	return resourceAlibabacloudStackDBAccountPrivilegeRead(d, meta)
+	return resourceAlibabacloudStackDBAccountPrivilegeRead(ctx, d, meta)
 }
 
-func resourceAlibabacloudStackDBAccountPrivilegeRead(d *schema.ResourceData, meta interface{}) error {
+func resourceAlibabacloudStackDBAccountPrivilegeRead(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 	wiatSecondsIfWithTest(1)
 	client := meta.(*connectivity.AlibabacloudStackClient)
 	rsdService := RdsService{client}
@@ -125,7 +126,7 @@ func resourceAlibabacloudStackDBAccountPrivilegeRead(d *schema.ResourceData, met
 		} else {
 			request.Scheme = "http"
 		}
-		err = resource.Retry(5*time.Minute, func() *resource.RetryError {
+		err = resource.RetryContext(ctx, 5*time.Minute, func() *resource.RetryError {
 			raw, err := client.WithRdsClient(func(rdsClient *rds.Client) (interface{}, error) {
 				return rdsClient.DescribeDatabases(request)
 			})
@@ -158,7 +159,7 @@ func resourceAlibabacloudStackDBAccountPrivilegeRead(d *schema.ResourceData, met
 	return nil
 }
 
-func resourceAlibabacloudStackDBAccountPrivilegeUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceAlibabacloudStackDBAccountPrivilegeUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*connectivity.AlibabacloudStackClient)
 	rdsService := RdsService{client}
 	d.Partial(true)
@@ -181,7 +182,7 @@ func resourceAlibabacloudStackDBAccountPrivilegeUpdate(d *schema.ResourceData, m
 				return WrapError(err)
 			}
 			for _, db := range remove {
-				if err := rdsService.RevokeAccountPrivilege(d.Id(), db.(string)); err != nil {
+				if err := rdsService.RevokeAccountPrivilege(ctx, d.Id(), db.(string)); err != nil {
 					return WrapError(err)
 				}
 			}
@@ -193,7 +194,7 @@ func resourceAlibabacloudStackDBAccountPrivilegeUpdate(d *schema.ResourceData, m
 				return WrapError(err)
 			}
 			for _, db := range add {
-				if err := rdsService.GrantAccountPrivilege(d.Id(), db.(string)); err != nil {
+				if err := rdsService.GrantAccountPrivilege(ctx, d.Id(), db.(string)); err != nil {
 					return WrapError(err)
 				}
 			}
@@ -202,10 +203,10 @@ func resourceAlibabacloudStackDBAccountPrivilegeUpdate(d *schema.ResourceData, m
 	}
 
 	d.Partial(false)
-	return resourceAlibabacloudStackDBAccountPrivilegeRead(d, meta)
+	return resourceAlibabacloudStackDBAccountPrivilegeRead(ctx, d, meta)
 }
 
-func resourceAlibabacloudStackDBAccountPrivilegeDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceAlibabacloudStackDBAccountPrivilegeDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*connectivity.AlibabacloudStackClient)
 	rdsService := RdsService{client}
 	parts, err := ParseResourceId(d.Id(), 3)
@@ -228,7 +229,7 @@ func resourceAlibabacloudStackDBAccountPrivilegeDelete(d *schema.ResourceData, m
 		for _, pri := range object.DatabasePrivileges.DatabasePrivilege {
 			if pri.AccountPrivilege == parts[2] {
 				dbName = pri.DBName
-				if err := rdsService.RevokeAccountPrivilege(d.Id(), pri.DBName); err != nil {
+				if err := rdsService.RevokeAccountPrivilege(ctx, d.Id(), pri.DBName); err != nil {
 					return WrapError(err)
 				}
 			}
@@ -1,6 +1,7 @@
 package alibabacloudstack
 
 import (
+	"context"
 	"strings"
 	"time"
 
@@ -15,12 +16,12 @@ import (
 
 func resourceAlibabacloudStackDBBackupPolicy() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceAlibabacloudStackDBBackupPolicyCreate,
-		Read:   resourceAlibabacloudStackDBBackupPolicyRead,
-		Update: resourceAlibabacloudStackDBBackupPolicyUpdate,
-		Delete: resourceAlibabacloudStackDBBackupPolicyDelete,
+		CreateContext: withDiagnostics(resourceAlibabacloudStackDBBackupPolicyCreate),
+		ReadContext:   withDiagnostics(resourceAlibabacloudStackDBBackupPolicyRead),
+		UpdateContext: withDiagnostics(resourceAlibabacloudStackDBBackupPolicyUpdate),
+		DeleteContext: withDiagnostics(resourceAlibabacloudStackDBBackupPolicyDelete),
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			StateContext: schema.ImportStatePassthroughContext,
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -127,14 +128,14 @@ func resourceAlibabacloudStackDBBackupPolicy() *schema.Resource {
 	}
 }
 
-func resourceAlibabacloudStackDBBackupPolicyCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceAlibabacloudStackDBBackupPolicyCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 
 	d.SetId(d.Get("instance_id").(string))
 
-	return resourceAlibabacloudStackDBBackupPolicyUpdate(d, meta)
+	return resourceAlibabacloudStackDBBackupPolicyUpdate(ctx, d, meta)
 }
 
-func resourceAlibabacloudStackDBBackupPolicyRead(d *schema.ResourceData, meta interface{}) error {
+func resourceAlibabacloudStackDBBackupPolicyRead(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 	wiatSecondsIfWithTest(1)
 	client := meta.(*connectivity.AlibabacloudStackClient)
 	rdsService := RdsService{client}
@@ -177,7 +178,7 @@ func resourceAlibabacloudStackDBBackupPolicyRead(d *schema.ResourceData, meta in
 	return nil
 }
 
-func resourceAlibabacloudStackDBBackupPolicyUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceAlibabacloudStackDBBackupPolicyUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*connectivity.AlibabacloudStackClient)
 	rdsService := RdsService{client}
 
@@ -199,7 +200,7 @@ func resourceAlibabacloudStackDBBackupPolicyUpdate(d *schema.ResourceData, meta
 		if err := rdsService.WaitForDBInstance(d.Id(), Running, DefaultTimeoutMedium); err != nil {
 			return WrapError(err)
 		}
-		if err := resource.Retry(5*time.Minute, func() *resource.RetryError {
+		if err := resource.RetryContext(ctx, 5*time.Minute, func() *resource.RetryError {
 			if err := rdsService.ModifyDBBackupPolicy(d, updateForData, updateForLog); err != nil {
 				if IsExpectedErrors(err, OperationDeniedDBStatus) {
 					return resource.RetryableError(err)
@@ -212,10 +213,10 @@ func resourceAlibabacloudStackDBBackupPolicyUpdate(d *schema.ResourceData, meta
 		}
 	}
 
-	return resourceAlibabacloudStackDBBackupPolicyRead(d, meta)
+	return resourceAlibabacloudStackDBBackupPolicyRead(ctx, d, meta)
 }
 
-func resourceAlibabacloudStackDBBackupPolicyDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceAlibabacloudStackDBBackupPolicyDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*connectivity.AlibabacloudStackClient)
 	rdsService := RdsService{client}
 	request := rds.CreateModifyBackupPolicyRequest()
@@ -1,6 +1,7 @@
 package alibabacloudstack
 
 import (
+	"context"
 	"fmt"
 	"strings"
 	"time"
@@ -13,12 +14,12 @@ import (
 
 func resourceAlibabacloudStackDBDatabase() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceAlibabacloudStackDBDatabaseCreate,
-		Read:   resourceAlibabacloudStackDBDatabaseRead,
-		Update: resourceAlibabacloudStackDBDatabaseUpdate,
-		Delete: resourceAlibabacloudStackDBDatabaseDelete,
+		CreateContext: withDiagnostics(resourceAlibabacloudStackDBDatabaseCreate),
+		ReadContext:   withDiagnostics(resourceAlibabacloudStackDBDatabaseRead),
+		UpdateContext: withDiagnostics(resourceAlibabacloudStackDBDatabaseUpdate),
+		DeleteContext: withDiagnostics(resourceAlibabacloudStackDBDatabaseDelete),
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			StateContext: schema.ImportStatePassthroughContext,
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -48,7 +49,7 @@ func resourceAlibabacloudStackDBDatabase() *schema.Resource {
 	}
 }
 
-func resourceAlibabacloudStackDBDatabaseCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceAlibabacloudStackDBDatabaseCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 
 	client := meta.(*connectivity.AlibabacloudStackClient)
 	request := rds.CreateCreateDatabaseRequest()
@@ -72,7 +73,7 @@ func resourceAlibabacloudStackDBDatabaseCreate(d *schema.ResourceData, meta inte
 		request.DBDescription = v.(string)
 	}
 
-	err := resource.Retry(5*time.Minute, func() *resource.RetryError {
+	err := resource.RetryContext(ctx, 5*time.Minute, func() *resource.RetryError {
 		raw, err := client.WithRdsClient(func(rdsClient *rds.Client) (interface{}, error) {
 			return rdsClient.CreateDatabase(request)
 		})
@@ -92,10 +93,10 @@ func resourceAlibabacloudStackDBDatabaseCreate(d *schema.ResourceData, meta inte
 
 	d.SetId(fmt.Sprintf("%s%s%s", request.DBInstanceId, COLON_SEPARATED, request.DBName))
 
-	return resourceAlibabacloudStackDBDatabaseRead(d, meta)
+	return resourceAlibabacloudStackDBDatabaseRead(ctx, d, meta)
 }
 
-func resourceAlibabacloudStackDBDatabaseRead(d *schema.ResourceData, meta interface{}) error {
+func resourceAlibabacloudStackDBDatabaseRead(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 	wiatSecondsIfWithTest(1)
 	client := meta.(*connectivity.AlibabacloudStackClient)
 	rsdService := RdsService{client}
@@ -116,7 +117,7 @@ func resourceAlibabacloudStackDBDatabaseRead(d *schema.ResourceData, meta interf
 	return nil
 }
 
-func resourceAlibabacloudStackDBDatabaseUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceAlibabacloudStackDBDatabaseUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*connectivity.AlibabacloudStackClient)
 	if d.HasChange("description") && !d.IsNewResource() {
 		parts, err := ParseResourceId(d.Id(), 2)
@@ -149,10 +150,10 @@ func resourceAlibabacloudStackDBDatabaseUpdate(d *schema.ResourceData, meta inte
 		}
 		addDebug(request.GetActionName(), raw, request.RpcRequest, request)
 	}
-	return resourceAlibabacloudStackDBDatabaseRead(d, meta)
+	return resourceAlibabacloudStackDBDatabaseRead(ctx, d, meta)
 }
 
-func resourceAlibabacloudStackDBDatabaseDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceAlibabacloudStackDBDatabaseDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*connectivity.AlibabacloudStackClient)
 	rdsService := RdsService{client}
 	parts, err := ParseResourceId(d.Id(), 2)
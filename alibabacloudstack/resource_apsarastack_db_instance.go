@@ -1,6 +1,7 @@
 package alibabacloudstack
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
 	"github.com/aliyun/alibaba-cloud-sdk-go/sdk/responses"
@@ -25,13 +26,14 @@ import (
 
 func resourceAlibabacloudStackDBInstance() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceAlibabacloudStackDBInstanceCreate,
-		Read:   resourceAlibabacloudStackDBInstanceRead,
-		Update: resourceAlibabacloudStackDBInstanceUpdate,
-		Delete: resourceAlibabacloudStackDBInstanceDelete,
+		CreateContext: withDiagnostics(resourceAlibabacloudStackDBInstanceCreate),
+		ReadContext:   withDiagnostics(resourceAlibabacloudStackDBInstanceRead),
+		UpdateContext: withDiagnostics(resourceAlibabacloudStackDBInstanceUpdate),
+		DeleteContext: withDiagnostics(resourceAlibabacloudStackDBInstanceDelete),
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			StateContext: schema.ImportStatePassthroughContext,
 		},
+		CustomizeDiff: dbInstanceEngineVersionCustomizeDiff,
 
 		Timeouts: &schema.ResourceTimeout{
 			Create: schema.DefaultTimeout(40 * time.Minute),
@@ -213,7 +215,7 @@ func parameterToHash(v interface{}) int {
 	return hashcode.String(m["name"].(string) + "|" + m["value"].(string))
 }
 
-func resourceAlibabacloudStackDBInstanceCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceAlibabacloudStackDBInstanceCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*connectivity.AlibabacloudStackClient)
 	rdsService := RdsService{client}
 
@@ -402,7 +404,7 @@ func resourceAlibabacloudStackDBInstanceCreate(d *schema.ResourceData, meta inte
 
 	// wait instance status change from Creating to running
 	stateConf := BuildStateConf([]string{"Creating"}, []string{"Running"}, d.Timeout(schema.TimeoutCreate), 5*time.Minute, rdsService.RdsDBInstanceStateRefreshFunc(d.Id(), []string{"Deleting"}))
-	if _, err := stateConf.WaitForState(); err != nil {
+	if _, err := stateConf.WaitForStateContext(ctx); err != nil {
 		return WrapErrorf(err, IdMsg, d.Id())
 	}
 
@@ -459,10 +461,10 @@ func resourceAlibabacloudStackDBInstanceCreate(d *schema.ResourceData, meta inte
 		log.Print("enabled SSL")
 		addDebug(request.GetActionName(), sslraw, request)
 	}
-	return resourceAlibabacloudStackDBInstanceUpdate(d, meta)
+	return resourceAlibabacloudStackDBInstanceUpdate(ctx, d, meta)
 }
 
-func resourceAlibabacloudStackDBInstanceUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceAlibabacloudStackDBInstanceUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 	//const tde_set = false
 	client := meta.(*connectivity.AlibabacloudStackClient)
 	rdsService := RdsService{client}
@@ -475,7 +477,7 @@ func resourceAlibabacloudStackDBInstanceUpdate(d *schema.ResourceData, meta inte
 	//	}
 	//}
 
-	if err := rdsService.setInstanceTags(d); err != nil {
+	if err := rdsService.setInstanceTags(ctx, d); err != nil {
 		return WrapError(err)
 	}
 
@@ -508,7 +510,7 @@ func resourceAlibabacloudStackDBInstanceUpdate(d *schema.ResourceData, meta inte
 		}
 		addDebug(prePaidRequest.GetActionName(), raw, prePaidRequest.RpcRequest, prePaidRequest)
 		// wait instance status is Normal after modifying
-		if _, err := stateConf.WaitForState(); err != nil {
+		if _, err := stateConf.WaitForStateContext(ctx); err != nil {
 			return WrapErrorf(err, IdMsg, d.Id())
 		}
 		//d.SetPartial("instance_charge_type")
@@ -617,7 +619,7 @@ func resourceAlibabacloudStackDBInstanceUpdate(d *schema.ResourceData, meta inte
 
 	if d.IsNewResource() {
 		d.Partial(false)
-		return resourceAlibabacloudStackDBInstanceRead(d, meta)
+		return resourceAlibabacloudStackDBInstanceRead(ctx, d, meta)
 	}
 
 	if d.HasChange("instance_name") {
@@ -682,10 +684,10 @@ func resourceAlibabacloudStackDBInstanceUpdate(d *schema.ResourceData, meta inte
 	}
 	if update {
 		// wait instance status is running before modifying
-		if _, err := stateConf.WaitForState(); err != nil {
+		if _, err := stateConf.WaitForStateContext(ctx); err != nil {
 			return WrapErrorf(err, IdMsg, d.Id())
 		}
-		err := resource.Retry(5*time.Minute, func() *resource.RetryError {
+		err := resource.RetryContext(ctx, 5*time.Minute, func() *resource.RetryError {
 			raw, err := client.WithRdsClient(func(rdsClient *rds.Client) (interface{}, error) {
 				return rdsClient.ModifyDBInstanceSpec(request)
 			})
@@ -706,7 +708,7 @@ func resourceAlibabacloudStackDBInstanceUpdate(d *schema.ResourceData, meta inte
 		}
 
 		// wait instance status is running after modifying
-		if _, err := stateConf.WaitForState(); err != nil {
+		if _, err := stateConf.WaitForStateContext(ctx); err != nil {
 			return WrapErrorf(err, IdMsg, d.Id())
 		}
 	}
@@ -796,10 +798,10 @@ func resourceAlibabacloudStackDBInstanceUpdate(d *schema.ResourceData, meta inte
 			addDebug(request.GetActionName(), sslraw, request)
 		}
 	}
-	return resourceAlibabacloudStackDBInstanceRead(d, meta)
+	return resourceAlibabacloudStackDBInstanceRead(ctx, d, meta)
 }
 
-func resourceAlibabacloudStackDBInstanceRead(d *schema.ResourceData, meta interface{}) error {
+func resourceAlibabacloudStackDBInstanceRead(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 	wiatSecondsIfWithTest(1)
 	client := meta.(*connectivity.AlibabacloudStackClient)
 	rdsService := RdsService{client}
@@ -887,7 +889,7 @@ func resourceAlibabacloudStackDBInstanceRead(d *schema.ResourceData, meta interf
 	return nil
 }
 
-func resourceAlibabacloudStackDBInstanceDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceAlibabacloudStackDBInstanceDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*connectivity.AlibabacloudStackClient)
 	rdsService := RdsService{client}
 
@@ -913,7 +915,7 @@ func resourceAlibabacloudStackDBInstanceDelete(d *schema.ResourceData, meta inte
 	request.QueryParams = map[string]string{"AccessKeySecret": client.SecretKey, "Product": "rds", "Department": client.Department, "ResourceGroup": client.ResourceGroup}
 	request.DBInstanceId = d.Id()
 
-	err = resource.Retry(10*time.Minute, func() *resource.RetryError {
+	err = resource.RetryContext(ctx, 10*time.Minute, func() *resource.RetryError {
 		raw, err := client.WithRdsClient(func(rdsClient *rds.Client) (interface{}, error) {
 			return rdsClient.DeleteDBInstance(request)
 		})
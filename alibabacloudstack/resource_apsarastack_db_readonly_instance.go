@@ -1,6 +1,7 @@
 package alibabacloudstack
 
 import (
+	"context"
 	"log"
 	"strings"
 	"time"
@@ -16,12 +17,12 @@ import (
 
 func resourceAlibabacloudStackDBReadonlyInstance() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceAlibabacloudStackDBReadonlyInstanceCreate,
-		Read:   resourceAlibabacloudStackDBReadonlyInstanceRead,
-		Update: resourceAlibabacloudStackDBReadonlyInstanceUpdate,
-		Delete: resourceAlibabacloudStackDBReadonlyInstanceDelete,
+		CreateContext: withDiagnostics(resourceAlibabacloudStackDBReadonlyInstanceCreate),
+		ReadContext:   withDiagnostics(resourceAlibabacloudStackDBReadonlyInstanceRead),
+		UpdateContext: withDiagnostics(resourceAlibabacloudStackDBReadonlyInstanceUpdate),
+		DeleteContext: withDiagnostics(resourceAlibabacloudStackDBReadonlyInstanceDelete),
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			StateContext: schema.ImportStatePassthroughContext,
 		},
 
 		Timeouts: &schema.ResourceTimeout{
@@ -189,7 +190,7 @@ func resourceAlibabacloudStackDBReadonlyInstance() *schema.Resource {
 	}
 }
 
-func resourceAlibabacloudStackDBReadonlyInstanceCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceAlibabacloudStackDBReadonlyInstanceCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*connectivity.AlibabacloudStackClient)
 	rdsService := RdsService{client}
 
@@ -216,14 +217,14 @@ func resourceAlibabacloudStackDBReadonlyInstanceCreate(d *schema.ResourceData, m
 
 	// wait instance status change from Creating to running
 	stateConf := BuildStateConf([]string{"Creating"}, []string{"Running"}, d.Timeout(schema.TimeoutCreate), 5*time.Minute, rdsService.RdsDBInstanceStateRefreshFunc(d.Id(), []string{"Deleting"}))
-	if _, err := stateConf.WaitForState(); err != nil {
+	if _, err := stateConf.WaitForStateContext(ctx); err != nil {
 		return WrapErrorf(err, IdMsg, d.Id())
 	}
 
-	return resourceAlibabacloudStackDBReadonlyInstanceUpdate(d, meta)
+	return resourceAlibabacloudStackDBReadonlyInstanceUpdate(ctx, d, meta)
 }
 
-func resourceAlibabacloudStackDBReadonlyInstanceUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceAlibabacloudStackDBReadonlyInstanceUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*connectivity.AlibabacloudStackClient)
 	rdsService := RdsService{client}
 	d.Partial(true)
@@ -234,13 +235,13 @@ func resourceAlibabacloudStackDBReadonlyInstanceUpdate(d *schema.ResourceData, m
 		}
 	}
 
-	if err := rdsService.setInstanceTags(d); err != nil {
+	if err := rdsService.setInstanceTags(ctx, d); err != nil {
 		return WrapError(err)
 	}
 
 	if d.IsNewResource() {
 		d.Partial(false)
-		return resourceAlibabacloudStackDBInstanceRead(d, meta)
+		return resourceAlibabacloudStackDBInstanceRead(ctx, d, meta)
 	}
 
 	if d.HasChange("instance_name") {
@@ -256,7 +257,7 @@ func resourceAlibabacloudStackDBReadonlyInstanceUpdate(d *schema.ResourceData, m
 		request.DBInstanceId = d.Id()
 		request.DBInstanceDescription = d.Get("instance_name").(string)
 
-		err := resource.Retry(5*time.Minute, func() *resource.RetryError {
+		err := resource.RetryContext(ctx, 5*time.Minute, func() *resource.RetryError {
 			raw, err := client.WithRdsClient(func(rdsClient *rds.Client) (interface{}, error) {
 				return rdsClient.ModifyDBInstanceDescription(request)
 			})
@@ -310,12 +311,12 @@ func resourceAlibabacloudStackDBReadonlyInstanceUpdate(d *schema.ResourceData, m
 	if update {
 		// wait instance status is running before modifying
 		stateConf := BuildStateConf([]string{"DBInstanceClassChanging", "DBInstanceNetTypeChanging"}, []string{"Running"}, d.Timeout(schema.TimeoutUpdate), 10*time.Minute, rdsService.RdsDBInstanceStateRefreshFunc(d.Id(), []string{"Deleting"}))
-		_, err := stateConf.WaitForState()
+		_, err := stateConf.WaitForStateContext(ctx)
 		if err != nil {
 			return WrapErrorf(err, IdMsg, d.Id())
 		}
 
-		err = resource.Retry(5*time.Minute, func() *resource.RetryError {
+		err = resource.RetryContext(ctx, 5*time.Minute, func() *resource.RetryError {
 			raw, err := client.WithRdsClient(func(rdsClient *rds.Client) (interface{}, error) {
 				return rdsClient.ModifyDBInstanceSpec(request)
 			})
@@ -337,17 +338,17 @@ func resourceAlibabacloudStackDBReadonlyInstanceUpdate(d *schema.ResourceData, m
 		}
 
 		// wait instance status is running after modifying
-		_, err = stateConf.WaitForState()
+		_, err = stateConf.WaitForStateContext(ctx)
 		if err != nil {
 			return WrapErrorf(err, IdMsg, d.Id())
 		}
 	}
 
 	d.Partial(false)
-	return resourceAlibabacloudStackDBReadonlyInstanceRead(d, meta)
+	return resourceAlibabacloudStackDBReadonlyInstanceRead(ctx, d, meta)
 }
 
-func resourceAlibabacloudStackDBReadonlyInstanceRead(d *schema.ResourceData, meta interface{}) error {
+func resourceAlibabacloudStackDBReadonlyInstanceRead(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 	wiatSecondsIfWithTest(1)
 	client := meta.(*connectivity.AlibabacloudStackClient)
 	rdsService := RdsService{client}
@@ -388,7 +389,7 @@ func resourceAlibabacloudStackDBReadonlyInstanceRead(d *schema.ResourceData, met
 	return nil
 }
 
-func resourceAlibabacloudStackDBReadonlyInstanceDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceAlibabacloudStackDBReadonlyInstanceDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*connectivity.AlibabacloudStackClient)
 	rdsService := RdsService{client}
 
@@ -414,7 +415,7 @@ func resourceAlibabacloudStackDBReadonlyInstanceDelete(d *schema.ResourceData, m
 	request.QueryParams = map[string]string{"AccessKeySecret": client.SecretKey, "Product": "rds", "Department": client.Department, "ResourceGroup": client.ResourceGroup}
 	request.DBInstanceId = d.Id()
 
-	err = resource.Retry(5*time.Minute, func() *resource.RetryError {
+	err = resource.RetryContext(ctx, 5*time.Minute, func() *resource.RetryError {
 
 		raw, err := client.WithRdsClient(func(rdsClient *rds.Client) (interface{}, error) {
 			return rdsClient.DeleteDBInstance(request)
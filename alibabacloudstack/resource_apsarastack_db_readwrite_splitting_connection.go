@@ -1,6 +1,7 @@
 package alibabacloudstack
 
 import (
+	"context"
 	"encoding/json"
 	"regexp"
 	"strconv"
@@ -21,12 +22,12 @@ var dbConnectionPrefixWithSuffixRegexp = regexp.MustCompile(dbConnectionPrefixWi
 
 func resourceAlibabacloudStackDBReadWriteSplittingConnection() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceAlibabacloudStackDBReadWriteSplittingConnectionCreate,
-		Read:   resourceAlibabacloudStackDBReadWriteSplittingConnectionRead,
-		Update: resourceAlibabacloudStackDBReadWriteSplittingConnectionUpdate,
-		Delete: resourceAlibabacloudStackDBReadWriteSplittingConnectionDelete,
+		CreateContext: withDiagnostics(resourceAlibabacloudStackDBReadWriteSplittingConnectionCreate),
+		ReadContext:   withDiagnostics(resourceAlibabacloudStackDBReadWriteSplittingConnectionRead),
+		UpdateContext: withDiagnostics(resourceAlibabacloudStackDBReadWriteSplittingConnectionUpdate),
+		DeleteContext: withDiagnostics(resourceAlibabacloudStackDBReadWriteSplittingConnectionDelete),
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			StateContext: schema.ImportStatePassthroughContext,
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -68,7 +69,7 @@ func resourceAlibabacloudStackDBReadWriteSplittingConnection() *schema.Resource
 	}
 }
 
-func resourceAlibabacloudStackDBReadWriteSplittingConnectionCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceAlibabacloudStackDBReadWriteSplittingConnectionCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*connectivity.AlibabacloudStackClient)
 	rdsService := RdsService{client}
 
@@ -104,7 +105,7 @@ func resourceAlibabacloudStackDBReadWriteSplittingConnectionCreate(d *schema.Res
 		}
 	}
 
-	if err := resource.Retry(60*time.Minute, func() *resource.RetryError {
+	if err := resource.RetryContext(ctx, 60*time.Minute, func() *resource.RetryError {
 		raw, err := client.WithRdsClient(func(rdsClient *rds.Client) (interface{}, error) {
 			return rdsClient.AllocateReadWriteSplittingConnection(request)
 		})
@@ -128,10 +129,10 @@ func resourceAlibabacloudStackDBReadWriteSplittingConnectionCreate(d *schema.Res
 		return WrapError(err)
 	}
 
-	return resourceAlibabacloudStackDBReadWriteSplittingConnectionUpdate(d, meta)
+	return resourceAlibabacloudStackDBReadWriteSplittingConnectionUpdate(ctx, d, meta)
 }
 
-func resourceAlibabacloudStackDBReadWriteSplittingConnectionRead(d *schema.ResourceData, meta interface{}) error {
+func resourceAlibabacloudStackDBReadWriteSplittingConnectionRead(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 	wiatSecondsIfWithTest(1)
 
 	client := meta.(*connectivity.AlibabacloudStackClient)
@@ -179,7 +180,7 @@ func resourceAlibabacloudStackDBReadWriteSplittingConnectionRead(d *schema.Resou
 	return nil
 }
 
-func resourceAlibabacloudStackDBReadWriteSplittingConnectionUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceAlibabacloudStackDBReadWriteSplittingConnectionUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*connectivity.AlibabacloudStackClient)
 	rdsService := RdsService{client}
 
@@ -202,7 +203,7 @@ func resourceAlibabacloudStackDBReadWriteSplittingConnectionUpdate(d *schema.Res
 	}
 
 	if !update && d.IsNewResource() {
-		return resourceAlibabacloudStackDBReadWriteSplittingConnectionRead(d, meta)
+		return resourceAlibabacloudStackDBReadWriteSplittingConnectionRead(ctx, d, meta)
 	}
 
 	if d.HasChange("weight") {
@@ -227,7 +228,7 @@ func resourceAlibabacloudStackDBReadWriteSplittingConnectionUpdate(d *schema.Res
 			return WrapError(err)
 		}
 
-		if err := resource.Retry(30*time.Minute, func() *resource.RetryError {
+		if err := resource.RetryContext(ctx, 30*time.Minute, func() *resource.RetryError {
 			raw, err := client.WithRdsClient(func(rdsClient *rds.Client) (interface{}, error) {
 				return rdsClient.ModifyReadWriteSplittingConnection(request)
 			})
@@ -249,10 +250,10 @@ func resourceAlibabacloudStackDBReadWriteSplittingConnectionUpdate(d *schema.Res
 		}
 	}
 
-	return resourceAlibabacloudStackDBReadWriteSplittingConnectionRead(d, meta)
+	return resourceAlibabacloudStackDBReadWriteSplittingConnectionRead(ctx, d, meta)
 }
 
-func resourceAlibabacloudStackDBReadWriteSplittingConnectionDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceAlibabacloudStackDBReadWriteSplittingConnectionDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*connectivity.AlibabacloudStackClient)
 	rdsService := RdsService{client}
 	request := rds.CreateReleaseReadWriteSplittingConnectionRequest()
@@ -266,7 +267,7 @@ func resourceAlibabacloudStackDBReadWriteSplittingConnectionDelete(d *schema.Res
 	request.QueryParams = map[string]string{"AccessKeySecret": client.SecretKey, "Product": "rds", "Department": client.Department, "ResourceGroup": client.ResourceGroup}
 	request.DBInstanceId = d.Id()
 
-	if err := resource.Retry(30*time.Minute, func() *resource.RetryError {
+	if err := resource.RetryContext(ctx, 30*time.Minute, func() *resource.RetryError {
 
 		raw, err := client.WithRdsClient(func(rdsClient *rds.Client) (interface{}, error) {
 			return rdsClient.ReleaseReadWriteSplittingConnection(request)
@@ -1,6 +1,7 @@
 package alibabacloudstack
 
 import (
+	"context"
 	"fmt"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"log"
@@ -14,12 +15,12 @@ import (
 
 func resourceAlibabacloudStackDbsBackupPlan() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceAlibabacloudStackDbsBackupPlanCreate,
-		Read:   resourceAlibabacloudStackDbsBackupPlanRead,
-		Update: resourceAlibabacloudStackDbsBackupPlanUpdate,
-		Delete: resourceAlibabacloudStackDbsBackupPlanDelete,
+		CreateContext: withDiagnostics(resourceAlibabacloudStackDbsBackupPlanCreate),
+		ReadContext:   withDiagnostics(resourceAlibabacloudStackDbsBackupPlanRead),
+		UpdateContext: withDiagnostics(resourceAlibabacloudStackDbsBackupPlanUpdate),
+		DeleteContext: withDiagnostics(resourceAlibabacloudStackDbsBackupPlanDelete),
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			StateContext: schema.ImportStatePassthroughContext,
 		},
 		Schema: map[string]*schema.Schema{
 			"backup_plan_id": {
@@ -69,7 +70,7 @@ func resourceAlibabacloudStackDbsBackupPlan() *schema.Resource {
 	}
 }
 
-func resourceAlibabacloudStackDbsBackupPlanCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceAlibabacloudStackDbsBackupPlanCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*connectivity.AlibabacloudStackClient)
 	var response map[string]interface{}
 	action := "CreateBackupPlan"
@@ -113,7 +114,7 @@ func resourceAlibabacloudStackDbsBackupPlanCreate(d *schema.ResourceData, meta i
 	request["ClientToken"] = buildClientToken("CreateBackupPlan")
 	request["RegionId"] = client.RegionId
 	wait := incrementalWait(3*time.Second, 3*time.Second)
-	err = resource.Retry(d.Timeout(schema.TimeoutCreate), func() *resource.RetryError {
+	err = resource.RetryContext(ctx, d.Timeout(schema.TimeoutCreate), func() *resource.RetryError {
 		response, err = conn.DoRequest(StringPointer(action), nil, StringPointer("POST"), StringPointer("2019-03-06"), StringPointer("AK"), nil, request, &util.RuntimeOptions{})
 		if err != nil {
 			if NeedRetry(err) {
@@ -131,10 +132,10 @@ func resourceAlibabacloudStackDbsBackupPlanCreate(d *schema.ResourceData, meta i
 
 	d.SetId(fmt.Sprint(response["BackupPlanId"]))
 
-	return resourceAlibabacloudStackDbsBackupPlanRead(d, meta)
+	return resourceAlibabacloudStackDbsBackupPlanRead(ctx, d, meta)
 }
 
-func resourceAlibabacloudStackDbsBackupPlanRead(d *schema.ResourceData, meta interface{}) error {
+func resourceAlibabacloudStackDbsBackupPlanRead(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*connectivity.AlibabacloudStackClient)
 	dbsService := DbsService{client}
 	object, err := dbsService.DescribeDbsBackupPlan(d.Id())
@@ -160,7 +161,7 @@ func resourceAlibabacloudStackDbsBackupPlanRead(d *schema.ResourceData, meta int
 	return nil
 }
 
-func resourceAlibabacloudStackDbsBackupPlanUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceAlibabacloudStackDbsBackupPlanUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*connectivity.AlibabacloudStackClient)
 	request := make(map[string]interface{})
 	var response map[string]interface{}
@@ -179,7 +180,7 @@ func resourceAlibabacloudStackDbsBackupPlanUpdate(d *schema.ResourceData, meta i
 		return WrapError(err)
 	}
 	wait := incrementalWait(3*time.Second, 3*time.Second)
-	err = resource.Retry(d.Timeout(schema.TimeoutUpdate), func() *resource.RetryError {
+	err = resource.RetryContext(ctx, d.Timeout(schema.TimeoutUpdate), func() *resource.RetryError {
 		response, err = conn.DoRequest(StringPointer(action), nil, StringPointer("POST"), StringPointer("2019-03-06"), StringPointer("AK"), nil, request, &util.RuntimeOptions{})
 		if err != nil {
 			if NeedRetry(err) {
@@ -194,10 +195,10 @@ func resourceAlibabacloudStackDbsBackupPlanUpdate(d *schema.ResourceData, meta i
 	if err != nil {
 		return WrapErrorf(err, DefaultErrorMsg, d.Id(), action, AlibabacloudStackSdkGoERROR)
 	}
-	return resourceAlibabacloudStackDbsBackupPlanRead(d, meta)
+	return resourceAlibabacloudStackDbsBackupPlanRead(ctx, d, meta)
 }
 
-func resourceAlibabacloudStackDbsBackupPlanDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceAlibabacloudStackDbsBackupPlanDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 	// 没有接口
 	return nil
 }
@@ -1,6 +1,7 @@
 package alibabacloudstack
 
 import (
+	"context"
 	"errors"
 	"strings"
 	"time"
@@ -16,13 +17,14 @@ import (
 
 func resourceAlibabacloudStackDisk() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceAlibabacloudStackDiskCreate,
-		Read:   resourceAlibabacloudStackDiskRead,
-		Update: resourceAlibabacloudStackDiskUpdate,
-		Delete: resourceAlibabacloudStackDiskDelete,
+		CreateContext: withDiagnostics(resourceAlibabacloudStackDiskCreate),
+		ReadContext:   withDiagnostics(resourceAlibabacloudStackDiskRead),
+		UpdateContext: withDiagnostics(resourceAlibabacloudStackDiskUpdate),
+		DeleteContext: withDiagnostics(resourceAlibabacloudStackDiskDelete),
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			StateContext: schema.ImportStatePassthroughContext,
 		},
+		CustomizeDiff: quotaCustomizeDiff("ECS", diskQuotaAmounts),
 
 		Schema: map[string]*schema.Schema{
 			"availability_zone": {
@@ -90,6 +92,12 @@ func resourceAlibabacloudStackDisk() *schema.Resource {
 				Default:  false,
 			},
 
+			"auto_snapshot_policy_id": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+
 			"status": {
 				Type:     schema.TypeString,
 				Computed: true,
@@ -100,7 +108,7 @@ func resourceAlibabacloudStackDisk() *schema.Resource {
 	}
 }
 
-func resourceAlibabacloudStackDiskCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceAlibabacloudStackDiskCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*connectivity.AlibabacloudStackClient)
 	ecsService := EcsService{client}
 
@@ -152,7 +160,7 @@ func resourceAlibabacloudStackDiskCreate(d *schema.ResourceData, meta interface{
 			}
 		}
 	}
-	if v, ok := d.GetOk("tags"); ok && len(v.(map[string]interface{})) > 0 {
+	if v, ok := getTagsAllOk(d, client); ok && len(v.(map[string]interface{})) > 0 {
 		tags := make([]ecs.CreateDiskTag, len(v.(map[string]interface{})))
 		for key, value := range v.(map[string]interface{}) {
 			tags = append(tags, ecs.CreateDiskTag{
@@ -176,10 +184,10 @@ func resourceAlibabacloudStackDiskCreate(d *schema.ResourceData, meta interface{
 		return WrapError(err)
 	}
 
-	return resourceAlibabacloudStackDiskUpdate(d, meta)
+	return resourceAlibabacloudStackDiskUpdate(ctx, d, meta)
 }
 
-func resourceAlibabacloudStackDiskRead(d *schema.ResourceData, meta interface{}) error {
+func resourceAlibabacloudStackDiskRead(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 	wiatSecondsIfWithTest(1)
 	client := meta.(*connectivity.AlibabacloudStackClient)
 	ecsService := EcsService{client}
@@ -205,12 +213,13 @@ func resourceAlibabacloudStackDiskRead(d *schema.ResourceData, meta interface{})
 	d.Set("delete_auto_snapshot", object.DeleteAutoSnapshot)
 	d.Set("delete_with_instance", object.DeleteWithInstance)
 	d.Set("enable_auto_snapshot", object.EnableAutoSnapshot)
+	d.Set("auto_snapshot_policy_id", object.AutoSnapshotPolicyId)
 	d.Set("tags", ecsService.tagsToMap(object.Tags.Tag))
 
 	return nil
 }
 
-func resourceAlibabacloudStackDiskUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceAlibabacloudStackDiskUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*connectivity.AlibabacloudStackClient)
 
 	d.Partial(true)
@@ -269,12 +278,27 @@ func resourceAlibabacloudStackDiskUpdate(d *schema.ResourceData, meta interface{
 		addDebug(request.GetActionName(), raw, request.RpcRequest, request)
 	}
 
+	// The policy is computed, so that the policy applied by alibabacloudstack_snapshot_policy_attachment is kept,
+	// and it is only cancelled when it is set to an empty string explicitly.
+	if d.HasChange("auto_snapshot_policy_id") {
+		ecsService := EcsService{client}
+		if v, ok := d.GetOk("auto_snapshot_policy_id"); ok {
+			if err := ecsService.ApplySnapshotPolicy(ctx, v.(string), []string{d.Id()}); err != nil {
+				return WrapError(err)
+			}
+		} else if !d.IsNewResource() {
+			if err := ecsService.CancelSnapshotPolicy(ctx, []string{d.Id()}); err != nil {
+				return WrapError(err)
+			}
+		}
+	}
+
 	if d.IsNewResource() {
 		d.Partial(false)
-		return resourceAlibabacloudStackDiskRead(d, meta)
+		return resourceAlibabacloudStackDiskRead(ctx, d, meta)
 	}
 
-	err := setTags(client, TagResourceDisk, d)
+	err := setTags(ctx, client, TagResourceDisk, d)
 	if err != nil {
 		return WrapError(err)
 	}
@@ -311,10 +335,10 @@ func resourceAlibabacloudStackDiskUpdate(d *schema.ResourceData, meta interface{
 	}
 
 	d.Partial(false)
-	return resourceAlibabacloudStackDiskRead(d, meta)
+	return resourceAlibabacloudStackDiskRead(ctx, d, meta)
 }
 
-func resourceAlibabacloudStackDiskDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceAlibabacloudStackDiskDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*connectivity.AlibabacloudStackClient)
 	ecsService := EcsService{client}
 
@@ -328,7 +352,7 @@ func resourceAlibabacloudStackDiskDelete(d *schema.ResourceData, meta interface{
 	} else {
 		request.Scheme = "http"
 	}
-	err := resource.Retry(5*time.Minute, func() *resource.RetryError {
+	err := resource.RetryContext(ctx, 5*time.Minute, func() *resource.RetryError {
 		raw, err := client.WithEcsClient(func(ecsClient *ecs.Client) (interface{}, error) {
 			return ecsClient.DeleteDisk(request)
 		})
@@ -1,6 +1,7 @@
 package alibabacloudstack
 
 import (
+	"context"
 	"strings"
 	"time"
 
@@ -14,9 +15,12 @@ import (
 
 func resourceAlibabacloudStackDiskAttachment() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceAlibabacloudStackDiskAttachmentCreate,
-		Read:   resourceAlibabacloudStackDiskAttachmentRead,
-		Delete: resourceAlibabacloudStackDiskAttachmentDelete,
+		CreateContext: withDiagnostics(resourceAlibabacloudStackDiskAttachmentCreate),
+		ReadContext:   withDiagnostics(resourceAlibabacloudStackDiskAttachmentRead),
+		DeleteContext: withDiagnostics(resourceAlibabacloudStackDiskAttachmentDelete),
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
 
 		Schema: map[string]*schema.Schema{
 			"instance_id": {
@@ -40,7 +44,7 @@ func resourceAlibabacloudStackDiskAttachment() *schema.Resource {
 	}
 }
 
-func resourceAlibabacloudStackDiskAttachmentCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceAlibabacloudStackDiskAttachmentCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*connectivity.AlibabacloudStackClient)
 	ecsService := EcsService{client}
 
@@ -62,7 +66,7 @@ func resourceAlibabacloudStackDiskAttachmentCreate(d *schema.ResourceData, meta
 	request.QueryParams = map[string]string{"AccessKeySecret": client.SecretKey, "Product": "ecs", "Department": client.Department, "ResourceGroup": client.ResourceGroup}
 	request.DiskId = diskID
 
-	err = resource.Retry(5*time.Minute, func() *resource.RetryError {
+	err = resource.RetryContext(ctx, 5*time.Minute, func() *resource.RetryError {
 		raw, err := client.WithEcsClient(func(ecsClient *ecs.Client) (interface{}, error) {
 			return ecsClient.AttachDisk(request)
 		})
@@ -108,10 +112,10 @@ func resourceAlibabacloudStackDiskAttachmentCreate(d *schema.ResourceData, meta
 		}
 		addDebug(request.GetActionName(), raw, request.RpcRequest, request)
 	}
-	return resourceAlibabacloudStackDiskAttachmentRead(d, meta)
+	return resourceAlibabacloudStackDiskAttachmentRead(ctx, d, meta)
 }
 
-func resourceAlibabacloudStackDiskAttachmentRead(d *schema.ResourceData, meta interface{}) error {
+func resourceAlibabacloudStackDiskAttachmentRead(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 	wiatSecondsIfWithTest(1)
 	client := meta.(*connectivity.AlibabacloudStackClient)
 	ecsService := EcsService{client}
@@ -136,7 +140,7 @@ func resourceAlibabacloudStackDiskAttachmentRead(d *schema.ResourceData, meta in
 	return nil
 }
 
-func resourceAlibabacloudStackDiskAttachmentDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceAlibabacloudStackDiskAttachmentDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*connectivity.AlibabacloudStackClient)
 	ecsService := EcsService{client}
 	parts, err := ParseResourceId(d.Id(), 2)
@@ -155,7 +159,7 @@ func resourceAlibabacloudStackDiskAttachmentDelete(d *schema.ResourceData, meta
 	request.InstanceId = parts[1]
 	request.DiskId = parts[0]
 
-	err = resource.Retry(5*time.Minute, func() *resource.RetryError {
+	err = resource.RetryContext(ctx, 5*time.Minute, func() *resource.RetryError {
 		raw, err := client.WithEcsClient(func(ecsClient *ecs.Client) (interface{}, error) {
 			return ecsClient.DetachDisk(request)
 		})
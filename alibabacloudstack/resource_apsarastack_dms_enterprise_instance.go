@@ -1,6 +1,7 @@
 package alibabacloudstack
 
 import (
+	"context"
 	"fmt"
 	"log"
 	"time"
@@ -14,12 +15,12 @@ import (
 
 func resourceAlibabacloudStackDmsEnterpriseInstance() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceAlibabacloudStackDmsEnterpriseInstanceCreate,
-		Read:   resourceAlibabacloudStackDmsEnterpriseInstanceRead,
-		Update: resourceAlibabacloudStackDmsEnterpriseInstanceUpdate,
-		Delete: resourceAlibabacloudStackDmsEnterpriseInstanceDelete,
+		CreateContext: withDiagnostics(resourceAlibabacloudStackDmsEnterpriseInstanceCreate),
+		ReadContext:   withDiagnostics(resourceAlibabacloudStackDmsEnterpriseInstanceRead),
+		UpdateContext: withDiagnostics(resourceAlibabacloudStackDmsEnterpriseInstanceUpdate),
+		DeleteContext: withDiagnostics(resourceAlibabacloudStackDmsEnterpriseInstanceDelete),
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			StateContext: schema.ImportStatePassthroughContext,
 		},
 		Timeouts: &schema.ResourceTimeout{
 			Create: schema.DefaultTimeout(1 * time.Minute),
@@ -163,7 +164,7 @@ func resourceAlibabacloudStackDmsEnterpriseInstance() *schema.Resource {
 	}
 }
 
-func resourceAlibabacloudStackDmsEnterpriseInstanceCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceAlibabacloudStackDmsEnterpriseInstanceCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*connectivity.AlibabacloudStackClient)
 	var response map[string]interface{}
 	action := "RegisterInstance"
@@ -229,7 +230,7 @@ func resourceAlibabacloudStackDmsEnterpriseInstanceCreate(d *schema.ResourceData
 	}
 
 	wait := incrementalWait(3*time.Second, 2*time.Second)
-	err = resource.Retry(d.Timeout(schema.TimeoutCreate), func() *resource.RetryError {
+	err = resource.RetryContext(ctx, d.Timeout(schema.TimeoutCreate), func() *resource.RetryError {
 		request["RegionId"] = client.RegionId
 		request["Product"] = "dms-enterprise"
 		request["OrganizationId"] = client.Department
@@ -250,9 +251,9 @@ func resourceAlibabacloudStackDmsEnterpriseInstanceCreate(d *schema.ResourceData
 
 	d.SetId(fmt.Sprint(request["Host"], ":", request["Port"]))
 
-	return resourceAlibabacloudStackDmsEnterpriseInstanceUpdate(d, meta)
+	return resourceAlibabacloudStackDmsEnterpriseInstanceUpdate(ctx, d, meta)
 }
-func resourceAlibabacloudStackDmsEnterpriseInstanceRead(d *schema.ResourceData, meta interface{}) error {
+func resourceAlibabacloudStackDmsEnterpriseInstanceRead(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*connectivity.AlibabacloudStackClient)
 	dms_enterpriseService := Dms_enterpriseService{client}
 	object, err := dms_enterpriseService.DescribeDmsEnterpriseInstance(d.Id())
@@ -294,7 +295,7 @@ func resourceAlibabacloudStackDmsEnterpriseInstanceRead(d *schema.ResourceData,
 
 	return nil
 }
-func resourceAlibabacloudStackDmsEnterpriseInstanceUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceAlibabacloudStackDmsEnterpriseInstanceUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*connectivity.AlibabacloudStackClient)
 	var response map[string]interface{}
 	parts, err := ParseResourceId(d.Id(), 2)
@@ -398,7 +399,7 @@ func resourceAlibabacloudStackDmsEnterpriseInstanceUpdate(d *schema.ResourceData
 			return WrapError(err)
 		}
 		wait := incrementalWait(3*time.Second, 3*time.Second)
-		err = resource.Retry(d.Timeout(schema.TimeoutUpdate), func() *resource.RetryError {
+		err = resource.RetryContext(ctx, d.Timeout(schema.TimeoutUpdate), func() *resource.RetryError {
 			request["RegionId"] = client.RegionId
 			request["Product"] = "dms-enterprise"
 			request["OrganizationId"] = client.Department
@@ -417,9 +418,9 @@ func resourceAlibabacloudStackDmsEnterpriseInstanceUpdate(d *schema.ResourceData
 			return WrapErrorf(err, DefaultErrorMsg, d.Id(), action, AlibabacloudStackSdkGoERROR)
 		}
 	}
-	return resourceAlibabacloudStackDmsEnterpriseInstanceRead(d, meta)
+	return resourceAlibabacloudStackDmsEnterpriseInstanceRead(ctx, d, meta)
 }
-func resourceAlibabacloudStackDmsEnterpriseInstanceDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceAlibabacloudStackDmsEnterpriseInstanceDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*connectivity.AlibabacloudStackClient)
 	parts, err := ParseResourceId(d.Id(), 2)
 	if err != nil {
@@ -443,7 +444,7 @@ func resourceAlibabacloudStackDmsEnterpriseInstanceDelete(d *schema.ResourceData
 		request["Tid"] = v
 	}
 	wait := incrementalWait(3*time.Second, 3*time.Second)
-	err = resource.Retry(d.Timeout(schema.TimeoutDelete), func() *resource.RetryError {
+	err = resource.RetryContext(ctx, d.Timeout(schema.TimeoutDelete), func() *resource.RetryError {
 		request["RegionId"] = client.RegionId
 		request["Product"] = "dms-enterprise"
 		request["OrganizationId"] = client.Department
@@ -1,6 +1,7 @@
 package alibabacloudstack
 
 import (
+	"context"
 	"fmt"
 	"log"
 	"time"
@@ -14,12 +15,12 @@ import (
 
 func resourceAlibabacloudStackDmsEnterpriseUser() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceAlibabacloudStackDmsEnterpriseUserCreate,
-		Read:   resourceAlibabacloudStackDmsEnterpriseUserRead,
-		Update: resourceAlibabacloudStackDmsEnterpriseUserUpdate,
-		Delete: resourceAlibabacloudStackDmsEnterpriseUserDelete,
+		CreateContext: withDiagnostics(resourceAlibabacloudStackDmsEnterpriseUserCreate),
+		ReadContext:   withDiagnostics(resourceAlibabacloudStackDmsEnterpriseUserRead),
+		UpdateContext: withDiagnostics(resourceAlibabacloudStackDmsEnterpriseUserUpdate),
+		DeleteContext: withDiagnostics(resourceAlibabacloudStackDmsEnterpriseUserDelete),
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			StateContext: schema.ImportStatePassthroughContext,
 		},
 		Schema: map[string]*schema.Schema{
 			"max_execute_count": {
@@ -73,7 +74,7 @@ func resourceAlibabacloudStackDmsEnterpriseUser() *schema.Resource {
 	}
 }
 
-func resourceAlibabacloudStackDmsEnterpriseUserCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceAlibabacloudStackDmsEnterpriseUserCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*connectivity.AlibabacloudStackClient)
 	var response map[string]interface{}
 	action := "RegisterUser"
@@ -102,7 +103,7 @@ func resourceAlibabacloudStackDmsEnterpriseUserCreate(d *schema.ResourceData, me
 	}
 	request["RegionId"] = client.RegionId
 	wait := incrementalWait(3*time.Second, 3*time.Second)
-	err = resource.Retry(d.Timeout(schema.TimeoutCreate), func() *resource.RetryError {
+	err = resource.RetryContext(ctx, d.Timeout(schema.TimeoutCreate), func() *resource.RetryError {
 		request["Product"] = "dms-enterprise"
 		request["OrganizationId"] = client.Department
 		response, err = conn.DoRequest(StringPointer(action), nil, StringPointer("POST"), StringPointer("2018-11-01"), StringPointer("AK"), nil, request, &util.RuntimeOptions{})
@@ -122,9 +123,9 @@ func resourceAlibabacloudStackDmsEnterpriseUserCreate(d *schema.ResourceData, me
 
 	d.SetId(fmt.Sprint(request["Uid"]))
 
-	return resourceAlibabacloudStackDmsEnterpriseUserUpdate(d, meta)
+	return resourceAlibabacloudStackDmsEnterpriseUserUpdate(ctx, d, meta)
 }
-func resourceAlibabacloudStackDmsEnterpriseUserRead(d *schema.ResourceData, meta interface{}) error {
+func resourceAlibabacloudStackDmsEnterpriseUserRead(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*connectivity.AlibabacloudStackClient)
 	dms_enterpriseService := Dms_enterpriseService{client}
 	object, err := dms_enterpriseService.DescribeDmsEnterpriseUser(d.Id())
@@ -145,7 +146,7 @@ func resourceAlibabacloudStackDmsEnterpriseUserRead(d *schema.ResourceData, meta
 	d.Set("nick_name", object["NickName"])
 	return nil
 }
-func resourceAlibabacloudStackDmsEnterpriseUserUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceAlibabacloudStackDmsEnterpriseUserUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*connectivity.AlibabacloudStackClient)
 	dms_enterpriseService := Dms_enterpriseService{client}
 	var response map[string]interface{}
@@ -187,7 +188,7 @@ func resourceAlibabacloudStackDmsEnterpriseUserUpdate(d *schema.ResourceData, me
 			return WrapError(err)
 		}
 		wait := incrementalWait(3*time.Second, 3*time.Second)
-		err = resource.Retry(d.Timeout(schema.TimeoutUpdate), func() *resource.RetryError {
+		err = resource.RetryContext(ctx, d.Timeout(schema.TimeoutUpdate), func() *resource.RetryError {
 			request["RegionId"] = client.RegionId
 			request["Product"] = "dms-enterprise"
 			request["OrganizationId"] = client.Department
@@ -230,7 +231,7 @@ func resourceAlibabacloudStackDmsEnterpriseUserUpdate(d *schema.ResourceData, me
 					return WrapError(err)
 				}
 				wait := incrementalWait(3*time.Second, 3*time.Second)
-				err = resource.Retry(d.Timeout(schema.TimeoutUpdate), func() *resource.RetryError {
+				err = resource.RetryContext(ctx, d.Timeout(schema.TimeoutUpdate), func() *resource.RetryError {
 					request["RegionId"] = client.RegionId
 					request["Product"] = "dms-enterprise"
 					request["OrganizationId"] = client.Department
@@ -262,7 +263,7 @@ func resourceAlibabacloudStackDmsEnterpriseUserUpdate(d *schema.ResourceData, me
 					return WrapError(err)
 				}
 				wait := incrementalWait(3*time.Second, 3*time.Second)
-				err = resource.Retry(d.Timeout(schema.TimeoutUpdate), func() *resource.RetryError {
+				err = resource.RetryContext(ctx, d.Timeout(schema.TimeoutUpdate), func() *resource.RetryError {
 					request["RegionId"] = client.RegionId
 					request["Product"] = "dms-enterprise"
 					request["OrganizationId"] = client.Department
@@ -285,9 +286,9 @@ func resourceAlibabacloudStackDmsEnterpriseUserUpdate(d *schema.ResourceData, me
 		}
 	}
 	d.Partial(false)
-	return resourceAlibabacloudStackDmsEnterpriseUserRead(d, meta)
+	return resourceAlibabacloudStackDmsEnterpriseUserRead(ctx, d, meta)
 }
-func resourceAlibabacloudStackDmsEnterpriseUserDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceAlibabacloudStackDmsEnterpriseUserDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*connectivity.AlibabacloudStackClient)
 	action := "DeleteUser"
 	var response map[string]interface{}
@@ -303,7 +304,7 @@ func resourceAlibabacloudStackDmsEnterpriseUserDelete(d *schema.ResourceData, me
 		request["Tid"] = v
 	}
 	wait := incrementalWait(3*time.Second, 3*time.Second)
-	err = resource.Retry(d.Timeout(schema.TimeoutDelete), func() *resource.RetryError {
+	err = resource.RetryContext(ctx, d.Timeout(schema.TimeoutDelete), func() *resource.RetryError {
 		request["RegionId"] = client.RegionId
 		request["Product"] = "dms-enterprise"
 		request["OrganizationId"] = client.Department
@@ -1,6 +1,7 @@
 package alibabacloudstack
 
 import (
+	"context"
 	"fmt"
 	"github.com/aliyun/alibaba-cloud-sdk-go/sdk/requests"
 	"github.com/aliyun/alibaba-cloud-sdk-go/sdk/responses"
@@ -14,12 +15,12 @@ import (
 
 func resourceAlibabacloudStackDnsDomain() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceAlibabacloudStackDnsDomainCreate,
-		Read:   resourceAlibabacloudStackDnsDomainRead,
-		Update: resourceAlibabacloudStackDnsDomainUpdate,
-		Delete: resourceAlibabacloudStackDnsDomainDelete,
+		CreateContext: withDiagnostics(resourceAlibabacloudStackDnsDomainCreate),
+		ReadContext:   withDiagnostics(resourceAlibabacloudStackDnsDomainRead),
+		UpdateContext: withDiagnostics(resourceAlibabacloudStackDnsDomainUpdate),
+		DeleteContext: withDiagnostics(resourceAlibabacloudStackDnsDomainDelete),
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			StateContext: schema.ImportStatePassthroughContext,
 		},
 		Schema: map[string]*schema.Schema{
 			"dns_servers": {
@@ -58,7 +59,7 @@ func resourceAlibabacloudStackDnsDomain() *schema.Resource {
 	}
 }
 
-func resourceAlibabacloudStackDnsDomainCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceAlibabacloudStackDnsDomainCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*connectivity.AlibabacloudStackClient)
 	dnsService := DnsService{client}
 	var requestInfo *ecs.Client
@@ -117,9 +118,9 @@ func resourceAlibabacloudStackDnsDomainCreate(d *schema.ResourceData, meta inter
 	//d.SetId(id)
 	d.SetId(check.ZoneList[0].DomainName + COLON_SEPARATED + fmt.Sprint(check.ZoneList[0].DomainID))
 
-	return resourceAlibabacloudStackDnsDomainUpdate(d, meta)
+	return resourceAlibabacloudStackDnsDomainUpdate(ctx, d, meta)
 }
-func resourceAlibabacloudStackDnsDomainRead(d *schema.ResourceData, meta interface{}) error {
+func resourceAlibabacloudStackDnsDomainRead(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 	wiatSecondsIfWithTest(1)
 	client := meta.(*connectivity.AlibabacloudStackClient)
 	dnsService := DnsService{client}
@@ -137,7 +138,7 @@ func resourceAlibabacloudStackDnsDomainRead(d *schema.ResourceData, meta interfa
 	d.Set("remark", object.ZoneList[0].Remark)
 	return nil
 }
-func resourceAlibabacloudStackDnsDomainUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceAlibabacloudStackDnsDomainUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*connectivity.AlibabacloudStackClient)
 	dnsService := DnsService{client}
 	remarkUpdate := false
@@ -199,9 +200,9 @@ func resourceAlibabacloudStackDnsDomainUpdate(d *schema.ResourceData, meta inter
 		addDebug(request.GetActionName(), raw, request)
 	}
 	d.SetId(check.ZoneList[0].DomainName + COLON_SEPARATED + fmt.Sprint(check.ZoneList[0].DomainID))
-	return resourceAlibabacloudStackDnsDomainRead(d, meta)
+	return resourceAlibabacloudStackDnsDomainRead(ctx, d, meta)
 }
-func resourceAlibabacloudStackDnsDomainDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceAlibabacloudStackDnsDomainDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*connectivity.AlibabacloudStackClient)
 	dnsService := DnsService{client}
 	var requestInfo *ecs.Client
@@ -1,6 +1,7 @@
 package alibabacloudstack
 
 import (
+	"context"
 	"strings"
 
 	"github.com/aliyun/alibaba-cloud-sdk-go/services/alidns"
@@ -10,12 +11,12 @@ import (
 
 func resourceAlibabacloudStackDnsDomainAttachment() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceAlibabacloudStackDnsDomainAttachmentCreate,
-		Read:   resourceApasaraStackDnsDomainAttachmentRead,
-		Update: resourceAlibabacloudStackDnsDomainAttachmentUpdate,
-		Delete: resourceAlibabacloudStackDnsdomainAttachmentDelete,
+		CreateContext: withDiagnostics(resourceAlibabacloudStackDnsDomainAttachmentCreate),
+		ReadContext:   withDiagnostics(resourceApasaraStackDnsDomainAttachmentRead),
+		UpdateContext: withDiagnostics(resourceAlibabacloudStackDnsDomainAttachmentUpdate),
+		DeleteContext: withDiagnostics(resourceAlibabacloudStackDnsdomainAttachmentDelete),
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			StateContext: schema.ImportStatePassthroughContext,
 		},
 		Schema: map[string]*schema.Schema{
 			"instance_id": {
@@ -32,12 +33,12 @@ func resourceAlibabacloudStackDnsDomainAttachment() *schema.Resource {
 	}
 }
 
-func resourceAlibabacloudStackDnsDomainAttachmentCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceAlibabacloudStackDnsDomainAttachmentCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 	d.SetId(d.Get("instance_id").(string))
-	return resourceAlibabacloudStackDnsDomainAttachmentUpdate(d, meta)
+	return resourceAlibabacloudStackDnsDomainAttachmentUpdate(ctx, d, meta)
 }
 
-func resourceApasaraStackDnsDomainAttachmentRead(d *schema.ResourceData, meta interface{}) error {
+func resourceApasaraStackDnsDomainAttachmentRead(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*connectivity.AlibabacloudStackClient)
 	dnsService := DnsService{client}
 
@@ -54,7 +55,7 @@ func resourceApasaraStackDnsDomainAttachmentRead(d *schema.ResourceData, meta in
 	return nil
 
 }
-func resourceAlibabacloudStackDnsDomainAttachmentUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceAlibabacloudStackDnsDomainAttachmentUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*connectivity.AlibabacloudStackClient)
 	dnsService := DnsService{client}
 
@@ -117,10 +118,10 @@ func resourceAlibabacloudStackDnsDomainAttachmentUpdate(d *schema.ResourceData,
 	if err := dnsService.WaitForAlidnsDomainAttachment(d.Id(), map[string]interface{}{"Domain": d.Get("domain_names").(*schema.Set).List()}, false, DefaultTimeoutMedium); err != nil {
 		return WrapError(err)
 	}
-	return resourceApasaraStackDnsDomainAttachmentRead(d, meta)
+	return resourceApasaraStackDnsDomainAttachmentRead(ctx, d, meta)
 }
 
-func resourceAlibabacloudStackDnsdomainAttachmentDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceAlibabacloudStackDnsdomainAttachmentDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*connectivity.AlibabacloudStackClient)
 	dnsService := DnsService{client}
 
@@ -1,6 +1,7 @@
 package alibabacloudstack
 
 import (
+	"context"
 	"fmt"
 	"github.com/aliyun/alibaba-cloud-sdk-go/sdk/requests"
 	"github.com/aliyun/alibaba-cloud-sdk-go/sdk/responses"
@@ -14,12 +15,12 @@ import (
 
 func resourceAlibabacloudStackDnsRecord() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceAlibabacloudStackDnsRecordCreate,
-		Read:   resourceAlibabacloudStackDnsRecordRead,
-		Update: resourceAlibabacloudStackDnsRecordUpdate,
-		Delete: resourceAlibabacloudStackDnsRecordDelete,
+		CreateContext: withDiagnostics(resourceAlibabacloudStackDnsRecordCreate),
+		ReadContext:   withDiagnostics(resourceAlibabacloudStackDnsRecordRead),
+		UpdateContext: withDiagnostics(resourceAlibabacloudStackDnsRecordUpdate),
+		DeleteContext: withDiagnostics(resourceAlibabacloudStackDnsRecordDelete),
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			StateContext: schema.ImportStatePassthroughContext,
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -60,7 +61,7 @@ func resourceAlibabacloudStackDnsRecord() *schema.Resource {
 	}
 }
 
-func resourceAlibabacloudStackDnsRecordCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceAlibabacloudStackDnsRecordCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*connectivity.AlibabacloudStackClient)
 	var requestInfo *ecs.Client
 	DomainID := d.Get("domain_id").(string)
@@ -123,10 +124,10 @@ func resourceAlibabacloudStackDnsRecordCreate(d *schema.ResourceData, meta inter
 
 	d.SetId(RR + COLON_SEPARATED + DomainID)
 
-	return resourceAlibabacloudStackDnsRecordRead(d, meta)
+	return resourceAlibabacloudStackDnsRecordRead(ctx, d, meta)
 }
 
-func resourceAlibabacloudStackDnsRecordUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceAlibabacloudStackDnsRecordUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*connectivity.AlibabacloudStackClient)
 	dnsService := DnsService{client}
 	RecordID := d.Get("record_id").(int)
@@ -284,10 +285,10 @@ func resourceAlibabacloudStackDnsRecordUpdate(d *schema.ResourceData, meta inter
 
 	}
 
-	return resourceAlibabacloudStackDnsRecordRead(d, meta)
+	return resourceAlibabacloudStackDnsRecordRead(ctx, d, meta)
 }
 
-func resourceAlibabacloudStackDnsRecordRead(d *schema.ResourceData, meta interface{}) error {
+func resourceAlibabacloudStackDnsRecordRead(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 	wiatSecondsIfWithTest(1)
 	client := meta.(*connectivity.AlibabacloudStackClient)
 
@@ -309,7 +310,7 @@ func resourceAlibabacloudStackDnsRecordRead(d *schema.ResourceData, meta interfa
 	return nil
 }
 
-func resourceAlibabacloudStackDnsRecordDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceAlibabacloudStackDnsRecordDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*connectivity.AlibabacloudStackClient)
 	RecordID := d.Get("record_id").(int)
 	request := requests.NewCommonRequest()
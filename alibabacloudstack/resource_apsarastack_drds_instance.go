@@ -1,6 +1,7 @@
 package alibabacloudstack
 
 import (
+	"context"
 	"time"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
@@ -12,12 +13,12 @@ import (
 
 func resourceAlibabacloudStackDRDSInstance() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceAliCloudDRDSInstanceCreate,
-		Read:   resourceAliCloudDRDSInstanceRead,
-		Update: resourceAliCloudDRDSInstanceUpdate,
-		Delete: resourceAliCloudDRDSInstanceDelete,
+		CreateContext: withDiagnostics(resourceAliCloudDRDSInstanceCreate),
+		ReadContext:   withDiagnostics(resourceAliCloudDRDSInstanceRead),
+		UpdateContext: withDiagnostics(resourceAliCloudDRDSInstanceUpdate),
+		DeleteContext: withDiagnostics(resourceAliCloudDRDSInstanceDelete),
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			StateContext: schema.ImportStatePassthroughContext,
 		},
 
 		Timeouts: &schema.ResourceTimeout{
@@ -64,7 +65,7 @@ func resourceAlibabacloudStackDRDSInstance() *schema.Resource {
 	}
 }
 
-func resourceAliCloudDRDSInstanceCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceAliCloudDRDSInstanceCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*connectivity.AlibabacloudStackClient)
 	drdsService := DrdsService{client}
 
@@ -118,15 +119,15 @@ func resourceAliCloudDRDSInstanceCreate(d *schema.ResourceData, meta interface{}
 
 	// wait instance status change from DO_CREATE to RUN
 	stateConf := BuildStateConf([]string{"DO_CREATE"}, []string{"RUN"}, d.Timeout(schema.TimeoutCreate), 1*time.Minute, drdsService.DrdsInstanceStateRefreshFunc(d.Id(), []string{}))
-	if _, err := stateConf.WaitForState(); err != nil {
+	if _, err := stateConf.WaitForStateContext(ctx); err != nil {
 		return WrapErrorf(err, IdMsg, d.Id())
 	}
 
-	return resourceAliCloudDRDSInstanceUpdate(d, meta)
+	return resourceAliCloudDRDSInstanceUpdate(ctx, d, meta)
 
 }
 
-func resourceAliCloudDRDSInstanceUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceAliCloudDRDSInstanceUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*connectivity.AlibabacloudStackClient)
 	drdsService := DrdsService{client}
 
@@ -155,14 +156,14 @@ func resourceAliCloudDRDSInstanceUpdate(d *schema.ResourceData, meta interface{}
 		return WrapError(err)
 	}
 	stateConf := BuildStateConf([]string{}, []string{"RUN"}, d.Timeout(schema.TimeoutUpdate), 3*time.Second, drdsService.DrdsInstanceStateRefreshFunc(d.Id(), []string{}))
-	if _, err := stateConf.WaitForState(); err != nil {
+	if _, err := stateConf.WaitForStateContext(ctx); err != nil {
 		return WrapErrorf(err, IdMsg, d.Id())
 	}
 
-	return resourceAliCloudDRDSInstanceRead(d, meta)
+	return resourceAliCloudDRDSInstanceRead(ctx, d, meta)
 }
 
-func resourceAliCloudDRDSInstanceRead(d *schema.ResourceData, meta interface{}) error {
+func resourceAliCloudDRDSInstanceRead(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*connectivity.AlibabacloudStackClient)
 	drdsService := DrdsService{client}
 
@@ -182,7 +183,7 @@ func resourceAliCloudDRDSInstanceRead(d *schema.ResourceData, meta interface{})
 	return nil
 }
 
-func resourceAliCloudDRDSInstanceDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceAliCloudDRDSInstanceDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*connectivity.AlibabacloudStackClient)
 	drdsService := DrdsService{client}
 	request := drds.CreateRemoveDrdsInstanceRequest()
@@ -209,7 +210,7 @@ func resourceAliCloudDRDSInstanceDelete(d *schema.ResourceData, meta interface{}
 
 	//0 -> RUN, 1->DO_CREATE, 2->EXCEPTION, 3->EXPIRE, 4->DO_RELEASE, 5->RELEASE, 6->UPGRADE, 7->DOWNGRADE, 10->VersionUpgrade, 11->VersionRollback, 14->RESTART
 	stateConf := BuildStateConf([]string{"RUN", "DO_CREATE", "EXCEPTION", "EXPIRE", "DO_RELEASE", "RELEASE", "UPGRADE", "DOWNGRADE", "VersionUpgrade", "VersionRollback", "RESTART"}, []string{}, d.Timeout(schema.TimeoutDelete), 3*time.Second, drdsService.DrdsInstanceStateRefreshFunc(d.Id(), []string{}))
-	if _, err = stateConf.WaitForState(); err != nil {
+	if _, err = stateConf.WaitForStateContext(ctx); err != nil {
 		return WrapErrorf(err, IdMsg, d.Id())
 	}
 	return nil
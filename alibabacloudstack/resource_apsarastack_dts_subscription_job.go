@@ -1,6 +1,7 @@
 package alibabacloudstack
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
 	"log"
@@ -16,12 +17,12 @@ import (
 
 func resourceAlibabacloudStackDtsSubscriptionJob() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceAlibabacloudStackDtsSubscriptionJobCreate,
-		Read:   resourceAlibabacloudStackDtsSubscriptionJobRead,
-		Update: resourceAlibabacloudStackDtsSubscriptionJobUpdate,
-		Delete: resourceAlibabacloudStackDtsSubscriptionJobDelete,
+		CreateContext: withDiagnostics(resourceAlibabacloudStackDtsSubscriptionJobCreate),
+		ReadContext:   withDiagnostics(resourceAlibabacloudStackDtsSubscriptionJobRead),
+		UpdateContext: withDiagnostics(resourceAlibabacloudStackDtsSubscriptionJobUpdate),
+		DeleteContext: withDiagnostics(resourceAlibabacloudStackDtsSubscriptionJobDelete),
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			StateContext: schema.ImportStatePassthroughContext,
 		},
 		Timeouts: &schema.ResourceTimeout{
 			Update: schema.DefaultTimeout(5 * time.Minute),
@@ -201,7 +202,7 @@ func resourceAlibabacloudStackDtsSubscriptionJob() *schema.Resource {
 	}
 }
 
-func resourceAlibabacloudStackDtsSubscriptionJobCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceAlibabacloudStackDtsSubscriptionJobCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*connectivity.AlibabacloudStackClient)
 	var response map[string]interface{}
 	action := "CreateDtsInstance"
@@ -250,7 +251,7 @@ func resourceAlibabacloudStackDtsSubscriptionJobCreate(d *schema.ResourceData, m
 		request["UsedTime"] = v
 	}
 	wait := incrementalWait(3*time.Second, 3*time.Second)
-	err = resource.Retry(d.Timeout(schema.TimeoutCreate), func() *resource.RetryError {
+	err = resource.RetryContext(ctx, d.Timeout(schema.TimeoutCreate), func() *resource.RetryError {
 		response, err = conn.DoRequest(StringPointer(action), nil, StringPointer("POST"), StringPointer("2020-01-01"), StringPointer("AK"), nil, request, &util.RuntimeOptions{})
 		if err != nil {
 			if NeedRetry(err) {
@@ -269,9 +270,9 @@ func resourceAlibabacloudStackDtsSubscriptionJobCreate(d *schema.ResourceData, m
 	d.SetId(fmt.Sprint(response["JobId"]))
 	d.Set("dts_instance_id", response["InstanceId"])
 
-	return resourceAlibabacloudStackDtsSubscriptionJobUpdate(d, meta)
+	return resourceAlibabacloudStackDtsSubscriptionJobUpdate(ctx, d, meta)
 }
-func resourceAlibabacloudStackDtsSubscriptionJobRead(d *schema.ResourceData, meta interface{}) error {
+func resourceAlibabacloudStackDtsSubscriptionJobRead(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*connectivity.AlibabacloudStackClient)
 	dtsService := DtsService{client}
 	object, err := dtsService.DescribeDtsSubscriptionJob(d.Id())
@@ -310,7 +311,7 @@ func resourceAlibabacloudStackDtsSubscriptionJobRead(d *schema.ResourceData, met
 	}
 	d.Set("subscription_instance_vpc_id", jsonData["vpcId"])
 	d.Set("subscription_instance_vswitch_id", jsonData["vswitchId"])
-	listTagResourcesObject, err := dtsService.ListTagResources(object["DtsInstanceID"].(string), "ALIYUN::DTS::INSTANCE")
+	listTagResourcesObject, err := dtsService.ListTagResources(ctx, object["DtsInstanceID"].(string), "ALIYUN::DTS::INSTANCE")
 	if err != nil {
 		return WrapError(err)
 	}
@@ -318,14 +319,14 @@ func resourceAlibabacloudStackDtsSubscriptionJobRead(d *schema.ResourceData, met
 
 	return nil
 }
-func resourceAlibabacloudStackDtsSubscriptionJobUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceAlibabacloudStackDtsSubscriptionJobUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*connectivity.AlibabacloudStackClient)
 	dtsService := DtsService{client}
 	var response map[string]interface{}
 	d.Partial(true)
 
-	if d.HasChange("tags") {
-		if err := dtsService.SetResourceTags(d, "ALIYUN::DTS::INSTANCE"); err != nil {
+	if hasTagsAllChange(d, client) {
+		if err := dtsService.SetResourceTags(ctx, d, "ALIYUN::DTS::INSTANCE"); err != nil {
 			return WrapError(err)
 		}
 		d.SetPartial("tags")
@@ -352,7 +353,7 @@ func resourceAlibabacloudStackDtsSubscriptionJobUpdate(d *schema.ResourceData, m
 			return WrapError(err)
 		}
 		wait := incrementalWait(3*time.Second, 3*time.Second)
-		err = resource.Retry(d.Timeout(schema.TimeoutUpdate), func() *resource.RetryError {
+		err = resource.RetryContext(ctx, d.Timeout(schema.TimeoutUpdate), func() *resource.RetryError {
 			response, err = conn.DoRequest(StringPointer(action), nil, StringPointer("POST"), StringPointer("2020-01-01"), StringPointer("AK"), nil, request, &util.RuntimeOptions{})
 			if err != nil {
 				if NeedRetry(err) {
@@ -397,7 +398,7 @@ func resourceAlibabacloudStackDtsSubscriptionJobUpdate(d *schema.ResourceData, m
 			return WrapError(err)
 		}
 		wait := incrementalWait(3*time.Second, 3*time.Second)
-		err = resource.Retry(d.Timeout(schema.TimeoutUpdate), func() *resource.RetryError {
+		err = resource.RetryContext(ctx, d.Timeout(schema.TimeoutUpdate), func() *resource.RetryError {
 			response, err = conn.DoRequest(StringPointer(action), nil, StringPointer("POST"), StringPointer("2020-01-01"), StringPointer("AK"), nil, modifyDtsJobPasswordReq, &util.RuntimeOptions{})
 			if err != nil {
 				if NeedRetry(err) {
@@ -419,7 +420,7 @@ func resourceAlibabacloudStackDtsSubscriptionJobUpdate(d *schema.ResourceData, m
 		//d.SetPartial("source_endpoint_user_name")
 
 		target := d.Get("status").(string)
-		err = resourceAlibabacloudStackDtsSubscriptionJobStatusFlow(d, meta, target)
+		err = resourceAlibabacloudStackDtsSubscriptionJobStatusFlow(ctx, d, meta, target)
 		if err != nil {
 			return WrapError(Error(FailedToReachTargetStatus, d.Get("status")))
 		}
@@ -427,7 +428,7 @@ func resourceAlibabacloudStackDtsSubscriptionJobUpdate(d *schema.ResourceData, m
 
 	if !d.IsNewResource() && d.HasChange("status") {
 		target := d.Get("status").(string)
-		err := resourceAlibabacloudStackDtsSubscriptionJobStatusFlow(d, meta, target)
+		err := resourceAlibabacloudStackDtsSubscriptionJobStatusFlow(ctx, d, meta, target)
 		if err != nil {
 			return WrapError(Error(FailedToReachTargetStatus, d.Get("status")))
 		}
@@ -579,7 +580,7 @@ func resourceAlibabacloudStackDtsSubscriptionJobUpdate(d *schema.ResourceData, m
 			return WrapError(err)
 		}
 		wait := incrementalWait(3*time.Second, 3*time.Second)
-		err = resource.Retry(d.Timeout(schema.TimeoutUpdate), func() *resource.RetryError {
+		err = resource.RetryContext(ctx, d.Timeout(schema.TimeoutUpdate), func() *resource.RetryError {
 			response, err = conn.DoRequest(StringPointer(action), nil, StringPointer("POST"), StringPointer("2020-01-01"), StringPointer("AK"), nil, configureSubscriptionReq, &util.RuntimeOptions{})
 			if err != nil {
 				if NeedRetry(err) {
@@ -616,15 +617,15 @@ func resourceAlibabacloudStackDtsSubscriptionJobUpdate(d *schema.ResourceData, m
 		//d.SetPartial("subscription_instance_vswitch_id")
 
 		target := d.Get("status").(string)
-		err = resourceAlibabacloudStackDtsSubscriptionJobStatusFlow(d, meta, target)
+		err = resourceAlibabacloudStackDtsSubscriptionJobStatusFlow(ctx, d, meta, target)
 		if err != nil {
 			return WrapError(Error(FailedToReachTargetStatus, d.Get("status")))
 		}
 	}
 	d.Partial(false)
-	return resourceAlibabacloudStackDtsSubscriptionJobRead(d, meta)
+	return resourceAlibabacloudStackDtsSubscriptionJobRead(ctx, d, meta)
 }
-func resourceAlibabacloudStackDtsSubscriptionJobDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceAlibabacloudStackDtsSubscriptionJobDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 
 	if v, ok := d.GetOk("payment_type"); ok {
 		if v.(string) == "Subscription" {
@@ -653,7 +654,7 @@ func resourceAlibabacloudStackDtsSubscriptionJobDelete(d *schema.ResourceData, m
 		request["SynchronizationDirection"] = v
 	}
 	wait := incrementalWait(3*time.Second, 3*time.Second)
-	err = resource.Retry(d.Timeout(schema.TimeoutDelete), func() *resource.RetryError {
+	err = resource.RetryContext(ctx, d.Timeout(schema.TimeoutDelete), func() *resource.RetryError {
 		response, err = conn.DoRequest(StringPointer(action), nil, StringPointer("POST"), StringPointer("2020-01-01"), StringPointer("AK"), nil, request, &util.RuntimeOptions{})
 		if err != nil {
 			if NeedRetry(err) {
@@ -670,7 +671,7 @@ func resourceAlibabacloudStackDtsSubscriptionJobDelete(d *schema.ResourceData, m
 	}
 	return nil
 }
-func resourceAlibabacloudStackDtsSubscriptionJobStatusFlow(d *schema.ResourceData, meta interface{}, target string) error {
+func resourceAlibabacloudStackDtsSubscriptionJobStatusFlow(ctx context.Context, d *schema.ResourceData, meta interface{}, target string) error {
 	client := meta.(*connectivity.AlibabacloudStackClient)
 	dtsService := DtsService{client}
 	var response map[string]interface{}
@@ -693,7 +694,7 @@ func resourceAlibabacloudStackDtsSubscriptionJobStatusFlow(d *schema.ResourceDat
 				return WrapError(err)
 			}
 			wait := incrementalWait(3*time.Second, 3*time.Second)
-			err = resource.Retry(d.Timeout(schema.TimeoutUpdate), func() *resource.RetryError {
+			err = resource.RetryContext(ctx, d.Timeout(schema.TimeoutUpdate), func() *resource.RetryError {
 				response, err = conn.DoRequest(StringPointer(action), nil, StringPointer("POST"), StringPointer("2020-01-01"), StringPointer("AK"), nil, request, &util.RuntimeOptions{})
 				if err != nil {
 					if NeedRetry(err) {
@@ -709,7 +710,7 @@ func resourceAlibabacloudStackDtsSubscriptionJobStatusFlow(d *schema.ResourceDat
 				return WrapErrorf(err, DefaultErrorMsg, d.Id(), action, AlibabacloudStackSdkGoERROR)
 			}
 			stateConf := BuildStateConf([]string{}, []string{"NotConfigured"}, d.Timeout(schema.TimeoutUpdate), 5*time.Second, dtsService.DtsSubscriptionJobStateRefreshFunc(d.Id(), []string{}))
-			if _, err := stateConf.WaitForState(); err != nil {
+			if _, err := stateConf.WaitForStateContext(ctx); err != nil {
 				return WrapErrorf(err, IdMsg, d.Id())
 			}
 		}
@@ -727,7 +728,7 @@ func resourceAlibabacloudStackDtsSubscriptionJobStatusFlow(d *schema.ResourceDat
 				return WrapError(err)
 			}
 			wait := incrementalWait(3*time.Second, 3*time.Second)
-			err = resource.Retry(d.Timeout(schema.TimeoutUpdate), func() *resource.RetryError {
+			err = resource.RetryContext(ctx, d.Timeout(schema.TimeoutUpdate), func() *resource.RetryError {
 				response, err = conn.DoRequest(StringPointer(action), nil, StringPointer("POST"), StringPointer("2020-01-01"), StringPointer("AK"), nil, request, &util.RuntimeOptions{})
 				if err != nil {
 					if NeedRetry(err) {
@@ -743,7 +744,7 @@ func resourceAlibabacloudStackDtsSubscriptionJobStatusFlow(d *schema.ResourceDat
 				return WrapErrorf(err, DefaultErrorMsg, d.Id(), action, AlibabacloudStackSdkGoERROR)
 			}
 			stateConf := BuildStateConf([]string{}, []string{"Starting", "Normal"}, d.Timeout(schema.TimeoutUpdate), 30*time.Second, dtsService.DtsSubscriptionJobStateRefreshFunc(d.Id(), []string{}))
-			if _, err := stateConf.WaitForState(); err != nil {
+			if _, err := stateConf.WaitForStateContext(ctx); err != nil {
 				return WrapErrorf(err, IdMsg, d.Id())
 			}
 		}
@@ -761,7 +762,7 @@ func resourceAlibabacloudStackDtsSubscriptionJobStatusFlow(d *schema.ResourceDat
 				return WrapError(err)
 			}
 			wait := incrementalWait(3*time.Second, 3*time.Second)
-			err = resource.Retry(d.Timeout(schema.TimeoutUpdate), func() *resource.RetryError {
+			err = resource.RetryContext(ctx, d.Timeout(schema.TimeoutUpdate), func() *resource.RetryError {
 				response, err = conn.DoRequest(StringPointer(action), nil, StringPointer("POST"), StringPointer("2020-01-01"), StringPointer("AK"), nil, request, &util.RuntimeOptions{})
 				if err != nil {
 					if NeedRetry(err) {
@@ -777,7 +778,7 @@ func resourceAlibabacloudStackDtsSubscriptionJobStatusFlow(d *schema.ResourceDat
 				return WrapErrorf(err, DefaultErrorMsg, d.Id(), action, AlibabacloudStackSdkGoERROR)
 			}
 			stateConf := BuildStateConf([]string{}, []string{"Abnormal"}, d.Timeout(schema.TimeoutUpdate), 30*time.Second, dtsService.DtsSubscriptionJobStateRefreshFunc(d.Id(), []string{}))
-			if _, err := stateConf.WaitForState(); err != nil {
+			if _, err := stateConf.WaitForStateContext(ctx); err != nil {
 				return WrapErrorf(err, IdMsg, d.Id())
 			}
 		}
@@ -1,6 +1,7 @@
 package alibabacloudstack
 
 import (
+	"context"
 	"fmt"
 	"log"
 	"time"
@@ -14,12 +15,12 @@ import (
 
 func resourceAlibabacloudStackDtsSynchronizationInstance() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceAlibabacloudStackDtsSynchronizationInstanceCreate,
-		Read:   resourceAlibabacloudStackDtsSynchronizationInstanceRead,
-		Update: resourceAlibabacloudStackDtsSynchronizationInstanceUpdate,
-		Delete: resourceAlibabacloudStackDtsSynchronizationInstanceDelete,
+		CreateContext: withDiagnostics(resourceAlibabacloudStackDtsSynchronizationInstanceCreate),
+		ReadContext:   withDiagnostics(resourceAlibabacloudStackDtsSynchronizationInstanceRead),
+		UpdateContext: withDiagnostics(resourceAlibabacloudStackDtsSynchronizationInstanceUpdate),
+		DeleteContext: withDiagnostics(resourceAlibabacloudStackDtsSynchronizationInstanceDelete),
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			StateContext: schema.ImportStatePassthroughContext,
 		},
 		Schema: map[string]*schema.Schema{
 			"compute_unit": {
@@ -91,7 +92,7 @@ func resourceAlibabacloudStackDtsSynchronizationInstance() *schema.Resource {
 	}
 }
 
-func resourceAlibabacloudStackDtsSynchronizationInstanceCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceAlibabacloudStackDtsSynchronizationInstanceCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*connectivity.AlibabacloudStackClient)
 	var response map[string]interface{}
 	action := "CreateDtsInstance"
@@ -150,7 +151,7 @@ func resourceAlibabacloudStackDtsSynchronizationInstanceCreate(d *schema.Resourc
 		request["UsedTime"] = v
 	}
 	wait := incrementalWait(3*time.Second, 3*time.Second)
-	err = resource.Retry(d.Timeout(schema.TimeoutCreate), func() *resource.RetryError {
+	err = resource.RetryContext(ctx, d.Timeout(schema.TimeoutCreate), func() *resource.RetryError {
 		response, err = conn.DoRequest(StringPointer(action), nil, StringPointer("POST"), StringPointer("2020-01-01"), StringPointer("AK"), nil, request, &util.RuntimeOptions{})
 		if err != nil {
 			if NeedRetry(err) {
@@ -168,9 +169,9 @@ func resourceAlibabacloudStackDtsSynchronizationInstanceCreate(d *schema.Resourc
 
 	d.SetId(fmt.Sprint(response["InstanceId"]))
 
-	return resourceAlibabacloudStackDtsSynchronizationInstanceRead(d, meta)
+	return resourceAlibabacloudStackDtsSynchronizationInstanceRead(ctx, d, meta)
 }
-func resourceAlibabacloudStackDtsSynchronizationInstanceRead(d *schema.ResourceData, meta interface{}) error {
+func resourceAlibabacloudStackDtsSynchronizationInstanceRead(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*connectivity.AlibabacloudStackClient)
 	dtsService := DtsService{client}
 	object, err := dtsService.DescribeDtsSynchronizationInstance(d.Id())
@@ -186,15 +187,15 @@ func resourceAlibabacloudStackDtsSynchronizationInstanceRead(d *schema.ResourceD
 	d.Set("payment_type", convertDtsSyncPaymentTypeResponse(object["PayType"]))
 	return nil
 }
-func resourceAlibabacloudStackDtsSynchronizationInstanceUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceAlibabacloudStackDtsSynchronizationInstanceUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 	log.Println(fmt.Sprintf("[WARNING] The resouce has not update operation."))
-	return resourceAlibabacloudStackDtsSynchronizationInstanceRead(d, meta)
+	return resourceAlibabacloudStackDtsSynchronizationInstanceRead(ctx, d, meta)
 }
-func resourceAlibabacloudStackDtsSynchronizationInstanceDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceAlibabacloudStackDtsSynchronizationInstanceDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 
 	if v, ok := d.GetOk("payment_type"); ok {
 		if v.(string) == "Subscription" {
-			log.Printf("[WARN] Cannot destroy resource: alibabacloudstack_dts_synchronization_job because it's s. Terraform will remove this resource from the state file, however resources may remain.")
+			addWarning(ctx, "payment_type", "Cannot destroy a Subscription synchronization instance", "Terraform will remove this resource from the state file, however resources may remain.")
 			return nil
 		}
 	}
@@ -214,7 +215,7 @@ func resourceAlibabacloudStackDtsSynchronizationInstanceDelete(d *schema.Resourc
 	request["product"] = "Dts"
 	request["OrganizationId"] = client.Department
 	wait := incrementalWait(3*time.Second, 3*time.Second)
-	err = resource.Retry(d.Timeout(schema.TimeoutDelete), func() *resource.RetryError {
+	err = resource.RetryContext(ctx, d.Timeout(schema.TimeoutDelete), func() *resource.RetryError {
 		response, err = conn.DoRequest(StringPointer(action), nil, StringPointer("POST"), StringPointer("2020-01-01"), StringPointer("AK"), nil, request, &util.RuntimeOptions{})
 		if err != nil {
 			if NeedRetry(err) {
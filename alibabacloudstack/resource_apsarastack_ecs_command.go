@@ -1,6 +1,7 @@
 package alibabacloudstack
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
 	"github.com/aliyun/alibaba-cloud-sdk-go/sdk/requests"
@@ -18,11 +19,11 @@ import (
 
 func resourceAlibabacloudStackEcsCommand() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceAlibabacloudStackEcsCommandCreate,
-		Read:   resourceAlibabacloudStackEcsCommandRead,
-		Delete: resourceAlibabacloudStackEcsCommandDelete,
+		CreateContext: withDiagnostics(resourceAlibabacloudStackEcsCommandCreate),
+		ReadContext:   withDiagnostics(resourceAlibabacloudStackEcsCommandRead),
+		DeleteContext: withDiagnostics(resourceAlibabacloudStackEcsCommandDelete),
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			StateContext: schema.ImportStatePassthroughContext,
 		},
 		Schema: map[string]*schema.Schema{
 			"command_content": {
@@ -67,7 +68,7 @@ func resourceAlibabacloudStackEcsCommand() *schema.Resource {
 	}
 }
 
-func resourceAlibabacloudStackEcsCommandCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceAlibabacloudStackEcsCommandCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*connectivity.AlibabacloudStackClient)
 	//var response map[string]interface{}
 	action := "CreateCommand"
@@ -154,7 +155,7 @@ func resourceAlibabacloudStackEcsCommandCreate(d *schema.ResourceData, meta inte
 	}
 
 	wait := incrementalWait(3*time.Second, 3*time.Second)
-	err := resource.Retry(d.Timeout(schema.TimeoutCreate), func() *resource.RetryError {
+	err := resource.RetryContext(ctx, d.Timeout(schema.TimeoutCreate), func() *resource.RetryError {
 		raw, err := client.WithEcsClient(func(EcsClient *ecs.Client) (interface{}, error) {
 			return EcsClient.ProcessCommonRequest(request)
 		})
@@ -178,9 +179,9 @@ func resourceAlibabacloudStackEcsCommandCreate(d *schema.ResourceData, meta inte
 		return WrapErrorf(err, DefaultErrorMsg, "alibabacloudstack_ecs_command", action, AlibabacloudStackSdkGoERROR)
 	}
 
-	return resourceAlibabacloudStackEcsCommandRead(d, meta)
+	return resourceAlibabacloudStackEcsCommandRead(ctx, d, meta)
 }
-func resourceAlibabacloudStackEcsCommandRead(d *schema.ResourceData, meta interface{}) error {
+func resourceAlibabacloudStackEcsCommandRead(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*connectivity.AlibabacloudStackClient)
 	ecsService := EcsService{client}
 	object, err := ecsService.DescribeEcsCommand(d.Id())
@@ -202,7 +203,7 @@ func resourceAlibabacloudStackEcsCommandRead(d *schema.ResourceData, meta interf
 	d.Set("working_dir", object.Commands.Command[0].WorkingDir)
 	return nil
 }
-func resourceAlibabacloudStackEcsCommandDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceAlibabacloudStackEcsCommandDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*connectivity.AlibabacloudStackClient)
 	action := "DeleteCommand"
 	//var response map[string]interface{}
@@ -244,7 +245,7 @@ func resourceAlibabacloudStackEcsCommandDelete(d *schema.ResourceData, meta inte
 
 	//request["RegionId"] = client.RegionId
 	wait := incrementalWait(3*time.Second, 3*time.Second)
-	err := resource.Retry(d.Timeout(schema.TimeoutDelete), func() *resource.RetryError {
+	err := resource.RetryContext(ctx, d.Timeout(schema.TimeoutDelete), func() *resource.RetryError {
 		//response, err = conn.DoRequest(StringPointer(action), nil, StringPointer("POST"), StringPointer("2014-05-26"), StringPointer("AK"), nil, request, &util.RuntimeOptions{})
 		response, err := client.WithEcsClient(func(EcsClient *ecs.Client) (interface{}, error) {
 			return EcsClient.ProcessCommonRequest(request)
@@ -1,6 +1,7 @@
 package alibabacloudstack
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
 	"github.com/aliyun/alibaba-cloud-sdk-go/sdk/requests"
@@ -21,12 +22,12 @@ import (
 
 func resourceAlibabacloudStackEcsDeploymentSet() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceAlibabacloudStackEcsDeploymentSetCreate,
-		Read:   resourceAlibabacloudStackEcsDeploymentSetRead,
-		Update: resourceAlibabacloudStackEcsDeploymentSetUpdate,
-		Delete: resourceAlibabacloudStackEcsDeploymentSetDelete,
+		CreateContext: withDiagnostics(resourceAlibabacloudStackEcsDeploymentSetCreate),
+		ReadContext:   withDiagnostics(resourceAlibabacloudStackEcsDeploymentSetRead),
+		UpdateContext: withDiagnostics(resourceAlibabacloudStackEcsDeploymentSetUpdate),
+		DeleteContext: withDiagnostics(resourceAlibabacloudStackEcsDeploymentSetDelete),
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			StateContext: schema.ImportStatePassthroughContext,
 		},
 		Schema: map[string]*schema.Schema{
 			"deployment_set_name": {
@@ -66,7 +67,7 @@ func resourceAlibabacloudStackEcsDeploymentSet() *schema.Resource {
 	}
 }
 
-func resourceAlibabacloudStackEcsDeploymentSetCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceAlibabacloudStackEcsDeploymentSetCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*connectivity.AlibabacloudStackClient)
 	//var response map[string]interface{}
 	action := "CreateDeploymentSet"
@@ -136,7 +137,7 @@ func resourceAlibabacloudStackEcsDeploymentSetCreate(d *schema.ResourceData, met
 	runtime := util.RuntimeOptions{}
 	runtime.SetAutoretry(true)
 	wait := incrementalWait(3*time.Second, 3*time.Second)
-	err := resource.Retry(d.Timeout(schema.TimeoutCreate), func() *resource.RetryError {
+	err := resource.RetryContext(ctx, d.Timeout(schema.TimeoutCreate), func() *resource.RetryError {
 		raw, err := client.WithEcsClient(func(EcsClient *ecs.Client) (interface{}, error) {
 			return EcsClient.ProcessCommonRequest(request)
 		})
@@ -159,9 +160,9 @@ func resourceAlibabacloudStackEcsDeploymentSetCreate(d *schema.ResourceData, met
 		return WrapErrorf(err, DefaultErrorMsg, "alibabacloudstack_ecs_deployment_set", action, AlibabacloudStackSdkGoERROR)
 	}
 
-	return resourceAlibabacloudStackEcsDeploymentSetRead(d, meta)
+	return resourceAlibabacloudStackEcsDeploymentSetRead(ctx, d, meta)
 }
-func resourceAlibabacloudStackEcsDeploymentSetRead(d *schema.ResourceData, meta interface{}) error {
+func resourceAlibabacloudStackEcsDeploymentSetRead(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*connectivity.AlibabacloudStackClient)
 	ecsService := EcsService{client}
 	object, err := ecsService.DescribeEcsDeploymentSet(d.Id())
@@ -182,7 +183,7 @@ func resourceAlibabacloudStackEcsDeploymentSetRead(d *schema.ResourceData, meta
 
 	return nil
 }
-func resourceAlibabacloudStackEcsDeploymentSetUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceAlibabacloudStackEcsDeploymentSetUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*connectivity.AlibabacloudStackClient)
 	request := requests.NewCommonRequest()
 
@@ -232,7 +233,7 @@ func resourceAlibabacloudStackEcsDeploymentSetUpdate(d *schema.ResourceData, met
 	if update {
 
 		wait := incrementalWait(3*time.Second, 3*time.Second)
-		err := resource.Retry(d.Timeout(schema.TimeoutCreate), func() *resource.RetryError {
+		err := resource.RetryContext(ctx, d.Timeout(schema.TimeoutCreate), func() *resource.RetryError {
 			response, err := client.WithEcsClient(func(EcsClient *ecs.Client) (interface{}, error) {
 				return EcsClient.ProcessCommonRequest(request)
 			})
@@ -250,9 +251,9 @@ func resourceAlibabacloudStackEcsDeploymentSetUpdate(d *schema.ResourceData, met
 			return WrapErrorf(err, DefaultErrorMsg, d.Id(), action, AlibabacloudStackSdkGoERROR)
 		}
 	}
-	return resourceAlibabacloudStackEcsDeploymentSetRead(d, meta)
+	return resourceAlibabacloudStackEcsDeploymentSetRead(ctx, d, meta)
 }
-func resourceAlibabacloudStackEcsDeploymentSetDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceAlibabacloudStackEcsDeploymentSetDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*connectivity.AlibabacloudStackClient)
 	action := "DeleteDeploymentSet"
 	DeploymentSetId := d.Id()
@@ -280,7 +281,7 @@ func resourceAlibabacloudStackEcsDeploymentSetDelete(d *schema.ResourceData, met
 		"DeploymentSetId": DeploymentSetId,
 	}
 	wait := incrementalWait(3*time.Second, 3*time.Second)
-	err := resource.Retry(d.Timeout(schema.TimeoutCreate), func() *resource.RetryError {
+	err := resource.RetryContext(ctx, d.Timeout(schema.TimeoutCreate), func() *resource.RetryError {
 		response, err := client.WithEcsClient(func(EcsClient *ecs.Client) (interface{}, error) {
 			return EcsClient.ProcessCommonRequest(request)
 		})
@@ -1,6 +1,7 @@
 package alibabacloudstack
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
 	"github.com/aliyun/alibaba-cloud-sdk-go/sdk/requests"
@@ -18,11 +19,11 @@ import (
 
 func resourceAlibabacloudStackEcsEbsStorageSets() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceAlibabacloudStackEcsEbsStorageSetsCreate,
-		Read:   resourceAlibabacloudStackEcsEbsStorageSetsRead,
-		Delete: resourceAlibabacloudStackEcsEbsStorageSetsDelete,
+		CreateContext: withDiagnostics(resourceAlibabacloudStackEcsEbsStorageSetsCreate),
+		ReadContext:   withDiagnostics(resourceAlibabacloudStackEcsEbsStorageSetsRead),
+		DeleteContext: withDiagnostics(resourceAlibabacloudStackEcsEbsStorageSetsDelete),
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			StateContext: schema.ImportStatePassthroughContext,
 		},
 		Schema: map[string]*schema.Schema{
 			"storage_set_name": {
@@ -44,7 +45,7 @@ func resourceAlibabacloudStackEcsEbsStorageSets() *schema.Resource {
 	}
 }
 
-func resourceAlibabacloudStackEcsEbsStorageSetsCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceAlibabacloudStackEcsEbsStorageSetsCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*connectivity.AlibabacloudStackClient)
 	//var response map[string]interface{}
 	action := "CreateStorageSet"
@@ -92,7 +93,7 @@ func resourceAlibabacloudStackEcsEbsStorageSetsCreate(d *schema.ResourceData, me
 	}
 
 	wait := incrementalWait(3*time.Second, 3*time.Second)
-	err := resource.Retry(d.Timeout(schema.TimeoutCreate), func() *resource.RetryError {
+	err := resource.RetryContext(ctx, d.Timeout(schema.TimeoutCreate), func() *resource.RetryError {
 		raw, err := client.WithEcsClient(func(EcsClient *ecs.Client) (interface{}, error) {
 			return EcsClient.ProcessCommonRequest(request)
 		})
@@ -116,9 +117,9 @@ func resourceAlibabacloudStackEcsEbsStorageSetsCreate(d *schema.ResourceData, me
 		return WrapErrorf(err, DefaultErrorMsg, "alibabacloudstack_ecs_command", action, AlibabacloudStackSdkGoERROR)
 	}
 
-	return resourceAlibabacloudStackEcsEbsStorageSetsRead(d, meta)
+	return resourceAlibabacloudStackEcsEbsStorageSetsRead(ctx, d, meta)
 }
-func resourceAlibabacloudStackEcsEbsStorageSetsRead(d *schema.ResourceData, meta interface{}) error {
+func resourceAlibabacloudStackEcsEbsStorageSetsRead(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*connectivity.AlibabacloudStackClient)
 	ecsService := EcsService{client}
 	object, err := ecsService.DescribeEcsEbsStorageSet(d.Id())
@@ -136,7 +137,7 @@ func resourceAlibabacloudStackEcsEbsStorageSetsRead(d *schema.ResourceData, meta
 	d.Set("maxpartition_number", strconv.Itoa(object.StorageSets.StorageSet[0].StorageSetPartitionNumber))
 	return nil
 }
-func resourceAlibabacloudStackEcsEbsStorageSetsDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceAlibabacloudStackEcsEbsStorageSetsDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*connectivity.AlibabacloudStackClient)
 	action := "DeleteStorageSet"
 	//var response map[string]interface{}
@@ -178,7 +179,7 @@ func resourceAlibabacloudStackEcsEbsStorageSetsDelete(d *schema.ResourceData, me
 
 	//request["RegionId"] = client.RegionId
 	wait := incrementalWait(3*time.Second, 3*time.Second)
-	err := resource.Retry(d.Timeout(schema.TimeoutDelete), func() *resource.RetryError {
+	err := resource.RetryContext(ctx, d.Timeout(schema.TimeoutDelete), func() *resource.RetryError {
 		//response, err = conn.DoRequest(StringPointer(action), nil, StringPointer("POST"), StringPointer("2014-05-26"), StringPointer("AK"), nil, request, &util.RuntimeOptions{})
 		response, err := client.WithEcsClient(func(EcsClient *ecs.Client) (interface{}, error) {
 			return EcsClient.ProcessCommonRequest(request)
@@ -1,6 +1,7 @@
 package alibabacloudstack
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
 	"github.com/aliyun/alibaba-cloud-sdk-go/sdk/requests"
@@ -16,12 +17,12 @@ import (
 
 func resourceAlibabacloudStackEcsHpcCluster() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceAlibabacloudStackEcsHpcClusterCreate,
-		Read:   resourceAlibabacloudStackEcsHpcClusterRead,
-		Update: resourceAlibabacloudStackEcsHpcClusterUpdate,
-		Delete: resourceAlibabacloudStackEcsHpcClusterDelete,
+		CreateContext: withDiagnostics(resourceAlibabacloudStackEcsHpcClusterCreate),
+		ReadContext:   withDiagnostics(resourceAlibabacloudStackEcsHpcClusterRead),
+		UpdateContext: withDiagnostics(resourceAlibabacloudStackEcsHpcClusterUpdate),
+		DeleteContext: withDiagnostics(resourceAlibabacloudStackEcsHpcClusterDelete),
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			StateContext: schema.ImportStatePassthroughContext,
 		},
 		Schema: map[string]*schema.Schema{
 			"description": {
@@ -36,7 +37,7 @@ func resourceAlibabacloudStackEcsHpcCluster() *schema.Resource {
 	}
 }
 
-func resourceAlibabacloudStackEcsHpcClusterCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceAlibabacloudStackEcsHpcClusterCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*connectivity.AlibabacloudStackClient)
 	//var response map[string]interface{}
 	action := "CreateHpcCluster"
@@ -96,9 +97,9 @@ func resourceAlibabacloudStackEcsHpcClusterCreate(d *schema.ResourceData, meta i
 	//var response *ecs.CreateHpcClusterResponse
 	//response, _ = raw.(*ecs.CreateHpcClusterResponse)
 	d.SetId(fmt.Sprint(resp.HpcClusterId))
-	return resourceAlibabacloudStackEcsHpcClusterRead(d, meta)
+	return resourceAlibabacloudStackEcsHpcClusterRead(ctx, d, meta)
 }
-func resourceAlibabacloudStackEcsHpcClusterRead(d *schema.ResourceData, meta interface{}) error {
+func resourceAlibabacloudStackEcsHpcClusterRead(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*connectivity.AlibabacloudStackClient)
 
 	ecsService := EcsService{client}
@@ -116,7 +117,7 @@ func resourceAlibabacloudStackEcsHpcClusterRead(d *schema.ResourceData, meta int
 	d.Set("name", object.HpcClusters.HpcCluster[0].Name)
 	return nil
 }
-func resourceAlibabacloudStackEcsHpcClusterUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceAlibabacloudStackEcsHpcClusterUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*connectivity.AlibabacloudStackClient)
 
 	//var response map[string]interface{}
@@ -182,9 +183,9 @@ func resourceAlibabacloudStackEcsHpcClusterUpdate(d *schema.ResourceData, meta i
 			return WrapErrorf(err, DefaultErrorMsg, d.Id(), action, AlibabacloudStackSdkGoERROR)
 		}
 	}
-	return resourceAlibabacloudStackEcsHpcClusterRead(d, meta)
+	return resourceAlibabacloudStackEcsHpcClusterRead(ctx, d, meta)
 }
-func resourceAlibabacloudStackEcsHpcClusterDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceAlibabacloudStackEcsHpcClusterDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*connectivity.AlibabacloudStackClient)
 	action := "DeleteHpcCluster"
 
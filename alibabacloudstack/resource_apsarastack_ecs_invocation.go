@@ -0,0 +1,274 @@
+package alibabacloudstack
+
+import (
+	"context"
+	"encoding/base64"
+	"fmt"
+	"log"
+	"strings"
+	"time"
+
+	"github.com/aliyun/terraform-provider-alibabacloudstack/alibabacloudstack/connectivity"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+func resourceAlibabacloudStackEcsInvocation() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: withDiagnostics(resourceAlibabacloudStackEcsInvocationCreate),
+		ReadContext:   withDiagnostics(resourceAlibabacloudStackEcsInvocationRead),
+		DeleteContext: withDiagnostics(resourceAlibabacloudStackEcsInvocationDelete),
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(5 * time.Minute),
+		},
+		Schema: map[string]*schema.Schema{
+			"command_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"instance_id": {
+				Type:     schema.TypeList,
+				Required: true,
+				ForceNew: true,
+				MinItems: 1,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"parameters": {
+				Type:     schema.TypeMap,
+				Optional: true,
+				ForceNew: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"repeat_mode": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				Default:      "Once",
+				ValidateFunc: validation.StringInSlice([]string{"Once", "Period", "NextRebootOnly", "EveryReboot"}, false),
+			},
+			"frequency": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+			},
+			"timeout": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.IntBetween(10, 86400),
+			},
+			"username": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+			},
+			"fail_on_non_zero_exit": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				ForceNew: true,
+				Default:  false,
+			},
+			"status": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"invocation_results": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"instance_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"invocation_status": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"exit_code": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"output": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"error_code": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"error_info": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"start_time": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"finished_time": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func resourceAlibabacloudStackEcsInvocationCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*connectivity.AlibabacloudStackClient)
+	ecsService := EcsService{client}
+	action := "InvokeCommand"
+	params := map[string]string{
+		"CommandId":  d.Get("command_id").(string),
+		"RepeatMode": d.Get("repeat_mode").(string),
+	}
+	for i, instanceId := range d.Get("instance_id").([]interface{}) {
+		params[fmt.Sprintf("InstanceId.%d", i+1)] = fmt.Sprint(instanceId)
+	}
+	if v, ok := d.GetOk("parameters"); ok {
+		parameters, err := convertMaptoJsonString(v.(map[string]interface{}))
+		if err != nil {
+			return WrapError(err)
+		}
+		params["Parameters"] = parameters
+	}
+	if v, ok := d.GetOk("frequency"); ok {
+		params["Frequency"] = v.(string)
+	}
+	if v, ok := d.GetOk("timeout"); ok {
+		params["Timeout"] = fmt.Sprint(v.(int))
+	}
+	if v, ok := d.GetOk("username"); ok {
+		params["Username"] = v.(string)
+	}
+	response, err := ecsService.doEcsCommonRequest(ctx, action, params, d.Timeout(schema.TimeoutCreate))
+	if err != nil {
+		return WrapErrorf(err, DefaultErrorMsg, "alibabacloudstack_ecs_invocation", action, AlibabacloudStackSdkGoERROR)
+	}
+	d.SetId(fmt.Sprint(response["InvokeId"]))
+
+	// The invocation which is repeated never finishes, so only the one which runs once is waited.
+	if d.Get("repeat_mode").(string) == "Once" {
+		stateConf := BuildStateConf([]string{"Pending", "Scheduled", "Running"}, []string{"Finished", "Success", "Failed", "PartialFailed", "Stopped"}, d.Timeout(schema.TimeoutCreate), 5*time.Second, ecsService.EcsInvocationStateRefreshFunc(ctx, d.Id(), []string{}))
+		if _, err := stateConf.WaitForStateContext(ctx); err != nil {
+			return WrapErrorf(err, IdMsg, d.Id())
+		}
+	}
+
+	if err := resourceAlibabacloudStackEcsInvocationRead(ctx, d, meta); err != nil {
+		return WrapError(err)
+	}
+
+	if d.Get("fail_on_non_zero_exit").(bool) {
+		var failed []string
+		for _, v := range d.Get("invocation_results").([]interface{}) {
+			result := v.(map[string]interface{})
+			if result["exit_code"].(int) != 0 || result["error_code"].(string) != "" {
+				failed = append(failed, fmt.Sprintf("%s (exit code %d, %s%s)", result["instance_id"], result["exit_code"], result["error_code"], result["error_info"]))
+			}
+		}
+		if len(failed) > 0 {
+			return WrapError(fmt.Errorf("the invocation %s failed on the instances: %s", d.Id(), strings.Join(failed, ", ")))
+		}
+	}
+	return nil
+}
+
+func resourceAlibabacloudStackEcsInvocationRead(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*connectivity.AlibabacloudStackClient)
+	ecsService := EcsService{client}
+	object, err := ecsService.DescribeEcsInvocation(ctx, d.Id())
+	if err != nil {
+		if NotFoundError(err) {
+			log.Printf("[DEBUG] Resource alibabacloudstack_ecs_invocation ecsService.DescribeEcsInvocation Failed!!! %s", err)
+			d.SetId("")
+			return nil
+		}
+		return WrapError(err)
+	}
+	d.Set("command_id", object["CommandId"])
+	d.Set("status", object["InvocationStatus"])
+	if v, ok := object["RepeatMode"]; ok && fmt.Sprint(v) != "" {
+		d.Set("repeat_mode", v)
+	}
+	d.Set("frequency", object["Frequency"])
+	d.Set("username", object["Username"])
+
+	results, err := ecsService.DescribeEcsInvocationResults(ctx, d.Id())
+	if err != nil {
+		return WrapError(err)
+	}
+	invocationResults := make([]map[string]interface{}, 0)
+	instanceIds := make([]string, 0)
+	for _, v := range results {
+		result := v.(map[string]interface{})
+		instanceIds = append(instanceIds, fmt.Sprint(result["InstanceId"]))
+		invocationResults = append(invocationResults, map[string]interface{}{
+			"instance_id":       result["InstanceId"],
+			"invocation_status": result["InvocationStatus"],
+			"exit_code":         formatInt(result["ExitCode"]),
+			"output":            decodeEcsInvocationOutput(result["Output"]),
+			"error_code":        result["ErrorCode"],
+			"error_info":        result["ErrorInfo"],
+			"start_time":        result["StartTime"],
+			"finished_time":     result["FinishedTime"],
+		})
+	}
+	if err := d.Set("invocation_results", invocationResults); err != nil {
+		return WrapError(err)
+	}
+	if _, ok := d.GetOk("instance_id"); !ok {
+		d.Set("instance_id", instanceIds)
+	}
+	return nil
+}
+
+func resourceAlibabacloudStackEcsInvocationDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*connectivity.AlibabacloudStackClient)
+	ecsService := EcsService{client}
+	object, err := ecsService.DescribeEcsInvocation(ctx, d.Id())
+	if err != nil {
+		if NotFoundError(err) {
+			return nil
+		}
+		return WrapError(err)
+	}
+	// Only the invocation which is still running needs to be stopped, the finished one is kept as a record.
+	switch fmt.Sprint(object["InvocationStatus"]) {
+	case "Pending", "Scheduled", "Running":
+	default:
+		return nil
+	}
+	action := "StopInvocation"
+	params := map[string]string{
+		"InvokeId": d.Id(),
+	}
+	for i, instanceId := range d.Get("instance_id").([]interface{}) {
+		params[fmt.Sprintf("InstanceId.%d", i+1)] = fmt.Sprint(instanceId)
+	}
+	if _, err := ecsService.doEcsCommonRequest(ctx, action, params, 5*time.Minute); err != nil {
+		if IsExpectedErrors(err, []string{"InvalidInvokeId.NotFound"}) {
+			return nil
+		}
+		return WrapErrorf(err, DefaultErrorMsg, d.Id(), action, AlibabacloudStackSdkGoERROR)
+	}
+	return nil
+}
+
+// decodeEcsInvocationOutput decodes the output of the command, which is encoded in base64.
+func decodeEcsInvocationOutput(output interface{}) string {
+	if output == nil {
+		return ""
+	}
+	decoded, err := base64.StdEncoding.DecodeString(fmt.Sprint(output))
+	if err != nil {
+		return fmt.Sprint(output)
+	}
+	return string(decoded)
+}
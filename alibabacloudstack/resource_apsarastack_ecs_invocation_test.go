@@ -0,0 +1,109 @@
+package alibabacloudstack
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/aliyun/terraform-provider-alibabacloudstack/alibabacloudstack/connectivity"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccAlibabacloudStackEcsInvocation_basic(t *testing.T) {
+	var v map[string]interface{}
+	resourceId := "alibabacloudstack_ecs_invocation.default"
+	ra := resourceAttrInit(resourceId, AlibabacloudStackEcsInvocationMap)
+	rc := resourceCheckInitWithDescribeMethod(resourceId, &v, func() interface{} {
+		return &EcsService{testAccProvider.Meta().(*connectivity.AlibabacloudStackClient)}
+	}, "DescribeEcsInvocation")
+	rac := resourceAttrCheckInit(rc, ra)
+	testAccCheck := rac.resourceAttrMapUpdateSet()
+	rand := acctest.RandIntRange(10000, 99999)
+	name := fmt.Sprintf("tf-testAcc%sAlibabacloudStackEcsInvocation%d", defaultRegionToTest, rand)
+	testAccConfig := resourceTestAccConfigFunc(resourceId, name, AlibabacloudStackEcsInvocationBasicDependence)
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+		},
+
+		IDRefreshName: resourceId,
+		Providers:     testAccProviders,
+		CheckDestroy:  nil,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccConfig(map[string]interface{}{
+					"command_id":            "${alibabacloudstack_ecs_command.default.id}",
+					"instance_id":           []string{"${alibabacloudstack_instance.default.id}"},
+					"parameters":            map[string]string{"name": "terraform"},
+					"fail_on_non_zero_exit": "true",
+				}),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheck(map[string]string{
+						"command_id":                             CHECKSET,
+						"instance_id.#":                          "1",
+						"parameters.%":                           "1",
+						"invocation_results.#":                   "1",
+						"invocation_results.0.exit_code":         "0",
+						"invocation_results.0.output":            "hello terraform\n",
+						"invocation_results.0.invocation_status": "Success",
+					}),
+				),
+			},
+			{
+				ResourceName:            resourceId,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"parameters", "timeout", "fail_on_non_zero_exit"},
+			},
+		},
+	})
+}
+
+var AlibabacloudStackEcsInvocationMap = map[string]string{
+	"repeat_mode": "Once",
+	"status":      CHECKSET,
+}
+
+func AlibabacloudStackEcsInvocationBasicDependence(name string) string {
+	return fmt.Sprintf(`
+%s
+%s
+%s
+variable "name" {
+  default = "%s"
+}
+
+resource "alibabacloudstack_vpc" "default" {
+  name       = var.name
+  cidr_block = "172.16.0.0/12"
+}
+
+resource "alibabacloudstack_vswitch" "default" {
+  name              = var.name
+  vpc_id            = alibabacloudstack_vpc.default.id
+  cidr_block        = "172.16.0.0/16"
+  availability_zone = data.alibabacloudstack_zones.default.zones.0.id
+}
+
+resource "alibabacloudstack_security_group" "default" {
+  name   = var.name
+  vpc_id = alibabacloudstack_vpc.default.id
+}
+
+resource "alibabacloudstack_instance" "default" {
+  image_id             = data.alibabacloudstack_images.default.images.0.id
+  instance_type        = local.instance_type_id
+  system_disk_category = "cloud_efficiency"
+  security_groups      = [alibabacloudstack_security_group.default.id]
+  instance_name        = var.name
+  vswitch_id           = alibabacloudstack_vswitch.default.id
+}
+
+resource "alibabacloudstack_ecs_command" "default" {
+  name             = var.name
+  command_content  = base64encode("echo hello {{name}}")
+  type             = "RunShellScript"
+  enable_parameter = true
+}
+`, DataAlibabacloudstackVswitchZones, DataAlibabacloudstackInstanceTypes, DataAlibabacloudstackImages, name)
+}
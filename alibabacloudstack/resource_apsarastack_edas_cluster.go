@@ -1,6 +1,7 @@
 package alibabacloudstack
 
 import (
+	"context"
 	"github.com/aliyun/alibaba-cloud-sdk-go/sdk/requests"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"time"
@@ -15,11 +16,11 @@ import (
 
 func resourceAlibabacloudStackEdasCluster() *schema.Resource {
 	return &schema.Resource{
-		Create: rresourceAlibabacloudStackEdasClusterCreate,
-		Read:   resourceAlibabacloudStackEdasClusterRead,
-		Delete: resourceAlibabacloudStackEdasClusterDelete,
+		CreateContext: withDiagnostics(rresourceAlibabacloudStackEdasClusterCreate),
+		ReadContext:   withDiagnostics(resourceAlibabacloudStackEdasClusterRead),
+		DeleteContext: withDiagnostics(resourceAlibabacloudStackEdasClusterDelete),
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			StateContext: schema.ImportStatePassthroughContext,
 		},
 		Schema: map[string]*schema.Schema{
 			"cluster_name": {
@@ -53,7 +54,7 @@ func resourceAlibabacloudStackEdasCluster() *schema.Resource {
 	}
 }
 
-func rresourceAlibabacloudStackEdasClusterCreate(d *schema.ResourceData, meta interface{}) error {
+func rresourceAlibabacloudStackEdasClusterCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*connectivity.AlibabacloudStackClient)
 	edasService := EdasService{client}
 
@@ -89,10 +90,10 @@ func rresourceAlibabacloudStackEdasClusterCreate(d *schema.ResourceData, meta in
 	}
 	d.SetId(response.Cluster.ClusterId)
 
-	return resourceAlibabacloudStackEdasClusterRead(d, meta)
+	return resourceAlibabacloudStackEdasClusterRead(ctx, d, meta)
 }
 
-func resourceAlibabacloudStackEdasClusterRead(d *schema.ResourceData, meta interface{}) error {
+func resourceAlibabacloudStackEdasClusterRead(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*connectivity.AlibabacloudStackClient)
 	edasService := EdasService{client}
 
@@ -129,7 +130,7 @@ func resourceAlibabacloudStackEdasClusterRead(d *schema.ResourceData, meta inter
 	return nil
 }
 
-func resourceAlibabacloudStackEdasClusterDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceAlibabacloudStackEdasClusterDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*connectivity.AlibabacloudStackClient)
 	edasService := EdasService{client}
 
@@ -144,7 +145,7 @@ func resourceAlibabacloudStackEdasClusterDelete(d *schema.ResourceData, meta int
 	request.Headers["x-acs-content-type"] = "application/x-www-form-urlencoded"
 
 	wait := incrementalWait(1*time.Second, 2*time.Second)
-	err := resource.Retry(5*time.Minute, func() *resource.RetryError {
+	err := resource.RetryContext(ctx, 5*time.Minute, func() *resource.RetryError {
 		raw, err := edasService.client.WithEdasClient(func(edasClient *edas.Client) (interface{}, error) {
 			return edasClient.DeleteCluster(request)
 		})
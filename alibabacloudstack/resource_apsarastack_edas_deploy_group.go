@@ -1,6 +1,7 @@
 package alibabacloudstack
 
 import (
+	"context"
 	"time"
 
 	"github.com/aliyun/alibaba-cloud-sdk-go/services/edas"
@@ -11,11 +12,11 @@ import (
 
 func resourceAlibabacloudStackEdasDeployGroup() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceAlibabacloudStackEdasDeployGroupCreate,
-		Read:   resourceAlibabacloudStackEdasDeployGroupRead,
-		Delete: resourceAlibabacloudStackEdasDeployGroupDelete,
+		CreateContext: withDiagnostics(resourceAlibabacloudStackEdasDeployGroupCreate),
+		ReadContext:   withDiagnostics(resourceAlibabacloudStackEdasDeployGroupRead),
+		DeleteContext: withDiagnostics(resourceAlibabacloudStackEdasDeployGroupDelete),
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			StateContext: schema.ImportStatePassthroughContext,
 		},
 		Schema: map[string]*schema.Schema{
 			"app_id": {
@@ -37,7 +38,7 @@ func resourceAlibabacloudStackEdasDeployGroup() *schema.Resource {
 	}
 }
 
-func resourceAlibabacloudStackEdasDeployGroupCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceAlibabacloudStackEdasDeployGroupCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*connectivity.AlibabacloudStackClient)
 	edasService := EdasService{client}
 
@@ -53,7 +54,7 @@ func resourceAlibabacloudStackEdasDeployGroupCreate(d *schema.ResourceData, meta
 	request.Headers["x-acs-organizationid"] = client.Department
 	request.Headers["x-acs-content-type"] = "application/x-www-form-urlencoded"
 	wait := incrementalWait(1*time.Second, 2*time.Second)
-	err := resource.Retry(5*time.Minute, func() *resource.RetryError {
+	err := resource.RetryContext(ctx, 5*time.Minute, func() *resource.RetryError {
 		raw, err := edasService.client.WithEdasClient(func(edasClient *edas.Client) (interface{}, error) {
 			return edasClient.InsertDeployGroup(request)
 		})
@@ -75,10 +76,10 @@ func resourceAlibabacloudStackEdasDeployGroupCreate(d *schema.ResourceData, meta
 		return WrapErrorf(err, DefaultErrorMsg, "alibabacloudstack_edas_deploy_group", request.GetActionName(), AlibabacloudStackSdkGoERROR)
 	}
 
-	return resourceAlibabacloudStackEdasDeployGroupRead(d, meta)
+	return resourceAlibabacloudStackEdasDeployGroupRead(ctx, d, meta)
 }
 
-func resourceAlibabacloudStackEdasDeployGroupRead(d *schema.ResourceData, meta interface{}) error {
+func resourceAlibabacloudStackEdasDeployGroupRead(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*connectivity.AlibabacloudStackClient)
 	edasService := EdasService{client}
 
@@ -105,7 +106,7 @@ func resourceAlibabacloudStackEdasDeployGroupRead(d *schema.ResourceData, meta i
 	return nil
 }
 
-func resourceAlibabacloudStackEdasDeployGroupDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceAlibabacloudStackEdasDeployGroupDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*connectivity.AlibabacloudStackClient)
 	edasService := EdasService{client}
 
@@ -117,7 +118,7 @@ func resourceAlibabacloudStackEdasDeployGroupDelete(d *schema.ResourceData, meta
 	request.Headers["x-acs-organizationid"] = client.Department
 	request.Headers["x-acs-content-type"] = "application/x-www-form-urlencoded"
 	wait := incrementalWait(1*time.Second, 2*time.Second)
-	err := resource.Retry(5*time.Minute, func() *resource.RetryError {
+	err := resource.RetryContext(ctx, 5*time.Minute, func() *resource.RetryError {
 		raw, err := edasService.client.WithEdasClient(func(edasClient *edas.Client) (interface{}, error) {
 			return edasClient.DeleteDeployGroup(request)
 		})
@@ -1,6 +1,7 @@
 package alibabacloudstack
 
 import (
+	"context"
 	"strings"
 	"time"
 
@@ -13,11 +14,11 @@ import (
 
 func resourceAlibabacloudStackEdasInstanceClusterAttachment() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceAlibabacloudStackEdasInstanceClusterAttachmentCreate,
-		Read:   resourceAlibabacloudStackEdasInstanceClusterAttachmentRead,
-		Delete: resourceAlibabacloudStackEdasInstanceClusterAttachmentDelete,
+		CreateContext: withDiagnostics(resourceAlibabacloudStackEdasInstanceClusterAttachmentCreate),
+		ReadContext:   withDiagnostics(resourceAlibabacloudStackEdasInstanceClusterAttachmentRead),
+		DeleteContext: withDiagnostics(resourceAlibabacloudStackEdasInstanceClusterAttachmentDelete),
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			StateContext: schema.ImportStatePassthroughContext,
 		},
 		Schema: map[string]*schema.Schema{
 			"cluster_id": {
@@ -64,7 +65,7 @@ func resourceAlibabacloudStackEdasInstanceClusterAttachment() *schema.Resource {
 	}
 }
 
-func resourceAlibabacloudStackEdasInstanceClusterAttachmentCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceAlibabacloudStackEdasInstanceClusterAttachmentCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*connectivity.AlibabacloudStackClient)
 	edasService := EdasService{client}
 
@@ -90,7 +91,7 @@ func resourceAlibabacloudStackEdasInstanceClusterAttachmentCreate(d *schema.Reso
 	}
 
 	wait := incrementalWait(3*time.Second, 3*time.Second)
-	err := resource.Retry(d.Timeout(schema.TimeoutCreate), func() *resource.RetryError {
+	err := resource.RetryContext(ctx, d.Timeout(schema.TimeoutCreate), func() *resource.RetryError {
 		raw, err := edasService.client.WithEdasClient(func(edasClient *edas.Client) (interface{}, error) {
 			return edasClient.InsertClusterMember(request)
 		})
@@ -156,10 +157,10 @@ func resourceAlibabacloudStackEdasInstanceClusterAttachmentCreate(d *schema.Reso
 		time.Sleep(30 * time.Second)
 		cnt++
 	}
-	return resourceAlibabacloudStackEdasInstanceClusterAttachmentRead(d, meta)
+	return resourceAlibabacloudStackEdasInstanceClusterAttachmentRead(ctx, d, meta)
 }
 
-func resourceAlibabacloudStackEdasInstanceClusterAttachmentRead(d *schema.ResourceData, meta interface{}) error {
+func resourceAlibabacloudStackEdasInstanceClusterAttachmentRead(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*connectivity.AlibabacloudStackClient)
 	edasService := EdasService{client}
 
@@ -208,7 +209,7 @@ func resourceAlibabacloudStackEdasInstanceClusterAttachmentRead(d *schema.Resour
 }
 
 //有问题 单个实例删除失败会影响整个过程
-func resourceAlibabacloudStackEdasInstanceClusterAttachmentDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceAlibabacloudStackEdasInstanceClusterAttachmentDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*connectivity.AlibabacloudStackClient)
 	edasService := EdasService{client}
 
@@ -222,7 +223,7 @@ func resourceAlibabacloudStackEdasInstanceClusterAttachmentDelete(d *schema.Reso
 		request.Headers["x-acs-organizationid"] = client.Department
 		request.Headers["x-acs-content-type"] = "application/x-www-form-urlencoded"
 		wait := incrementalWait(3*time.Second, 3*time.Second)
-		err := resource.Retry(1*time.Minute, func() *resource.RetryError {
+		err := resource.RetryContext(ctx, 1*time.Minute, func() *resource.RetryError {
 			raw, err := edasService.client.WithEdasClient(func(edasClient *edas.Client) (interface{}, error) {
 				return edasClient.DeleteClusterMember(request)
 
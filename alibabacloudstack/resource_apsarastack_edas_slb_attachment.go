@@ -1,6 +1,7 @@
 package alibabacloudstack
 
 import (
+	"context"
 	"github.com/aliyun/alibaba-cloud-sdk-go/sdk/requests"
 	"github.com/aliyun/alibaba-cloud-sdk-go/services/edas"
 	"github.com/aliyun/terraform-provider-alibabacloudstack/alibabacloudstack/connectivity"
@@ -10,11 +11,11 @@ import (
 
 func resourceAlibabacloudStackEdasSlbAttachment() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceAlibabacloudStackEdasSlbAttachmentCreate,
-		Read:   resourceAlibabacloudStackEdasSlbAttachmentRead,
-		Delete: resourceAlibabacloudStackEdasSlbAttachmentDelete,
+		CreateContext: withDiagnostics(resourceAlibabacloudStackEdasSlbAttachmentCreate),
+		ReadContext:   withDiagnostics(resourceAlibabacloudStackEdasSlbAttachmentRead),
+		DeleteContext: withDiagnostics(resourceAlibabacloudStackEdasSlbAttachmentDelete),
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			StateContext: schema.ImportStatePassthroughContext,
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -65,7 +66,7 @@ func resourceAlibabacloudStackEdasSlbAttachment() *schema.Resource {
 	}
 }
 
-func resourceAlibabacloudStackEdasSlbAttachmentCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceAlibabacloudStackEdasSlbAttachmentCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*connectivity.AlibabacloudStackClient)
 	edasService := EdasService{client}
 
@@ -107,10 +108,10 @@ func resourceAlibabacloudStackEdasSlbAttachmentCreate(d *schema.ResourceData, me
 	}
 	d.SetId(appId + ":" + slbId)
 	//return resourceAlibabacloudStackEdasInstanceApplicationAttachmentRead(d, meta)
-	return resourceAlibabacloudStackEdasSlbAttachmentRead(d, meta)
+	return resourceAlibabacloudStackEdasSlbAttachmentRead(ctx, d, meta)
 }
 
-func resourceAlibabacloudStackEdasSlbAttachmentRead(d *schema.ResourceData, meta interface{}) error {
+func resourceAlibabacloudStackEdasSlbAttachmentRead(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*connectivity.AlibabacloudStackClient)
 	edasService := EdasService{client}
 
@@ -175,7 +176,7 @@ func resourceAlibabacloudStackEdasSlbAttachmentRead(d *schema.ResourceData, meta
 	return nil
 }
 
-func resourceAlibabacloudStackEdasSlbAttachmentDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceAlibabacloudStackEdasSlbAttachmentDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*connectivity.AlibabacloudStackClient)
 	edasService := EdasService{client}
 
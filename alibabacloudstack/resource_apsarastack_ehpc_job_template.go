@@ -1,6 +1,7 @@
 package alibabacloudstack
 
 import (
+	"context"
 	"fmt"
 	"log"
 	"strconv"
@@ -14,12 +15,12 @@ import (
 
 func resourceAlibabacloudStackEhpcJobTemplate() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceAlibabacloudStackEhpcJobTemplateCreate,
-		Read:   resourceAlibabacloudStackEhpcJobTemplateRead,
-		Update: resourceAlibabacloudStackEhpcJobTemplateUpdate,
-		Delete: resourceAlibabacloudStackEhpcJobTemplateDelete,
+		CreateContext: withDiagnostics(resourceAlibabacloudStackEhpcJobTemplateCreate),
+		ReadContext:   withDiagnostics(resourceAlibabacloudStackEhpcJobTemplateRead),
+		UpdateContext: withDiagnostics(resourceAlibabacloudStackEhpcJobTemplateUpdate),
+		DeleteContext: withDiagnostics(resourceAlibabacloudStackEhpcJobTemplateDelete),
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			StateContext: schema.ImportStatePassthroughContext,
 		},
 		Schema: map[string]*schema.Schema{
 			"array_request": {
@@ -95,7 +96,7 @@ func resourceAlibabacloudStackEhpcJobTemplate() *schema.Resource {
 	}
 }
 
-func resourceAlibabacloudStackEhpcJobTemplateCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceAlibabacloudStackEhpcJobTemplateCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*connectivity.AlibabacloudStackClient)
 	var response map[string]interface{}
 	action := "CreateJobTemplate"
@@ -152,7 +153,7 @@ func resourceAlibabacloudStackEhpcJobTemplateCreate(d *schema.ResourceData, meta
 		request["Variables"] = v
 	}
 	wait := incrementalWait(3*time.Second, 3*time.Second)
-	err = resource.Retry(d.Timeout(schema.TimeoutCreate), func() *resource.RetryError {
+	err = resource.RetryContext(ctx, d.Timeout(schema.TimeoutCreate), func() *resource.RetryError {
 		response, err = conn.DoRequest(StringPointer(action), nil, StringPointer("GET"), StringPointer("2018-04-12"), StringPointer("AK"), request, nil, &util.RuntimeOptions{})
 		if err != nil {
 			if NeedRetry(err) {
@@ -170,9 +171,9 @@ func resourceAlibabacloudStackEhpcJobTemplateCreate(d *schema.ResourceData, meta
 
 	d.SetId(fmt.Sprint(response["TemplateId"]))
 
-	return resourceAlibabacloudStackEhpcJobTemplateRead(d, meta)
+	return resourceAlibabacloudStackEhpcJobTemplateRead(ctx, d, meta)
 }
-func resourceAlibabacloudStackEhpcJobTemplateRead(d *schema.ResourceData, meta interface{}) error {
+func resourceAlibabacloudStackEhpcJobTemplateRead(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*connectivity.AlibabacloudStackClient)
 	ehpcService := EhpcService{client}
 	object, err := ehpcService.DescribeEhpcJobTemplate(d.Id())
@@ -218,7 +219,7 @@ func resourceAlibabacloudStackEhpcJobTemplateRead(d *schema.ResourceData, meta i
 	d.Set("variables", object["Variables"])
 	return nil
 }
-func resourceAlibabacloudStackEhpcJobTemplateUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceAlibabacloudStackEhpcJobTemplateUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*connectivity.AlibabacloudStackClient)
 	var response map[string]interface{}
 	request := map[string]interface{}{
@@ -295,7 +296,7 @@ func resourceAlibabacloudStackEhpcJobTemplateUpdate(d *schema.ResourceData, meta
 		return WrapError(err)
 	}
 	wait := incrementalWait(3*time.Second, 3*time.Second)
-	err = resource.Retry(d.Timeout(schema.TimeoutUpdate), func() *resource.RetryError {
+	err = resource.RetryContext(ctx, d.Timeout(schema.TimeoutUpdate), func() *resource.RetryError {
 		response, err = conn.DoRequest(StringPointer(action), nil, StringPointer("GET"), StringPointer("2018-04-12"), StringPointer("AK"), request, nil, &util.RuntimeOptions{})
 		if err != nil {
 			if NeedRetry(err) {
@@ -311,9 +312,9 @@ func resourceAlibabacloudStackEhpcJobTemplateUpdate(d *schema.ResourceData, meta
 		return WrapErrorf(err, DefaultErrorMsg, d.Id(), action, AlibabacloudStackSdkGoERROR)
 	}
 
-	return resourceAlibabacloudStackEhpcJobTemplateRead(d, meta)
+	return resourceAlibabacloudStackEhpcJobTemplateRead(ctx, d, meta)
 }
-func resourceAlibabacloudStackEhpcJobTemplateDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceAlibabacloudStackEhpcJobTemplateDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*connectivity.AlibabacloudStackClient)
 	action := "DeleteJobTemplates"
 	var response map[string]interface{}
@@ -326,7 +327,7 @@ func resourceAlibabacloudStackEhpcJobTemplateDelete(d *schema.ResourceData, meta
 	}
 
 	wait := incrementalWait(3*time.Second, 3*time.Second)
-	err = resource.Retry(d.Timeout(schema.TimeoutDelete), func() *resource.RetryError {
+	err = resource.RetryContext(ctx, d.Timeout(schema.TimeoutDelete), func() *resource.RetryError {
 		response, err = conn.DoRequest(StringPointer(action), nil, StringPointer("GET"), StringPointer("2018-04-12"), StringPointer("AK"), request, nil, &util.RuntimeOptions{})
 		if err != nil {
 			if NeedRetry(err) {
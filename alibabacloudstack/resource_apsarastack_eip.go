@@ -1,6 +1,7 @@
 package alibabacloudstack
 
 import (
+	"context"
 	"github.com/aliyun/alibaba-cloud-sdk-go/services/vpc"
 	"github.com/aliyun/terraform-provider-alibabacloudstack/alibabacloudstack/connectivity"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
@@ -13,13 +14,14 @@ import (
 
 func resourceAlibabacloudStackEip() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceAlibabacloudStackEipCreate,
-		Read:   resourceAlibabacloudStackEipRead,
-		Update: resourceAlibabacloudStackEipUpdate,
-		Delete: resourceAlibabacloudStackEipDelete,
+		CreateContext: withDiagnostics(resourceAlibabacloudStackEipCreate),
+		ReadContext:   withDiagnostics(resourceAlibabacloudStackEipRead),
+		UpdateContext: withDiagnostics(resourceAlibabacloudStackEipUpdate),
+		DeleteContext: withDiagnostics(resourceAlibabacloudStackEipDelete),
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			StateContext: schema.ImportStatePassthroughContext,
 		},
+		CustomizeDiff: quotaCustomizeDiff("EIP", eipQuotaAmounts),
 
 		Schema: map[string]*schema.Schema{
 			"name": {
@@ -50,7 +52,7 @@ func resourceAlibabacloudStackEip() *schema.Resource {
 	}
 }
 
-func resourceAlibabacloudStackEipCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceAlibabacloudStackEipCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*connectivity.AlibabacloudStackClient)
 	vpcService := VpcService{client}
 
@@ -80,10 +82,10 @@ func resourceAlibabacloudStackEipCreate(d *schema.ResourceData, meta interface{}
 	if err != nil {
 		return WrapError(err)
 	}
-	return resourceAlibabacloudStackEipUpdate(d, meta)
+	return resourceAlibabacloudStackEipUpdate(ctx, d, meta)
 }
 
-func resourceAlibabacloudStackEipRead(d *schema.ResourceData, meta interface{}) error {
+func resourceAlibabacloudStackEipRead(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 	wiatSecondsIfWithTest(1)
 	client := meta.(*connectivity.AlibabacloudStackClient)
 	vpcService := VpcService{client}
@@ -112,12 +114,12 @@ func resourceAlibabacloudStackEipRead(d *schema.ResourceData, meta interface{})
 	return nil
 }
 
-func resourceAlibabacloudStackEipUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceAlibabacloudStackEipUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*connectivity.AlibabacloudStackClient)
 	vpcService := VpcService{client}
 
-	if d.HasChange("tags") {
-		if err := vpcService.SetResourceTags(d, "EIP"); err != nil {
+	if hasTagsAllChange(d, client) {
+		if err := vpcService.SetResourceTags(ctx, d, "EIP"); err != nil {
 			return WrapError(err)
 		}
 	}
@@ -155,10 +157,10 @@ func resourceAlibabacloudStackEipUpdate(d *schema.ResourceData, meta interface{}
 		}
 		addDebug(request.GetActionName(), raw, request.RpcRequest, request)
 	}
-	return resourceAlibabacloudStackEipRead(d, meta)
+	return resourceAlibabacloudStackEipRead(ctx, d, meta)
 }
 
-func resourceAlibabacloudStackEipDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceAlibabacloudStackEipDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*connectivity.AlibabacloudStackClient)
 	vpcService := VpcService{client}
 
@@ -172,7 +174,7 @@ func resourceAlibabacloudStackEipDelete(d *schema.ResourceData, meta interface{}
 	}
 	request.Headers = map[string]string{"RegionId": client.RegionId}
 	request.QueryParams = map[string]string{"AccessKeySecret": client.SecretKey, "Product": "vpc", "Department": client.Department, "ResourceGroup": client.ResourceGroup}
-	err := resource.Retry(5*time.Minute, func() *resource.RetryError {
+	err := resource.RetryContext(ctx, 5*time.Minute, func() *resource.RetryError {
 		raw, err := client.WithVpcClient(func(vpcClient *vpc.Client) (interface{}, error) {
 			return vpcClient.ReleaseEipAddress(request)
 		})
@@ -1,6 +1,7 @@
 package alibabacloudstack
 
 import (
+	"context"
 	"github.com/aliyun/alibaba-cloud-sdk-go/sdk/requests"
 	"strings"
 	"time"
@@ -13,9 +14,15 @@ import (
 
 func resourceAlibabacloudStackEipAssociation() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceAlibabacloudStackEipAssociationCreate,
-		Read:   resourceAlibabacloudStackEipAssociationRead,
-		Delete: resourceAlibabacloudStackEipAssociationDelete,
+		CreateContext: withDiagnostics(resourceAlibabacloudStackEipAssociationCreate),
+		ReadContext:   withDiagnostics(resourceAlibabacloudStackEipAssociationRead),
+		DeleteContext: withDiagnostics(resourceAlibabacloudStackEipAssociationDelete),
+		Importer: &schema.ResourceImporter{
+			StateContext: func(ctx context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+				d.Set("force", true)
+				return []*schema.ResourceData{d}, nil
+			},
+		},
 
 		Schema: map[string]*schema.Schema{
 			"allocation_id": {
@@ -46,7 +53,7 @@ func resourceAlibabacloudStackEipAssociation() *schema.Resource {
 	}
 }
 
-func resourceAlibabacloudStackEipAssociationCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceAlibabacloudStackEipAssociationCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*connectivity.AlibabacloudStackClient)
 	vpcService := VpcService{client}
 
@@ -73,7 +80,7 @@ func resourceAlibabacloudStackEipAssociationCreate(d *schema.ResourceData, meta
 	if instanceType, ok := d.GetOk("instance_type"); ok {
 		request.InstanceType = instanceType.(string)
 	}
-	if err := resource.Retry(3*time.Minute, func() *resource.RetryError {
+	if err := resource.RetryContext(ctx, 3*time.Minute, func() *resource.RetryError {
 		raw, err := client.WithVpcClient(func(vpcClient *vpc.Client) (interface{}, error) {
 			return vpcClient.AssociateEipAddress(request)
 		})
@@ -99,10 +106,10 @@ func resourceAlibabacloudStackEipAssociationCreate(d *schema.ResourceData, meta
 
 	d.SetId(request.AllocationId + ":" + request.InstanceId)
 
-	return resourceAlibabacloudStackEipAssociationRead(d, meta)
+	return resourceAlibabacloudStackEipAssociationRead(ctx, d, meta)
 }
 
-func resourceAlibabacloudStackEipAssociationRead(d *schema.ResourceData, meta interface{}) error {
+func resourceAlibabacloudStackEipAssociationRead(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 	wiatSecondsIfWithTest(1)
 	client := meta.(*connectivity.AlibabacloudStackClient)
 	vpcService := VpcService{client}
@@ -122,7 +129,7 @@ func resourceAlibabacloudStackEipAssociationRead(d *schema.ResourceData, meta in
 	return nil
 }
 
-func resourceAlibabacloudStackEipAssociationDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceAlibabacloudStackEipAssociationDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*connectivity.AlibabacloudStackClient)
 	vpcService := VpcService{client}
 	parts, err := ParseResourceId(d.Id(), 2)
@@ -158,7 +165,7 @@ func resourceAlibabacloudStackEipAssociationDelete(d *schema.ResourceData, meta
 	if instanceType, ok := d.GetOk("instance_type"); ok {
 		request.InstanceType = instanceType.(string)
 	}
-	err = resource.Retry(5*time.Minute, func() *resource.RetryError {
+	err = resource.RetryContext(ctx, 5*time.Minute, func() *resource.RetryError {
 		raw, err := client.WithVpcClient(func(vpcClient *vpc.Client) (interface{}, error) {
 			return vpcClient.UnassociateEipAddress(request)
 		})
@@ -1,6 +1,7 @@
 package alibabacloudstack
 
 import (
+	"context"
 	"regexp"
 	"strings"
 	"time"
@@ -19,12 +20,12 @@ import (
 
 func resourceAlibabacloudStackElasticsearch() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceAlibabacloudStackElasticsearchCreate,
-		Read:   resourceAlibabacloudStackElasticsearchRead,
-		Update: resourceAlibabacloudStackElasticsearchUpdate,
-		Delete: resourceAlibabacloudStackElasticsearchDelete,
+		CreateContext: withDiagnostics(resourceAlibabacloudStackElasticsearchCreate),
+		ReadContext:   withDiagnostics(resourceAlibabacloudStackElasticsearchRead),
+		UpdateContext: withDiagnostics(resourceAlibabacloudStackElasticsearchUpdate),
+		DeleteContext: withDiagnostics(resourceAlibabacloudStackElasticsearchDelete),
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			StateContext: schema.ImportStatePassthroughContext,
 		},
 		Timeouts: &schema.ResourceTimeout{
 			Create: schema.DefaultTimeout(120 * time.Minute),
@@ -238,7 +239,7 @@ func resourceAlibabacloudStackElasticsearch() *schema.Resource {
 	}
 }
 
-func resourceAlibabacloudStackElasticsearchCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceAlibabacloudStackElasticsearchCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*connectivity.AlibabacloudStackClient)
 	elasticsearchService := ElasticsearchService{client}
 	action := "createInstance"
@@ -253,7 +254,7 @@ func resourceAlibabacloudStackElasticsearchCreate(d *schema.ResourceData, meta i
 
 	runtime := util.RuntimeOptions{}
 	runtime.SetAutoretry(true)
-	err = resource.Retry(5*time.Minute, func() *resource.RetryError {
+	err = resource.RetryContext(ctx, 5*time.Minute, func() *resource.RetryError {
 		response, err = conn.DoRequest(StringPointer(action), nil, StringPointer("POST"), StringPointer("2017-06-13"), StringPointer("AK"), nil, requestBody, &runtime)
 		if err != nil {
 			if IsExpectedErrors(err, errorCodeList) || NeedRetry(err) {
@@ -279,14 +280,14 @@ func resourceAlibabacloudStackElasticsearchCreate(d *schema.ResourceData, meta i
 
 	stateConf := BuildStateConf([]string{"activating"}, []string{"active"}, d.Timeout(schema.TimeoutCreate), 5*time.Minute, elasticsearchService.ElasticsearchStateRefreshFunc(d.Id(), []string{"inactive"}))
 	stateConf.PollInterval = 5 * time.Second
-	if _, err := stateConf.WaitForState(); err != nil {
+	if _, err := stateConf.WaitForStateContext(ctx); err != nil {
 		return WrapErrorf(err, IdMsg, d.Id())
 	}
 
-	return resourceAlibabacloudStackElasticsearchUpdate(d, meta)
+	return resourceAlibabacloudStackElasticsearchUpdate(ctx, d, meta)
 }
 
-func resourceAlibabacloudStackElasticsearchRead(d *schema.ResourceData, meta interface{}) error {
+func resourceAlibabacloudStackElasticsearchRead(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*connectivity.AlibabacloudStackClient)
 	elasticsearchService := ElasticsearchService{client}
 
@@ -361,7 +362,7 @@ func resourceAlibabacloudStackElasticsearchRead(d *schema.ResourceData, meta int
 	return nil
 }
 
-func resourceAlibabacloudStackElasticsearchUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceAlibabacloudStackElasticsearchUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*connectivity.AlibabacloudStackClient)
 	elasticsearchService := ElasticsearchService{client}
 	d.Partial(true)
@@ -369,7 +370,7 @@ func resourceAlibabacloudStackElasticsearchUpdate(d *schema.ResourceData, meta i
 	stateConf.PollInterval = 5 * time.Second
 
 	if d.HasChange("description") {
-		if err := updateDescription(d, meta); err != nil {
+		if err := updateDescription(ctx, d, meta); err != nil {
 			return WrapError(err)
 		}
 
@@ -381,7 +382,7 @@ func resourceAlibabacloudStackElasticsearchUpdate(d *schema.ResourceData, meta i
 		content["networkType"] = string(PRIVATE)
 		content["nodeType"] = string(WORKER)
 		content["whiteIpList"] = d.Get("private_whitelist").(*schema.Set).List()
-		if err := elasticsearchService.ModifyWhiteIps(d, content, meta); err != nil {
+		if err := elasticsearchService.ModifyWhiteIps(ctx, d, content, meta); err != nil {
 			return WrapError(err)
 		}
 
@@ -393,7 +394,7 @@ func resourceAlibabacloudStackElasticsearchUpdate(d *schema.ResourceData, meta i
 		content["networkType"] = string(PUBLIC)
 		content["nodeType"] = string(WORKER)
 		content["actionType"] = elasticsearchService.getActionType(d.Get("enable_public").(bool))
-		if err := elasticsearchService.TriggerNetwork(d, content, meta); err != nil {
+		if err := elasticsearchService.TriggerNetwork(ctx, d, content, meta); err != nil {
 			return WrapError(err)
 		}
 
@@ -405,7 +406,7 @@ func resourceAlibabacloudStackElasticsearchUpdate(d *schema.ResourceData, meta i
 		content["networkType"] = string(PUBLIC)
 		content["nodeType"] = string(WORKER)
 		content["whiteIpList"] = d.Get("public_whitelist").(*schema.Set).List()
-		if err := elasticsearchService.ModifyWhiteIps(d, content, meta); err != nil {
+		if err := elasticsearchService.ModifyWhiteIps(ctx, d, content, meta); err != nil {
 			return WrapError(err)
 		}
 
@@ -417,7 +418,7 @@ func resourceAlibabacloudStackElasticsearchUpdate(d *schema.ResourceData, meta i
 		content["networkType"] = string(PUBLIC)
 		content["nodeType"] = string(KIBANA)
 		content["actionType"] = elasticsearchService.getActionType(d.Get("enable_kibana_public_network").(bool))
-		if err := elasticsearchService.TriggerNetwork(d, content, meta); err != nil {
+		if err := elasticsearchService.TriggerNetwork(ctx, d, content, meta); err != nil {
 			return WrapError(err)
 		}
 
@@ -429,7 +430,7 @@ func resourceAlibabacloudStackElasticsearchUpdate(d *schema.ResourceData, meta i
 		content["networkType"] = string(PUBLIC)
 		content["nodeType"] = string(KIBANA)
 		content["whiteIpList"] = d.Get("kibana_whitelist").(*schema.Set).List()
-		if err := elasticsearchService.ModifyWhiteIps(d, content, meta); err != nil {
+		if err := elasticsearchService.ModifyWhiteIps(ctx, d, content, meta); err != nil {
 			return WrapError(err)
 		}
 
@@ -441,7 +442,7 @@ func resourceAlibabacloudStackElasticsearchUpdate(d *schema.ResourceData, meta i
 		content["networkType"] = string(PRIVATE)
 		content["nodeType"] = string(KIBANA)
 		content["actionType"] = elasticsearchService.getActionType(d.Get("enable_kibana_private_network").(bool))
-		if err := elasticsearchService.TriggerNetwork(d, content, meta); err != nil {
+		if err := elasticsearchService.TriggerNetwork(ctx, d, content, meta); err != nil {
 			return WrapError(err)
 		}
 
@@ -453,14 +454,14 @@ func resourceAlibabacloudStackElasticsearchUpdate(d *schema.ResourceData, meta i
 		content["networkType"] = string(PRIVATE)
 		content["nodeType"] = string(KIBANA)
 		content["whiteIpList"] = d.Get("kibana_private_whitelist").(*schema.Set).List()
-		if err := elasticsearchService.ModifyWhiteIps(d, content, meta); err != nil {
+		if err := elasticsearchService.ModifyWhiteIps(ctx, d, content, meta); err != nil {
 			return WrapError(err)
 		}
 
 		//d.SetPartial("kibana_private_whitelist")
 	}
 
-	if d.HasChange("tags") {
+	if hasTagsAllChange(d, client) {
 		if err := updateInstanceTags(d, meta); err != nil {
 			return WrapError(err)
 		}
@@ -470,11 +471,11 @@ func resourceAlibabacloudStackElasticsearchUpdate(d *schema.ResourceData, meta i
 
 	if d.HasChange("client_node_spec") || d.HasChange("client_node_amount") {
 
-		if _, err := stateConf.WaitForState(); err != nil {
+		if _, err := stateConf.WaitForStateContext(ctx); err != nil {
 			return WrapErrorf(err, IdMsg, d.Id())
 		}
 
-		if err := updateClientNode(d, meta); err != nil {
+		if err := updateClientNode(ctx, d, meta); err != nil {
 			return WrapError(err)
 		}
 
@@ -484,11 +485,11 @@ func resourceAlibabacloudStackElasticsearchUpdate(d *schema.ResourceData, meta i
 
 	if d.HasChange("protocol") {
 
-		if _, err := stateConf.WaitForState(); err != nil {
+		if _, err := stateConf.WaitForStateContext(ctx); err != nil {
 			return WrapErrorf(err, IdMsg, d.Id())
 		}
 
-		var https func(*schema.ResourceData, interface{}) error
+		var https func(context.Context, *schema.ResourceData, interface{}) error
 
 		if d.Get("protocol") == "HTTPS" {
 			https = openHttps
@@ -497,7 +498,7 @@ func resourceAlibabacloudStackElasticsearchUpdate(d *schema.ResourceData, meta i
 		}
 
 		if nil != https {
-			if err := https(d, meta); err != nil {
+			if err := https(ctx, d, meta); err != nil {
 				return WrapError(err)
 			}
 		}
@@ -524,7 +525,7 @@ func resourceAlibabacloudStackElasticsearchUpdate(d *schema.ResourceData, meta i
 		runtime.SetAutoretry(true)
 		// retry
 		wait := incrementalWait(3*time.Second, 5*time.Second)
-		err = resource.Retry(5*time.Minute, func() *resource.RetryError {
+		err = resource.RetryContext(ctx, 5*time.Minute, func() *resource.RetryError {
 			response, err = conn.DoRequest(StringPointer(action), nil, StringPointer("POST"), StringPointer("2017-06-13"), StringPointer("AK"), nil, content, &runtime)
 
 			if err != nil {
@@ -543,7 +544,7 @@ func resourceAlibabacloudStackElasticsearchUpdate(d *schema.ResourceData, meta i
 		}
 		stateConf := BuildStateConf([]string{"activating"}, []string{"active"}, d.Timeout(schema.TimeoutUpdate), 5*time.Minute, elasticsearchService.ElasticsearchStateRefreshFunc(d.Id(), []string{"inactive"}))
 		stateConf.PollInterval = 5 * time.Second
-		if _, err := stateConf.WaitForState(); err != nil {
+		if _, err := stateConf.WaitForStateContext(ctx); err != nil {
 			return WrapErrorf(err, IdMsg, d.Id())
 		}
 		//d.SetPartial("setting_config")
@@ -551,7 +552,7 @@ func resourceAlibabacloudStackElasticsearchUpdate(d *schema.ResourceData, meta i
 
 	if d.IsNewResource() {
 		d.Partial(false)
-		return resourceAlibabacloudStackElasticsearchRead(d, meta)
+		return resourceAlibabacloudStackElasticsearchRead(ctx, d, meta)
 	}
 
 	if d.HasChange("instance_charge_type") {
@@ -571,11 +572,11 @@ func resourceAlibabacloudStackElasticsearchUpdate(d *schema.ResourceData, meta i
 
 	if d.HasChange("data_node_amount") {
 
-		if _, err := stateConf.WaitForState(); err != nil {
+		if _, err := stateConf.WaitForStateContext(ctx); err != nil {
 			return WrapErrorf(err, IdMsg, d.Id())
 		}
 
-		if err := updateDataNodeAmount(d, meta); err != nil {
+		if err := updateDataNodeAmount(ctx, d, meta); err != nil {
 			return WrapError(err)
 		}
 
@@ -584,11 +585,11 @@ func resourceAlibabacloudStackElasticsearchUpdate(d *schema.ResourceData, meta i
 
 	if d.HasChange("data_node_spec") || d.HasChange("data_node_disk_size") || d.HasChange("data_node_disk_type") {
 
-		if _, err := stateConf.WaitForState(); err != nil {
+		if _, err := stateConf.WaitForStateContext(ctx); err != nil {
 			return WrapErrorf(err, IdMsg, d.Id())
 		}
 
-		if err := updateDataNodeSpec(d, meta); err != nil {
+		if err := updateDataNodeSpec(ctx, d, meta); err != nil {
 			return WrapError(err)
 		}
 
@@ -600,11 +601,11 @@ func resourceAlibabacloudStackElasticsearchUpdate(d *schema.ResourceData, meta i
 
 	if d.HasChange("master_node_spec") {
 
-		if _, err := stateConf.WaitForState(); err != nil {
+		if _, err := stateConf.WaitForStateContext(ctx); err != nil {
 			return WrapErrorf(err, IdMsg, d.Id())
 		}
 
-		if err := updateMasterNode(d, meta); err != nil {
+		if err := updateMasterNode(ctx, d, meta); err != nil {
 			return WrapError(err)
 		}
 
@@ -613,11 +614,11 @@ func resourceAlibabacloudStackElasticsearchUpdate(d *schema.ResourceData, meta i
 
 	if d.HasChange("password") || d.HasChange("kms_encrypted_password") {
 
-		if _, err := stateConf.WaitForState(); err != nil {
+		if _, err := stateConf.WaitForStateContext(ctx); err != nil {
 			return WrapErrorf(err, IdMsg, d.Id())
 		}
 
-		if err := updatePassword(d, meta); err != nil {
+		if err := updatePassword(ctx, d, meta); err != nil {
 			return WrapError(err)
 		}
 
@@ -625,10 +626,10 @@ func resourceAlibabacloudStackElasticsearchUpdate(d *schema.ResourceData, meta i
 	}
 
 	d.Partial(false)
-	return resourceAlibabacloudStackElasticsearchRead(d, meta)
+	return resourceAlibabacloudStackElasticsearchRead(ctx, d, meta)
 }
 
-func resourceAlibabacloudStackElasticsearchDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceAlibabacloudStackElasticsearchDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*connectivity.AlibabacloudStackClient)
 	elasticsearchService := ElasticsearchService{client}
 	action := "DeleteInstance"
@@ -651,7 +652,7 @@ func resourceAlibabacloudStackElasticsearchDelete(d *schema.ResourceData, meta i
 	errorCodeList := []string{"InstanceActivating", "TokenPreviousRequestProcessError"}
 	conn, err := client.NewElasticsearchClient()
 
-	err = resource.Retry(5*time.Minute, func() *resource.RetryError {
+	err = resource.RetryContext(ctx, 5*time.Minute, func() *resource.RetryError {
 		response, err = conn.DoRequest(StringPointer(action), nil, StringPointer("POST"), StringPointer("2017-06-13"), StringPointer("AK"), nil, request, &runtime)
 
 		if err != nil {
@@ -676,7 +677,7 @@ func resourceAlibabacloudStackElasticsearchDelete(d *schema.ResourceData, meta i
 	stateConf := BuildStateConf([]string{"activating", "inactive", "active"}, []string{}, d.Timeout(schema.TimeoutDelete), 5*time.Minute, elasticsearchService.ElasticsearchStateRefreshFunc(d.Id(), []string{}))
 	stateConf.PollInterval = 5 * time.Second
 
-	if _, err = stateConf.WaitForState(); err != nil {
+	if _, err = stateConf.WaitForStateContext(ctx); err != nil {
 		return WrapErrorf(err, IdMsg, d.Id())
 	}
 	// Instance will be completed deleted in 5 minutes, so deleting vswitch is available after the time.
@@ -1,6 +1,7 @@
 package alibabacloudstack
 
 import (
+	"context"
 	"strconv"
 	"strings"
 	"time"
@@ -16,12 +17,12 @@ import (
 
 func resourceAlibabacloudStackEssAlarm() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceAlibabacloudStackEssAlarmCreate,
-		Read:   resourceAlibabacloudStackEssAlarmRead,
-		Update: resourceAlibabacloudStackEssAlarmUpdate,
-		Delete: resourceAlibabacloudStackEssAlarmDelete,
+		CreateContext: withDiagnostics(resourceAlibabacloudStackEssAlarmCreate),
+		ReadContext:   withDiagnostics(resourceAlibabacloudStackEssAlarmRead),
+		UpdateContext: withDiagnostics(resourceAlibabacloudStackEssAlarmUpdate),
+		DeleteContext: withDiagnostics(resourceAlibabacloudStackEssAlarmDelete),
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			StateContext: schema.ImportStatePassthroughContext,
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -112,7 +113,7 @@ func resourceAlibabacloudStackEssAlarm() *schema.Resource {
 	}
 }
 
-func resourceAlibabacloudStackEssAlarmCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceAlibabacloudStackEssAlarmCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 
 	request, err := buildAlibabacloudStackEssAlarmArgs(d)
 	if err != nil {
@@ -130,7 +131,7 @@ func resourceAlibabacloudStackEssAlarmCreate(d *schema.ResourceData, meta interf
 		request.Scheme = "http"
 	}
 	var raw interface{}
-	if err = resource.Retry(5*time.Minute, func() *resource.RetryError {
+	if err = resource.RetryContext(ctx, 5*time.Minute, func() *resource.RetryError {
 		raw, err = client.WithEssClient(func(essClient *ess.Client) (interface{}, error) {
 			return essClient.CreateAlarm(request)
 		})
@@ -161,10 +162,10 @@ func resourceAlibabacloudStackEssAlarmCreate(d *schema.ResourceData, meta interf
 		}
 		addDebug(disableAlarmRequest.GetActionName(), raw, disableAlarmRequest.RpcRequest, disableAlarmRequest)
 	}
-	return resourceAlibabacloudStackEssAlarmRead(d, meta)
+	return resourceAlibabacloudStackEssAlarmRead(ctx, d, meta)
 }
 
-func resourceAlibabacloudStackEssAlarmRead(d *schema.ResourceData, meta interface{}) error {
+func resourceAlibabacloudStackEssAlarmRead(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*connectivity.AlibabacloudStackClient)
 	essService := EssService{client}
 
@@ -207,7 +208,7 @@ func resourceAlibabacloudStackEssAlarmRead(d *schema.ResourceData, meta interfac
 	return nil
 }
 
-func resourceAlibabacloudStackEssAlarmUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceAlibabacloudStackEssAlarmUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*connectivity.AlibabacloudStackClient)
 	request := ess.CreateModifyAlarmRequest()
 	request.AlarmTaskId = d.Id()
@@ -319,10 +320,10 @@ func resourceAlibabacloudStackEssAlarmUpdate(d *schema.ResourceData, meta interf
 		//d.SetPartial("enable")
 	}
 	d.Partial(false)
-	return resourceAlibabacloudStackEssAlarmRead(d, meta)
+	return resourceAlibabacloudStackEssAlarmRead(ctx, d, meta)
 }
 
-func resourceAlibabacloudStackEssAlarmDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceAlibabacloudStackEssAlarmDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*connectivity.AlibabacloudStackClient)
 	essService := EssService{client}
 	request := ess.CreateDeleteAlarmRequest()
@@ -1,6 +1,7 @@
 package alibabacloudstack
 
 import (
+	"context"
 	"fmt"
 	"strings"
 	"time"
@@ -15,12 +16,12 @@ import (
 
 func resourceAlibabacloudstackEssAttachment() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceAlibabacloudstackEssAttachmentCreate,
-		Read:   resourceAlibabacloudstackEssAttachmentRead,
-		Update: resourceAlibabacloudstackEssAttachmentUpdate,
-		Delete: resourceAlibabacloudstackEssAttachmentDelete,
+		CreateContext: withDiagnostics(resourceAlibabacloudstackEssAttachmentCreate),
+		ReadContext:   withDiagnostics(resourceAlibabacloudstackEssAttachmentRead),
+		UpdateContext: withDiagnostics(resourceAlibabacloudstackEssAttachmentUpdate),
+		DeleteContext: withDiagnostics(resourceAlibabacloudstackEssAttachmentDelete),
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			StateContext: schema.ImportStatePassthroughContext,
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -47,13 +48,13 @@ func resourceAlibabacloudstackEssAttachment() *schema.Resource {
 	}
 }
 
-func resourceAlibabacloudstackEssAttachmentCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceAlibabacloudstackEssAttachmentCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 
 	d.SetId(d.Get("scaling_group_id").(string))
-	return resourceAlibabacloudstackEssAttachmentUpdate(d, meta)
+	return resourceAlibabacloudstackEssAttachmentUpdate(ctx, d, meta)
 }
 
-func resourceAlibabacloudstackEssAttachmentUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceAlibabacloudstackEssAttachmentUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*connectivity.AlibabacloudStackClient)
 	essService := EssService{client}
 	d.Partial(true)
@@ -90,7 +91,7 @@ func resourceAlibabacloudstackEssAttachmentUpdate(d *schema.ResourceData, meta i
 			request.ScalingGroupId = d.Id()
 			s := reflect.ValueOf(request).Elem()
 
-			err := resource.Retry(5*time.Minute, func() *resource.RetryError {
+			err := resource.RetryContext(ctx, 5*time.Minute, func() *resource.RetryError {
 				for i, id := range add {
 					s.FieldByName(fmt.Sprintf("InstanceId%d", i+1)).Set(reflect.ValueOf(id))
 				}
@@ -121,7 +122,7 @@ func resourceAlibabacloudstackEssAttachmentUpdate(d *schema.ResourceData, meta i
 
 						if len(autoAdded) > 0 {
 							if d.Get("force").(bool) {
-								if err := essService.EssRemoveInstances(d.Id(), autoAdded); err != nil {
+								if err := essService.EssRemoveInstances(ctx, d.Id(), autoAdded); err != nil {
 									return resource.NonRetryableError(WrapError(err))
 								}
 								time.Sleep(5)
@@ -150,7 +151,7 @@ func resourceAlibabacloudstackEssAttachmentUpdate(d *schema.ResourceData, meta i
 				return WrapError(err)
 			}
 
-			err = resource.Retry(3*time.Minute, func() *resource.RetryError {
+			err = resource.RetryContext(ctx, 3*time.Minute, func() *resource.RetryError {
 
 				instances, err := essService.DescribeEssAttachment(d.Id(), add)
 				if err != nil {
@@ -172,7 +173,7 @@ func resourceAlibabacloudstackEssAttachmentUpdate(d *schema.ResourceData, meta i
 			}
 		}
 		if len(remove) > 0 {
-			if err := essService.EssRemoveInstances(d.Id(), convertArrayInterfaceToArrayString(remove)); err != nil {
+			if err := essService.EssRemoveInstances(ctx, d.Id(), convertArrayInterfaceToArrayString(remove)); err != nil {
 				return WrapError(err)
 			}
 		}
@@ -182,10 +183,10 @@ func resourceAlibabacloudstackEssAttachmentUpdate(d *schema.ResourceData, meta i
 
 	d.Partial(false)
 
-	return resourceAlibabacloudstackEssAttachmentRead(d, meta)
+	return resourceAlibabacloudstackEssAttachmentRead(ctx, d, meta)
 }
 
-func resourceAlibabacloudstackEssAttachmentRead(d *schema.ResourceData, meta interface{}) error {
+func resourceAlibabacloudstackEssAttachmentRead(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 	wiatSecondsIfWithTest(1)
 	client := meta.(*connectivity.AlibabacloudStackClient)
 	essService := EssService{client}
@@ -210,7 +211,7 @@ func resourceAlibabacloudstackEssAttachmentRead(d *schema.ResourceData, meta int
 	return nil
 }
 
-func resourceAlibabacloudstackEssAttachmentDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceAlibabacloudstackEssAttachmentDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*connectivity.AlibabacloudStackClient)
 	essService := EssService{client}
 
@@ -231,7 +232,7 @@ func resourceAlibabacloudstackEssAttachmentDelete(d *schema.ResourceData, meta i
 		return WrapError(err)
 	}
 
-	if err := resource.Retry(5*time.Minute, func() *resource.RetryError {
+	if err := resource.RetryContext(ctx, 5*time.Minute, func() *resource.RetryError {
 		request := ess.CreateRemoveInstancesRequest()
 		request.RegionId = client.RegionId
 		if strings.ToLower(client.Config.Protocol) == "https" {
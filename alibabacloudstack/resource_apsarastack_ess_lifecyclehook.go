@@ -1,6 +1,7 @@
 package alibabacloudstack
 
 import (
+	"context"
 	"strings"
 	"time"
 
@@ -15,12 +16,12 @@ import (
 
 func resourceAlibabacloudStackEssLifecycleHook() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceAlibabacloudStackEssLifeCycleHookCreate,
-		Read:   resourceAlibabacloudStackEssLifeCycleHookRead,
-		Update: resourceAlibabacloudStackEssLifeCycleHookUpdate,
-		Delete: resourceAlibabacloudStackEssLifeCycleHookDelete,
+		CreateContext: withDiagnostics(resourceAlibabacloudStackEssLifeCycleHookCreate),
+		ReadContext:   withDiagnostics(resourceAlibabacloudStackEssLifeCycleHookRead),
+		UpdateContext: withDiagnostics(resourceAlibabacloudStackEssLifeCycleHookUpdate),
+		DeleteContext: withDiagnostics(resourceAlibabacloudStackEssLifeCycleHookDelete),
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			StateContext: schema.ImportStatePassthroughContext,
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -66,7 +67,7 @@ func resourceAlibabacloudStackEssLifecycleHook() *schema.Resource {
 	}
 }
 
-func resourceAlibabacloudStackEssLifeCycleHookCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceAlibabacloudStackEssLifeCycleHookCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 
 	request := buildAlibabacloudStackEssLifeCycleHookArgs(d)
 	client := meta.(*connectivity.AlibabacloudStackClient)
@@ -79,7 +80,7 @@ func resourceAlibabacloudStackEssLifeCycleHookCreate(d *schema.ResourceData, met
 	request.Headers = map[string]string{"RegionId": client.RegionId}
 	request.QueryParams = map[string]string{"AccessKeySecret": client.SecretKey, "Product": "ess", "Department": client.Department, "ResourceGroup": client.ResourceGroup}
 
-	if err := resource.Retry(5*time.Minute, func() *resource.RetryError {
+	if err := resource.RetryContext(ctx, 5*time.Minute, func() *resource.RetryError {
 		raw, err := client.WithEssClient(func(essClient *ess.Client) (interface{}, error) {
 			return essClient.CreateLifecycleHook(request)
 		})
@@ -97,10 +98,10 @@ func resourceAlibabacloudStackEssLifeCycleHookCreate(d *schema.ResourceData, met
 		return WrapErrorf(err, DefaultErrorMsg, "alibabacloudstack_ess_lifecyclehook", request.GetActionName(), AlibabacloudStackSdkGoERROR)
 	}
 
-	return resourceAlibabacloudStackEssLifeCycleHookRead(d, meta)
+	return resourceAlibabacloudStackEssLifeCycleHookRead(ctx, d, meta)
 }
 
-func resourceAlibabacloudStackEssLifeCycleHookRead(d *schema.ResourceData, meta interface{}) error {
+func resourceAlibabacloudStackEssLifeCycleHookRead(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 	wiatSecondsIfWithTest(1)
 
 	client := meta.(*connectivity.AlibabacloudStackClient)
@@ -126,7 +127,7 @@ func resourceAlibabacloudStackEssLifeCycleHookRead(d *schema.ResourceData, meta
 	return nil
 }
 
-func resourceAlibabacloudStackEssLifeCycleHookUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceAlibabacloudStackEssLifeCycleHookUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 
 	client := meta.(*connectivity.AlibabacloudStackClient)
 	request := ess.CreateModifyLifecycleHookRequest()
@@ -168,10 +169,10 @@ func resourceAlibabacloudStackEssLifeCycleHookUpdate(d *schema.ResourceData, met
 		return WrapErrorf(err, DefaultErrorMsg, d.Id(), request.GetActionName(), AlibabacloudStackSdkGoERROR)
 	}
 	addDebug(request.GetActionName(), raw, request.RpcRequest, request)
-	return resourceAlibabacloudStackEssLifeCycleHookRead(d, meta)
+	return resourceAlibabacloudStackEssLifeCycleHookRead(ctx, d, meta)
 }
 
-func resourceAlibabacloudStackEssLifeCycleHookDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceAlibabacloudStackEssLifeCycleHookDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 
 	client := meta.(*connectivity.AlibabacloudStackClient)
 	essService := EssService{client}
@@ -1,6 +1,7 @@
 package alibabacloudstack
 
 import (
+	"context"
 	"fmt"
 	"strings"
 
@@ -11,12 +12,12 @@ import (
 
 func resourceAlibabacloudStackEssNotification() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceAlibabacloudStackEssNotificationCreate,
-		Read:   resourceAlibabacloudStackEssNotificationRead,
-		Update: resourceAlibabacloudStackEssNotificationUpdate,
-		Delete: resourceAlibabacloudStackEssNotificationDelete,
+		CreateContext: withDiagnostics(resourceAlibabacloudStackEssNotificationCreate),
+		ReadContext:   withDiagnostics(resourceAlibabacloudStackEssNotificationRead),
+		UpdateContext: withDiagnostics(resourceAlibabacloudStackEssNotificationUpdate),
+		DeleteContext: withDiagnostics(resourceAlibabacloudStackEssNotificationDelete),
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			StateContext: schema.ImportStatePassthroughContext,
 		},
 		Schema: map[string]*schema.Schema{
 			"notification_arn": {
@@ -39,7 +40,7 @@ func resourceAlibabacloudStackEssNotification() *schema.Resource {
 	}
 }
 
-func resourceAlibabacloudStackEssNotificationCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceAlibabacloudStackEssNotificationCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*connectivity.AlibabacloudStackClient)
 
 	request := ess.CreateCreateNotificationConfigurationRequest()
@@ -76,10 +77,10 @@ func resourceAlibabacloudStackEssNotificationCreate(d *schema.ResourceData, meta
 	}
 	addDebug(request.GetActionName(), raw, request.RpcRequest, request)
 	d.SetId(fmt.Sprintf("%s:%s", request.ScalingGroupId, request.NotificationArn))
-	return resourceAlibabacloudStackEssNotificationRead(d, meta)
+	return resourceAlibabacloudStackEssNotificationRead(ctx, d, meta)
 }
 
-func resourceAlibabacloudStackEssNotificationRead(d *schema.ResourceData, meta interface{}) error {
+func resourceAlibabacloudStackEssNotificationRead(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 	wiatSecondsIfWithTest(1)
 	client := meta.(*connectivity.AlibabacloudStackClient)
 	essService := EssService{client}
@@ -97,7 +98,7 @@ func resourceAlibabacloudStackEssNotificationRead(d *schema.ResourceData, meta i
 	return nil
 }
 
-func resourceAlibabacloudStackEssNotificationUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceAlibabacloudStackEssNotificationUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*connectivity.AlibabacloudStackClient)
 	request := ess.CreateModifyNotificationConfigurationRequest()
 	if strings.ToLower(client.Config.Protocol) == "https" {
@@ -132,10 +133,10 @@ func resourceAlibabacloudStackEssNotificationUpdate(d *schema.ResourceData, meta
 		return WrapErrorf(err, DefaultErrorMsg, d.Id(), request.GetActionName(), AlibabacloudStackSdkGoERROR)
 	}
 	addDebug(request.GetActionName(), raw, request.RpcRequest, request)
-	return resourceAlibabacloudStackEssNotificationRead(d, meta)
+	return resourceAlibabacloudStackEssNotificationRead(ctx, d, meta)
 }
 
-func resourceAlibabacloudStackEssNotificationDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceAlibabacloudStackEssNotificationDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*connectivity.AlibabacloudStackClient)
 	essService := EssService{client}
 	request := ess.CreateDeleteNotificationConfigurationRequest()
@@ -1,6 +1,7 @@
 package alibabacloudstack
 
 import (
+	"context"
 	"encoding/base64"
 	"fmt"
 	"github.com/aliyun/alibaba-cloud-sdk-go/sdk/requests"
@@ -17,12 +18,12 @@ import (
 
 func resourceAlibabacloudStackEssScalingConfiguration() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceAlibabacloudStackEssScalingConfigurationCreate,
-		Read:   resourceAlibabacloudStackEssScalingConfigurationRead,
-		Update: resourceAlibabacloudStackEssScalingConfigurationUpdate,
-		Delete: resourceAlibabacloudStackEssScalingConfigurationDelete,
+		CreateContext: withDiagnostics(resourceAlibabacloudStackEssScalingConfigurationCreate),
+		ReadContext:   withDiagnostics(resourceAlibabacloudStackEssScalingConfigurationRead),
+		UpdateContext: withDiagnostics(resourceAlibabacloudStackEssScalingConfigurationUpdate),
+		DeleteContext: withDiagnostics(resourceAlibabacloudStackEssScalingConfigurationDelete),
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			StateContext: schema.ImportStatePassthroughContext,
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -179,7 +180,7 @@ func resourceAlibabacloudStackEssScalingConfiguration() *schema.Resource {
 	}
 }
 
-func resourceAlibabacloudStackEssScalingConfigurationCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceAlibabacloudStackEssScalingConfigurationCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 
 	// Ensure instance_type is generation three
 	client := meta.(*connectivity.AlibabacloudStackClient)
@@ -198,7 +199,7 @@ func resourceAlibabacloudStackEssScalingConfigurationCreate(d *schema.ResourceDa
 		request.IoOptimized = string(NoneOptimized)
 	}
 
-	if err := resource.Retry(5*time.Minute, func() *resource.RetryError {
+	if err := resource.RetryContext(ctx, 5*time.Minute, func() *resource.RetryError {
 		raw, err := client.WithEssClient(func(essClient *ess.Client) (interface{}, error) {
 			return essClient.CreateScalingConfiguration(request)
 		})
@@ -216,10 +217,10 @@ func resourceAlibabacloudStackEssScalingConfigurationCreate(d *schema.ResourceDa
 		return WrapErrorf(err, DefaultErrorMsg, "alibabacloudstack_ess_scalingconfiguration", request.GetActionName(), AlibabacloudStackSdkGoERROR)
 	}
 
-	return resourceAlibabacloudStackEssScalingConfigurationUpdate(d, meta)
+	return resourceAlibabacloudStackEssScalingConfigurationUpdate(ctx, d, meta)
 }
 
-func resourceAlibabacloudStackEssScalingConfigurationUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceAlibabacloudStackEssScalingConfigurationUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*connectivity.AlibabacloudStackClient)
 	essService := EssService{client}
 	d.Partial(true)
@@ -256,20 +257,20 @@ func resourceAlibabacloudStackEssScalingConfigurationUpdate(d *schema.ResourceDa
 		//d.SetPartial("active")
 	}
 
-	if err := enableEssScalingConfiguration(d, meta); err != nil {
+	if err := enableEssScalingConfiguration(ctx, d, meta); err != nil {
 		return WrapError(err)
 	}
 
-	if err := modifyEssScalingConfiguration(d, meta); err != nil {
+	if err := modifyEssScalingConfiguration(ctx, d, meta); err != nil {
 		return WrapError(err)
 	}
 
 	d.Partial(false)
 
-	return resourceAlibabacloudStackEssScalingConfigurationRead(d, meta)
+	return resourceAlibabacloudStackEssScalingConfigurationRead(ctx, d, meta)
 }
 
-func modifyEssScalingConfiguration(d *schema.ResourceData, meta interface{}) error {
+func modifyEssScalingConfiguration(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*connectivity.AlibabacloudStackClient)
 	request := ess.CreateModifyScalingConfigurationRequest()
 	if strings.ToLower(client.Config.Protocol) == "https" {
@@ -366,8 +367,8 @@ func modifyEssScalingConfiguration(d *schema.ResourceData, meta interface{}) err
 		//d.SetPartial("instance_name")
 	}
 
-	if d.HasChange("tags") {
-		if v, ok := d.GetOk("tags"); ok {
+	if hasTagsAllChange(d, client) {
+		if v, ok := getTagsAllOk(d, client); ok {
 			tags := "{"
 			for key, value := range v.(map[string]interface{}) {
 				tags += "\"" + key + "\"" + ":" + "\"" + value.(string) + "\"" + ","
@@ -408,7 +409,7 @@ func modifyEssScalingConfiguration(d *schema.ResourceData, meta interface{}) err
 	return nil
 }
 
-func enableEssScalingConfiguration(d *schema.ResourceData, meta interface{}) error {
+func enableEssScalingConfiguration(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*connectivity.AlibabacloudStackClient)
 	essService := EssService{client}
 
@@ -498,7 +499,7 @@ func enableEssScalingConfiguration(d *schema.ResourceData, meta interface{}) err
 	return nil
 }
 
-func resourceAlibabacloudStackEssScalingConfigurationRead(d *schema.ResourceData, meta interface{}) error {
+func resourceAlibabacloudStackEssScalingConfigurationRead(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 	wiatSecondsIfWithTest(1)
 
 	client := meta.(*connectivity.AlibabacloudStackClient)
@@ -556,7 +557,7 @@ func resourceAlibabacloudStackEssScalingConfigurationRead(d *schema.ResourceData
 	return nil
 }
 
-func resourceAlibabacloudStackEssScalingConfigurationDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceAlibabacloudStackEssScalingConfigurationDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*connectivity.AlibabacloudStackClient)
 	essService := EssService{client}
 
@@ -747,7 +748,7 @@ func buildAlibabacloudStackEssScalingConfigurationArgs(d *schema.ResourceData, m
 		}
 	}
 
-	if v, ok := d.GetOk("tags"); ok {
+	if v, ok := getTagsAllOk(d, client); ok {
 		tags := "{"
 		for key, value := range v.(map[string]interface{}) {
 			tags += "\"" + key + "\"" + ":" + "\"" + value.(string) + "\"" + ","
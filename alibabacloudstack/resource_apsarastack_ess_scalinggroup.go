@@ -1,6 +1,7 @@
 package alibabacloudstack
 
 import (
+	"context"
 	"fmt"
 	"strings"
 	"time"
@@ -18,13 +19,14 @@ import (
 
 func resourceAlibabacloudStackEssScalingGroup() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceAlibabacloudStackEssScalingGroupCreate,
-		Read:   resourceAlibabacloudStackEssScalingGroupRead,
-		Update: resourceAlibabacloudStackEssScalingGroupUpdate,
-		Delete: resourceAlibabacloudStackEssScalingGroupDelete,
+		CreateContext: withDiagnostics(resourceAlibabacloudStackEssScalingGroupCreate),
+		ReadContext:   withDiagnostics(resourceAlibabacloudStackEssScalingGroupRead),
+		UpdateContext: withDiagnostics(resourceAlibabacloudStackEssScalingGroupUpdate),
+		DeleteContext: withDiagnostics(resourceAlibabacloudStackEssScalingGroupDelete),
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			StateContext: schema.ImportStatePassthroughContext,
 		},
+		CustomizeDiff: essScalingGroupSizeCustomizeDiff,
 
 		Schema: map[string]*schema.Schema{
 			"min_size": {
@@ -74,11 +76,12 @@ func resourceAlibabacloudStackEssScalingGroup() *schema.Resource {
 				Optional: true,
 				MinItems: 0,
 			},
+			"tags": tagsSchema(),
 		},
 	}
 }
 
-func resourceAlibabacloudStackEssScalingGroupCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceAlibabacloudStackEssScalingGroupCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 
 	request, err := buildAlibabacloudStackEssScalingGroupArgs(d, meta)
 
@@ -93,7 +96,7 @@ func resourceAlibabacloudStackEssScalingGroupCreate(d *schema.ResourceData, meta
 		request.Scheme = "http"
 	}
 	essService := EssService{client}
-	if err := resource.Retry(5*time.Minute, func() *resource.RetryError {
+	if err := resource.RetryContext(ctx, 5*time.Minute, func() *resource.RetryError {
 		raw, err := client.WithEssClient(func(essClient *ess.Client) (interface{}, error) {
 			return essClient.CreateScalingGroup(request)
 		})
@@ -114,10 +117,10 @@ func resourceAlibabacloudStackEssScalingGroupCreate(d *schema.ResourceData, meta
 		return WrapError(err)
 	}
 
-	return resourceAlibabacloudStackEssScalingGroupUpdate(d, meta)
+	return resourceAlibabacloudStackEssScalingGroupUpdate(ctx, d, meta)
 }
 
-func resourceAlibabacloudStackEssScalingGroupRead(d *schema.ResourceData, meta interface{}) error {
+func resourceAlibabacloudStackEssScalingGroupRead(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 	wiatSecondsIfWithTest(1)
 
 	client := meta.(*connectivity.AlibabacloudStackClient)
@@ -167,10 +170,15 @@ func resourceAlibabacloudStackEssScalingGroupRead(d *schema.ResourceData, meta i
 	}
 	d.Set("vswitch_ids", vswitchIds)
 
+	tags, err := essService.DescribeTags(d.Id(), TagResourceScalingGroup)
+	if err != nil {
+		return WrapError(err)
+	}
+	d.Set("tags", essService.tagsToMap(tags))
 	return nil
 }
 
-func resourceAlibabacloudStackEssScalingGroupUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceAlibabacloudStackEssScalingGroupUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 
 	client := meta.(*connectivity.AlibabacloudStackClient)
 	request := ess.CreateModifyScalingGroupRequest()
@@ -186,6 +194,11 @@ func resourceAlibabacloudStackEssScalingGroupUpdate(d *schema.ResourceData, meta
 	request.ScalingGroupId = d.Id()
 
 	d.Partial(true)
+	essService := EssService{client}
+	if err := essService.setInstanceTags(d, TagResourceScalingGroup); err != nil {
+		return WrapError(err)
+	}
+
 	if d.HasChange("scaling_group_name") {
 		request.ScalingGroupName = d.Get("scaling_group_name").(string)
 	}
@@ -242,10 +255,10 @@ func resourceAlibabacloudStackEssScalingGroupUpdate(d *schema.ResourceData, meta
 		//d.SetPartial("db_instance_ids")
 	}
 	d.Partial(false)
-	return resourceAlibabacloudStackEssScalingGroupRead(d, meta)
+	return resourceAlibabacloudStackEssScalingGroupRead(ctx, d, meta)
 }
 
-func resourceAlibabacloudStackEssScalingGroupDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceAlibabacloudStackEssScalingGroupDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*connectivity.AlibabacloudStackClient)
 	essService := EssService{client}
 
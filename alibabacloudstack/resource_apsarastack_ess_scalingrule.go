@@ -1,6 +1,7 @@
 package alibabacloudstack
 
 import (
+	"context"
 	"strings"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
@@ -13,12 +14,12 @@ import (
 
 func resourceAlibabacloudStackEssScalingRule() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceAlibabacloudStackEssScalingRuleCreate,
-		Read:   resourceAlibabacloudStackEssScalingRuleRead,
-		Update: resourceAlibabacloudStackEssScalingRuleUpdate,
-		Delete: resourceAlibabacloudStackEssScalingRuleDelete,
+		CreateContext: withDiagnostics(resourceAlibabacloudStackEssScalingRuleCreate),
+		ReadContext:   withDiagnostics(resourceAlibabacloudStackEssScalingRuleRead),
+		UpdateContext: withDiagnostics(resourceAlibabacloudStackEssScalingRuleUpdate),
+		DeleteContext: withDiagnostics(resourceAlibabacloudStackEssScalingRuleDelete),
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			StateContext: schema.ImportStatePassthroughContext,
 		},
 		Schema: map[string]*schema.Schema{
 			"scaling_group_id": {
@@ -56,7 +57,7 @@ func resourceAlibabacloudStackEssScalingRule() *schema.Resource {
 	}
 }
 
-func resourceAlibabacloudStackEssScalingRuleCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceAlibabacloudStackEssScalingRuleCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 
 	request, err := buildAlibabacloudStackEssScalingRuleArgs(d, meta)
 	if err != nil {
@@ -83,10 +84,10 @@ func resourceAlibabacloudStackEssScalingRuleCreate(d *schema.ResourceData, meta
 	response, _ := raw.(*ess.CreateScalingRuleResponse)
 	d.SetId(response.ScalingRuleId)
 
-	return resourceAlibabacloudStackEssScalingRuleRead(d, meta)
+	return resourceAlibabacloudStackEssScalingRuleRead(ctx, d, meta)
 }
 
-func resourceAlibabacloudStackEssScalingRuleRead(d *schema.ResourceData, meta interface{}) error {
+func resourceAlibabacloudStackEssScalingRuleRead(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 	wiatSecondsIfWithTest(1)
 
 	//Compatible with older versions id
@@ -117,7 +118,7 @@ func resourceAlibabacloudStackEssScalingRuleRead(d *schema.ResourceData, meta in
 	return nil
 }
 
-func resourceAlibabacloudStackEssScalingRuleDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceAlibabacloudStackEssScalingRuleDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 
 	//Compatible with older versions id
 	if strings.Contains(d.Id(), COLON_SEPARATED) {
@@ -151,7 +152,7 @@ func resourceAlibabacloudStackEssScalingRuleDelete(d *schema.ResourceData, meta
 	return WrapError(essService.WaitForEssScalingRule(d.Id(), Deleted, DefaultTimeout))
 }
 
-func resourceAlibabacloudStackEssScalingRuleUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceAlibabacloudStackEssScalingRuleUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 
 	//Compatible with older versions id
 	if strings.Contains(d.Id(), COLON_SEPARATED) {
@@ -191,7 +192,7 @@ func resourceAlibabacloudStackEssScalingRuleUpdate(d *schema.ResourceData, meta
 		return WrapErrorf(err, DefaultErrorMsg, d.Id(), request.GetActionName(), AlibabacloudStackSdkGoERROR)
 	}
 	addDebug(request.GetActionName(), raw, request.RpcRequest, request)
-	return resourceAlibabacloudStackEssScalingRuleRead(d, meta)
+	return resourceAlibabacloudStackEssScalingRuleRead(ctx, d, meta)
 }
 
 func buildAlibabacloudStackEssScalingRuleArgs(d *schema.ResourceData, meta interface{}) (*ess.CreateScalingRuleRequest, error) {
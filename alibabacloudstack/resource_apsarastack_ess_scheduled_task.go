@@ -1,6 +1,7 @@
 package alibabacloudstack
 
 import (
+	"context"
 	"github.com/aliyun/alibaba-cloud-sdk-go/sdk/requests"
 	"github.com/aliyun/alibaba-cloud-sdk-go/services/ess"
 	"github.com/aliyun/terraform-provider-alibabacloudstack/alibabacloudstack/connectivity"
@@ -11,12 +12,12 @@ import (
 
 func resourceAlibabacloudStackEssScheduledTask() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceAlibabacloudStackEssScheduledTaskCreate,
-		Read:   resourceAlibabacloudStackEssScheduledTaskRead,
-		Update: resourceAlibabacloudStackEssScheduledTaskUpdate,
-		Delete: resourceAlibabacloudStackEssScheduledTaskDelete,
+		CreateContext: withDiagnostics(resourceAlibabacloudStackEssScheduledTaskCreate),
+		ReadContext:   withDiagnostics(resourceAlibabacloudStackEssScheduledTaskRead),
+		UpdateContext: withDiagnostics(resourceAlibabacloudStackEssScheduledTaskUpdate),
+		DeleteContext: withDiagnostics(resourceAlibabacloudStackEssScheduledTaskDelete),
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			StateContext: schema.ImportStatePassthroughContext,
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -68,7 +69,7 @@ func resourceAlibabacloudStackEssScheduledTask() *schema.Resource {
 	}
 }
 
-func resourceAlibabacloudStackEssScheduledTaskCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceAlibabacloudStackEssScheduledTaskCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 
 	request := buildAlibabacloudStackEssScheduledTaskArgs(d)
 	client := meta.(*connectivity.AlibabacloudStackClient)
@@ -91,10 +92,10 @@ func resourceAlibabacloudStackEssScheduledTaskCreate(d *schema.ResourceData, met
 	response, _ := raw.(*ess.CreateScheduledTaskResponse)
 	d.SetId(response.ScheduledTaskId)
 
-	return resourceAlibabacloudStackEssScheduledTaskRead(d, meta)
+	return resourceAlibabacloudStackEssScheduledTaskRead(ctx, d, meta)
 }
 
-func resourceAlibabacloudStackEssScheduledTaskRead(d *schema.ResourceData, meta interface{}) error {
+func resourceAlibabacloudStackEssScheduledTaskRead(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 	wiatSecondsIfWithTest(1)
 
 	client := meta.(*connectivity.AlibabacloudStackClient)
@@ -122,7 +123,7 @@ func resourceAlibabacloudStackEssScheduledTaskRead(d *schema.ResourceData, meta
 	return nil
 }
 
-func resourceAlibabacloudStackEssScheduledTaskUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceAlibabacloudStackEssScheduledTaskUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 
 	client := meta.(*connectivity.AlibabacloudStackClient)
 
@@ -172,10 +173,10 @@ func resourceAlibabacloudStackEssScheduledTaskUpdate(d *schema.ResourceData, met
 		return WrapErrorf(err, DefaultErrorMsg, d.Id(), request.GetActionName(), AlibabacloudStackSdkGoERROR)
 	}
 	addDebug(request.GetActionName(), raw, request.RpcRequest, request)
-	return resourceAlibabacloudStackEssScheduledTaskRead(d, meta)
+	return resourceAlibabacloudStackEssScheduledTaskRead(ctx, d, meta)
 }
 
-func resourceAlibabacloudStackEssScheduledTaskDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceAlibabacloudStackEssScheduledTaskDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*connectivity.AlibabacloudStackClient)
 	essService := EssService{client}
 
@@ -1,6 +1,7 @@
 package alibabacloudstack
 
 import (
+	"context"
 	"github.com/aliyun/alibaba-cloud-sdk-go/services/vpc"
 	"github.com/aliyun/terraform-provider-alibabacloudstack/alibabacloudstack/connectivity"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
@@ -12,10 +13,13 @@ import (
 
 func resourceAlibabacloudStackForwardEntry() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceAlibabacloudStackForwardEntryCreate,
-		Read:   resourceAlibabacloudStackForwardEntryRead,
-		Update: resourceAlibabacloudStackForwardEntryUpdate,
-		Delete: resourceAlibabacloudStackForwardEntryDelete,
+		CreateContext: withDiagnostics(resourceAlibabacloudStackForwardEntryCreate),
+		ReadContext:   withDiagnostics(resourceAlibabacloudStackForwardEntryRead),
+		UpdateContext: withDiagnostics(resourceAlibabacloudStackForwardEntryUpdate),
+		DeleteContext: withDiagnostics(resourceAlibabacloudStackForwardEntryDelete),
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
 
 		Schema: map[string]*schema.Schema{
 			"forward_table_id": {
@@ -58,7 +62,7 @@ func resourceAlibabacloudStackForwardEntry() *schema.Resource {
 	}
 }
 
-func resourceAlibabacloudStackForwardEntryCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceAlibabacloudStackForwardEntryCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*connectivity.AlibabacloudStackClient)
 	vpcService := VpcService{client}
 
@@ -82,7 +86,7 @@ func resourceAlibabacloudStackForwardEntryCreate(d *schema.ResourceData, meta in
 	}
 	var raw interface{}
 	var err error
-	if err = resource.Retry(2*time.Minute, func() *resource.RetryError {
+	if err = resource.RetryContext(ctx, 2*time.Minute, func() *resource.RetryError {
 		ar := request
 		raw, err = client.WithVpcClient(func(vpcClient *vpc.Client) (interface{}, error) {
 			return vpcClient.CreateForwardEntry(ar)
@@ -104,10 +108,10 @@ func resourceAlibabacloudStackForwardEntryCreate(d *schema.ResourceData, meta in
 	if err := vpcService.WaitForForwardEntry(d.Id(), Available, DefaultTimeoutMedium); err != nil {
 		return WrapError(err)
 	}
-	return resourceAlibabacloudStackForwardEntryRead(d, meta)
+	return resourceAlibabacloudStackForwardEntryRead(ctx, d, meta)
 }
 
-func resourceAlibabacloudStackForwardEntryRead(d *schema.ResourceData, meta interface{}) error {
+func resourceAlibabacloudStackForwardEntryRead(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 	wiatSecondsIfWithTest(1)
 	client := meta.(*connectivity.AlibabacloudStackClient)
 	vpcService := VpcService{client}
@@ -135,7 +139,7 @@ func resourceAlibabacloudStackForwardEntryRead(d *schema.ResourceData, meta inte
 	return nil
 }
 
-func resourceAlibabacloudStackForwardEntryUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceAlibabacloudStackForwardEntryUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*connectivity.AlibabacloudStackClient)
 	vpcService := VpcService{client}
 	if !strings.Contains(d.Id(), COLON_SEPARATED) {
@@ -192,10 +196,10 @@ func resourceAlibabacloudStackForwardEntryUpdate(d *schema.ResourceData, meta in
 	if err := vpcService.WaitForForwardEntry(d.Id(), Available, DefaultTimeout); err != nil {
 		return WrapError(err)
 	}
-	return resourceAlibabacloudStackForwardEntryRead(d, meta)
+	return resourceAlibabacloudStackForwardEntryRead(ctx, d, meta)
 }
 
-func resourceAlibabacloudStackForwardEntryDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceAlibabacloudStackForwardEntryDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 	if !strings.Contains(d.Id(), COLON_SEPARATED) {
 		d.SetId(d.Get("forward_table_id").(string) + COLON_SEPARATED + d.Id())
 	}
@@ -217,7 +221,7 @@ func resourceAlibabacloudStackForwardEntryDelete(d *schema.ResourceData, meta in
 	request.ForwardTableId = parts[0]
 	request.ForwardEntryId = parts[1]
 
-	err = resource.Retry(3*time.Minute, func() *resource.RetryError {
+	err = resource.RetryContext(ctx, 3*time.Minute, func() *resource.RetryError {
 		raw, err := client.WithVpcClient(func(vpcClient *vpc.Client) (interface{}, error) {
 			return vpcClient.DeleteForwardEntry(request)
 		})
@@ -1,6 +1,7 @@
 package alibabacloudstack
 
 import (
+	"context"
 	"fmt"
 	"log"
 	"regexp"
@@ -16,12 +17,12 @@ import (
 
 func resourceAlibabacloudStackGpdbAccount() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceAlibabacloudStackGpdbAccountCreate,
-		Read:   resourceAlibabacloudStackGpdbAccountRead,
-		Update: resourceAlibabacloudStackGpdbAccountUpdate,
-		Delete: resourceAlibabacloudStackGpdbAccountDelete,
+		CreateContext: withDiagnostics(resourceAlibabacloudStackGpdbAccountCreate),
+		ReadContext:   withDiagnostics(resourceAlibabacloudStackGpdbAccountRead),
+		UpdateContext: withDiagnostics(resourceAlibabacloudStackGpdbAccountUpdate),
+		DeleteContext: withDiagnostics(resourceAlibabacloudStackGpdbAccountDelete),
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			StateContext: schema.ImportStatePassthroughContext,
 		},
 		Timeouts: &schema.ResourceTimeout{
 			Create: schema.DefaultTimeout(5 * time.Minute),
@@ -57,7 +58,7 @@ func resourceAlibabacloudStackGpdbAccount() *schema.Resource {
 	}
 }
 
-func resourceAlibabacloudStackGpdbAccountCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceAlibabacloudStackGpdbAccountCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*connectivity.AlibabacloudStackClient)
 	var response map[string]interface{}
 	action := "CreateAccount"
@@ -76,7 +77,7 @@ func resourceAlibabacloudStackGpdbAccountCreate(d *schema.ResourceData, meta int
 	request["DBInstanceId"] = d.Get("db_instance_id")
 	request["AccountPassword"] = d.Get("account_password")
 	wait := incrementalWait(3*time.Second, 3*time.Second)
-	err = resource.Retry(d.Timeout(schema.TimeoutCreate), func() *resource.RetryError {
+	err = resource.RetryContext(ctx, d.Timeout(schema.TimeoutCreate), func() *resource.RetryError {
 		response, err = conn.DoRequest(StringPointer(action), nil, StringPointer("POST"), StringPointer("2016-05-03"), StringPointer("AK"), nil, request, &util.RuntimeOptions{})
 		if err != nil {
 			if NeedRetry(err) {
@@ -95,13 +96,13 @@ func resourceAlibabacloudStackGpdbAccountCreate(d *schema.ResourceData, meta int
 	d.SetId(fmt.Sprint(request["DBInstanceId"], ":", request["AccountName"]))
 	gpdbService := GpdbService{client}
 	stateConf := BuildStateConf([]string{}, []string{"1"}, d.Timeout(schema.TimeoutCreate), 60*time.Second, gpdbService.GpdbAccountStateRefreshFunc(d.Id(), []string{}))
-	if _, err := stateConf.WaitForState(); err != nil {
+	if _, err := stateConf.WaitForStateContext(ctx); err != nil {
 		return WrapErrorf(err, IdMsg, d.Id())
 	}
 
-	return resourceAlibabacloudStackGpdbAccountRead(d, meta)
+	return resourceAlibabacloudStackGpdbAccountRead(ctx, d, meta)
 }
-func resourceAlibabacloudStackGpdbAccountRead(d *schema.ResourceData, meta interface{}) error {
+func resourceAlibabacloudStackGpdbAccountRead(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*connectivity.AlibabacloudStackClient)
 	gpdbService := GpdbService{client}
 	object, err := gpdbService.DescribeGpdbAccount(d.Id())
@@ -123,7 +124,7 @@ func resourceAlibabacloudStackGpdbAccountRead(d *schema.ResourceData, meta inter
 	d.Set("status", convertGpdbAccountStatusResponse(object["AccountStatus"]))
 	return nil
 }
-func resourceAlibabacloudStackGpdbAccountUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceAlibabacloudStackGpdbAccountUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*connectivity.AlibabacloudStackClient)
 	var response map[string]interface{}
 	parts, err := ParseResourceId(d.Id(), 2)
@@ -154,7 +155,7 @@ func resourceAlibabacloudStackGpdbAccountUpdate(d *schema.ResourceData, meta int
 			return WrapError(err)
 		}
 		wait := incrementalWait(3*time.Second, 3*time.Second)
-		err = resource.Retry(d.Timeout(schema.TimeoutUpdate), func() *resource.RetryError {
+		err = resource.RetryContext(ctx, d.Timeout(schema.TimeoutUpdate), func() *resource.RetryError {
 
 			response, err = conn.DoRequest(StringPointer(action), nil, StringPointer("POST"), StringPointer("2016-05-03"), StringPointer("AK"), nil, request, &util.RuntimeOptions{})
 			if err != nil {
@@ -172,10 +173,10 @@ func resourceAlibabacloudStackGpdbAccountUpdate(d *schema.ResourceData, meta int
 		}
 	}
 
-	return resourceAlibabacloudStackGpdbAccountRead(d, meta)
+	return resourceAlibabacloudStackGpdbAccountRead(ctx, d, meta)
 }
-func resourceAlibabacloudStackGpdbAccountDelete(d *schema.ResourceData, meta interface{}) error {
-	log.Printf("[WARN] Cannot destroy resourcealibabacloudstackGpdbAccount. Terraform will remove this resource from the state file, however resources may remain.")
+func resourceAlibabacloudStackGpdbAccountDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
+	addWarning(ctx, "", "Cannot destroy resource alibabacloudstack_gpdb_account", "Terraform will remove this resource from the state file, however resources may remain.")
 	return nil
 }
 func convertGpdbAccountStatusResponse(source interface{}) interface{} {
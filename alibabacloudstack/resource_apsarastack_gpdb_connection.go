@@ -1,6 +1,7 @@
 package alibabacloudstack
 
 import (
+	"context"
 	"fmt"
 	"strings"
 	"time"
@@ -16,12 +17,12 @@ import (
 
 func resourceAlibabacloudStackGpdbConnection() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceAlibabacloudStackGpdbConnectionCreate,
-		Read:   resourceAlibabacloudStackGpdbConnectionRead,
-		Update: resourceAlibabacloudStackGpdbConnectionUpdate,
-		Delete: resourceAlibabacloudStackGpdbConnectionDelete,
+		CreateContext: withDiagnostics(resourceAlibabacloudStackGpdbConnectionCreate),
+		ReadContext:   withDiagnostics(resourceAlibabacloudStackGpdbConnectionRead),
+		UpdateContext: withDiagnostics(resourceAlibabacloudStackGpdbConnectionUpdate),
+		DeleteContext: withDiagnostics(resourceAlibabacloudStackGpdbConnectionDelete),
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			StateContext: schema.ImportStatePassthroughContext,
 		},
 
 		Timeouts: &schema.ResourceTimeout{
@@ -61,7 +62,7 @@ func resourceAlibabacloudStackGpdbConnection() *schema.Resource {
 	}
 }
 
-func resourceAlibabacloudStackGpdbConnectionCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceAlibabacloudStackGpdbConnectionCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*connectivity.AlibabacloudStackClient)
 	gpdbService := GpdbService{client}
 	instanceId := d.Get("instance_id").(string)
@@ -85,7 +86,7 @@ func resourceAlibabacloudStackGpdbConnectionCreate(d *schema.ResourceData, meta
 	if client.Config.Insecure {
 		request.SetHTTPSInsecure(client.Config.Insecure)
 	}
-	err := resource.Retry(8*time.Minute, func() *resource.RetryError {
+	err := resource.RetryContext(ctx, 8*time.Minute, func() *resource.RetryError {
 		raw, err := client.WithGpdbClient(func(gpdbClient *gpdb.Client) (interface{}, error) {
 			return gpdbClient.AllocateInstancePublicConnection(request)
 		})
@@ -106,14 +107,14 @@ func resourceAlibabacloudStackGpdbConnectionCreate(d *schema.ResourceData, meta
 	// wait instance running after allocating
 	stateConf := BuildStateConf([]string{"Creating", "NetAddressCreating"}, []string{"Running"}, d.Timeout(schema.TimeoutCreate), 5*time.Second, gpdbService.GpdbInstanceStateRefreshFunc(instanceId, []string{"Deleting"}))
 
-	if _, err := stateConf.WaitForState(); err != nil {
+	if _, err := stateConf.WaitForStateContext(ctx); err != nil {
 		return WrapErrorf(err, IdMsg, d.Id())
 	}
 
-	return resourceAlibabacloudStackGpdbConnectionRead(d, meta)
+	return resourceAlibabacloudStackGpdbConnectionRead(ctx, d, meta)
 }
 
-func resourceAlibabacloudStackGpdbConnectionRead(d *schema.ResourceData, meta interface{}) error {
+func resourceAlibabacloudStackGpdbConnectionRead(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 	wiatSecondsIfWithTest(1)
 	parts, err := ParseResourceId(d.Id(), 2)
 	if err != nil {
@@ -140,7 +141,7 @@ func resourceAlibabacloudStackGpdbConnectionRead(d *schema.ResourceData, meta in
 	return nil
 }
 
-func resourceAlibabacloudStackGpdbConnectionUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceAlibabacloudStackGpdbConnectionUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 	parts, err := ParseResourceId(d.Id(), 2)
 	if err != nil {
 		return WrapError(err)
@@ -163,7 +164,7 @@ func resourceAlibabacloudStackGpdbConnectionUpdate(d *schema.ResourceData, meta
 		request.ConnectionStringPrefix = parts[1]
 		request.Port = d.Get("port").(string)
 
-		if err := resource.Retry(8*time.Minute, func() *resource.RetryError {
+		if err := resource.RetryContext(ctx, 8*time.Minute, func() *resource.RetryError {
 			raw, err := client.WithGpdbClient(func(gpdbClient *gpdb.Client) (interface{}, error) {
 				return gpdbClient.ModifyDBInstanceConnectionString(request)
 			})
@@ -182,14 +183,14 @@ func resourceAlibabacloudStackGpdbConnectionUpdate(d *schema.ResourceData, meta
 		// wait instance running after modifying
 		stateConf := BuildStateConf([]string{"NET_MODIFYING"}, []string{"Running"}, d.Timeout(schema.TimeoutUpdate), 3*time.Minute, gpdbService.GpdbInstanceStateRefreshFunc(request.DBInstanceId, []string{"Deleting"}))
 
-		if _, err := stateConf.WaitForState(); err != nil {
+		if _, err := stateConf.WaitForStateContext(ctx); err != nil {
 			return WrapErrorf(err, IdMsg, d.Id())
 		}
 	}
-	return resourceAlibabacloudStackGpdbConnectionRead(d, meta)
+	return resourceAlibabacloudStackGpdbConnectionRead(ctx, d, meta)
 }
 
-func resourceAlibabacloudStackGpdbConnectionDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceAlibabacloudStackGpdbConnectionDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*connectivity.AlibabacloudStackClient)
 	parts, err := ParseResourceId(d.Id(), 2)
 	if err != nil {
@@ -203,7 +204,7 @@ func resourceAlibabacloudStackGpdbConnectionDelete(d *schema.ResourceData, meta
 
 	gpdbService := GpdbService{client}
 	request.RegionId = client.RegionId
-	err = resource.Retry(5*time.Minute, func() *resource.RetryError {
+	err = resource.RetryContext(ctx, 5*time.Minute, func() *resource.RetryError {
 		object, err := gpdbService.DescribeGpdbConnection(d.Id())
 		if err != nil {
 			return resource.NonRetryableError(WrapError(err))
@@ -231,7 +232,7 @@ func resourceAlibabacloudStackGpdbConnectionDelete(d *schema.ResourceData, meta
 	}
 	stateConf := BuildStateConf([]string{"NetAddressDeleting"}, []string{"Running"}, d.Timeout(schema.TimeoutDelete), 5*time.Second, gpdbService.GpdbInstanceStateRefreshFunc(request.DBInstanceId, []string{"Deleting"}))
 
-	if _, err := stateConf.WaitForState(); err != nil {
+	if _, err := stateConf.WaitForStateContext(ctx); err != nil {
 		return WrapErrorf(err, IdMsg, d.Id())
 	}
 	return WrapError(gpdbService.WaitForGpdbConnection(d.Id(), Deleted, DefaultTimeoutMedium))
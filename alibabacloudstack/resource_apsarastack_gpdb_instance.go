@@ -1,6 +1,7 @@
 package alibabacloudstack
 
 import (
+	"context"
 	"strings"
 	"time"
 
@@ -15,12 +16,12 @@ import (
 
 func resourceAlibabacloudStackGpdbInstance() *schema.Resource {
 	return &schema.Resource{
-		Read:   resourceAlibabacloudStackGpdbInstanceRead,
-		Create: resourceAlibabacloudStackGpdbInstanceCreate,
-		Update: resourceAlibabacloudStackGpdbInstanceUpdate,
-		Delete: resourceAlibabacloudStackGpdbInstanceDelete,
+		ReadContext:   withDiagnostics(resourceAlibabacloudStackGpdbInstanceRead),
+		CreateContext: withDiagnostics(resourceAlibabacloudStackGpdbInstanceCreate),
+		UpdateContext: withDiagnostics(resourceAlibabacloudStackGpdbInstanceUpdate),
+		DeleteContext: withDiagnostics(resourceAlibabacloudStackGpdbInstanceDelete),
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			StateContext: schema.ImportStatePassthroughContext,
 		},
 
 		Timeouts: &schema.ResourceTimeout{
@@ -118,7 +119,7 @@ func resourceAlibabacloudStackGpdbInstance() *schema.Resource {
 	}
 }
 
-func resourceAlibabacloudStackGpdbInstanceRead(d *schema.ResourceData, meta interface{}) error {
+func resourceAlibabacloudStackGpdbInstanceRead(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 	wiatSecondsIfWithTest(1)
 	client := meta.(*connectivity.AlibabacloudStackClient)
 	gpdbService := GpdbService{client}
@@ -156,7 +157,7 @@ func resourceAlibabacloudStackGpdbInstanceRead(d *schema.ResourceData, meta inte
 	return nil
 }
 
-func resourceAlibabacloudStackGpdbInstanceCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceAlibabacloudStackGpdbInstanceCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*connectivity.AlibabacloudStackClient)
 	gpdbService := GpdbService{client}
 
@@ -175,7 +176,7 @@ func resourceAlibabacloudStackGpdbInstanceCreate(d *schema.ResourceData, meta in
 		return WrapError(err)
 	}
 	var raw interface{}
-	err = resource.Retry(5*time.Minute, func() *resource.RetryError {
+	err = resource.RetryContext(ctx, 5*time.Minute, func() *resource.RetryError {
 		raw, err = client.WithGpdbClient(func(client *gpdb.Client) (interface{}, error) {
 			return client.CreateDBInstance(request)
 		})
@@ -196,13 +197,13 @@ func resourceAlibabacloudStackGpdbInstanceCreate(d *schema.ResourceData, meta in
 
 	stateConf := BuildStateConf([]string{"Creating"}, []string{"Running"}, d.Timeout(schema.TimeoutCreate), 10*time.Minute, gpdbService.GpdbInstanceStateRefreshFunc(d.Id(), []string{"Deleting"}))
 
-	if _, err := stateConf.WaitForState(); err != nil {
+	if _, err := stateConf.WaitForStateContext(ctx); err != nil {
 		return WrapErrorf(err, IdMsg, d.Id())
 	}
-	return resourceAlibabacloudStackGpdbInstanceUpdate(d, meta)
+	return resourceAlibabacloudStackGpdbInstanceUpdate(ctx, d, meta)
 }
 
-func resourceAlibabacloudStackGpdbInstanceUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceAlibabacloudStackGpdbInstanceUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*connectivity.AlibabacloudStackClient)
 	gpdbService := GpdbService{client}
 
@@ -248,10 +249,10 @@ func resourceAlibabacloudStackGpdbInstanceUpdate(d *schema.ResourceData, meta in
 	// Finish Update
 	d.Partial(false)
 
-	return resourceAlibabacloudStackGpdbInstanceRead(d, meta)
+	return resourceAlibabacloudStackGpdbInstanceRead(ctx, d, meta)
 }
 
-func resourceAlibabacloudStackGpdbInstanceDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceAlibabacloudStackGpdbInstanceDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*connectivity.AlibabacloudStackClient)
 
 	request := gpdb.CreateDeleteDBInstanceRequest()
@@ -260,7 +261,7 @@ func resourceAlibabacloudStackGpdbInstanceDelete(d *schema.ResourceData, meta in
 	request.QueryParams = map[string]string{"AccessKeySecret": client.SecretKey, "Product": "gpdb", "Department": client.Department, "ResourceGroup": client.ResourceGroup}
 	request.DBInstanceId = d.Id()
 
-	err := resource.Retry(10*5*time.Minute, func() *resource.RetryError {
+	err := resource.RetryContext(ctx, 10*5*time.Minute, func() *resource.RetryError {
 		raw, err := client.WithGpdbClient(func(client *gpdb.Client) (interface{}, error) {
 			return client.DeleteDBInstance(request)
 		})
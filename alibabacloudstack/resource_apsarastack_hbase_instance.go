@@ -1,6 +1,7 @@
 package alibabacloudstack
 
 import (
+	"context"
 	"strings"
 	"time"
 
@@ -15,12 +16,12 @@ import (
 
 func resourceAlibabacloudStackHBaseInstance() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceAlibabacloudStackHBaseInstanceCreate,
-		Read:   resourceAlibabacloudStackHBaseInstanceRead,
-		Update: resourceAlibabacloudStackHBaseInstanceUpdate,
-		Delete: resourceAlibabacloudStackHBaseInstanceDelete,
+		CreateContext: withDiagnostics(resourceAlibabacloudStackHBaseInstanceCreate),
+		ReadContext:   withDiagnostics(resourceAlibabacloudStackHBaseInstanceRead),
+		UpdateContext: withDiagnostics(resourceAlibabacloudStackHBaseInstanceUpdate),
+		DeleteContext: withDiagnostics(resourceAlibabacloudStackHBaseInstanceDelete),
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			StateContext: schema.ImportStatePassthroughContext,
 		},
 		Timeouts: &schema.ResourceTimeout{
 			Create: schema.DefaultTimeout(30 * time.Minute),
@@ -288,7 +289,7 @@ func buildHBaseCreateRequest(d *schema.ResourceData, meta interface{}) (*hbase.C
 	return request, checkParams(request)
 }
 
-func resourceAlibabacloudStackHBaseInstanceCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceAlibabacloudStackHBaseInstanceCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*connectivity.AlibabacloudStackClient)
 	hBaseService := HBaseService{client}
 
@@ -325,14 +326,14 @@ func resourceAlibabacloudStackHBaseInstanceCreate(d *schema.ResourceData, meta i
 
 	stateConf := BuildStateConf([]string{Hb_LAUNCHING, Hb_CREATING}, []string{Hb_ACTIVATION}, d.Timeout(schema.TimeoutCreate),
 		5*time.Minute, hBaseService.HBaseClusterStateRefreshFunc(d.Id(), []string{Hb_CREATE_FAILED}))
-	if _, err := stateConf.WaitForState(); err != nil {
+	if _, err := stateConf.WaitForStateContext(ctx); err != nil {
 		return WrapError(err)
 	}
 
-	return resourceAlibabacloudStackHBaseInstanceUpdate(d, meta)
+	return resourceAlibabacloudStackHBaseInstanceUpdate(ctx, d, meta)
 }
 
-func resourceAlibabacloudStackHBaseInstanceRead(d *schema.ResourceData, meta interface{}) error {
+func resourceAlibabacloudStackHBaseInstanceRead(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*connectivity.AlibabacloudStackClient)
 	hbaseService := HBaseService{client}
 
@@ -432,7 +433,7 @@ func resourceAlibabacloudStackHBaseInstanceRead(d *schema.ResourceData, meta int
 	return nil
 }
 
-func resourceAlibabacloudStackHBaseInstanceUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceAlibabacloudStackHBaseInstanceUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*connectivity.AlibabacloudStackClient)
 	hBaseService := HBaseService{client}
 	d.Partial(true)
@@ -478,7 +479,7 @@ func resourceAlibabacloudStackHBaseInstanceUpdate(d *schema.ResourceData, meta i
 			}
 			stateConf := BuildStateConf([]string{}, []string{Hb_ACTIVATION}, d.Timeout(schema.TimeoutUpdate),
 				2*time.Minute, hBaseService.HBaseClusterStateRefreshFunc(d.Id(), []string{}))
-			if _, err := stateConf.WaitForState(); err != nil {
+			if _, err := stateConf.WaitForStateContext(ctx); err != nil {
 				return WrapErrorf(err, IdMsg, d.Id())
 			}
 			d.SetPartial("pay_type")
@@ -545,7 +546,7 @@ func resourceAlibabacloudStackHBaseInstanceUpdate(d *schema.ResourceData, meta i
 		}
 		stateConf := BuildStateConf([]string{}, []string{Hb_ACTIVATION}, d.Timeout(schema.TimeoutUpdate),
 			5*time.Second, hBaseService.HBaseClusterStateRefreshFunc(d.Id(), []string{}))
-		if _, err := stateConf.WaitForState(); err != nil {
+		if _, err := stateConf.WaitForStateContext(ctx); err != nil {
 			return WrapErrorf(err, IdMsg, d.Id())
 		}
 		d.SetPartial("security_groups")
@@ -624,7 +625,7 @@ func resourceAlibabacloudStackHBaseInstanceUpdate(d *schema.ResourceData, meta i
 
 	if d.IsNewResource() {
 		d.Partial(false)
-		return resourceAlibabacloudStackHBaseInstanceRead(d, meta)
+		return resourceAlibabacloudStackHBaseInstanceRead(ctx, d, meta)
 	}
 
 	if d.HasChange("name") {
@@ -683,7 +684,7 @@ func resourceAlibabacloudStackHBaseInstanceUpdate(d *schema.ResourceData, meta i
 		// wait instance status is running after modifying
 		stateConf := BuildStateConf([]string{Hb_NODE_RESIZING}, []string{Hb_ACTIVATION}, d.Timeout(schema.TimeoutUpdate),
 			5*time.Minute, hBaseService.HBaseClusterStateRefreshFunc(d.Id(), []string{Hb_NODE_RESIZING_FAILED}))
-		if _, err := stateConf.WaitForState(); err != nil {
+		if _, err := stateConf.WaitForStateContext(ctx); err != nil {
 			return WrapError(err)
 		}
 		addDebug(request.GetActionName(), raw, request.RpcRequest, request)
@@ -729,7 +730,7 @@ func resourceAlibabacloudStackHBaseInstanceUpdate(d *schema.ResourceData, meta i
 			// wait instance status is running after modifying
 			stateConf := BuildStateConf([]string{Hb_LEVEL_MODIFY}, []string{Hb_ACTIVATION}, d.Timeout(schema.TimeoutUpdate),
 				5*time.Minute, hBaseService.HBaseClusterStateRefreshFunc(d.Id(), []string{Hb_LEVEL_MODIFY_FAILED}))
-			if _, err := stateConf.WaitForState(); err != nil {
+			if _, err := stateConf.WaitForStateContext(ctx); err != nil {
 				return WrapError(err)
 			}
 			addDebug(request.GetActionName(), raw, request.RpcRequest, request)
@@ -766,7 +767,7 @@ func resourceAlibabacloudStackHBaseInstanceUpdate(d *schema.ResourceData, meta i
 		// wait instance status is running after modifying
 		stateConf := BuildStateConf([]string{Hb_DISK_RESIZING}, []string{Hb_ACTIVATION}, d.Timeout(schema.TimeoutUpdate),
 			2*time.Minute, hBaseService.HBaseClusterStateRefreshFunc(d.Id(), []string{Hb_DISK_RESIZE_FAILED}))
-		if _, err := stateConf.WaitForState(); err != nil {
+		if _, err := stateConf.WaitForStateContext(ctx); err != nil {
 			return WrapError(err)
 		}
 		addDebug(request.GetActionName(), raw, request.RpcRequest, request)
@@ -801,7 +802,7 @@ func resourceAlibabacloudStackHBaseInstanceUpdate(d *schema.ResourceData, meta i
 		// wait instance status is running after modifying
 		stateConf := BuildStateConf([]string{Hb_HBASE_COLD_EXPANDING}, []string{Hb_ACTIVATION}, d.Timeout(schema.TimeoutUpdate),
 			10*time.Second, hBaseService.HBaseClusterStateRefreshFunc(d.Id(), []string{Hb_DISK_RESIZE_FAILED}))
-		if _, err := stateConf.WaitForState(); err != nil {
+		if _, err := stateConf.WaitForStateContext(ctx); err != nil {
 			return WrapError(err)
 		}
 		addDebug(request.GetActionName(), raw, request.RpcRequest, request)
@@ -809,10 +810,10 @@ func resourceAlibabacloudStackHBaseInstanceUpdate(d *schema.ResourceData, meta i
 	}
 
 	d.Partial(false)
-	return resourceAlibabacloudStackHBaseInstanceRead(d, meta)
+	return resourceAlibabacloudStackHBaseInstanceRead(ctx, d, meta)
 }
 
-func resourceAlibabacloudStackHBaseInstanceDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceAlibabacloudStackHBaseInstanceDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*connectivity.AlibabacloudStackClient)
 	hbaseService := HBaseService{client}
 
@@ -849,6 +850,6 @@ func resourceAlibabacloudStackHBaseInstanceDelete(d *schema.ResourceData, meta i
 	}
 
 	stateConf := BuildStateConf([]string{Hb_DELETING}, []string{}, d.Timeout(schema.TimeoutDelete), 1*time.Minute, hbaseService.HBaseClusterStateRefreshFunc(d.Id(), []string{}))
-	_, err = stateConf.WaitForState()
+	_, err = stateConf.WaitForStateContext(ctx)
 	return WrapError(err)
 }
@@ -1,6 +1,7 @@
 package alibabacloudstack
 
 import (
+	"context"
 	"strconv"
 	"strings"
 	"time"
@@ -16,12 +17,12 @@ import (
 
 func resourceAlibabacloudStackImage() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceAlibabacloudStackImageCreate,
-		Read:   resourceAlibabacloudStackImageRead,
-		Update: resourceAlibabacloudStackImageUpdate,
-		Delete: resourceAlibabacloudStackImageDelete,
+		CreateContext: withDiagnostics(resourceAlibabacloudStackImageCreate),
+		ReadContext:   withDiagnostics(resourceAlibabacloudStackImageRead),
+		UpdateContext: withDiagnostics(resourceAlibabacloudStackImageUpdate),
+		DeleteContext: withDiagnostics(resourceAlibabacloudStackImageDelete),
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			StateContext: schema.ImportStatePassthroughContext,
 		},
 
 		Timeouts: &schema.ResourceTimeout{
@@ -86,7 +87,7 @@ func resourceAlibabacloudStackImage() *schema.Resource {
 		},
 	}
 }
-func resourceAlibabacloudStackImageCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceAlibabacloudStackImageCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*connectivity.AlibabacloudStackClient)
 	ecsService := EcsService{client}
 
@@ -145,7 +146,7 @@ func resourceAlibabacloudStackImageCreate(d *schema.ResourceData, meta interface
 		}
 	}
 
-	tags := d.Get("tags").(map[string]interface{})
+	tags := getTagsAll(d, client)
 	if tags != nil && len(tags) > 0 {
 		imageTags := make([]ecs.CreateImageTag, 0, len(tags))
 		for k, v := range tags {
@@ -164,7 +165,7 @@ func resourceAlibabacloudStackImageCreate(d *schema.ResourceData, meta interface
 	request.ImageName = d.Get("image_name").(string)
 	request.Description = d.Get("description").(string)
 
-	err := resource.Retry(30*time.Minute, func() *resource.RetryError {
+	err := resource.RetryContext(ctx, 30*time.Minute, func() *resource.RetryError {
 		raw, err := client.WithEcsClient(func(ecsClient *ecs.Client) (interface{}, error) {
 			return ecsClient.CreateImage(request)
 		})
@@ -186,21 +187,21 @@ func resourceAlibabacloudStackImageCreate(d *schema.ResourceData, meta interface
 	}
 
 	stateConf := BuildStateConf([]string{"Creating", ""}, []string{"Available"}, d.Timeout(schema.TimeoutCreate), 20*time.Minute, ecsService.ImageStateRefreshFunc(d.Id(), []string{"CreateFailed", "UnAvailable"}))
-	if _, err := stateConf.WaitForState(); err != nil {
+	if _, err := stateConf.WaitForStateContext(ctx); err != nil {
 		return WrapErrorf(err, IdMsg, d.Id())
 	}
-	return resourceAlibabacloudStackImageRead(d, meta)
+	return resourceAlibabacloudStackImageRead(ctx, d, meta)
 }
-func resourceAlibabacloudStackImageUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceAlibabacloudStackImageUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*connectivity.AlibabacloudStackClient)
 	ecsService := EcsService{client}
-	err := ecsService.updateImage(d)
+	err := ecsService.updateImage(ctx, d)
 	if err != nil {
 		return WrapError(err)
 	}
-	return resourceAlibabacloudStackImageRead(d, meta)
+	return resourceAlibabacloudStackImageRead(ctx, d, meta)
 }
-func resourceAlibabacloudStackImageRead(d *schema.ResourceData, meta interface{}) error {
+func resourceAlibabacloudStackImageRead(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 	wiatSecondsIfWithTest(1)
 	client := meta.(*connectivity.AlibabacloudStackClient)
 
@@ -224,10 +225,10 @@ func resourceAlibabacloudStackImageRead(d *schema.ResourceData, meta interface{}
 	return WrapError(err)
 }
 
-func resourceAlibabacloudStackImageDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceAlibabacloudStackImageDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*connectivity.AlibabacloudStackClient)
 	ecsService := EcsService{client}
-	return ecsService.deleteImage(d)
+	return ecsService.deleteImage(ctx, d)
 }
 
 func FlattenImageDiskDeviceMappings(list []ecs.DiskDeviceMapping) []map[string]interface{} {
@@ -1,6 +1,7 @@
 package alibabacloudstack
 
 import (
+	"context"
 	"log"
 	"strings"
 	"time"
@@ -12,12 +13,12 @@ import (
 
 func resourceAlibabacloudStackImageCopy() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceAlibabacloudStackImageCopyCreate,
-		Read:   resourceAlibabacloudStackImageCopyRead,
-		Update: resourceAlibabacloudStackImageCopyUpdate,
-		Delete: resourceAlibabacloudStackImageCopyDelete,
+		CreateContext: withDiagnostics(resourceAlibabacloudStackImageCopyCreate),
+		ReadContext:   withDiagnostics(resourceAlibabacloudStackImageCopyRead),
+		UpdateContext: withDiagnostics(resourceAlibabacloudStackImageCopyUpdate),
+		DeleteContext: withDiagnostics(resourceAlibabacloudStackImageCopyDelete),
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			StateContext: schema.ImportStatePassthroughContext,
 		},
 
 		Timeouts: &schema.ResourceTimeout{
@@ -53,7 +54,7 @@ func resourceAlibabacloudStackImageCopy() *schema.Resource {
 		},
 	}
 }
-func resourceAlibabacloudStackImageCopyCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceAlibabacloudStackImageCopyCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*connectivity.AlibabacloudStackClient)
 	ecsService := EcsService{client}
 
@@ -83,21 +84,21 @@ func resourceAlibabacloudStackImageCopyCreate(d *schema.ResourceData, meta inter
 	d.SetId(response.ImageId)
 	log.Printf("[DEBUG] state %#v", d.Id())
 	stateConf := BuildStateConf([]string{"Creating"}, []string{"Available"}, d.Timeout(schema.TimeoutCreate), 1*time.Minute, ecsService.ImageStateRefreshFuncforcopy(d.Id(), d.Get("destination_region_id").(string), []string{"CreateFailed", "UnAvailable"}))
-	if _, err := stateConf.WaitForState(); err != nil {
+	if _, err := stateConf.WaitForStateContext(ctx); err != nil {
 		return WrapErrorf(err, IdMsg, d.Id())
 	}
-	return resourceAlibabacloudStackImageCopyRead(d, meta)
+	return resourceAlibabacloudStackImageCopyRead(ctx, d, meta)
 }
-func resourceAlibabacloudStackImageCopyUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceAlibabacloudStackImageCopyUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*connectivity.AlibabacloudStackClient)
 	ecsService := EcsService{client}
-	err := ecsService.updateImage(d)
+	err := ecsService.updateImage(ctx, d)
 	if err != nil {
 		return WrapError(err)
 	}
-	return resourceAlibabacloudStackImageRead(d, meta)
+	return resourceAlibabacloudStackImageRead(ctx, d, meta)
 }
-func resourceAlibabacloudStackImageCopyRead(d *schema.ResourceData, meta interface{}) error {
+func resourceAlibabacloudStackImageCopyRead(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 	wiatSecondsIfWithTest(1)
 	client := meta.(*connectivity.AlibabacloudStackClient)
 
@@ -118,16 +119,16 @@ func resourceAlibabacloudStackImageCopyRead(d *schema.ResourceData, meta interfa
 	return WrapError(err)
 }
 
-func resourceAlibabacloudStackImageCopyDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceAlibabacloudStackImageCopyDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*connectivity.AlibabacloudStackClient)
 	ecsService := EcsService{client}
-	err := ecsService.deleteImageforDest(d, d.Get("destination_region_id").(string))
+	err := ecsService.deleteImageforDest(ctx, d, d.Get("destination_region_id").(string))
 	if err != nil {
 		return WrapErrorf(err, DefaultErrorMsg, d.Id(), AlibabacloudStackSdkGoERROR)
 	}
 	stateConf := BuildStateConf([]string{"Available", "CreateFailed"}, []string{"Deprecated", "UnAvailable"}, d.Timeout(schema.TimeoutCreate), 1*time.Minute, ecsService.ImageStateRefreshFuncforcopy(d.Id(), d.Get("destination_region_id").(string), []string{"CreateFailed", "UnAvailable"}))
-	if _, err := stateConf.WaitForState(); err != nil {
+	if _, err := stateConf.WaitForStateContext(ctx); err != nil {
 		return WrapErrorf(err, IdMsg, d.Id())
 	}
-	return resourceAlibabacloudStackImageCopyRead(d, meta)
+	return resourceAlibabacloudStackImageCopyRead(ctx, d, meta)
 }
@@ -1,6 +1,7 @@
 package alibabacloudstack
 
 import (
+	"context"
 	"strings"
 	"time"
 
@@ -14,9 +15,22 @@ import (
 
 func resourceAlibabacloudStackImageExport() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceAlibabacloudStackImageExportCreate,
-		Read:   resourceAlibabacloudStackImageExportRead,
-		Delete: resourceAlibabacloudStackImageExportDelete,
+		CreateContext: withDiagnostics(resourceAlibabacloudStackImageExportCreate),
+		ReadContext:   withDiagnostics(resourceAlibabacloudStackImageExportRead),
+		DeleteContext: withDiagnostics(resourceAlibabacloudStackImageExportDelete),
+		Importer: &schema.ResourceImporter{
+			StateContext: func(ctx context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+				// The export target can not be described from the image, so it is given by the import ID.
+				parts, err := ParseResourceId(d.Id(), 3)
+				if err != nil {
+					return nil, WrapError(err)
+				}
+				d.SetId(parts[0])
+				d.Set("oss_bucket", parts[1])
+				d.Set("oss_prefix", parts[2])
+				return []*schema.ResourceData{d}, nil
+			},
+		},
 		Timeouts: &schema.ResourceTimeout{
 			Create: schema.DefaultTimeout(20 * time.Minute),
 		},
@@ -40,7 +54,7 @@ func resourceAlibabacloudStackImageExport() *schema.Resource {
 	}
 }
 
-func resourceAlibabacloudStackImageExportCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceAlibabacloudStackImageExportCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*connectivity.AlibabacloudStackClient)
 	ecsService := EcsService{client: client}
 
@@ -68,14 +82,14 @@ func resourceAlibabacloudStackImageExportCreate(d *schema.ResourceData, meta int
 	taskId := response.TaskId
 	d.SetId(request.ImageId)
 	stateConf := BuildStateConf([]string{"ExportImage", "Waiting", "Processing"}, []string{"Finished"}, d.Timeout(schema.TimeoutCreate), 2*time.Minute, ecsService.TaskStateRefreshFunc(taskId, []string{"CreateFailed", "UnAvailable"}))
-	if _, err := stateConf.WaitForState(); err != nil {
+	if _, err := stateConf.WaitForStateContext(ctx); err != nil {
 		return WrapErrorf(err, IdMsg, d.Id())
 	}
-	return resourceAlibabacloudStackImageExportRead(d, meta)
+	return resourceAlibabacloudStackImageExportRead(ctx, d, meta)
 
 }
 
-func resourceAlibabacloudStackImageExportRead(d *schema.ResourceData, meta interface{}) error {
+func resourceAlibabacloudStackImageExportRead(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 	wiatSecondsIfWithTest(1)
 	client := meta.(*connectivity.AlibabacloudStackClient)
 	ecsService := EcsService{client: client}
@@ -89,10 +103,10 @@ func resourceAlibabacloudStackImageExportRead(d *schema.ResourceData, meta inter
 		return WrapError(err)
 	}
 	d.Set("image_id", object.ImageId)
-	return WrapError(err)
+	return nil
 }
 
-func resourceAlibabacloudStackImageExportDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceAlibabacloudStackImageExportDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*connectivity.AlibabacloudStackClient)
 	ossService := OssService{client}
 	var requestInfo *oss.Client
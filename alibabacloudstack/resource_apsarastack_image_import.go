@@ -1,6 +1,7 @@
 package alibabacloudstack
 
 import (
+	"context"
 	"strconv"
 	"strings"
 	"time"
@@ -13,12 +14,12 @@ import (
 
 func resourceAlibabacloudStackImageImport() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceAlibabacloudStackImageImportCreate,
-		Read:   resourceAlibabacloudStackImageImportRead,
-		Update: resourceAlibabacloudStackImageImportUpdate,
-		Delete: resourceAlibabacloudStackImageImportDelete,
+		CreateContext: withDiagnostics(resourceAlibabacloudStackImageImportCreate),
+		ReadContext:   withDiagnostics(resourceAlibabacloudStackImageImportRead),
+		UpdateContext: withDiagnostics(resourceAlibabacloudStackImageImportUpdate),
+		DeleteContext: withDiagnostics(resourceAlibabacloudStackImageImportDelete),
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			StateContext: schema.ImportStatePassthroughContext,
 		},
 		Timeouts: &schema.ResourceTimeout{
 			Create: schema.DefaultTimeout(20 * time.Minute),
@@ -124,7 +125,7 @@ func resourceAlibabacloudStackImageImport() *schema.Resource {
 	}
 }
 
-func resourceAlibabacloudStackImageImportCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceAlibabacloudStackImageImportCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*connectivity.AlibabacloudStackClient)
 	ecsService := EcsService{client: client}
 
@@ -172,13 +173,13 @@ func resourceAlibabacloudStackImageImportCreate(d *schema.ResourceData, meta int
 	resp, _ := raw.(*ecs.ImportImageResponse)
 	d.SetId(resp.ImageId)
 	stateConf := BuildStateConf([]string{"Waiting"}, []string{"Available"}, d.Timeout(schema.TimeoutCreate), 1*time.Minute, ecsService.ImageStateRefreshFunc(d.Id(), []string{"CreateFailed", "UnAvailable"}))
-	if _, err := stateConf.WaitForState(); err != nil {
+	if _, err := stateConf.WaitForStateContext(ctx); err != nil {
 		return WrapErrorf(err, IdMsg, d.Id())
 	}
-	return resourceAlibabacloudStackImageImportRead(d, meta)
+	return resourceAlibabacloudStackImageImportRead(ctx, d, meta)
 }
 
-func resourceAlibabacloudStackImageImportRead(d *schema.ResourceData, meta interface{}) error {
+func resourceAlibabacloudStackImageImportRead(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 	wiatSecondsIfWithTest(1)
 	client := meta.(*connectivity.AlibabacloudStackClient)
 	ecsService := EcsService{client: client}
@@ -201,20 +202,20 @@ func resourceAlibabacloudStackImageImportRead(d *schema.ResourceData, meta inter
 	return nil
 }
 
-func resourceAlibabacloudStackImageImportUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceAlibabacloudStackImageImportUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*connectivity.AlibabacloudStackClient)
 	ecsService := EcsService{client}
-	err := ecsService.updateImage(d)
+	err := ecsService.updateImage(ctx, d)
 	if err != nil {
 		return WrapError(err)
 	}
-	return resourceAlibabacloudStackImageRead(d, meta)
+	return resourceAlibabacloudStackImageRead(ctx, d, meta)
 }
 
-func resourceAlibabacloudStackImageImportDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceAlibabacloudStackImageImportDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*connectivity.AlibabacloudStackClient)
 	ecsService := EcsService{client}
-	return ecsService.deleteImage(d)
+	return ecsService.deleteImage(ctx, d)
 }
 
 func FlattenImageImportDiskDeviceMappings(list []ecs.DiskDeviceMapping) []map[string]interface{} {
@@ -1,6 +1,7 @@
 package alibabacloudstack
 
 import (
+	"context"
 	"github.com/aliyun/alibaba-cloud-sdk-go/services/ecs"
 	"github.com/aliyun/terraform-provider-alibabacloudstack/alibabacloudstack/connectivity"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
@@ -9,11 +10,11 @@ import (
 
 func resourceAlibabacloudStackImageSharePermission() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceAlibabacloudStackImageSharePermissionCreate,
-		Read:   resourceAlibabacloudStackImageSharePermissionRead,
-		Delete: resourceAlibabacloudStackImageSharePermissionDelete,
+		CreateContext: withDiagnostics(resourceAlibabacloudStackImageSharePermissionCreate),
+		ReadContext:   withDiagnostics(resourceAlibabacloudStackImageSharePermissionRead),
+		DeleteContext: withDiagnostics(resourceAlibabacloudStackImageSharePermissionDelete),
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			StateContext: schema.ImportStatePassthroughContext,
 		},
 		Schema: map[string]*schema.Schema{
 			"image_id": {
@@ -30,7 +31,7 @@ func resourceAlibabacloudStackImageSharePermission() *schema.Resource {
 	}
 }
 
-func resourceAlibabacloudStackImageSharePermissionCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceAlibabacloudStackImageSharePermissionCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*connectivity.AlibabacloudStackClient)
 
 	imageId := d.Get("image_id").(string)
@@ -55,10 +56,10 @@ func resourceAlibabacloudStackImageSharePermissionCreate(d *schema.ResourceData,
 	}
 	addDebug(request.GetActionName(), raw, request.RpcRequest, request)
 	d.SetId(imageId + ":" + accountId)
-	return resourceAlibabacloudStackImageSharePermissionRead(d, meta)
+	return resourceAlibabacloudStackImageSharePermissionRead(ctx, d, meta)
 }
 
-func resourceAlibabacloudStackImageSharePermissionRead(d *schema.ResourceData, meta interface{}) error {
+func resourceAlibabacloudStackImageSharePermissionRead(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 	wiatSecondsIfWithTest(1)
 	client := meta.(*connectivity.AlibabacloudStackClient)
 	ecsService := EcsService{client: client}
@@ -76,7 +77,7 @@ func resourceAlibabacloudStackImageSharePermissionRead(d *schema.ResourceData, m
 	return WrapError(err)
 }
 
-func resourceAlibabacloudStackImageSharePermissionDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceAlibabacloudStackImageSharePermissionDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*connectivity.AlibabacloudStackClient)
 	request := ecs.CreateModifyImageSharePermissionRequest()
 	if strings.ToLower(client.Config.Protocol) == "https" {
@@ -1,6 +1,7 @@
 package alibabacloudstack
 
 import (
+	"context"
 	"errors"
 	"fmt"
 	"log"
@@ -22,13 +23,14 @@ import (
 
 func resourceAlibabacloudStackInstance() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceAlibabacloudStackInstanceCreate,
-		Read:   resourceAlibabacloudStackInstanceRead,
-		Update: resourceAlibabacloudStackInstanceUpdate,
-		Delete: resourceAlibabacloudStackInstanceDelete,
+		CreateContext: withDiagnostics(resourceAlibabacloudStackInstanceCreate),
+		ReadContext:   withDiagnostics(resourceAlibabacloudStackInstanceRead),
+		UpdateContext: withDiagnostics(resourceAlibabacloudStackInstanceUpdate),
+		DeleteContext: withDiagnostics(resourceAlibabacloudStackInstanceDelete),
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			StateContext: schema.ImportStatePassthroughContext,
 		},
+		CustomizeDiff: quotaCustomizeDiff("ECS", instanceQuotaAmounts),
 
 		Timeouts: &schema.ResourceTimeout{
 			Create: schema.DefaultTimeout(20 * time.Minute),
@@ -91,14 +93,16 @@ func resourceAlibabacloudStackInstance() *schema.Resource {
 				Computed: true,
 			},
 			"password": {
-				Type:      schema.TypeString,
-				Optional:  true,
-				Sensitive: true,
+				Type:          schema.TypeString,
+				Optional:      true,
+				Sensitive:     true,
+				ConflictsWith: []string{"kms_encrypted_password"},
 			},
 			"kms_encrypted_password": {
 				Type:             schema.TypeString,
 				Optional:         true,
 				DiffSuppressFunc: kmsDiffSuppressFunc,
+				ConflictsWith:    []string{"password"},
 			},
 			"kms_encryption_context": {
 				Type:     schema.TypeMap,
@@ -256,7 +260,7 @@ func resourceAlibabacloudStackInstance() *schema.Resource {
 	}
 }
 
-func resourceAlibabacloudStackInstanceCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceAlibabacloudStackInstanceCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*connectivity.AlibabacloudStackClient)
 	ecsService := EcsService{client}
 
@@ -275,7 +279,7 @@ func resourceAlibabacloudStackInstanceCreate(d *schema.ResourceData, meta interf
 		request.Scheme = "http"
 	}
 	wait := incrementalWait(1*time.Second, 1*time.Second)
-	err = resource.Retry(d.Timeout(schema.TimeoutCreate), func() *resource.RetryError {
+	err = resource.RetryContext(ctx, d.Timeout(schema.TimeoutCreate), func() *resource.RetryError {
 		raw, err := client.WithEcsClient(func(ecsClient *ecs.Client) (interface{}, error) {
 			return ecsClient.RunInstances(request)
 		})
@@ -300,7 +304,7 @@ func resourceAlibabacloudStackInstanceCreate(d *schema.ResourceData, meta interf
 
 	stateConf := BuildStateConf([]string{"Pending", "Starting", "Stopped"}, []string{"Running"}, d.Timeout(schema.TimeoutCreate), 120*time.Second, ecsService.InstanceStateRefreshFunc(d.Id(), []string{"Stopping"}))
 
-	if _, err := stateConf.WaitForState(); err != nil {
+	if _, err := stateConf.WaitForStateContext(ctx); err != nil {
 		return WrapErrorf(err, IdMsg, d.Id())
 	}
 	if v, ok := d.GetOk("security_groups"); ok {
@@ -312,10 +316,10 @@ func resourceAlibabacloudStackInstanceCreate(d *schema.ResourceData, meta interf
 			}
 		}
 	}
-	return resourceAlibabacloudStackInstanceUpdate(d, meta)
+	return resourceAlibabacloudStackInstanceUpdate(ctx, d, meta)
 }
 
-func resourceAlibabacloudStackInstanceRead(d *schema.ResourceData, meta interface{}) error {
+func resourceAlibabacloudStackInstanceRead(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 	wiatSecondsIfWithTest(1)
 	client := meta.(*connectivity.AlibabacloudStackClient)
 	ecsService := EcsService{client}
@@ -329,7 +333,7 @@ func resourceAlibabacloudStackInstanceRead(d *schema.ResourceData, meta interfac
 		return WrapError(err)
 	}
 	log.Printf("[ECS Creation]: Getting Instance Details Successfully: %s", instance.Status)
-	disk, err := ecsService.DescribeInstanceSystemDisk(d.Id(), instance.ResourceGroupId)
+	disk, err := ecsService.DescribeInstanceSystemDisk(ctx, d.Id(), instance.ResourceGroupId)
 	if err != nil {
 		if NotFoundError(err) {
 			d.SetId("")
@@ -456,14 +460,14 @@ func resourceAlibabacloudStackInstanceRead(d *schema.ResourceData, meta interfac
 	return nil
 }
 
-func resourceAlibabacloudStackInstanceUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceAlibabacloudStackInstanceUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*connectivity.AlibabacloudStackClient)
 	ecsService := EcsService{client}
 
 	d.Partial(true)
 
 	if !d.IsNewResource() {
-		if err := setTags(client, TagResourceInstance, d); err != nil {
+		if err := setTags(ctx, client, TagResourceInstance, d); err != nil {
 			return WrapError(err)
 		} else {
 			//d.SetPartial("tags")
@@ -497,22 +501,22 @@ func resourceAlibabacloudStackInstanceUpdate(d *schema.ResourceData, meta interf
 	}
 
 	run := false
-	imageUpdate, err := modifyInstanceImage(d, meta, run)
+	imageUpdate, err := modifyInstanceImage(ctx, d, meta, run)
 	if err != nil {
 		return WrapError(err)
 	}
 
-	vpcUpdate, err := modifyVpcAttribute(d, meta, run)
+	vpcUpdate, err := modifyVpcAttribute(ctx, d, meta, run)
 	if err != nil {
 		return WrapError(err)
 	}
 
-	passwordUpdate, err := modifyInstanceAttribute(d, meta)
+	passwordUpdate, err := modifyInstanceAttribute(ctx, d, meta)
 	if err != nil {
 		return WrapError(err)
 	}
 
-	typeUpdate, err := modifyInstanceType(d, meta, run)
+	typeUpdate, err := modifyInstanceType(ctx, d, meta, run)
 	if err != nil {
 		return WrapError(err)
 	}
@@ -546,19 +550,19 @@ func resourceAlibabacloudStackInstanceUpdate(d *schema.ResourceData, meta interf
 
 		stateConf := BuildStateConf([]string{"Pending", "Running", "Stopping"}, []string{"Stopped"}, d.Timeout(schema.TimeoutUpdate), 5*time.Second, ecsService.InstanceStateRefreshFunc(d.Id(), []string{}))
 
-		if _, err = stateConf.WaitForState(); err != nil {
+		if _, err = stateConf.WaitForStateContext(ctx); err != nil {
 			return WrapErrorf(err, IdMsg, d.Id())
 		}
 
-		if _, err := modifyInstanceImage(d, meta, run); err != nil {
+		if _, err := modifyInstanceImage(ctx, d, meta, run); err != nil {
 			return WrapError(err)
 		}
 
-		if _, err := modifyVpcAttribute(d, meta, run); err != nil {
+		if _, err := modifyVpcAttribute(ctx, d, meta, run); err != nil {
 			return WrapError(err)
 		}
 
-		if _, err := modifyInstanceType(d, meta, run); err != nil {
+		if _, err := modifyInstanceType(ctx, d, meta, run); err != nil {
 			return WrapError(err)
 		}
 
@@ -572,7 +576,7 @@ func resourceAlibabacloudStackInstanceUpdate(d *schema.ResourceData, meta interf
 		startRequest.QueryParams = map[string]string{"AccessKeySecret": client.SecretKey, "Product": "ecs", "Department": client.Department, "ResourceGroup": client.ResourceGroup}
 		startRequest.InstanceId = d.Id()
 
-		err := resource.Retry(5*time.Minute, func() *resource.RetryError {
+		err := resource.RetryContext(ctx, 5*time.Minute, func() *resource.RetryError {
 			raw, err := client.WithEcsClient(func(ecsClient *ecs.Client) (interface{}, error) {
 				return ecsClient.StartInstance(startRequest)
 			})
@@ -601,20 +605,20 @@ func resourceAlibabacloudStackInstanceUpdate(d *schema.ResourceData, meta interf
 			MinTimeout: 3 * time.Second,
 		}
 
-		if _, err = stateConf.WaitForState(); err != nil {
+		if _, err = stateConf.WaitForStateContext(ctx); err != nil {
 			return WrapErrorf(err, IdMsg, d.Id())
 		}
 	}
 
-	if err := modifyInstanceNetworkSpec(d, meta); err != nil {
+	if err := modifyInstanceNetworkSpec(ctx, d, meta); err != nil {
 		return WrapError(err)
 	}
 
 	d.Partial(false)
-	return resourceAlibabacloudStackInstanceRead(d, meta)
+	return resourceAlibabacloudStackInstanceRead(ctx, d, meta)
 }
 
-func resourceAlibabacloudStackInstanceDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceAlibabacloudStackInstanceDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*connectivity.AlibabacloudStackClient)
 	ecsService := EcsService{client}
 
@@ -641,7 +645,7 @@ func resourceAlibabacloudStackInstanceDelete(d *schema.ResourceData, meta interf
 	deleteRequest.Force = requests.NewBoolean(true)
 
 	wait := incrementalWait(1*time.Second, 1*time.Second)
-	err := resource.Retry(d.Timeout(schema.TimeoutDelete), func() *resource.RetryError {
+	err := resource.RetryContext(ctx, d.Timeout(schema.TimeoutDelete), func() *resource.RetryError {
 		raw, err := client.WithEcsClient(func(ecsClient *ecs.Client) (interface{}, error) {
 			return ecsClient.DeleteInstance(deleteRequest)
 		})
@@ -667,7 +671,7 @@ func resourceAlibabacloudStackInstanceDelete(d *schema.ResourceData, meta interf
 
 	stateConf := BuildStateConf([]string{"Pending", "Running", "Stopped", "Stopping"}, []string{}, d.Timeout(schema.TimeoutDelete), 10*time.Second, ecsService.InstanceStateRefreshFunc(d.Id(), []string{}))
 
-	if _, err = stateConf.WaitForState(); err != nil {
+	if _, err = stateConf.WaitForStateContext(ctx); err != nil {
 		return WrapErrorf(err, IdMsg, d.Id())
 	}
 	return nil
@@ -771,7 +775,7 @@ func buildAlibabacloudStackInstanceArgs(d *schema.ResourceData, meta interface{}
 		request.SecurityEnhancementStrategy = v.(string)
 	}
 
-	v, ok := d.GetOk("tags")
+	v, ok := getTagsAllOk(d, client)
 	if ok && len(v.(map[string]interface{})) > 0 {
 		tags := make([]ecs.RunInstancesTag, 0)
 		for key, value := range v.(map[string]interface{}) {
@@ -831,7 +835,7 @@ func buildAlibabacloudStackInstanceArgs(d *schema.ResourceData, meta interface{}
 	return request, nil
 }
 
-func modifyInstanceImage(d *schema.ResourceData, meta interface{}, run bool) (bool, error) {
+func modifyInstanceImage(ctx context.Context, d *schema.ResourceData, meta interface{}, run bool) (bool, error) {
 	if d.IsNewResource() {
 		d.Partial(false)
 		return false, nil
@@ -876,8 +880,8 @@ func modifyInstanceImage(d *schema.ResourceData, meta interface{}, run bool) (bo
 				return update, WrapError(errDesc)
 			}
 			var disk ecs.Disk
-			err := resource.Retry(2*time.Minute, func() *resource.RetryError {
-				disk, err = ecsService.DescribeInstanceSystemDisk(d.Id(), instance.ResourceGroupId)
+			err := resource.RetryContext(ctx, 2*time.Minute, func() *resource.RetryError {
+				disk, err = ecsService.DescribeInstanceSystemDisk(ctx, d.Id(), instance.ResourceGroupId)
 				if err != nil {
 					if NotFoundError(err) {
 						return resource.RetryableError(err)
@@ -906,7 +910,7 @@ func modifyInstanceImage(d *schema.ResourceData, meta interface{}, run bool) (bo
 
 		// After updating image, it need to re-attach key pair
 		if keyPairName != "" {
-			if err := ecsService.AttachKeyPair(keyPairName, []interface{}{d.Id()}); err != nil {
+			if err := ecsService.AttachKeyPair(ctx, keyPairName, []interface{}{d.Id()}); err != nil {
 				return update, WrapError(err)
 			}
 		}
@@ -914,7 +918,7 @@ func modifyInstanceImage(d *schema.ResourceData, meta interface{}, run bool) (bo
 	return update, nil
 }
 
-func modifyInstanceAttribute(d *schema.ResourceData, meta interface{}) (bool, error) {
+func modifyInstanceAttribute(ctx context.Context, d *schema.ResourceData, meta interface{}) (bool, error) {
 	client := meta.(*connectivity.AlibabacloudStackClient)
 	if d.IsNewResource() {
 		d.Partial(false)
@@ -993,7 +997,7 @@ func modifyInstanceAttribute(d *schema.ResourceData, meta interface{}) (bool, er
 	}
 
 	if update {
-		err := resource.Retry(5*time.Minute, func() *resource.RetryError {
+		err := resource.RetryContext(ctx, 5*time.Minute, func() *resource.RetryError {
 			raw, err := client.WithEcsClient(func(ecsClient *ecs.Client) (interface{}, error) {
 				return ecsClient.ModifyInstanceAttribute(request)
 			})
@@ -1014,7 +1018,7 @@ func modifyInstanceAttribute(d *schema.ResourceData, meta interface{}) (bool, er
 	return reboot, nil
 }
 
-func modifyVpcAttribute(d *schema.ResourceData, meta interface{}, run bool) (bool, error) {
+func modifyVpcAttribute(ctx context.Context, d *schema.ResourceData, meta interface{}, run bool) (bool, error) {
 	client := meta.(*connectivity.AlibabacloudStackClient)
 	if d.IsNewResource() {
 		d.Partial(false)
@@ -1036,7 +1040,7 @@ func modifyVpcAttribute(d *schema.ResourceData, meta interface{}, run bool) (boo
 	if d.HasChange("vswitch_id") {
 		update = true
 		if d.Get("vswitch_id").(string) == "" {
-			return update, WrapError(Error("Field 'vswitch_id' is required when modifying the instance VPC attribute."))
+			return update, WrapAttributeError("vswitch_id", WrapError(Error("Field 'vswitch_id' is required when modifying the instance VPC attribute.")))
 		}
 		//d.SetPartial("vswitch_id")
 	}
@@ -1044,7 +1048,7 @@ func modifyVpcAttribute(d *schema.ResourceData, meta interface{}, run bool) (boo
 	if d.HasChange("subnet_id") {
 		update = true
 		if d.Get("subnet_id").(string) == "" {
-			return update, WrapError(Error("Field 'subnet_id' is required when modifying the instance VPC attribute."))
+			return update, WrapAttributeError("subnet_id", WrapError(Error("Field 'subnet_id' is required when modifying the instance VPC attribute.")))
 		}
 		request.VSwitchId = d.Get("subnet_id").(string)
 		//d.SetPartial("subnet_id")
@@ -1062,7 +1066,7 @@ func modifyVpcAttribute(d *schema.ResourceData, meta interface{}, run bool) (boo
 
 	if update {
 		client := meta.(*connectivity.AlibabacloudStackClient)
-		err := resource.Retry(1*time.Minute, func() *resource.RetryError {
+		err := resource.RetryContext(ctx, 1*time.Minute, func() *resource.RetryError {
 			raw, err := client.WithEcsClient(func(ecsClient *ecs.Client) (interface{}, error) {
 				return ecsClient.ModifyInstanceVpcAttribute(request)
 			})
@@ -1088,7 +1092,7 @@ func modifyVpcAttribute(d *schema.ResourceData, meta interface{}, run bool) (boo
 	return update, nil
 }
 
-func modifyInstanceType(d *schema.ResourceData, meta interface{}, run bool) (bool, error) {
+func modifyInstanceType(ctx context.Context, d *schema.ResourceData, meta interface{}, run bool) (bool, error) {
 	if d.IsNewResource() {
 		d.Partial(false)
 		return false, nil
@@ -1115,7 +1119,7 @@ func modifyInstanceType(d *schema.ResourceData, meta interface{}, run bool) (boo
 		request.InstanceType = d.Get("instance_type").(string)
 		request.ClientToken = buildClientToken(request.GetActionName())
 
-		err := resource.Retry(6*time.Minute, func() *resource.RetryError {
+		err := resource.RetryContext(ctx, 6*time.Minute, func() *resource.RetryError {
 			args := *request
 			raw, err := client.WithEcsClient(func(ecsClient *ecs.Client) (interface{}, error) {
 				return ecsClient.ModifyInstanceSpec(&args)
@@ -1159,7 +1163,7 @@ func modifyInstanceType(d *schema.ResourceData, meta interface{}, run bool) (boo
 	return update, nil
 }
 
-func modifyInstanceNetworkSpec(d *schema.ResourceData, meta interface{}) error {
+func modifyInstanceNetworkSpec(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*connectivity.AlibabacloudStackClient)
 	if d.IsNewResource() {
 		d.Partial(false)
@@ -1199,7 +1203,7 @@ func modifyInstanceNetworkSpec(d *schema.ResourceData, meta interface{}) error {
 	wait := incrementalWait(2*time.Second, 2*time.Second)
 
 	if update {
-		if err := resource.Retry(d.Timeout(schema.TimeoutUpdate), func() *resource.RetryError {
+		if err := resource.RetryContext(ctx, d.Timeout(schema.TimeoutUpdate), func() *resource.RetryError {
 			raw, err := client.WithEcsClient(func(ecsClient *ecs.Client) (interface{}, error) {
 				return ecsClient.ModifyInstanceNetworkSpec(request)
 			})
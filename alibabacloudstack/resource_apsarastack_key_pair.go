@@ -1,6 +1,7 @@
 package alibabacloudstack
 
 import (
+	"context"
 	"fmt"
 	"io/ioutil"
 	"strings"
@@ -19,12 +20,12 @@ import (
 
 func resourceAlibabacloudStackKeyPair() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceAlibabacloudStackKeyPairCreate,
-		Read:   resourceAlibabacloudStackKeyPairRead,
-		Update: resourceAlibabacloudStackKeyPairUpdate,
-		Delete: resourceAlibabacloudStackKeyPairDelete,
+		CreateContext: withDiagnostics(resourceAlibabacloudStackKeyPairCreate),
+		ReadContext:   withDiagnostics(resourceAlibabacloudStackKeyPairRead),
+		UpdateContext: withDiagnostics(resourceAlibabacloudStackKeyPairUpdate),
+		DeleteContext: withDiagnostics(resourceAlibabacloudStackKeyPairDelete),
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			StateContext: schema.ImportStatePassthroughContext,
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -70,7 +71,7 @@ func resourceAlibabacloudStackKeyPair() *schema.Resource {
 	}
 }
 
-func resourceAlibabacloudStackKeyPairCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceAlibabacloudStackKeyPairCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*connectivity.AlibabacloudStackClient)
 
 	var keyName string
@@ -147,18 +148,18 @@ func resourceAlibabacloudStackKeyPairCreate(d *schema.ResourceData, meta interfa
 		}
 	}
 
-	return resourceAlibabacloudStackKeyPairUpdate(d, meta)
+	return resourceAlibabacloudStackKeyPairUpdate(ctx, d, meta)
 }
-func resourceAlibabacloudStackKeyPairUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceAlibabacloudStackKeyPairUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*connectivity.AlibabacloudStackClient)
-	err := setTags(client, TagResourceKeypair, d)
+	err := setTags(ctx, client, TagResourceKeypair, d)
 	if err != nil {
 		return WrapError(err)
 	}
-	return resourceAlibabacloudStackKeyPairRead(d, meta)
+	return resourceAlibabacloudStackKeyPairRead(ctx, d, meta)
 }
 
-func resourceAlibabacloudStackKeyPairRead(d *schema.ResourceData, meta interface{}) error {
+func resourceAlibabacloudStackKeyPairRead(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 	wiatSecondsIfWithTest(1)
 	client := meta.(*connectivity.AlibabacloudStackClient)
 	ecsService := EcsService{client}
@@ -180,7 +181,7 @@ func resourceAlibabacloudStackKeyPairRead(d *schema.ResourceData, meta interface
 	return nil
 }
 
-func resourceAlibabacloudStackKeyPairDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceAlibabacloudStackKeyPairDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*connectivity.AlibabacloudStackClient)
 	ecsService := EcsService{client}
 
@@ -201,7 +202,7 @@ func resourceAlibabacloudStackKeyPairDelete(d *schema.ResourceData, meta interfa
 		"ResourceGroup":   client.ResourceGroup}
 	request.KeyPairNames = convertListToJsonString(append(make([]interface{}, 0, 1), d.Id()))
 
-	err := resource.Retry(5*time.Minute, func() *resource.RetryError {
+	err := resource.RetryContext(ctx, 5*time.Minute, func() *resource.RetryError {
 		raw, err := client.WithEcsClient(func(ecsClient *ecs.Client) (interface{}, error) {
 			return ecsClient.DeleteKeyPairs(request)
 		})
@@ -1,6 +1,7 @@
 package alibabacloudstack
 
 import (
+	"context"
 	"fmt"
 	"strings"
 	"time"
@@ -16,11 +17,11 @@ import (
 
 func resourceAlibabacloudStackKeyPairAttachment() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceAlibabacloudStackKeyPairAttachmentCreate,
-		Read:   resourceAlibabacloudStackKeyPairAttachmentRead,
-		Delete: resourceAlibabacloudStackKeyPairAttachmentDelete,
+		CreateContext: withDiagnostics(resourceAlibabacloudStackKeyPairAttachmentCreate),
+		ReadContext:   withDiagnostics(resourceAlibabacloudStackKeyPairAttachmentRead),
+		DeleteContext: withDiagnostics(resourceAlibabacloudStackKeyPairAttachmentDelete),
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			StateContext: schema.ImportStatePassthroughContext,
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -45,7 +46,7 @@ func resourceAlibabacloudStackKeyPairAttachment() *schema.Resource {
 	}
 }
 
-func resourceAlibabacloudStackKeyPairAttachmentCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceAlibabacloudStackKeyPairAttachmentCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*connectivity.AlibabacloudStackClient)
 	ecsService := EcsService{client}
 	keyName := d.Get("key_name").(string)
@@ -69,7 +70,7 @@ func resourceAlibabacloudStackKeyPairAttachmentCreate(d *schema.ResourceData, me
 		}
 	}
 
-	if err := ecsService.AttachKeyPair(keyName, instanceIds); err != nil {
+	if err := ecsService.AttachKeyPair(ctx, keyName, instanceIds); err != nil {
 		return WrapError(err)
 	}
 
@@ -103,10 +104,10 @@ func resourceAlibabacloudStackKeyPairAttachmentCreate(d *schema.ResourceData, me
 
 	d.SetId(keyName + ":" + convertListToJsonString(instanceIds))
 
-	return resourceAlibabacloudStackKeyPairAttachmentRead(d, meta)
+	return resourceAlibabacloudStackKeyPairAttachmentRead(ctx, d, meta)
 }
 
-func resourceAlibabacloudStackKeyPairAttachmentRead(d *schema.ResourceData, meta interface{}) error {
+func resourceAlibabacloudStackKeyPairAttachmentRead(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 	wiatSecondsIfWithTest(1)
 	client := meta.(*connectivity.AlibabacloudStackClient)
 	ecsService := EcsService{client}
@@ -134,7 +135,7 @@ func resourceAlibabacloudStackKeyPairAttachmentRead(d *schema.ResourceData, meta
 	return nil
 }
 
-func resourceAlibabacloudStackKeyPairAttachmentDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceAlibabacloudStackKeyPairAttachmentDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*connectivity.AlibabacloudStackClient)
 	ecsService := EcsService{client}
 	keyName := strings.Split(d.Id(), ":")[0]
@@ -151,7 +152,7 @@ func resourceAlibabacloudStackKeyPairAttachmentDelete(d *schema.ResourceData, me
 	request.QueryParams = map[string]string{"AccessKeySecret": client.SecretKey, "Product": "ecs", "Department": client.Department, "ResourceGroup": client.ResourceGroup}
 	request.KeyPairName = keyName
 
-	return resource.Retry(5*time.Minute, func() *resource.RetryError {
+	return resource.RetryContext(ctx, 5*time.Minute, func() *resource.RetryError {
 		request.InstanceIds = instanceIds
 		raw, err := client.WithEcsClient(func(ecsClient *ecs.Client) (interface{}, error) {
 			return ecsClient.DetachKeyPair(request)
@@ -1,6 +1,7 @@
 package alibabacloudstack
 
 import (
+	"context"
 	"github.com/aliyun/alibaba-cloud-sdk-go/services/kms"
 	"github.com/aliyun/terraform-provider-alibabacloudstack/alibabacloudstack/connectivity"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
@@ -8,12 +9,12 @@ import (
 
 func resourceAlibabacloudStackKmsAlias() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceAlibabacloudStackKmsAliasCreate,
-		Read:   resourceAlibabacloudStackKmsAliasRead,
-		Update: resourceAlibabacloudStackKmsAliasUpdate,
-		Delete: resourceAlibabacloudStackKmsAliasDelete,
+		CreateContext: withDiagnostics(resourceAlibabacloudStackKmsAliasCreate),
+		ReadContext:   withDiagnostics(resourceAlibabacloudStackKmsAliasRead),
+		UpdateContext: withDiagnostics(resourceAlibabacloudStackKmsAliasUpdate),
+		DeleteContext: withDiagnostics(resourceAlibabacloudStackKmsAliasDelete),
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			StateContext: schema.ImportStatePassthroughContext,
 		},
 		Schema: map[string]*schema.Schema{
 			"alias_name": {
@@ -29,7 +30,7 @@ func resourceAlibabacloudStackKmsAlias() *schema.Resource {
 	}
 }
 
-func resourceAlibabacloudStackKmsAliasCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceAlibabacloudStackKmsAliasCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*connectivity.AlibabacloudStackClient)
 
 	request := kms.CreateCreateAliasRequest()
@@ -47,9 +48,9 @@ func resourceAlibabacloudStackKmsAliasCreate(d *schema.ResourceData, meta interf
 	addDebug(request.GetActionName(), raw)
 	d.SetId(request.AliasName)
 
-	return resourceAlibabacloudStackKmsAliasRead(d, meta)
+	return resourceAlibabacloudStackKmsAliasRead(ctx, d, meta)
 }
-func resourceAlibabacloudStackKmsAliasRead(d *schema.ResourceData, meta interface{}) error {
+func resourceAlibabacloudStackKmsAliasRead(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 	wiatSecondsIfWithTest(1)
 	client := meta.(*connectivity.AlibabacloudStackClient)
 	kmsService := KmsService{client}
@@ -66,7 +67,7 @@ func resourceAlibabacloudStackKmsAliasRead(d *schema.ResourceData, meta interfac
 	d.Set("key_id", object.KeyId)
 	return nil
 }
-func resourceAlibabacloudStackKmsAliasUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceAlibabacloudStackKmsAliasUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*connectivity.AlibabacloudStackClient)
 	if d.HasChange("key_id") {
 		request := kms.CreateUpdateAliasRequest()
@@ -83,9 +84,9 @@ func resourceAlibabacloudStackKmsAliasUpdate(d *schema.ResourceData, meta interf
 			return WrapErrorf(err, DefaultErrorMsg, d.Id(), request.GetActionName(), AlibabacloudStackSdkGoERROR)
 		}
 	}
-	return resourceAlibabacloudStackKmsAliasRead(d, meta)
+	return resourceAlibabacloudStackKmsAliasRead(ctx, d, meta)
 }
-func resourceAlibabacloudStackKmsAliasDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceAlibabacloudStackKmsAliasDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*connectivity.AlibabacloudStackClient)
 	request := kms.CreateDeleteAliasRequest()
 	request.Headers = map[string]string{"RegionId": client.RegionId}
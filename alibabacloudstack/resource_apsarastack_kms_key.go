@@ -1,6 +1,7 @@
 package alibabacloudstack
 
 import (
+	"context"
 	"fmt"
 
 	"github.com/aliyun/alibaba-cloud-sdk-go/sdk/requests"
@@ -12,12 +13,12 @@ import (
 
 func resourceAlibabacloudStackKmsKey() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceAlibabacloudStackKmsKeyCreate,
-		Read:   resourceAlibabacloudStackKmsKeyRead,
-		Update: resourceAlibabacloudStackKmsKeyUpdate,
-		Delete: resourceAlibabacloudStackKmsKeyDelete,
+		CreateContext: withDiagnostics(resourceAlibabacloudStackKmsKeyCreate),
+		ReadContext:   withDiagnostics(resourceAlibabacloudStackKmsKeyRead),
+		UpdateContext: withDiagnostics(resourceAlibabacloudStackKmsKeyUpdate),
+		DeleteContext: withDiagnostics(resourceAlibabacloudStackKmsKeyDelete),
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			StateContext: schema.ImportStatePassthroughContext,
 		},
 		Schema: map[string]*schema.Schema{
 			"arn": {
@@ -110,11 +111,12 @@ func resourceAlibabacloudStackKmsKey() *schema.Resource {
 				Type:     schema.TypeString,
 				Optional: true,
 			},
+			"tags": tagsSchema(),
 		},
 	}
 }
 
-func resourceAlibabacloudStackKmsKeyCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceAlibabacloudStackKmsKeyCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*connectivity.AlibabacloudStackClient)
 
 	request := kms.CreateCreateKeyRequest()
@@ -152,9 +154,16 @@ func resourceAlibabacloudStackKmsKeyCreate(d *schema.ResourceData, meta interfac
 
 	d.SetId(fmt.Sprintf("%v", response.KeyMetadata.KeyId))
 
-	return resourceAlibabacloudStackKmsKeyRead(d, meta)
+	if hasTagsAllChange(d, client) {
+		kmsService := KmsService{client}
+		if err := kmsService.setResourceTags(d, "key"); err != nil {
+			return WrapError(err)
+		}
+	}
+
+	return resourceAlibabacloudStackKmsKeyRead(ctx, d, meta)
 }
-func resourceAlibabacloudStackKmsKeyRead(d *schema.ResourceData, meta interface{}) error {
+func resourceAlibabacloudStackKmsKeyRead(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 	wiatSecondsIfWithTest(1)
 	client := meta.(*connectivity.AlibabacloudStackClient)
 	kmsService := KmsService{client}
@@ -182,13 +191,29 @@ func resourceAlibabacloudStackKmsKeyRead(d *schema.ResourceData, meta interface{
 	d.Set("primary_key_version", object.PrimaryKeyVersion)
 	d.Set("protection_level", object.ProtectionLevel)
 	d.Set("rotation_interval", object.RotationInterval)
+
+	tags, err := kmsService.ListResourceTags(d.Id())
+	if err != nil {
+		return WrapError(err)
+	}
+	tagsMap := make(map[string]string)
+	for _, t := range tags {
+		tagsMap[t.TagKey] = t.TagValue
+	}
+	d.Set("tags", tagsMap)
 	return nil
 }
-func resourceAlibabacloudStackKmsKeyUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceAlibabacloudStackKmsKeyUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*connectivity.AlibabacloudStackClient)
 	kmsService := KmsService{client}
 	d.Partial(true)
 
+	if hasTagsAllChange(d, client) {
+		if err := kmsService.setResourceTags(d, "key"); err != nil {
+			return WrapError(err)
+		}
+	}
+
 	if d.HasChange("description") {
 		request := kms.CreateUpdateKeyDescriptionRequest()
 		request.Headers = map[string]string{"RegionId": client.RegionId}
@@ -284,9 +309,9 @@ func resourceAlibabacloudStackKmsKeyUpdate(d *schema.ResourceData, meta interfac
 		}
 	}
 	d.Partial(false)
-	return resourceAlibabacloudStackKmsKeyRead(d, meta)
+	return resourceAlibabacloudStackKmsKeyRead(ctx, d, meta)
 }
-func resourceAlibabacloudStackKmsKeyDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceAlibabacloudStackKmsKeyDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*connectivity.AlibabacloudStackClient)
 	request := kms.CreateScheduleKeyDeletionRequest()
 	request.Headers = map[string]string{"RegionId": client.RegionId}
@@ -1,6 +1,7 @@
 package alibabacloudstack
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
 
@@ -12,12 +13,12 @@ import (
 
 func resourceAlibabacloudStackKmsSecret() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceAlibabacloudStackKmsSecretCreate,
-		Read:   resourceAlibabacloudStackKmsSecretRead,
-		Update: resourceAlibabacloudStackKmsSecretUpdate,
-		Delete: resourceAlibabacloudStackKmsSecretDelete,
+		CreateContext: withDiagnostics(resourceAlibabacloudStackKmsSecretCreate),
+		ReadContext:   withDiagnostics(resourceAlibabacloudStackKmsSecretRead),
+		UpdateContext: withDiagnostics(resourceAlibabacloudStackKmsSecretUpdate),
+		DeleteContext: withDiagnostics(resourceAlibabacloudStackKmsSecretDelete),
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			StateContext: schema.ImportStatePassthroughContext,
 		},
 		Schema: map[string]*schema.Schema{
 			"arn": {
@@ -83,7 +84,7 @@ func resourceAlibabacloudStackKmsSecret() *schema.Resource {
 	}
 }
 
-func resourceAlibabacloudStackKmsSecretCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceAlibabacloudStackKmsSecretCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*connectivity.AlibabacloudStackClient)
 
 	request := kms.CreateCreateSecretRequest()
@@ -102,7 +103,7 @@ func resourceAlibabacloudStackKmsSecretCreate(d *schema.ResourceData, meta inter
 		request.SecretDataType = v.(string)
 	}
 	request.SecretName = d.Get("secret_name").(string)
-	if v, ok := d.GetOk("tags"); ok {
+	if v, ok := getTagsAllOk(d, client); ok {
 		addTags := make([]JsonTag, 0)
 		for key, value := range v.(map[string]interface{}) {
 			addTags = append(addTags, JsonTag{
@@ -127,9 +128,9 @@ func resourceAlibabacloudStackKmsSecretCreate(d *schema.ResourceData, meta inter
 	response, _ := raw.(*kms.CreateSecretResponse)
 	d.SetId(response.SecretName)
 
-	return resourceAlibabacloudStackKmsSecretRead(d, meta)
+	return resourceAlibabacloudStackKmsSecretRead(ctx, d, meta)
 }
-func resourceAlibabacloudStackKmsSecretRead(d *schema.ResourceData, meta interface{}) error {
+func resourceAlibabacloudStackKmsSecretRead(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 	wiatSecondsIfWithTest(1)
 	client := meta.(*connectivity.AlibabacloudStackClient)
 	kmsService := KmsService{client}
@@ -164,12 +165,12 @@ func resourceAlibabacloudStackKmsSecretRead(d *schema.ResourceData, meta interfa
 	d.Set("version_stages", getSecretValueObject.VersionStages.VersionStage)
 	return nil
 }
-func resourceAlibabacloudStackKmsSecretUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceAlibabacloudStackKmsSecretUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*connectivity.AlibabacloudStackClient)
 	kmsService := KmsService{client}
 	d.Partial(true)
 
-	if d.HasChange("tags") {
+	if hasTagsAllChange(d, client) {
 		if err := kmsService.setResourceTags(d, "secret"); err != nil {
 			return WrapError(err)
 		}
@@ -227,9 +228,9 @@ func resourceAlibabacloudStackKmsSecretUpdate(d *schema.ResourceData, meta inter
 		//d.SetPartial("version_stages")
 	}
 	d.Partial(false)
-	return resourceAlibabacloudStackKmsSecretRead(d, meta)
+	return resourceAlibabacloudStackKmsSecretRead(ctx, d, meta)
 }
-func resourceAlibabacloudStackKmsSecretDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceAlibabacloudStackKmsSecretDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*connectivity.AlibabacloudStackClient)
 	request := kms.CreateDeleteSecretRequest()
 	request.Headers = map[string]string{"RegionId": client.RegionId}
@@ -1,6 +1,7 @@
 package alibabacloudstack
 
 import (
+	"context"
 	"fmt"
 	"strings"
 	"time"
@@ -14,12 +15,12 @@ import (
 
 func resourceAlibabacloudStackKVstoreAccount() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceAlibabacloudStackKVStoreAccountCreate,
-		Read:   resourceAlibabacloudStackKVStoreAccountRead,
-		Update: resourceAlibabacloudStackKVStoreAccountUpdate,
-		Delete: resourceAlibabacloudStackKVStoreAccountDelete,
+		CreateContext: withDiagnostics(resourceAlibabacloudStackKVStoreAccountCreate),
+		ReadContext:   withDiagnostics(resourceAlibabacloudStackKVStoreAccountRead),
+		UpdateContext: withDiagnostics(resourceAlibabacloudStackKVStoreAccountUpdate),
+		DeleteContext: withDiagnostics(resourceAlibabacloudStackKVStoreAccountDelete),
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			StateContext: schema.ImportStatePassthroughContext,
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -74,7 +75,7 @@ func resourceAlibabacloudStackKVstoreAccount() *schema.Resource {
 	}
 }
 
-func resourceAlibabacloudStackKVStoreAccountCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceAlibabacloudStackKVStoreAccountCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*connectivity.AlibabacloudStackClient)
 	kvstoreService := KvstoreService{client}
 	request := r_kvstore.CreateCreateAccountRequest()
@@ -109,7 +110,7 @@ func resourceAlibabacloudStackKVStoreAccountCreate(d *schema.ResourceData, meta
 	if err := kvstoreService.WaitForKVstoreInstance(request.InstanceId, Normal, DefaultTimeoutMedium); err != nil {
 		return WrapError(err)
 	}
-	err := resource.Retry(5*time.Minute, func() *resource.RetryError {
+	err := resource.RetryContext(ctx, 5*time.Minute, func() *resource.RetryError {
 		raw, err := client.WithRkvClient(func(rkvClient *r_kvstore.Client) (interface{}, error) {
 			return rkvClient.CreateAccount(request)
 		})
@@ -133,10 +134,10 @@ func resourceAlibabacloudStackKVStoreAccountCreate(d *schema.ResourceData, meta
 		return WrapError(err)
 	}
 
-	return resourceAlibabacloudStackKVStoreAccountRead(d, meta)
+	return resourceAlibabacloudStackKVStoreAccountRead(ctx, d, meta)
 }
 
-func resourceAlibabacloudStackKVStoreAccountRead(d *schema.ResourceData, meta interface{}) error {
+func resourceAlibabacloudStackKVStoreAccountRead(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 	wiatSecondsIfWithTest(1)
 	client := meta.(*connectivity.AlibabacloudStackClient)
 	kvstoreService := KvstoreService{client}
@@ -158,7 +159,7 @@ func resourceAlibabacloudStackKVStoreAccountRead(d *schema.ResourceData, meta in
 	return nil
 }
 
-func resourceAlibabacloudStackKVStoreAccountUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceAlibabacloudStackKVStoreAccountUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*connectivity.AlibabacloudStackClient)
 	kvstoreService := KvstoreService{client}
 	d.Partial(true)
@@ -254,10 +255,10 @@ func resourceAlibabacloudStackKVStoreAccountUpdate(d *schema.ResourceData, meta
 	}
 
 	d.Partial(false)
-	return resourceAlibabacloudStackKVStoreAccountRead(d, meta)
+	return resourceAlibabacloudStackKVStoreAccountRead(ctx, d, meta)
 }
 
-func resourceAlibabacloudStackKVStoreAccountDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceAlibabacloudStackKVStoreAccountDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*connectivity.AlibabacloudStackClient)
 	kvstoreService := KvstoreService{client}
 	parts, err := ParseResourceId(d.Id(), 2)
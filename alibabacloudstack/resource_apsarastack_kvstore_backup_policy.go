@@ -1,6 +1,7 @@
 package alibabacloudstack
 
 import (
+	"context"
 	"strings"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
@@ -12,12 +13,12 @@ import (
 
 func resourceAlibabacloudStackKVStoreBackupPolicy() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceAlibabacloudStackKVStoreBackupPolicyCreate,
-		Read:   resourceAlibabacloudStackKVStoreBackupPolicyRead,
-		Update: resourceAlibabacloudStackKVStoreBackupPolicyUpdate,
-		Delete: resourceAlibabacloudStackKVStoreBackupPolicyDelete,
+		CreateContext: withDiagnostics(resourceAlibabacloudStackKVStoreBackupPolicyCreate),
+		ReadContext:   withDiagnostics(resourceAlibabacloudStackKVStoreBackupPolicyRead),
+		UpdateContext: withDiagnostics(resourceAlibabacloudStackKVStoreBackupPolicyUpdate),
+		DeleteContext: withDiagnostics(resourceAlibabacloudStackKVStoreBackupPolicyDelete),
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			StateContext: schema.ImportStatePassthroughContext,
 		},
 		Schema: map[string]*schema.Schema{
 			"instance_id": {
@@ -43,14 +44,14 @@ func resourceAlibabacloudStackKVStoreBackupPolicy() *schema.Resource {
 	}
 }
 
-func resourceAlibabacloudStackKVStoreBackupPolicyCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceAlibabacloudStackKVStoreBackupPolicyCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 
 	d.SetId(d.Get("instance_id").(string))
 
-	return resourceAlibabacloudStackKVStoreBackupPolicyUpdate(d, meta)
+	return resourceAlibabacloudStackKVStoreBackupPolicyUpdate(ctx, d, meta)
 }
 
-func resourceAlibabacloudStackKVStoreBackupPolicyRead(d *schema.ResourceData, meta interface{}) error {
+func resourceAlibabacloudStackKVStoreBackupPolicyRead(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 	wiatSecondsIfWithTest(1)
 	client := meta.(*connectivity.AlibabacloudStackClient)
 	kvstoreService := KvstoreService{client}
@@ -71,7 +72,7 @@ func resourceAlibabacloudStackKVStoreBackupPolicyRead(d *schema.ResourceData, me
 	return nil
 }
 
-func resourceAlibabacloudStackKVStoreBackupPolicyUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceAlibabacloudStackKVStoreBackupPolicyUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 	if d.HasChange("backup_time") || d.HasChange("backup_period") {
 		client := meta.(*connectivity.AlibabacloudStackClient)
 		kvstoreService := KvstoreService{client}
@@ -99,10 +100,10 @@ func resourceAlibabacloudStackKVStoreBackupPolicyUpdate(d *schema.ResourceData,
 		}
 	}
 
-	return resourceAlibabacloudStackKVStoreBackupPolicyRead(d, meta)
+	return resourceAlibabacloudStackKVStoreBackupPolicyRead(ctx, d, meta)
 }
 
-func resourceAlibabacloudStackKVStoreBackupPolicyDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceAlibabacloudStackKVStoreBackupPolicyDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 	// In case of a delete we are resetting to default values which is Monday - Sunday each 3am-4am
 	client := meta.(*connectivity.AlibabacloudStackClient)
 	request := r_kvstore.CreateModifyBackupPolicyRequest()
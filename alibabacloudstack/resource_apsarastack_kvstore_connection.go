@@ -1,6 +1,7 @@
 package alibabacloudstack
 
 import (
+	"context"
 	"fmt"
 	"log"
 	"time"
@@ -12,12 +13,12 @@ import (
 
 func resourceAlibabacloudStackKvstoreConnection() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceAlibabacloudStackKvstoreConnectionCreate,
-		Read:   resourceAlibabacloudStackKvstoreConnectionRead,
-		Update: resourceAlibabacloudStackKvstoreConnectionUpdate,
-		Delete: resourceAlibabacloudStackKvstoreConnectionDelete,
+		CreateContext: withDiagnostics(resourceAlibabacloudStackKvstoreConnectionCreate),
+		ReadContext:   withDiagnostics(resourceAlibabacloudStackKvstoreConnectionRead),
+		UpdateContext: withDiagnostics(resourceAlibabacloudStackKvstoreConnectionUpdate),
+		DeleteContext: withDiagnostics(resourceAlibabacloudStackKvstoreConnectionDelete),
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			StateContext: schema.ImportStatePassthroughContext,
 		},
 		Timeouts: &schema.ResourceTimeout{
 			Create: schema.DefaultTimeout(2 * time.Minute),
@@ -46,7 +47,7 @@ func resourceAlibabacloudStackKvstoreConnection() *schema.Resource {
 	}
 }
 
-func resourceAlibabacloudStackKvstoreConnectionCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceAlibabacloudStackKvstoreConnectionCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*connectivity.AlibabacloudStackClient)
 	r_kvstoreService := KvstoreService{client}
 
@@ -77,13 +78,13 @@ func resourceAlibabacloudStackKvstoreConnectionCreate(d *schema.ResourceData, me
 	addDebug(request.GetActionName(), raw)
 	d.SetId(fmt.Sprintf("%v", request.InstanceId))
 	stateConf := BuildStateConf([]string{}, []string{"Normal"}, d.Timeout(schema.TimeoutCreate), 30*time.Second, r_kvstoreService.RdsKvstoreInstanceStateRefreshFunc(d.Id(), []string{}))
-	if _, err := stateConf.WaitForState(); err != nil {
+	if _, err := stateConf.WaitForStateContext(ctx); err != nil {
 		return WrapErrorf(err, IdMsg, d.Id())
 	}
 
-	return resourceAlibabacloudStackKvstoreConnectionUpdate(d, meta)
+	return resourceAlibabacloudStackKvstoreConnectionUpdate(ctx, d, meta)
 }
-func resourceAlibabacloudStackKvstoreConnectionRead(d *schema.ResourceData, meta interface{}) error {
+func resourceAlibabacloudStackKvstoreConnectionRead(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*connectivity.AlibabacloudStackClient)
 	r_kvstoreService := KvstoreService{client}
 	object, err := r_kvstoreService.DescribeKvstoreConnection(d.Id())
@@ -105,7 +106,7 @@ func resourceAlibabacloudStackKvstoreConnectionRead(d *schema.ResourceData, meta
 	}
 	return nil
 }
-func resourceAlibabacloudStackKvstoreConnectionUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceAlibabacloudStackKvstoreConnectionUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*connectivity.AlibabacloudStackClient)
 	r_kvstoreService := KvstoreService{client}
 	update := false
@@ -144,13 +145,13 @@ func resourceAlibabacloudStackKvstoreConnectionUpdate(d *schema.ResourceData, me
 			return WrapErrorf(err, DefaultErrorMsg, d.Id(), request.GetActionName(), AlibabacloudStackSdkGoERROR)
 		}
 		stateConf := BuildStateConf([]string{}, []string{"Normal"}, d.Timeout(schema.TimeoutUpdate), 30*time.Second, r_kvstoreService.RdsKvstoreInstanceStateRefreshFunc(d.Id(), []string{}))
-		if _, err := stateConf.WaitForState(); err != nil {
+		if _, err := stateConf.WaitForStateContext(ctx); err != nil {
 			return WrapErrorf(err, IdMsg, d.Id())
 		}
 	}
-	return resourceAlibabacloudStackKvstoreConnectionRead(d, meta)
+	return resourceAlibabacloudStackKvstoreConnectionRead(ctx, d, meta)
 }
-func resourceAlibabacloudStackKvstoreConnectionDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceAlibabacloudStackKvstoreConnectionDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*connectivity.AlibabacloudStackClient)
 	r_kvstoreService := KvstoreService{client}
 	request := r_kvstore.CreateReleaseInstancePublicConnectionRequest()
@@ -180,7 +181,7 @@ func resourceAlibabacloudStackKvstoreConnectionDelete(d *schema.ResourceData, me
 		return WrapErrorf(err, DefaultErrorMsg, d.Id(), request.GetActionName(), AlibabacloudStackSdkGoERROR)
 	}
 	stateConf := BuildStateConf([]string{}, []string{"Normal"}, d.Timeout(schema.TimeoutDelete), 30*time.Second, r_kvstoreService.RdsKvstoreInstanceStateRefreshFunc(d.Id(), []string{}))
-	if _, err := stateConf.WaitForState(); err != nil {
+	if _, err := stateConf.WaitForStateContext(ctx); err != nil {
 		return WrapErrorf(err, IdMsg, d.Id())
 	}
 	return nil
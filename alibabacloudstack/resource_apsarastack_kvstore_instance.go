@@ -1,6 +1,7 @@
 package alibabacloudstack
 
 import (
+	"context"
 	"encoding/json"
 	"github.com/aliyun/alibaba-cloud-sdk-go/sdk/requests"
 	"strings"
@@ -22,12 +23,12 @@ import (
 
 func resourceAlibabacloudStackKVStoreInstance() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceAlibabacloudStackKVStoreInstanceCreate,
-		Read:   resourceAlibabacloudStackKVStoreInstanceRead,
-		Update: resourceAlibabacloudStackKVStoreInstanceUpdate,
-		Delete: resourceAlibabacloudStackKVStoreInstanceDelete,
+		CreateContext: withDiagnostics(resourceAlibabacloudStackKVStoreInstanceCreate),
+		ReadContext:   withDiagnostics(resourceAlibabacloudStackKVStoreInstanceRead),
+		UpdateContext: withDiagnostics(resourceAlibabacloudStackKVStoreInstanceUpdate),
+		DeleteContext: withDiagnostics(resourceAlibabacloudStackKVStoreInstanceDelete),
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			StateContext: schema.ImportStatePassthroughContext,
 		},
 
 		Timeouts: &schema.ResourceTimeout{
@@ -164,11 +165,13 @@ func resourceAlibabacloudStackKVStoreInstance() *schema.Resource {
 				Optional: true,
 				Computed: true,
 			},
+
+			"tags": tagsSchema(),
 		},
 	}
 }
 
-func resourceAlibabacloudStackKVStoreInstanceCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceAlibabacloudStackKVStoreInstanceCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*connectivity.AlibabacloudStackClient)
 	kvstoreService := KvstoreService{client}
 
@@ -190,19 +193,23 @@ func resourceAlibabacloudStackKVStoreInstanceCreate(d *schema.ResourceData, meta
 
 	// wait instance status change from Creating to Normal
 	stateConf := BuildStateConf([]string{"Creating"}, []string{"Normal"}, d.Timeout(schema.TimeoutCreate), 1*time.Minute, kvstoreService.RdsKvstoreInstanceStateRefreshFunc(d.Id(), []string{"Deleting"}))
-	if _, err := stateConf.WaitForState(); err != nil {
+	if _, err := stateConf.WaitForStateContext(ctx); err != nil {
 		return WrapError(err)
 	}
 
-	return resourceAlibabacloudStackKVStoreInstanceUpdate(d, meta)
+	return resourceAlibabacloudStackKVStoreInstanceUpdate(ctx, d, meta)
 }
 
-func resourceAlibabacloudStackKVStoreInstanceUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceAlibabacloudStackKVStoreInstanceUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*connectivity.AlibabacloudStackClient)
 	kvstoreService := KvstoreService{client}
 	d.Partial(true)
 	stateConf := BuildStateConf([]string{"DBInstanceClassChanging", "DBInstanceNetTypeChanging", "Changing"}, []string{"Normal"}, d.Timeout(schema.TimeoutUpdate), 1*time.Minute, kvstoreService.RdsKvstoreInstanceStateRefreshFunc(d.Id(), []string{"Deleting"}))
 
+	if err := kvstoreService.setInstanceTags(d); err != nil {
+		return WrapError(err)
+	}
+
 	if d.HasChange("parameters") {
 		config := make(map[string]interface{})
 		documented := d.Get("parameters").(*schema.Set).List()
@@ -223,7 +230,7 @@ func resourceAlibabacloudStackKVStoreInstanceUpdate(d *schema.ResourceData, meta
 
 	if d.HasChange("security_ips") {
 		// wait instance status is Normal before modifying
-		if _, err := stateConf.WaitForState(); err != nil {
+		if _, err := stateConf.WaitForStateContext(ctx); err != nil {
 			return WrapError(err)
 		}
 		request := r_kvstore.CreateModifySecurityIpsRequest()
@@ -246,7 +253,7 @@ func resourceAlibabacloudStackKVStoreInstanceUpdate(d *schema.ResourceData, meta
 		addDebug(request.GetActionName(), raw, request.RpcRequest, request)
 		//d.SetPartial("security_ips")
 		// wait instance status is Normal after modifying
-		if _, err := stateConf.WaitForState(); err != nil {
+		if _, err := stateConf.WaitForStateContext(ctx); err != nil {
 			return WrapError(err)
 		}
 	}
@@ -257,7 +264,7 @@ func resourceAlibabacloudStackKVStoreInstanceUpdate(d *schema.ResourceData, meta
 			instanceType := d.Get("instance_type").(string)
 			if string(KVStoreRedis) == instanceType {
 				// wait instance status is Normal before modifying
-				if _, err := stateConf.WaitForState(); err != nil {
+				if _, err := stateConf.WaitForStateContext(ctx); err != nil {
 					return WrapError(err)
 				}
 
@@ -278,7 +285,7 @@ func resourceAlibabacloudStackKVStoreInstanceUpdate(d *schema.ResourceData, meta
 				//d.SetPartial("vpc_auth_mode")
 
 				// The auth mode take some time to be effective, so wait to ensure the state !
-				if _, err := stateConf.WaitForState(); err != nil {
+				if _, err := stateConf.WaitForStateContext(ctx); err != nil {
 					return WrapError(err)
 				}
 			}
@@ -303,7 +310,7 @@ func resourceAlibabacloudStackKVStoreInstanceUpdate(d *schema.ResourceData, meta
 		}
 		addDebug(prePaidRequest.GetActionName(), raw, prePaidRequest.RpcRequest, prePaidRequest)
 		// wait instance status is Normal after modifying
-		if _, err := stateConf.WaitForState(); err != nil {
+		if _, err := stateConf.WaitForStateContext(ctx); err != nil {
 			return WrapError(err)
 		}
 		//d.SetPartial("instance_charge_type")
@@ -332,12 +339,12 @@ func resourceAlibabacloudStackKVStoreInstanceUpdate(d *schema.ResourceData, meta
 
 	if d.IsNewResource() {
 		d.Partial(false)
-		return resourceAlibabacloudStackKVStoreInstanceRead(d, meta)
+		return resourceAlibabacloudStackKVStoreInstanceRead(ctx, d, meta)
 	}
 
 	if d.HasChange("instance_class") {
 		// wait instance status is Normal before modifying
-		if _, err := stateConf.WaitForState(); err != nil {
+		if _, err := stateConf.WaitForStateContext(ctx); err != nil {
 			return WrapError(err)
 		}
 
@@ -349,7 +356,7 @@ func resourceAlibabacloudStackKVStoreInstanceUpdate(d *schema.ResourceData, meta
 		request.InstanceClass = d.Get("instance_class").(string)
 		request.EffectiveTime = "Immediately"
 
-		err := resource.Retry(5*time.Minute, func() *resource.RetryError {
+		err := resource.RetryContext(ctx, 5*time.Minute, func() *resource.RetryError {
 			raw, err := client.WithRkvClient(func(rkvClient *r_kvstore.Client) (interface{}, error) {
 				return rkvClient.ModifyInstanceSpec(request)
 			})
@@ -367,11 +374,11 @@ func resourceAlibabacloudStackKVStoreInstanceUpdate(d *schema.ResourceData, meta
 			return WrapErrorf(err, DefaultErrorMsg, d.Id(), request.GetActionName(), AlibabacloudStackSdkGoERROR)
 		}
 		// wait instance status is Normal after modifying
-		if _, err := stateConf.WaitForState(); err != nil {
+		if _, err := stateConf.WaitForStateContext(ctx); err != nil {
 			return WrapError(err)
 		}
 		// There needs more time to sync instance class update
-		err = resource.Retry(1*time.Minute, func() *resource.RetryError {
+		err = resource.RetryContext(ctx, 1*time.Minute, func() *resource.RetryError {
 			object, err := kvstoreService.DescribeKVstoreInstance(d.Id())
 			if err != nil {
 				return resource.NonRetryableError(err)
@@ -421,7 +428,7 @@ func resourceAlibabacloudStackKVStoreInstanceUpdate(d *schema.ResourceData, meta
 
 	if update {
 		// wait instance status is Normal before modifying
-		if _, err := stateConf.WaitForState(); err != nil {
+		if _, err := stateConf.WaitForStateContext(ctx); err != nil {
 			return WrapError(err)
 		}
 		raw, err := client.WithRkvClient(func(rkvClient *r_kvstore.Client) (interface{}, error) {
@@ -432,7 +439,7 @@ func resourceAlibabacloudStackKVStoreInstanceUpdate(d *schema.ResourceData, meta
 		}
 		addDebug(request.GetActionName(), raw, request.RpcRequest, request)
 		// wait instance status is Normal after modifying
-		if _, err := stateConf.WaitForState(); err != nil {
+		if _, err := stateConf.WaitForStateContext(ctx); err != nil {
 			return WrapError(err)
 		}
 		//d.SetPartial("instance_name")
@@ -440,10 +447,10 @@ func resourceAlibabacloudStackKVStoreInstanceUpdate(d *schema.ResourceData, meta
 	}
 
 	d.Partial(false)
-	return resourceAlibabacloudStackKVStoreInstanceRead(d, meta)
+	return resourceAlibabacloudStackKVStoreInstanceRead(ctx, d, meta)
 }
 
-func resourceAlibabacloudStackKVStoreInstanceRead(d *schema.ResourceData, meta interface{}) error {
+func resourceAlibabacloudStackKVStoreInstanceRead(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 	wiatSecondsIfWithTest(1)
 	client := meta.(*connectivity.AlibabacloudStackClient)
 	kvstoreService := KvstoreService{client}
@@ -470,6 +477,12 @@ func resourceAlibabacloudStackKVStoreInstanceRead(d *schema.ResourceData, meta i
 	d.Set("maintain_start_time", object.MaintainStartTime)
 	d.Set("maintain_end_time", object.MaintainEndTime)
 
+	tags, err := kvstoreService.DescribeTags(d.Id(), TagResourceInstance)
+	if err != nil {
+		return WrapError(err)
+	}
+	d.Set("tags", kvstoreService.tagsToMap(tags))
+
 	if object.ChargeType == string(PrePaid) {
 		request := r_kvstore.CreateDescribeInstanceAutoRenewalAttributeRequest()
 		request.RegionId = client.RegionId
@@ -491,14 +504,14 @@ func resourceAlibabacloudStackKVStoreInstanceRead(d *schema.ResourceData, meta i
 		d.Set("period", period)
 	}
 	//refresh parameters
-	if err = refreshParameters(d, meta); err != nil {
+	if err = refreshParameters(ctx, d, meta); err != nil {
 		return WrapError(err)
 	}
 
 	return nil
 }
 
-func resourceAlibabacloudStackKVStoreInstanceDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceAlibabacloudStackKVStoreInstanceDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*connectivity.AlibabacloudStackClient)
 	kvstoreService := KvstoreService{client}
 
@@ -531,7 +544,7 @@ func resourceAlibabacloudStackKVStoreInstanceDelete(d *schema.ResourceData, meta
 	addDebug(request.GetActionName(), raw, request.RpcRequest, request)
 
 	stateConf := BuildStateConf([]string{"Creating", "Active", "Deleting"}, []string{}, d.Timeout(schema.TimeoutDelete), 1*time.Minute, kvstoreService.RdsKvstoreInstanceStateRefreshFunc(d.Id(), []string{}))
-	_, err = stateConf.WaitForState()
+	_, err = stateConf.WaitForStateContext(ctx)
 	return WrapError(err)
 }
 
@@ -607,7 +620,7 @@ func buildKVStoreCreateRequest(d *schema.ResourceData, meta interface{}) (*r_kvs
 	return request, nil
 }
 
-func refreshParameters(d *schema.ResourceData, meta interface{}) error {
+func refreshParameters(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*connectivity.AlibabacloudStackClient)
 	kvstoreService := KvstoreService{client}
 
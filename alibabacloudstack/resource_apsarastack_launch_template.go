@@ -1,6 +1,7 @@
 package alibabacloudstack
 
 import (
+	"context"
 	"fmt"
 	"regexp"
 	"strconv"
@@ -18,12 +19,12 @@ import (
 
 func resourceAlibabacloudStackLaunchTemplate() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceAlibabacloudStackLaunchTemplateCreate,
-		Read:   resourceAlibabacloudStackLaunchTemplateRead,
-		Update: resourceAlibabacloudStackLaunchTemplateUpdate,
-		Delete: resourceAlibabacloudStackLaunchTemplateDelete,
+		CreateContext: withDiagnostics(resourceAlibabacloudStackLaunchTemplateCreate),
+		ReadContext:   withDiagnostics(resourceAlibabacloudStackLaunchTemplateRead),
+		UpdateContext: withDiagnostics(resourceAlibabacloudStackLaunchTemplateUpdate),
+		DeleteContext: withDiagnostics(resourceAlibabacloudStackLaunchTemplateDelete),
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			StateContext: schema.ImportStatePassthroughContext,
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -269,7 +270,7 @@ func resourceAlibabacloudStackLaunchTemplate() *schema.Resource {
 	}
 }
 
-func resourceAlibabacloudStackLaunchTemplateCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceAlibabacloudStackLaunchTemplateCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*connectivity.AlibabacloudStackClient)
 
 	request := ecs.CreateCreateLaunchTemplateRequest()
@@ -347,7 +348,7 @@ func resourceAlibabacloudStackLaunchTemplateCreate(d *schema.ResourceData, meta
 
 		request.DataDisk = &disks
 	}
-	tagsRaw := d.Get("tags").(map[string]interface{})
+	tagsRaw := getTagsAll(d, client)
 	var tags []ecs.CreateLaunchTemplateTag
 	for key, value := range tagsRaw {
 		tags = append(tags, ecs.CreateLaunchTemplateTag{
@@ -368,10 +369,10 @@ func resourceAlibabacloudStackLaunchTemplateCreate(d *schema.ResourceData, meta
 
 	d.SetId(response.LaunchTemplateId)
 
-	return resourceAlibabacloudStackLaunchTemplateRead(d, meta)
+	return resourceAlibabacloudStackLaunchTemplateRead(ctx, d, meta)
 }
 
-func resourceAlibabacloudStackLaunchTemplateRead(d *schema.ResourceData, meta interface{}) error {
+func resourceAlibabacloudStackLaunchTemplateRead(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 	wiatSecondsIfWithTest(1)
 	client := meta.(*connectivity.AlibabacloudStackClient)
 	ecsService := EcsService{client}
@@ -461,7 +462,7 @@ func resourceAlibabacloudStackLaunchTemplateRead(d *schema.ResourceData, meta in
 	return nil
 }
 
-func resourceAlibabacloudStackLaunchTemplateUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceAlibabacloudStackLaunchTemplateUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 	versions, err := getLaunchTemplateVersions(d.Id(), meta)
 	if err != nil {
 		return WrapError(err)
@@ -480,11 +481,11 @@ func resourceAlibabacloudStackLaunchTemplateUpdate(d *schema.ResourceData, meta
 			return WrapError(err)
 		}
 	}
-	return WrapError(createLaunchTemplateVersion(d, meta))
+	return WrapError(createLaunchTemplateVersion(ctx, d, meta))
 
 }
 
-func resourceAlibabacloudStackLaunchTemplateDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceAlibabacloudStackLaunchTemplateDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*connectivity.AlibabacloudStackClient)
 
 	request := ecs.CreateDeleteLaunchTemplateRequest()
@@ -508,7 +509,7 @@ func resourceAlibabacloudStackLaunchTemplateDelete(d *schema.ResourceData, meta
 	if err := ecsService.WaitForLaunchTemplate(d.Id(), Deleted, DefaultTimeout); err != nil {
 		return WrapError(err)
 	}
-	return resourceAlibabacloudStackLaunchTemplateRead(d, meta)
+	return resourceAlibabacloudStackLaunchTemplateRead(ctx, d, meta)
 }
 
 func getLaunchTemplateVersions(id string, meta interface{}) ([]ecs.LaunchTemplateVersionSet, error) {
@@ -562,7 +563,7 @@ func deleteLaunchTemplateVersion(id string, version int, meta interface{}) error
 	return nil
 }
 
-func createLaunchTemplateVersion(d *schema.ResourceData, meta interface{}) error {
+func createLaunchTemplateVersion(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*connectivity.AlibabacloudStackClient)
 	request := ecs.CreateCreateLaunchTemplateVersionRequest()
 	if strings.ToLower(client.Config.Protocol) == "https" {
@@ -639,7 +640,7 @@ func createLaunchTemplateVersion(d *schema.ResourceData, meta interface{}) error
 
 		request.DataDisk = &disks
 	}
-	tagsRaw := d.Get("tags").(map[string]interface{})
+	tagsRaw := getTagsAll(d, client)
 	var tags []ecs.CreateLaunchTemplateVersionTag
 	for key, value := range tagsRaw {
 		tags = append(tags, ecs.CreateLaunchTemplateVersionTag{
@@ -0,0 +1,440 @@
+package alibabacloudstack
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	sls "github.com/aliyun/aliyun-log-go-sdk"
+	"github.com/aliyun/terraform-provider-alibabacloudstack/alibabacloudstack/connectivity"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+func resourceAlibabacloudStackLogAlert() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: withDiagnostics(resourceAlibabacloudStackLogAlertCreate),
+		ReadContext:   withDiagnostics(resourceAlibabacloudStackLogAlertRead),
+		UpdateContext: withDiagnostics(resourceAlibabacloudStackLogAlertUpdate),
+		DeleteContext: withDiagnostics(resourceAlibabacloudStackLogAlertDelete),
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"project_name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"alert_name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"alert_displayname": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"alert_description": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"condition": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"dashboard": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"mute_until": {
+				Type:     schema.TypeInt,
+				Optional: true,
+				Computed: true,
+			},
+			"throttling": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Default:  "60s",
+			},
+			"notify_threshold": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Default:      1,
+				ValidateFunc: validation.IntAtLeast(1),
+			},
+			"schedule_type": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      sls.ScheduleTypeFixedRate,
+				ValidateFunc: validation.StringInSlice([]string{sls.ScheduleTypeFixedRate, sls.ScheduleTypeHourly, sls.ScheduleTypeDaily, sls.ScheduleTypeWeekly, sls.ScheduleTypeCron}, false),
+			},
+			"schedule_interval": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Default:  "60s",
+			},
+			"schedule_cron_expression": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"schedule_hour": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				ValidateFunc: validation.IntBetween(0, 23),
+			},
+			"schedule_day_of_week": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				ValidateFunc: validation.IntBetween(0, 6),
+			},
+			"query_list": {
+				Type:     schema.TypeList,
+				Required: true,
+				MinItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"chart_title": {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+						"logstore": {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+						"query": {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+						"start": {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+						"end": {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+						"time_span_type": {
+							Type:     schema.TypeString,
+							Optional: true,
+							Default:  "Custom",
+						},
+					},
+				},
+			},
+			"notification_list": {
+				Type:     schema.TypeList,
+				Required: true,
+				MinItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"type": {
+							Type:     schema.TypeString,
+							Required: true,
+							ValidateFunc: validation.StringInSlice([]string{
+								sls.NotificationTypeSMS,
+								sls.NotificationTypeDingTalk,
+								sls.NotificationTypeEmail,
+								sls.NotificationTypeMessageCenter,
+								sls.NotificationTypeWebhook,
+							}, false),
+						},
+						"content": {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+						"service_uri": {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+						"mobile_list": {
+							Type:     schema.TypeList,
+							Optional: true,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+						"email_list": {
+							Type:     schema.TypeList,
+							Optional: true,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func resourceAlibabacloudStackLogAlertCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*connectivity.AlibabacloudStackClient)
+	logService := LogService{client}
+	projectName := d.Get("project_name").(string)
+	alertName := d.Get("alert_name").(string)
+
+	if err := checkLogAlertDashboard(logService, projectName, d.Get("dashboard").(string)); err != nil {
+		return err
+	}
+
+	var requestInfo *sls.Client
+	wait := incrementalWait(3*time.Second, 3*time.Second)
+	if err := resource.RetryContext(ctx, 2*time.Minute, func() *resource.RetryError {
+		raw, err := client.WithLogClient(func(slsClient *sls.Client) (interface{}, error) {
+			requestInfo = slsClient
+			return nil, slsClient.CreateAlert(projectName, buildLogAlert(d, slsClient))
+		})
+		if err != nil {
+			if IsExpectedErrors(err, []string{"InternalServerError", LogClientTimeout}) {
+				wait()
+				return resource.RetryableError(err)
+			}
+			return resource.NonRetryableError(err)
+		}
+		if debugOn() {
+			addDebug("CreateLogstoreAlert", raw, requestInfo, map[string]interface{}{
+				"project":    projectName,
+				"alert_name": alertName,
+			})
+		}
+		return nil
+	}); err != nil {
+		return WrapErrorf(err, DefaultErrorMsg, "alibabacloudstack_log_alert", "CreateLogstoreAlert", AlibabacloudStackLogGoSdkERROR)
+	}
+
+	d.SetId(fmt.Sprintf("%s%s%s", projectName, COLON_SEPARATED, alertName))
+	if err := logService.WaitForLogstoreAlert(d.Id(), Running, DefaultTimeout); err != nil {
+		return WrapError(err)
+	}
+
+	return resourceAlibabacloudStackLogAlertRead(ctx, d, meta)
+}
+
+func resourceAlibabacloudStackLogAlertRead(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*connectivity.AlibabacloudStackClient)
+	logService := LogService{client}
+	parts, err := ParseResourceId(d.Id(), 2)
+	if err != nil {
+		return WrapError(err)
+	}
+	object, err := logService.DescribeLogAlert(d.Id())
+	if err != nil {
+		if NotFoundError(err) {
+			d.SetId("")
+			return nil
+		}
+		return WrapError(err)
+	}
+
+	d.Set("project_name", parts[0])
+	d.Set("alert_name", object.Name)
+	d.Set("alert_displayname", object.DisplayName)
+	d.Set("alert_description", object.Description)
+	if object.Schedule != nil {
+		d.Set("schedule_type", object.Schedule.Type)
+		d.Set("schedule_interval", object.Schedule.Interval)
+		d.Set("schedule_cron_expression", object.Schedule.CronExpression)
+		d.Set("schedule_hour", object.Schedule.Hour)
+		d.Set("schedule_day_of_week", object.Schedule.DayOfWeek)
+	}
+	if object.Configuration == nil {
+		return nil
+	}
+	configuration := object.Configuration
+	d.Set("condition", configuration.Condition)
+	d.Set("dashboard", configuration.Dashboard)
+	d.Set("mute_until", configuration.MuteUntil)
+	d.Set("throttling", configuration.Throttling)
+	d.Set("notify_threshold", configuration.NotifyThreshold)
+
+	// The chart title of the config may be the display name of the chart, which is kept when it refers to the same chart.
+	configured := make(map[int]string)
+	for i, v := range d.Get("query_list").([]interface{}) {
+		if query, ok := v.(map[string]interface{}); ok {
+			configured[i] = query["chart_title"].(string)
+		}
+	}
+	var queries []map[string]interface{}
+	for i, v := range configuration.QueryList {
+		chartTitle := v.ChartTitle
+		if title, ok := configured[i]; ok && title != chartTitle {
+			raw, err := client.WithLogClient(func(slsClient *sls.Client) (interface{}, error) {
+				return GetCharTitile(parts[0], configuration.Dashboard, title, slsClient), nil
+			})
+			if err == nil && raw.(string) == chartTitle {
+				chartTitle = title
+			}
+		}
+		queries = append(queries, map[string]interface{}{
+			"chart_title":    chartTitle,
+			"logstore":       v.LogStore,
+			"query":          v.Query,
+			"start":          v.Start,
+			"end":            v.End,
+			"time_span_type": v.TimeSpanType,
+		})
+	}
+	if err := d.Set("query_list", queries); err != nil {
+		return WrapError(err)
+	}
+
+	var notifications []map[string]interface{}
+	for _, v := range configuration.NotificationList {
+		notifications = append(notifications, map[string]interface{}{
+			"type":        v.Type,
+			"content":     v.Content,
+			"service_uri": v.ServiceUri,
+			"mobile_list": v.MobileList,
+			"email_list":  v.EmailList,
+		})
+	}
+	if err := d.Set("notification_list", notifications); err != nil {
+		return WrapError(err)
+	}
+	return nil
+}
+
+func resourceAlibabacloudStackLogAlertUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*connectivity.AlibabacloudStackClient)
+	logService := LogService{client}
+	parts, err := ParseResourceId(d.Id(), 2)
+	if err != nil {
+		return WrapError(err)
+	}
+
+	if d.HasChange("dashboard") {
+		if err := checkLogAlertDashboard(logService, parts[0], d.Get("dashboard").(string)); err != nil {
+			return err
+		}
+	}
+
+	var requestInfo *sls.Client
+	wait := incrementalWait(3*time.Second, 3*time.Second)
+	if err := resource.RetryContext(ctx, 2*time.Minute, func() *resource.RetryError {
+		raw, err := client.WithLogClient(func(slsClient *sls.Client) (interface{}, error) {
+			requestInfo = slsClient
+			return nil, slsClient.UpdateAlert(parts[0], buildLogAlert(d, slsClient))
+		})
+		if err != nil {
+			if IsExpectedErrors(err, []string{"InternalServerError", LogClientTimeout}) {
+				wait()
+				return resource.RetryableError(err)
+			}
+			return resource.NonRetryableError(err)
+		}
+		if debugOn() {
+			addDebug("UpdateLogstoreAlert", raw, requestInfo, map[string]interface{}{
+				"project":    parts[0],
+				"alert_name": parts[1],
+			})
+		}
+		return nil
+	}); err != nil {
+		return WrapErrorf(err, DefaultErrorMsg, d.Id(), "UpdateLogstoreAlert", AlibabacloudStackLogGoSdkERROR)
+	}
+
+	return resourceAlibabacloudStackLogAlertRead(ctx, d, meta)
+}
+
+func resourceAlibabacloudStackLogAlertDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*connectivity.AlibabacloudStackClient)
+	logService := LogService{client}
+	parts, err := ParseResourceId(d.Id(), 2)
+	if err != nil {
+		return WrapError(err)
+	}
+	var requestInfo *sls.Client
+	wait := incrementalWait(3*time.Second, 3*time.Second)
+	err = resource.RetryContext(ctx, 3*time.Minute, func() *resource.RetryError {
+		raw, err := client.WithLogClient(func(slsClient *sls.Client) (interface{}, error) {
+			requestInfo = slsClient
+			return nil, slsClient.DeleteAlert(parts[0], parts[1])
+		})
+		if err != nil {
+			if IsExpectedErrors(err, []string{"InternalServerError", LogClientTimeout}) {
+				wait()
+				return resource.RetryableError(err)
+			}
+			return resource.NonRetryableError(err)
+		}
+		if debugOn() {
+			addDebug("DeleteLogstoreAlert", raw, requestInfo, map[string]interface{}{
+				"project":    parts[0],
+				"alert_name": parts[1],
+			})
+		}
+		return nil
+	})
+	if err != nil {
+		if IsExpectedErrors(err, []string{"ProjectNotExist", "JobNotExist"}) {
+			return nil
+		}
+		return WrapErrorf(err, DefaultErrorMsg, d.Id(), "DeleteLogstoreAlert", AlibabacloudStackLogGoSdkERROR)
+	}
+	return WrapError(logService.WaitForLogstoreAlert(d.Id(), Deleted, DefaultTimeout))
+}
+
+// checkLogAlertDashboard returns an error when the dashboard of the alert does not exist. The dashboard is not managed
+// by the alert, so it is created by alibabacloudstack_log_dashboard or outside of terraform.
+func checkLogAlertDashboard(logService LogService, project, dashboard string) error {
+	if _, err := logService.DescribeLogDashboard(project + COLON_SEPARATED + dashboard); err != nil {
+		if NotFoundError(err) {
+			return WrapError(Error("The dashboard %s does not exist in the project %s, and it must be created before the alert.", dashboard, project))
+		}
+		return WrapError(err)
+	}
+	return nil
+}
+
+// buildLogAlert builds the alert of the config, the chart titles of the queries are resolved from the display names
+// of the charts of the dashboard.
+func buildLogAlert(d *schema.ResourceData, slsClient *sls.Client) *sls.Alert {
+	projectName := d.Get("project_name").(string)
+	dashboard := d.Get("dashboard").(string)
+
+	var queries []*sls.AlertQuery
+	for _, v := range d.Get("query_list").([]interface{}) {
+		query := v.(map[string]interface{})
+		queries = append(queries, &sls.AlertQuery{
+			ChartTitle:   GetCharTitile(projectName, dashboard, query["chart_title"].(string), slsClient),
+			LogStore:     query["logstore"].(string),
+			Query:        query["query"].(string),
+			Start:        query["start"].(string),
+			End:          query["end"].(string),
+			TimeSpanType: query["time_span_type"].(string),
+		})
+	}
+	var notifications []*sls.Notification
+	for _, v := range d.Get("notification_list").([]interface{}) {
+		notification := v.(map[string]interface{})
+		notifications = append(notifications, &sls.Notification{
+			Type:       notification["type"].(string),
+			Content:    notification["content"].(string),
+			ServiceUri: notification["service_uri"].(string),
+			MobileList: expandStringList(notification["mobile_list"].([]interface{})),
+			EmailList:  expandStringList(notification["email_list"].([]interface{})),
+		})
+	}
+
+	return &sls.Alert{
+		Name:        d.Get("alert_name").(string),
+		DisplayName: d.Get("alert_displayname").(string),
+		Description: d.Get("alert_description").(string),
+		State:       "Enabled",
+		Configuration: &sls.AlertConfiguration{
+			Condition:        d.Get("condition").(string),
+			Dashboard:        dashboard,
+			QueryList:        queries,
+			MuteUntil:        int64(d.Get("mute_until").(int)),
+			NotificationList: notifications,
+			NotifyThreshold:  int32(d.Get("notify_threshold").(int)),
+			Throttling:       d.Get("throttling").(string),
+		},
+		Schedule: &sls.Schedule{
+			Type:           d.Get("schedule_type").(string),
+			Interval:       d.Get("schedule_interval").(string),
+			CronExpression: d.Get("schedule_cron_expression").(string),
+			Hour:           int32(d.Get("schedule_hour").(int)),
+			DayOfWeek:      int32(d.Get("schedule_day_of_week").(int)),
+		},
+	}
+}
@@ -0,0 +1,156 @@
+package alibabacloudstack
+
+import (
+	"fmt"
+	"testing"
+
+	sls "github.com/aliyun/aliyun-log-go-sdk"
+	"github.com/aliyun/terraform-provider-alibabacloudstack/alibabacloudstack/connectivity"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccAlibabacloudStackLogAlert_basic(t *testing.T) {
+	var v *sls.Alert
+	resourceId := "alibabacloudstack_log_alert.default"
+	ra := resourceAttrInit(resourceId, logAlertMap)
+	serviceFunc := func() interface{} {
+		return &LogService{testAccProvider.Meta().(*connectivity.AlibabacloudStackClient)}
+	}
+	rc := resourceCheckInit(resourceId, &v, serviceFunc)
+	rac := resourceAttrCheckInit(rc, ra)
+	testAccCheck := rac.resourceAttrMapUpdateSet()
+	rand := acctest.RandIntRange(1000000, 9999999)
+	name := fmt.Sprintf("tf-testacclogalert-%d", rand)
+	testAccConfig := resourceTestAccConfigFunc(resourceId, name, resourceLogAlertConfigDependence)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+		},
+		IDRefreshName: resourceId,
+		Providers:     testAccProviders,
+		CheckDestroy:  rac.checkResourceDestroy(),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccConfig(map[string]interface{}{
+					"project_name":      "${alibabacloudstack_log_project.default.name}",
+					"alert_name":        "alert_name",
+					"alert_displayname": name,
+					"condition":         "count> 100",
+					"dashboard":         "${alibabacloudstack_log_dashboard.default.dashboard_name}",
+					"query_list": []map[string]interface{}{
+						{
+							"logstore":    "${alibabacloudstack_log_store.default.name}",
+							"chart_title": "chart_title",
+							"start":       "-60s",
+							"end":         "20s",
+							"query":       "* AND aliyun",
+						},
+					},
+					"notification_list": []map[string]interface{}{
+						{
+							"type":        "SMS",
+							"mobile_list": []string{"12345678", "87654321"},
+							"content":     "alert content",
+						},
+					},
+				}),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheck(map[string]string{
+						"alert_name":          "alert_name",
+						"alert_displayname":   name,
+						"condition":           "count> 100",
+						"query_list.#":        "1",
+						"notification_list.#": "1",
+					}),
+				),
+			},
+			{
+				ResourceName:      resourceId,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccConfig(map[string]interface{}{
+					"alert_displayname": name + "_update",
+					"alert_description": "update alert",
+					"condition":         "count> 1000",
+				}),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheck(map[string]string{
+						"alert_displayname": name + "_update",
+						"alert_description": "update alert",
+						"condition":         "count> 1000",
+					}),
+				),
+			},
+			{
+				Config: testAccConfig(map[string]interface{}{
+					"schedule_interval": "5m",
+					"throttling":        "10m",
+					"notify_threshold":  "2",
+				}),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheck(map[string]string{
+						"schedule_interval": "5m",
+						"throttling":        "10m",
+						"notify_threshold":  "2",
+					}),
+				),
+			},
+			{
+				Config: testAccConfig(map[string]interface{}{
+					"notification_list": []map[string]interface{}{
+						{
+							"type":       "Email",
+							"email_list": []string{"abc@test.com"},
+							"content":    "alert content",
+						},
+						{
+							"type":        "DingTalk",
+							"service_uri": "www.aliyun.com",
+							"content":     "alert content",
+						},
+					},
+				}),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheck(map[string]string{
+						"notification_list.#": "2",
+					}),
+				),
+			},
+		},
+	})
+}
+
+func resourceLogAlertConfigDependence(name string) string {
+	return fmt.Sprintf(`
+	variable "name" {
+	    default = "%s"
+	}
+	resource "alibabacloudstack_log_project" "default" {
+	    name = "${var.name}"
+	    description = "tf unit test"
+	}
+	resource "alibabacloudstack_log_store" "default" {
+	    project = "${alibabacloudstack_log_project.default.name}"
+	    name = "${var.name}"
+	    retention_period = "3000"
+	    shard_count = 1
+	}
+	resource "alibabacloudstack_log_dashboard" "default" {
+	    project_name = "${alibabacloudstack_log_project.default.name}"
+	    dashboard_name = "${var.name}"
+	    char_list = "[]"
+	}
+	`, name)
+}
+
+var logAlertMap = map[string]string{
+	"project_name":     CHECKSET,
+	"alert_name":       CHECKSET,
+	"schedule_type":    "FixedRate",
+	"throttling":       "60s",
+	"notify_threshold": "1",
+}
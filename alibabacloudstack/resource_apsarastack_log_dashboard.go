@@ -0,0 +1,219 @@
+package alibabacloudstack
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	sls "github.com/aliyun/aliyun-log-go-sdk"
+	"github.com/aliyun/terraform-provider-alibabacloudstack/alibabacloudstack/connectivity"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+func resourceAlibabacloudStackLogDashboard() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: withDiagnostics(resourceAlibabacloudStackLogDashboardCreate),
+		ReadContext:   withDiagnostics(resourceAlibabacloudStackLogDashboardRead),
+		UpdateContext: withDiagnostics(resourceAlibabacloudStackLogDashboardUpdate),
+		DeleteContext: withDiagnostics(resourceAlibabacloudStackLogDashboardDelete),
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"project_name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"dashboard_name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"display_name": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+			"description": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"char_list": {
+				Type:             schema.TypeString,
+				Required:         true,
+				ValidateFunc:     validation.StringIsJSON,
+				DiffSuppressFunc: logDashboardCharListDiffSuppressFunc,
+			},
+		},
+	}
+}
+
+func resourceAlibabacloudStackLogDashboardCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*connectivity.AlibabacloudStackClient)
+	logService := LogService{client}
+	projectName := d.Get("project_name").(string)
+	dashboard, err := buildLogDashboard(d)
+	if err != nil {
+		return WrapError(err)
+	}
+
+	var requestInfo *sls.Client
+	wait := incrementalWait(3*time.Second, 3*time.Second)
+	if err := resource.RetryContext(ctx, 2*time.Minute, func() *resource.RetryError {
+		raw, err := client.WithLogClient(func(slsClient *sls.Client) (interface{}, error) {
+			requestInfo = slsClient
+			return nil, slsClient.CreateDashboard(projectName, dashboard)
+		})
+		if err != nil {
+			if IsExpectedErrors(err, []string{"InternalServerError", LogClientTimeout}) {
+				wait()
+				return resource.RetryableError(err)
+			}
+			return resource.NonRetryableError(err)
+		}
+		if debugOn() {
+			addDebug("CreateLogDashboard", raw, requestInfo, map[string]interface{}{
+				"project":   projectName,
+				"dashboard": dashboard,
+			})
+		}
+		return nil
+	}); err != nil {
+		return WrapErrorf(err, DefaultErrorMsg, "alibabacloudstack_log_dashboard", "CreateLogDashboard", AlibabacloudStackLogGoSdkERROR)
+	}
+
+	d.SetId(fmt.Sprintf("%s%s%s", projectName, COLON_SEPARATED, dashboard.DashboardName))
+	if err := logService.WaitForLogDashboard(d.Id(), Running, DefaultTimeout); err != nil {
+		return WrapError(err)
+	}
+
+	return resourceAlibabacloudStackLogDashboardRead(ctx, d, meta)
+}
+
+func resourceAlibabacloudStackLogDashboardRead(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*connectivity.AlibabacloudStackClient)
+	logService := LogService{client}
+	parts, err := ParseResourceId(d.Id(), 2)
+	if err != nil {
+		return WrapError(err)
+	}
+	object, err := logService.DescribeLogDashboard(d.Id())
+	if err != nil {
+		if NotFoundError(err) {
+			d.SetId("")
+			return nil
+		}
+		return WrapError(err)
+	}
+
+	d.Set("project_name", parts[0])
+	d.Set("dashboard_name", object.DashboardName)
+	d.Set("display_name", object.DisplayName)
+	d.Set("description", object.Description)
+	charts := object.ChartList
+	if charts == nil {
+		charts = []sls.Chart{}
+	}
+	charList, err := json.Marshal(charts)
+	if err != nil {
+		return WrapError(err)
+	}
+	d.Set("char_list", string(charList))
+	return nil
+}
+
+func resourceAlibabacloudStackLogDashboardUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*connectivity.AlibabacloudStackClient)
+	parts, err := ParseResourceId(d.Id(), 2)
+	if err != nil {
+		return WrapError(err)
+	}
+	if d.HasChanges("display_name", "description", "char_list") {
+		dashboard, err := buildLogDashboard(d)
+		if err != nil {
+			return WrapError(err)
+		}
+		var requestInfo *sls.Client
+		wait := incrementalWait(3*time.Second, 3*time.Second)
+		if err := resource.RetryContext(ctx, 2*time.Minute, func() *resource.RetryError {
+			raw, err := client.WithLogClient(func(slsClient *sls.Client) (interface{}, error) {
+				requestInfo = slsClient
+				return nil, slsClient.UpdateDashboard(parts[0], dashboard)
+			})
+			if err != nil {
+				if IsExpectedErrors(err, []string{"InternalServerError", LogClientTimeout}) {
+					wait()
+					return resource.RetryableError(err)
+				}
+				return resource.NonRetryableError(err)
+			}
+			if debugOn() {
+				addDebug("UpdateLogDashboard", raw, requestInfo, map[string]interface{}{
+					"project":   parts[0],
+					"dashboard": dashboard,
+				})
+			}
+			return nil
+		}); err != nil {
+			return WrapErrorf(err, DefaultErrorMsg, d.Id(), "UpdateLogDashboard", AlibabacloudStackLogGoSdkERROR)
+		}
+	}
+
+	return resourceAlibabacloudStackLogDashboardRead(ctx, d, meta)
+}
+
+func resourceAlibabacloudStackLogDashboardDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*connectivity.AlibabacloudStackClient)
+	logService := LogService{client}
+	parts, err := ParseResourceId(d.Id(), 2)
+	if err != nil {
+		return WrapError(err)
+	}
+	var requestInfo *sls.Client
+	wait := incrementalWait(3*time.Second, 3*time.Second)
+	err = resource.RetryContext(ctx, 3*time.Minute, func() *resource.RetryError {
+		raw, err := client.WithLogClient(func(slsClient *sls.Client) (interface{}, error) {
+			requestInfo = slsClient
+			return nil, slsClient.DeleteDashboard(parts[0], parts[1])
+		})
+		if err != nil {
+			if IsExpectedErrors(err, []string{"InternalServerError", LogClientTimeout}) {
+				wait()
+				return resource.RetryableError(err)
+			}
+			return resource.NonRetryableError(err)
+		}
+		if debugOn() {
+			addDebug("DeleteLogDashboard", raw, requestInfo, map[string]interface{}{
+				"project":        parts[0],
+				"dashboard_name": parts[1],
+			})
+		}
+		return nil
+	})
+	if err != nil {
+		if IsExpectedErrors(err, []string{"ProjectNotExist", "DashboardNotExist"}) {
+			return nil
+		}
+		return WrapErrorf(err, DefaultErrorMsg, d.Id(), "DeleteLogDashboard", AlibabacloudStackLogGoSdkERROR)
+	}
+	return WrapError(logService.WaitForLogDashboard(d.Id(), Deleted, DefaultTimeout))
+}
+
+func buildLogDashboard(d *schema.ResourceData) (sls.Dashboard, error) {
+	dashboard := sls.Dashboard{
+		DashboardName: d.Get("dashboard_name").(string),
+		DisplayName:   d.Get("display_name").(string),
+		Description:   d.Get("description").(string),
+		ChartList:     []sls.Chart{},
+	}
+	if err := json.Unmarshal([]byte(d.Get("char_list").(string)), &dashboard.ChartList); err != nil {
+		return dashboard, WrapError(err)
+	}
+	return dashboard, nil
+}
@@ -0,0 +1,98 @@
+package alibabacloudstack
+
+import (
+	"fmt"
+	"testing"
+
+	sls "github.com/aliyun/aliyun-log-go-sdk"
+	"github.com/aliyun/terraform-provider-alibabacloudstack/alibabacloudstack/connectivity"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccAlibabacloudStackLogDashboard_basic(t *testing.T) {
+	var v *sls.Dashboard
+	resourceId := "alibabacloudstack_log_dashboard.default"
+	ra := resourceAttrInit(resourceId, logDashboardMap)
+	serviceFunc := func() interface{} {
+		return &LogService{testAccProvider.Meta().(*connectivity.AlibabacloudStackClient)}
+	}
+	rc := resourceCheckInit(resourceId, &v, serviceFunc)
+	rac := resourceAttrCheckInit(rc, ra)
+	testAccCheck := rac.resourceAttrMapUpdateSet()
+	rand := acctest.RandIntRange(1000000, 9999999)
+	name := fmt.Sprintf("tf-testacclogdashboard-%d", rand)
+	testAccConfig := resourceTestAccConfigFunc(resourceId, name, resourceLogDashboardConfigDependence)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+		},
+		IDRefreshName: resourceId,
+		Providers:     testAccProviders,
+		CheckDestroy:  rac.checkResourceDestroy(),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccConfig(map[string]interface{}{
+					"project_name":   "${alibabacloudstack_log_project.default.name}",
+					"dashboard_name": "dashboard_name",
+					"char_list":      `[{\"title\":\"new_title\",\"type\":\"map\",\"search\":{\"logstore\":\"` + name + `\",\"topic\":\"new_topic\",\"query\":\"* | SELECT COUNT(name) as ct_name, COUNT(product) as ct_product, name,product GROUP BY name,product\",\"start\":\"-86400s\",\"end\":\"now\"},\"display\":{\"xAxis\":[\"ct_name\"],\"yAxis\":[\"ct_product\"],\"xPos\":0,\"yPos\":0,\"width\":10,\"height\":12,\"displayName\":\"new_chart\"}}]`,
+				}),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheck(map[string]string{
+						"dashboard_name": "dashboard_name",
+						"char_list":      CHECKSET,
+					}),
+				),
+			},
+			{
+				ResourceName:      resourceId,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccConfig(map[string]interface{}{
+					"display_name": name,
+				}),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheck(map[string]string{
+						"display_name": name,
+					}),
+				),
+			},
+			{
+				Config: testAccConfig(map[string]interface{}{
+					"char_list": `[{\"title\":\"update_title\",\"type\":\"map\",\"search\":{\"logstore\":\"` + name + `\",\"topic\":\"new_topic\",\"query\":\"* | SELECT COUNT(name) as ct_name, name GROUP BY name\",\"start\":\"-86400s\",\"end\":\"now\"},\"display\":{\"xAxis\":[\"ct_name\"],\"yAxis\":[\"ct_name\"],\"xPos\":0,\"yPos\":0,\"width\":10,\"height\":12,\"displayName\":\"update\"}}]`,
+				}),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheck(map[string]string{
+						"char_list": CHECKSET,
+					}),
+				),
+			},
+		},
+	})
+}
+
+func resourceLogDashboardConfigDependence(name string) string {
+	return fmt.Sprintf(`
+	variable "name" {
+	    default = "%s"
+	}
+	resource "alibabacloudstack_log_project" "default" {
+	    name = "${var.name}"
+	    description = "tf unit test"
+	}
+	resource "alibabacloudstack_log_store" "default" {
+	    project = "${alibabacloudstack_log_project.default.name}"
+	    name = "${var.name}"
+	    retention_period = "3000"
+	    shard_count = 1
+	}
+	`, name)
+}
+
+var logDashboardMap = map[string]string{
+	"project_name":   CHECKSET,
+	"dashboard_name": CHECKSET,
+}
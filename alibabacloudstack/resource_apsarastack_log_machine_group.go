@@ -1,6 +1,7 @@
 package alibabacloudstack
 
 import (
+	"context"
 	"fmt"
 	"time"
 
@@ -14,12 +15,12 @@ import (
 
 func resourceAlibabacloudStackLogMachineGroup() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceAlibabacloudStackLogMachineGroupCreate,
-		Read:   resourceAlibabacloudStackLogMachineGroupRead,
-		Update: resourceAlibabacloudStackLogMachineGroupUpdate,
-		Delete: resourceAlibabacloudStackLogMachineGroupDelete,
+		CreateContext: withDiagnostics(resourceAlibabacloudStackLogMachineGroupCreate),
+		ReadContext:   withDiagnostics(resourceAlibabacloudStackLogMachineGroupRead),
+		UpdateContext: withDiagnostics(resourceAlibabacloudStackLogMachineGroupUpdate),
+		DeleteContext: withDiagnostics(resourceAlibabacloudStackLogMachineGroupDelete),
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			StateContext: schema.ImportStatePassthroughContext,
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -53,7 +54,7 @@ func resourceAlibabacloudStackLogMachineGroup() *schema.Resource {
 	}
 }
 
-func resourceAlibabacloudStackLogMachineGroupCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceAlibabacloudStackLogMachineGroupCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*connectivity.AlibabacloudStackClient)
 
 	params := &sls.MachineGroup{
@@ -65,7 +66,7 @@ func resourceAlibabacloudStackLogMachineGroupCreate(d *schema.ResourceData, meta
 		},
 	}
 	var requestInfo *sls.Client
-	if err := resource.Retry(2*time.Minute, func() *resource.RetryError {
+	if err := resource.RetryContext(ctx, 2*time.Minute, func() *resource.RetryError {
 		raw, err := client.WithLogClient(func(slsClient *sls.Client) (interface{}, error) {
 			requestInfo = slsClient
 			return nil, slsClient.CreateMachineGroup(d.Get("project").(string), params)
@@ -90,10 +91,10 @@ func resourceAlibabacloudStackLogMachineGroupCreate(d *schema.ResourceData, meta
 
 	d.SetId(fmt.Sprintf("%s%s%s", d.Get("project").(string), COLON_SEPARATED, d.Get("name").(string)))
 
-	return resourceAlibabacloudStackLogMachineGroupRead(d, meta)
+	return resourceAlibabacloudStackLogMachineGroupRead(ctx, d, meta)
 }
 
-func resourceAlibabacloudStackLogMachineGroupRead(d *schema.ResourceData, meta interface{}) error {
+func resourceAlibabacloudStackLogMachineGroupRead(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 	wiatSecondsIfWithTest(1)
 	client := meta.(*connectivity.AlibabacloudStackClient)
 	logService := LogService{client}
@@ -119,7 +120,7 @@ func resourceAlibabacloudStackLogMachineGroupRead(d *schema.ResourceData, meta i
 	return nil
 }
 
-func resourceAlibabacloudStackLogMachineGroupUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceAlibabacloudStackLogMachineGroupUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 	if d.HasChange("identify_type") || d.HasChange("identify_list") || d.HasChange("topic") {
 		parts, err := ParseResourceId(d.Id(), 2)
 		if err != nil {
@@ -136,7 +137,7 @@ func resourceAlibabacloudStackLogMachineGroupUpdate(d *schema.ResourceData, meta
 				TopicName: d.Get("topic").(string),
 			},
 		}
-		if err := resource.Retry(2*time.Minute, func() *resource.RetryError {
+		if err := resource.RetryContext(ctx, 2*time.Minute, func() *resource.RetryError {
 			raw, err := client.WithLogClient(func(slsClient *sls.Client) (interface{}, error) {
 				requestInfo = slsClient
 				return nil, slsClient.UpdateMachineGroup(parts[0], params)
@@ -160,10 +161,10 @@ func resourceAlibabacloudStackLogMachineGroupUpdate(d *schema.ResourceData, meta
 		}
 	}
 
-	return resourceAlibabacloudStackLogMachineGroupRead(d, meta)
+	return resourceAlibabacloudStackLogMachineGroupRead(ctx, d, meta)
 }
 
-func resourceAlibabacloudStackLogMachineGroupDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceAlibabacloudStackLogMachineGroupDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*connectivity.AlibabacloudStackClient)
 	logService := LogService{client}
 	parts, err := ParseResourceId(d.Id(), 2)
@@ -171,7 +172,7 @@ func resourceAlibabacloudStackLogMachineGroupDelete(d *schema.ResourceData, meta
 		return WrapError(err)
 	}
 	var requestInfo *sls.Client
-	err = resource.Retry(3*time.Minute, func() *resource.RetryError {
+	err = resource.RetryContext(ctx, 3*time.Minute, func() *resource.RetryError {
 		raw, err := client.WithLogClient(func(slsClient *sls.Client) (interface{}, error) {
 			requestInfo = slsClient
 			return nil, slsClient.DeleteMachineGroup(parts[0], parts[1])
@@ -1,6 +1,7 @@
 package alibabacloudstack
 
 import (
+	"context"
 	"github.com/aliyun/alibaba-cloud-sdk-go/sdk/requests"
 	"github.com/aliyun/alibaba-cloud-sdk-go/services/ecs"
 	"strings"
@@ -15,12 +16,12 @@ import (
 
 func resourceAlibabacloudStackLogProject() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceAlibabacloudStackLogProjectCreate,
-		Read:   resourceAlibabacloudStackLogProjectRead,
-		Update: resourceAlibabacloudStackLogProjectUpdate,
-		Delete: resourceAlibabacloudStackLogProjectDelete,
+		CreateContext: withDiagnostics(resourceAlibabacloudStackLogProjectCreate),
+		ReadContext:   withDiagnostics(resourceAlibabacloudStackLogProjectRead),
+		UpdateContext: withDiagnostics(resourceAlibabacloudStackLogProjectUpdate),
+		DeleteContext: withDiagnostics(resourceAlibabacloudStackLogProjectDelete),
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			StateContext: schema.ImportStatePassthroughContext,
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -37,7 +38,7 @@ func resourceAlibabacloudStackLogProject() *schema.Resource {
 	}
 }
 
-func resourceAlibabacloudStackLogProjectCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceAlibabacloudStackLogProjectCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*connectivity.AlibabacloudStackClient)
 	logService := LogService{client}
 	name := d.Get("name").(string)
@@ -73,7 +74,7 @@ func resourceAlibabacloudStackLogProjectCreate(d *schema.ResourceData, meta inte
 	}
 	addDebug("LogProject", raw)
 
-	err = resource.Retry(2*time.Minute, func() *resource.RetryError {
+	err = resource.RetryContext(ctx, 2*time.Minute, func() *resource.RetryError {
 		object, err := logService.DescribeLogProject(name)
 		if err != nil {
 			return resource.NonRetryableError(err)
@@ -84,10 +85,10 @@ func resourceAlibabacloudStackLogProjectCreate(d *schema.ResourceData, meta inte
 		return resource.RetryableError(Error("Failed to describe log project"))
 	})
 	d.SetId(name)
-	return resourceAlibabacloudStackLogProjectRead(d, meta)
+	return resourceAlibabacloudStackLogProjectRead(ctx, d, meta)
 }
 
-func resourceAlibabacloudStackLogProjectRead(d *schema.ResourceData, meta interface{}) error {
+func resourceAlibabacloudStackLogProjectRead(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 	wiatSecondsIfWithTest(1)
 	client := meta.(*connectivity.AlibabacloudStackClient)
 	logService := LogService{client}
@@ -105,7 +106,7 @@ func resourceAlibabacloudStackLogProjectRead(d *schema.ResourceData, meta interf
 	return nil
 }
 
-func resourceAlibabacloudStackLogProjectUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceAlibabacloudStackLogProjectUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*connectivity.AlibabacloudStackClient)
 	var requestInfo *sls.Client
 
@@ -147,10 +148,10 @@ func resourceAlibabacloudStackLogProjectUpdate(d *schema.ResourceData, meta inte
 		addDebug("UpdateProject", raw, requestInfo, request)
 	}
 
-	return resourceAlibabacloudStackLogProjectRead(d, meta)
+	return resourceAlibabacloudStackLogProjectRead(ctx, d, meta)
 }
 
-func resourceAlibabacloudStackLogProjectDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceAlibabacloudStackLogProjectDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*connectivity.AlibabacloudStackClient)
 	var requestInfo *sls.Client
 	name := d.Get("name").(string)
@@ -179,7 +180,7 @@ func resourceAlibabacloudStackLogProjectDelete(d *schema.ResourceData, meta inte
 		"Version":         "2020-03-31",
 		"ProjectName":     name,
 	}
-	err := resource.Retry(3*time.Minute, func() *resource.RetryError {
+	err := resource.RetryContext(ctx, 3*time.Minute, func() *resource.RetryError {
 		raw, err := client.WithEcsClient(func(slsClient *ecs.Client) (interface{}, error) {
 			return slsClient.ProcessCommonRequest(request)
 		})
@@ -1,6 +1,7 @@
 package alibabacloudstack
 
 import (
+	"context"
 	"fmt"
 	"time"
 
@@ -15,12 +16,12 @@ import (
 
 func resourceAlibabacloudStackLogStore() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceAlibabacloudStackLogStoreCreate,
-		Read:   resourceAlibabacloudStackLogStoreRead,
-		Update: resourceAlibabacloudStackLogStoreUpdate,
-		Delete: resourceAlibabacloudStackLogStoreDelete,
+		CreateContext: withDiagnostics(resourceAlibabacloudStackLogStoreCreate),
+		ReadContext:   withDiagnostics(resourceAlibabacloudStackLogStoreRead),
+		UpdateContext: withDiagnostics(resourceAlibabacloudStackLogStoreUpdate),
+		DeleteContext: withDiagnostics(resourceAlibabacloudStackLogStoreDelete),
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			StateContext: schema.ImportStatePassthroughContext,
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -103,7 +104,7 @@ func resourceAlibabacloudStackLogStore() *schema.Resource {
 	}
 }
 
-func resourceAlibabacloudStackLogStoreCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceAlibabacloudStackLogStoreCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*connectivity.AlibabacloudStackClient)
 	update := false
 	if v, ok := d.GetOk("encryption"); ok {
@@ -134,7 +135,7 @@ func resourceAlibabacloudStackLogStoreCreate(d *schema.ResourceData, meta interf
 				Encrypt_type: "sm4_gcm",
 			},
 		}
-		err := resource.Retry(3*time.Minute, func() *resource.RetryError {
+		err := resource.RetryContext(ctx, 3*time.Minute, func() *resource.RetryError {
 
 			raw, err := client.WithLogClient(func(slsClient *sls.Client) (interface{}, error) {
 				requestinfo = slsClient
@@ -159,7 +160,7 @@ func resourceAlibabacloudStackLogStoreCreate(d *schema.ResourceData, meta interf
 		}
 		d.SetId(fmt.Sprintf("%s%s%s", d.Get("project").(string), COLON_SEPARATED, d.Get("name").(string)))
 	}
-	return resourceAlibabacloudStackLogStoreUpdate(d, meta)
+	return resourceAlibabacloudStackLogStoreUpdate(ctx, d, meta)
 }
 
 //err := resource.Retry(3*time.Minute, func() *resource.RetryError {
@@ -189,7 +190,7 @@ func resourceAlibabacloudStackLogStoreCreate(d *schema.ResourceData, meta interf
 //
 //return resourceAlibabacloudStackLogStoreUpdate(d, meta)
 
-func resourceAlibabacloudStackLogStoreRead(d *schema.ResourceData, meta interface{}) error {
+func resourceAlibabacloudStackLogStoreRead(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 	wiatSecondsIfWithTest(1)
 	client := meta.(*connectivity.AlibabacloudStackClient)
 	logService := LogService{client}
@@ -211,7 +212,7 @@ func resourceAlibabacloudStackLogStoreRead(d *schema.ResourceData, meta interfac
 	d.Set("retention_period", object.TTL)
 	d.Set("shard_count", object.ShardCount)
 	var shards []*sls.Shard
-	err = resource.Retry(2*time.Minute, func() *resource.RetryError {
+	err = resource.RetryContext(ctx, 2*time.Minute, func() *resource.RetryError {
 		shards, err = object.ListShards()
 		if err != nil {
 			if IsExpectedErrors(err, []string{"InternalServerError"}) {
@@ -244,12 +245,12 @@ func resourceAlibabacloudStackLogStoreRead(d *schema.ResourceData, meta interfac
 	return nil
 }
 
-func resourceAlibabacloudStackLogStoreUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceAlibabacloudStackLogStoreUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*connectivity.AlibabacloudStackClient)
 	logService := LogService{client}
 
 	if d.IsNewResource() {
-		return resourceAlibabacloudStackLogStoreRead(d, meta)
+		return resourceAlibabacloudStackLogStoreRead(ctx, d, meta)
 	}
 
 	parts, err := ParseResourceId(d.Id(), 2)
@@ -307,10 +308,10 @@ func resourceAlibabacloudStackLogStoreUpdate(d *schema.ResourceData, meta interf
 	}
 	d.Partial(false)
 
-	return resourceAlibabacloudStackLogStoreRead(d, meta)
+	return resourceAlibabacloudStackLogStoreRead(ctx, d, meta)
 }
 
-func resourceAlibabacloudStackLogStoreDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceAlibabacloudStackLogStoreDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 
 	return nil
 }
@@ -1,6 +1,7 @@
 package alibabacloudstack
 
 import (
+	"context"
 	"fmt"
 
 	sls "github.com/aliyun/aliyun-log-go-sdk"
@@ -10,11 +11,11 @@ import (
 
 func resourceAlibabacloudStackLogtailAttachment() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceAlibabacloudStackLogtailAttachmentCreate,
-		Read:   resourceAlibabacloudStackLogtailAttachmentRead,
-		Delete: resourceAlibabacloudStackLogtailAttachmentDelete,
+		CreateContext: withDiagnostics(resourceAlibabacloudStackLogtailAttachmentCreate),
+		ReadContext:   withDiagnostics(resourceAlibabacloudStackLogtailAttachmentRead),
+		DeleteContext: withDiagnostics(resourceAlibabacloudStackLogtailAttachmentDelete),
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			StateContext: schema.ImportStatePassthroughContext,
 		},
 		Schema: map[string]*schema.Schema{
 			"project": {
@@ -36,7 +37,7 @@ func resourceAlibabacloudStackLogtailAttachment() *schema.Resource {
 	}
 }
 
-func resourceAlibabacloudStackLogtailAttachmentCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceAlibabacloudStackLogtailAttachmentCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*connectivity.AlibabacloudStackClient)
 	project := d.Get("project").(string)
 	config_name := d.Get("logtail_config_name").(string)
@@ -57,10 +58,10 @@ func resourceAlibabacloudStackLogtailAttachmentCreate(d *schema.ResourceData, me
 		})
 	}
 	d.SetId(fmt.Sprintf("%s%s%s%s%s", project, COLON_SEPARATED, config_name, COLON_SEPARATED, group_name))
-	return resourceAlibabacloudStackLogtailAttachmentRead(d, meta)
+	return resourceAlibabacloudStackLogtailAttachmentRead(ctx, d, meta)
 }
 
-func resourceAlibabacloudStackLogtailAttachmentRead(d *schema.ResourceData, meta interface{}) error {
+func resourceAlibabacloudStackLogtailAttachmentRead(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 	wiatSecondsIfWithTest(1)
 	client := meta.(*connectivity.AlibabacloudStackClient)
 	logService := LogService{client}
@@ -84,7 +85,7 @@ func resourceAlibabacloudStackLogtailAttachmentRead(d *schema.ResourceData, meta
 	return nil
 }
 
-func resourceAlibabacloudStackLogtailAttachmentDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceAlibabacloudStackLogtailAttachmentDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*connectivity.AlibabacloudStackClient)
 	logService := LogService{client}
 	parts, err := ParseResourceId(d.Id(), 3)
@@ -1,6 +1,7 @@
 package alibabacloudstack
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
 	"log"
@@ -15,11 +16,11 @@ import (
 
 func resourceAlibabacloudStackMaxcomputeCu() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceAlibabacloudStackMaxcomputeCuCreate,
-		Read:   resourceAlibabacloudStackMaxcomputeCuRead,
-		Delete: resourceAlibabacloudStackMaxcomputeCuDelete,
+		CreateContext: withDiagnostics(resourceAlibabacloudStackMaxcomputeCuCreate),
+		ReadContext:   withDiagnostics(resourceAlibabacloudStackMaxcomputeCuRead),
+		DeleteContext: withDiagnostics(resourceAlibabacloudStackMaxcomputeCuDelete),
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			StateContext: schema.ImportStatePassthroughContext,
 		},
 		Timeouts: &schema.ResourceTimeout{
 			Delete: schema.DefaultTimeout(2 * time.Minute),
@@ -51,7 +52,7 @@ func resourceAlibabacloudStackMaxcomputeCu() *schema.Resource {
 	}
 }
 
-func resourceAlibabacloudStackMaxcomputeCuCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceAlibabacloudStackMaxcomputeCuCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*connectivity.AlibabacloudStackClient)
 	var response map[string]interface{}
 	action := "CreateUpdateOdpsCu"
@@ -72,7 +73,7 @@ func resourceAlibabacloudStackMaxcomputeCuCreate(d *schema.ResourceData, meta in
 	request["Share"] = "0"
 	request["Product"] = product
 	wait := incrementalWait(3*time.Second, 3*time.Second)
-	err = resource.Retry(d.Timeout(schema.TimeoutCreate), func() *resource.RetryError {
+	err = resource.RetryContext(ctx, d.Timeout(schema.TimeoutCreate), func() *resource.RetryError {
 		response, err = conn.DoRequest(StringPointer(action), nil, StringPointer("POST"), StringPointer("2019-05-10"), StringPointer("AK"), nil, request, &util.RuntimeOptions{})
 		if err != nil {
 			if NeedRetry(err) {
@@ -93,10 +94,10 @@ func resourceAlibabacloudStackMaxcomputeCuCreate(d *schema.ResourceData, meta in
 
 	d.Set("cu_name", request["CuName"])
 
-	return resourceAlibabacloudStackMaxcomputeCuRead(d, meta)
+	return resourceAlibabacloudStackMaxcomputeCuRead(ctx, d, meta)
 }
 
-func resourceAlibabacloudStackMaxcomputeCuRead(d *schema.ResourceData, meta interface{}) error {
+func resourceAlibabacloudStackMaxcomputeCuRead(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*connectivity.AlibabacloudStackClient)
 	maxcomputeService := MaxcomputeService{client}
 	object, err := maxcomputeService.DescribeMaxcomputeCu(d.Get("cu_name").(string))
@@ -132,7 +133,7 @@ func resourceAlibabacloudStackMaxcomputeCuRead(d *schema.ResourceData, meta inte
 	}
 	return nil
 }
-func resourceAlibabacloudStackMaxcomputeCuDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceAlibabacloudStackMaxcomputeCuDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*connectivity.AlibabacloudStackClient)
 	action := "DeleteOdpsCu"
 	var response map[string]interface{}
@@ -150,7 +151,7 @@ func resourceAlibabacloudStackMaxcomputeCuDelete(d *schema.ResourceData, meta in
 	}
 
 	wait := incrementalWait(3*time.Second, 10*time.Second)
-	err = resource.Retry(d.Timeout(schema.TimeoutDelete), func() *resource.RetryError {
+	err = resource.RetryContext(ctx, d.Timeout(schema.TimeoutDelete), func() *resource.RetryError {
 		response, err = conn.DoRequest(StringPointer(action), nil, StringPointer("POST"), StringPointer("2019-05-10"), StringPointer("AK"), nil, request, &util.RuntimeOptions{})
 		if err != nil {
 			if IsExpectedErrors(err, []string{"500"}) || NeedRetry(err) {
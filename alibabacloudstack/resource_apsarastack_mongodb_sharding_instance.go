@@ -1,6 +1,7 @@
 package alibabacloudstack
 
 import (
+	"context"
 	"strconv"
 	"strings"
 	"time"
@@ -16,12 +17,12 @@ import (
 
 func resourceAlibabacloudStackMongoDBShardingInstance() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceAlibabacloudStackMongoDBShardingInstanceCreate,
-		Read:   resourceAlibabacloudStackMongoDBShardingInstanceRead,
-		Update: resourceAlibabacloudStackMongoDBShardingInstanceUpdate,
-		Delete: resourceAlibabacloudStackMongoDBShardingInstanceDelete,
+		CreateContext: withDiagnostics(resourceAlibabacloudStackMongoDBShardingInstanceCreate),
+		ReadContext:   withDiagnostics(resourceAlibabacloudStackMongoDBShardingInstanceRead),
+		UpdateContext: withDiagnostics(resourceAlibabacloudStackMongoDBShardingInstanceUpdate),
+		DeleteContext: withDiagnostics(resourceAlibabacloudStackMongoDBShardingInstanceDelete),
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			StateContext: schema.ImportStatePassthroughContext,
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -265,7 +266,7 @@ func buildMongoDBShardingCreateRequest(d *schema.ResourceData, meta interface{})
 	return request, nil
 }
 
-func resourceAlibabacloudStackMongoDBShardingInstanceCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceAlibabacloudStackMongoDBShardingInstanceCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*connectivity.AlibabacloudStackClient)
 	ddsService := MongoDBService{client}
 
@@ -291,10 +292,10 @@ func resourceAlibabacloudStackMongoDBShardingInstanceCreate(d *schema.ResourceDa
 		return WrapError(err)
 	}
 
-	return resourceAlibabacloudStackMongoDBShardingInstanceUpdate(d, meta)
+	return resourceAlibabacloudStackMongoDBShardingInstanceUpdate(ctx, d, meta)
 }
 
-func resourceAlibabacloudStackMongoDBShardingInstanceRead(d *schema.ResourceData, meta interface{}) error {
+func resourceAlibabacloudStackMongoDBShardingInstanceRead(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 	wiatSecondsIfWithTest(1)
 	client := meta.(*connectivity.AlibabacloudStackClient)
 	ddsService := MongoDBService{client}
@@ -386,7 +387,7 @@ func resourceAlibabacloudStackMongoDBShardingInstanceRead(d *schema.ResourceData
 	return nil
 }
 
-func resourceAlibabacloudStackMongoDBShardingInstanceUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceAlibabacloudStackMongoDBShardingInstanceUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*connectivity.AlibabacloudStackClient)
 	ddsService := MongoDBService{client}
 	d.Partial(true)
@@ -436,7 +437,7 @@ func resourceAlibabacloudStackMongoDBShardingInstanceUpdate(d *schema.ResourceDa
 
 	if d.IsNewResource() {
 		d.Partial(false)
-		return resourceAlibabacloudStackMongoDBShardingInstanceRead(d, meta)
+		return resourceAlibabacloudStackMongoDBShardingInstanceRead(ctx, d, meta)
 	}
 
 	if d.HasChange("shard_list") {
@@ -513,10 +514,10 @@ func resourceAlibabacloudStackMongoDBShardingInstanceUpdate(d *schema.ResourceDa
 		//d.SetPartial("security_ip_list")
 	}
 	d.Partial(false)
-	return resourceAlibabacloudStackMongoDBShardingInstanceRead(d, meta)
+	return resourceAlibabacloudStackMongoDBShardingInstanceRead(ctx, d, meta)
 }
 
-func resourceAlibabacloudStackMongoDBShardingInstanceDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceAlibabacloudStackMongoDBShardingInstanceDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*connectivity.AlibabacloudStackClient)
 	ddsService := MongoDBService{client}
 
@@ -526,7 +527,7 @@ func resourceAlibabacloudStackMongoDBShardingInstanceDelete(d *schema.ResourceDa
 	request.QueryParams = map[string]string{"AccessKeySecret": client.SecretKey, "Product": "dds", "Department": client.Department, "ResourceGroup": client.ResourceGroup}
 	request.DBInstanceId = d.Id()
 
-	err := resource.Retry(10*5*time.Minute, func() *resource.RetryError {
+	err := resource.RetryContext(ctx, 10*5*time.Minute, func() *resource.RetryError {
 		raw, err := client.WithDdsClient(func(ddsClient *dds.Client) (interface{}, error) {
 			return ddsClient.DeleteDBInstance(request)
 		})
@@ -1,6 +1,7 @@
 package alibabacloudstack
 
 import (
+	"context"
 	"fmt"
 	"log"
 	"strings"
@@ -15,12 +16,12 @@ import (
 
 func resourceAlibabacloudStackNasAccessGroup() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceAlibabacloudStackNasAccessGroupCreate,
-		Read:   resourceAlibabacloudStackNasAccessGroupRead,
-		Update: resourceAlibabacloudStackNasAccessGroupUpdate,
-		Delete: resourceAlibabacloudStackNasAccessGroupDelete,
+		CreateContext: withDiagnostics(resourceAlibabacloudStackNasAccessGroupCreate),
+		ReadContext:   withDiagnostics(resourceAlibabacloudStackNasAccessGroupRead),
+		UpdateContext: withDiagnostics(resourceAlibabacloudStackNasAccessGroupUpdate),
+		DeleteContext: withDiagnostics(resourceAlibabacloudStackNasAccessGroupDelete),
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			StateContext: schema.ImportStatePassthroughContext,
 		},
 		Timeouts: &schema.ResourceTimeout{
 			Create: schema.DefaultTimeout(1 * time.Minute),
@@ -71,7 +72,7 @@ func resourceAlibabacloudStackNasAccessGroup() *schema.Resource {
 	}
 }
 
-func resourceAlibabacloudStackNasAccessGroupCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceAlibabacloudStackNasAccessGroupCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*connectivity.AlibabacloudStackClient)
 	var response map[string]interface{}
 	action := "CreateAccessGroup"
@@ -107,7 +108,7 @@ func resourceAlibabacloudStackNasAccessGroupCreate(d *schema.ResourceData, meta
 	request["Product"] = "Nas"
 	request["OrganizationId"] = client.Department
 	wait := incrementalWait(3*time.Second, 5*time.Second)
-	err = resource.Retry(d.Timeout(schema.TimeoutCreate), func() *resource.RetryError {
+	err = resource.RetryContext(ctx, d.Timeout(schema.TimeoutCreate), func() *resource.RetryError {
 		response, err = conn.DoRequest(StringPointer(action), nil, StringPointer("POST"), StringPointer("2017-06-26"), StringPointer("AK"), nil, request, &util.RuntimeOptions{})
 		if err != nil {
 			if IsExpectedErrors(err, []string{"InternalError", "ServiceTimeout", "ServiceUnavailable"}) || NeedRetry(err) {
@@ -125,9 +126,9 @@ func resourceAlibabacloudStackNasAccessGroupCreate(d *schema.ResourceData, meta
 
 	d.SetId(fmt.Sprint(response["AccessGroupName"], ":", request["FileSystemType"]))
 
-	return resourceAlibabacloudStackNasAccessGroupRead(d, meta)
+	return resourceAlibabacloudStackNasAccessGroupRead(ctx, d, meta)
 }
-func resourceAlibabacloudStackNasAccessGroupRead(d *schema.ResourceData, meta interface{}) error {
+func resourceAlibabacloudStackNasAccessGroupRead(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*connectivity.AlibabacloudStackClient)
 	nasService := NasService{client}
 	if len(strings.Split(d.Id(), ":")) != 2 {
@@ -154,7 +155,7 @@ func resourceAlibabacloudStackNasAccessGroupRead(d *schema.ResourceData, meta in
 	d.Set("description", object["Description"])
 	return nil
 }
-func resourceAlibabacloudStackNasAccessGroupUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceAlibabacloudStackNasAccessGroupUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*connectivity.AlibabacloudStackClient)
 	var response map[string]interface{}
 	if len(strings.Split(d.Id(), ":")) != 2 {
@@ -179,7 +180,7 @@ func resourceAlibabacloudStackNasAccessGroupUpdate(d *schema.ResourceData, meta
 			return WrapError(err)
 		}
 		wait := incrementalWait(3*time.Second, 3*time.Second)
-		err = resource.Retry(d.Timeout(schema.TimeoutUpdate), func() *resource.RetryError {
+		err = resource.RetryContext(ctx, d.Timeout(schema.TimeoutUpdate), func() *resource.RetryError {
 			response, err = conn.DoRequest(StringPointer(action), nil, StringPointer("POST"), StringPointer("2017-06-26"), StringPointer("AK"), nil, request, &util.RuntimeOptions{})
 			if err != nil {
 				if NeedRetry(err) {
@@ -195,9 +196,9 @@ func resourceAlibabacloudStackNasAccessGroupUpdate(d *schema.ResourceData, meta
 			return WrapErrorf(err, DefaultErrorMsg, d.Id(), action, AlibabacloudStackSdkGoERROR)
 		}
 	}
-	return resourceAlibabacloudStackNasAccessGroupRead(d, meta)
+	return resourceAlibabacloudStackNasAccessGroupRead(ctx, d, meta)
 }
-func resourceAlibabacloudStackNasAccessGroupDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceAlibabacloudStackNasAccessGroupDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*connectivity.AlibabacloudStackClient)
 	if len(strings.Split(d.Id(), ":")) != 2 {
 		d.SetId(fmt.Sprintf("%v:%v", d.Id(), "standard"))
@@ -220,7 +221,7 @@ func resourceAlibabacloudStackNasAccessGroupDelete(d *schema.ResourceData, meta
 	request["Product"] = "Nas"
 	request["OrganizationId"] = client.Department
 	wait := incrementalWait(3*time.Second, 3*time.Second)
-	err = resource.Retry(d.Timeout(schema.TimeoutDelete), func() *resource.RetryError {
+	err = resource.RetryContext(ctx, d.Timeout(schema.TimeoutDelete), func() *resource.RetryError {
 		response, err = conn.DoRequest(StringPointer(action), nil, StringPointer("POST"), StringPointer("2017-06-26"), StringPointer("AK"), nil, request, &util.RuntimeOptions{})
 		if err != nil {
 			if NeedRetry(err) {
@@ -1,6 +1,7 @@
 package alibabacloudstack
 
 import (
+	"context"
 	"fmt"
 	"log"
 	"time"
@@ -16,12 +17,12 @@ import (
 
 func resourceAlibabacloudStackNasAccessRule() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceAlibabacloudStackNasAccessRuleCreate,
-		Read:   resourceAlibabacloudStackNasAccessRuleRead,
-		Update: resourceAlibabacloudStackNasAccessRuleUpdate,
-		Delete: resourceAlibabacloudStackNasAccessRuleDelete,
+		CreateContext: withDiagnostics(resourceAlibabacloudStackNasAccessRuleCreate),
+		ReadContext:   withDiagnostics(resourceAlibabacloudStackNasAccessRuleRead),
+		UpdateContext: withDiagnostics(resourceAlibabacloudStackNasAccessRuleUpdate),
+		DeleteContext: withDiagnostics(resourceAlibabacloudStackNasAccessRuleDelete),
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			StateContext: schema.ImportStatePassthroughContext,
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -60,7 +61,7 @@ func resourceAlibabacloudStackNasAccessRule() *schema.Resource {
 	}
 }
 
-func resourceAlibabacloudStackNasAccessRuleCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceAlibabacloudStackNasAccessRuleCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*connectivity.AlibabacloudStackClient)
 	var response map[string]interface{}
 	action := "CreateAccessRule"
@@ -82,7 +83,7 @@ func resourceAlibabacloudStackNasAccessRuleCreate(d *schema.ResourceData, meta i
 	}
 	request["Priority"] = d.Get("priority")
 	wait := incrementalWait(3*time.Second, 3*time.Second)
-	err = resource.Retry(d.Timeout(schema.TimeoutCreate), func() *resource.RetryError {
+	err = resource.RetryContext(ctx, d.Timeout(schema.TimeoutCreate), func() *resource.RetryError {
 		response, err = conn.DoRequest(StringPointer(action), nil, StringPointer("POST"), StringPointer("2017-06-26"), StringPointer("AK"), nil, request, &util.RuntimeOptions{})
 		if err != nil {
 			if NeedRetry(err) {
@@ -98,10 +99,10 @@ func resourceAlibabacloudStackNasAccessRuleCreate(d *schema.ResourceData, meta i
 		return WrapErrorf(err, DefaultErrorMsg, "alibabacloudstack_nas_access_rule", action, AlibabacloudStackSdkGoERROR)
 	}
 	d.SetId(fmt.Sprint(request["AccessGroupName"], ":", response["AccessRuleId"]))
-	return resourceAlibabacloudStackNasAccessRuleRead(d, meta)
+	return resourceAlibabacloudStackNasAccessRuleRead(ctx, d, meta)
 }
 
-func resourceAlibabacloudStackNasAccessRuleUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceAlibabacloudStackNasAccessRuleUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*connectivity.AlibabacloudStackClient)
 	var response map[string]interface{}
 	parts, err := ParseResourceId(d.Id(), 2)
@@ -144,7 +145,7 @@ func resourceAlibabacloudStackNasAccessRuleUpdate(d *schema.ResourceData, meta i
 			return WrapError(err)
 		}
 		wait := incrementalWait(3*time.Second, 3*time.Second)
-		err = resource.Retry(d.Timeout(schema.TimeoutUpdate), func() *resource.RetryError {
+		err = resource.RetryContext(ctx, d.Timeout(schema.TimeoutUpdate), func() *resource.RetryError {
 			response, err = conn.DoRequest(StringPointer(action), nil, StringPointer("POST"), StringPointer("2017-06-26"), StringPointer("AK"), nil, request, &util.RuntimeOptions{})
 			if err != nil {
 				if NeedRetry(err) {
@@ -160,10 +161,10 @@ func resourceAlibabacloudStackNasAccessRuleUpdate(d *schema.ResourceData, meta i
 			return WrapErrorf(err, DefaultErrorMsg, d.Id(), action, AlibabacloudStackSdkGoERROR)
 		}
 	}
-	return resourceAlibabacloudStackNasAccessRuleRead(d, meta)
+	return resourceAlibabacloudStackNasAccessRuleRead(ctx, d, meta)
 }
 
-func resourceAlibabacloudStackNasAccessRuleRead(d *schema.ResourceData, meta interface{}) error {
+func resourceAlibabacloudStackNasAccessRuleRead(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*connectivity.AlibabacloudStackClient)
 	nasService := NasService{client}
 	object, err := nasService.DescribeNasAccessRule(d.Id())
@@ -189,7 +190,7 @@ func resourceAlibabacloudStackNasAccessRuleRead(d *schema.ResourceData, meta int
 	return nil
 }
 
-func resourceAlibabacloudStackNasAccessRuleDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceAlibabacloudStackNasAccessRuleDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*connectivity.AlibabacloudStackClient)
 	action := "DeleteAccessRule"
 	var response map[string]interface{}
@@ -210,7 +211,7 @@ func resourceAlibabacloudStackNasAccessRuleDelete(d *schema.ResourceData, meta i
 	request["Product"] = "Nas"
 	request["OrganizationId"] = client.Department
 	wait := incrementalWait(3*time.Second, 3*time.Second)
-	err = resource.Retry(d.Timeout(schema.TimeoutDelete), func() *resource.RetryError {
+	err = resource.RetryContext(ctx, d.Timeout(schema.TimeoutDelete), func() *resource.RetryError {
 		response, err = conn.DoRequest(StringPointer(action), nil, StringPointer("POST"), StringPointer("2017-06-26"), StringPointer("AK"), nil, request, &util.RuntimeOptions{})
 		if err != nil {
 			if NeedRetry(err) {
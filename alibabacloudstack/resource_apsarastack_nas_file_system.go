@@ -1,6 +1,7 @@
 package alibabacloudstack
 
 import (
+	"context"
 	"fmt"
 	"log"
 	"time"
@@ -14,12 +15,12 @@ import (
 
 func resourceAlibabacloudStackNasFileSystem() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceAlibabacloudStackNasFileSystemCreate,
-		Read:   resourceAlibabacloudStackNasFileSystemRead,
-		Update: resourceAlibabacloudStackNasFileSystemUpdate,
-		Delete: resourceAlibabacloudStackNasFileSystemDelete,
+		CreateContext: withDiagnostics(resourceAlibabacloudStackNasFileSystemCreate),
+		ReadContext:   withDiagnostics(resourceAlibabacloudStackNasFileSystemRead),
+		UpdateContext: withDiagnostics(resourceAlibabacloudStackNasFileSystemUpdate),
+		DeleteContext: withDiagnostics(resourceAlibabacloudStackNasFileSystemDelete),
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			StateContext: schema.ImportStatePassthroughContext,
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -78,11 +79,12 @@ func resourceAlibabacloudStackNasFileSystem() *schema.Resource {
 				Optional: true,
 				Computed: true,
 			},
+			"tags": tagsSchema(),
 		},
 	}
 }
 
-func resourceAlibabacloudStackNasFileSystemCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceAlibabacloudStackNasFileSystemCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*connectivity.AlibabacloudStackClient)
 	var response map[string]interface{}
 	action := "CreateFileSystem"
@@ -111,7 +113,7 @@ func resourceAlibabacloudStackNasFileSystemCreate(d *schema.ResourceData, meta i
 	}
 
 	wait := incrementalWait(3*time.Second, 3*time.Second)
-	err = resource.Retry(d.Timeout(schema.TimeoutCreate), func() *resource.RetryError {
+	err = resource.RetryContext(ctx, d.Timeout(schema.TimeoutCreate), func() *resource.RetryError {
 		response, err = conn.DoRequest(StringPointer(action), nil, StringPointer("POST"), StringPointer("2017-06-26"), StringPointer("AK"), nil, request, &util.RuntimeOptions{})
 		if err != nil {
 			if NeedRetry(err) && IsExpectedErrors(err, []string{InvalidFileSystemStatus_Ordering}) {
@@ -132,15 +134,19 @@ func resourceAlibabacloudStackNasFileSystemCreate(d *schema.ResourceData, meta i
 	if d.Get("file_system_type") == "extreme" {
 		nasService := NasService{client}
 		stateConf := BuildStateConf([]string{}, []string{"Running"}, d.Timeout(schema.TimeoutRead), 3*time.Second, nasService.DescribeNasFileSystemStateRefreshFunc(d.Id(), "Pending", []string{"Stopped", "Stopping", "Deleting"}))
-		if _, err := stateConf.WaitForState(); err != nil {
+		if _, err := stateConf.WaitForStateContext(ctx); err != nil {
 			return WrapErrorf(err, IdMsg, d.Id())
 		}
 	}
-	return resourceAlibabacloudStackNasFileSystemUpdate(d, meta)
+	return resourceAlibabacloudStackNasFileSystemUpdate(ctx, d, meta)
 }
 
-func resourceAlibabacloudStackNasFileSystemUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceAlibabacloudStackNasFileSystemUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*connectivity.AlibabacloudStackClient)
+	nasService := NasService{client}
+	if err := nasService.SetResourceTags(ctx, d, TagResourceFileSystem); err != nil {
+		return WrapError(err)
+	}
 	var response map[string]interface{}
 	request := map[string]interface{}{
 		"RegionId":     client.RegionId,
@@ -156,7 +162,7 @@ func resourceAlibabacloudStackNasFileSystemUpdate(d *schema.ResourceData, meta i
 			return WrapError(err)
 		}
 		wait := incrementalWait(3*time.Second, 3*time.Second)
-		err = resource.Retry(d.Timeout(schema.TimeoutUpdate), func() *resource.RetryError {
+		err = resource.RetryContext(ctx, d.Timeout(schema.TimeoutUpdate), func() *resource.RetryError {
 			response, err = conn.DoRequest(StringPointer(action), nil, StringPointer("POST"), StringPointer("2017-06-26"), StringPointer("AK"), nil, request, &util.RuntimeOptions{})
 			if err != nil {
 				if NeedRetry(err) && IsExpectedErrors(err, []string{InvalidFileSystemStatus_Ordering}) {
@@ -172,10 +178,10 @@ func resourceAlibabacloudStackNasFileSystemUpdate(d *schema.ResourceData, meta i
 			return WrapErrorf(err, DefaultErrorMsg, d.Id(), action, AlibabacloudStackSdkGoERROR)
 		}
 	}
-	return resourceAlibabacloudStackNasFileSystemRead(d, meta)
+	return resourceAlibabacloudStackNasFileSystemRead(ctx, d, meta)
 }
 
-func resourceAlibabacloudStackNasFileSystemRead(d *schema.ResourceData, meta interface{}) error {
+func resourceAlibabacloudStackNasFileSystemRead(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*connectivity.AlibabacloudStackClient)
 	nasService := NasService{client}
 	object, err := nasService.DescribeNasFileSystem(d.Id())
@@ -196,10 +202,16 @@ func resourceAlibabacloudStackNasFileSystemRead(d *schema.ResourceData, meta int
 	d.Set("capacity", object["Capacity"])
 	d.Set("zone_id", object["ZoneId"])
 	d.Set("kms_key_id", object["KMSKeyId"])
+
+	listTagResourcesObject, err := nasService.ListTagResources(ctx, d.Id(), TagResourceFileSystem)
+	if err != nil {
+		return WrapError(err)
+	}
+	d.Set("tags", tagsToMap(listTagResourcesObject))
 	return nil
 }
 
-func resourceAlibabacloudStackNasFileSystemDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceAlibabacloudStackNasFileSystemDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*connectivity.AlibabacloudStackClient)
 	action := "DeleteFileSystem"
 	var response map[string]interface{}
@@ -214,7 +226,7 @@ func resourceAlibabacloudStackNasFileSystemDelete(d *schema.ResourceData, meta i
 	request["Product"] = "Nas"
 	request["OrganizationId"] = client.Department
 	wait := incrementalWait(3*time.Second, 3*time.Second)
-	err = resource.Retry(d.Timeout(schema.TimeoutDelete), func() *resource.RetryError {
+	err = resource.RetryContext(ctx, d.Timeout(schema.TimeoutDelete), func() *resource.RetryError {
 		response, err = conn.DoRequest(StringPointer(action), nil, StringPointer("POST"), StringPointer("2017-06-26"), StringPointer("AK"), nil, request, &util.RuntimeOptions{})
 		if err != nil {
 			if NeedRetry(err) && IsExpectedErrors(err, []string{InvalidFileSystemStatus_Ordering}) {
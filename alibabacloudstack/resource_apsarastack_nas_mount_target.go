@@ -1,6 +1,7 @@
 package alibabacloudstack
 
 import (
+	"context"
 	"fmt"
 	"log"
 	"strings"
@@ -15,12 +16,12 @@ import (
 
 func resourceAlibabacloudStackNasMountTarget() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceAlibabacloudStackNasMountTargetCreate,
-		Read:   resourceAlibabacloudStackNasMountTargetRead,
-		Update: resourceAlibabacloudStackNasMountTargetUpdate,
-		Delete: resourceAlibabacloudStackNasMountTargetDelete,
+		CreateContext: withDiagnostics(resourceAlibabacloudStackNasMountTargetCreate),
+		ReadContext:   withDiagnostics(resourceAlibabacloudStackNasMountTargetRead),
+		UpdateContext: withDiagnostics(resourceAlibabacloudStackNasMountTargetUpdate),
+		DeleteContext: withDiagnostics(resourceAlibabacloudStackNasMountTargetDelete),
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			StateContext: schema.ImportStatePassthroughContext,
 		},
 		Timeouts: &schema.ResourceTimeout{
 			Create: schema.DefaultTimeout(5 * time.Minute),
@@ -55,7 +56,7 @@ func resourceAlibabacloudStackNasMountTarget() *schema.Resource {
 	}
 }
 
-func resourceAlibabacloudStackNasMountTargetCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceAlibabacloudStackNasMountTargetCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*connectivity.AlibabacloudStackClient)
 	nasService := NasService{client}
 	var response map[string]interface{}
@@ -89,7 +90,7 @@ func resourceAlibabacloudStackNasMountTargetCreate(d *schema.ResourceData, meta
 		request["VSwitchId"] = vswitchId
 	}
 	wait := incrementalWait(3*time.Second, 3*time.Second)
-	err = resource.Retry(d.Timeout(schema.TimeoutCreate), func() *resource.RetryError {
+	err = resource.RetryContext(ctx, d.Timeout(schema.TimeoutCreate), func() *resource.RetryError {
 		response, err = conn.DoRequest(StringPointer(action), nil, StringPointer("POST"), StringPointer("2017-06-26"), StringPointer("AK"), nil, request, &util.RuntimeOptions{})
 		if err != nil {
 			if NeedRetry(err) {
@@ -107,13 +108,13 @@ func resourceAlibabacloudStackNasMountTargetCreate(d *schema.ResourceData, meta
 
 	d.SetId(fmt.Sprint(request["FileSystemId"], ":", response["MountTargetDomain"]))
 	stateConf := BuildStateConf([]string{}, []string{"Active"}, d.Timeout(schema.TimeoutCreate), 10*time.Second, nasService.NasMountTargetStateRefreshFunc(d.Id(), []string{"Inactive"}))
-	if _, err := stateConf.WaitForState(); err != nil {
+	if _, err := stateConf.WaitForStateContext(ctx); err != nil {
 		return WrapErrorf(err, IdMsg, d.Id())
 	}
 
-	return resourceAlibabacloudStackNasMountTargetUpdate(d, meta)
+	return resourceAlibabacloudStackNasMountTargetUpdate(ctx, d, meta)
 }
-func resourceAlibabacloudStackNasMountTargetRead(d *schema.ResourceData, meta interface{}) error {
+func resourceAlibabacloudStackNasMountTargetRead(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*connectivity.AlibabacloudStackClient)
 	nasService := NasService{client}
 	if len(strings.Split(d.Id(), ":")) != 2 {
@@ -138,7 +139,7 @@ func resourceAlibabacloudStackNasMountTargetRead(d *schema.ResourceData, meta in
 	d.Set("vswitch_id", object["VswId"])
 	return nil
 }
-func resourceAlibabacloudStackNasMountTargetUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceAlibabacloudStackNasMountTargetUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*connectivity.AlibabacloudStackClient)
 	var response map[string]interface{}
 	if len(strings.Split(d.Id(), ":")) != 2 {
@@ -171,7 +172,7 @@ func resourceAlibabacloudStackNasMountTargetUpdate(d *schema.ResourceData, meta
 			return WrapError(err)
 		}
 		wait := incrementalWait(3*time.Second, 3*time.Second)
-		err = resource.Retry(d.Timeout(schema.TimeoutUpdate), func() *resource.RetryError {
+		err = resource.RetryContext(ctx, d.Timeout(schema.TimeoutUpdate), func() *resource.RetryError {
 			response, err = conn.DoRequest(StringPointer(action), nil, StringPointer("POST"), StringPointer("2017-06-26"), StringPointer("AK"), nil, request, &util.RuntimeOptions{})
 			if err != nil {
 				if NeedRetry(err) {
@@ -187,9 +188,9 @@ func resourceAlibabacloudStackNasMountTargetUpdate(d *schema.ResourceData, meta
 			return WrapErrorf(err, DefaultErrorMsg, d.Id(), action, AlibabacloudStackSdkGoERROR)
 		}
 	}
-	return resourceAlibabacloudStackNasMountTargetRead(d, meta)
+	return resourceAlibabacloudStackNasMountTargetRead(ctx, d, meta)
 }
-func resourceAlibabacloudStackNasMountTargetDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceAlibabacloudStackNasMountTargetDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*connectivity.AlibabacloudStackClient)
 	if len(strings.Split(d.Id(), ":")) != 2 {
 		d.SetId(fmt.Sprintf("%v:%v", strings.Split(d.Id(), "-")[0], d.Id()))
@@ -212,7 +213,7 @@ func resourceAlibabacloudStackNasMountTargetDelete(d *schema.ResourceData, meta
 	request["Product"] = "Nas"
 	request["OrganizationId"] = client.Department
 	wait := incrementalWait(3*time.Second, 3*time.Second)
-	err = resource.Retry(d.Timeout(schema.TimeoutDelete), func() *resource.RetryError {
+	err = resource.RetryContext(ctx, d.Timeout(schema.TimeoutDelete), func() *resource.RetryError {
 		response, err = conn.DoRequest(StringPointer(action), nil, StringPointer("POST"), StringPointer("2017-06-26"), StringPointer("AK"), nil, request, &util.RuntimeOptions{})
 		if err != nil {
 			if NeedRetry(err) {
@@ -1,6 +1,7 @@
 package alibabacloudstack
 
 import (
+	"context"
 	"strings"
 	"time"
 
@@ -16,12 +17,12 @@ import (
 
 func resourceAlibabacloudStackNatGateway() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceAlibabacloudStackNatGatewayCreate,
-		Read:   resourceAlibabacloudStackNatGatewayRead,
-		Update: resourceAlibabacloudStackNatGatewayUpdate,
-		Delete: resourceAlibabacloudStackNatGatewayDelete,
+		CreateContext: withDiagnostics(resourceAlibabacloudStackNatGatewayCreate),
+		ReadContext:   withDiagnostics(resourceAlibabacloudStackNatGatewayRead),
+		UpdateContext: withDiagnostics(resourceAlibabacloudStackNatGatewayUpdate),
+		DeleteContext: withDiagnostics(resourceAlibabacloudStackNatGatewayDelete),
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			StateContext: schema.ImportStatePassthroughContext,
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -87,11 +88,12 @@ func resourceAlibabacloudStackNatGateway() *schema.Resource {
 				MaxItems: 4,
 				Optional: true,
 			},
+			"tags": tagsSchema(),
 		},
 	}
 }
 
-func resourceAlibabacloudStackNatGatewayCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceAlibabacloudStackNatGatewayCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*connectivity.AlibabacloudStackClient)
 	vpcService := VpcService{client}
 
@@ -130,7 +132,7 @@ func resourceAlibabacloudStackNatGatewayCreate(d *schema.ResourceData, meta inte
 		request.Description = v.(string)
 	}
 
-	if err := resource.Retry(3*time.Minute, func() *resource.RetryError {
+	if err := resource.RetryContext(ctx, 3*time.Minute, func() *resource.RetryError {
 		args := *request
 		raw, err := client.WithVpcClient(func(vpcClient *vpc.Client) (interface{}, error) {
 			return vpcClient.CreateNatGateway(&args)
@@ -151,10 +153,13 @@ func resourceAlibabacloudStackNatGatewayCreate(d *schema.ResourceData, meta inte
 	if err := vpcService.WaitForNatGateway(d.Id(), Available, DefaultTimeout); err != nil {
 		return WrapError(err)
 	}
-	return resourceAlibabacloudStackNatGatewayRead(d, meta)
+	if err := vpcService.setInstanceTags(ctx, d, TagResourceNatGateway); err != nil {
+		return WrapError(err)
+	}
+	return resourceAlibabacloudStackNatGatewayRead(ctx, d, meta)
 }
 
-func resourceAlibabacloudStackNatGatewayRead(d *schema.ResourceData, meta interface{}) error {
+func resourceAlibabacloudStackNatGatewayRead(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 	wiatSecondsIfWithTest(1)
 	client := meta.(*connectivity.AlibabacloudStackClient)
 	vpcService := VpcService{client}
@@ -182,10 +187,15 @@ func resourceAlibabacloudStackNatGatewayRead(d *schema.ResourceData, meta interf
 		d.Set("bandwidth_packages", bindWidthPackages)
 	}
 
+	tags, err := vpcService.DescribeTags(ctx, d.Id(), nil, TagResourceNatGateway)
+	if err != nil {
+		return WrapError(err)
+	}
+	d.Set("tags", vpcService.tagsToMap(tags))
 	return nil
 }
 
-func resourceAlibabacloudStackNatGatewayUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceAlibabacloudStackNatGatewayUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 
 	client := meta.(*connectivity.AlibabacloudStackClient)
 	vpcService := VpcService{client}
@@ -196,6 +206,9 @@ func resourceAlibabacloudStackNatGatewayUpdate(d *schema.ResourceData, meta inte
 	}
 
 	d.Partial(true)
+	if err := vpcService.setInstanceTags(ctx, d, TagResourceNatGateway); err != nil {
+		return WrapError(err)
+	}
 	attributeUpdate := false
 	modifyNatGatewayAttributeRequest := vpc.CreateModifyNatGatewayAttributeRequest()
 	if strings.ToLower(client.Config.Protocol) == "https" {
@@ -271,13 +284,13 @@ func resourceAlibabacloudStackNatGatewayUpdate(d *schema.ResourceData, meta inte
 	if err := vpcService.WaitForNatGateway(d.Id(), Available, DefaultTimeout); err != nil {
 		return WrapError(err)
 	}
-	return resourceAlibabacloudStackNatGatewayRead(d, meta)
+	return resourceAlibabacloudStackNatGatewayRead(ctx, d, meta)
 }
 
-func resourceAlibabacloudStackNatGatewayDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceAlibabacloudStackNatGatewayDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*connectivity.AlibabacloudStackClient)
 	vpcService := VpcService{client}
-	err := deleteBandwidthPackages(d, meta)
+	err := deleteBandwidthPackages(ctx, d, meta)
 	if err != nil {
 		return WrapError(err)
 	}
@@ -291,7 +304,7 @@ func resourceAlibabacloudStackNatGatewayDelete(d *schema.ResourceData, meta inte
 	request.Headers = map[string]string{"RegionId": client.RegionId}
 	request.QueryParams = map[string]string{"AccessKeySecret": client.SecretKey, "Product": "vpc", "Department": client.Department, "ResourceGroup": client.ResourceGroup}
 	request.NatGatewayId = d.Id()
-	err = resource.Retry(5*time.Minute, func() *resource.RetryError {
+	err = resource.RetryContext(ctx, 5*time.Minute, func() *resource.RetryError {
 		request := vpc.CreateDeleteNatGatewayRequest()
 		if strings.ToLower(client.Config.Protocol) == "https" {
 			request.Scheme = "https"
@@ -323,7 +336,7 @@ func resourceAlibabacloudStackNatGatewayDelete(d *schema.ResourceData, meta inte
 	return WrapError(vpcService.WaitForNatGateway(d.Id(), Deleted, DefaultTimeoutMedium))
 }
 
-func deleteBandwidthPackages(d *schema.ResourceData, meta interface{}) error {
+func deleteBandwidthPackages(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*connectivity.AlibabacloudStackClient)
 	packRequest := vpc.CreateDescribeBandwidthPackagesRequest()
 	if strings.ToLower(client.Config.Protocol) == "https" {
@@ -335,7 +348,7 @@ func deleteBandwidthPackages(d *schema.ResourceData, meta interface{}) error {
 	packRequest.Headers = map[string]string{"RegionId": client.RegionId}
 	packRequest.QueryParams = map[string]string{"AccessKeySecret": client.SecretKey, "Product": "vpc", "Department": client.Department, "ResourceGroup": client.ResourceGroup}
 	packRequest.NatGatewayId = d.Id()
-	err := resource.Retry(5*time.Minute, func() *resource.RetryError {
+	err := resource.RetryContext(ctx, 5*time.Minute, func() *resource.RetryError {
 		raw, err := client.WithVpcClient(func(vpcClient *vpc.Client) (interface{}, error) {
 			return vpcClient.DescribeBandwidthPackages(packRequest)
 		})
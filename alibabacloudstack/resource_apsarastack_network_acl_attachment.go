@@ -1,6 +1,9 @@
 package alibabacloudstack
 
 import (
+	"context"
+	"fmt"
+	"strings"
 	"time"
 
 	"github.com/aliyun/alibaba-cloud-sdk-go/services/vpc"
@@ -11,10 +14,13 @@ import (
 
 func resourceAlibabacloudStackNetworkAclAttachment() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceAlibabacloudStackNetworkAclAttachmentCreate,
-		Read:   resourceAlibabacloudStackNetworkAclAttachmentRead,
-		Update: resourceAlibabacloudStackNetworkAclAttachmentUpdate,
-		Delete: resourceAlibabacloudStackNetworkAclAttachmentDelete,
+		CreateContext: withDiagnostics(resourceAlibabacloudStackNetworkAclAttachmentCreate),
+		ReadContext:   withDiagnostics(resourceAlibabacloudStackNetworkAclAttachmentRead),
+		UpdateContext: withDiagnostics(resourceAlibabacloudStackNetworkAclAttachmentUpdate),
+		DeleteContext: withDiagnostics(resourceAlibabacloudStackNetworkAclAttachmentDelete),
+		Importer: &schema.ResourceImporter{
+			StateContext: resourceAlibabacloudStackNetworkAclAttachmentImport,
+		},
 
 		Schema: map[string]*schema.Schema{
 
@@ -43,14 +49,44 @@ func resourceAlibabacloudStackNetworkAclAttachment() *schema.Resource {
 	}
 }
 
-func resourceAlibabacloudStackNetworkAclAttachmentCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceAlibabacloudStackNetworkAclAttachmentCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 
 	d.SetId(d.Get("network_acl_id").(string) + COLON_SEPARATED + resource.UniqueId())
 
-	return resourceAlibabacloudStackNetworkAclAttachmentUpdate(d, meta)
+	return resourceAlibabacloudStackNetworkAclAttachmentUpdate(ctx, d, meta)
+}
+
+// resourceAlibabacloudStackNetworkAclAttachmentImport imports the attachment by the network acl id, and adopts all the
+// resources bound to the network acl. The id of the imported attachment is <network_acl_id>:imported.
+func resourceAlibabacloudStackNetworkAclAttachmentImport(ctx context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	client := meta.(*connectivity.AlibabacloudStackClient)
+	vpcService := VpcService{client}
+	networkAclId := strings.Split(d.Id(), COLON_SEPARATED)[0]
+	object, err := vpcService.DescribeNetworkAcl(networkAclId)
+	if err != nil {
+		return nil, WrapError(err)
+	}
+	items, _ := object["Resources"].(map[string]interface{})["Resource"].([]interface{})
+	if len(items) == 0 {
+		return nil, WrapError(Error("the network acl %s is not bound to any resource", networkAclId))
+	}
+	resources := make([]map[string]interface{}, 0, len(items))
+	for _, e := range items {
+		item := e.(map[string]interface{})
+		resources = append(resources, map[string]interface{}{
+			"resource_id":   fmt.Sprint(item["ResourceId"]),
+			"resource_type": fmt.Sprint(item["ResourceType"]),
+		})
+	}
+	if !strings.Contains(d.Id(), COLON_SEPARATED) {
+		d.SetId(networkAclId + COLON_SEPARATED + "imported")
+	}
+	d.Set("network_acl_id", networkAclId)
+	d.Set("resources", resources)
+	return []*schema.ResourceData{d}, nil
 }
 
-func resourceAlibabacloudStackNetworkAclAttachmentRead(d *schema.ResourceData, meta interface{}) error {
+func resourceAlibabacloudStackNetworkAclAttachmentRead(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 
 	client := meta.(*connectivity.AlibabacloudStackClient)
 	vpcService := VpcService{client}
@@ -77,11 +113,18 @@ func resourceAlibabacloudStackNetworkAclAttachmentRead(d *schema.ResourceData, m
 		return WrapError(err)
 	}
 	d.Set("network_acl_id", networkAclId)
-	d.Set("resources", vpcResource)
+	resources := make([]map[string]interface{}, 0, len(vpcResource))
+	for _, res := range vpcResource {
+		resources = append(resources, map[string]interface{}{
+			"resource_id":   res.ResourceId,
+			"resource_type": res.ResourceType,
+		})
+	}
+	d.Set("resources", resources)
 	return nil
 }
 
-func resourceAlibabacloudStackNetworkAclAttachmentUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceAlibabacloudStackNetworkAclAttachmentUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*connectivity.AlibabacloudStackClient)
 	vpcService := VpcService{client}
 
@@ -179,10 +222,10 @@ func resourceAlibabacloudStackNetworkAclAttachmentUpdate(d *schema.ResourceData,
 		//d.SetPartial("resources")
 	}
 
-	return resourceAlibabacloudStackNetworkAclAttachmentRead(d, meta)
+	return resourceAlibabacloudStackNetworkAclAttachmentRead(ctx, d, meta)
 }
 
-func resourceAlibabacloudStackNetworkAclAttachmentDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceAlibabacloudStackNetworkAclAttachmentDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*connectivity.AlibabacloudStackClient)
 	vpcService := VpcService{client}
 
@@ -214,7 +257,7 @@ func resourceAlibabacloudStackNetworkAclAttachmentDelete(d *schema.ResourceData,
 		})
 	}
 	request.Resource = &resources
-	err = resource.Retry(5*time.Minute, func() *resource.RetryError {
+	err = resource.RetryContext(ctx, 5*time.Minute, func() *resource.RetryError {
 		raw, err := client.WithVpcClient(func(vpcClient *vpc.Client) (interface{}, error) {
 			return vpcClient.UnassociateNetworkAcl(request)
 		})
@@ -1,6 +1,7 @@
 package alibabacloudstack
 
 import (
+	"context"
 	"github.com/aliyun/alibaba-cloud-sdk-go/sdk/requests"
 	"github.com/aliyun/alibaba-cloud-sdk-go/services/ecs"
 	"github.com/aliyun/terraform-provider-alibabacloudstack/alibabacloudstack/connectivity"
@@ -13,13 +14,13 @@ import (
 
 func resourceAlibabacloudStackNetworkInterface() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceNetworkInterfaceCreate,
-		Read:   resourceNetworkInterfaceRead,
-		Update: resourceNetworkInterfaceUpdate,
-		Delete: resourceNetworkInterfaceDelete,
+		CreateContext: withDiagnostics(resourceNetworkInterfaceCreate),
+		ReadContext:   withDiagnostics(resourceNetworkInterfaceRead),
+		UpdateContext: withDiagnostics(resourceNetworkInterfaceUpdate),
+		DeleteContext: withDiagnostics(resourceNetworkInterfaceDelete),
 
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			StateContext: schema.ImportStatePassthroughContext,
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -77,7 +78,7 @@ func resourceAlibabacloudStackNetworkInterface() *schema.Resource {
 	}
 }
 
-func resourceNetworkInterfaceCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceNetworkInterfaceCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*connectivity.AlibabacloudStackClient)
 	ecsService := EcsService{client}
 	request := ecs.CreateCreateNetworkInterfaceRequest()
@@ -119,10 +120,10 @@ func resourceNetworkInterfaceCreate(d *schema.ResourceData, meta interface{}) er
 		return WrapError(err)
 	}
 
-	return resourceNetworkInterfaceUpdate(d, meta)
+	return resourceNetworkInterfaceUpdate(ctx, d, meta)
 }
 
-func resourceNetworkInterfaceRead(d *schema.ResourceData, meta interface{}) error {
+func resourceNetworkInterfaceRead(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*connectivity.AlibabacloudStackClient)
 	ecsService := EcsService{client}
 
@@ -162,7 +163,7 @@ func resourceNetworkInterfaceRead(d *schema.ResourceData, meta interface{}) erro
 	return nil
 }
 
-func resourceNetworkInterfaceUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceNetworkInterfaceUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*connectivity.AlibabacloudStackClient)
 	ecsService := EcsService{client}
 
@@ -229,7 +230,7 @@ func resourceNetworkInterfaceUpdate(d *schema.ResourceData, meta interface{}) er
 			unAssignPrivateIpAddressesRequest.QueryParams = map[string]string{"AccessKeySecret": client.SecretKey, "Product": "ecs", "Department": client.Department, "ResourceGroup": client.ResourceGroup}
 			unAssignPrivateIpAddressesRequest.NetworkInterfaceId = d.Id()
 			unAssignPrivateIpAddressesRequest.PrivateIpAddress = &unAssignIpList
-			err := resource.Retry(DefaultTimeout*time.Second, func() *resource.RetryError {
+			err := resource.RetryContext(ctx, DefaultTimeout*time.Second, func() *resource.RetryError {
 				raw, err := client.WithEcsClient(func(ecsClient *ecs.Client) (interface{}, error) {
 					return ecsClient.UnassignPrivateIpAddresses(unAssignPrivateIpAddressesRequest)
 				})
@@ -261,7 +262,7 @@ func resourceNetworkInterfaceUpdate(d *schema.ResourceData, meta interface{}) er
 			assignPrivateIpAddressesRequest.QueryParams = map[string]string{"AccessKeySecret": client.SecretKey, "Product": "ecs", "Department": client.Department, "ResourceGroup": client.ResourceGroup}
 			assignPrivateIpAddressesRequest.NetworkInterfaceId = d.Id()
 			assignPrivateIpAddressesRequest.PrivateIpAddress = &assignIpList
-			err := resource.Retry(DefaultTimeout*time.Second, func() *resource.RetryError {
+			err := resource.RetryContext(ctx, DefaultTimeout*time.Second, func() *resource.RetryError {
 				raw, err := client.WithEcsClient(func(ecsClient *ecs.Client) (interface{}, error) {
 					return ecsClient.AssignPrivateIpAddresses(assignPrivateIpAddressesRequest)
 				})
@@ -303,7 +304,7 @@ func resourceNetworkInterfaceUpdate(d *schema.ResourceData, meta interface{}) er
 				assignPrivateIpAddressesRequest.QueryParams = map[string]string{"AccessKeySecret": client.SecretKey, "Product": "ecs", "Department": client.Department, "ResourceGroup": client.ResourceGroup}
 				assignPrivateIpAddressesRequest.NetworkInterfaceId = d.Id()
 				assignPrivateIpAddressesRequest.SecondaryPrivateIpAddressCount = requests.NewInteger(diff)
-				err := resource.Retry(DefaultTimeout*time.Second, func() *resource.RetryError {
+				err := resource.RetryContext(ctx, DefaultTimeout*time.Second, func() *resource.RetryError {
 					raw, err := client.WithEcsClient(func(ecsClient *ecs.Client) (interface{}, error) {
 						return ecsClient.AssignPrivateIpAddresses(assignPrivateIpAddressesRequest)
 					})
@@ -334,7 +335,7 @@ func resourceNetworkInterfaceUpdate(d *schema.ResourceData, meta interface{}) er
 				}
 				unAssignPrivateIpAddressesRequest.Headers = map[string]string{"RegionId": client.RegionId}
 				unAssignPrivateIpAddressesRequest.QueryParams = map[string]string{"AccessKeySecret": client.SecretKey, "Product": "ecs", "Department": client.Department, "ResourceGroup": client.ResourceGroup}
-				err := resource.Retry(DefaultTimeout*time.Second, func() *resource.RetryError {
+				err := resource.RetryContext(ctx, DefaultTimeout*time.Second, func() *resource.RetryError {
 					unAssignPrivateIpAddressesRequest.NetworkInterfaceId = d.Id()
 					unAssignPrivateIpAddressesRequest.PrivateIpAddress = &unAssignIps
 					raw, err := client.WithEcsClient(func(ecsClient *ecs.Client) (interface{}, error) {
@@ -363,7 +364,7 @@ func resourceNetworkInterfaceUpdate(d *schema.ResourceData, meta interface{}) er
 		}
 	}
 
-	if err := setTags(client, TagResourceEni, d); err != nil {
+	if err := setTags(ctx, client, TagResourceEni, d); err != nil {
 		return WrapError(err)
 	} else {
 		//d.SetPartial("tags")
@@ -371,10 +372,10 @@ func resourceNetworkInterfaceUpdate(d *schema.ResourceData, meta interface{}) er
 
 	d.Partial(false)
 
-	return resourceNetworkInterfaceRead(d, meta)
+	return resourceNetworkInterfaceRead(ctx, d, meta)
 }
 
-func resourceNetworkInterfaceDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceNetworkInterfaceDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*connectivity.AlibabacloudStackClient)
 	ecsService := EcsService{client}
 
@@ -389,7 +390,7 @@ func resourceNetworkInterfaceDelete(d *schema.ResourceData, meta interface{}) er
 	request.Headers = map[string]string{"RegionId": client.RegionId}
 	request.QueryParams = map[string]string{"AccessKeySecret": client.SecretKey, "Product": "ecs", "Department": client.Department, "ResourceGroup": client.ResourceGroup}
 
-	err := resource.Retry(DefaultTimeout*time.Second, func() *resource.RetryError {
+	err := resource.RetryContext(ctx, DefaultTimeout*time.Second, func() *resource.RetryError {
 		raw, err := client.WithEcsClient(func(ecsClient *ecs.Client) (interface{}, error) {
 			return ecsClient.DeleteNetworkInterface(request)
 		})
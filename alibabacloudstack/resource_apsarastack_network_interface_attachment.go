@@ -1,6 +1,7 @@
 package alibabacloudstack
 
 import (
+	"context"
 	"strings"
 	"time"
 
@@ -13,12 +14,12 @@ import (
 
 func resourceNetworkInterfaceAttachment() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceAlibabacloudStackNetworkInterfaceAttachmentCreate,
-		Read:   resourceAlibabacloudStackNetworkInterfaceAttachmentRead,
-		Delete: resourceAlibabacloudStackNetworkInterfaceAttachmentDelete,
+		CreateContext: withDiagnostics(resourceAlibabacloudStackNetworkInterfaceAttachmentCreate),
+		ReadContext:   withDiagnostics(resourceAlibabacloudStackNetworkInterfaceAttachmentRead),
+		DeleteContext: withDiagnostics(resourceAlibabacloudStackNetworkInterfaceAttachmentDelete),
 
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			StateContext: schema.ImportStatePassthroughContext,
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -36,7 +37,7 @@ func resourceNetworkInterfaceAttachment() *schema.Resource {
 	}
 }
 
-func resourceAlibabacloudStackNetworkInterfaceAttachmentCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceAlibabacloudStackNetworkInterfaceAttachmentCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*connectivity.AlibabacloudStackClient)
 	ecsService := EcsService{client}
 
@@ -55,7 +56,7 @@ func resourceAlibabacloudStackNetworkInterfaceAttachmentCreate(d *schema.Resourc
 	request.InstanceId = instanceId
 	request.NetworkInterfaceId = eniId
 
-	err := resource.Retry(DefaultTimeout*time.Minute, func() *resource.RetryError {
+	err := resource.RetryContext(ctx, DefaultTimeout*time.Minute, func() *resource.RetryError {
 		raw, err := client.WithEcsClient(func(ecsClient *ecs.Client) (interface{}, error) {
 			return ecsClient.AttachNetworkInterface(request)
 		})
@@ -75,10 +76,10 @@ func resourceAlibabacloudStackNetworkInterfaceAttachmentCreate(d *schema.Resourc
 	if err = ecsService.WaitForNetworkInterface(eniId, InUse, DefaultTimeout); err != nil {
 		return WrapError(err)
 	}
-	return resourceAlibabacloudStackNetworkInterfaceAttachmentRead(d, meta)
+	return resourceAlibabacloudStackNetworkInterfaceAttachmentRead(ctx, d, meta)
 }
 
-func resourceAlibabacloudStackNetworkInterfaceAttachmentRead(d *schema.ResourceData, meta interface{}) error {
+func resourceAlibabacloudStackNetworkInterfaceAttachmentRead(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 	wiatSecondsIfWithTest(1)
 	client := meta.(*connectivity.AlibabacloudStackClient)
 	ecsService := EcsService{client}
@@ -98,7 +99,7 @@ func resourceAlibabacloudStackNetworkInterfaceAttachmentRead(d *schema.ResourceD
 	return nil
 }
 
-func resourceAlibabacloudStackNetworkInterfaceAttachmentDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceAlibabacloudStackNetworkInterfaceAttachmentDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*connectivity.AlibabacloudStackClient)
 	ecsService := EcsService{client}
 	parts, err := ParseResourceId(d.Id(), 2)
@@ -127,7 +128,7 @@ func resourceAlibabacloudStackNetworkInterfaceAttachmentDelete(d *schema.Resourc
 	request.Headers = map[string]string{"RegionId": client.RegionId}
 	request.QueryParams = map[string]string{"AccessKeySecret": client.SecretKey, "Product": "ecs", "Department": client.Department, "ResourceGroup": client.ResourceGroup}
 
-	err = resource.Retry(DefaultTimeout*time.Second, func() *resource.RetryError {
+	err = resource.RetryContext(ctx, DefaultTimeout*time.Second, func() *resource.RetryError {
 		raw, err := client.WithEcsClient(func(ecsClient *ecs.Client) (interface{}, error) {
 			return ecsClient.DetachNetworkInterface(request)
 		})
@@ -1,6 +1,7 @@
 package alibabacloudstack
 
 import (
+	"context"
 	"encoding/json"
 	"errors"
 	"github.com/aliyun/alibaba-cloud-sdk-go/sdk/responses"
@@ -19,12 +20,12 @@ import (
 
 func resourceAlibabacloudStackOnsGroup() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceAlibabacloudStackOnsGroupCreate,
-		Read:   resourceAlibabacloudStackOnsGroupRead,
-		Update: resourceAlibabacloudStackOnsGroupUpdate,
-		Delete: resourceAlibabacloudStackOnsGroupDelete,
+		CreateContext: withDiagnostics(resourceAlibabacloudStackOnsGroupCreate),
+		ReadContext:   withDiagnostics(resourceAlibabacloudStackOnsGroupRead),
+		UpdateContext: withDiagnostics(resourceAlibabacloudStackOnsGroupUpdate),
+		DeleteContext: withDiagnostics(resourceAlibabacloudStackOnsGroupDelete),
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			StateContext: schema.ImportStatePassthroughContext,
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -52,7 +53,7 @@ func resourceAlibabacloudStackOnsGroup() *schema.Resource {
 	}
 }
 
-func resourceAlibabacloudStackOnsGroupCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceAlibabacloudStackOnsGroupCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*connectivity.AlibabacloudStackClient)
 	var requestInfo *ecs.Client
 
@@ -116,10 +117,10 @@ func resourceAlibabacloudStackOnsGroupCreate(d *schema.ResourceData, meta interf
 	log.Printf("groupid and instanceid %s %s", groupId, instanceId)
 	d.SetId(groupId + COLON_SEPARATED + instanceId)
 
-	return resourceAlibabacloudStackOnsGroupRead(d, meta)
+	return resourceAlibabacloudStackOnsGroupRead(ctx, d, meta)
 }
 
-func resourceAlibabacloudStackOnsGroupRead(d *schema.ResourceData, meta interface{}) error {
+func resourceAlibabacloudStackOnsGroupRead(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 	wiatSecondsIfWithTest(1)
 	client := meta.(*connectivity.AlibabacloudStackClient)
 	onsService := OnsService{client}
@@ -141,11 +142,11 @@ func resourceAlibabacloudStackOnsGroupRead(d *schema.ResourceData, meta interfac
 	return nil
 }
 
-func resourceAlibabacloudStackOnsGroupUpdate(d *schema.ResourceData, meta interface{}) error {
-	return resourceAlibabacloudStackOnsGroupRead(d, meta)
+func resourceAlibabacloudStackOnsGroupUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
+	return resourceAlibabacloudStackOnsGroupRead(ctx, d, meta)
 }
 
-func resourceAlibabacloudStackOnsGroupDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceAlibabacloudStackOnsGroupDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*connectivity.AlibabacloudStackClient)
 	onsService := OnsService{client}
 	var requestInfo *ecs.Client
@@ -156,7 +157,7 @@ func resourceAlibabacloudStackOnsGroupDelete(d *schema.ResourceData, meta interf
 		return WrapErrorf(err, DefaultErrorMsg, parts[0], "IsGroupExist", AlibabacloudStackSdkGoERROR)
 	}
 	addDebug("IsGroupExist", check, requestInfo, map[string]string{"GroupId": parts[0]})
-	err = resource.Retry(2*time.Minute, func() *resource.RetryError {
+	err = resource.RetryContext(ctx, 2*time.Minute, func() *resource.RetryError {
 
 		request := requests.NewCommonRequest()
 		request.QueryParams = map[string]string{
@@ -1,6 +1,7 @@
 package alibabacloudstack
 
 import (
+	"context"
 	"encoding/json"
 	"errors"
 	"fmt"
@@ -19,12 +20,12 @@ import (
 
 func resourceAlibabacloudStackOnsInstance() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceAlibabacloudStackOnsInstanceCreate,
-		Read:   resourceAlibabacloudStackOnsInstanceRead,
-		Update: resourceAlibabacloudStackOnsInstanceUpdate,
-		Delete: resourceAlibabacloudStackOnsInstanceDelete,
+		CreateContext: withDiagnostics(resourceAlibabacloudStackOnsInstanceCreate),
+		ReadContext:   withDiagnostics(resourceAlibabacloudStackOnsInstanceRead),
+		UpdateContext: withDiagnostics(resourceAlibabacloudStackOnsInstanceUpdate),
+		DeleteContext: withDiagnostics(resourceAlibabacloudStackOnsInstanceDelete),
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			StateContext: schema.ImportStatePassthroughContext,
 		},
 		Schema: map[string]*schema.Schema{
 			"name": {
@@ -82,7 +83,7 @@ func resourceAlibabacloudStackOnsInstance() *schema.Resource {
 	}
 }
 
-func resourceAlibabacloudStackOnsInstanceCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceAlibabacloudStackOnsInstanceCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*connectivity.AlibabacloudStackClient)
 	var requestInfo *ecs.Client
 	maxrtps := d.Get("tps_receive_max").(int)
@@ -143,10 +144,10 @@ func resourceAlibabacloudStackOnsInstanceCreate(d *schema.ResourceData, meta int
 	}
 	d.SetId(ins_resp.Data.InstanceID)
 
-	return resourceAlibabacloudStackOnsInstanceUpdate(d, meta)
+	return resourceAlibabacloudStackOnsInstanceUpdate(ctx, d, meta)
 }
 
-func resourceAlibabacloudStackOnsInstanceRead(d *schema.ResourceData, meta interface{}) error {
+func resourceAlibabacloudStackOnsInstanceRead(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 	wiatSecondsIfWithTest(1)
 	client := meta.(*connectivity.AlibabacloudStackClient)
 	onsService := OnsService{client}
@@ -170,7 +171,7 @@ func resourceAlibabacloudStackOnsInstanceRead(d *schema.ResourceData, meta inter
 	return nil
 }
 
-func resourceAlibabacloudStackOnsInstanceUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceAlibabacloudStackOnsInstanceUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*connectivity.AlibabacloudStackClient)
 	onsService := OnsService{client}
 	independentname := d.Get("independent_naming").(string)
@@ -299,10 +300,10 @@ func resourceAlibabacloudStackOnsInstanceUpdate(d *schema.ResourceData, meta int
 
 	}
 
-	return resourceAlibabacloudStackOnsInstanceRead(d, meta)
+	return resourceAlibabacloudStackOnsInstanceRead(ctx, d, meta)
 }
 
-func resourceAlibabacloudStackOnsInstanceDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceAlibabacloudStackOnsInstanceDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*connectivity.AlibabacloudStackClient)
 	onsService := OnsService{client}
 	var requestInfo *ecs.Client
@@ -311,7 +312,7 @@ func resourceAlibabacloudStackOnsInstanceDelete(d *schema.ResourceData, meta int
 		return WrapErrorf(err, DefaultErrorMsg, d.Id(), "IsInstanceExist", AlibabacloudStackSdkGoERROR)
 	}
 	addDebug("IsInstanceExist", check, requestInfo, map[string]string{"InstanceId": d.Id()})
-	err = resource.Retry(2*time.Minute, func() *resource.RetryError {
+	err = resource.RetryContext(ctx, 2*time.Minute, func() *resource.RetryError {
 
 		request := requests.NewCommonRequest()
 		request.QueryParams = map[string]string{
@@ -1,6 +1,7 @@
 package alibabacloudstack
 
 import (
+	"context"
 	"encoding/json"
 	"errors"
 	"github.com/aliyun/alibaba-cloud-sdk-go/sdk/responses"
@@ -19,12 +20,12 @@ import (
 
 func resourceAlibabacloudStackOnsTopic() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceAlibabacloudStackOnsTopicCreate,
-		Read:   resourceAlibabacloudStackOnsTopicRead,
-		Update: resourceAlibabacloudStackOnsTopicUpdate,
-		Delete: resourceAlibabacloudStackOnsTopicDelete,
+		CreateContext: withDiagnostics(resourceAlibabacloudStackOnsTopicCreate),
+		ReadContext:   withDiagnostics(resourceAlibabacloudStackOnsTopicRead),
+		UpdateContext: withDiagnostics(resourceAlibabacloudStackOnsTopicUpdate),
+		DeleteContext: withDiagnostics(resourceAlibabacloudStackOnsTopicDelete),
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			StateContext: schema.ImportStatePassthroughContext,
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -56,7 +57,7 @@ func resourceAlibabacloudStackOnsTopic() *schema.Resource {
 	}
 }
 
-func resourceAlibabacloudStackOnsTopicCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceAlibabacloudStackOnsTopicCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*connectivity.AlibabacloudStackClient)
 	ordertype := d.Get("message_type").(string)
 	instanceId := d.Get("instance_id").(string)
@@ -111,10 +112,10 @@ func resourceAlibabacloudStackOnsTopicCreate(d *schema.ResourceData, meta interf
 	}
 	d.SetId(topic + COLON_SEPARATED + instanceId)
 
-	return resourceAlibabacloudStackOnsTopicRead(d, meta)
+	return resourceAlibabacloudStackOnsTopicRead(ctx, d, meta)
 }
 
-func resourceAlibabacloudStackOnsTopicRead(d *schema.ResourceData, meta interface{}) error {
+func resourceAlibabacloudStackOnsTopicRead(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 	wiatSecondsIfWithTest(1)
 	client := meta.(*connectivity.AlibabacloudStackClient)
 	onsService := OnsService{client}
@@ -137,11 +138,11 @@ func resourceAlibabacloudStackOnsTopicRead(d *schema.ResourceData, meta interfac
 	return nil
 }
 
-func resourceAlibabacloudStackOnsTopicUpdate(d *schema.ResourceData, meta interface{}) error {
-	return resourceAlibabacloudStackOnsTopicRead(d, meta)
+func resourceAlibabacloudStackOnsTopicUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
+	return resourceAlibabacloudStackOnsTopicRead(ctx, d, meta)
 }
 
-func resourceAlibabacloudStackOnsTopicDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceAlibabacloudStackOnsTopicDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*connectivity.AlibabacloudStackClient)
 	onsService := OnsService{client}
 	var requestInfo *ecs.Client
@@ -153,7 +154,7 @@ func resourceAlibabacloudStackOnsTopicDelete(d *schema.ResourceData, meta interf
 		return WrapErrorf(err, DefaultErrorMsg, d.Id(), "IsTopicExist", AlibabacloudStackSdkGoERROR)
 	}
 	addDebug("IsTopicExist", check, requestInfo, map[string]string{"Topic": d.Id()})
-	err = resource.Retry(2*time.Minute, func() *resource.RetryError {
+	err = resource.RetryContext(ctx, 2*time.Minute, func() *resource.RetryError {
 
 		request := requests.NewCommonRequest()
 		request.QueryParams = map[string]string{
@@ -2,6 +2,7 @@ package alibabacloudstack
 
 import (
 	"bytes"
+	"context"
 	"encoding/json"
 	"fmt"
 	"log"
@@ -23,12 +24,12 @@ import (
 
 func resourceAlibabacloudStackOssBucket() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceAlibabacloudStackOssBucketCreate,
-		Read:   resourceAlibabacloudStackOssBucketRead,
-		Update: resourceAlibabacloudStackOssBucketUpdate,
-		Delete: resourceAlibabacloudStackOssBucketDelete,
+		CreateContext: withDiagnostics(resourceAlibabacloudStackOssBucketCreate),
+		ReadContext:   withDiagnostics(resourceAlibabacloudStackOssBucketRead),
+		UpdateContext: withDiagnostics(resourceAlibabacloudStackOssBucketUpdate),
+		DeleteContext: withDiagnostics(resourceAlibabacloudStackOssBucketDelete),
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			StateContext: schema.ImportStatePassthroughContext,
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -300,13 +301,14 @@ func resourceAlibabacloudStackOssBucket() *schema.Resource {
 			"vpclist": {
 				Type:     schema.TypeList,
 				Optional: true,
+				Computed: true,
 				Elem:     &schema.Schema{Type: schema.TypeString},
 			},
 		},
 	}
 }
 
-func resourceAlibabacloudStackOssBucketCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceAlibabacloudStackOssBucketCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*connectivity.AlibabacloudStackClient)
 	ossService := OssService{client}
 	request := map[string]string{"bucketName": d.Get("bucket").(string), "Department": client.Department, "ResourceGroup": client.ResourceGroup}
@@ -388,7 +390,7 @@ func resourceAlibabacloudStackOssBucketCreate(d *schema.ResourceData, meta inter
 
 	}
 
-	err = resource.Retry(3*time.Minute, func() *resource.RetryError {
+	err = resource.RetryContext(ctx, 3*time.Minute, func() *resource.RetryError {
 		det, err := ossService.DescribeOssBucket(bucketName)
 		if err != nil {
 			return resource.NonRetryableError(err)
@@ -425,10 +427,10 @@ func resourceAlibabacloudStackOssBucketCreate(d *schema.ResourceData, meta inter
 	if vpc_err != nil {
 		return WrapError(vpc_err)
 	}
-	return resourceAlibabacloudStackOssBucketRead(d, meta)
+	return resourceAlibabacloudStackOssBucketRead(ctx, d, meta)
 }
 
-func resourceAlibabacloudStackOssBucketRead(d *schema.ResourceData, meta interface{}) error {
+func resourceAlibabacloudStackOssBucketRead(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 	wiatSecondsIfWithTest(1)
 	client := meta.(*connectivity.AlibabacloudStackClient)
 	ossService := OssService{client}
@@ -737,7 +739,7 @@ func resourceAlibabacloudStackOssBucketRead(d *schema.ResourceData, meta interfa
 	return nil
 }
 
-func resourceAlibabacloudStackOssBucketUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceAlibabacloudStackOssBucketUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*connectivity.AlibabacloudStackClient)
 
 	d.Partial(true)
@@ -762,10 +764,10 @@ func resourceAlibabacloudStackOssBucketUpdate(d *schema.ResourceData, meta inter
 		}
 	}
 	d.Partial(false)
-	return resourceAlibabacloudStackOssBucketRead(d, meta)
+	return resourceAlibabacloudStackOssBucketRead(ctx, d, meta)
 }
 
-func resourceAlibabacloudStackOssBucketDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceAlibabacloudStackOssBucketDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 	bvclient := meta.(*connectivity.AlibabacloudStackClient)
 	bvserver := BucketVpcService{bvclient}
 	vpclist, binderr := bvserver.BucketVpcList(d.Id())
@@ -797,7 +799,7 @@ func resourceAlibabacloudStackOssBucketDelete(d *schema.ResourceData, meta inter
 		return nil
 	}
 
-	err = resource.Retry(1*time.Minute, func() *resource.RetryError {
+	err = resource.RetryContext(ctx, 1*time.Minute, func() *resource.RetryError {
 		request := requests.NewCommonRequest()
 		if client.Config.Insecure {
 			request.SetHTTPSInsecure(client.Config.Insecure)
@@ -0,0 +1,228 @@
+package alibabacloudstack
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/aliyun/aliyun-oss-go-sdk/oss"
+	"github.com/aliyun/terraform-provider-alibabacloudstack/alibabacloudstack/connectivity"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+const ossBucketArnPrefix = "acs:oss:::"
+
+func resourceAlibabacloudStackOssBucketInventory() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: withDiagnostics(resourceAlibabacloudStackOssBucketInventoryCreate),
+		ReadContext:   withDiagnostics(resourceAlibabacloudStackOssBucketInventoryRead),
+		DeleteContext: withDiagnostics(resourceAlibabacloudStackOssBucketInventoryDelete),
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+		Schema: map[string]*schema.Schema{
+			"bucket": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"inventory_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"is_enabled": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				ForceNew: true,
+				Default:  true,
+			},
+			"prefix": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+			},
+			"frequency": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringInSlice([]string{"Daily", "Weekly"}, false),
+			},
+			"included_object_versions": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				Default:      "All",
+				ValidateFunc: validation.StringInSlice([]string{"All", "Current"}, false),
+			},
+			"optional_fields": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				ForceNew: true,
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: validation.StringInSlice([]string{"Size", "LastModifiedDate", "ETag", "StorageClass", "IsMultipartUploaded", "EncryptionStatus"}, false),
+				},
+			},
+			"destination": {
+				Type:     schema.TypeList,
+				Required: true,
+				ForceNew: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"bucket": {
+							Type:     schema.TypeString,
+							Required: true,
+							ForceNew: true,
+						},
+						"account_id": {
+							Type:     schema.TypeString,
+							Required: true,
+							ForceNew: true,
+						},
+						"role_arn": {
+							Type:     schema.TypeString,
+							Required: true,
+							ForceNew: true,
+						},
+						"prefix": {
+							Type:     schema.TypeString,
+							Optional: true,
+							ForceNew: true,
+						},
+						"format": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							ForceNew:     true,
+							Default:      "CSV",
+							ValidateFunc: validation.StringInSlice([]string{"CSV"}, false),
+						},
+						"encryption": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							ForceNew:     true,
+							ValidateFunc: validation.StringInSlice([]string{"SSE-OSS", "SSE-KMS"}, false),
+						},
+						"kms_key_id": {
+							Type:     schema.TypeString,
+							Optional: true,
+							ForceNew: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func resourceAlibabacloudStackOssBucketInventoryCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*connectivity.AlibabacloudStackClient)
+	bucket := d.Get("bucket").(string)
+	inventoryId := d.Get("inventory_id").(string)
+	isEnabled := d.Get("is_enabled").(bool)
+	config := oss.InventoryConfiguration{
+		Id:                     inventoryId,
+		IsEnabled:              &isEnabled,
+		Prefix:                 d.Get("prefix").(string),
+		Frequency:              d.Get("frequency").(string),
+		IncludedObjectVersions: d.Get("included_object_versions").(string),
+		OptionalFields: oss.OptionalFields{
+			Field: expandStringList(d.Get("optional_fields").(*schema.Set).List()),
+		},
+	}
+	destination := d.Get("destination").([]interface{})[0].(map[string]interface{})
+	config.OSSBucketDestination = oss.OSSBucketDestination{
+		Format:    destination["format"].(string),
+		AccountId: destination["account_id"].(string),
+		RoleArn:   destination["role_arn"].(string),
+		Bucket:    ossBucketArnPrefix + destination["bucket"].(string),
+		Prefix:    destination["prefix"].(string),
+	}
+	switch destination["encryption"].(string) {
+	case "SSE-OSS":
+		config.OSSBucketDestination.Encryption = &oss.InvEncryption{SseOss: &oss.InvSseOss{}}
+	case "SSE-KMS":
+		config.OSSBucketDestination.Encryption = &oss.InvEncryption{SseKms: &oss.InvSseKms{KmsId: destination["kms_key_id"].(string)}}
+	}
+
+	raw, err := client.WithOssClient(func(ossClient *oss.Client) (interface{}, error) {
+		return nil, ossClient.SetBucketInventory(bucket, config)
+	})
+	if err != nil {
+		return WrapErrorf(err, DefaultErrorMsg, "alibabacloudstack_oss_bucket_inventory", "SetBucketInventory", AlibabacloudStackOssGoSdk)
+	}
+	addDebug("SetBucketInventory", raw, config)
+	d.SetId(fmt.Sprintf("%s%s%s", bucket, COLON_SEPARATED, inventoryId))
+
+	return resourceAlibabacloudStackOssBucketInventoryRead(ctx, d, meta)
+}
+
+func resourceAlibabacloudStackOssBucketInventoryRead(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*connectivity.AlibabacloudStackClient)
+	ossService := OssService{client}
+	object, err := ossService.DescribeOssBucketInventory(d.Id())
+	if err != nil {
+		if NotFoundError(err) {
+			log.Printf("[DEBUG] Resource alibabacloudstack_oss_bucket_inventory ossService.DescribeOssBucketInventory Failed!!! %s", err)
+			d.SetId("")
+			return nil
+		}
+		return WrapError(err)
+	}
+	parts, err := ParseResourceId(d.Id(), 2)
+	if err != nil {
+		return WrapError(err)
+	}
+	d.Set("bucket", parts[0])
+	d.Set("inventory_id", object.Id)
+	if object.IsEnabled != nil {
+		d.Set("is_enabled", *object.IsEnabled)
+	}
+	d.Set("prefix", object.Prefix)
+	d.Set("frequency", object.Frequency)
+	d.Set("included_object_versions", object.IncludedObjectVersions)
+	d.Set("optional_fields", object.OptionalFields.Field)
+
+	destination := map[string]interface{}{
+		"bucket":     strings.TrimPrefix(object.OSSBucketDestination.Bucket, ossBucketArnPrefix),
+		"account_id": object.OSSBucketDestination.AccountId,
+		"role_arn":   object.OSSBucketDestination.RoleArn,
+		"prefix":     object.OSSBucketDestination.Prefix,
+		"format":     object.OSSBucketDestination.Format,
+	}
+	if encryption := object.OSSBucketDestination.Encryption; encryption != nil {
+		if encryption.SseOss != nil {
+			destination["encryption"] = "SSE-OSS"
+		}
+		if encryption.SseKms != nil {
+			destination["encryption"] = "SSE-KMS"
+			destination["kms_key_id"] = encryption.SseKms.KmsId
+		}
+	}
+	if err := d.Set("destination", []interface{}{destination}); err != nil {
+		return WrapError(err)
+	}
+	return nil
+}
+
+func resourceAlibabacloudStackOssBucketInventoryDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*connectivity.AlibabacloudStackClient)
+	parts, err := ParseResourceId(d.Id(), 2)
+	if err != nil {
+		return WrapError(err)
+	}
+	raw, err := client.WithOssClient(func(ossClient *oss.Client) (interface{}, error) {
+		return nil, ossClient.DeleteBucketInventory(parts[0], parts[1])
+	})
+	if err != nil {
+		if ossNotFoundError(err) {
+			return nil
+		}
+		return WrapErrorf(err, DefaultErrorMsg, d.Id(), "DeleteBucketInventory", AlibabacloudStackOssGoSdk)
+	}
+	addDebug("DeleteBucketInventory", raw, parts)
+	return nil
+}
@@ -0,0 +1,96 @@
+package alibabacloudstack
+
+import (
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/aliyun/aliyun-oss-go-sdk/oss"
+	"github.com/aliyun/terraform-provider-alibabacloudstack/alibabacloudstack/connectivity"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccAlibabacloudStackOssBucketInventory_basic(t *testing.T) {
+	var v oss.InventoryConfiguration
+	resourceId := "alibabacloudstack_oss_bucket_inventory.default"
+	ra := resourceAttrInit(resourceId, map[string]string{
+		"bucket":                   CHECKSET,
+		"inventory_id":             "tf-test",
+		"is_enabled":               "true",
+		"frequency":                "Daily",
+		"included_object_versions": "All",
+		"destination.#":            "1",
+	})
+	serviceFunc := func() interface{} {
+		return &OssService{testAccProvider.Meta().(*connectivity.AlibabacloudStackClient)}
+	}
+	rc := resourceCheckInit(resourceId, &v, serviceFunc)
+	rac := resourceAttrCheckInit(rc, ra)
+	testAccCheck := rac.resourceAttrMapUpdateSet()
+	rand := acctest.RandIntRange(1000000, 9999999)
+	name := fmt.Sprintf("tf-testacc-inventory-%d", rand)
+	testAccConfig := resourceTestAccConfigFunc(resourceId, name, resourceOssBucketInventoryConfigDependence)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+		},
+		IDRefreshName: resourceId,
+		Providers:     testAccProviders,
+		CheckDestroy:  rac.checkResourceDestroy(),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccConfig(map[string]interface{}{
+					"bucket":          "${alibabacloudstack_oss_bucket.source.bucket}",
+					"inventory_id":    "tf-test",
+					"frequency":       "Daily",
+					"prefix":          "logs/",
+					"optional_fields": []string{"Size", "LastModifiedDate"},
+					"destination": []map[string]interface{}{
+						{
+							"bucket":     "${alibabacloudstack_oss_bucket.destination.bucket}",
+							"account_id": os.Getenv("ALIBABACLOUDSTACK_ACCOUNT_ID"),
+							"role_arn":   fmt.Sprintf("acs:ram::%s:role/AliyunOSSRole", os.Getenv("ALIBABACLOUDSTACK_ACCOUNT_ID")),
+							"prefix":     "inventory/",
+							"encryption": "SSE-OSS",
+						},
+					},
+				}),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheck(map[string]string{
+						"prefix":                   "logs/",
+						"optional_fields.#":        "2",
+						"destination.0.prefix":     "inventory/",
+						"destination.0.encryption": "SSE-OSS",
+						"destination.0.format":     "CSV",
+						"destination.0.account_id": CHECKSET,
+						"destination.0.role_arn":   CHECKSET,
+						"destination.0.bucket":     name + "-destination",
+					}),
+				),
+			},
+			{
+				ResourceName:      resourceId,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func resourceOssBucketInventoryConfigDependence(name string) string {
+	return fmt.Sprintf(`
+variable "name" {
+  default = "%s"
+}
+
+resource "alibabacloudstack_oss_bucket" "source" {
+  bucket = "${var.name}"
+}
+
+resource "alibabacloudstack_oss_bucket" "destination" {
+  bucket = "${var.name}-destination"
+}
+`, name)
+}
@@ -1,6 +1,8 @@
 package alibabacloudstack
 
 import (
+	"context"
+	"encoding/json"
 	"fmt"
 	"github.com/aliyun/alibaba-cloud-sdk-go/sdk/requests"
 	"github.com/aliyun/alibaba-cloud-sdk-go/sdk/responses"
@@ -18,10 +20,13 @@ import (
 
 func resourceAlibabacloudStackOssBucketKms() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceAlibabacloudStackOssBucketKmsCreate,
-		Read:   resourceAlibabacloudStackOssBucketKmsRead,
-		Update: resourceAlibabacloudStackOssBucketKmsCreate,
-		Delete: resourceAlibabacloudStackOssBucketKmsDelete,
+		CreateContext: withDiagnostics(resourceAlibabacloudStackOssBucketKmsCreate),
+		ReadContext:   withDiagnostics(resourceAlibabacloudStackOssBucketKmsRead),
+		UpdateContext: withDiagnostics(resourceAlibabacloudStackOssBucketKmsCreate),
+		DeleteContext: withDiagnostics(resourceAlibabacloudStackOssBucketKmsDelete),
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
 
 		Schema: map[string]*schema.Schema{
 			"bucket": {
@@ -67,7 +72,7 @@ func resourceAlibabacloudStackOssBucketKms() *schema.Resource {
 	}
 }
 
-func resourceAlibabacloudStackOssBucketKmsCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceAlibabacloudStackOssBucketKmsCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*connectivity.AlibabacloudStackClient)
 	ossService := OssService{client}
 	var requestInfo *oss.Client
@@ -145,17 +150,23 @@ func resourceAlibabacloudStackOssBucketKmsCreate(d *schema.ResourceData, meta in
 	// Assign the bucket name as the resource ID
 	d.SetId(bucketName)
 
-	return resourceAlibabacloudStackOssBucketKmsRead(d, meta)
+	return resourceAlibabacloudStackOssBucketKmsRead(ctx, d, meta)
 }
 
-func resourceAlibabacloudStackOssBucketKmsRead(d *schema.ResourceData, meta interface{}) error {
+func resourceAlibabacloudStackOssBucketKmsRead(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 	wiatSecondsIfWithTest(1)
 	client := meta.(*connectivity.AlibabacloudStackClient)
 	ossService := OssService{client}
 	var requestInfo *oss.Client
-	bucketName := d.Get("bucket").(string)
+	// The id is the bucket name, which is the only thing known when the resource is imported.
+	bucketName := d.Id()
 	det, err := ossService.DescribeOssBucket(bucketName)
 	if err != nil {
+		if NotFoundError(err) {
+			log.Printf("[DEBUG] Resource alibabacloudstack_oss_bucket_kms ossService.DescribeOssBucket Failed!!! %s", err)
+			d.SetId("")
+			return nil
+		}
 		return WrapErrorf(err, DefaultErrorMsg, "alibabacloudstack_oss_bucket", "IsBucketExist", AlibabacloudStackOssGoSdk)
 	}
 	if det.BucketInfo.Name == bucketName {
@@ -207,9 +218,18 @@ func resourceAlibabacloudStackOssBucketKmsRead(d *schema.ResourceData, meta inte
 		if bresponse.GetHttpStatus() != 200 {
 			return WrapErrorf(err, DefaultErrorMsg, "alibabacloudstack_oss_bucket", "GetBucketEncryption", AlibabacloudStackOssGoSdk)
 		}
-		//logging:= make(map[string]interface{})
-		log.Printf("Enter for logging")
-		//d.Set("SSEAlgorithm",bresponse.GetHttpContentString())
+		encryption := BucketEncryption{}
+		if err := json.Unmarshal(bresponse.GetHttpContentBytes(), &encryption); err != nil {
+			return WrapError(err)
+		}
+		rule := encryption.Data.ServerSideEncryptionRule.ApplyServerSideEncryptionByDefault
+		d.Set("bucket", bucketName)
+		d.Set("sse_algorithm", rule.SSEAlgorithm)
+		d.Set("kms_data_encryption", rule.KMSDataEncryption)
+		d.Set("kms_master_key_id", rule.KMSMasterKeyID)
+	} else {
+		d.SetId("")
+		return nil
 	}
 	if err != nil {
 		return WrapErrorf(err, DefaultErrorMsg, "alibabacloudstack_oss_bucket", "Bucket Not Found", AlibabacloudStackOssGoSdk)
@@ -218,7 +238,7 @@ func resourceAlibabacloudStackOssBucketKmsRead(d *schema.ResourceData, meta inte
 	return nil
 }
 
-func resourceAlibabacloudStackOssBucketKmsDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceAlibabacloudStackOssBucketKmsDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*connectivity.AlibabacloudStackClient)
 	ossService := OssService{client}
 	var requestInfo *oss.Client
@@ -231,7 +251,7 @@ func resourceAlibabacloudStackOssBucketKmsDelete(d *schema.ResourceData, meta in
 		return nil
 	}
 
-	err = resource.Retry(3*time.Second, func() *resource.RetryError {
+	err = resource.RetryContext(ctx, 3*time.Second, func() *resource.RetryError {
 		request := requests.NewCommonRequest()
 		if client.Config.Insecure {
 			request.SetHTTPSInsecure(client.Config.Insecure)
@@ -280,3 +300,15 @@ func resourceAlibabacloudStackOssBucketKmsDelete(d *schema.ResourceData, meta in
 	//return WrapError(ossService.WaitForOssBucket(d.Id(), Deleted, DefaultTimeoutMedium))
 
 }
+
+type BucketEncryption struct {
+	Data struct {
+		ServerSideEncryptionRule struct {
+			ApplyServerSideEncryptionByDefault struct {
+				SSEAlgorithm      string `json:"SSEAlgorithm"`
+				KMSDataEncryption string `json:"KMSDataEncryption"`
+				KMSMasterKeyID    string `json:"KMSMasterKeyID"`
+			} `json:"ApplyServerSideEncryptionByDefault"`
+		} `json:"ServerSideEncryptionRule"`
+	} `json:"Data"`
+}
@@ -2,9 +2,12 @@ package alibabacloudstack
 
 import (
 	"bytes"
+	"context"
 	"fmt"
 	"io"
 	"log"
+	"os"
+	"strings"
 	"time"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
@@ -17,11 +20,24 @@ import (
 
 func resourceAlibabacloudStackOssBucketObject() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceAlibabacloudStackOssBucketObjectPut,
-		Read:   resourceAlibabacloudStackOssBucketObjectRead,
-		Update: resourceAlibabacloudStackOssBucketObjectPut,
-		Delete: resourceAlibabacloudStackOssBucketObjectDelete,
-
+		CreateContext: withDiagnostics(resourceAlibabacloudStackOssBucketObjectPut),
+		ReadContext:   withDiagnostics(resourceAlibabacloudStackOssBucketObjectRead),
+		UpdateContext: withDiagnostics(resourceAlibabacloudStackOssBucketObjectUpdate),
+		DeleteContext: withDiagnostics(resourceAlibabacloudStackOssBucketObjectDelete),
+		CustomizeDiff: ossBucketObjectSourceCustomizeDiff,
+		Importer: &schema.ResourceImporter{
+			StateContext: func(ctx context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+				// The object key may contain the separator, so only the first one splits the bucket name.
+				parts := strings.SplitN(d.Id(), COLON_SEPARATED, 2)
+				if len(parts) != 2 || parts[0] == "" || parts[1] == "" {
+					return nil, WrapError(fmt.Errorf("Invalid Resource Id %s. Expected format <bucket>:<key>", d.Id()))
+				}
+				d.SetId(parts[1])
+				d.Set("bucket", parts[0])
+				d.Set("key", parts[1])
+				return []*schema.ResourceData{d}, nil
+			},
+		},
 		Schema: map[string]*schema.Schema{
 			"bucket": {
 				Type:     schema.TypeString,
@@ -47,6 +63,37 @@ func resourceAlibabacloudStackOssBucketObject() *schema.Resource {
 				ConflictsWith: []string{"source"},
 			},
 
+			"source_hash": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+
+			"multipart_threshold": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Default:      100,
+				ValidateFunc: validation.IntAtLeast(1),
+			},
+
+			"part_size": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Default:      10,
+				ValidateFunc: validation.IntBetween(1, 5120),
+			},
+
+			"parallel": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Default:      3,
+				ValidateFunc: validation.IntBetween(1, 100),
+			},
+
+			"checkpoint_dir": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+
 			"acl": {
 				Type:         schema.TypeString,
 				Default:      oss.ACLPrivate,
@@ -106,11 +153,16 @@ func resourceAlibabacloudStackOssBucketObject() *schema.Resource {
 				Type:     schema.TypeString,
 				Computed: true,
 			},
+
+			"etag": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
 		},
 	}
 }
 
-func resourceAlibabacloudStackOssBucketObjectPut(d *schema.ResourceData, meta interface{}) error {
+func resourceAlibabacloudStackOssBucketObjectPut(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*connectivity.AlibabacloudStackClient)
 	var requestInfo *oss.Client
 	raw, err := client.WithOssClientPutObject(func(ossClient *oss.Client) (interface{}, error) {
@@ -155,7 +207,7 @@ func resourceAlibabacloudStackOssBucketObjectPut(d *schema.ResourceData, meta in
 		return WrapError(err)
 	}
 	if filePath != "" {
-		err = bucket.PutObjectFromFile(key, filePath, options...)
+		err = putOssObjectFromFile(bucket, key, filePath, d, options)
 	}
 
 	if body != nil {
@@ -167,10 +219,41 @@ func resourceAlibabacloudStackOssBucketObjectPut(d *schema.ResourceData, meta in
 	}
 
 	d.SetId(key)
-	return resourceAlibabacloudStackOssBucketObjectRead(d, meta)
+	return resourceAlibabacloudStackOssBucketObjectRead(ctx, d, meta)
 }
 
-func resourceAlibabacloudStackOssBucketObjectRead(d *schema.ResourceData, meta interface{}) error {
+// putOssObjectFromFile uploads the large files in parts concurrently, and the checkpoint file lets
+// the next apply resume the upload which is interrupted.
+func putOssObjectFromFile(bucket *oss.Bucket, key, filePath string, d *schema.ResourceData, options []oss.Option) error {
+	info, err := os.Stat(filePath)
+	if err != nil {
+		return WrapError(err)
+	}
+	if info.Size() <= int64(d.Get("multipart_threshold").(int))*1024*1024 {
+		return bucket.PutObjectFromFile(key, filePath, options...)
+	}
+
+	checkpointDir := os.TempDir()
+	if v, ok := d.GetOk("checkpoint_dir"); ok {
+		if checkpointDir, err = homedir.Expand(v.(string)); err != nil {
+			return WrapError(err)
+		}
+	}
+	options = append(options, oss.Routines(d.Get("parallel").(int)), oss.CheckpointDir(true, checkpointDir))
+	partSize := int64(d.Get("part_size").(int)) * 1024 * 1024
+	log.Printf("[DEBUG] Uploading the file %s of %d bytes to the object %s in parts of %d bytes", filePath, info.Size(), key, partSize)
+	return bucket.UploadFile(key, filePath, partSize, options...)
+}
+
+func resourceAlibabacloudStackOssBucketObjectUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
+	// The upload settings only take effect on the next upload.
+	if !d.HasChangesExcept("multipart_threshold", "part_size", "parallel", "checkpoint_dir") {
+		return resourceAlibabacloudStackOssBucketObjectRead(ctx, d, meta)
+	}
+	return resourceAlibabacloudStackOssBucketObjectPut(ctx, d, meta)
+}
+
+func resourceAlibabacloudStackOssBucketObjectRead(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 	wiatSecondsIfWithTest(1)
 	client := meta.(*connectivity.AlibabacloudStackClient)
 	var requestInfo *oss.Client
@@ -201,17 +284,36 @@ func resourceAlibabacloudStackOssBucketObjectRead(d *schema.ResourceData, meta i
 		"options":   options,
 	})
 
+	d.Set("bucket", d.Get("bucket").(string))
+	d.Set("key", d.Id())
 	d.Set("content_type", object.Get("Content-Type"))
 	//d.Set("cache_control", object.Get("Cache-Control"))
 	d.Set("content_disposition", object.Get("Content-Disposition"))
 	d.Set("content_encoding", object.Get("Content-Encoding"))
 	d.Set("expires", object.Get("Expires"))
 	d.Set("version_id", object.Get("x-oss-version-id"))
+	d.Set("etag", strings.Trim(object.Get("ETag"), "\""))
+	if sse := object.Get("x-oss-server-side-encryption"); sse != "" {
+		d.Set("server_side_encryption", sse)
+	}
+	if kmsKeyId := object.Get("x-oss-server-side-encryption-key-id"); kmsKeyId != "" {
+		d.Set("kms_key_id", kmsKeyId)
+	}
+
+	acl, err := bucket.GetObjectACL(d.Id())
+	if err != nil {
+		return WrapErrorf(err, DefaultErrorMsg, d.Id(), "GetObjectACL", AlibabacloudStackOssGoSdk)
+	}
+	addDebug("GetObjectACL", acl, requestInfo, map[string]string{"objectKey": d.Id()})
+	// The object which follows the acl of its bucket returns "default", which is not a valid argument.
+	if acl.ACL != "default" {
+		d.Set("acl", acl.ACL)
+	}
 
 	return nil
 }
 
-func resourceAlibabacloudStackOssBucketObjectDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceAlibabacloudStackOssBucketObjectDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*connectivity.AlibabacloudStackClient)
 	ossService := OssService{client}
 	var requestInfo *oss.Client
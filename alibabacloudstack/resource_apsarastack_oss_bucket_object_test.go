@@ -132,6 +132,78 @@ func TestAccAlibabacloudStackOssBucketObject_basic(t *testing.T) {
 	})
 }
 
+func TestAccAlibabacloudStackOssBucketObject_multipart(t *testing.T) {
+	tmpFile, err := ioutil.TempFile("", "tf-oss-object-test-acc-multipart")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.Remove(tmpFile.Name())
+
+	// 3 MB of data is uploaded in 3 parts with the threshold and the part size of 1 MB.
+	err = ioutil.WriteFile(tmpFile.Name(), []byte(strings.Repeat("a", 3*1024*1024)), 0644)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var v http.Header
+	resourceId := "alibabacloudstack_oss_bucket_object.default"
+	ra := resourceAttrInit(resourceId, ossBucketObjectBasicMap)
+	testAccCheck := ra.resourceAttrMapUpdateSet()
+	rand := acctest.RandIntRange(1000000, 9999999)
+	name := fmt.Sprintf("tf-testacc-object-%d", rand)
+	testAccConfig := resourceTestAccConfigFunc(resourceId, name, resourceOssBucketObjectConfigDependence)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+		},
+		IDRefreshName: resourceId,
+		Providers:     testAccProviders,
+		CheckDestroy:  testAccCheckAlicloudOssBucketObjectDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccConfig(map[string]interface{}{
+					"bucket":              "${alibabacloudstack_oss_bucket.default.bucket}",
+					"key":                 "test-object-source-key",
+					"source":              strings.Replace(tmpFile.Name(), "\\", "\\\\", -1),
+					"content_type":        "binary/octet-stream",
+					"acl":                 "public-read-write",
+					"multipart_threshold": "1",
+					"part_size":           "1",
+					"parallel":            "2",
+				}),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAlicloudOssBucketObjectExists(
+						"alibabacloudstack_oss_bucket_object.default", name, v),
+					testAccCheck(map[string]string{
+						"bucket":              name,
+						"source":              tmpFile.Name(),
+						"multipart_threshold": "1",
+						"part_size":           "1",
+						"parallel":            "2",
+						"etag":                CHECKSET,
+					}),
+				),
+			},
+			{
+				Config: testAccConfig(map[string]interface{}{
+					"source_hash": "v2",
+				}),
+				PreConfig: func() {
+					if err := ioutil.WriteFile(tmpFile.Name(), []byte(strings.Repeat("b", 3*1024*1024)), 0644); err != nil {
+						t.Fatal(err)
+					}
+				},
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheck(map[string]string{
+						"source_hash": "v2",
+					}),
+				),
+			},
+		},
+	})
+}
+
 func resourceOssBucketObjectConfigDependence(name string) string {
 
 	return fmt.Sprintf(`
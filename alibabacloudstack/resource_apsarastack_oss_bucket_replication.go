@@ -0,0 +1,164 @@
+package alibabacloudstack
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/aliyun/terraform-provider-alibabacloudstack/alibabacloudstack/connectivity"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+func resourceAlibabacloudStackOssBucketReplication() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: withDiagnostics(resourceAlibabacloudStackOssBucketReplicationCreate),
+		ReadContext:   withDiagnostics(resourceAlibabacloudStackOssBucketReplicationRead),
+		DeleteContext: withDiagnostics(resourceAlibabacloudStackOssBucketReplicationDelete),
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+		Timeouts: &schema.ResourceTimeout{
+			Delete: schema.DefaultTimeout(10 * time.Minute),
+		},
+		Schema: map[string]*schema.Schema{
+			"bucket": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"destination_bucket": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"destination_location": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"action": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				Default:      "ALL",
+				ValidateFunc: validation.StringInSlice([]string{"ALL", "PUT"}, false),
+			},
+			"prefixes": {
+				Type:     schema.TypeList,
+				Optional: true,
+				ForceNew: true,
+				MaxItems: 10,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"historical_object_replication": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				Default:      "enabled",
+				ValidateFunc: validation.StringInSlice([]string{"enabled", "disabled"}, false),
+			},
+			"sync_role": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+			},
+			"rule_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"status": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceAlibabacloudStackOssBucketReplicationCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*connectivity.AlibabacloudStackClient)
+	ossService := OssService{client}
+	bucket := d.Get("bucket").(string)
+	rule := OssReplicationRule{
+		Action: d.Get("action").(string),
+		Destination: OssReplicationDestination{
+			Bucket:   d.Get("destination_bucket").(string),
+			Location: d.Get("destination_location").(string),
+		},
+		HistoricalObjectReplication: d.Get("historical_object_replication").(string),
+		Prefixes:                    expandStringList(d.Get("prefixes").([]interface{})),
+	}
+	if v, ok := d.GetOk("sync_role"); ok {
+		rule.SyncRole = v.(string)
+	}
+	if err := ossService.PutOssBucketReplication(bucket, rule); err != nil {
+		return WrapError(err)
+	}
+
+	// The bucket only has one rule for each destination, which is used to find the id generated for the rule.
+	config := OssReplicationConfiguration{}
+	if _, err := ossService.doOssBucketSubResourceRequest("GET", bucket, map[string]interface{}{"replication": nil}, nil, &config); err != nil {
+		return WrapErrorf(err, DefaultErrorMsg, "alibabacloudstack_oss_bucket_replication", "GetBucketReplication", AlibabacloudStackOssGoSdk)
+	}
+	for _, r := range config.Rules {
+		if r.Destination.Bucket == rule.Destination.Bucket && r.Destination.Location == rule.Destination.Location {
+			d.SetId(fmt.Sprintf("%s%s%s", bucket, COLON_SEPARATED, r.ID))
+			break
+		}
+	}
+	if d.Id() == "" {
+		return WrapError(Error("The replication rule of the bucket %s to %s is not found after it is created.", bucket, rule.Destination.Bucket))
+	}
+
+	return resourceAlibabacloudStackOssBucketReplicationRead(ctx, d, meta)
+}
+
+func resourceAlibabacloudStackOssBucketReplicationRead(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*connectivity.AlibabacloudStackClient)
+	ossService := OssService{client}
+	object, err := ossService.DescribeOssBucketReplication(d.Id())
+	if err != nil {
+		if NotFoundError(err) {
+			log.Printf("[DEBUG] Resource alibabacloudstack_oss_bucket_replication ossService.DescribeOssBucketReplication Failed!!! %s", err)
+			d.SetId("")
+			return nil
+		}
+		return WrapError(err)
+	}
+	parts, err := ParseResourceId(d.Id(), 2)
+	if err != nil {
+		return WrapError(err)
+	}
+	d.Set("bucket", parts[0])
+	d.Set("rule_id", object.ID)
+	d.Set("destination_bucket", object.Destination.Bucket)
+	d.Set("destination_location", object.Destination.Location)
+	d.Set("action", object.Action)
+	d.Set("prefixes", object.Prefixes)
+	d.Set("historical_object_replication", object.HistoricalObjectReplication)
+	d.Set("sync_role", object.SyncRole)
+	d.Set("status", object.Status)
+	return nil
+}
+
+func resourceAlibabacloudStackOssBucketReplicationDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*connectivity.AlibabacloudStackClient)
+	ossService := OssService{client}
+	parts, err := ParseResourceId(d.Id(), 2)
+	if err != nil {
+		return WrapError(err)
+	}
+	if err := ossService.DeleteOssBucketReplication(parts[0], parts[1]); err != nil {
+		if ossNotFoundError(err) {
+			return nil
+		}
+		return WrapErrorf(err, DefaultErrorMsg, d.Id(), "DeleteBucketReplication", AlibabacloudStackOssGoSdk)
+	}
+	// The rule is in the closing state until the replication tasks are stopped.
+	stateConf := BuildStateConf([]string{"starting", "doing", "closing"}, []string{}, d.Timeout(schema.TimeoutDelete), 5*time.Second, ossService.OssBucketReplicationStateRefreshFunc(d.Id()))
+	if _, err := stateConf.WaitForStateContext(ctx); err != nil {
+		return WrapErrorf(err, IdMsg, d.Id())
+	}
+	return nil
+}
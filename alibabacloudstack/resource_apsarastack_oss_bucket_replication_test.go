@@ -0,0 +1,78 @@
+package alibabacloudstack
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/aliyun/terraform-provider-alibabacloudstack/alibabacloudstack/connectivity"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccAlibabacloudStackOssBucketReplication_basic(t *testing.T) {
+	var v OssReplicationRule
+	resourceId := "alibabacloudstack_oss_bucket_replication.default"
+	ra := resourceAttrInit(resourceId, map[string]string{
+		"bucket":                        CHECKSET,
+		"destination_bucket":            CHECKSET,
+		"destination_location":          CHECKSET,
+		"action":                        "ALL",
+		"historical_object_replication": "enabled",
+		"rule_id":                       CHECKSET,
+	})
+	serviceFunc := func() interface{} {
+		return &OssService{testAccProvider.Meta().(*connectivity.AlibabacloudStackClient)}
+	}
+	rc := resourceCheckInit(resourceId, &v, serviceFunc)
+	rac := resourceAttrCheckInit(rc, ra)
+	testAccCheck := rac.resourceAttrMapUpdateSet()
+	rand := acctest.RandIntRange(1000000, 9999999)
+	name := fmt.Sprintf("tf-testacc-replication-%d", rand)
+	testAccConfig := resourceTestAccConfigFunc(resourceId, name, resourceOssBucketReplicationConfigDependence)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+		},
+		IDRefreshName: resourceId,
+		Providers:     testAccProviders,
+		CheckDestroy:  rac.checkResourceDestroy(),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccConfig(map[string]interface{}{
+					"bucket":               "${alibabacloudstack_oss_bucket.source.bucket}",
+					"destination_bucket":   "${alibabacloudstack_oss_bucket.destination.bucket}",
+					"destination_location": fmt.Sprintf("oss-%s", defaultRegionToTest),
+					"prefixes":             []string{"logs/"},
+				}),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheck(map[string]string{
+						"prefixes.#": "1",
+						"prefixes.0": "logs/",
+					}),
+				),
+			},
+			{
+				ResourceName:      resourceId,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func resourceOssBucketReplicationConfigDependence(name string) string {
+	return fmt.Sprintf(`
+variable "name" {
+  default = "%s"
+}
+
+resource "alibabacloudstack_oss_bucket" "source" {
+  bucket = "${var.name}"
+}
+
+resource "alibabacloudstack_oss_bucket" "destination" {
+  bucket = "${var.name}-destination"
+}
+`, name)
+}
@@ -0,0 +1,110 @@
+package alibabacloudstack
+
+import (
+	"context"
+	"fmt"
+	"log"
+
+	"github.com/aliyun/terraform-provider-alibabacloudstack/alibabacloudstack/connectivity"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func resourceAlibabacloudStackOssBucketVpcAttachment() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: withDiagnostics(resourceAlibabacloudStackOssBucketVpcAttachmentCreate),
+		ReadContext:   withDiagnostics(resourceAlibabacloudStackOssBucketVpcAttachmentRead),
+		DeleteContext: withDiagnostics(resourceAlibabacloudStackOssBucketVpcAttachmentDelete),
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+		Schema: map[string]*schema.Schema{
+			"bucket": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"vpc_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"vlan": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+				Computed: true,
+			},
+			"vpc_name": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceAlibabacloudStackOssBucketVpcAttachmentCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*connectivity.AlibabacloudStackClient)
+	vpcService := VpcService{client}
+	bucketVpcService := BucketVpcService{client}
+	bucket := d.Get("bucket").(string)
+	vpc, err := vpcService.DescribeVpc(d.Get("vpc_id").(string))
+	if err != nil {
+		return WrapError(err)
+	}
+	// The bucket resource binds the vpc with its cidr block as the vlan as well.
+	vlan := vpc.CidrBlock
+	if v, ok := d.GetOk("vlan"); ok {
+		vlan = v.(string)
+	}
+	if err := bucketVpcService.BindBucket(vpc.VpcId, vpc.VpcName, vlan, bucket); err != nil {
+		return WrapError(err)
+	}
+	d.SetId(fmt.Sprintf("%s%s%s", bucket, COLON_SEPARATED, vpc.VpcId))
+	d.Set("vlan", vlan)
+	d.Set("vpc_name", vpc.VpcName)
+
+	return resourceAlibabacloudStackOssBucketVpcAttachmentRead(ctx, d, meta)
+}
+
+func resourceAlibabacloudStackOssBucketVpcAttachmentRead(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*connectivity.AlibabacloudStackClient)
+	bucketVpcService := BucketVpcService{client}
+	object, err := bucketVpcService.DescribeOssBucketVpcAttachment(d.Id())
+	if err != nil {
+		if NotFoundError(err) {
+			log.Printf("[DEBUG] Resource alibabacloudstack_oss_bucket_vpc_attachment bucketVpcService.DescribeOssBucketVpcAttachment Failed!!! %s", err)
+			d.SetId("")
+			return nil
+		}
+		return WrapError(err)
+	}
+	parts, err := ParseResourceId(d.Id(), 2)
+	if err != nil {
+		return WrapError(err)
+	}
+	d.Set("bucket", parts[0])
+	d.Set("vpc_id", parts[1])
+	if v, ok := object["vLan"]; ok && fmt.Sprint(v) != "" {
+		d.Set("vlan", fmt.Sprint(v))
+	}
+	if v, ok := object["vpcName"]; ok && fmt.Sprint(v) != "" {
+		d.Set("vpc_name", fmt.Sprint(v))
+	}
+	return nil
+}
+
+func resourceAlibabacloudStackOssBucketVpcAttachmentDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*connectivity.AlibabacloudStackClient)
+	bucketVpcService := BucketVpcService{client}
+	parts, err := ParseResourceId(d.Id(), 2)
+	if err != nil {
+		return WrapError(err)
+	}
+	if err := bucketVpcService.UnBindBucket(parts[1], parts[0]); err != nil {
+		if NotFoundError(err) {
+			return nil
+		}
+		return WrapError(err)
+	}
+	return nil
+}
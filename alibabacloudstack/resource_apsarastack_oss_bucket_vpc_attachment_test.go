@@ -0,0 +1,72 @@
+package alibabacloudstack
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/aliyun/terraform-provider-alibabacloudstack/alibabacloudstack/connectivity"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccAlibabacloudStackOssBucketVpcAttachment_basic(t *testing.T) {
+	var v map[string]interface{}
+	resourceId := "alibabacloudstack_oss_bucket_vpc_attachment.default"
+	ra := resourceAttrInit(resourceId, map[string]string{
+		"bucket":   CHECKSET,
+		"vpc_id":   CHECKSET,
+		"vlan":     "172.16.0.0/12",
+		"vpc_name": CHECKSET,
+	})
+	serviceFunc := func() interface{} {
+		return &BucketVpcService{testAccProvider.Meta().(*connectivity.AlibabacloudStackClient)}
+	}
+	rc := resourceCheckInit(resourceId, &v, serviceFunc)
+	rac := resourceAttrCheckInit(rc, ra)
+	testAccCheck := rac.resourceAttrMapUpdateSet()
+	rand := acctest.RandIntRange(1000000, 9999999)
+	name := fmt.Sprintf("tf-testacc-bucket-vpc-%d", rand)
+	testAccConfig := resourceTestAccConfigFunc(resourceId, name, resourceOssBucketVpcAttachmentConfigDependence)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+		},
+		IDRefreshName: resourceId,
+		Providers:     testAccProviders,
+		CheckDestroy:  rac.checkResourceDestroy(),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccConfig(map[string]interface{}{
+					"bucket": "${alibabacloudstack_oss_bucket.default.bucket}",
+					"vpc_id": "${alibabacloudstack_vpc.default.id}",
+				}),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheck(nil),
+				),
+			},
+			{
+				ResourceName:      resourceId,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func resourceOssBucketVpcAttachmentConfigDependence(name string) string {
+	return fmt.Sprintf(`
+variable "name" {
+  default = "%s"
+}
+
+resource "alibabacloudstack_oss_bucket" "default" {
+  bucket = "${var.name}"
+}
+
+resource "alibabacloudstack_vpc" "default" {
+  name       = "${var.name}"
+  cidr_block = "172.16.0.0/12"
+}
+`, name)
+}
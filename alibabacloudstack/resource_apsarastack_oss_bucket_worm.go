@@ -0,0 +1,137 @@
+package alibabacloudstack
+
+import (
+	"context"
+	"log"
+
+	"github.com/aliyun/terraform-provider-alibabacloudstack/alibabacloudstack/connectivity"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+func resourceAlibabacloudStackOssBucketWorm() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: withDiagnostics(resourceAlibabacloudStackOssBucketWormCreate),
+		ReadContext:   withDiagnostics(resourceAlibabacloudStackOssBucketWormRead),
+		UpdateContext: withDiagnostics(resourceAlibabacloudStackOssBucketWormUpdate),
+		DeleteContext: withDiagnostics(resourceAlibabacloudStackOssBucketWormDelete),
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+		Schema: map[string]*schema.Schema{
+			"bucket": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"retention_period_in_days": {
+				Type:         schema.TypeInt,
+				Required:     true,
+				ValidateFunc: validation.IntBetween(1, 25550),
+			},
+			"status": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      "InProgress",
+				ValidateFunc: validation.StringInSlice([]string{"InProgress", "Locked"}, false),
+			},
+			"worm_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"creation_date": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceAlibabacloudStackOssBucketWormCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*connectivity.AlibabacloudStackClient)
+	ossService := OssService{client}
+	bucket := d.Get("bucket").(string)
+	if _, err := ossService.InitiateOssBucketWorm(bucket, d.Get("retention_period_in_days").(int)); err != nil {
+		return WrapError(err)
+	}
+	d.SetId(bucket)
+
+	return resourceAlibabacloudStackOssBucketWormUpdate(ctx, d, meta)
+}
+
+func resourceAlibabacloudStackOssBucketWormRead(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*connectivity.AlibabacloudStackClient)
+	ossService := OssService{client}
+	object, err := ossService.DescribeOssBucketWorm(d.Id())
+	if err != nil {
+		if NotFoundError(err) {
+			log.Printf("[DEBUG] Resource alibabacloudstack_oss_bucket_worm ossService.DescribeOssBucketWorm Failed!!! %s", err)
+			d.SetId("")
+			return nil
+		}
+		return WrapError(err)
+	}
+	d.Set("bucket", d.Id())
+	d.Set("retention_period_in_days", object.RetentionPeriodInDays)
+	d.Set("status", object.State)
+	d.Set("worm_id", object.WormId)
+	d.Set("creation_date", object.CreationDate)
+	return nil
+}
+
+func resourceAlibabacloudStackOssBucketWormUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*connectivity.AlibabacloudStackClient)
+	ossService := OssService{client}
+	object, err := ossService.DescribeOssBucketWorm(d.Id())
+	if err != nil {
+		return WrapError(err)
+	}
+	days := d.Get("retention_period_in_days").(int)
+
+	if object.State == "Locked" {
+		if d.Get("status").(string) != "Locked" {
+			return WrapError(Error("The locked worm policy of the bucket %s can not be unlocked.", d.Id()))
+		}
+		if days < object.RetentionPeriodInDays {
+			return WrapError(Error("The retention period of the locked worm policy of the bucket %s can only be extended, from %d days to %d days is not allowed.", d.Id(), object.RetentionPeriodInDays, days))
+		}
+		if days > object.RetentionPeriodInDays {
+			if err := ossService.ExtendOssBucketWorm(d.Id(), object.WormId, days); err != nil {
+				return WrapError(err)
+			}
+		}
+		return resourceAlibabacloudStackOssBucketWormRead(ctx, d, meta)
+	}
+
+	// The policy which is not locked can not be extended, so it is initiated again with the new retention period.
+	if days != object.RetentionPeriodInDays {
+		if err := ossService.AbortOssBucketWorm(d.Id()); err != nil {
+			return WrapErrorf(err, DefaultErrorMsg, d.Id(), "AbortBucketWorm", AlibabacloudStackOssGoSdk)
+		}
+		if object.WormId, err = ossService.InitiateOssBucketWorm(d.Id(), days); err != nil {
+			return WrapError(err)
+		}
+	}
+	if d.Get("status").(string) == "Locked" {
+		if err := ossService.CompleteOssBucketWorm(d.Id(), object.WormId); err != nil {
+			return WrapError(err)
+		}
+	}
+	return resourceAlibabacloudStackOssBucketWormRead(ctx, d, meta)
+}
+
+func resourceAlibabacloudStackOssBucketWormDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*connectivity.AlibabacloudStackClient)
+	ossService := OssService{client}
+	if d.Get("status").(string) == "Locked" {
+		log.Printf("[WARN] The locked worm policy of the bucket %s can not be deleted, and it is only removed from the state.", d.Id())
+		return nil
+	}
+	if err := ossService.AbortOssBucketWorm(d.Id()); err != nil {
+		if ossNotFoundError(err) {
+			return nil
+		}
+		return WrapErrorf(err, DefaultErrorMsg, d.Id(), "AbortBucketWorm", AlibabacloudStackOssGoSdk)
+	}
+	return nil
+}
@@ -1,6 +1,7 @@
 package alibabacloudstack
 
 import (
+	"context"
 	"time"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
@@ -13,12 +14,12 @@ import (
 
 func resourceAlibabacloudStackOtsInstance() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceAliyunOtsInstanceCreate,
-		Read:   resourceAliyunOtsInstanceRead,
-		Update: resourceAliyunOtsInstanceUpdate,
-		Delete: resourceAliyunOtsInstanceDelete,
+		CreateContext: withDiagnostics(resourceAliyunOtsInstanceCreate),
+		ReadContext:   withDiagnostics(resourceAliyunOtsInstanceRead),
+		UpdateContext: withDiagnostics(resourceAliyunOtsInstanceUpdate),
+		DeleteContext: withDiagnostics(resourceAliyunOtsInstanceDelete),
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			StateContext: schema.ImportStatePassthroughContext,
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -60,7 +61,7 @@ func resourceAlibabacloudStackOtsInstance() *schema.Resource {
 	}
 }
 
-func resourceAliyunOtsInstanceCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceAliyunOtsInstanceCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*connectivity.AlibabacloudStackClient)
 	otsService := OtsService{client}
 
@@ -103,10 +104,10 @@ func resourceAliyunOtsInstanceCreate(d *schema.ResourceData, meta interface{}) e
 	if err := otsService.WaitForOtsInstance(request.InstanceName, Running, DefaultTimeout/3); err != nil {
 		return WrapError(err)
 	}
-	return resourceAliyunOtsInstanceUpdate(d, meta)
+	return resourceAliyunOtsInstanceUpdate(ctx, d, meta)
 }
 
-func resourceAliyunOtsInstanceRead(d *schema.ResourceData, meta interface{}) error {
+func resourceAliyunOtsInstanceRead(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*connectivity.AlibabacloudStackClient)
 	otsService := OtsService{client}
 	object, err := otsService.DescribeOtsInstance(d.Id())
@@ -126,7 +127,7 @@ func resourceAliyunOtsInstanceRead(d *schema.ResourceData, meta interface{}) err
 	return nil
 }
 
-func resourceAliyunOtsInstanceUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceAliyunOtsInstanceUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*connectivity.AlibabacloudStackClient)
 	otsService := OtsService{client}
 
@@ -150,8 +151,8 @@ func resourceAliyunOtsInstanceUpdate(d *schema.ResourceData, meta interface{}) e
 		d.SetPartial("accessed_by")
 	}
 
-	if d.HasChange("tags") {
-		oraw, nraw := d.GetChange("tags")
+	if hasTagsAllChange(d, client) {
+		oraw, nraw := getTagsAllChange(d, client)
 		o := oraw.(map[string]interface{})
 		n := nraw.(map[string]interface{})
 		create, remove := diffTags(tagsFromMap(o), tagsFromMap(n))
@@ -206,10 +207,10 @@ func resourceAliyunOtsInstanceUpdate(d *schema.ResourceData, meta interface{}) e
 		return WrapError(err)
 	}
 	d.Partial(false)
-	return resourceAliyunOtsInstanceRead(d, meta)
+	return resourceAliyunOtsInstanceRead(ctx, d, meta)
 }
 
-func resourceAliyunOtsInstanceDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceAliyunOtsInstanceDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*connectivity.AlibabacloudStackClient)
 	otsService := OtsService{client}
 	request := ots.CreateDeleteInstanceRequest()
@@ -219,7 +220,7 @@ func resourceAliyunOtsInstanceDelete(d *schema.ResourceData, meta interface{}) e
 
 	request.QueryParams = map[string]string{"AccessKeySecret": client.SecretKey, "Product": "Ots", "Department": client.Department, "ResourceGroup": client.ResourceGroup}
 	request.InstanceName = d.Id()
-	err := resource.Retry(10*time.Minute, func() *resource.RetryError {
+	err := resource.RetryContext(ctx, 10*time.Minute, func() *resource.RetryError {
 		raw, err := client.WithOtsClient(func(otsClient *ots.Client) (interface{}, error) {
 			return otsClient.DeleteInstance(request)
 		})
@@ -1,6 +1,7 @@
 package alibabacloudstack
 
 import (
+	"context"
 	"github.com/aliyun/alibaba-cloud-sdk-go/services/ots"
 	"github.com/aliyun/terraform-provider-alibabacloudstack/alibabacloudstack/connectivity"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
@@ -8,9 +9,20 @@ import (
 
 func resourceAlibabacloudStackOtsInstanceAttachment() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceAliyunOtsInstanceAttachmentCreate,
-		Read:   resourceAliyunOtsInstanceAttachmentRead,
-		Delete: resourceAliyunOtsInstanceAttachmentDelete,
+		CreateContext: withDiagnostics(resourceAliyunOtsInstanceAttachmentCreate),
+		ReadContext:   withDiagnostics(resourceAliyunOtsInstanceAttachmentRead),
+		DeleteContext: withDiagnostics(resourceAliyunOtsInstanceAttachmentDelete),
+		Importer: &schema.ResourceImporter{
+			StateContext: func(ctx context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+				parts, err := ParseResourceId(d.Id(), 2)
+				if err != nil {
+					return nil, WrapError(err)
+				}
+				d.SetId(parts[0])
+				d.Set("vswitch_id", parts[1])
+				return []*schema.ResourceData{d}, nil
+			},
+		},
 
 		Schema: map[string]*schema.Schema{
 			"instance_name": {
@@ -37,7 +49,7 @@ func resourceAlibabacloudStackOtsInstanceAttachment() *schema.Resource {
 	}
 }
 
-func resourceAliyunOtsInstanceAttachmentCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceAliyunOtsInstanceAttachmentCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*connectivity.AlibabacloudStackClient)
 	vpcService := VpcService{client}
 
@@ -64,10 +76,10 @@ func resourceAliyunOtsInstanceAttachmentCreate(d *schema.ResourceData, meta inte
 	addDebug(request.GetActionName(), raw, request.RpcRequest, request)
 
 	d.SetId(request.InstanceName)
-	return resourceAliyunOtsInstanceAttachmentRead(d, meta)
+	return resourceAliyunOtsInstanceAttachmentRead(ctx, d, meta)
 }
 
-func resourceAliyunOtsInstanceAttachmentRead(d *schema.ResourceData, meta interface{}) error {
+func resourceAliyunOtsInstanceAttachmentRead(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*connectivity.AlibabacloudStackClient)
 	otsService := OtsService{client}
 	object, err := otsService.DescribeOtsInstanceAttachment(d.Id())
@@ -78,14 +90,14 @@ func resourceAliyunOtsInstanceAttachmentRead(d *schema.ResourceData, meta interf
 		}
 		return WrapError(err)
 	}
-	// There is a bug that inst does not contain instance name and vswitch ID, so this resource does not support import function.
-	//d.Set("instance_name", inst.InstanceName)
+	// The vpc info does not contain the vswitch ID, which is given by the import ID instead.
+	d.Set("instance_name", d.Id())
 	d.Set("vpc_name", object.InstanceVpcName)
 	d.Set("vpc_id", object.VpcId)
 	return nil
 }
 
-func resourceAliyunOtsInstanceAttachmentDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceAliyunOtsInstanceAttachmentDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*connectivity.AlibabacloudStackClient)
 	otsService := OtsService{client}
 	object, err := otsService.DescribeOtsInstanceAttachment(d.Id())
@@ -1,6 +1,7 @@
 package alibabacloudstack
 
 import (
+	"context"
 	"fmt"
 
 	"strings"
@@ -18,12 +19,12 @@ import (
 
 func resourceAlibabacloudStackOtsTable() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceAliyunOtsTableCreate,
-		Read:   resourceAliyunOtsTableRead,
-		Update: resourceAliyunOtsTableUpdate,
-		Delete: resourceAliyunOtsTableDelete,
+		CreateContext: withDiagnostics(resourceAliyunOtsTableCreate),
+		ReadContext:   withDiagnostics(resourceAliyunOtsTableRead),
+		UpdateContext: withDiagnostics(resourceAliyunOtsTableUpdate),
+		DeleteContext: withDiagnostics(resourceAliyunOtsTableDelete),
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			StateContext: schema.ImportStatePassthroughContext,
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -80,14 +81,14 @@ func resourceAlibabacloudStackOtsTable() *schema.Resource {
 	}
 }
 
-func resourceAliyunOtsTableCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceAliyunOtsTableCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 	tableMeta := new(tablestore.TableMeta)
 	instanceName := d.Get("instance_name").(string)
 	tableName := d.Get("table_name").(string)
 	tableMeta.TableName = tableName
 	client := meta.(*connectivity.AlibabacloudStackClient)
 	otsService := OtsService{client}
-	if err := resource.Retry(1*time.Minute, func() *resource.RetryError {
+	if err := resource.RetryContext(ctx, 1*time.Minute, func() *resource.RetryError {
 		_, e := otsService.DescribeOtsInstance(instanceName)
 		if e != nil {
 			if NotFoundError(e) {
@@ -117,7 +118,7 @@ func resourceAliyunOtsTableCreate(d *schema.ResourceData, meta interface{}) erro
 	request.TableOption = tableOption
 	request.ReservedThroughput = reservedThroughput
 	var requestinfo *tablestore.TableStoreClient
-	if err := resource.Retry(6*time.Minute, func() *resource.RetryError {
+	if err := resource.RetryContext(ctx, 6*time.Minute, func() *resource.RetryError {
 		raw, err := client.WithTableStoreClient(instanceName, func(tableStoreClient *tablestore.TableStoreClient) (interface{}, error) {
 			requestinfo = tableStoreClient
 			return tableStoreClient.CreateTable(request)
@@ -135,10 +136,10 @@ func resourceAliyunOtsTableCreate(d *schema.ResourceData, meta interface{}) erro
 	}
 
 	d.SetId(fmt.Sprintf("%s%s%s", instanceName, COLON_SEPARATED, tableName))
-	return resourceAliyunOtsTableRead(d, meta)
+	return resourceAliyunOtsTableRead(ctx, d, meta)
 }
 
-func resourceAliyunOtsTableRead(d *schema.ResourceData, meta interface{}) error {
+func resourceAliyunOtsTableRead(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 	instanceName, _, err := parseId(d, meta)
 	if err != nil {
 		return WrapError(err)
@@ -175,7 +176,7 @@ func resourceAliyunOtsTableRead(d *schema.ResourceData, meta interface{}) error
 	return nil
 }
 
-func resourceAliyunOtsTableUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceAliyunOtsTableUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 	// As the issue of ots sdk, time_to_live and max_version need to be updated together at present.
 	// For the issue, please refer to https://github.com/aliyun/aliyun-tablestore-go-sdk/issues/18
 	if d.HasChange("time_to_live") || d.HasChange("max_version") || d.HasChange("deviation_cell_version_in_sec") {
@@ -197,7 +198,7 @@ func resourceAliyunOtsTableUpdate(d *schema.ResourceData, meta interface{}) erro
 
 		request.TableOption = tableOption
 		var requestinfo *tablestore.TableStoreClient
-		if err := resource.Retry(3*time.Minute, func() *resource.RetryError {
+		if err := resource.RetryContext(ctx, 3*time.Minute, func() *resource.RetryError {
 			raw, err := client.WithTableStoreClient(instanceName, func(tableStoreClient *tablestore.TableStoreClient) (interface{}, error) {
 				requestinfo = tableStoreClient
 				return tableStoreClient.UpdateTable(request)
@@ -214,10 +215,10 @@ func resourceAliyunOtsTableUpdate(d *schema.ResourceData, meta interface{}) erro
 			return WrapErrorf(err, DefaultErrorMsg, d.Id(), "UpdateTable", AliyunTablestoreGoSdk)
 		}
 	}
-	return resourceAliyunOtsTableRead(d, meta)
+	return resourceAliyunOtsTableRead(ctx, d, meta)
 }
 
-func resourceAliyunOtsTableDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceAliyunOtsTableDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 	instanceName, tableName, err := parseId(d, meta)
 	if err != nil {
 		return WrapError(err)
@@ -228,7 +229,7 @@ func resourceAliyunOtsTableDelete(d *schema.ResourceData, meta interface{}) erro
 	req := new(tablestore.DeleteTableRequest)
 	req.TableName = tableName
 	var requestinfo *tablestore.TableStoreClient
-	err = resource.Retry(2*time.Minute, func() *resource.RetryError {
+	err = resource.RetryContext(ctx, 2*time.Minute, func() *resource.RetryError {
 		raw, err := client.WithTableStoreClient(instanceName, func(tableStoreClient *tablestore.TableStoreClient) (interface{}, error) {
 			requestinfo = tableStoreClient
 			return tableStoreClient.DeleteTable(req)
@@ -1,6 +1,7 @@
 package alibabacloudstack
 
 import (
+	"context"
 	"fmt"
 	"log"
 	"strings"
@@ -15,12 +16,12 @@ import (
 
 func resourceAlibabacloudStackQuickBiUser() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceAlibabacloudStackQuickBiUserCreate,
-		Read:   resourceAlibabacloudStackQuickBiUserRead,
-		Update: resourceAlibabacloudStackQuickBiUserUpdate,
-		Delete: resourceAlibabacloudStackQuickBiUserDelete,
+		CreateContext: withDiagnostics(resourceAlibabacloudStackQuickBiUserCreate),
+		ReadContext:   withDiagnostics(resourceAlibabacloudStackQuickBiUserRead),
+		UpdateContext: withDiagnostics(resourceAlibabacloudStackQuickBiUserUpdate),
+		DeleteContext: withDiagnostics(resourceAlibabacloudStackQuickBiUserDelete),
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			StateContext: schema.ImportStatePassthroughContext,
 		},
 		Schema: map[string]*schema.Schema{
 			"account_id": {
@@ -61,7 +62,7 @@ func resourceAlibabacloudStackQuickBiUser() *schema.Resource {
 	}
 }
 
-func resourceAlibabacloudStackQuickBiUserCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceAlibabacloudStackQuickBiUserCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*connectivity.AlibabacloudStackClient)
 	var response map[string]interface{}
 	action := "AddUser"
@@ -79,7 +80,7 @@ func resourceAlibabacloudStackQuickBiUserCreate(d *schema.ResourceData, meta int
 	request["NickName"] = d.Get("nick_name")
 	request["UserType"] = convertQuickBiUserUserTypeRequest(d.Get("user_type").(string))
 	wait := incrementalWait(3*time.Second, 3*time.Second)
-	err = resource.Retry(d.Timeout(schema.TimeoutCreate), func() *resource.RetryError {
+	err = resource.RetryContext(ctx, d.Timeout(schema.TimeoutCreate), func() *resource.RetryError {
 		response, err = conn.DoRequest(StringPointer(action), nil, StringPointer("POST"), StringPointer("2022-03-01"), StringPointer("AK"), nil, request, &util.RuntimeOptions{})
 		if err != nil {
 			if NeedRetry(err) {
@@ -98,9 +99,9 @@ func resourceAlibabacloudStackQuickBiUserCreate(d *schema.ResourceData, meta int
 	responseResult := response["Result"].(map[string]interface{})
 	d.SetId(fmt.Sprint(responseResult["UserId"]))
 
-	return resourceAlibabacloudStackQuickBiUserRead(d, meta)
+	return resourceAlibabacloudStackQuickBiUserRead(ctx, d, meta)
 }
-func resourceAlibabacloudStackQuickBiUserRead(d *schema.ResourceData, meta interface{}) error {
+func resourceAlibabacloudStackQuickBiUserRead(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*connectivity.AlibabacloudStackClient)
 	quickbiPublicService := QuickbiPublicService{client}
 	object, err := quickbiPublicService.DescribeQuickBiUser(d.Id())
@@ -121,7 +122,7 @@ func resourceAlibabacloudStackQuickBiUserRead(d *schema.ResourceData, meta inter
 	d.Set("user_type", convertQuickBiUserUserTypeResponse(formatInt(object["UserType"])))
 	return nil
 }
-func resourceAlibabacloudStackQuickBiUserUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceAlibabacloudStackQuickBiUserUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*connectivity.AlibabacloudStackClient)
 	var response map[string]interface{}
 	update := false
@@ -149,7 +150,7 @@ func resourceAlibabacloudStackQuickBiUserUpdate(d *schema.ResourceData, meta int
 			return WrapError(err)
 		}
 		wait := incrementalWait(3*time.Second, 3*time.Second)
-		err = resource.Retry(d.Timeout(schema.TimeoutUpdate), func() *resource.RetryError {
+		err = resource.RetryContext(ctx, d.Timeout(schema.TimeoutUpdate), func() *resource.RetryError {
 			response, err = conn.DoRequest(StringPointer(action), nil, StringPointer("POST"), StringPointer("2022-03-01"), StringPointer("AK"), nil, request, &util.RuntimeOptions{})
 			if err != nil {
 				if NeedRetry(err) {
@@ -165,9 +166,9 @@ func resourceAlibabacloudStackQuickBiUserUpdate(d *schema.ResourceData, meta int
 			return WrapErrorf(err, DefaultErrorMsg, d.Id(), action, AlibabacloudStackSdkGoERROR)
 		}
 	}
-	return resourceAlibabacloudStackQuickBiUserRead(d, meta)
+	return resourceAlibabacloudStackQuickBiUserRead(ctx, d, meta)
 }
-func resourceAlibabacloudStackQuickBiUserDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceAlibabacloudStackQuickBiUserDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*connectivity.AlibabacloudStackClient)
 	action := "DeleteUser"
 	var response map[string]interface{}
@@ -180,7 +181,7 @@ func resourceAlibabacloudStackQuickBiUserDelete(d *schema.ResourceData, meta int
 	}
 
 	wait := incrementalWait(3*time.Second, 3*time.Second)
-	err = resource.Retry(d.Timeout(schema.TimeoutDelete), func() *resource.RetryError {
+	err = resource.RetryContext(ctx, d.Timeout(schema.TimeoutDelete), func() *resource.RetryError {
 		response, err = conn.DoRequest(StringPointer(action), nil, StringPointer("POST"), StringPointer("2022-03-01"), StringPointer("AK"), nil, request, &util.RuntimeOptions{})
 		if err != nil {
 			if NeedRetry(err) {
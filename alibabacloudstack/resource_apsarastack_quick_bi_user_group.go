@@ -1,6 +1,7 @@
 package alibabacloudstack
 
 import (
+	"context"
 	"time"
 
 	util "github.com/alibabacloud-go/tea-utils/service"
@@ -11,12 +12,12 @@ import (
 
 func resourceAlibabacloudStackQuickBiUserGroup() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceAlibabacloudStackQuickBiUserGroupCreate,
-		Read:   resourceAlibabacloudStackQuickBiUserGroupRead,
-		Update: resourceAlibabacloudStackQuickBiUserGroupUpdate,
-		Delete: resourceAlibabacloudStackQuickBiUserGroupDelete,
+		CreateContext: withDiagnostics(resourceAlibabacloudStackQuickBiUserGroupCreate),
+		ReadContext:   withDiagnostics(resourceAlibabacloudStackQuickBiUserGroupRead),
+		UpdateContext: withDiagnostics(resourceAlibabacloudStackQuickBiUserGroupUpdate),
+		DeleteContext: withDiagnostics(resourceAlibabacloudStackQuickBiUserGroupDelete),
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			StateContext: schema.ImportStatePassthroughContext,
 		},
 		Schema: map[string]*schema.Schema{
 			"user_group_id": {
@@ -46,7 +47,7 @@ var UserGroupDescription string
 var ParentUserGroupId string
 var UserGroupId string
 
-func resourceAlibabacloudStackQuickBiUserGroupCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceAlibabacloudStackQuickBiUserGroupCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*connectivity.AlibabacloudStackClient)
 	var response map[string]interface{}
 	action := "CreateUserGroup"
@@ -68,7 +69,7 @@ func resourceAlibabacloudStackQuickBiUserGroupCreate(d *schema.ResourceData, met
 	request["ParentUserGroupId"] = ParentUserGroupId
 
 	wait := incrementalWait(3*time.Second, 3*time.Second)
-	err = resource.Retry(d.Timeout(schema.TimeoutCreate), func() *resource.RetryError {
+	err = resource.RetryContext(ctx, d.Timeout(schema.TimeoutCreate), func() *resource.RetryError {
 		response, err = conn.DoRequest(StringPointer(action), nil, StringPointer("POST"), StringPointer("2022-03-01"), StringPointer("AK"), nil, request, &util.RuntimeOptions{})
 		if err != nil {
 			if NeedRetry(err) {
@@ -88,9 +89,9 @@ func resourceAlibabacloudStackQuickBiUserGroupCreate(d *schema.ResourceData, met
 	UserGroupId = responseResult
 	d.SetId(responseResult)
 
-	return resourceAlibabacloudStackQuickBiUserGroupRead(d, meta)
+	return resourceAlibabacloudStackQuickBiUserGroupRead(ctx, d, meta)
 }
-func resourceAlibabacloudStackQuickBiUserGroupRead(d *schema.ResourceData, meta interface{}) error {
+func resourceAlibabacloudStackQuickBiUserGroupRead(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 
 	d.Set("user_group_id", UserGroupId)
 	d.Set("user_group_name", UserGroupName)
@@ -99,7 +100,7 @@ func resourceAlibabacloudStackQuickBiUserGroupRead(d *schema.ResourceData, meta
 
 	return nil
 }
-func resourceAlibabacloudStackQuickBiUserGroupUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceAlibabacloudStackQuickBiUserGroupUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*connectivity.AlibabacloudStackClient)
 	var response map[string]interface{}
 	update := false
@@ -124,7 +125,7 @@ func resourceAlibabacloudStackQuickBiUserGroupUpdate(d *schema.ResourceData, met
 			return WrapError(err)
 		}
 		wait := incrementalWait(3*time.Second, 3*time.Second)
-		err = resource.Retry(d.Timeout(schema.TimeoutUpdate), func() *resource.RetryError {
+		err = resource.RetryContext(ctx, d.Timeout(schema.TimeoutUpdate), func() *resource.RetryError {
 			response, err = conn.DoRequest(StringPointer(action), nil, StringPointer("POST"), StringPointer("2022-03-01"), StringPointer("AK"), nil, request, &util.RuntimeOptions{})
 			if err != nil {
 				if NeedRetry(err) {
@@ -141,9 +142,9 @@ func resourceAlibabacloudStackQuickBiUserGroupUpdate(d *schema.ResourceData, met
 		}
 	}
 
-	return resourceAlibabacloudStackQuickBiUserGroupRead(d, meta)
+	return resourceAlibabacloudStackQuickBiUserGroupRead(ctx, d, meta)
 }
-func resourceAlibabacloudStackQuickBiUserGroupDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceAlibabacloudStackQuickBiUserGroupDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*connectivity.AlibabacloudStackClient)
 	action := "DeleteUserGroup"
 	var response map[string]interface{}
@@ -156,7 +157,7 @@ func resourceAlibabacloudStackQuickBiUserGroupDelete(d *schema.ResourceData, met
 	}
 
 	wait := incrementalWait(3*time.Second, 3*time.Second)
-	err = resource.Retry(d.Timeout(schema.TimeoutDelete), func() *resource.RetryError {
+	err = resource.RetryContext(ctx, d.Timeout(schema.TimeoutDelete), func() *resource.RetryError {
 		response, err = conn.DoRequest(StringPointer(action), nil, StringPointer("POST"), StringPointer("2022-03-01"), StringPointer("AK"), nil, request, &util.RuntimeOptions{})
 		if err != nil {
 			if NeedRetry(err) {
@@ -1,6 +1,7 @@
 package alibabacloudstack
 
 import (
+	"context"
 	"fmt"
 	"time"
 
@@ -12,12 +13,12 @@ import (
 
 func resourceAlibabacloudStackQuickBiWorkspace() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceAlibabacloudStackQuickBiWorkspaceCreate,
-		Read:   resourceAlibabacloudStackQuickBiWorkspaceRead,
-		Update: resourceAlibabacloudStackQuickBiWorkspaceUpdate,
-		Delete: resourceAlibabacloudStackQuickBiWorkspaceDelete,
+		CreateContext: withDiagnostics(resourceAlibabacloudStackQuickBiWorkspaceCreate),
+		ReadContext:   withDiagnostics(resourceAlibabacloudStackQuickBiWorkspaceRead),
+		UpdateContext: withDiagnostics(resourceAlibabacloudStackQuickBiWorkspaceUpdate),
+		DeleteContext: withDiagnostics(resourceAlibabacloudStackQuickBiWorkspaceDelete),
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			StateContext: schema.ImportStatePassthroughContext,
 		},
 		Schema: map[string]*schema.Schema{
 			"workspace_id": {
@@ -55,7 +56,7 @@ var UseComment bool
 var AllowShare bool
 var AllowPublish bool
 
-func resourceAlibabacloudStackQuickBiWorkspaceCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceAlibabacloudStackQuickBiWorkspaceCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*connectivity.AlibabacloudStackClient)
 	var response map[string]interface{}
 	action := "CreateWorkSpace"
@@ -77,7 +78,7 @@ func resourceAlibabacloudStackQuickBiWorkspaceCreate(d *schema.ResourceData, met
 	request["AllowShare"] = AllowShare
 	request["AllowPublish"] = AllowPublish
 	wait := incrementalWait(3*time.Second, 3*time.Second)
-	err = resource.Retry(d.Timeout(schema.TimeoutCreate), func() *resource.RetryError {
+	err = resource.RetryContext(ctx, d.Timeout(schema.TimeoutCreate), func() *resource.RetryError {
 		response, err = conn.DoRequest(StringPointer(action), nil, StringPointer("POST"), StringPointer("2022-03-01"), StringPointer("AK"), nil, request, &util.RuntimeOptions{})
 		if err != nil {
 			if NeedRetry(err) {
@@ -96,9 +97,9 @@ func resourceAlibabacloudStackQuickBiWorkspaceCreate(d *schema.ResourceData, met
 	WorkspaceId = response["Result"].(string)
 	d.SetId(fmt.Sprint(WorkspaceId))
 
-	return resourceAlibabacloudStackQuickBiWorkspaceRead(d, meta)
+	return resourceAlibabacloudStackQuickBiWorkspaceRead(ctx, d, meta)
 }
-func resourceAlibabacloudStackQuickBiWorkspaceRead(d *schema.ResourceData, meta interface{}) error {
+func resourceAlibabacloudStackQuickBiWorkspaceRead(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 
 	d.Set("workspace_id", WorkspaceId)
 	d.Set("workspace_name", WorkspaceName)
@@ -109,10 +110,10 @@ func resourceAlibabacloudStackQuickBiWorkspaceRead(d *schema.ResourceData, meta
 
 	return nil
 }
-func resourceAlibabacloudStackQuickBiWorkspaceUpdate(d *schema.ResourceData, meta interface{}) error {
-	return resourceAlibabacloudStackQuickBiWorkspaceRead(d, meta)
+func resourceAlibabacloudStackQuickBiWorkspaceUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
+	return resourceAlibabacloudStackQuickBiWorkspaceRead(ctx, d, meta)
 }
-func resourceAlibabacloudStackQuickBiWorkspaceDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceAlibabacloudStackQuickBiWorkspaceDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*connectivity.AlibabacloudStackClient)
 	action := "DeleteWorkSpace"
 	var response map[string]interface{}
@@ -125,7 +126,7 @@ func resourceAlibabacloudStackQuickBiWorkspaceDelete(d *schema.ResourceData, met
 	}
 
 	wait := incrementalWait(3*time.Second, 3*time.Second)
-	err = resource.Retry(d.Timeout(schema.TimeoutDelete), func() *resource.RetryError {
+	err = resource.RetryContext(ctx, d.Timeout(schema.TimeoutDelete), func() *resource.RetryError {
 		response, err = conn.DoRequest(StringPointer(action), nil, StringPointer("POST"), StringPointer("2022-03-01"), StringPointer("AK"), nil, request, &util.RuntimeOptions{})
 		if err != nil {
 			if NeedRetry(err) {
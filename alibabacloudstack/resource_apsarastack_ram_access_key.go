@@ -1,6 +1,7 @@
 package alibabacloudstack
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
 	"github.com/aliyun/alibaba-cloud-sdk-go/sdk/requests"
@@ -17,10 +18,10 @@ import (
 
 func resourceAlibabacloudstackRamAccessKey() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceAlibabacloudstackAscmAccessKeyCreate,
-		Read:   resourceAlibabacloudstackAscmAccessKeyRead,
-		Update: resourceAlibabacloudstackAscmAccessKeyUpdate,
-		Delete: resourceAlibabacloudstackAscmAccessKeyDelete,
+		CreateContext: withDiagnostics(resourceAlibabacloudstackAscmAccessKeyCreate),
+		ReadContext:   withDiagnostics(resourceAlibabacloudstackAscmAccessKeyRead),
+		UpdateContext: withDiagnostics(resourceAlibabacloudstackAscmAccessKeyUpdate),
+		DeleteContext: withDiagnostics(resourceAlibabacloudstackAscmAccessKeyDelete),
 
 		Schema: map[string]*schema.Schema{
 			"user_name": {
@@ -61,7 +62,7 @@ func resourceAlibabacloudstackRamAccessKey() *schema.Resource {
 	}
 }
 
-func resourceAlibabacloudstackAscmAccessKeyCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceAlibabacloudstackAscmAccessKeyCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*connectivity.AlibabacloudStackClient)
 	var requestInfo *ecs.Client
 	//  userName:=d.Get("user_name")
@@ -114,14 +115,14 @@ func resourceAlibabacloudstackAscmAccessKeyCreate(d *schema.ResourceData, meta i
 
 	d.SetId(fmt.Sprint(response.AccessKeyId))
 
-	return resourceAlibabacloudstackAscmAccessKeyUpdate(d, meta)
+	return resourceAlibabacloudstackAscmAccessKeyUpdate(ctx, d, meta)
 }
 
-func resourceAlibabacloudstackAscmAccessKeyUpdate(d *schema.ResourceData, meta interface{}) error {
-	return resourceAlibabacloudstackAscmAccessKeyRead(d, meta)
+func resourceAlibabacloudstackAscmAccessKeyUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
+	return resourceAlibabacloudstackAscmAccessKeyRead(ctx, d, meta)
 }
 
-func resourceAlibabacloudstackAscmAccessKeyRead(d *schema.ResourceData, meta interface{}) error {
+func resourceAlibabacloudstackAscmAccessKeyRead(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 	wiatSecondsIfWithTest(1)
 	client := meta.(*connectivity.AlibabacloudStackClient)
 	ascmService := AscmService{client}
@@ -137,7 +138,7 @@ func resourceAlibabacloudstackAscmAccessKeyRead(d *schema.ResourceData, meta int
 	return nil
 }
 
-func resourceAlibabacloudstackAscmAccessKeyDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceAlibabacloudstackAscmAccessKeyDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*connectivity.AlibabacloudStackClient)
 	ascmService := AscmService{client}
 	var requestInfo *ecs.Client
@@ -147,7 +148,7 @@ func resourceAlibabacloudstackAscmAccessKeyDelete(d *schema.ResourceData, meta i
 		return WrapErrorf(err, DefaultErrorMsg, d.Id(), "IsResourceGroupExist", AlibabacloudStackSdkGoERROR)
 	}
 	addDebug("IsKeyExist", check, requestInfo, map[string]string{"resourceGroupName": d.Id()})
-	err = resource.Retry(1*time.Minute, func() *resource.RetryError {
+	err = resource.RetryContext(ctx, 1*time.Minute, func() *resource.RetryError {
 
 		request := requests.NewCommonRequest()
 		if client.Config.Insecure {
@@ -1,6 +1,7 @@
 package alibabacloudstack
 
 import (
+	"context"
 	"fmt"
 	"strings"
 	"time"
@@ -13,9 +14,12 @@ import (
 
 func resourceAlibabacloudStackRamRoleAttachment() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceAlibabacloudStackInstanceRoleAttachmentCreate,
-		Read:   resourceAlibabacloudStackInstanceRoleAttachmentRead,
-		Delete: resourceAlibabacloudStackInstanceRoleAttachmentDelete,
+		CreateContext: withDiagnostics(resourceAlibabacloudStackInstanceRoleAttachmentCreate),
+		ReadContext:   withDiagnostics(resourceAlibabacloudStackInstanceRoleAttachmentRead),
+		DeleteContext: withDiagnostics(resourceAlibabacloudStackInstanceRoleAttachmentDelete),
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
 
 		Schema: map[string]*schema.Schema{
 			"role_name": {
@@ -33,7 +37,7 @@ func resourceAlibabacloudStackRamRoleAttachment() *schema.Resource {
 	}
 }
 
-func resourceAlibabacloudStackInstanceRoleAttachmentCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceAlibabacloudStackInstanceRoleAttachmentCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*connectivity.AlibabacloudStackClient)
 	var instanceId string
 	var instanceIds []string
@@ -62,7 +66,7 @@ func resourceAlibabacloudStackInstanceRoleAttachmentCreate(d *schema.ResourceDat
 	request.InstanceIds = fmt.Sprintf("[\"%s\"]", instanceId)
 	request.RamRoleName = d.Get("role_name").(string)
 
-	err := resource.Retry(5*time.Minute, func() *resource.RetryError {
+	err := resource.RetryContext(ctx, 5*time.Minute, func() *resource.RetryError {
 		raw, err := client.WithEcsClient(func(ecsClient *ecs.Client) (interface{}, error) {
 			return ecsClient.AttachInstanceRamRole(request)
 		})
@@ -80,10 +84,10 @@ func resourceAlibabacloudStackInstanceRoleAttachmentCreate(d *schema.ResourceDat
 	if err != nil {
 		return WrapErrorf(err, DefaultErrorMsg, "ram_role_attachment", request.GetActionName(), AlibabacloudStackSdkGoERROR)
 	}
-	return resourceAlibabacloudStackInstanceRoleAttachmentRead(d, meta)
+	return resourceAlibabacloudStackInstanceRoleAttachmentRead(ctx, d, meta)
 }
 
-func resourceAlibabacloudStackInstanceRoleAttachmentRead(d *schema.ResourceData, meta interface{}) error {
+func resourceAlibabacloudStackInstanceRoleAttachmentRead(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 	wiatSecondsIfWithTest(1)
 	parts, err := ParseResourceId(d.Id(), 2)
 	if err != nil {
@@ -107,13 +111,17 @@ func resourceAlibabacloudStackInstanceRoleAttachmentRead(d *schema.ResourceData,
 			instIds = append(instIds, item.InstanceId)
 		}
 	}
-	d.Set("role_name", object.InstanceRamRoleSets.InstanceRamRoleSet[0].RamRoleName)
+	if len(instIds) == 0 {
+		d.SetId("")
+		return nil
+	}
+	d.Set("role_name", roleName)
 	d.Set("instance_ids", instIds)
 	return nil
 
 }
 
-func resourceAlibabacloudStackInstanceRoleAttachmentDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceAlibabacloudStackInstanceRoleAttachmentDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*connectivity.AlibabacloudStackClient)
 	ramService := RamService{client}
 	parts, err := ParseResourceId(d.Id(), 2)
@@ -135,7 +143,7 @@ func resourceAlibabacloudStackInstanceRoleAttachmentDelete(d *schema.ResourceDat
 	request.RamRoleName = roleName
 	request.InstanceIds = fmt.Sprintf("[\"%s\"]", instanceIds)
 
-	err = resource.Retry(5*time.Minute, func() *resource.RetryError {
+	err = resource.RetryContext(ctx, 5*time.Minute, func() *resource.RetryError {
 		raw, err := client.WithEcsClient(func(ecsClient *ecs.Client) (interface{}, error) {
 			return ecsClient.DetachInstanceRamRole(request)
 		})
@@ -1,6 +1,7 @@
 package alibabacloudstack
 
 import (
+	"context"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"strings"
 
@@ -12,12 +13,12 @@ import (
 
 func resourceAlibabacloudStackReservedInstance() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceAlibabacloudStackReservedInstanceCreate,
-		Read:   resourceAlibabacloudStackReservedInstanceRead,
-		Update: resourceAlibabacloudStackReservedInstanceUpdate,
-		Delete: resourceAlibabacloudStackReservedInstanceDelete,
+		CreateContext: withDiagnostics(resourceAlibabacloudStackReservedInstanceCreate),
+		ReadContext:   withDiagnostics(resourceAlibabacloudStackReservedInstanceRead),
+		UpdateContext: withDiagnostics(resourceAlibabacloudStackReservedInstanceUpdate),
+		DeleteContext: withDiagnostics(resourceAlibabacloudStackReservedInstanceDelete),
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			StateContext: schema.ImportStatePassthroughContext,
 		},
 		Schema: map[string]*schema.Schema{
 			"instance_type": {
@@ -87,7 +88,7 @@ func resourceAlibabacloudStackReservedInstance() *schema.Resource {
 		},
 	}
 }
-func resourceAlibabacloudStackReservedInstanceCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceAlibabacloudStackReservedInstanceCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*connectivity.AlibabacloudStackClient)
 	ecsService := EcsService{client}
 	request := ecs.CreatePurchaseReservedInstancesOfferingRequest()
@@ -152,9 +153,9 @@ func resourceAlibabacloudStackReservedInstanceCreate(d *schema.ResourceData, met
 		return WrapError(err)
 	}
 
-	return resourceAlibabacloudStackReservedInstanceRead(d, meta)
+	return resourceAlibabacloudStackReservedInstanceRead(ctx, d, meta)
 }
-func resourceAlibabacloudStackReservedInstanceUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceAlibabacloudStackReservedInstanceUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*connectivity.AlibabacloudStackClient)
 	request := ecs.CreateModifyReservedInstanceAttributeRequest()
 	if strings.ToLower(client.Config.Protocol) == "https" {
@@ -182,9 +183,9 @@ func resourceAlibabacloudStackReservedInstanceUpdate(d *schema.ResourceData, met
 		addDebug(request.GetActionName(), raw, request.RpcRequest, request)
 	}
 
-	return resourceAlibabacloudStackReservedInstanceRead(d, meta)
+	return resourceAlibabacloudStackReservedInstanceRead(ctx, d, meta)
 }
-func resourceAlibabacloudStackReservedInstanceRead(d *schema.ResourceData, meta interface{}) error {
+func resourceAlibabacloudStackReservedInstanceRead(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 	wiatSecondsIfWithTest(1)
 	client := meta.(*connectivity.AlibabacloudStackClient)
 	ecsService := EcsService{client}
@@ -204,7 +205,7 @@ func resourceAlibabacloudStackReservedInstanceRead(d *schema.ResourceData, meta
 
 	return WrapError(err)
 }
-func resourceAlibabacloudStackReservedInstanceDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceAlibabacloudStackReservedInstanceDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 	// PurchaseReservedInstancesOffering can not be release.
 	return nil
 }
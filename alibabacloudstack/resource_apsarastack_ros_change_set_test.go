@@ -0,0 +1,86 @@
+package alibabacloudstack
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/aliyun/terraform-provider-alibabacloudstack/alibabacloudstack/connectivity"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccAlibabacloudStackRosChangeSet_basic(t *testing.T) {
+	var v map[string]interface{}
+	resourceId := "alibabacloudstack_ros_change_set.default"
+	ra := resourceAttrInit(resourceId, AlibabacloudStackRosChangeSetMap)
+	rc := resourceCheckInitWithDescribeMethod(resourceId, &v, func() interface{} {
+		return &RosService{testAccProvider.Meta().(*connectivity.AlibabacloudStackClient)}
+	}, "DescribeRosChangeSet")
+	rac := resourceAttrCheckInit(rc, ra)
+	testAccCheck := rac.resourceAttrMapUpdateSet()
+	rand := acctest.RandIntRange(10000, 99999)
+	name := fmt.Sprintf("tf-testAcc%sAlibabacloudStackRosChangeSet%d", defaultRegionToTest, rand)
+	testAccConfig := resourceTestAccConfigFunc(resourceId, name, AlibabacloudStackRosChangeSetBasicDependence)
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+		},
+
+		IDRefreshName: resourceId,
+		Providers:     testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccConfig(map[string]interface{}{
+					"change_set_name": name,
+					"change_set_type": "CREATE",
+					"stack_name":      name,
+					"description":     "Test From Terraform",
+					"template_body":   `{\"ROSTemplateFormatVersion\":\"2015-09-01\", \"Parameters\": {\"VpcName\": {\"Type\": \"String\"}}, \"Resources\": {\"Vpc\": {\"Type\": \"ALIYUN::ECS::VPC\", \"Properties\": {\"CidrBlock\": \"172.16.0.0/12\", \"VpcName\": {\"Ref\": \"VpcName\"}}}}}`,
+					"parameters": []map[string]interface{}{
+						{
+							"parameter_key":   "VpcName",
+							"parameter_value": name,
+						},
+					},
+				}),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheck(map[string]string{
+						"change_set_name":  name,
+						"change_set_type":  "CREATE",
+						"stack_name":       name,
+						"description":      "Test From Terraform",
+						"parameters.#":     "1",
+						"changes.#":        "1",
+						"execution_status": "AVAILABLE",
+					}),
+				),
+			},
+			{
+				ResourceName:            resourceId,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"template_body", "execute"},
+			},
+			{
+				Config: testAccConfig(map[string]interface{}{
+					"execute": "true",
+				}),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheck(map[string]string{
+						"execute":          "true",
+						"execution_status": "EXECUTE_COMPLETE",
+					}),
+				),
+			},
+		},
+	})
+}
+
+var AlibabacloudStackRosChangeSetMap = map[string]string{
+	"status":   "CREATE_COMPLETE",
+	"stack_id": CHECKSET,
+}
+
+func AlibabacloudStackRosChangeSetBasicDependence(name string) string {
+	return ""
+}
@@ -1,6 +1,7 @@
 package alibabacloudstack
 
 import (
+	"context"
 	"fmt"
 	"log"
 	"strings"
@@ -15,12 +16,12 @@ import (
 
 func resourceAlibabacloudStackRosStack() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceAlibabacloudStackRosStackCreate,
-		Read:   resourceAlibabacloudStackRosStackRead,
-		Update: resourceAlibabacloudStackRosStackUpdate,
-		Delete: resourceAlibabacloudStackRosStackDelete,
+		CreateContext: withDiagnostics(resourceAlibabacloudStackRosStackCreate),
+		ReadContext:   withDiagnostics(resourceAlibabacloudStackRosStackRead),
+		UpdateContext: withDiagnostics(resourceAlibabacloudStackRosStackUpdate),
+		DeleteContext: withDiagnostics(resourceAlibabacloudStackRosStackDelete),
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			StateContext: schema.ImportStatePassthroughContext,
 		},
 		Timeouts: &schema.ResourceTimeout{
 			Create: schema.DefaultTimeout(11 * time.Minute),
@@ -149,7 +150,7 @@ func resourceAlibabacloudStackRosStack() *schema.Resource {
 	}
 }
 
-func resourceAlibabacloudStackRosStackCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceAlibabacloudStackRosStackCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*connectivity.AlibabacloudStackClient)
 	rosService := RosService{client}
 	var response map[string]interface{}
@@ -229,13 +230,13 @@ func resourceAlibabacloudStackRosStackCreate(d *schema.ResourceData, meta interf
 
 	d.SetId(fmt.Sprint(response["StackId"]))
 	stateConf := BuildStateConf([]string{}, []string{"CREATE_COMPLETE"}, d.Timeout(schema.TimeoutCreate), 100*time.Second, rosService.RosStackStateRefreshFunc(d.Id(), []string{"CREATE_FAILED", "CREATE_ROLLBACK_COMPLETE", "CREATE_ROLLBACK_FAILED"}))
-	if _, err := stateConf.WaitForState(); err != nil {
+	if _, err := stateConf.WaitForStateContext(ctx); err != nil {
 		return WrapErrorf(err, IdMsg, d.Id())
 	}
 
-	return resourceAlibabacloudStackRosStackRead(d, meta)
+	return resourceAlibabacloudStackRosStackRead(ctx, d, meta)
 }
-func resourceAlibabacloudStackRosStackRead(d *schema.ResourceData, meta interface{}) error {
+func resourceAlibabacloudStackRosStackRead(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*connectivity.AlibabacloudStackClient)
 	rosService := RosService{client}
 	object, err := rosService.DescribeRosStack(d.Id())
@@ -286,7 +287,7 @@ func resourceAlibabacloudStackRosStackRead(d *schema.ResourceData, meta interfac
 	return nil
 }
 
-func resourceAlibabacloudStackRosStackUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceAlibabacloudStackRosStackUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*connectivity.AlibabacloudStackClient)
 	rosService := RosService{client}
 	var response map[string]interface{}
@@ -375,7 +376,7 @@ func resourceAlibabacloudStackRosStackUpdate(d *schema.ResourceData, meta interf
 			return WrapErrorf(err, DefaultErrorMsg, d.Id(), action, AlibabacloudStackSdkGoERROR)
 		}
 		stateConf := BuildStateConf([]string{}, []string{"UPDATE_COMPLETE"}, d.Timeout(schema.TimeoutUpdate), 100*time.Second, rosService.RosStackStateRefreshFunc(d.Id(), []string{"UPDATE_FAILED", "ROLLBACK_FAILED"}))
-		if _, err := stateConf.WaitForState(); err != nil {
+		if _, err := stateConf.WaitForStateContext(ctx); err != nil {
 			return WrapErrorf(err, IdMsg, d.Id())
 		}
 		//d.SetPartial("disable_rollback")
@@ -385,9 +386,9 @@ func resourceAlibabacloudStackRosStackUpdate(d *schema.ResourceData, meta interf
 		//d.SetPartial("timeout_in_minutes")
 	}
 	d.Partial(false)
-	return resourceAlibabacloudStackRosStackRead(d, meta)
+	return resourceAlibabacloudStackRosStackRead(ctx, d, meta)
 }
-func resourceAlibabacloudStackRosStackDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceAlibabacloudStackRosStackDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*connectivity.AlibabacloudStackClient)
 	rosService := RosService{client}
 	action := "DeleteStack"
@@ -414,7 +415,7 @@ func resourceAlibabacloudStackRosStackDelete(d *schema.ResourceData, meta interf
 		request["RetainResources"] = v.(*schema.Set).List()
 	}
 	wait := incrementalWait(3*time.Second, 3*time.Second)
-	err = resource.Retry(d.Timeout(schema.TimeoutDelete), func() *resource.RetryError {
+	err = resource.RetryContext(ctx, d.Timeout(schema.TimeoutDelete), func() *resource.RetryError {
 		response, err = conn.DoRequest(StringPointer(action), nil, StringPointer("POST"), StringPointer("2019-09-10"), StringPointer("AK"), nil, request, &util.RuntimeOptions{})
 		if err != nil {
 			if NeedRetry(err) {
@@ -433,7 +434,7 @@ func resourceAlibabacloudStackRosStackDelete(d *schema.ResourceData, meta interf
 		return WrapErrorf(err, DefaultErrorMsg, d.Id(), action, AlibabacloudStackSdkGoERROR)
 	}
 	stateConf := BuildStateConf([]string{}, []string{"DELETE_COMPLETE"}, d.Timeout(schema.TimeoutDelete), 100*time.Second, rosService.RosStackStateRefreshFunc(d.Id(), []string{"DELETE_FAILED"}))
-	if _, err := stateConf.WaitForState(); err != nil {
+	if _, err := stateConf.WaitForStateContext(ctx); err != nil {
 		return WrapErrorf(err, IdMsg, d.Id())
 	}
 	return nil
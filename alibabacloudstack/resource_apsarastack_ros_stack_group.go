@@ -0,0 +1,345 @@
+package alibabacloudstack
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"time"
+
+	util "github.com/alibabacloud-go/tea-utils/service"
+	"github.com/aliyun/terraform-provider-alibabacloudstack/alibabacloudstack/connectivity"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+func resourceAlibabacloudStackRosStackGroup() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: withDiagnostics(resourceAlibabacloudStackRosStackGroupCreate),
+		ReadContext:   withDiagnostics(resourceAlibabacloudStackRosStackGroupRead),
+		UpdateContext: withDiagnostics(resourceAlibabacloudStackRosStackGroupUpdate),
+		DeleteContext: withDiagnostics(resourceAlibabacloudStackRosStackGroupDelete),
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+		Timeouts: &schema.ResourceTimeout{
+			Update: schema.DefaultTimeout(30 * time.Minute),
+		},
+		Schema: map[string]*schema.Schema{
+			"stack_group_name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"description": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"administration_role_name": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+			"execution_role_name": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+			"parameters": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"parameter_key": {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+						"parameter_value": {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+					},
+				},
+			},
+			"template_body": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validation.StringIsJSON,
+				DiffSuppressFunc: func(k, old, new string, d *schema.ResourceData) bool {
+					equal, _ := compareJsonTemplateAreEquivalent(old, new)
+					return equal
+				},
+			},
+			"template_url": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"template_version": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"account_ids": {
+				Type:        schema.TypeSet,
+				Optional:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: "The accounts of the stack instances which the changes of the stack group are applied to.",
+			},
+			"region_ids": {
+				Type:        schema.TypeSet,
+				Optional:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: "The regions of the stack instances which the changes of the stack group are applied to.",
+			},
+			"operation_description": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"operation_preferences": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringIsJSON,
+			},
+			"stack_group_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"status": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceAlibabacloudStackRosStackGroupCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*connectivity.AlibabacloudStackClient)
+	var response map[string]interface{}
+	action := "CreateStackGroup"
+	request := make(map[string]interface{})
+	conn, err := client.NewRosClient()
+	if err != nil {
+		return WrapError(err)
+	}
+	request["StackGroupName"] = d.Get("stack_group_name")
+	if v, ok := d.GetOk("description"); ok {
+		request["Description"] = v
+	}
+	if v, ok := d.GetOk("administration_role_name"); ok {
+		request["AdministrationRoleName"] = v
+	}
+	if v, ok := d.GetOk("execution_role_name"); ok {
+		request["ExecutionRoleName"] = v
+	}
+	if v, ok := d.GetOk("parameters"); ok {
+		request["Parameters"] = buildRosParameters(v.(*schema.Set).List())
+	}
+	if v, ok := d.GetOk("template_body"); ok {
+		request["TemplateBody"] = v
+	}
+	if v, ok := d.GetOk("template_url"); ok {
+		request["TemplateURL"] = v
+	}
+	if v, ok := d.GetOk("template_version"); ok {
+		request["TemplateVersion"] = v
+	}
+	request["RegionId"] = client.RegionId
+	request["Product"] = "ROS"
+	request["product"] = "ROS"
+	request["OrganizationId"] = client.Department
+
+	runtime := util.RuntimeOptions{}
+	runtime.SetAutoretry(true)
+	request["ClientToken"] = buildClientToken("CreateStackGroup")
+	response, err = conn.DoRequest(StringPointer(action), nil, StringPointer("POST"), StringPointer("2019-09-10"), StringPointer("AK"), nil, request, &runtime)
+	if err != nil {
+		return WrapErrorf(err, DefaultErrorMsg, "alibabacloudstack_ros_stack_group", action, AlibabacloudStackSdkGoERROR)
+	}
+	addDebug(action, response, request)
+
+	d.SetId(fmt.Sprint(request["StackGroupName"]))
+
+	return resourceAlibabacloudStackRosStackGroupRead(ctx, d, meta)
+}
+
+func resourceAlibabacloudStackRosStackGroupRead(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*connectivity.AlibabacloudStackClient)
+	rosService := RosService{client}
+	object, err := rosService.DescribeRosStackGroup(d.Id())
+	if err != nil {
+		if NotFoundError(err) {
+			log.Printf("[DEBUG] Resource alibabacloudstack_ros_stack_group rosService.DescribeRosStackGroup Failed!!! %s", err)
+			d.SetId("")
+			return nil
+		}
+		return WrapError(err)
+	}
+	d.Set("stack_group_name", object["StackGroupName"])
+	d.Set("description", object["Description"])
+	d.Set("administration_role_name", object["AdministrationRoleName"])
+	d.Set("execution_role_name", object["ExecutionRoleName"])
+	d.Set("template_body", object["TemplateBody"])
+	d.Set("stack_group_id", object["StackGroupId"])
+	d.Set("status", object["Status"])
+
+	parameters := make([]map[string]interface{}, 0)
+	if parametersList, ok := object["Parameters"].([]interface{}); ok {
+		for _, v := range parametersList {
+			if m1, ok := v.(map[string]interface{}); ok {
+				parameters = append(parameters, map[string]interface{}{
+					"parameter_key":   m1["ParameterKey"],
+					"parameter_value": m1["ParameterValue"],
+				})
+			}
+		}
+	}
+	if err := d.Set("parameters", parameters); err != nil {
+		return WrapError(err)
+	}
+	return nil
+}
+
+func resourceAlibabacloudStackRosStackGroupUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*connectivity.AlibabacloudStackClient)
+	var response map[string]interface{}
+	update := false
+	request := map[string]interface{}{
+		"StackGroupName": d.Id(),
+	}
+	if d.HasChange("description") {
+		update = true
+	}
+	if v, ok := d.GetOk("description"); ok {
+		request["Description"] = v
+	}
+	if d.HasChange("administration_role_name") {
+		update = true
+	}
+	if v, ok := d.GetOk("administration_role_name"); ok {
+		request["AdministrationRoleName"] = v
+	}
+	if d.HasChange("execution_role_name") {
+		update = true
+	}
+	if v, ok := d.GetOk("execution_role_name"); ok {
+		request["ExecutionRoleName"] = v
+	}
+	if d.HasChange("parameters") {
+		update = true
+	}
+	if v, ok := d.GetOk("parameters"); ok {
+		request["Parameters"] = buildRosParameters(v.(*schema.Set).List())
+	}
+	if d.HasChange("template_body") {
+		update = true
+	}
+	if v, ok := d.GetOk("template_body"); ok {
+		request["TemplateBody"] = v
+	}
+	if d.HasChange("template_url") {
+		update = true
+		request["TemplateURL"] = d.Get("template_url")
+		delete(request, "TemplateBody")
+	}
+	if d.HasChange("template_version") {
+		update = true
+		request["TemplateVersion"] = d.Get("template_version")
+	}
+	if !update {
+		return resourceAlibabacloudStackRosStackGroupRead(ctx, d, meta)
+	}
+	if v, ok := d.GetOk("account_ids"); ok {
+		request["AccountIds"] = convertListToJsonString(v.(*schema.Set).List())
+	}
+	if v, ok := d.GetOk("region_ids"); ok {
+		request["RegionIds"] = convertListToJsonString(v.(*schema.Set).List())
+	}
+	if v, ok := d.GetOk("operation_description"); ok {
+		request["OperationDescription"] = v
+	}
+	if v, ok := d.GetOk("operation_preferences"); ok {
+		request["OperationPreferences"] = v
+	}
+	request["RegionId"] = client.RegionId
+	request["Product"] = "ROS"
+	request["product"] = "ROS"
+	request["OrganizationId"] = client.Department
+	action := "UpdateStackGroup"
+	conn, err := client.NewRosClient()
+	if err != nil {
+		return WrapError(err)
+	}
+	runtime := util.RuntimeOptions{}
+	runtime.SetAutoretry(true)
+	request["ClientToken"] = buildClientToken("UpdateStackGroup")
+	wait := incrementalWait(3*time.Second, 3*time.Second)
+	err = resource.RetryContext(ctx, d.Timeout(schema.TimeoutUpdate), func() *resource.RetryError {
+		response, err = conn.DoRequest(StringPointer(action), nil, StringPointer("POST"), StringPointer("2019-09-10"), StringPointer("AK"), nil, request, &runtime)
+		if err != nil {
+			if NeedRetry(err) || IsExpectedErrors(err, []string{"StackGroupOperationInProgress"}) {
+				wait()
+				return resource.RetryableError(err)
+			}
+			return resource.NonRetryableError(err)
+		}
+		return nil
+	})
+	addDebug(action, response, request)
+	if err != nil {
+		return WrapErrorf(err, DefaultErrorMsg, d.Id(), action, AlibabacloudStackSdkGoERROR)
+	}
+	// The stack group without any stack instance is updated at once, otherwise an operation is started.
+	if err := waitForRosStackGroupOperation(ctx, client, response, d.Timeout(schema.TimeoutUpdate)); err != nil {
+		return WrapErrorf(err, IdMsg, d.Id())
+	}
+	return resourceAlibabacloudStackRosStackGroupRead(ctx, d, meta)
+}
+
+func resourceAlibabacloudStackRosStackGroupDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*connectivity.AlibabacloudStackClient)
+	action := "DeleteStackGroup"
+	var response map[string]interface{}
+	conn, err := client.NewRosClient()
+	if err != nil {
+		return WrapError(err)
+	}
+	request := map[string]interface{}{
+		"StackGroupName": d.Id(),
+	}
+	request["RegionId"] = client.RegionId
+	request["Product"] = "ROS"
+	request["product"] = "ROS"
+	request["OrganizationId"] = client.Department
+	wait := incrementalWait(3*time.Second, 3*time.Second)
+	err = resource.RetryContext(ctx, 5*time.Minute, func() *resource.RetryError {
+		response, err = conn.DoRequest(StringPointer(action), nil, StringPointer("POST"), StringPointer("2019-09-10"), StringPointer("AK"), nil, request, &util.RuntimeOptions{})
+		if err != nil {
+			if NeedRetry(err) || IsExpectedErrors(err, []string{"StackGroupOperationInProgress"}) {
+				wait()
+				return resource.RetryableError(err)
+			}
+			return resource.NonRetryableError(err)
+		}
+		addDebug(action, response, request)
+		return nil
+	})
+	if err != nil {
+		if IsExpectedErrors(err, []string{"StackGroupNotFound"}) {
+			return nil
+		}
+		return WrapErrorf(err, DefaultErrorMsg, d.Id(), action, AlibabacloudStackSdkGoERROR)
+	}
+	return nil
+}
+
+// buildRosParameters converts the parameters of the schema to the ones of the request.
+func buildRosParameters(configured []interface{}) []map[string]interface{} {
+	parameters := make([]map[string]interface{}, len(configured))
+	for i, v := range configured {
+		parameters[i] = map[string]interface{}{
+			"ParameterKey":   v.(map[string]interface{})["parameter_key"],
+			"ParameterValue": v.(map[string]interface{})["parameter_value"],
+		}
+	}
+	return parameters
+}
@@ -0,0 +1,100 @@
+package alibabacloudstack
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/aliyun/terraform-provider-alibabacloudstack/alibabacloudstack/connectivity"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccAlibabacloudStackRosStackGroup_basic(t *testing.T) {
+	var v map[string]interface{}
+	resourceId := "alibabacloudstack_ros_stack_group.default"
+	ra := resourceAttrInit(resourceId, AlibabacloudStackRosStackGroupMap)
+	rc := resourceCheckInitWithDescribeMethod(resourceId, &v, func() interface{} {
+		return &RosService{testAccProvider.Meta().(*connectivity.AlibabacloudStackClient)}
+	}, "DescribeRosStackGroup")
+	rac := resourceAttrCheckInit(rc, ra)
+	testAccCheck := rac.resourceAttrMapUpdateSet()
+	rand := acctest.RandIntRange(10000, 99999)
+	name := fmt.Sprintf("tf-testAcc%sAlibabacloudStackRosStackGroup%d", defaultRegionToTest, rand)
+	testAccConfig := resourceTestAccConfigFunc(resourceId, name, AlibabacloudStackRosStackGroupBasicDependence)
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+		},
+
+		IDRefreshName: resourceId,
+		Providers:     testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccConfig(map[string]interface{}{
+					"stack_group_name": name,
+					"template_body":    `{\"ROSTemplateFormatVersion\":\"2015-09-01\", \"Parameters\": {\"VpcName\": {\"Type\": \"String\"},\"InstanceType\": {\"Type\": \"String\"}}}`,
+					"parameters": []map[string]interface{}{
+						{
+							"parameter_key":   "VpcName",
+							"parameter_value": "VpcName",
+						},
+						{
+							"parameter_key":   "InstanceType",
+							"parameter_value": "InstanceType",
+						},
+					},
+				}),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheck(map[string]string{
+						"stack_group_name": name,
+						"parameters.#":     "2",
+					}),
+				),
+			},
+			{
+				ResourceName:            resourceId,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"template_body"},
+			},
+			{
+				Config: testAccConfig(map[string]interface{}{
+					"description": "Test From Terraform",
+				}),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheck(map[string]string{
+						"description": "Test From Terraform",
+					}),
+				),
+			},
+			{
+				Config: testAccConfig(map[string]interface{}{
+					"parameters": []map[string]interface{}{
+						{
+							"parameter_key":   "VpcName",
+							"parameter_value": "tf-testacc",
+						},
+						{
+							"parameter_key":   "InstanceType",
+							"parameter_value": "ECS",
+						},
+					},
+				}),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheck(map[string]string{
+						"parameters.#": "2",
+					}),
+				),
+			},
+		},
+	})
+}
+
+var AlibabacloudStackRosStackGroupMap = map[string]string{
+	"stack_group_id": CHECKSET,
+	"status":         "ACTIVE",
+}
+
+func AlibabacloudStackRosStackGroupBasicDependence(name string) string {
+	return ""
+}
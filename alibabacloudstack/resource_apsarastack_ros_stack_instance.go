@@ -0,0 +1,310 @@
+package alibabacloudstack
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"time"
+
+	util "github.com/alibabacloud-go/tea-utils/service"
+	"github.com/aliyun/terraform-provider-alibabacloudstack/alibabacloudstack/connectivity"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+func resourceAlibabacloudStackRosStackInstance() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: withDiagnostics(resourceAlibabacloudStackRosStackInstanceCreate),
+		ReadContext:   withDiagnostics(resourceAlibabacloudStackRosStackInstanceRead),
+		UpdateContext: withDiagnostics(resourceAlibabacloudStackRosStackInstanceUpdate),
+		DeleteContext: withDiagnostics(resourceAlibabacloudStackRosStackInstanceDelete),
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(30 * time.Minute),
+			Update: schema.DefaultTimeout(30 * time.Minute),
+			Delete: schema.DefaultTimeout(30 * time.Minute),
+		},
+		Schema: map[string]*schema.Schema{
+			"stack_group_name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"stack_instance_account_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"stack_instance_region_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"operation_description": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"operation_preferences": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringIsJSON,
+			},
+			"parameter_overrides": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"parameter_key": {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+						"parameter_value": {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+					},
+				},
+			},
+			"retain_stacks": {
+				Type:     schema.TypeBool,
+				Optional: true,
+			},
+			"timeout_in_minutes": {
+				Type:     schema.TypeInt,
+				Optional: true,
+			},
+			"stack_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"status": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceAlibabacloudStackRosStackInstanceCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*connectivity.AlibabacloudStackClient)
+	var response map[string]interface{}
+	action := "CreateStackInstances"
+	request := make(map[string]interface{})
+	conn, err := client.NewRosClient()
+	if err != nil {
+		return WrapError(err)
+	}
+	request["StackGroupName"] = d.Get("stack_group_name")
+	request["AccountIds"] = convertListToJsonString([]interface{}{d.Get("stack_instance_account_id")})
+	request["RegionIds"] = convertListToJsonString([]interface{}{d.Get("stack_instance_region_id")})
+	if v, ok := d.GetOk("operation_description"); ok {
+		request["OperationDescription"] = v
+	}
+	if v, ok := d.GetOk("operation_preferences"); ok {
+		request["OperationPreferences"] = v
+	}
+	if v, ok := d.GetOk("parameter_overrides"); ok {
+		request["ParameterOverrides"] = buildRosParameters(v.(*schema.Set).List())
+	}
+	if v, ok := d.GetOk("timeout_in_minutes"); ok {
+		request["TimeoutInMinutes"] = v
+	}
+	request["RegionId"] = client.RegionId
+	request["Product"] = "ROS"
+	request["product"] = "ROS"
+	request["OrganizationId"] = client.Department
+
+	runtime := util.RuntimeOptions{}
+	runtime.SetAutoretry(true)
+	request["ClientToken"] = buildClientToken("CreateStackInstances")
+	wait := incrementalWait(3*time.Second, 3*time.Second)
+	err = resource.RetryContext(ctx, d.Timeout(schema.TimeoutCreate), func() *resource.RetryError {
+		response, err = conn.DoRequest(StringPointer(action), nil, StringPointer("POST"), StringPointer("2019-09-10"), StringPointer("AK"), nil, request, &runtime)
+		if err != nil {
+			if NeedRetry(err) || IsExpectedErrors(err, []string{"StackGroupOperationInProgress"}) {
+				wait()
+				return resource.RetryableError(err)
+			}
+			return resource.NonRetryableError(err)
+		}
+		return nil
+	})
+	addDebug(action, response, request)
+	if err != nil {
+		return WrapErrorf(err, DefaultErrorMsg, "alibabacloudstack_ros_stack_instance", action, AlibabacloudStackSdkGoERROR)
+	}
+
+	d.SetId(fmt.Sprintf("%v%s%v%s%v", request["StackGroupName"], COLON_SEPARATED, d.Get("stack_instance_account_id"), COLON_SEPARATED, d.Get("stack_instance_region_id")))
+	if err := waitForRosStackGroupOperation(ctx, client, response, d.Timeout(schema.TimeoutCreate)); err != nil {
+		return WrapErrorf(err, IdMsg, d.Id())
+	}
+
+	return resourceAlibabacloudStackRosStackInstanceRead(ctx, d, meta)
+}
+
+func resourceAlibabacloudStackRosStackInstanceRead(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*connectivity.AlibabacloudStackClient)
+	rosService := RosService{client}
+	object, err := rosService.DescribeRosStackInstance(d.Id())
+	if err != nil {
+		if NotFoundError(err) {
+			log.Printf("[DEBUG] Resource alibabacloudstack_ros_stack_instance rosService.DescribeRosStackInstance Failed!!! %s", err)
+			d.SetId("")
+			return nil
+		}
+		return WrapError(err)
+	}
+	d.Set("stack_group_name", object["StackGroupName"])
+	d.Set("stack_instance_account_id", object["AccountId"])
+	d.Set("stack_instance_region_id", object["RegionId"])
+	d.Set("stack_id", object["StackId"])
+	d.Set("status", object["Status"])
+
+	parameterOverrides := make([]map[string]interface{}, 0)
+	if parametersList, ok := object["ParameterOverrides"].([]interface{}); ok {
+		for _, v := range parametersList {
+			if m1, ok := v.(map[string]interface{}); ok {
+				parameterOverrides = append(parameterOverrides, map[string]interface{}{
+					"parameter_key":   m1["ParameterKey"],
+					"parameter_value": m1["ParameterValue"],
+				})
+			}
+		}
+	}
+	if err := d.Set("parameter_overrides", parameterOverrides); err != nil {
+		return WrapError(err)
+	}
+	return nil
+}
+
+func resourceAlibabacloudStackRosStackInstanceUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*connectivity.AlibabacloudStackClient)
+	parts, err := ParseResourceId(d.Id(), 3)
+	if err != nil {
+		return WrapError(err)
+	}
+	if !d.HasChanges("parameter_overrides", "timeout_in_minutes") {
+		return resourceAlibabacloudStackRosStackInstanceRead(ctx, d, meta)
+	}
+	var response map[string]interface{}
+	request := map[string]interface{}{
+		"StackGroupName": parts[0],
+		"AccountIds":     convertListToJsonString([]interface{}{parts[1]}),
+		"RegionIds":      convertListToJsonString([]interface{}{parts[2]}),
+	}
+	if v, ok := d.GetOk("parameter_overrides"); ok {
+		request["ParameterOverrides"] = buildRosParameters(v.(*schema.Set).List())
+	}
+	if v, ok := d.GetOk("timeout_in_minutes"); ok {
+		request["TimeoutInMinutes"] = v
+	}
+	if v, ok := d.GetOk("operation_description"); ok {
+		request["OperationDescription"] = v
+	}
+	if v, ok := d.GetOk("operation_preferences"); ok {
+		request["OperationPreferences"] = v
+	}
+	request["RegionId"] = client.RegionId
+	request["Product"] = "ROS"
+	request["product"] = "ROS"
+	request["OrganizationId"] = client.Department
+	action := "UpdateStackInstances"
+	conn, err := client.NewRosClient()
+	if err != nil {
+		return WrapError(err)
+	}
+	runtime := util.RuntimeOptions{}
+	runtime.SetAutoretry(true)
+	request["ClientToken"] = buildClientToken("UpdateStackInstances")
+	wait := incrementalWait(3*time.Second, 3*time.Second)
+	err = resource.RetryContext(ctx, d.Timeout(schema.TimeoutUpdate), func() *resource.RetryError {
+		response, err = conn.DoRequest(StringPointer(action), nil, StringPointer("POST"), StringPointer("2019-09-10"), StringPointer("AK"), nil, request, &runtime)
+		if err != nil {
+			if NeedRetry(err) || IsExpectedErrors(err, []string{"StackGroupOperationInProgress"}) {
+				wait()
+				return resource.RetryableError(err)
+			}
+			return resource.NonRetryableError(err)
+		}
+		return nil
+	})
+	addDebug(action, response, request)
+	if err != nil {
+		return WrapErrorf(err, DefaultErrorMsg, d.Id(), action, AlibabacloudStackSdkGoERROR)
+	}
+	if err := waitForRosStackGroupOperation(ctx, client, response, d.Timeout(schema.TimeoutUpdate)); err != nil {
+		return WrapErrorf(err, IdMsg, d.Id())
+	}
+	return resourceAlibabacloudStackRosStackInstanceRead(ctx, d, meta)
+}
+
+func resourceAlibabacloudStackRosStackInstanceDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*connectivity.AlibabacloudStackClient)
+	parts, err := ParseResourceId(d.Id(), 3)
+	if err != nil {
+		return WrapError(err)
+	}
+	action := "DeleteStackInstances"
+	var response map[string]interface{}
+	conn, err := client.NewRosClient()
+	if err != nil {
+		return WrapError(err)
+	}
+	request := map[string]interface{}{
+		"StackGroupName": parts[0],
+		"AccountIds":     convertListToJsonString([]interface{}{parts[1]}),
+		"RegionIds":      convertListToJsonString([]interface{}{parts[2]}),
+		"RetainStacks":   d.Get("retain_stacks"),
+	}
+	if v, ok := d.GetOk("operation_description"); ok {
+		request["OperationDescription"] = v
+	}
+	if v, ok := d.GetOk("operation_preferences"); ok {
+		request["OperationPreferences"] = v
+	}
+	request["RegionId"] = client.RegionId
+	request["Product"] = "ROS"
+	request["product"] = "ROS"
+	request["OrganizationId"] = client.Department
+	request["ClientToken"] = buildClientToken("DeleteStackInstances")
+	wait := incrementalWait(3*time.Second, 3*time.Second)
+	err = resource.RetryContext(ctx, d.Timeout(schema.TimeoutDelete), func() *resource.RetryError {
+		response, err = conn.DoRequest(StringPointer(action), nil, StringPointer("POST"), StringPointer("2019-09-10"), StringPointer("AK"), nil, request, &util.RuntimeOptions{})
+		if err != nil {
+			if NeedRetry(err) || IsExpectedErrors(err, []string{"StackGroupOperationInProgress"}) {
+				wait()
+				return resource.RetryableError(err)
+			}
+			return resource.NonRetryableError(err)
+		}
+		return nil
+	})
+	addDebug(action, response, request)
+	if err != nil {
+		if IsExpectedErrors(err, []string{"StackGroupNotFound", "StackInstanceNotFound"}) {
+			return nil
+		}
+		return WrapErrorf(err, DefaultErrorMsg, d.Id(), action, AlibabacloudStackSdkGoERROR)
+	}
+	if err := waitForRosStackGroupOperation(ctx, client, response, d.Timeout(schema.TimeoutDelete)); err != nil {
+		return WrapErrorf(err, IdMsg, d.Id())
+	}
+	return nil
+}
+
+// waitForRosStackGroupOperation waits for the operation of the stack group returned by the response to be succeeded.
+func waitForRosStackGroupOperation(ctx context.Context, client *connectivity.AlibabacloudStackClient, response map[string]interface{}, timeout time.Duration) error {
+	operationId := fmt.Sprint(response["OperationId"])
+	if response["OperationId"] == nil || operationId == "" {
+		return nil
+	}
+	rosService := RosService{client}
+	stateConf := BuildStateConf([]string{}, []string{"SUCCEEDED"}, timeout, 5*time.Second, rosService.RosStackGroupOperationStateRefreshFunc(operationId, []string{"FAILED", "STOPPED"}))
+	if _, err := stateConf.WaitForStateContext(ctx); err != nil {
+		return WrapError(err)
+	}
+	return nil
+}
@@ -0,0 +1,100 @@
+package alibabacloudstack
+
+import (
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/aliyun/terraform-provider-alibabacloudstack/alibabacloudstack/connectivity"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccAlibabacloudStackRosStackInstance_basic(t *testing.T) {
+	var v map[string]interface{}
+	resourceId := "alibabacloudstack_ros_stack_instance.default"
+	ra := resourceAttrInit(resourceId, AlibabacloudStackRosStackInstanceMap)
+	rc := resourceCheckInitWithDescribeMethod(resourceId, &v, func() interface{} {
+		return &RosService{testAccProvider.Meta().(*connectivity.AlibabacloudStackClient)}
+	}, "DescribeRosStackInstance")
+	rac := resourceAttrCheckInit(rc, ra)
+	testAccCheck := rac.resourceAttrMapUpdateSet()
+	rand := acctest.RandIntRange(10000, 99999)
+	name := fmt.Sprintf("tf-testAcc%sAlibabacloudStackRosStackInstance%d", defaultRegionToTest, rand)
+	testAccConfig := resourceTestAccConfigFunc(resourceId, name, AlibabacloudStackRosStackInstanceBasicDependence)
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+		},
+
+		IDRefreshName: resourceId,
+		Providers:     testAccProviders,
+		CheckDestroy:  rac.checkResourceDestroy(),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccConfig(map[string]interface{}{
+					"stack_group_name":          "${alibabacloudstack_ros_stack_group.default.stack_group_name}",
+					"stack_instance_account_id": os.Getenv("ALIBABACLOUDSTACK_ACCOUNT_ID"),
+					"stack_instance_region_id":  os.Getenv("ALIBABACLOUDSTACK_REGION"),
+					"operation_preferences":     `{\"FailureToleranceCount\": 1, \"MaxConcurrentCount\": 2}`,
+					"parameter_overrides": []map[string]interface{}{
+						{
+							"parameter_key":   "VpcName",
+							"parameter_value": "tf-testacc",
+						},
+					},
+				}),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheck(map[string]string{
+						"stack_group_name":      name,
+						"parameter_overrides.#": "1",
+					}),
+				),
+			},
+			{
+				ResourceName:            resourceId,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"operation_description", "operation_preferences", "retain_stacks", "timeout_in_minutes"},
+			},
+			{
+				Config: testAccConfig(map[string]interface{}{
+					"parameter_overrides": []map[string]interface{}{
+						{
+							"parameter_key":   "VpcName",
+							"parameter_value": "tf-testacc-update",
+						},
+					},
+				}),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheck(map[string]string{
+						"parameter_overrides.#": "1",
+					}),
+				),
+			},
+		},
+	})
+}
+
+var AlibabacloudStackRosStackInstanceMap = map[string]string{
+	"stack_instance_account_id": CHECKSET,
+	"stack_instance_region_id":  CHECKSET,
+	"status":                    "CURRENT",
+}
+
+func AlibabacloudStackRosStackInstanceBasicDependence(name string) string {
+	return fmt.Sprintf(`
+variable "name" {
+  default = "%s"
+}
+
+resource "alibabacloudstack_ros_stack_group" "default" {
+  stack_group_name = var.name
+  template_body    = "{\"ROSTemplateFormatVersion\":\"2015-09-01\", \"Parameters\": {\"VpcName\": {\"Type\": \"String\"}}}"
+  parameters {
+    parameter_key   = "VpcName"
+    parameter_value = "VpcName"
+  }
+}
+`, name)
+}
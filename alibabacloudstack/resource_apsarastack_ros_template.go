@@ -1,6 +1,7 @@
 package alibabacloudstack
 
 import (
+	"context"
 	"fmt"
 	"log"
 	"time"
@@ -14,12 +15,12 @@ import (
 
 func resourceAlibabacloudStackRosTemplate() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceAlibabacloudStackRosTemplateCreate,
-		Read:   resourceAlibabacloudStackRosTemplateRead,
-		Update: resourceAlibabacloudStackRosTemplateUpdate,
-		Delete: resourceAlibabacloudStackRosTemplateDelete,
+		CreateContext: withDiagnostics(resourceAlibabacloudStackRosTemplateCreate),
+		ReadContext:   withDiagnostics(resourceAlibabacloudStackRosTemplateRead),
+		UpdateContext: withDiagnostics(resourceAlibabacloudStackRosTemplateUpdate),
+		DeleteContext: withDiagnostics(resourceAlibabacloudStackRosTemplateDelete),
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			StateContext: schema.ImportStatePassthroughContext,
 		},
 		Schema: map[string]*schema.Schema{
 			"description": {
@@ -48,7 +49,7 @@ func resourceAlibabacloudStackRosTemplate() *schema.Resource {
 	}
 }
 
-func resourceAlibabacloudStackRosTemplateCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceAlibabacloudStackRosTemplateCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*connectivity.AlibabacloudStackClient)
 	var response map[string]interface{}
 	action := "CreateTemplate"
@@ -92,9 +93,9 @@ func resourceAlibabacloudStackRosTemplateCreate(d *schema.ResourceData, meta int
 
 	d.SetId(fmt.Sprint(response["TemplateId"]))
 
-	return resourceAlibabacloudStackRosTemplateRead(d, meta)
+	return resourceAlibabacloudStackRosTemplateRead(ctx, d, meta)
 }
-func resourceAlibabacloudStackRosTemplateRead(d *schema.ResourceData, meta interface{}) error {
+func resourceAlibabacloudStackRosTemplateRead(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*connectivity.AlibabacloudStackClient)
 	rosService := RosService{client}
 	object, err := rosService.DescribeRosTemplate(d.Id())
@@ -117,7 +118,7 @@ func resourceAlibabacloudStackRosTemplateRead(d *schema.ResourceData, meta inter
 	//d.Set("tags", tagsToMap(listTagResourcesObject))
 	return nil
 }
-func resourceAlibabacloudStackRosTemplateUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceAlibabacloudStackRosTemplateUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*connectivity.AlibabacloudStackClient)
 	//rosService := RosService{client}
 	var response map[string]interface{}
@@ -159,7 +160,7 @@ func resourceAlibabacloudStackRosTemplateUpdate(d *schema.ResourceData, meta int
 			return WrapError(err)
 		}
 		wait := incrementalWait(3*time.Second, 3*time.Second)
-		err = resource.Retry(d.Timeout(schema.TimeoutUpdate), func() *resource.RetryError {
+		err = resource.RetryContext(ctx, d.Timeout(schema.TimeoutUpdate), func() *resource.RetryError {
 			response, err = conn.DoRequest(StringPointer(action), nil, StringPointer("POST"), StringPointer("2019-09-10"), StringPointer("AK"), nil, request, &util.RuntimeOptions{})
 			if err != nil {
 				if NeedRetry(err) {
@@ -179,9 +180,9 @@ func resourceAlibabacloudStackRosTemplateUpdate(d *schema.ResourceData, meta int
 		//d.SetPartial("template_name")
 	}
 	d.Partial(false)
-	return resourceAlibabacloudStackRosTemplateRead(d, meta)
+	return resourceAlibabacloudStackRosTemplateRead(ctx, d, meta)
 }
-func resourceAlibabacloudStackRosTemplateDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceAlibabacloudStackRosTemplateDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*connectivity.AlibabacloudStackClient)
 	action := "DeleteTemplate"
 	var response map[string]interface{}
@@ -197,7 +198,7 @@ func resourceAlibabacloudStackRosTemplateDelete(d *schema.ResourceData, meta int
 	request["product"] = "ROS"
 	request["OrganizationId"] = client.Department
 	wait := incrementalWait(3*time.Second, 3*time.Second)
-	err = resource.Retry(d.Timeout(schema.TimeoutDelete), func() *resource.RetryError {
+	err = resource.RetryContext(ctx, d.Timeout(schema.TimeoutDelete), func() *resource.RetryError {
 		response, err = conn.DoRequest(StringPointer(action), nil, StringPointer("POST"), StringPointer("2019-09-10"), StringPointer("AK"), nil, request, &util.RuntimeOptions{})
 		if err != nil {
 			if NeedRetry(err) {
@@ -1,6 +1,7 @@
 package alibabacloudstack
 
 import (
+	"context"
 	"strings"
 	"time"
 
@@ -14,11 +15,11 @@ import (
 
 func resourceAlibabacloudStackRouteEntry() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceAlibabacloudStackRouteEntryCreate,
-		Read:   resourceAlibabacloudStackRouteEntryRead,
-		Delete: resourceAlibabacloudStackRouteEntryDelete,
+		CreateContext: withDiagnostics(resourceAlibabacloudStackRouteEntryCreate),
+		ReadContext:   withDiagnostics(resourceAlibabacloudStackRouteEntryRead),
+		DeleteContext: withDiagnostics(resourceAlibabacloudStackRouteEntryDelete),
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			StateContext: schema.ImportStatePassthroughContext,
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -58,7 +59,7 @@ func resourceAlibabacloudStackRouteEntry() *schema.Resource {
 	}
 }
 
-func resourceAlibabacloudStackRouteEntryCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceAlibabacloudStackRouteEntryCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*connectivity.AlibabacloudStackClient)
 	vpcService := VpcService{client}
 
@@ -87,7 +88,7 @@ func resourceAlibabacloudStackRouteEntryCreate(d *schema.ResourceData, meta inte
 	request.NextHopId = ni
 	request.ClientToken = buildClientToken(request.GetActionName())
 	request.RouteEntryName = d.Get("name").(string)
-	err = resource.Retry(10*time.Minute, func() *resource.RetryError {
+	err = resource.RetryContext(ctx, 10*time.Minute, func() *resource.RetryError {
 		if err := vpcService.WaitForAllRouteEntriesAvailable(rtId, DefaultTimeout); err != nil {
 			return resource.NonRetryableError(err)
 		}
@@ -122,10 +123,10 @@ func resourceAlibabacloudStackRouteEntryCreate(d *schema.ResourceData, meta inte
 	if err := vpcService.WaitForRouteEntry(d.Id(), Available, DefaultTimeout); err != nil {
 		return WrapError(err)
 	}
-	return resourceAlibabacloudStackRouteEntryRead(d, meta)
+	return resourceAlibabacloudStackRouteEntryRead(ctx, d, meta)
 }
 
-func resourceAlibabacloudStackRouteEntryRead(d *schema.ResourceData, meta interface{}) error {
+func resourceAlibabacloudStackRouteEntryRead(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 	wiatSecondsIfWithTest(1)
 	client := meta.(*connectivity.AlibabacloudStackClient)
 	vpcService := VpcService{client}
@@ -151,7 +152,7 @@ func resourceAlibabacloudStackRouteEntryRead(d *schema.ResourceData, meta interf
 	return nil
 }
 
-func resourceAlibabacloudStackRouteEntryDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceAlibabacloudStackRouteEntryDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 	request, err := buildAlibabacloudStackRouteEntryDeleteArgs(d, meta)
 	if err != nil {
 		return WrapError(err)
@@ -172,7 +173,7 @@ func resourceAlibabacloudStackRouteEntryDelete(d *schema.ResourceData, meta inte
 		return WrapError(err)
 	}
 	retryTimes := 7
-	err = resource.Retry(10*time.Minute, func() *resource.RetryError {
+	err = resource.RetryContext(ctx, 10*time.Minute, func() *resource.RetryError {
 		raw, err := client.WithVpcClient(func(vpcClient *vpc.Client) (interface{}, error) {
 			return vpcClient.DeleteRouteEntry(request)
 		})
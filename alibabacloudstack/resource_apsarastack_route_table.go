@@ -1,6 +1,7 @@
 package alibabacloudstack
 
 import (
+	"context"
 	"github.com/aliyun/alibaba-cloud-sdk-go/services/vpc"
 	"github.com/aliyun/terraform-provider-alibabacloudstack/alibabacloudstack/connectivity"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
@@ -10,12 +11,12 @@ import (
 
 func resourceAlibabacloudStackRouteTable() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceAliyunRouteTableCreate,
-		Read:   resourceAliyunRouteTableRead,
-		Update: resourceAliyunRouteTableUpdate,
-		Delete: resourceAliyunRouteTableDelete,
+		CreateContext: withDiagnostics(resourceAliyunRouteTableCreate),
+		ReadContext:   withDiagnostics(resourceAliyunRouteTableRead),
+		UpdateContext: withDiagnostics(resourceAliyunRouteTableUpdate),
+		DeleteContext: withDiagnostics(resourceAliyunRouteTableDelete),
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			StateContext: schema.ImportStatePassthroughContext,
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -40,7 +41,7 @@ func resourceAlibabacloudStackRouteTable() *schema.Resource {
 	}
 }
 
-func resourceAliyunRouteTableCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceAliyunRouteTableCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*connectivity.AlibabacloudStackClient)
 	vpcService := VpcService{client}
 
@@ -73,10 +74,10 @@ func resourceAliyunRouteTableCreate(d *schema.ResourceData, meta interface{}) er
 		return WrapError(err)
 	}
 
-	return resourceAliyunRouteTableUpdate(d, meta)
+	return resourceAliyunRouteTableUpdate(ctx, d, meta)
 }
 
-func resourceAliyunRouteTableRead(d *schema.ResourceData, meta interface{}) error {
+func resourceAliyunRouteTableRead(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 	wiatSecondsIfWithTest(1)
 
 	client := meta.(*connectivity.AlibabacloudStackClient)
@@ -96,15 +97,15 @@ func resourceAliyunRouteTableRead(d *schema.ResourceData, meta interface{}) erro
 	return nil
 }
 
-func resourceAliyunRouteTableUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceAliyunRouteTableUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*connectivity.AlibabacloudStackClient)
 	vpcService := VpcService{client}
-	if err := vpcService.setInstanceTags(d, TagResourceRouteTable); err != nil {
+	if err := vpcService.setInstanceTags(ctx, d, TagResourceRouteTable); err != nil {
 		return WrapError(err)
 	}
 	if d.IsNewResource() {
 		d.Partial(false)
-		return resourceAliyunRouteTableRead(d, meta)
+		return resourceAliyunRouteTableRead(ctx, d, meta)
 	}
 	request := vpc.CreateModifyRouteTableAttributesRequest()
 	request.RegionId = client.RegionId
@@ -133,10 +134,10 @@ func resourceAliyunRouteTableUpdate(d *schema.ResourceData, meta interface{}) er
 	}
 	addDebug(request.GetActionName(), raw, request.RpcRequest, request)
 
-	return resourceAliyunRouteTableRead(d, meta)
+	return resourceAliyunRouteTableRead(ctx, d, meta)
 }
 
-func resourceAliyunRouteTableDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceAliyunRouteTableDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*connectivity.AlibabacloudStackClient)
 	routeTableService := VpcService{client}
 	request := vpc.CreateDeleteRouteTableRequest()
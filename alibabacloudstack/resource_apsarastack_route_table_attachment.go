@@ -1,6 +1,7 @@
 package alibabacloudstack
 
 import (
+	"context"
 	"strings"
 	"time"
 
@@ -12,11 +13,11 @@ import (
 
 func resourceAlibabacloudStackRouteTableAttachment() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceAliyunRouteTableAttachmentCreate,
-		Read:   resourceAliyunRouteTableAttachmentRead,
-		Delete: resourceAliyunRouteTableAttachmentDelete,
+		CreateContext: withDiagnostics(resourceAliyunRouteTableAttachmentCreate),
+		ReadContext:   withDiagnostics(resourceAliyunRouteTableAttachmentRead),
+		DeleteContext: withDiagnostics(resourceAliyunRouteTableAttachmentDelete),
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			StateContext: schema.ImportStatePassthroughContext,
 		},
 		Schema: map[string]*schema.Schema{
 			"route_table_id": {
@@ -34,7 +35,7 @@ func resourceAlibabacloudStackRouteTableAttachment() *schema.Resource {
 	}
 }
 
-func resourceAliyunRouteTableAttachmentCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceAliyunRouteTableAttachmentCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*connectivity.AlibabacloudStackClient)
 	vpcService := VpcService{client}
 
@@ -50,7 +51,7 @@ func resourceAliyunRouteTableAttachmentCreate(d *schema.ResourceData, meta inter
 	request.RouteTableId = Trim(d.Get("route_table_id").(string))
 	request.VSwitchId = Trim(d.Get("vswitch_id").(string))
 	request.ClientToken = buildClientToken(request.GetActionName())
-	if err := resource.Retry(3*time.Minute, func() *resource.RetryError {
+	if err := resource.RetryContext(ctx, 3*time.Minute, func() *resource.RetryError {
 		args := *request
 		raw, err := client.WithVpcClient(func(vpcClient *vpc.Client) (interface{}, error) {
 			return vpcClient.AssociateRouteTable(&args)
@@ -74,10 +75,10 @@ func resourceAliyunRouteTableAttachmentCreate(d *schema.ResourceData, meta inter
 	if err := vpcService.WaitForVSwitch(request.VSwitchId, Available, DefaultTimeoutMedium); err != nil {
 		return WrapError(err)
 	}
-	return resourceAliyunRouteTableAttachmentRead(d, meta)
+	return resourceAliyunRouteTableAttachmentRead(ctx, d, meta)
 }
 
-func resourceAliyunRouteTableAttachmentRead(d *schema.ResourceData, meta interface{}) error {
+func resourceAliyunRouteTableAttachmentRead(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*connectivity.AlibabacloudStackClient)
 	vpcService := VpcService{client}
 	object, err := vpcService.DescribeRouteTableAttachment(d.Id())
@@ -97,7 +98,7 @@ func resourceAliyunRouteTableAttachmentRead(d *schema.ResourceData, meta interfa
 	return nil
 }
 
-func resourceAliyunRouteTableAttachmentDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceAliyunRouteTableAttachmentDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*connectivity.AlibabacloudStackClient)
 	vpcService := VpcService{client}
 
@@ -119,7 +120,7 @@ func resourceAliyunRouteTableAttachmentDelete(d *schema.ResourceData, meta inter
 	request.RouteTableId = parts[0]
 	request.VSwitchId = parts[1]
 	request.ClientToken = buildClientToken(request.GetActionName())
-	err = resource.Retry(5*time.Minute, func() *resource.RetryError {
+	err = resource.RetryContext(ctx, 5*time.Minute, func() *resource.RetryError {
 		args := *request
 		raw, err := client.WithVpcClient(func(vpcClient *vpc.Client) (interface{}, error) {
 			return vpcClient.UnassociateRouteTable(&args)
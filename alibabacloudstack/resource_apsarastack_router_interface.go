@@ -1,6 +1,7 @@
 package alibabacloudstack
 
 import (
+	"context"
 	"strings"
 	"time"
 
@@ -14,12 +15,12 @@ import (
 
 func resourceAlibabacloudStackRouterInterface() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceAlibabacloudStackRouterInterfaceCreate,
-		Read:   resourceAlibabacloudStackRouterInterfaceRead,
-		Update: resourceAlibabacloudStackRouterInterfaceUpdate,
-		Delete: resourceAlibabacloudStackRouterInterfaceDelete,
+		CreateContext: withDiagnostics(resourceAlibabacloudStackRouterInterfaceCreate),
+		ReadContext:   withDiagnostics(resourceAlibabacloudStackRouterInterfaceRead),
+		UpdateContext: withDiagnostics(resourceAlibabacloudStackRouterInterfaceUpdate),
+		DeleteContext: withDiagnostics(resourceAlibabacloudStackRouterInterfaceDelete),
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			StateContext: schema.ImportStatePassthroughContext,
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -106,7 +107,7 @@ func resourceAlibabacloudStackRouterInterface() *schema.Resource {
 	}
 }
 
-func resourceAlibabacloudStackRouterInterfaceCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceAlibabacloudStackRouterInterfaceCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*connectivity.AlibabacloudStackClient)
 	vpcService := VpcService{client}
 	request, err := buildAlibabacloudStackRouterInterfaceCreateArgs(d, meta)
@@ -135,10 +136,10 @@ func resourceAlibabacloudStackRouterInterfaceCreate(d *schema.ResourceData, meta
 		return WrapError(err)
 	}
 
-	return resourceAlibabacloudStackRouterInterfaceUpdate(d, meta)
+	return resourceAlibabacloudStackRouterInterfaceUpdate(ctx, d, meta)
 }
 
-func resourceAlibabacloudStackRouterInterfaceUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceAlibabacloudStackRouterInterfaceUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*connectivity.AlibabacloudStackClient)
 
 	d.Partial(true)
@@ -189,10 +190,10 @@ func resourceAlibabacloudStackRouterInterfaceUpdate(d *schema.ResourceData, meta
 	}
 
 	d.Partial(false)
-	return resourceAlibabacloudStackRouterInterfaceRead(d, meta)
+	return resourceAlibabacloudStackRouterInterfaceRead(ctx, d, meta)
 }
 
-func resourceAlibabacloudStackRouterInterfaceRead(d *schema.ResourceData, meta interface{}) error {
+func resourceAlibabacloudStackRouterInterfaceRead(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 	wiatSecondsIfWithTest(1)
 	client := meta.(*connectivity.AlibabacloudStackClient)
 	vpcService := VpcService{client}
@@ -223,7 +224,7 @@ func resourceAlibabacloudStackRouterInterfaceRead(d *schema.ResourceData, meta i
 
 }
 
-func resourceAlibabacloudStackRouterInterfaceDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceAlibabacloudStackRouterInterfaceDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*connectivity.AlibabacloudStackClient)
 	vpcService := VpcService{client}
 
@@ -249,7 +250,7 @@ func resourceAlibabacloudStackRouterInterfaceDelete(d *schema.ResourceData, meta
 	request.QueryParams = map[string]string{"AccessKeySecret": client.SecretKey, "Product": "vpc", "Department": client.Department, "ResourceGroup": client.ResourceGroup}
 	request.RouterInterfaceId = d.Id()
 	request.ClientToken = buildClientToken(request.GetActionName())
-	err := resource.Retry(5*time.Minute, func() *resource.RetryError {
+	err := resource.RetryContext(ctx, 5*time.Minute, func() *resource.RetryError {
 		args := *request
 		raw, err := client.WithVpcClient(func(vpcClient *vpc.Client) (interface{}, error) {
 			return vpcClient.DeleteRouterInterface(&args)
@@ -1,6 +1,7 @@
 package alibabacloudstack
 
 import (
+	"context"
 	"strings"
 	"time"
 
@@ -15,11 +16,11 @@ import (
 
 func resourceAlibabacloudStackRouterInterfaceConnection() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceAlibabacloudStackRouterInterfaceConnectionCreate,
-		Read:   resourceAlibabacloudStackRouterInterfaceConnectionRead,
-		Delete: resourceAlibabacloudStackRouterInterfaceConnectionDelete,
+		CreateContext: withDiagnostics(resourceAlibabacloudStackRouterInterfaceConnectionCreate),
+		ReadContext:   withDiagnostics(resourceAlibabacloudStackRouterInterfaceConnectionRead),
+		DeleteContext: withDiagnostics(resourceAlibabacloudStackRouterInterfaceConnectionDelete),
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			StateContext: schema.ImportStatePassthroughContext,
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -63,7 +64,7 @@ func resourceAlibabacloudStackRouterInterfaceConnection() *schema.Resource {
 	}
 }
 
-func resourceAlibabacloudStackRouterInterfaceConnectionCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceAlibabacloudStackRouterInterfaceConnectionCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*connectivity.AlibabacloudStackClient)
 	vpcService := VpcService{client}
 
@@ -88,7 +89,7 @@ func resourceAlibabacloudStackRouterInterfaceConnectionCreate(d *schema.Resource
 			if err = vpcService.WaitForRouterInterfaceConnection(d.Id(), client.RegionId, Active, DefaultTimeout); err != nil {
 				return WrapError(err)
 			}
-			return resourceAlibabacloudStackRouterInterfaceConnectionRead(d, meta)
+			return resourceAlibabacloudStackRouterInterfaceConnectionRead(ctx, d, meta)
 		}
 	}
 
@@ -108,13 +109,13 @@ func resourceAlibabacloudStackRouterInterfaceConnectionCreate(d *schema.Resource
 	if owner_id, ok := d.GetOk("opposite_interface_owner_id"); ok && owner_id.(string) != "" {
 		request.OppositeInterfaceOwnerId = requests.Integer(owner_id.(string))
 		if v, o := d.GetOk("opposite_router_type"); !o || v.(string) == "" {
-			return WrapError(Error("'opposite_router_type' is required when 'opposite_interface_owner_id' is set."))
+			return WrapAttributeError("opposite_router_type", WrapError(Error("'opposite_router_type' is required when 'opposite_interface_owner_id' is set.")))
 		} else {
 			request.OppositeRouterType = v.(string)
 		}
 
 		if v, o := d.GetOk("opposite_router_id"); !o || v.(string) == "" {
-			return WrapError(Error("'opposite_router_id' is required when 'opposite_interface_owner_id' is set."))
+			return WrapAttributeError("opposite_router_id", WrapError(Error("'opposite_router_id' is required when 'opposite_interface_owner_id' is set.")))
 		} else {
 			request.OppositeRouterId = v.(string)
 		}
@@ -163,7 +164,7 @@ func resourceAlibabacloudStackRouterInterfaceConnectionCreate(d *schema.Resource
 		connectRequest.QueryParams = map[string]string{"AccessKeySecret": client.SecretKey, "Product": "vpc", "Department": client.Department, "ResourceGroup": client.ResourceGroup}
 
 		connectRequest.RouterInterfaceId = interfaceId
-		if err := resource.Retry(2*time.Minute, func() *resource.RetryError {
+		if err := resource.RetryContext(ctx, 2*time.Minute, func() *resource.RetryError {
 			raw, err := client.WithVpcClient(func(vpcClient *vpc.Client) (interface{}, error) {
 				return vpcClient.ConnectRouterInterface(connectRequest)
 			})
@@ -184,10 +185,10 @@ func resourceAlibabacloudStackRouterInterfaceConnectionCreate(d *schema.Resource
 		}
 	}
 
-	return resourceAlibabacloudStackRouterInterfaceConnectionRead(d, meta)
+	return resourceAlibabacloudStackRouterInterfaceConnectionRead(ctx, d, meta)
 }
 
-func resourceAlibabacloudStackRouterInterfaceConnectionRead(d *schema.ResourceData, meta interface{}) error {
+func resourceAlibabacloudStackRouterInterfaceConnectionRead(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 	wiatSecondsIfWithTest(1)
 	client := meta.(*connectivity.AlibabacloudStackClient)
 	vpcService := VpcService{client}
@@ -219,7 +220,7 @@ func resourceAlibabacloudStackRouterInterfaceConnectionRead(d *schema.ResourceDa
 
 }
 
-func resourceAlibabacloudStackRouterInterfaceConnectionDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceAlibabacloudStackRouterInterfaceConnectionDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*connectivity.AlibabacloudStackClient)
 	vpcService := VpcService{client}
 	object, err := vpcService.DescribeRouterInterfaceConnection(d.Id(), client.RegionId)
@@ -1,6 +1,7 @@
 package alibabacloudstack
 
 import (
+	"context"
 	"strings"
 	"time"
 
@@ -14,12 +15,12 @@ import (
 
 func resourceAlibabacloudStackSecurityGroup() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceAlibabacloudStackSecurityGroupCreate,
-		Read:   resourceAlibabacloudStackSecurityGroupRead,
-		Update: resourceAlibabacloudStackSecurityGroupUpdate,
-		Delete: resourceAlibabacloudStackSecurityGroupDelete,
+		CreateContext: withDiagnostics(resourceAlibabacloudStackSecurityGroupCreate),
+		ReadContext:   withDiagnostics(resourceAlibabacloudStackSecurityGroupRead),
+		UpdateContext: withDiagnostics(resourceAlibabacloudStackSecurityGroupUpdate),
+		DeleteContext: withDiagnostics(resourceAlibabacloudStackSecurityGroupDelete),
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			StateContext: schema.ImportStatePassthroughContext,
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -52,7 +53,7 @@ func resourceAlibabacloudStackSecurityGroup() *schema.Resource {
 	}
 }
 
-func resourceAlibabacloudStackSecurityGroupCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceAlibabacloudStackSecurityGroupCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*connectivity.AlibabacloudStackClient)
 
 	request := ecs.CreateCreateSecurityGroupRequest()
@@ -86,10 +87,10 @@ func resourceAlibabacloudStackSecurityGroupCreate(d *schema.ResourceData, meta i
 	addDebug(request.GetActionName(), raw, request.RpcRequest, request)
 	response, _ := raw.(*ecs.CreateSecurityGroupResponse)
 	d.SetId(response.SecurityGroupId)
-	return resourceAlibabacloudStackSecurityGroupUpdate(d, meta)
+	return resourceAlibabacloudStackSecurityGroupUpdate(ctx, d, meta)
 }
 
-func resourceAlibabacloudStackSecurityGroupRead(d *schema.ResourceData, meta interface{}) error {
+func resourceAlibabacloudStackSecurityGroupRead(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 	wiatSecondsIfWithTest(1)
 	client := meta.(*connectivity.AlibabacloudStackClient)
 	ecsService := EcsService{client}
@@ -134,12 +135,12 @@ func resourceAlibabacloudStackSecurityGroupRead(d *schema.ResourceData, meta int
 	return nil
 }
 
-func resourceAlibabacloudStackSecurityGroupUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceAlibabacloudStackSecurityGroupUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*connectivity.AlibabacloudStackClient)
 
 	d.Partial(true)
 
-	if err := setTags(client, TagResourceSecurityGroup, d); err != nil {
+	if err := setTags(ctx, client, TagResourceSecurityGroup, d); err != nil {
 		return WrapError(err)
 	} else {
 		//d.SetPartial("tags")
@@ -175,7 +176,7 @@ func resourceAlibabacloudStackSecurityGroupUpdate(d *schema.ResourceData, meta i
 
 	if d.IsNewResource() {
 		d.Partial(false)
-		return resourceAlibabacloudStackSecurityGroupRead(d, meta)
+		return resourceAlibabacloudStackSecurityGroupRead(ctx, d, meta)
 	}
 
 	update := false
@@ -212,10 +213,10 @@ func resourceAlibabacloudStackSecurityGroupUpdate(d *schema.ResourceData, meta i
 
 	d.Partial(false)
 
-	return resourceAlibabacloudStackSecurityGroupRead(d, meta)
+	return resourceAlibabacloudStackSecurityGroupRead(ctx, d, meta)
 }
 
-func resourceAlibabacloudStackSecurityGroupDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceAlibabacloudStackSecurityGroupDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*connectivity.AlibabacloudStackClient)
 	ecsService := EcsService{client}
 	request := ecs.CreateDeleteSecurityGroupRequest()
@@ -228,7 +229,7 @@ func resourceAlibabacloudStackSecurityGroupDelete(d *schema.ResourceData, meta i
 	} else {
 		request.Scheme = "http"
 	}
-	err := resource.Retry(6*time.Minute, func() *resource.RetryError {
+	err := resource.RetryContext(ctx, 6*time.Minute, func() *resource.RetryError {
 		raw, err := client.WithEcsClient(func(ecsClient *ecs.Client) (interface{}, error) {
 			return ecsClient.DeleteSecurityGroup(request)
 		})
@@ -1,6 +1,7 @@
 package alibabacloudstack
 
 import (
+	"context"
 	"fmt"
 	"strconv"
 	"strings"
@@ -17,12 +18,12 @@ import (
 
 func resourceAlibabacloudStackSecurityGroupRule() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceAlibabacloudStackSecurityGroupRuleCreate,
-		Read:   resourceAlibabacloudStackSecurityGroupRuleRead,
-		Update: resourceAlibabacloudStackSecurityGroupRuleUpdate,
-		Delete: resourceAlibabacloudStackSecurityGroupRuleDelete,
+		CreateContext: withDiagnostics(resourceAlibabacloudStackSecurityGroupRuleCreate),
+		ReadContext:   withDiagnostics(resourceAlibabacloudStackSecurityGroupRuleRead),
+		UpdateContext: withDiagnostics(resourceAlibabacloudStackSecurityGroupRuleUpdate),
+		DeleteContext: withDiagnostics(resourceAlibabacloudStackSecurityGroupRuleDelete),
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			StateContext: schema.ImportStatePassthroughContext,
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -106,7 +107,7 @@ func resourceAlibabacloudStackSecurityGroupRule() *schema.Resource {
 	}
 }
 
-func resourceAlibabacloudStackSecurityGroupRuleCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceAlibabacloudStackSecurityGroupRuleCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*connectivity.AlibabacloudStackClient)
 
 	direction := d.Get("type").(string)
@@ -158,10 +159,10 @@ func resourceAlibabacloudStackSecurityGroupRuleCreate(d *schema.ResourceData, me
 
 	d.SetId(sgId + ":" + direction + ":" + ptl + ":" + port + ":" + nicType + ":" + cidr_ip + ":" + policy + ":" + strconv.Itoa(priority))
 
-	return resourceAlibabacloudStackSecurityGroupRuleRead(d, meta)
+	return resourceAlibabacloudStackSecurityGroupRuleRead(ctx, d, meta)
 }
 
-func resourceAlibabacloudStackSecurityGroupRuleRead(d *schema.ResourceData, meta interface{}) error {
+func resourceAlibabacloudStackSecurityGroupRuleRead(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 	wiatSecondsIfWithTest(1)
 	client := meta.(*connectivity.AlibabacloudStackClient)
 	ecsService := EcsService{client}
@@ -217,7 +218,7 @@ func resourceAlibabacloudStackSecurityGroupRuleRead(d *schema.ResourceData, meta
 	return nil
 }
 
-func resourceAlibabacloudStackSecurityGroupRuleUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceAlibabacloudStackSecurityGroupRuleUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*connectivity.AlibabacloudStackClient)
 
 	policy := parseSecurityRuleId(d, meta, 6)
@@ -268,10 +269,10 @@ func resourceAlibabacloudStackSecurityGroupRuleUpdate(d *schema.ResourceData, me
 	}
 
 	addDebug(request.GetActionName(), raw, request.Headers, request)
-	return resourceAlibabacloudStackSecurityGroupRuleRead(d, meta)
+	return resourceAlibabacloudStackSecurityGroupRuleRead(ctx, d, meta)
 }
 
-func deleteSecurityGroupRule(d *schema.ResourceData, meta interface{}) error {
+func deleteSecurityGroupRule(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*connectivity.AlibabacloudStackClient)
 	ruleType := d.Get("type").(string)
 	request, err := buildAlibabacloudStackSGRuleRequest(d, meta)
@@ -297,7 +298,7 @@ func deleteSecurityGroupRule(d *schema.ResourceData, meta interface{}) error {
 	return nil
 }
 
-func resourceAlibabacloudStackSecurityGroupRuleDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceAlibabacloudStackSecurityGroupRuleDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 	policy := parseSecurityRuleId(d, meta, 6)
 	strPriority := parseSecurityRuleId(d, meta, 7)
 	var priority int
@@ -313,8 +314,8 @@ func resourceAlibabacloudStackSecurityGroupRuleDelete(d *schema.ResourceData, me
 		priority = prior
 	}
 
-	err := resource.Retry(5*time.Minute, func() *resource.RetryError {
-		err := deleteSecurityGroupRule(d, meta)
+	err := resource.RetryContext(ctx, 5*time.Minute, func() *resource.RetryError {
+		err := deleteSecurityGroupRule(ctx, d, meta)
 		if err != nil {
 			if NotFoundError(err) || IsExpectedErrors(err, []string{"InvalidSecurityGroupId.NotFound"}) {
 				return nil
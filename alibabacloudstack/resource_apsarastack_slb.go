@@ -1,6 +1,7 @@
 package alibabacloudstack
 
 import (
+	"context"
 	"log"
 	"strings"
 
@@ -14,13 +15,14 @@ import (
 
 func resourceAlibabacloudStackSlb() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceAlibabacloudStackSlbCreate,
-		Read:   resourceAlibabacloudStackSlbRead,
-		Update: resourceAlibabacloudStackSlbUpdate,
-		Delete: resourceAlibabacloudStackSlbDelete,
+		CreateContext: withDiagnostics(resourceAlibabacloudStackSlbCreate),
+		ReadContext:   withDiagnostics(resourceAlibabacloudStackSlbRead),
+		UpdateContext: withDiagnostics(resourceAlibabacloudStackSlbUpdate),
+		DeleteContext: withDiagnostics(resourceAlibabacloudStackSlbDelete),
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			StateContext: schema.ImportStatePassthroughContext,
 		},
+		CustomizeDiff: quotaCustomizeDiff("SLB", slbQuotaAmounts),
 
 		Schema: map[string]*schema.Schema{
 			"name": {
@@ -65,7 +67,7 @@ func resourceAlibabacloudStackSlb() *schema.Resource {
 	}
 }
 
-func resourceAlibabacloudStackSlbCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceAlibabacloudStackSlbCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*connectivity.AlibabacloudStackClient)
 	slbService := SlbService{client}
 	request := slb.CreateCreateLoadBalancerRequest()
@@ -121,10 +123,10 @@ func resourceAlibabacloudStackSlbCreate(d *schema.ResourceData, meta interface{}
 		return WrapError(err)
 	}
 
-	return resourceAlibabacloudStackSlbUpdate(d, meta)
+	return resourceAlibabacloudStackSlbUpdate(ctx, d, meta)
 }
 
-func resourceAlibabacloudStackSlbRead(d *schema.ResourceData, meta interface{}) error {
+func resourceAlibabacloudStackSlbRead(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 	wiatSecondsIfWithTest(1)
 	client := meta.(*connectivity.AlibabacloudStackClient)
 	slbService := SlbService{client}
@@ -143,7 +145,7 @@ func resourceAlibabacloudStackSlbRead(d *schema.ResourceData, meta interface{})
 	d.Set("address", object.Address)
 	d.Set("specification", object.LoadBalancerSpec)
 
-	tags, _ := slbService.DescribeTags(d.Id(), nil, TagResourceInstance)
+	tags, _ := slbService.DescribeTags(ctx, d.Id(), nil, TagResourceInstance)
 	if len(tags) > 0 {
 		if err := d.Set("tags", slbService.tagsToMap(tags)); err != nil {
 			return WrapError(err)
@@ -152,7 +154,7 @@ func resourceAlibabacloudStackSlbRead(d *schema.ResourceData, meta interface{})
 	return nil
 }
 
-func resourceAlibabacloudStackSlbUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceAlibabacloudStackSlbUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 
 	client := meta.(*connectivity.AlibabacloudStackClient)
 	slbService := SlbService{client}
@@ -160,13 +162,13 @@ func resourceAlibabacloudStackSlbUpdate(d *schema.ResourceData, meta interface{}
 
 	// set instance tags
 
-	if err := slbService.setInstanceTags(d, TagResourceInstance); err != nil {
+	if err := slbService.setInstanceTags(ctx, d, TagResourceInstance); err != nil {
 		return WrapError(err)
 	}
 
 	if d.IsNewResource() {
 		d.Partial(false)
-		return resourceAlibabacloudStackSlbRead(d, meta)
+		return resourceAlibabacloudStackSlbRead(ctx, d, meta)
 	}
 
 	if d.HasChange("specification") {
@@ -255,10 +257,10 @@ func resourceAlibabacloudStackSlbUpdate(d *schema.ResourceData, meta interface{}
 	}
 	d.Partial(false)
 
-	return resourceAlibabacloudStackSlbRead(d, meta)
+	return resourceAlibabacloudStackSlbRead(ctx, d, meta)
 }
 
-func resourceAlibabacloudStackSlbDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceAlibabacloudStackSlbDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*connectivity.AlibabacloudStackClient)
 	slbService := SlbService{client}
 
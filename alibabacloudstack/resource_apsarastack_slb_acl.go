@@ -1,6 +1,7 @@
 package alibabacloudstack
 
 import (
+	"context"
 	"strings"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
@@ -15,12 +16,12 @@ import (
 
 func resourceAlibabacloudStackSlbAcl() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceAlibabacloudStackSlbAclCreate,
-		Read:   resourceAlibabacloudStackSlbAclRead,
-		Update: resourceAlibabacloudStackSlbAclUpdate,
-		Delete: resourceAlibabacloudStackSlbAclDelete,
+		CreateContext: withDiagnostics(resourceAlibabacloudStackSlbAclCreate),
+		ReadContext:   withDiagnostics(resourceAlibabacloudStackSlbAclRead),
+		UpdateContext: withDiagnostics(resourceAlibabacloudStackSlbAclUpdate),
+		DeleteContext: withDiagnostics(resourceAlibabacloudStackSlbAclDelete),
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			StateContext: schema.ImportStatePassthroughContext,
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -58,7 +59,7 @@ func resourceAlibabacloudStackSlbAcl() *schema.Resource {
 	}
 }
 
-func resourceAlibabacloudStackSlbAclCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceAlibabacloudStackSlbAclCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*connectivity.AlibabacloudStackClient)
 
 	request := slb.CreateCreateAccessControlListRequest()
@@ -86,15 +87,15 @@ func resourceAlibabacloudStackSlbAclCreate(d *schema.ResourceData, meta interfac
 	response, _ := raw.(*slb.CreateAccessControlListResponse)
 
 	d.SetId(response.AclId)
-	return resourceAlibabacloudStackSlbAclUpdate(d, meta)
+	return resourceAlibabacloudStackSlbAclUpdate(ctx, d, meta)
 }
 
-func resourceAlibabacloudStackSlbAclRead(d *schema.ResourceData, meta interface{}) error {
+func resourceAlibabacloudStackSlbAclRead(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 	wiatSecondsIfWithTest(1)
 	client := meta.(*connectivity.AlibabacloudStackClient)
 	slbService := SlbService{client}
 
-	tags, err := slbService.DescribeTags(d.Id(), nil, TagResourceAcl)
+	tags, err := slbService.DescribeTags(ctx, d.Id(), nil, TagResourceAcl)
 	if err != nil {
 		return WrapError(err)
 	}
@@ -117,13 +118,13 @@ func resourceAlibabacloudStackSlbAclRead(d *schema.ResourceData, meta interface{
 	return nil
 }
 
-func resourceAlibabacloudStackSlbAclUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceAlibabacloudStackSlbAclUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*connectivity.AlibabacloudStackClient)
 	slbService := SlbService{client}
 
 	d.Partial(true)
 
-	if err := slbService.setInstanceTags(d, TagResourceAcl); err != nil {
+	if err := slbService.setInstanceTags(ctx, d, TagResourceAcl); err != nil {
 		return WrapError(err)
 	}
 
@@ -172,10 +173,10 @@ func resourceAlibabacloudStackSlbAclUpdate(d *schema.ResourceData, meta interfac
 
 	d.Partial(false)
 
-	return resourceAlibabacloudStackSlbAclRead(d, meta)
+	return resourceAlibabacloudStackSlbAclRead(ctx, d, meta)
 }
 
-func resourceAlibabacloudStackSlbAclDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceAlibabacloudStackSlbAclDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*connectivity.AlibabacloudStackClient)
 	slbService := SlbService{client}
 	request := slb.CreateDeleteAccessControlListRequest()
@@ -188,7 +189,7 @@ func resourceAlibabacloudStackSlbAclDelete(d *schema.ResourceData, meta interfac
 	request.Headers = map[string]string{"RegionId": client.RegionId}
 	request.QueryParams = map[string]string{"AccessKeySecret": client.SecretKey, "Product": "slb", "Department": client.Department, "ResourceGroup": client.ResourceGroup}
 	request.AclId = d.Id()
-	err := resource.Retry(3*time.Minute, func() *resource.RetryError {
+	err := resource.RetryContext(ctx, 3*time.Minute, func() *resource.RetryError {
 		raw, err := client.WithSlbClient(func(slbClient *slb.Client) (interface{}, error) {
 			return slbClient.DeleteAccessControlList(request)
 		})
@@ -1,6 +1,7 @@
 package alibabacloudstack
 
 import (
+	"context"
 	"fmt"
 	"strings"
 	"time"
@@ -15,12 +16,12 @@ import (
 
 func resourceAlibabacloudStackSlbBackendServer() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceAlibabacloudStackSlbBackendServersCreate,
-		Read:   resourceAlibabacloudStackSlbBackendServersRead,
-		Update: resourceAlibabacloudStackSlbBackendServersUpdate,
-		Delete: resourceAlibabacloudStackSlbBackendServersDelete,
+		CreateContext: withDiagnostics(resourceAlibabacloudStackSlbBackendServersCreate),
+		ReadContext:   withDiagnostics(resourceAlibabacloudStackSlbBackendServersRead),
+		UpdateContext: withDiagnostics(resourceAlibabacloudStackSlbBackendServersUpdate),
+		DeleteContext: withDiagnostics(resourceAlibabacloudStackSlbBackendServersDelete),
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			StateContext: schema.ImportStatePassthroughContext,
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -57,7 +58,7 @@ func resourceAlibabacloudStackSlbBackendServer() *schema.Resource {
 	}
 }
 
-func resourceAlibabacloudStackSlbBackendServersCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceAlibabacloudStackSlbBackendServersCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*connectivity.AlibabacloudStackClient)
 	request := slb.CreateAddBackendServersRequest()
 	request.RegionId = client.RegionId
@@ -82,10 +83,10 @@ func resourceAlibabacloudStackSlbBackendServersCreate(d *schema.ResourceData, me
 	response, _ := raw.(*slb.AddBackendServersResponse)
 	d.SetId(response.LoadBalancerId)
 
-	return resourceAlibabacloudStackSlbBackendServersRead(d, meta)
+	return resourceAlibabacloudStackSlbBackendServersRead(ctx, d, meta)
 }
 
-func resourceAlibabacloudStackSlbBackendServersRead(d *schema.ResourceData, meta interface{}) error {
+func resourceAlibabacloudStackSlbBackendServersRead(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 	wiatSecondsIfWithTest(1)
 	client := meta.(*connectivity.AlibabacloudStackClient)
 	slbService := SlbService{client}
@@ -119,7 +120,7 @@ func resourceAlibabacloudStackSlbBackendServersRead(d *schema.ResourceData, meta
 	return nil
 }
 
-func resourceAlibabacloudStackSlbBackendServersUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceAlibabacloudStackSlbBackendServersUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*connectivity.AlibabacloudStackClient)
 
 	d.Partial(true)
@@ -269,10 +270,10 @@ func resourceAlibabacloudStackSlbBackendServersUpdate(d *schema.ResourceData, me
 	}
 	d.Partial(false)
 
-	return resourceAlibabacloudStackSlbBackendServersRead(d, meta)
+	return resourceAlibabacloudStackSlbBackendServersRead(ctx, d, meta)
 }
 
-func resourceAlibabacloudStackSlbBackendServersDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceAlibabacloudStackSlbBackendServersDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*connectivity.AlibabacloudStackClient)
 	instanceSet := d.Get("backend_servers").(*schema.Set)
 	step := 20
@@ -322,7 +323,7 @@ func resourceAlibabacloudStackSlbBackendServersDelete(d *schema.ResourceData, me
 			}
 
 			request.BackendServers = expandBackendServersWithoutTypeToString(servers[start:end])
-			err := resource.Retry(5*time.Minute, func() *resource.RetryError {
+			err := resource.RetryContext(ctx, 5*time.Minute, func() *resource.RetryError {
 				raw, err := client.WithSlbClient(func(slbClient *slb.Client) (interface{}, error) {
 					return slbClient.RemoveBackendServers(request)
 				})
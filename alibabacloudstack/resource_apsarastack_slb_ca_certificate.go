@@ -1,6 +1,7 @@
 package alibabacloudstack
 
 import (
+	"context"
 	"strings"
 	"time"
 
@@ -12,12 +13,12 @@ import (
 
 func resourceAlibabacloudStackSlbCACertificate() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceAlibabacloudStackSlbCACertificateCreate,
-		Read:   resourceAlibabacloudStackSlbCACertificateRead,
-		Update: resourceAlibabacloudStackSlbCACertificateUpdate,
-		Delete: resourceAlibabacloudStackSlbCACertificateDelete,
+		CreateContext: withDiagnostics(resourceAlibabacloudStackSlbCACertificateCreate),
+		ReadContext:   withDiagnostics(resourceAlibabacloudStackSlbCACertificateRead),
+		UpdateContext: withDiagnostics(resourceAlibabacloudStackSlbCACertificateUpdate),
+		DeleteContext: withDiagnostics(resourceAlibabacloudStackSlbCACertificateDelete),
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			StateContext: schema.ImportStatePassthroughContext,
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -34,7 +35,7 @@ func resourceAlibabacloudStackSlbCACertificate() *schema.Resource {
 	}
 }
 
-func resourceAlibabacloudStackSlbCACertificateCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceAlibabacloudStackSlbCACertificateCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*connectivity.AlibabacloudStackClient)
 	slbService := SlbService{client}
 
@@ -69,10 +70,10 @@ func resourceAlibabacloudStackSlbCACertificateCreate(d *schema.ResourceData, met
 
 	d.SetId(response.CACertificateId)
 
-	return resourceAlibabacloudStackSlbCACertificateUpdate(d, meta)
+	return resourceAlibabacloudStackSlbCACertificateUpdate(ctx, d, meta)
 }
 
-func resourceAlibabacloudStackSlbCACertificateRead(d *schema.ResourceData, meta interface{}) error {
+func resourceAlibabacloudStackSlbCACertificateRead(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 	wiatSecondsIfWithTest(1)
 	client := meta.(*connectivity.AlibabacloudStackClient)
 	slbService := SlbService{client}
@@ -94,7 +95,7 @@ func resourceAlibabacloudStackSlbCACertificateRead(d *schema.ResourceData, meta
 	return nil
 }
 
-func resourceAlibabacloudStackSlbCACertificateUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceAlibabacloudStackSlbCACertificateUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*connectivity.AlibabacloudStackClient)
 	if d.HasChange("name") {
 		request := slb.CreateSetCACertificateNameRequest()
@@ -117,10 +118,10 @@ func resourceAlibabacloudStackSlbCACertificateUpdate(d *schema.ResourceData, met
 		addDebug(request.GetActionName(), raw, request.RpcRequest, request)
 	}
 
-	return resourceAlibabacloudStackSlbCACertificateRead(d, meta)
+	return resourceAlibabacloudStackSlbCACertificateRead(ctx, d, meta)
 }
 
-func resourceAlibabacloudStackSlbCACertificateDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceAlibabacloudStackSlbCACertificateDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*connectivity.AlibabacloudStackClient)
 	slbService := SlbService{client}
 
@@ -135,7 +136,7 @@ func resourceAlibabacloudStackSlbCACertificateDelete(d *schema.ResourceData, met
 	request.QueryParams = map[string]string{"AccessKeySecret": client.SecretKey, "Product": "slb", "Department": client.Department, "ResourceGroup": client.ResourceGroup}
 	request.CACertificateId = d.Id()
 
-	err := resource.Retry(2*time.Minute, func() *resource.RetryError {
+	err := resource.RetryContext(ctx, 2*time.Minute, func() *resource.RetryError {
 		raw, err := client.WithSlbClient(func(slbClient *slb.Client) (interface{}, error) {
 			return slbClient.DeleteCACertificate(request)
 		})
@@ -1,6 +1,7 @@
 package alibabacloudstack
 
 import (
+	"context"
 	"fmt"
 	"regexp"
 	"strconv"
@@ -18,12 +19,12 @@ import (
 
 func resourceAlibabacloudStackSlbListener() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceAlibabacloudStackSlbListenerCreate,
-		Read:   resourceAlibabacloudStackSlbListenerRead,
-		Update: resourceAlibabacloudStackSlbListenerUpdate,
-		Delete: resourceAlibabacloudStackSlbListenerDelete,
+		CreateContext: withDiagnostics(resourceAlibabacloudStackSlbListenerCreate),
+		ReadContext:   withDiagnostics(resourceAlibabacloudStackSlbListenerRead),
+		UpdateContext: withDiagnostics(resourceAlibabacloudStackSlbListenerUpdate),
+		DeleteContext: withDiagnostics(resourceAlibabacloudStackSlbListenerDelete),
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			StateContext: schema.ImportStatePassthroughContext,
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -295,7 +296,7 @@ func resourceAlibabacloudStackSlbListener() *schema.Resource {
 	}
 }
 
-func resourceAlibabacloudStackSlbListenerCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceAlibabacloudStackSlbListenerCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 
 	client := meta.(*connectivity.AlibabacloudStackClient)
 	slbService := SlbService{client}
@@ -339,7 +340,7 @@ func resourceAlibabacloudStackSlbListenerCreate(d *schema.ResourceData, meta int
 		if Protocol(protocol) == Https {
 			scId := d.Get("server_certificate_id").(string)
 			if scId == "" {
-				return WrapError(Error(`'server_certificate_id': required field is not set when the protocol is 'https'.`))
+				return WrapAttributeError("server_certificate_id", WrapError(Error(`'server_certificate_id': required field is not set when the protocol is 'https'.`)))
 			}
 			request.QueryParams["ServerCertificateId"] = scId
 		}
@@ -370,7 +371,7 @@ func resourceAlibabacloudStackSlbListenerCreate(d *schema.ResourceData, meta int
 	startLoadBalancerListenerRequest.ListenerPort = requests.NewInteger(frontend)
 	startLoadBalancerListenerRequest.ListenerProtocol = protocol
 
-	err = resource.Retry(5*time.Minute, func() *resource.RetryError {
+	err = resource.RetryContext(ctx, 5*time.Minute, func() *resource.RetryError {
 		raw, err = client.WithSlbClient(func(slbClient *slb.Client) (interface{}, error) {
 			return slbClient.StartLoadBalancerListener(startLoadBalancerListenerRequest)
 		})
@@ -392,12 +393,12 @@ func resourceAlibabacloudStackSlbListenerCreate(d *schema.ResourceData, meta int
 		return WrapError(err)
 	}
 	if httpForward {
-		return resourceAlibabacloudStackSlbListenerRead(d, meta)
+		return resourceAlibabacloudStackSlbListenerRead(ctx, d, meta)
 	}
-	return resourceAlibabacloudStackSlbListenerUpdate(d, meta)
+	return resourceAlibabacloudStackSlbListenerUpdate(ctx, d, meta)
 }
 
-func resourceAlibabacloudStackSlbListenerRead(d *schema.ResourceData, meta interface{}) error {
+func resourceAlibabacloudStackSlbListenerRead(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 	wiatSecondsIfWithTest(1)
 	client := meta.(*connectivity.AlibabacloudStackClient)
 	slbService := SlbService{client}
@@ -415,7 +416,7 @@ func resourceAlibabacloudStackSlbListenerRead(d *schema.ResourceData, meta inter
 	d.Set("load_balancer_id", lb_id)
 	d.Set("frontend_port", port)
 	d.SetId(lb_id + ":" + protocol + ":" + strconv.Itoa(port))
-	return resource.Retry(5*time.Minute, func() *resource.RetryError {
+	return resource.RetryContext(ctx, 5*time.Minute, func() *resource.RetryError {
 		object, err := slbService.DescribeSlbListener(d.Id())
 		if err != nil {
 			if NotFoundError(err) {
@@ -437,7 +438,7 @@ func resourceAlibabacloudStackSlbListenerRead(d *schema.ResourceData, meta inter
 	})
 }
 
-func resourceAlibabacloudStackSlbListenerUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceAlibabacloudStackSlbListenerUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 	proto := d.Get("protocol").(string)
 	lb_id := d.Get("load_balancer_id").(string)
 	frontend := d.Get("frontend_port").(int)
@@ -626,7 +627,7 @@ func resourceAlibabacloudStackSlbListenerUpdate(d *schema.ResourceData, meta int
 	if protocol == Https {
 		scId := d.Get("server_certificate_id").(string)
 		if scId == "" {
-			return WrapError(Error("'server_certificate_id': required field is not set when the protocol is 'https'."))
+			return WrapAttributeError("server_certificate_id", WrapError(Error("'server_certificate_id': required field is not set when the protocol is 'https'.")))
 		}
 
 		httpsArgs.QueryParams["ServerCertificateId"] = scId
@@ -656,10 +657,10 @@ func resourceAlibabacloudStackSlbListenerUpdate(d *schema.ResourceData, meta int
 
 	d.Partial(false)
 
-	return resourceAlibabacloudStackSlbListenerRead(d, meta)
+	return resourceAlibabacloudStackSlbListenerRead(ctx, d, meta)
 }
 
-func resourceAlibabacloudStackSlbListenerDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceAlibabacloudStackSlbListenerDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*connectivity.AlibabacloudStackClient)
 	slbService := SlbService{client}
 
@@ -695,7 +696,7 @@ func resourceAlibabacloudStackSlbListenerDelete(d *schema.ResourceData, meta int
 	request.LoadBalancerId = lbId
 	request.ListenerPort = requests.NewInteger(port)
 	request.ListenerProtocol = protocol
-	err = resource.Retry(5*time.Minute, func() *resource.RetryError {
+	err = resource.RetryContext(ctx, 5*time.Minute, func() *resource.RetryError {
 		raw, err := client.WithSlbClient(func(slbClient *slb.Client) (interface{}, error) {
 			return slbClient.DeleteLoadBalancerListener(request)
 		})
@@ -1,6 +1,7 @@
 package alibabacloudstack
 
 import (
+	"context"
 	"fmt"
 	"strings"
 	"time"
@@ -15,12 +16,12 @@ import (
 
 func resourceAlibabacloudStackSlbMasterSlaveServerGroup() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceAlibabacloudStackSlbMasterSlaveServerGroupCreate,
-		Read:   resourceAlibabacloudStackSlbMasterSlaveServerGroupRead,
-		Update: resourceAlibabacloudStackSlbMasterSlaveServerGroupUpdate,
-		Delete: resourceAlibabacloudStackSlbMasterSlaveServerGroupDelete,
+		CreateContext: withDiagnostics(resourceAlibabacloudStackSlbMasterSlaveServerGroupCreate),
+		ReadContext:   withDiagnostics(resourceAlibabacloudStackSlbMasterSlaveServerGroupRead),
+		UpdateContext: withDiagnostics(resourceAlibabacloudStackSlbMasterSlaveServerGroupUpdate),
+		DeleteContext: withDiagnostics(resourceAlibabacloudStackSlbMasterSlaveServerGroupDelete),
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			StateContext: schema.ImportStatePassthroughContext,
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -75,7 +76,7 @@ func resourceAlibabacloudStackSlbMasterSlaveServerGroup() *schema.Resource {
 	}
 }
 
-func resourceAlibabacloudStackSlbMasterSlaveServerGroupCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceAlibabacloudStackSlbMasterSlaveServerGroupCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*connectivity.AlibabacloudStackClient)
 	request := slb.CreateCreateMasterSlaveServerGroupRequest()
 	request.RegionId = client.RegionId
@@ -103,10 +104,10 @@ func resourceAlibabacloudStackSlbMasterSlaveServerGroupCreate(d *schema.Resource
 	response, _ := raw.(*slb.CreateMasterSlaveServerGroupResponse)
 	d.SetId(response.MasterSlaveServerGroupId)
 
-	return resourceAlibabacloudStackSlbMasterSlaveServerGroupRead(d, meta)
+	return resourceAlibabacloudStackSlbMasterSlaveServerGroupRead(ctx, d, meta)
 }
 
-func resourceAlibabacloudStackSlbMasterSlaveServerGroupRead(d *schema.ResourceData, meta interface{}) error {
+func resourceAlibabacloudStackSlbMasterSlaveServerGroupRead(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 	wiatSecondsIfWithTest(1)
 	client := meta.(*connectivity.AlibabacloudStackClient)
 	slbService := SlbService{client}
@@ -142,11 +143,11 @@ func resourceAlibabacloudStackSlbMasterSlaveServerGroupRead(d *schema.ResourceDa
 	return nil
 }
 
-func resourceAlibabacloudStackSlbMasterSlaveServerGroupUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceAlibabacloudStackSlbMasterSlaveServerGroupUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 	return nil
 }
 
-func resourceAlibabacloudStackSlbMasterSlaveServerGroupDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceAlibabacloudStackSlbMasterSlaveServerGroupDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*connectivity.AlibabacloudStackClient)
 	slbService := SlbService{client}
 
@@ -175,7 +176,7 @@ func resourceAlibabacloudStackSlbMasterSlaveServerGroupDelete(d *schema.Resource
 	request.QueryParams = map[string]string{"AccessKeySecret": client.SecretKey, "Product": "slb", "Department": client.Department, "ResourceGroup": client.ResourceGroup}
 	request.MasterSlaveServerGroupId = d.Id()
 
-	err := resource.Retry(5*time.Minute, func() *resource.RetryError {
+	err := resource.RetryContext(ctx, 5*time.Minute, func() *resource.RetryError {
 		raw, err := client.WithSlbClient(func(slbClient *slb.Client) (interface{}, error) {
 			return slbClient.DeleteMasterSlaveServerGroup(request)
 		})
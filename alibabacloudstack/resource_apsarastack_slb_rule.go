@@ -1,6 +1,7 @@
 package alibabacloudstack
 
 import (
+	"context"
 	"fmt"
 	"strings"
 	"time"
@@ -18,12 +19,12 @@ import (
 
 func resourceAlibabacloudStackSlbRule() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceAlibabacloudStackSlbRuleCreate,
-		Read:   resourceAlibabacloudStackSlbRuleRead,
-		Update: resourceAlibabacloudStackSlbRuleUpdate,
-		Delete: resourceAlibabacloudStackSlbRuleDelete,
+		CreateContext: withDiagnostics(resourceAlibabacloudStackSlbRuleCreate),
+		ReadContext:   withDiagnostics(resourceAlibabacloudStackSlbRuleRead),
+		UpdateContext: withDiagnostics(resourceAlibabacloudStackSlbRuleUpdate),
+		DeleteContext: withDiagnostics(resourceAlibabacloudStackSlbRuleDelete),
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			StateContext: schema.ImportStatePassthroughContext,
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -177,7 +178,7 @@ func resourceAlibabacloudStackSlbRule() *schema.Resource {
 	}
 }
 
-func resourceAlibabacloudStackSlbRuleCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceAlibabacloudStackSlbRuleCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 
 	client := meta.(*connectivity.AlibabacloudStackClient)
 	slb_id := d.Get("load_balancer_id").(string)
@@ -217,7 +218,7 @@ func resourceAlibabacloudStackSlbRuleCreate(d *schema.ResourceData, meta interfa
 	request.RuleList = rule
 	var raw interface{}
 	var err error
-	if err = resource.Retry(3*time.Minute, func() *resource.RetryError {
+	if err = resource.RetryContext(ctx, 3*time.Minute, func() *resource.RetryError {
 		raw, err = client.WithSlbClient(func(slbClient *slb.Client) (interface{}, error) {
 			return slbClient.CreateRules(request)
 		})
@@ -236,10 +237,10 @@ func resourceAlibabacloudStackSlbRuleCreate(d *schema.ResourceData, meta interfa
 	response, _ := raw.(*slb.CreateRulesResponse)
 	d.SetId(response.Rules.Rule[0].RuleId)
 
-	return resourceAlibabacloudStackSlbRuleUpdate(d, meta)
+	return resourceAlibabacloudStackSlbRuleUpdate(ctx, d, meta)
 }
 
-func resourceAlibabacloudStackSlbRuleRead(d *schema.ResourceData, meta interface{}) error {
+func resourceAlibabacloudStackSlbRuleRead(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 	wiatSecondsIfWithTest(1)
 	client := meta.(*connectivity.AlibabacloudStackClient)
 	slbService := SlbService{client}
@@ -281,7 +282,7 @@ func resourceAlibabacloudStackSlbRuleRead(d *schema.ResourceData, meta interface
 	return nil
 }
 
-func resourceAlibabacloudStackSlbRuleUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceAlibabacloudStackSlbRuleUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*connectivity.AlibabacloudStackClient)
 	update := false
 	fullUpdate := false
@@ -369,10 +370,10 @@ func resourceAlibabacloudStackSlbRuleUpdate(d *schema.ResourceData, meta interfa
 		addDebug(request.GetActionName(), raw, request.RpcRequest, request)
 	}
 
-	return resourceAlibabacloudStackSlbRuleRead(d, meta)
+	return resourceAlibabacloudStackSlbRuleRead(ctx, d, meta)
 }
 
-func resourceAlibabacloudStackSlbRuleDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceAlibabacloudStackSlbRuleDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*connectivity.AlibabacloudStackClient)
 	slbService := SlbService{client}
 
@@ -401,7 +402,7 @@ func resourceAlibabacloudStackSlbRuleDelete(d *schema.ResourceData, meta interfa
 	request.QueryParams = map[string]string{"AccessKeySecret": client.SecretKey, "Product": "slb", "Department": client.Department, "ResourceGroup": client.ResourceGroup}
 	request.RuleIds = fmt.Sprintf("['%s']", d.Id())
 
-	err := resource.Retry(5*time.Minute, func() *resource.RetryError {
+	err := resource.RetryContext(ctx, 5*time.Minute, func() *resource.RetryError {
 		raw, err := client.WithSlbClient(func(slbClient *slb.Client) (interface{}, error) {
 			return slbClient.DeleteRules(request)
 		})
@@ -1,6 +1,7 @@
 package alibabacloudstack
 
 import (
+	"context"
 	"strings"
 	"time"
 
@@ -12,12 +13,12 @@ import (
 
 func resourceAlibabacloudStackSlbServerCertificate() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceAlibabacloudStackSlbServerCertificateCreate,
-		Read:   resourceAlibabacloudStackSlbServerCertificateRead,
-		Update: resourceAlibabacloudStackSlbServerCertificateUpdate,
-		Delete: resourceAlibabacloudStackSlbServerCertificateDelete,
+		CreateContext: withDiagnostics(resourceAlibabacloudStackSlbServerCertificateCreate),
+		ReadContext:   withDiagnostics(resourceAlibabacloudStackSlbServerCertificateRead),
+		UpdateContext: withDiagnostics(resourceAlibabacloudStackSlbServerCertificateUpdate),
+		DeleteContext: withDiagnostics(resourceAlibabacloudStackSlbServerCertificateDelete),
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			StateContext: schema.ImportStatePassthroughContext,
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -39,7 +40,7 @@ func resourceAlibabacloudStackSlbServerCertificate() *schema.Resource {
 	}
 }
 
-func resourceAlibabacloudStackSlbServerCertificateCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceAlibabacloudStackSlbServerCertificateCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*connectivity.AlibabacloudStackClient)
 
 	request := slb.CreateUploadServerCertificateRequest()
@@ -86,10 +87,10 @@ func resourceAlibabacloudStackSlbServerCertificateCreate(d *schema.ResourceData,
 	response, _ := raw.(*slb.UploadServerCertificateResponse)
 	d.SetId(response.ServerCertificateId)
 
-	return resourceAlibabacloudStackSlbServerCertificateUpdate(d, meta)
+	return resourceAlibabacloudStackSlbServerCertificateUpdate(ctx, d, meta)
 }
 
-func resourceAlibabacloudStackSlbServerCertificateRead(d *schema.ResourceData, meta interface{}) error {
+func resourceAlibabacloudStackSlbServerCertificateRead(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 	wiatSecondsIfWithTest(1)
 	client := meta.(*connectivity.AlibabacloudStackClient)
 	slbService := SlbService{client}
@@ -108,11 +109,11 @@ func resourceAlibabacloudStackSlbServerCertificateRead(d *schema.ResourceData, m
 	return nil
 }
 
-func resourceAlibabacloudStackSlbServerCertificateUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceAlibabacloudStackSlbServerCertificateUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*connectivity.AlibabacloudStackClient)
 	if d.IsNewResource() {
 		d.Partial(false)
-		return resourceAlibabacloudStackSlbServerCertificateRead(d, meta)
+		return resourceAlibabacloudStackSlbServerCertificateRead(ctx, d, meta)
 	}
 	if !d.IsNewResource() && d.HasChange("name") {
 		request := slb.CreateSetServerCertificateNameRequest()
@@ -134,10 +135,10 @@ func resourceAlibabacloudStackSlbServerCertificateUpdate(d *schema.ResourceData,
 		}
 		addDebug(request.GetActionName(), raw, request.RpcRequest, request)
 	}
-	return resourceAlibabacloudStackSlbServerCertificateRead(d, meta)
+	return resourceAlibabacloudStackSlbServerCertificateRead(ctx, d, meta)
 }
 
-func resourceAlibabacloudStackSlbServerCertificateDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceAlibabacloudStackSlbServerCertificateDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*connectivity.AlibabacloudStackClient)
 	slbService := SlbService{client}
 
@@ -151,7 +152,7 @@ func resourceAlibabacloudStackSlbServerCertificateDelete(d *schema.ResourceData,
 	request.Headers = map[string]string{"RegionId": client.RegionId}
 	request.QueryParams = map[string]string{"AccessKeySecret": client.SecretKey, "Product": "slb", "Department": client.Department, "ResourceGroup": client.ResourceGroup}
 	request.ServerCertificateId = d.Id()
-	err := resource.Retry(3*time.Minute, func() *resource.RetryError {
+	err := resource.RetryContext(ctx, 3*time.Minute, func() *resource.RetryError {
 		raw, err := client.WithSlbClient(func(slbClient *slb.Client) (interface{}, error) {
 			return slbClient.DeleteServerCertificate(request)
 		})
@@ -1,6 +1,7 @@
 package alibabacloudstack
 
 import (
+	"context"
 	"fmt"
 	"strconv"
 	"strings"
@@ -16,12 +17,12 @@ import (
 
 func resourceAlibabacloudStackSlbServerGroup() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceAlibabacloudStackSlbServerGroupCreate,
-		Read:   resourceAlibabacloudStackSlbServerGroupRead,
-		Update: resourceAlibabacloudStackSlbServerGroupUpdate,
-		Delete: resourceAlibabacloudStackSlbServerGroupDelete,
+		CreateContext: withDiagnostics(resourceAlibabacloudStackSlbServerGroupCreate),
+		ReadContext:   withDiagnostics(resourceAlibabacloudStackSlbServerGroupRead),
+		UpdateContext: withDiagnostics(resourceAlibabacloudStackSlbServerGroupUpdate),
+		DeleteContext: withDiagnostics(resourceAlibabacloudStackSlbServerGroupDelete),
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			StateContext: schema.ImportStatePassthroughContext,
 		},
 		Schema: map[string]*schema.Schema{
 			"load_balancer_id": {
@@ -76,7 +77,7 @@ func resourceAlibabacloudStackSlbServerGroup() *schema.Resource {
 	}
 }
 
-func resourceAlibabacloudStackSlbServerGroupCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceAlibabacloudStackSlbServerGroupCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*connectivity.AlibabacloudStackClient)
 	request := slb.CreateCreateVServerGroupRequest()
 	if strings.ToLower(client.Config.Protocol) == "https" {
@@ -101,10 +102,10 @@ func resourceAlibabacloudStackSlbServerGroupCreate(d *schema.ResourceData, meta
 	response, _ := raw.(*slb.CreateVServerGroupResponse)
 	d.SetId(response.VServerGroupId)
 	d.Set("load_balancer_id", d.Get("load_balancer_id").(string))
-	return resourceAlibabacloudStackSlbServerGroupUpdate(d, meta)
+	return resourceAlibabacloudStackSlbServerGroupUpdate(ctx, d, meta)
 }
 
-func resourceAlibabacloudStackSlbServerGroupRead(d *schema.ResourceData, meta interface{}) error {
+func resourceAlibabacloudStackSlbServerGroupRead(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 	wiatSecondsIfWithTest(1)
 	client := meta.(*connectivity.AlibabacloudStackClient)
 	slbService := SlbService{client}
@@ -159,7 +160,7 @@ func resourceAlibabacloudStackSlbServerGroupRead(d *schema.ResourceData, meta in
 	return nil
 }
 
-func resourceAlibabacloudStackSlbServerGroupUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceAlibabacloudStackSlbServerGroupUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*connectivity.AlibabacloudStackClient)
 
 	d.Partial(true)
@@ -346,10 +347,10 @@ func resourceAlibabacloudStackSlbServerGroupUpdate(d *schema.ResourceData, meta
 	}
 	d.Partial(false)
 
-	return resourceAlibabacloudStackSlbServerGroupRead(d, meta)
+	return resourceAlibabacloudStackSlbServerGroupRead(ctx, d, meta)
 }
 
-func resourceAlibabacloudStackSlbServerGroupDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceAlibabacloudStackSlbServerGroupDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*connectivity.AlibabacloudStackClient)
 	slbService := SlbService{client}
 
@@ -377,7 +378,7 @@ func resourceAlibabacloudStackSlbServerGroupDelete(d *schema.ResourceData, meta
 	request.Headers = map[string]string{"RegionId": client.RegionId}
 	request.QueryParams = map[string]string{"AccessKeySecret": client.SecretKey, "Product": "slb", "Department": client.Department, "ResourceGroup": client.ResourceGroup}
 	request.VServerGroupId = d.Id()
-	err := resource.Retry(5*time.Minute, func() *resource.RetryError {
+	err := resource.RetryContext(ctx, 5*time.Minute, func() *resource.RetryError {
 		raw, err := client.WithSlbClient(func(slbClient *slb.Client) (interface{}, error) {
 			return slbClient.DeleteVServerGroup(request)
 		})
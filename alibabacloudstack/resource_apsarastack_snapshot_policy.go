@@ -1,6 +1,7 @@
 package alibabacloudstack
 
 import (
+	"context"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"strings"
 	"time"
@@ -14,13 +15,13 @@ import (
 
 func resourceAlibabacloudStackSnapshotPolicy() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceAlibabacloudStackSnapshotPolicyCreate,
-		Read:   resourceAlibabacloudStackSnapshotPolicyRead,
-		Update: resourceAlibabacloudStackSnapshotPolicyUpdate,
-		Delete: resourceAlibabacloudStackSnapshotPolicyDelete,
+		CreateContext: withDiagnostics(resourceAlibabacloudStackSnapshotPolicyCreate),
+		ReadContext:   withDiagnostics(resourceAlibabacloudStackSnapshotPolicyRead),
+		UpdateContext: withDiagnostics(resourceAlibabacloudStackSnapshotPolicyUpdate),
+		DeleteContext: withDiagnostics(resourceAlibabacloudStackSnapshotPolicyDelete),
 
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			StateContext: schema.ImportStatePassthroughContext,
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -47,7 +48,7 @@ func resourceAlibabacloudStackSnapshotPolicy() *schema.Resource {
 	}
 }
 
-func resourceAlibabacloudStackSnapshotPolicyCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceAlibabacloudStackSnapshotPolicyCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*connectivity.AlibabacloudStackClient)
 
 	request := ecs.CreateCreateAutoSnapshotPolicyRequest()
@@ -79,10 +80,10 @@ func resourceAlibabacloudStackSnapshotPolicyCreate(d *schema.ResourceData, meta
 		return WrapError(err)
 	}
 
-	return resourceAlibabacloudStackSnapshotPolicyRead(d, meta)
+	return resourceAlibabacloudStackSnapshotPolicyRead(ctx, d, meta)
 }
 
-func resourceAlibabacloudStackSnapshotPolicyRead(d *schema.ResourceData, meta interface{}) error {
+func resourceAlibabacloudStackSnapshotPolicyRead(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 	wiatSecondsIfWithTest(1)
 	client := meta.(*connectivity.AlibabacloudStackClient)
 	ecsService := EcsService{client}
@@ -111,7 +112,7 @@ func resourceAlibabacloudStackSnapshotPolicyRead(d *schema.ResourceData, meta in
 	return nil
 }
 
-func resourceAlibabacloudStackSnapshotPolicyUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceAlibabacloudStackSnapshotPolicyUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*connectivity.AlibabacloudStackClient)
 
 	request := ecs.CreateModifyAutoSnapshotPolicyExRequest()
@@ -143,10 +144,10 @@ func resourceAlibabacloudStackSnapshotPolicyUpdate(d *schema.ResourceData, meta
 		return WrapErrorf(err, DefaultErrorMsg, d.Id(), request.GetActionName(), AlibabacloudStackSdkGoERROR)
 	}
 	addDebug(request.GetActionName(), raw, request.RpcRequest, request)
-	return resourceAlibabacloudStackSnapshotPolicyRead(d, meta)
+	return resourceAlibabacloudStackSnapshotPolicyRead(ctx, d, meta)
 }
 
-func resourceAlibabacloudStackSnapshotPolicyDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceAlibabacloudStackSnapshotPolicyDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*connectivity.AlibabacloudStackClient)
 	ecsService := EcsService{client}
 
@@ -160,7 +161,7 @@ func resourceAlibabacloudStackSnapshotPolicyDelete(d *schema.ResourceData, meta
 	request.Headers = map[string]string{"RegionId": client.RegionId}
 	request.QueryParams = map[string]string{"AccessKeySecret": client.SecretKey, "Product": "ecs", "Department": client.Department, "ResourceGroup": client.ResourceGroup}
 	request.AutoSnapshotPolicyId = d.Id()
-	err := resource.Retry(DefaultTimeout*time.Second, func() *resource.RetryError {
+	err := resource.RetryContext(ctx, DefaultTimeout*time.Second, func() *resource.RetryError {
 		raw, err := client.WithEcsClient(func(ecsClient *ecs.Client) (interface{}, error) {
 			return ecsClient.DeleteAutoSnapshotPolicy(request)
 		})
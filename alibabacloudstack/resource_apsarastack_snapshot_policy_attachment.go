@@ -0,0 +1,80 @@
+package alibabacloudstack
+
+import (
+	"context"
+	"fmt"
+	"log"
+
+	"github.com/aliyun/terraform-provider-alibabacloudstack/alibabacloudstack/connectivity"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func resourceAlibabacloudStackSnapshotPolicyAttachment() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: withDiagnostics(resourceAlibabacloudStackSnapshotPolicyAttachmentCreate),
+		ReadContext:   withDiagnostics(resourceAlibabacloudStackSnapshotPolicyAttachmentRead),
+		DeleteContext: withDiagnostics(resourceAlibabacloudStackSnapshotPolicyAttachmentDelete),
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"snapshot_policy_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"disk_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+		},
+	}
+}
+
+func resourceAlibabacloudStackSnapshotPolicyAttachmentCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*connectivity.AlibabacloudStackClient)
+	ecsService := EcsService{client}
+	policyId := d.Get("snapshot_policy_id").(string)
+	diskId := d.Get("disk_id").(string)
+	if err := ecsService.ApplySnapshotPolicy(ctx, policyId, []string{diskId}); err != nil {
+		return WrapError(err)
+	}
+	d.SetId(fmt.Sprintf("%s%s%s", policyId, COLON_SEPARATED, diskId))
+
+	return resourceAlibabacloudStackSnapshotPolicyAttachmentRead(ctx, d, meta)
+}
+
+func resourceAlibabacloudStackSnapshotPolicyAttachmentRead(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*connectivity.AlibabacloudStackClient)
+	ecsService := EcsService{client}
+	object, err := ecsService.DescribeSnapshotPolicyAttachment(d.Id())
+	if err != nil {
+		if NotFoundError(err) {
+			log.Printf("[DEBUG] Resource alibabacloudstack_snapshot_policy_attachment ecsService.DescribeSnapshotPolicyAttachment Failed!!! %s", err)
+			d.SetId("")
+			return nil
+		}
+		return WrapError(err)
+	}
+	d.Set("snapshot_policy_id", object.AutoSnapshotPolicyId)
+	d.Set("disk_id", object.DiskId)
+	return nil
+}
+
+func resourceAlibabacloudStackSnapshotPolicyAttachmentDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*connectivity.AlibabacloudStackClient)
+	ecsService := EcsService{client}
+	parts, err := ParseResourceId(d.Id(), 2)
+	if err != nil {
+		return WrapError(err)
+	}
+	if err := ecsService.CancelSnapshotPolicy(ctx, []string{parts[1]}); err != nil {
+		if IsExpectedErrors(err, []string{"InvalidDiskId.NotFound"}) {
+			return nil
+		}
+		return WrapError(err)
+	}
+	return nil
+}
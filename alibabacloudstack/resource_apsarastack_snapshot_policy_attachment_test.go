@@ -0,0 +1,79 @@
+package alibabacloudstack
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/aliyun/alibaba-cloud-sdk-go/services/ecs"
+	"github.com/aliyun/terraform-provider-alibabacloudstack/alibabacloudstack/connectivity"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccAlibabacloudStackSnapshotPolicyAttachmentBasic(t *testing.T) {
+	var v ecs.Disk
+	resourceId := "alibabacloudstack_snapshot_policy_attachment.default"
+	ra := resourceAttrInit(resourceId, map[string]string{
+		"snapshot_policy_id": CHECKSET,
+		"disk_id":            CHECKSET,
+	})
+	serviceFunc := func() interface{} {
+		return &EcsService{testAccProvider.Meta().(*connectivity.AlibabacloudStackClient)}
+	}
+	rc := resourceCheckInit(resourceId, &v, serviceFunc)
+	rac := resourceAttrCheckInit(rc, ra)
+	testAccCheck := rac.resourceAttrMapUpdateSet()
+	rand := acctest.RandIntRange(10000, 99999)
+	name := fmt.Sprintf("tf-testAccSnapshotPolicyAttachment%d", rand)
+	testAccConfig := resourceTestAccConfigFunc(resourceId, name, resourceSnapshotPolicyAttachmentConfigDependence)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+		},
+		IDRefreshName: resourceId,
+		Providers:     testAccProviders,
+		CheckDestroy:  rac.checkResourceDestroy(),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccConfig(map[string]interface{}{
+					"snapshot_policy_id": "${alibabacloudstack_snapshot_policy.default.id}",
+					"disk_id":            "${alibabacloudstack_disk.default.id}",
+				}),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheck(nil),
+				),
+			},
+			{
+				ResourceName:      resourceId,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func resourceSnapshotPolicyAttachmentConfigDependence(name string) string {
+	return fmt.Sprintf(`
+variable "name" {
+  default = "%s"
+}
+
+data "alibabacloudstack_zones" "default" {
+  available_resource_creation = "VSwitch"
+}
+
+resource "alibabacloudstack_disk" "default" {
+  availability_zone = "${data.alibabacloudstack_zones.default.zones.0.id}"
+  size              = "20"
+  name              = "${var.name}"
+}
+
+resource "alibabacloudstack_snapshot_policy" "default" {
+  name            = "${var.name}"
+  repeat_weekdays = ["1"]
+  retention_days  = -1
+  time_points     = ["1"]
+}
+`, name)
+}
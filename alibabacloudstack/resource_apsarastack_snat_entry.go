@@ -1,6 +1,7 @@
 package alibabacloudstack
 
 import (
+	"context"
 	"fmt"
 
 	"time"
@@ -15,12 +16,12 @@ import (
 
 func resourceAlibabacloudStackSnatEntry() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceAlibabacloudStackSnatEntryCreate,
-		Read:   resourceAlibabacloudStackSnatEntryRead,
-		Update: resourceAlibabacloudStackSnatEntryUpdate,
-		Delete: resourceAlibabacloudStackSnatEntryDelete,
+		CreateContext: withDiagnostics(resourceAlibabacloudStackSnatEntryCreate),
+		ReadContext:   withDiagnostics(resourceAlibabacloudStackSnatEntryRead),
+		UpdateContext: withDiagnostics(resourceAlibabacloudStackSnatEntryUpdate),
+		DeleteContext: withDiagnostics(resourceAlibabacloudStackSnatEntryDelete),
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			StateContext: schema.ImportStatePassthroughContext,
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -53,7 +54,7 @@ func resourceAlibabacloudStackSnatEntry() *schema.Resource {
 	}
 }
 
-func resourceAlibabacloudStackSnatEntryCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceAlibabacloudStackSnatEntryCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*connectivity.AlibabacloudStackClient)
 	vpcService := VpcService{client}
 
@@ -73,7 +74,7 @@ func resourceAlibabacloudStackSnatEntryCreate(d *schema.ResourceData, meta inter
 		request.SourceCIDR = v.(string)
 	}
 
-	if err := resource.Retry(3*time.Minute, func() *resource.RetryError {
+	if err := resource.RetryContext(ctx, 3*time.Minute, func() *resource.RetryError {
 		ar := request
 		raw, err := client.WithVpcClient(func(vpcClient *vpc.Client) (interface{}, error) {
 			return vpcClient.CreateSnatEntry(ar)
@@ -96,10 +97,10 @@ func resourceAlibabacloudStackSnatEntryCreate(d *schema.ResourceData, meta inter
 		return WrapError(err)
 	}
 
-	return resourceAlibabacloudStackSnatEntryRead(d, meta)
+	return resourceAlibabacloudStackSnatEntryRead(ctx, d, meta)
 }
 
-func resourceAlibabacloudStackSnatEntryRead(d *schema.ResourceData, meta interface{}) error {
+func resourceAlibabacloudStackSnatEntryRead(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 	wiatSecondsIfWithTest(1)
 	client := meta.(*connectivity.AlibabacloudStackClient)
 	vpcService := VpcService{client}
@@ -129,7 +130,7 @@ func resourceAlibabacloudStackSnatEntryRead(d *schema.ResourceData, meta interfa
 	return nil
 }
 
-func resourceAlibabacloudStackSnatEntryUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceAlibabacloudStackSnatEntryUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 
 	if strings.HasPrefix(d.Id(), "snat-") {
 		d.SetId(fmt.Sprintf("%s%s%s", d.Get("snat_table_id").(string), COLON_SEPARATED, d.Id()))
@@ -171,10 +172,10 @@ func resourceAlibabacloudStackSnatEntryUpdate(d *schema.ResourceData, meta inter
 			return WrapError(err)
 		}
 	}
-	return resourceAlibabacloudStackSnatEntryRead(d, meta)
+	return resourceAlibabacloudStackSnatEntryRead(ctx, d, meta)
 }
 
-func resourceAlibabacloudStackSnatEntryDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceAlibabacloudStackSnatEntryDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*connectivity.AlibabacloudStackClient)
 	vpcService := VpcService{client}
 
@@ -196,7 +197,7 @@ func resourceAlibabacloudStackSnatEntryDelete(d *schema.ResourceData, meta inter
 	request.QueryParams = map[string]string{"AccessKeySecret": client.SecretKey, "Product": "vpc", "Department": client.Department, "ResourceGroup": client.ResourceGroup}
 	request.SnatTableId = parts[0]
 	request.SnatEntryId = parts[1]
-	err = resource.Retry(5*time.Minute, func() *resource.RetryError {
+	err = resource.RetryContext(ctx, 5*time.Minute, func() *resource.RetryError {
 		raw, err := client.WithVpcClient(func(vpcClient *vpc.Client) (interface{}, error) {
 			return vpcClient.DeleteSnatEntry(request)
 		})
@@ -0,0 +1,234 @@
+package alibabacloudstack
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"strings"
+	"time"
+
+	"github.com/aliyun/terraform-provider-alibabacloudstack/alibabacloudstack/connectivity"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+func resourceAlibabacloudStackTsdbInstance() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: withDiagnostics(resourceAlibabacloudStackTsdbInstanceCreate),
+		ReadContext:   withDiagnostics(resourceAlibabacloudStackTsdbInstanceRead),
+		UpdateContext: withDiagnostics(resourceAlibabacloudStackTsdbInstanceUpdate),
+		DeleteContext: withDiagnostics(resourceAlibabacloudStackTsdbInstanceDelete),
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(30 * time.Minute),
+			Update: schema.DefaultTimeout(30 * time.Minute),
+			Delete: schema.DefaultTimeout(10 * time.Minute),
+		},
+		Schema: map[string]*schema.Schema{
+			"instance_class": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"instance_storage": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"disk_category": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				Computed:     true,
+				ValidateFunc: validation.StringInSlice([]string{"cloud_efficiency", "cloud_ssd", "cloud_essd"}, false),
+			},
+			"engine_type": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				Default:      "tsdb_tsdb",
+				ValidateFunc: validation.StringInSlice([]string{"tsdb_tsdb", "tsdb_influxdb"}, false),
+			},
+			"instance_alias": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+			"vswitch_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"zone_id": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+				Computed: true,
+			},
+			"vpc_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"security_ip_list": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"connection_string": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"status": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceAlibabacloudStackTsdbInstanceCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*connectivity.AlibabacloudStackClient)
+	tsdbService := TsdbService{client}
+	vpcService := VpcService{client}
+	action := "CreateHiTSDBInstance"
+	request := make(map[string]interface{})
+	request["InstanceClass"] = d.Get("instance_class")
+	request["InstanceStorage"] = d.Get("instance_storage")
+	request["EngineType"] = d.Get("engine_type")
+	request["PayType"] = "POSTPAY"
+	if v, ok := d.GetOk("disk_category"); ok {
+		request["DiskCategory"] = v
+	}
+	if v, ok := d.GetOk("instance_alias"); ok {
+		request["InstanceAlias"] = v
+	}
+	vswitchId := d.Get("vswitch_id").(string)
+	vsw, err := vpcService.DescribeVSwitch(vswitchId)
+	if err != nil {
+		return WrapError(err)
+	}
+	request["VSwitchId"] = vswitchId
+	request["VPCId"] = vsw.VpcId
+	request["ZoneId"] = vsw.ZoneId
+	if v, ok := d.GetOk("zone_id"); ok && v.(string) != "" {
+		request["ZoneId"] = v
+	}
+	request["ClientToken"] = buildClientToken(action)
+	response, err := tsdbService.doTsdbRequest(action, request)
+	if err != nil {
+		return WrapErrorf(err, DefaultErrorMsg, "alibabacloudstack_tsdb_instance", action, AlibabacloudStackSdkGoERROR)
+	}
+	d.SetId(fmt.Sprint(response["InstanceId"]))
+
+	stateConf := BuildStateConf([]string{"CREATING"}, []string{"ACTIVATION"}, d.Timeout(schema.TimeoutCreate), 60*time.Second, tsdbService.TsdbInstanceStateRefreshFunc(d.Id(), []string{"CREATE_FAILED"}))
+	if _, err := stateConf.WaitForStateContext(ctx); err != nil {
+		return WrapErrorf(err, IdMsg, d.Id())
+	}
+
+	return resourceAlibabacloudStackTsdbInstanceUpdate(ctx, d, meta)
+}
+
+func resourceAlibabacloudStackTsdbInstanceRead(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*connectivity.AlibabacloudStackClient)
+	tsdbService := TsdbService{client}
+	object, err := tsdbService.DescribeTsdbInstance(d.Id())
+	if err != nil {
+		if NotFoundError(err) {
+			log.Printf("[DEBUG] Resource alibabacloudstack_tsdb_instance tsdbService.DescribeTsdbInstance Failed!!! %s", err)
+			d.SetId("")
+			return nil
+		}
+		return WrapError(err)
+	}
+	d.Set("instance_class", object["InstanceClass"])
+	d.Set("instance_storage", fmt.Sprint(object["InstanceStorage"]))
+	if v, ok := object["DiskCategory"]; ok && fmt.Sprint(v) != "" {
+		d.Set("disk_category", v)
+	}
+	if v, ok := object["EngineType"]; ok && fmt.Sprint(v) != "" {
+		d.Set("engine_type", v)
+	}
+	d.Set("instance_alias", object["InstanceAlias"])
+	d.Set("vswitch_id", object["VswitchId"])
+	d.Set("vpc_id", object["VpcId"])
+	d.Set("zone_id", object["ZoneId"])
+	d.Set("connection_string", object["ConnectionString"])
+	d.Set("status", object["Status"])
+
+	ips, err := tsdbService.DescribeTsdbInstanceSecurityIps(d.Id())
+	if err != nil {
+		return WrapError(err)
+	}
+	d.Set("security_ip_list", ips)
+	return nil
+}
+
+func resourceAlibabacloudStackTsdbInstanceUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*connectivity.AlibabacloudStackClient)
+	tsdbService := TsdbService{client}
+	d.Partial(true)
+
+	if !d.IsNewResource() && d.HasChange("instance_alias") {
+		action := "RenameHiTSDBInstanceAlias"
+		request := map[string]interface{}{
+			"InstanceId":    d.Id(),
+			"InstanceAlias": d.Get("instance_alias"),
+		}
+		if _, err := tsdbService.doTsdbRequest(action, request); err != nil {
+			return WrapErrorf(err, DefaultErrorMsg, d.Id(), action, AlibabacloudStackSdkGoERROR)
+		}
+	}
+
+	if d.HasChange("security_ip_list") {
+		if v, ok := d.GetOk("security_ip_list"); ok {
+			action := "ModifyHiTSDBInstanceSecurityIpList"
+			request := map[string]interface{}{
+				"InstanceId":     d.Id(),
+				"SecurityIpList": strings.Join(expandStringList(v.(*schema.Set).List()), ","),
+			}
+			if _, err := tsdbService.doTsdbRequest(action, request); err != nil {
+				return WrapErrorf(err, DefaultErrorMsg, d.Id(), action, AlibabacloudStackSdkGoERROR)
+			}
+		}
+	}
+
+	if !d.IsNewResource() && d.HasChanges("instance_class", "instance_storage") {
+		action := "ModifyHiTSDBInstanceClass"
+		request := map[string]interface{}{
+			"InstanceId":      d.Id(),
+			"InstanceClass":   d.Get("instance_class"),
+			"InstanceStorage": d.Get("instance_storage"),
+		}
+		if _, err := tsdbService.doTsdbRequest(action, request); err != nil {
+			return WrapErrorf(err, DefaultErrorMsg, d.Id(), action, AlibabacloudStackSdkGoERROR)
+		}
+		stateConf := BuildStateConf([]string{"CLASS_CHANGING"}, []string{"ACTIVATION"}, d.Timeout(schema.TimeoutUpdate), 60*time.Second, tsdbService.TsdbInstanceStateRefreshFunc(d.Id(), []string{"CLASS_CHANGE_FAILED"}))
+		if _, err := stateConf.WaitForStateContext(ctx); err != nil {
+			return WrapErrorf(err, IdMsg, d.Id())
+		}
+	}
+
+	d.Partial(false)
+	return resourceAlibabacloudStackTsdbInstanceRead(ctx, d, meta)
+}
+
+func resourceAlibabacloudStackTsdbInstanceDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*connectivity.AlibabacloudStackClient)
+	tsdbService := TsdbService{client}
+	action := "DeleteHiTSDBInstance"
+	request := map[string]interface{}{
+		"InstanceId": d.Id(),
+	}
+	if _, err := tsdbService.doTsdbRequest(action, request); err != nil {
+		if IsExpectedErrors(err, []string{"Instance.IsDeleted", "Instance.NotFound", "InvalidInstanceId.NotFound"}) {
+			return nil
+		}
+		return WrapErrorf(err, DefaultErrorMsg, d.Id(), action, AlibabacloudStackSdkGoERROR)
+	}
+	stateConf := BuildStateConf([]string{"DELETING", "ACTIVATION"}, []string{}, d.Timeout(schema.TimeoutDelete), 30*time.Second, tsdbService.TsdbInstanceStateRefreshFunc(d.Id(), []string{}))
+	if _, err := stateConf.WaitForStateContext(ctx); err != nil {
+		return WrapErrorf(err, IdMsg, d.Id())
+	}
+	return nil
+}
@@ -0,0 +1,119 @@
+package alibabacloudstack
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/aliyun/terraform-provider-alibabacloudstack/alibabacloudstack/connectivity"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccAlibabacloudStackTsdbInstance_basic(t *testing.T) {
+	var v map[string]interface{}
+	resourceId := "alibabacloudstack_tsdb_instance.default"
+	ra := resourceAttrInit(resourceId, tsdbInstanceBasicMap)
+	serviceFunc := func() interface{} {
+		return &TsdbService{testAccProvider.Meta().(*connectivity.AlibabacloudStackClient)}
+	}
+	rc := resourceCheckInit(resourceId, &v, serviceFunc)
+	rac := resourceAttrCheckInit(rc, ra)
+	testAccCheck := rac.resourceAttrMapUpdateSet()
+	rand := acctest.RandIntRange(10000, 99999)
+	name := fmt.Sprintf("tf-testacc-tsdbinstance%d", rand)
+	testAccConfig := resourceTestAccConfigFunc(resourceId, name, resourceTsdbInstanceConfigDependence)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+		},
+		IDRefreshName: resourceId,
+		Providers:     testAccProviders,
+		CheckDestroy:  rac.checkResourceDestroy(),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccConfig(map[string]interface{}{
+					"instance_class":   "tsdb.1x.basic",
+					"instance_storage": "50",
+					"engine_type":      "tsdb_tsdb",
+					"instance_alias":   "${var.name}",
+					"vswitch_id":       "${alibabacloudstack_vswitch.default.id}",
+				}),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheck(map[string]string{
+						"instance_alias": name,
+					}),
+				),
+			},
+			{
+				ResourceName:      resourceId,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccConfig(map[string]interface{}{
+					"instance_alias": "${var.name}_update",
+				}),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheck(map[string]string{
+						"instance_alias": name + "_update",
+					}),
+				),
+			},
+			{
+				Config: testAccConfig(map[string]interface{}{
+					"security_ip_list": []string{"192.168.0.0/24", "10.0.0.1"},
+				}),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheck(map[string]string{
+						"security_ip_list.#": "2",
+					}),
+				),
+			},
+			{
+				Config: testAccConfig(map[string]interface{}{
+					"instance_class":   "tsdb.3x.basic",
+					"instance_storage": "100",
+				}),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheck(map[string]string{
+						"instance_class":   "tsdb.3x.basic",
+						"instance_storage": "100",
+					}),
+				),
+			},
+		},
+	})
+}
+
+func resourceTsdbInstanceConfigDependence(name string) string {
+	return fmt.Sprintf(`
+variable "name" {
+  default = "%v"
+}
+
+data "alibabacloudstack_tsdb_zones" "default" {}
+
+resource "alibabacloudstack_vpc" "default" {
+  name       = var.name
+  cidr_block = "172.16.0.0/16"
+}
+
+resource "alibabacloudstack_vswitch" "default" {
+  vpc_id            = alibabacloudstack_vpc.default.id
+  cidr_block        = "172.16.0.0/24"
+  availability_zone = data.alibabacloudstack_tsdb_zones.default.ids.0
+  name              = var.name
+}
+`, name)
+}
+
+var tsdbInstanceBasicMap = map[string]string{
+	"instance_class":   "tsdb.1x.basic",
+	"instance_storage": "50",
+	"engine_type":      "tsdb_tsdb",
+	"vswitch_id":       CHECKSET,
+	"vpc_id":           CHECKSET,
+	"zone_id":          CHECKSET,
+	"status":           "ACTIVATION",
+}
@@ -1,6 +1,7 @@
 package alibabacloudstack
 
 import (
+	"context"
 	"log"
 	"strings"
 	"time"
@@ -16,12 +17,12 @@ import (
 
 func resourceAlibabacloudStackVpc() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceAlibabacloudStackVpcCreate,
-		Read:   resourceAlibabacloudStackVpcRead,
-		Update: resourceAlibabacloudStackVpcUpdate,
-		Delete: resourceAlibabacloudStackVpcDelete,
+		CreateContext: withDiagnostics(resourceAlibabacloudStackVpcCreate),
+		ReadContext:   withDiagnostics(resourceAlibabacloudStackVpcRead),
+		UpdateContext: withDiagnostics(resourceAlibabacloudStackVpcUpdate),
+		DeleteContext: withDiagnostics(resourceAlibabacloudStackVpcDelete),
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			StateContext: schema.ImportStatePassthroughContext,
 		},
 		Timeouts: &schema.ResourceTimeout{
 			Create: schema.DefaultTimeout(10 * time.Minute),
@@ -111,7 +112,7 @@ func resourceAlibabacloudStackVpc() *schema.Resource {
 	}
 }
 
-func resourceAlibabacloudStackVpcCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceAlibabacloudStackVpcCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*connectivity.AlibabacloudStackClient)
 	vpcService := VpcService{client}
 
@@ -126,7 +127,7 @@ func resourceAlibabacloudStackVpcCreate(d *schema.ResourceData, meta interface{}
 	//request.Headers = map[string]string{"RegionId": client.RegionId}
 	//request.QueryParams = map[string]string{"AccessKeySecret": client.SecretKey, "Product": "vpc", "Department": client.Department, "ResourceGroup": client.ResourceGroup}
 
-	err := resource.Retry(3*time.Minute, func() *resource.RetryError {
+	err := resource.RetryContext(ctx, 3*time.Minute, func() *resource.RetryError {
 		args := *request
 		raw, err := client.WithVpcClient(func(vpcClient *vpc.Client) (interface{}, error) {
 			return vpcClient.CreateVpc(&args)
@@ -149,14 +150,14 @@ func resourceAlibabacloudStackVpcCreate(d *schema.ResourceData, meta interface{}
 	d.SetId(response.VpcId)
 
 	stateConf := BuildStateConf([]string{"Pending"}, []string{"Available"}, d.Timeout(schema.TimeoutCreate), 3*time.Second, vpcService.VpcStateRefreshFunc(d.Id(), []string{}))
-	if _, err := stateConf.WaitForState(); err != nil {
+	if _, err := stateConf.WaitForStateContext(ctx); err != nil {
 		return WrapErrorf(err, IdMsg, d.Id())
 	}
 
-	return resourceAlibabacloudStackVpcUpdate(d, meta)
+	return resourceAlibabacloudStackVpcUpdate(ctx, d, meta)
 }
 
-func resourceAlibabacloudStackVpcRead(d *schema.ResourceData, meta interface{}) error {
+func resourceAlibabacloudStackVpcRead(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 	wiatSecondsIfWithTest(1)
 	client := meta.(*connectivity.AlibabacloudStackClient)
 	vpcService := VpcService{client}
@@ -201,7 +202,7 @@ func resourceAlibabacloudStackVpcRead(d *schema.ResourceData, meta interface{})
 	var routeTabls []vpc.RouteTable
 	for {
 		total := 0
-		err = resource.Retry(6*time.Minute, func() *resource.RetryError {
+		err = resource.RetryContext(ctx, 6*time.Minute, func() *resource.RetryError {
 			raw, err := client.WithVpcClient(func(vpcClient *vpc.Client) (interface{}, error) {
 				return vpcClient.DescribeRouteTables(request)
 			})
@@ -244,22 +245,22 @@ func resourceAlibabacloudStackVpcRead(d *schema.ResourceData, meta interface{})
 	return nil
 }
 
-func resourceAlibabacloudStackVpcUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceAlibabacloudStackVpcUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*connectivity.AlibabacloudStackClient)
 	vpcService := VpcService{client}
 
 	if err := vpcService.setInstanceSecondaryCidrBlocks(d); err != nil {
 		return WrapError(err)
 	}
-	if d.HasChange("tags") {
-		if err := vpcService.SetResourceTags(d, "vpc"); err != nil {
+	if hasTagsAllChange(d, client) {
+		if err := vpcService.SetResourceTags(ctx, d, "vpc"); err != nil {
 			return WrapError(err)
 		}
 	}
 
 	if d.IsNewResource() {
 		d.Partial(false)
-		return resourceAlibabacloudStackVpcRead(d, meta)
+		return resourceAlibabacloudStackVpcRead(ctx, d, meta)
 	}
 
 	groupRequestUpdate := false
@@ -334,10 +335,10 @@ func resourceAlibabacloudStackVpcUpdate(d *schema.ResourceData, meta interface{}
 		addDebug(request.GetActionName(), raw, request.RpcRequest, request)
 	}
 
-	return resourceAlibabacloudStackVpcRead(d, meta)
+	return resourceAlibabacloudStackVpcRead(ctx, d, meta)
 }
 
-func resourceAlibabacloudStackVpcDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceAlibabacloudStackVpcDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*connectivity.AlibabacloudStackClient)
 	vpcService := VpcService{client}
 	request := vpc.CreateDeleteVpcRequest()
@@ -350,7 +351,7 @@ func resourceAlibabacloudStackVpcDelete(d *schema.ResourceData, meta interface{}
 		request.Scheme = "http"
 	}
 	request.VpcId = d.Id()
-	err := resource.Retry(d.Timeout(schema.TimeoutDelete), func() *resource.RetryError {
+	err := resource.RetryContext(ctx, d.Timeout(schema.TimeoutDelete), func() *resource.RetryError {
 		raw, err := client.WithVpcClient(func(vpcClient *vpc.Client) (interface{}, error) {
 			return vpcClient.DeleteVpc(request)
 		})
@@ -367,7 +368,7 @@ func resourceAlibabacloudStackVpcDelete(d *schema.ResourceData, meta interface{}
 		return WrapErrorf(err, DefaultErrorMsg, d.Id(), request.GetActionName(), AlibabacloudStackSdkGoERROR)
 	}
 	stateConf := BuildStateConf([]string{"Pending"}, []string{}, d.Timeout(schema.TimeoutDelete), 3*time.Second, vpcService.VpcStateRefreshFunc(d.Id(), []string{}))
-	if _, err := stateConf.WaitForState(); err != nil {
+	if _, err := stateConf.WaitForStateContext(ctx); err != nil {
 		return WrapErrorf(err, IdMsg, d.Id())
 	}
 	return nil
@@ -1,6 +1,7 @@
 package alibabacloudstack
 
 import (
+	"context"
 	"fmt"
 	"log"
 	"regexp"
@@ -15,11 +16,11 @@ import (
 
 func resourceAlibabacloudStackVpcIpv6EgressRule() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceAlibabacloudStackVpcIpv6EgressRuleCreate,
-		Read:   resourceAlibabacloudStackVpcIpv6EgressRuleRead,
-		Delete: resourceAlibabacloudStackVpcIpv6EgressRuleDelete,
+		CreateContext: withDiagnostics(resourceAlibabacloudStackVpcIpv6EgressRuleCreate),
+		ReadContext:   withDiagnostics(resourceAlibabacloudStackVpcIpv6EgressRuleRead),
+		DeleteContext: withDiagnostics(resourceAlibabacloudStackVpcIpv6EgressRuleDelete),
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			StateContext: schema.ImportStatePassthroughContext,
 		},
 		Timeouts: &schema.ResourceTimeout{
 			Create: schema.DefaultTimeout(1 * time.Minute),
@@ -63,7 +64,7 @@ func resourceAlibabacloudStackVpcIpv6EgressRule() *schema.Resource {
 	}
 }
 
-func resourceAlibabacloudStackVpcIpv6EgressRuleCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceAlibabacloudStackVpcIpv6EgressRuleCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*connectivity.AlibabacloudStackClient)
 	var response map[string]interface{}
 	action := "CreateIpv6EgressOnlyRule"
@@ -87,7 +88,7 @@ func resourceAlibabacloudStackVpcIpv6EgressRuleCreate(d *schema.ResourceData, me
 	runtime := util.RuntimeOptions{}
 	runtime.SetAutoretry(true)
 	wait := incrementalWait(3*time.Second, 3*time.Second)
-	err = resource.Retry(d.Timeout(schema.TimeoutCreate), func() *resource.RetryError {
+	err = resource.RetryContext(ctx, d.Timeout(schema.TimeoutCreate), func() *resource.RetryError {
 		request["ClientToken"] = buildClientToken("CreateIpv6EgressOnlyRule")
 		request["Product"] = "Vpc"
 		request["OrganizationId"] = client.Department
@@ -109,13 +110,13 @@ func resourceAlibabacloudStackVpcIpv6EgressRuleCreate(d *schema.ResourceData, me
 	d.SetId(fmt.Sprint(request["Ipv6GatewayId"], ":", response["Ipv6EgressRuleId"]))
 	vpcService := VpcService{client}
 	stateConf := BuildStateConf([]string{}, []string{"Available"}, d.Timeout(schema.TimeoutCreate), 5*time.Second, vpcService.VpcIpv6EgressRuleStateRefreshFunc(d.Id(), []string{}))
-	if _, err := stateConf.WaitForState(); err != nil {
+	if _, err := stateConf.WaitForStateContext(ctx); err != nil {
 		return WrapErrorf(err, IdMsg, d.Id())
 	}
 
-	return resourceAlibabacloudStackVpcIpv6EgressRuleRead(d, meta)
+	return resourceAlibabacloudStackVpcIpv6EgressRuleRead(ctx, d, meta)
 }
-func resourceAlibabacloudStackVpcIpv6EgressRuleRead(d *schema.ResourceData, meta interface{}) error {
+func resourceAlibabacloudStackVpcIpv6EgressRuleRead(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*connectivity.AlibabacloudStackClient)
 	vpcService := VpcService{client}
 	object, err := vpcService.DescribeVpcIpv6EgressRule(d.Id())
@@ -139,7 +140,7 @@ func resourceAlibabacloudStackVpcIpv6EgressRuleRead(d *schema.ResourceData, meta
 	d.Set("status", object["Status"])
 	return nil
 }
-func resourceAlibabacloudStackVpcIpv6EgressRuleDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceAlibabacloudStackVpcIpv6EgressRuleDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*connectivity.AlibabacloudStackClient)
 	parts, err := ParseResourceId(d.Id(), 2)
 	if err != nil {
@@ -160,7 +161,7 @@ func resourceAlibabacloudStackVpcIpv6EgressRuleDelete(d *schema.ResourceData, me
 	runtime := util.RuntimeOptions{}
 	runtime.SetAutoretry(true)
 	wait := incrementalWait(3*time.Second, 3*time.Second)
-	err = resource.Retry(d.Timeout(schema.TimeoutDelete), func() *resource.RetryError {
+	err = resource.RetryContext(ctx, d.Timeout(schema.TimeoutDelete), func() *resource.RetryError {
 		request["ClientToken"] = buildClientToken("DeleteIpv6EgressOnlyRule")
 		request["Product"] = "Vpc"
 		request["OrganizationId"] = client.Department
@@ -179,7 +180,7 @@ func resourceAlibabacloudStackVpcIpv6EgressRuleDelete(d *schema.ResourceData, me
 		return WrapErrorf(err, DefaultErrorMsg, d.Id(), action, AlibabacloudStackSdkGoERROR)
 	}
 	stateConf := BuildStateConf([]string{}, []string{}, d.Timeout(schema.TimeoutDelete), 5*time.Second, vpcService.VpcIpv6EgressRuleStateRefreshFunc(d.Id(), []string{}))
-	if _, err := stateConf.WaitForState(); err != nil {
+	if _, err := stateConf.WaitForStateContext(ctx); err != nil {
 		return WrapErrorf(err, IdMsg, d.Id())
 	}
 	return nil
@@ -1,6 +1,7 @@
 package alibabacloudstack
 
 import (
+	"context"
 	"fmt"
 	"log"
 	"time"
@@ -14,12 +15,12 @@ import (
 
 func resourceAlibabacloudStackVpcIpv6InternetBandwidth() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceAlibabacloudStackVpcIpv6InternetBandwidthCreate,
-		Read:   resourceAlibabacloudStackVpcIpv6InternetBandwidthRead,
-		Update: resourceAlibabacloudStackVpcIpv6InternetBandwidthUpdate,
-		Delete: resourceAlibabacloudStackVpcIpv6InternetBandwidthDelete,
+		CreateContext: withDiagnostics(resourceAlibabacloudStackVpcIpv6InternetBandwidthCreate),
+		ReadContext:   withDiagnostics(resourceAlibabacloudStackVpcIpv6InternetBandwidthRead),
+		UpdateContext: withDiagnostics(resourceAlibabacloudStackVpcIpv6InternetBandwidthUpdate),
+		DeleteContext: withDiagnostics(resourceAlibabacloudStackVpcIpv6InternetBandwidthDelete),
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			StateContext: schema.ImportStatePassthroughContext,
 		},
 		Schema: map[string]*schema.Schema{
 			"bandwidth": {
@@ -52,7 +53,7 @@ func resourceAlibabacloudStackVpcIpv6InternetBandwidth() *schema.Resource {
 	}
 }
 
-func resourceAlibabacloudStackVpcIpv6InternetBandwidthCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceAlibabacloudStackVpcIpv6InternetBandwidthCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*connectivity.AlibabacloudStackClient)
 	var response map[string]interface{}
 	action := "AllocateIpv6InternetBandwidth"
@@ -71,7 +72,7 @@ func resourceAlibabacloudStackVpcIpv6InternetBandwidthCreate(d *schema.ResourceD
 	runtime := util.RuntimeOptions{}
 	runtime.SetAutoretry(true)
 	wait := incrementalWait(3*time.Second, 3*time.Second)
-	err = resource.Retry(d.Timeout(schema.TimeoutCreate), func() *resource.RetryError {
+	err = resource.RetryContext(ctx, d.Timeout(schema.TimeoutCreate), func() *resource.RetryError {
 		request["ClientToken"] = buildClientToken("AllocateIpv6InternetBandwidth")
 		request["Product"] = "Vpc"
 		request["OrganizationId"] = client.Department
@@ -92,9 +93,9 @@ func resourceAlibabacloudStackVpcIpv6InternetBandwidthCreate(d *schema.ResourceD
 
 	d.SetId(fmt.Sprint(response["InternetBandwidthId"]))
 
-	return resourceAlibabacloudStackVpcIpv6InternetBandwidthRead(d, meta)
+	return resourceAlibabacloudStackVpcIpv6InternetBandwidthRead(ctx, d, meta)
 }
-func resourceAlibabacloudStackVpcIpv6InternetBandwidthRead(d *schema.ResourceData, meta interface{}) error {
+func resourceAlibabacloudStackVpcIpv6InternetBandwidthRead(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*connectivity.AlibabacloudStackClient)
 	vpcService := VpcService{client}
 	object, err := vpcService.DescribeVpcIpv6InternetBandwidth(d.Id())
@@ -118,7 +119,7 @@ func resourceAlibabacloudStackVpcIpv6InternetBandwidthRead(d *schema.ResourceDat
 	}
 	return nil
 }
-func resourceAlibabacloudStackVpcIpv6InternetBandwidthUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceAlibabacloudStackVpcIpv6InternetBandwidthUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*connectivity.AlibabacloudStackClient)
 	var response map[string]interface{}
 	request := map[string]interface{}{
@@ -136,7 +137,7 @@ func resourceAlibabacloudStackVpcIpv6InternetBandwidthUpdate(d *schema.ResourceD
 	runtime := util.RuntimeOptions{}
 	runtime.SetAutoretry(true)
 	wait := incrementalWait(3*time.Second, 3*time.Second)
-	err = resource.Retry(d.Timeout(schema.TimeoutUpdate), func() *resource.RetryError {
+	err = resource.RetryContext(ctx, d.Timeout(schema.TimeoutUpdate), func() *resource.RetryError {
 		request["ClientToken"] = buildClientToken("ModifyIpv6InternetBandwidth")
 		request["Product"] = "Vpc"
 		request["OrganizationId"] = client.Department
@@ -154,9 +155,9 @@ func resourceAlibabacloudStackVpcIpv6InternetBandwidthUpdate(d *schema.ResourceD
 	if err != nil {
 		return WrapErrorf(err, DefaultErrorMsg, d.Id(), action, AlibabacloudStackSdkGoERROR)
 	}
-	return resourceAlibabacloudStackVpcIpv6InternetBandwidthRead(d, meta)
+	return resourceAlibabacloudStackVpcIpv6InternetBandwidthRead(ctx, d, meta)
 }
-func resourceAlibabacloudStackVpcIpv6InternetBandwidthDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceAlibabacloudStackVpcIpv6InternetBandwidthDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*connectivity.AlibabacloudStackClient)
 	action := "DeleteIpv6InternetBandwidth"
 	var response map[string]interface{}
@@ -171,7 +172,7 @@ func resourceAlibabacloudStackVpcIpv6InternetBandwidthDelete(d *schema.ResourceD
 	request["Ipv6AddressId"] = d.Get("ipv6_address_id")
 	request["RegionId"] = client.RegionId
 	wait := incrementalWait(3*time.Second, 3*time.Second)
-	err = resource.Retry(d.Timeout(schema.TimeoutDelete), func() *resource.RetryError {
+	err = resource.RetryContext(ctx, d.Timeout(schema.TimeoutDelete), func() *resource.RetryError {
 		request["Product"] = "Vpc"
 		request["OrganizationId"] = client.Department
 		response, err = conn.DoRequest(StringPointer(action), nil, StringPointer("POST"), StringPointer("2016-04-28"), StringPointer("AK"), nil, request, &util.RuntimeOptions{})
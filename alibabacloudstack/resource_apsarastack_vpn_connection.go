@@ -1,6 +1,7 @@
 package alibabacloudstack
 
 import (
+	"context"
 	"fmt"
 	"time"
 
@@ -17,12 +18,12 @@ import (
 
 func resourceAlibabacloudStackVpnConnection() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceAlibabacloudStackVpnConnectionCreate,
-		Read:   resourceAlibabacloudStackVpnConnectionRead,
-		Update: resourceAlibabacloudStackVpnConnectionUpdate,
-		Delete: resourceAlibabacloudStackVpnConnectionDelete,
+		CreateContext: withDiagnostics(resourceAlibabacloudStackVpnConnectionCreate),
+		ReadContext:   withDiagnostics(resourceAlibabacloudStackVpnConnectionRead),
+		UpdateContext: withDiagnostics(resourceAlibabacloudStackVpnConnectionUpdate),
+		DeleteContext: withDiagnostics(resourceAlibabacloudStackVpnConnectionDelete),
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			StateContext: schema.ImportStatePassthroughContext,
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -174,7 +175,7 @@ func resourceAlibabacloudStackVpnConnection() *schema.Resource {
 	}
 }
 
-func resourceAlibabacloudStackVpnConnectionCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceAlibabacloudStackVpnConnectionCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*connectivity.AlibabacloudStackClient)
 	vpnGatewayService := VpnGatewayService{client}
 	request, err := buildAlibabacloudStackVpnConnectionArgs(d, meta)
@@ -182,7 +183,7 @@ func resourceAlibabacloudStackVpnConnectionCreate(d *schema.ResourceData, meta i
 		return WrapError(err)
 	}
 	var response *vpc.CreateVpnConnectionResponse
-	err = resource.Retry(3*time.Minute, func() *resource.RetryError {
+	err = resource.RetryContext(ctx, 3*time.Minute, func() *resource.RetryError {
 		args := *request
 		raw, err := client.WithVpcClient(func(vpcClient *vpc.Client) (interface{}, error) {
 			return vpcClient.CreateVpnConnection(&args)
@@ -208,10 +209,10 @@ func resourceAlibabacloudStackVpnConnectionCreate(d *schema.ResourceData, meta i
 		return WrapError(err)
 	}
 
-	return resourceAlibabacloudStackVpnConnectionRead(d, meta)
+	return resourceAlibabacloudStackVpnConnectionRead(ctx, d, meta)
 }
 
-func resourceAlibabacloudStackVpnConnectionRead(d *schema.ResourceData, meta interface{}) error {
+func resourceAlibabacloudStackVpnConnectionRead(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 
 	client := meta.(*connectivity.AlibabacloudStackClient)
 	vpnGatewayService := VpnGatewayService{client}
@@ -247,7 +248,7 @@ func resourceAlibabacloudStackVpnConnectionRead(d *schema.ResourceData, meta int
 	return nil
 }
 
-func resourceAlibabacloudStackVpnConnectionUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceAlibabacloudStackVpnConnectionUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*connectivity.AlibabacloudStackClient)
 	vpnGatewayService := VpnGatewayService{client}
 	request := vpc.CreateModifyVpnConnectionAttributeRequest()
@@ -294,10 +295,10 @@ func resourceAlibabacloudStackVpnConnectionUpdate(d *schema.ResourceData, meta i
 	}
 	addDebug(request.GetActionName(), raw, request.RpcRequest, request)
 
-	return resourceAlibabacloudStackVpnConnectionRead(d, meta)
+	return resourceAlibabacloudStackVpnConnectionRead(ctx, d, meta)
 }
 
-func resourceAlibabacloudStackVpnConnectionDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceAlibabacloudStackVpnConnectionDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*connectivity.AlibabacloudStackClient)
 	vpnGatewayService := VpnGatewayService{client}
 	request := vpc.CreateDeleteVpnConnectionRequest()
@@ -308,7 +309,7 @@ func resourceAlibabacloudStackVpnConnectionDelete(d *schema.ResourceData, meta i
 	request.ClientToken = buildClientToken(request.GetActionName())
 	request.VpnConnectionId = d.Id()
 
-	err := resource.Retry(5*time.Minute, func() *resource.RetryError {
+	err := resource.RetryContext(ctx, 5*time.Minute, func() *resource.RetryError {
 		args := *request
 		raw, err := client.WithVpcClient(func(vpcClient *vpc.Client) (interface{}, error) {
 			return vpcClient.DeleteVpnConnection(&args)
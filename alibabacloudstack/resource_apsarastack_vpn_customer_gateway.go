@@ -1,6 +1,7 @@
 package alibabacloudstack
 
 import (
+	"context"
 	"time"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
@@ -13,12 +14,12 @@ import (
 
 func resourceAlibabacloudStackVpnCustomerGateway() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceAlibabacloudStackVpnCustomerGatewayCreate,
-		Read:   resourceAlibabacloudStackVpnCustomerGatewayRead,
-		Update: resourceAlibabacloudStackVpnCustomerGatewayUpdate,
-		Delete: resourceAlibabacloudStackVpnCustomerGatewayDelete,
+		CreateContext: withDiagnostics(resourceAlibabacloudStackVpnCustomerGatewayCreate),
+		ReadContext:   withDiagnostics(resourceAlibabacloudStackVpnCustomerGatewayRead),
+		UpdateContext: withDiagnostics(resourceAlibabacloudStackVpnCustomerGatewayUpdate),
+		DeleteContext: withDiagnostics(resourceAlibabacloudStackVpnCustomerGatewayDelete),
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			StateContext: schema.ImportStatePassthroughContext,
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -42,7 +43,7 @@ func resourceAlibabacloudStackVpnCustomerGateway() *schema.Resource {
 	}
 }
 
-func resourceAlibabacloudStackVpnCustomerGatewayCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceAlibabacloudStackVpnCustomerGatewayCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*connectivity.AlibabacloudStackClient)
 	vpnGatewayService := VpnGatewayService{client}
 	request := vpc.CreateCreateCustomerGatewayRequest()
@@ -62,7 +63,7 @@ func resourceAlibabacloudStackVpnCustomerGatewayCreate(d *schema.ResourceData, m
 	wait := incrementalWait(3*time.Second, 5*time.Second)
 	var raw interface{}
 	var err error
-	err = resource.Retry(2*time.Minute, func() *resource.RetryError {
+	err = resource.RetryContext(ctx, 2*time.Minute, func() *resource.RetryError {
 		args := *request
 		raw, err = client.WithVpcClient(func(vpcClient *vpc.Client) (interface{}, error) {
 			return vpcClient.CreateCustomerGateway(&args)
@@ -90,10 +91,10 @@ func resourceAlibabacloudStackVpnCustomerGatewayCreate(d *schema.ResourceData, m
 	if err != nil {
 		return WrapError(err)
 	}
-	return resourceAlibabacloudStackVpnCustomerGatewayRead(d, meta)
+	return resourceAlibabacloudStackVpnCustomerGatewayRead(ctx, d, meta)
 }
 
-func resourceAlibabacloudStackVpnCustomerGatewayRead(d *schema.ResourceData, meta interface{}) error {
+func resourceAlibabacloudStackVpnCustomerGatewayRead(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 
 	client := meta.(*connectivity.AlibabacloudStackClient)
 	vpnGatewayService := VpnGatewayService{client}
@@ -114,7 +115,7 @@ func resourceAlibabacloudStackVpnCustomerGatewayRead(d *schema.ResourceData, met
 	return nil
 }
 
-func resourceAlibabacloudStackVpnCustomerGatewayUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceAlibabacloudStackVpnCustomerGatewayUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*connectivity.AlibabacloudStackClient)
 
 	request := vpc.CreateModifyCustomerGatewayAttributeRequest()
@@ -138,10 +139,10 @@ func resourceAlibabacloudStackVpnCustomerGatewayUpdate(d *schema.ResourceData, m
 	}
 	addDebug(request.GetActionName(), raw, request.RpcRequest, request)
 
-	return resourceAlibabacloudStackVpnCustomerGatewayRead(d, meta)
+	return resourceAlibabacloudStackVpnCustomerGatewayRead(ctx, d, meta)
 }
 
-func resourceAlibabacloudStackVpnCustomerGatewayDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceAlibabacloudStackVpnCustomerGatewayDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*connectivity.AlibabacloudStackClient)
 	vpnGatewayService := VpnGatewayService{client}
 	request := vpc.CreateDeleteCustomerGatewayRequest()
@@ -150,7 +151,7 @@ func resourceAlibabacloudStackVpnCustomerGatewayDelete(d *schema.ResourceData, m
 	request.ClientToken = buildClientToken(request.GetActionName())
 	request.Headers["x-ascm-product-name"] = "Vpc"
 	request.Headers["x-acs-organizationId"] = client.Department
-	err := resource.Retry(2*time.Minute, func() *resource.RetryError {
+	err := resource.RetryContext(ctx, 2*time.Minute, func() *resource.RetryError {
 		args := *request
 		raw, err := client.WithVpcClient(func(vpcClient *vpc.Client) (interface{}, error) {
 			return vpcClient.DeleteCustomerGateway(&args)
@@ -1,6 +1,7 @@
 package alibabacloudstack
 
 import (
+	"context"
 	"fmt"
 	"strconv"
 	"strings"
@@ -19,12 +20,12 @@ import (
 
 func resourceAlibabacloudStackVpnGateway() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceAlibabacloudStackVpnGatewayCreate,
-		Read:   resourceAlibabacloudStackVpnGatewayRead,
-		Update: resourceAlibabacloudStackVpnGatewayUpdate,
-		Delete: resourceAlibabacloudStackVpnGatewayDelete,
+		CreateContext: withDiagnostics(resourceAlibabacloudStackVpnGatewayCreate),
+		ReadContext:   withDiagnostics(resourceAlibabacloudStackVpnGatewayRead),
+		UpdateContext: withDiagnostics(resourceAlibabacloudStackVpnGatewayUpdate),
+		DeleteContext: withDiagnostics(resourceAlibabacloudStackVpnGatewayDelete),
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			StateContext: schema.ImportStatePassthroughContext,
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -108,11 +109,13 @@ func resourceAlibabacloudStackVpnGateway() *schema.Resource {
 				Type:     schema.TypeString,
 				Computed: true,
 			},
+
+			"tags": tagsSchema(),
 		},
 	}
 }
 
-func resourceAlibabacloudStackVpnGatewayCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceAlibabacloudStackVpnGatewayCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*connectivity.AlibabacloudStackClient)
 	vpnGatewayService := VpnGatewayService{client}
 	request := vpc.CreateCreateVpnGatewayRequest()
@@ -176,10 +179,10 @@ func resourceAlibabacloudStackVpnGatewayCreate(d *schema.ResourceData, meta inte
 		return WrapError(err)
 	}
 
-	return resourceAlibabacloudStackVpnGatewayUpdate(d, meta)
+	return resourceAlibabacloudStackVpnGatewayUpdate(ctx, d, meta)
 }
 
-func resourceAlibabacloudStackVpnGatewayRead(d *schema.ResourceData, meta interface{}) error {
+func resourceAlibabacloudStackVpnGatewayRead(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 
 	client := meta.(*connectivity.AlibabacloudStackClient)
 	vpnGatewayService := VpnGatewayService{client}
@@ -219,10 +222,16 @@ func resourceAlibabacloudStackVpnGatewayRead(d *schema.ResourceData, meta interf
 		d.Set("instance_charge_type", string(PrePaid))
 	}
 
+	vpcService := VpcService{client}
+	tags, err := vpcService.DescribeTags(ctx, d.Id(), nil, TagResourceVpnGateway)
+	if err != nil {
+		return WrapError(err)
+	}
+	d.Set("tags", vpcService.tagsToMap(tags))
 	return nil
 }
 
-func resourceAlibabacloudStackVpnGatewayUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceAlibabacloudStackVpnGatewayUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*connectivity.AlibabacloudStackClient)
 	request := vpc.CreateModifyVpnGatewayAttributeRequest()
 	request.Headers["x-ascm-product-name"] = "Vpc"
@@ -253,9 +262,14 @@ func resourceAlibabacloudStackVpnGatewayUpdate(d *schema.ResourceData, meta inte
 		//d.SetPartial("description")
 	}
 
+	vpcService := VpcService{client}
+	if err := vpcService.setInstanceTags(ctx, d, TagResourceVpnGateway); err != nil {
+		return WrapError(err)
+	}
+
 	if d.IsNewResource() {
 		d.Partial(false)
-		return resourceAlibabacloudStackVpnGatewayRead(d, meta)
+		return resourceAlibabacloudStackVpnGatewayRead(ctx, d, meta)
 	}
 
 	if d.HasChange("bandwidth") {
@@ -267,10 +281,10 @@ func resourceAlibabacloudStackVpnGatewayUpdate(d *schema.ResourceData, meta inte
 	}
 
 	d.Partial(false)
-	return resourceAlibabacloudStackVpnGatewayRead(d, meta)
+	return resourceAlibabacloudStackVpnGatewayRead(ctx, d, meta)
 }
 
-func resourceAlibabacloudStackVpnGatewayDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceAlibabacloudStackVpnGatewayDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*connectivity.AlibabacloudStackClient)
 	vpnGatewayService := VpnGatewayService{client}
 
@@ -280,7 +294,7 @@ func resourceAlibabacloudStackVpnGatewayDelete(d *schema.ResourceData, meta inte
 	request.ClientToken = buildClientToken(request.GetActionName())
 	request.Headers["x-ascm-product-name"] = "Vpc"
 	request.Headers["x-acs-organizationId"] = client.Department
-	err := resource.Retry(5*time.Minute, func() *resource.RetryError {
+	err := resource.RetryContext(ctx, 5*time.Minute, func() *resource.RetryError {
 		args := *request
 		raw, err := client.WithVpcClient(func(vpcClient *vpc.Client) (interface{}, error) {
 			return vpcClient.DeleteVpnGateway(&args)
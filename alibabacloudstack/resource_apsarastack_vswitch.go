@@ -1,6 +1,7 @@
 package alibabacloudstack
 
 import (
+	"context"
 	"log"
 	"strings"
 	"time"
@@ -13,12 +14,12 @@ import (
 
 func resourceAlibabacloudStackSwitch() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceAlibabacloudStackSwitchCreate,
-		Read:   resourceAlibabacloudStackSwitchRead,
-		Update: resourceAlibabacloudStackSwitchUpdate,
-		Delete: resourceAlibabacloudStackSwitchDelete,
+		CreateContext: withDiagnostics(resourceAlibabacloudStackSwitchCreate),
+		ReadContext:   withDiagnostics(resourceAlibabacloudStackSwitchRead),
+		UpdateContext: withDiagnostics(resourceAlibabacloudStackSwitchUpdate),
+		DeleteContext: withDiagnostics(resourceAlibabacloudStackSwitchDelete),
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			StateContext: schema.ImportStatePassthroughContext,
 		},
 		Timeouts: &schema.ResourceTimeout{
 			Create: schema.DefaultTimeout(10 * time.Minute),
@@ -49,11 +50,12 @@ func resourceAlibabacloudStackSwitch() *schema.Resource {
 				Type:     schema.TypeString,
 				Optional: true,
 			},
+			"tags": tagsSchema(),
 		},
 	}
 }
 
-func resourceAlibabacloudStackSwitchCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceAlibabacloudStackSwitchCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*connectivity.AlibabacloudStackClient)
 	vpcService := VpcService{client}
 
@@ -80,7 +82,7 @@ func resourceAlibabacloudStackSwitchCreate(d *schema.ResourceData, meta interfac
 	}
 	request.ClientToken = buildClientToken(request.GetActionName())
 
-	if err := resource.Retry(5*time.Minute, func() *resource.RetryError {
+	if err := resource.RetryContext(ctx, 5*time.Minute, func() *resource.RetryError {
 		args := *request
 		raw, err := client.WithVpcClient(func(vpcClient *vpc.Client) (interface{}, error) {
 			return vpcClient.CreateVSwitch(&args)
@@ -103,14 +105,14 @@ func resourceAlibabacloudStackSwitchCreate(d *schema.ResourceData, meta interfac
 	}
 
 	stateConf := BuildStateConf([]string{}, []string{"Available"}, d.Timeout(schema.TimeoutCreate), 3*time.Second, vpcService.VSwitchStateRefreshFunc(d.Id(), []string{}))
-	if _, err := stateConf.WaitForState(); err != nil {
+	if _, err := stateConf.WaitForStateContext(ctx); err != nil {
 		return WrapErrorf(err, IdMsg, d.Id())
 	}
 
-	return resourceAlibabacloudStackSwitchUpdate(d, meta)
+	return resourceAlibabacloudStackSwitchUpdate(ctx, d, meta)
 }
 
-func resourceAlibabacloudStackSwitchRead(d *schema.ResourceData, meta interface{}) error {
+func resourceAlibabacloudStackSwitchRead(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 	wiatSecondsIfWithTest(1)
 	client := meta.(*connectivity.AlibabacloudStackClient)
 	vpcService := VpcService{client}
@@ -128,14 +130,24 @@ func resourceAlibabacloudStackSwitchRead(d *schema.ResourceData, meta interface{
 	d.Set("cidr_block", vswitch.CidrBlock)
 	d.Set("name", vswitch.VSwitchName)
 	d.Set("description", vswitch.Description)
+
+	tags, err := vpcService.DescribeTags(ctx, d.Id(), nil, TagResourceVSwitch)
+	if err != nil {
+		return WrapError(err)
+	}
+	d.Set("tags", vpcService.tagsToMap(tags))
 	return nil
 }
 
-func resourceAlibabacloudStackSwitchUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceAlibabacloudStackSwitchUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*connectivity.AlibabacloudStackClient)
+	vpcService := VpcService{client}
+	if err := vpcService.setInstanceTags(ctx, d, TagResourceVSwitch); err != nil {
+		return WrapError(err)
+	}
 	if d.IsNewResource() {
 		d.Partial(false)
-		return resourceAlibabacloudStackSwitchRead(d, meta)
+		return resourceAlibabacloudStackSwitchRead(ctx, d, meta)
 	}
 	update := false
 	request := vpc.CreateModifyVSwitchAttributeRequest()
@@ -169,10 +181,10 @@ func resourceAlibabacloudStackSwitchUpdate(d *schema.ResourceData, meta interfac
 		}
 		addDebug(request.GetActionName(), raw, request.RpcRequest, request)
 	}
-	return resourceAlibabacloudStackSwitchRead(d, meta)
+	return resourceAlibabacloudStackSwitchRead(ctx, d, meta)
 }
 
-func resourceAlibabacloudStackSwitchDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceAlibabacloudStackSwitchDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*connectivity.AlibabacloudStackClient)
 	vpcService := VpcService{client}
 	request := vpc.CreateDeleteVSwitchRequest()
@@ -186,7 +198,7 @@ func resourceAlibabacloudStackSwitchDelete(d *schema.ResourceData, meta interfac
 
 	request.QueryParams = map[string]string{"AccessKeySecret": client.SecretKey, "Product": "vpc", "Department": client.Department, "ResourceGroup": client.ResourceGroup}
 	request.VSwitchId = d.Id()
-	err := resource.Retry(d.Timeout(schema.TimeoutDelete), func() *resource.RetryError {
+	err := resource.RetryContext(ctx, d.Timeout(schema.TimeoutDelete), func() *resource.RetryError {
 		raw, err := client.WithVpcClient(func(vpcClient *vpc.Client) (interface{}, error) {
 			return vpcClient.DeleteVSwitch(request)
 		})
@@ -208,7 +220,7 @@ func resourceAlibabacloudStackSwitchDelete(d *schema.ResourceData, meta interfac
 	}
 
 	stateConf := BuildStateConf([]string{}, []string{}, d.Timeout(schema.TimeoutDelete), 3*time.Second, vpcService.VSwitchStateRefreshFunc(d.Id(), []string{}))
-	if _, err := stateConf.WaitForState(); err != nil {
+	if _, err := stateConf.WaitForStateContext(ctx); err != nil {
 		return WrapErrorf(err, IdMsg, d.Id())
 	}
 
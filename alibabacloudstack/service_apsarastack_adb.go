@@ -1,6 +1,7 @@
 package alibabacloudstack
 
 import (
+	"context"
 	"fmt"
 	"log"
 	"regexp"
@@ -687,10 +688,10 @@ func (s *AdbService) DescribeDBClusterAccessWhiteList(id string) (object map[str
 	return object, nil
 }
 
-func (s *AdbService) SetResourceTags(d *schema.ResourceData, resourceType string) error {
+func (s *AdbService) SetResourceTags(ctx context.Context, d *schema.ResourceData, resourceType string) error {
 
-	if d.HasChange("tags") {
-		added, removed := parsingTags(d)
+	if hasTagsAllChange(d, s.client) {
+		added, removed := parsingTags(d, s.client)
 		conn, err := s.client.NewAdsClient()
 		if err != nil {
 			return WrapError(err)
@@ -717,7 +718,7 @@ func (s *AdbService) SetResourceTags(d *schema.ResourceData, resourceType string
 			runtime := util.RuntimeOptions{}
 			runtime.SetIgnoreSSL(s.client.Config.Insecure)
 			wait := incrementalWait(2*time.Second, 1*time.Second)
-			err := resource.Retry(10*time.Minute, func() *resource.RetryError {
+			err := resource.RetryContext(ctx, 10*time.Minute, func() *resource.RetryError {
 				response, err := conn.DoRequest(StringPointer(action), nil, StringPointer("POST"), StringPointer("2019-03-15"), StringPointer("AK"), nil, request, &runtime)
 				if err != nil {
 					if IsThrottling(err) {
@@ -751,7 +752,7 @@ func (s *AdbService) SetResourceTags(d *schema.ResourceData, resourceType string
 			wait := incrementalWait(2*time.Second, 1*time.Second)
 			runtime := util.RuntimeOptions{}
 			runtime.SetIgnoreSSL(s.client.Config.Insecure)
-			err := resource.Retry(10*time.Minute, func() *resource.RetryError {
+			err := resource.RetryContext(ctx, 10*time.Minute, func() *resource.RetryError {
 				response, err := conn.DoRequest(StringPointer(action), nil, StringPointer("POST"), StringPointer("2019-03-15"), StringPointer("AK"), nil, request, &runtime)
 				if err != nil {
 					if IsThrottling(err) {
@@ -1,10 +1,15 @@
 package alibabacloudstack
 
 import (
+	"context"
+	"encoding/json"
+
+	"github.com/aliyun/alibaba-cloud-sdk-go/sdk/requests"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"log"
 	"regexp"
+	"strconv"
 	"strings"
 	"time"
 
@@ -18,13 +23,28 @@ type AlikafkaService struct {
 
 func (alikafkaService *AlikafkaService) DescribeAlikafkaInstance(instanceId string) (*alikafka.InstanceVO, error) {
 	alikafkaInstance := &alikafka.InstanceVO{}
+	instances, err := alikafkaService.DescribeAlikafkaInstances(alikafkaService.client.StopContext())
+	if err != nil {
+		return alikafkaInstance, WrapError(err)
+	}
+	for _, v := range instances {
+		if v.InstanceId == instanceId {
+			return &v, nil
+		}
+	}
+	return alikafkaInstance, WrapErrorf(Error(GetNotFoundMessage("AlikafkaInstance", instanceId)), NotFoundMsg, ProviderERROR)
+}
+
+// DescribeAlikafkaInstances returns the instances of the region which are not released.
+func (alikafkaService *AlikafkaService) DescribeAlikafkaInstances(ctx context.Context) ([]alikafka.InstanceVO, error) {
 	instanceListReq := alikafka.CreateGetInstanceListRequest()
 	instanceListReq.RegionId = alikafkaService.client.RegionId
+	instanceListReq.Domain = alikafkaService.client.Config.AlikafkaOpenAPIEndpoint
 
 	wait := incrementalWait(2*time.Second, 1*time.Second)
 	var raw interface{}
 	var err error
-	err = resource.Retry(10*time.Minute, func() *resource.RetryError {
+	err = resource.RetryContext(ctx, 10*time.Minute, func() *resource.RetryError {
 		raw, err = alikafkaService.client.WithAlikafkaClient(func(client *alikafka.Client) (interface{}, error) {
 			return client.GetInstanceList(instanceListReq)
 		})
@@ -40,20 +60,20 @@ func (alikafkaService *AlikafkaService) DescribeAlikafkaInstance(instanceId stri
 	})
 
 	if err != nil {
-		return alikafkaInstance, WrapErrorf(err, DefaultErrorMsg, instanceId, instanceListReq.GetActionName(), AlibabacloudStackSdkGoERROR)
+		return nil, WrapErrorf(err, DefaultErrorMsg, "alikafka_instances", instanceListReq.GetActionName(), AlibabacloudStackSdkGoERROR)
 	}
 
 	instanceListResp, _ := raw.(*alikafka.GetInstanceListResponse)
 	addDebug(instanceListReq.GetActionName(), raw, instanceListReq.RpcRequest, instanceListReq)
 
+	var instances []alikafka.InstanceVO
 	for _, v := range instanceListResp.InstanceList.InstanceVO {
-
 		// ServiceStatus equals 10 means the instance is released, do not return the instance.
-		if v.InstanceId == instanceId && v.ServiceStatus != 10 {
-			return &v, nil
+		if v.ServiceStatus != 10 {
+			instances = append(instances, v)
 		}
 	}
-	return alikafkaInstance, WrapErrorf(Error(GetNotFoundMessage("AlikafkaInstance", instanceId)), NotFoundMsg, ProviderERROR)
+	return instances, nil
 }
 
 func (alikafkaService *AlikafkaService) DescribeAlikafkaNodeStatus(instanceId string) (*alikafka.StatusList, error) {
@@ -61,11 +81,12 @@ func (alikafkaService *AlikafkaService) DescribeAlikafkaNodeStatus(instanceId st
 	describeNodeStatusReq := alikafka.CreateDescribeNodeStatusRequest()
 	describeNodeStatusReq.RegionId = alikafkaService.client.RegionId
 	describeNodeStatusReq.InstanceId = instanceId
+	describeNodeStatusReq.Domain = alikafkaService.client.Config.AlikafkaOpenAPIEndpoint
 
 	wait := incrementalWait(2*time.Second, 1*time.Second)
 	var raw interface{}
 	var err error
-	err = resource.Retry(10*time.Minute, func() *resource.RetryError {
+	err = resource.RetryContext(alikafkaService.client.StopContext(), 10*time.Minute, func() *resource.RetryError {
 		raw, err = alikafkaService.client.WithAlikafkaClient(func(client *alikafka.Client) (interface{}, error) {
 			return client.DescribeNodeStatus(describeNodeStatusReq)
 		})
@@ -90,11 +111,12 @@ func (alikafkaService *AlikafkaService) DescribeAlikafkaNodeStatus(instanceId st
 	return &describeNodeStatusResp.StatusList, nil
 }
 
-func (alikafkaService *AlikafkaService) DescribeAlikafkaInstanceByOrderId(orderId string, timeout int) (*alikafka.InstanceVO, error) {
+func (alikafkaService *AlikafkaService) DescribeAlikafkaInstanceByOrderId(ctx context.Context, orderId string, timeout int) (*alikafka.InstanceVO, error) {
 	alikafkaInstance := &alikafka.InstanceVO{}
 	instanceListReq := alikafka.CreateGetInstanceListRequest()
 	instanceListReq.RegionId = alikafkaService.client.RegionId
 	instanceListReq.OrderId = orderId
+	instanceListReq.Domain = alikafkaService.client.Config.AlikafkaOpenAPIEndpoint
 
 	deadline := time.Now().Add(time.Duration(timeout) * time.Second)
 	for {
@@ -102,7 +124,7 @@ func (alikafkaService *AlikafkaService) DescribeAlikafkaInstanceByOrderId(orderI
 		wait := incrementalWait(2*time.Second, 1*time.Second)
 		var raw interface{}
 		var err error
-		err = resource.Retry(10*time.Minute, func() *resource.RetryError {
+		err = resource.RetryContext(ctx, 10*time.Minute, func() *resource.RetryError {
 			raw, err = alikafkaService.client.WithAlikafkaClient(func(client *alikafka.Client) (interface{}, error) {
 				return client.GetInstanceList(instanceListReq)
 			})
@@ -142,15 +164,30 @@ func (alikafkaService *AlikafkaService) DescribeAlikafkaConsumerGroup(id string)
 		return alikafkaConsumerGroup, WrapError(err)
 	}
 	instanceId := parts[0]
-	//consumerId := parts[1]
+	consumerId := parts[1]
+
+	consumerGroups, err := alikafkaService.DescribeAlikafkaConsumerGroups(alikafkaService.client.StopContext(), instanceId)
+	if err != nil {
+		return alikafkaConsumerGroup, WrapError(err)
+	}
+	for _, v := range consumerGroups {
+		if v.ConsumerId == consumerId {
+			return &v, nil
+		}
+	}
+	return alikafkaConsumerGroup, WrapErrorf(Error(GetNotFoundMessage("AlikafkaConsumerGroup", id)), NotFoundMsg, ProviderERROR)
+}
 
+func (alikafkaService *AlikafkaService) DescribeAlikafkaConsumerGroups(ctx context.Context, instanceId string) ([]alikafka.ConsumerVO, error) {
 	request := alikafka.CreateGetConsumerListRequest()
 	request.InstanceId = instanceId
 	request.RegionId = alikafkaService.client.RegionId
+	request.Domain = alikafkaService.client.Config.AlikafkaOpenAPIEndpoint
 
 	wait := incrementalWait(2*time.Second, 1*time.Second)
 	var raw interface{}
-	err = resource.Retry(10*time.Minute, func() *resource.RetryError {
+	var err error
+	err = resource.RetryContext(ctx, 10*time.Minute, func() *resource.RetryError {
 		raw, err = alikafkaService.client.WithAlikafkaClient(func(client *alikafka.Client) (interface{}, error) {
 			return client.GetConsumerList(request)
 		})
@@ -166,18 +203,11 @@ func (alikafkaService *AlikafkaService) DescribeAlikafkaConsumerGroup(id string)
 	})
 
 	if err != nil {
-		return alikafkaConsumerGroup, WrapErrorf(err, DefaultErrorMsg, id, request.GetActionName(), AlibabacloudStackSdkGoERROR)
+		return nil, WrapErrorf(err, DefaultErrorMsg, instanceId, request.GetActionName(), AlibabacloudStackSdkGoERROR)
 	}
 
-	//consumerListResp, _ := raw.(*alikafka.GetConsumerListResponse)
-	addDebug(request.GetActionName(), raw, request.RpcRequest, request)
-
-	//for _, v := range consumerListResp.ConsumerList {
-	//	if v.ConsumerId == consumerId {
-	//		return &v, nil
-	//	}
-	//}
-	return alikafkaConsumerGroup, WrapErrorf(Error(GetNotFoundMessage("AlikafkaConsumerGroup", id)), NotFoundMsg, ProviderERROR)
+	consumerListResp, _ := raw.(*alikafka.GetConsumerListResponse)
+	return consumerListResp.ConsumerList.ConsumerVO, nil
 }
 
 func (alikafkaService *AlikafkaService) DescribeAlikafkaTopicStatus(id string) (*alikafka.TopicStatus, error) {
@@ -198,7 +228,7 @@ func (alikafkaService *AlikafkaService) DescribeAlikafkaTopicStatus(id string) (
 	wait := incrementalWait(3*time.Second, 5*time.Second)
 	var raw interface{}
 
-	err = resource.Retry(5*time.Minute, func() *resource.RetryError {
+	err = resource.RetryContext(alikafkaService.client.StopContext(), 5*time.Minute, func() *resource.RetryError {
 		raw, err = alikafkaService.client.WithAlikafkaClient(func(alikafkaClient *alikafka.Client) (interface{}, error) {
 			return alikafkaClient.GetTopicStatus(request)
 		})
@@ -245,7 +275,7 @@ func (alikafkaService *AlikafkaService) DescribeAlikafkaTopic(id string) (*alika
 	wait := incrementalWait(3*time.Second, 5*time.Second)
 	var raw interface{}
 
-	err = resource.Retry(5*time.Minute, func() *resource.RetryError {
+	err = resource.RetryContext(alikafkaService.client.StopContext(), 5*time.Minute, func() *resource.RetryError {
 		raw, err = alikafkaService.client.WithAlikafkaClient(func(alikafkaClient *alikafka.Client) (interface{}, error) {
 			return alikafkaClient.GetTopicList(request)
 		})
@@ -274,6 +304,103 @@ func (alikafkaService *AlikafkaService) DescribeAlikafkaTopic(id string) (*alika
 	return alikafkaTopic, WrapErrorf(Error(GetNotFoundMessage("AlikafkaTopic", id)), NotFoundMsg, ProviderERROR)
 }
 
+// alikafkaTopicVO is the TopicVO item of the GetTopicList response. The response
+// is decoded from its body, since the sdk types of the topic list differ between releases.
+type alikafkaTopicVO struct {
+	Topic        string `json:"Topic"`
+	CreateTime   int64  `json:"CreateTime"`
+	Remark       string `json:"Remark"`
+	Status       int    `json:"Status"`
+	InstanceId   string `json:"InstanceId"`
+	StatusName   string `json:"StatusName"`
+	CompactTopic bool   `json:"CompactTopic"`
+	LocalTopic   bool   `json:"LocalTopic"`
+	PartitionNum int    `json:"PartitionNum"`
+}
+
+func (alikafkaService *AlikafkaService) DescribeAlikafkaTopics(ctx context.Context, instanceId string) ([]alikafkaTopicVO, error) {
+	request := alikafka.CreateGetTopicListRequest()
+	request.InstanceId = instanceId
+	request.RegionId = alikafkaService.client.RegionId
+	request.Domain = alikafkaService.client.Config.AlikafkaOpenAPIEndpoint
+	request.PageSize = strconv.Itoa(PageSizeLarge)
+
+	var topics []alikafkaTopicVO
+	for page := 1; ; page++ {
+		request.CurrentPage = strconv.Itoa(page)
+		wait := incrementalWait(3*time.Second, 5*time.Second)
+		var raw interface{}
+		var err error
+		err = resource.RetryContext(ctx, 5*time.Minute, func() *resource.RetryError {
+			raw, err = alikafkaService.client.WithAlikafkaClient(func(alikafkaClient *alikafka.Client) (interface{}, error) {
+				return alikafkaClient.GetTopicList(request)
+			})
+			if err != nil {
+				if IsExpectedErrors(err, []string{ThrottlingUser, "ONS_SYSTEM_FLOW_CONTROL"}) {
+					wait()
+					return resource.RetryableError(err)
+				}
+				return resource.NonRetryableError(err)
+			}
+			addDebug(request.GetActionName(), raw, request.RpcRequest, request)
+			return nil
+		})
+		if err != nil {
+			return nil, WrapErrorf(err, DefaultErrorMsg, instanceId, request.GetActionName(), AlibabacloudStackSdkGoERROR)
+		}
+		response := struct {
+			Total     int `json:"Total"`
+			TopicList struct {
+				TopicVO []alikafkaTopicVO `json:"TopicVO"`
+			} `json:"TopicList"`
+		}{}
+		if err := json.Unmarshal(raw.(*alikafka.GetTopicListResponse).GetHttpContentBytes(), &response); err != nil {
+			return nil, WrapError(err)
+		}
+		topics = append(topics, response.TopicList.TopicVO...)
+		if len(response.TopicList.TopicVO) < PageSizeLarge || len(topics) >= response.Total {
+			break
+		}
+	}
+	return topics, nil
+}
+
+func (alikafkaService *AlikafkaService) DescribeAlikafkaSaslUsers(ctx context.Context, instanceId string) ([]alikafka.SaslUserVO, error) {
+	request := alikafka.CreateDescribeSaslUsersRequest()
+	request.InstanceId = instanceId
+	request.RegionId = alikafkaService.client.RegionId
+	request.Domain = alikafkaService.client.Config.AlikafkaOpenAPIEndpoint
+	wait := incrementalWait(3*time.Second, 5*time.Second)
+	var raw interface{}
+	var err error
+	err = resource.RetryContext(ctx, 5*time.Minute, func() *resource.RetryError {
+		raw, err = alikafkaService.client.WithAlikafkaClient(func(alikafkaClient *alikafka.Client) (interface{}, error) {
+			return alikafkaClient.DescribeSaslUsers(request)
+		})
+		if err != nil {
+			if IsExpectedErrors(err, []string{ThrottlingUser, "ONS_SYSTEM_FLOW_CONTROL"}) {
+				wait()
+				return resource.RetryableError(err)
+			}
+			return resource.NonRetryableError(err)
+		}
+		addDebug(request.GetActionName(), raw, request.RpcRequest, request)
+		return nil
+	})
+	if err != nil {
+		return nil, WrapErrorf(err, DefaultErrorMsg, instanceId, request.GetActionName(), AlibabacloudStackSdkGoERROR)
+	}
+	response := struct {
+		SaslUserList struct {
+			SaslUserVO []alikafka.SaslUserVO `json:"SaslUserVO"`
+		} `json:"SaslUserList"`
+	}{}
+	if err := json.Unmarshal(raw.(*alikafka.DescribeSaslUsersResponse).GetHttpContentBytes(), &response); err != nil {
+		return nil, WrapError(err)
+	}
+	return response.SaslUserList.SaslUserVO, nil
+}
+
 func (alikafkaService *AlikafkaService) DescribeAlikafkaSaslUser(id string) (*alikafka.SaslUserList, error) {
 	alikafkaSaslUser := &alikafka.SaslUserList{}
 
@@ -291,7 +418,7 @@ func (alikafkaService *AlikafkaService) DescribeAlikafkaSaslUser(id string) (*al
 	wait := incrementalWait(3*time.Second, 5*time.Second)
 	var raw interface{}
 
-	err = resource.Retry(5*time.Minute, func() *resource.RetryError {
+	err = resource.RetryContext(alikafkaService.client.StopContext(), 5*time.Minute, func() *resource.RetryError {
 		raw, err = alikafkaService.client.WithAlikafkaClient(func(alikafkaClient *alikafka.Client) (interface{}, error) {
 			return alikafkaClient.DescribeSaslUsers(request)
 		})
@@ -345,7 +472,7 @@ func (alikafkaService *AlikafkaService) DescribeAlikafkaSaslAcl(id string) (*ali
 	request.Domain = alikafkaService.client.Config.AlikafkaOpenAPIEndpoint
 	wait := incrementalWait(3*time.Second, 5*time.Second)
 	var raw interface{}
-	err = resource.Retry(5*time.Minute, func() *resource.RetryError {
+	err = resource.RetryContext(alikafkaService.client.StopContext(), 5*time.Minute, func() *resource.RetryError {
 		raw, err = alikafkaService.client.WithAlikafkaClient(func(alikafkaClient *alikafka.Client) (interface{}, error) {
 			return alikafkaClient.DescribeAcls(request)
 		})
@@ -378,39 +505,54 @@ func (alikafkaService *AlikafkaService) DescribeAlikafkaSaslAcl(id string) (*ali
 	return alikafkaSaslAcl, WrapErrorf(Error(GetNotFoundMessage("AlikafkaSaslAcl", id)), NotFoundMsg, ProviderERROR)
 }
 
-func (s *AlikafkaService) WaitForAlikafkaInstanceUpdated(id string, topicQuota int, diskSize int, ioMax int,
+// invokeAlikafkaRequest invokes the alikafka request, and retries it when it is throttled.
+func (s *AlikafkaService) invokeAlikafkaRequest(ctx context.Context, id, action string, do func(*alikafka.Client) (interface{}, error), request *requests.RpcRequest) error {
+	err := resource.RetryContext(ctx, 5*time.Minute, func() *resource.RetryError {
+		raw, err := s.client.WithAlikafkaClient(do)
+		if err != nil {
+			if IsExpectedErrors(err, []string{ThrottlingUser, "ONS_SYSTEM_FLOW_CONTROL"}) {
+				time.Sleep(10 * time.Second)
+				return resource.RetryableError(err)
+			}
+			return resource.NonRetryableError(err)
+		}
+		addDebug(action, raw, request)
+		return nil
+	})
+	if err != nil {
+		return WrapErrorf(err, DefaultErrorMsg, id, action, AlibabacloudStackSdkGoERROR)
+	}
+	return nil
+}
+
+func (s *AlikafkaService) WaitForAlikafkaInstanceUpdated(ctx context.Context, id string, topicQuota int, diskSize int, ioMax int,
 	eipMax int, paidType int, specType string, timeout int) error {
-	deadline := time.Now().Add(time.Duration(timeout) * time.Second)
-	for {
+	err := resource.RetryContext(ctx, time.Duration(timeout)*time.Second, func() *resource.RetryError {
 		object, err := s.DescribeAlikafkaInstance(id)
 		if err != nil {
-			return WrapError(err)
+			return resource.NonRetryableError(err)
 		}
 
 		// Wait for all variables be equal.
 		if object.InstanceId == id && object.TopicNumLimit == topicQuota && object.DiskSize == diskSize && object.IoMax == ioMax && object.EipMax == eipMax && object.PaidType == paidType && object.SpecType == specType {
 			return nil
 		}
-
-		if time.Now().After(deadline) {
-			return WrapErrorf(err, WaitTimeoutMsg, id, GetFunc(1), timeout, object.InstanceId, id, ProviderERROR)
-		}
-		time.Sleep(DefaultIntervalShort * time.Second)
+		return resource.RetryableError(Error(GetTimeoutMessage("alibabacloudstack_alikafka_instance", "updated")))
+	})
+	if err != nil {
+		return WrapErrorf(err, IdMsg, id)
 	}
+	return nil
 }
 
-func (s *AlikafkaService) WaitForAlikafkaInstance(id string, status Status, timeout int) error {
-	deadline := time.Now().Add(time.Duration(timeout) * time.Second)
-	for {
+func (s *AlikafkaService) WaitForAlikafkaInstance(ctx context.Context, id string, status Status, timeout int) error {
+	err := resource.RetryContext(ctx, time.Duration(timeout)*time.Second, func() *resource.RetryError {
 		object, err := s.DescribeAlikafkaInstance(id)
 		if err != nil {
-			if NotFoundError(err) {
-				if status == Deleted {
-					return nil
-				}
-			} else {
-				return WrapError(err)
+			if NotFoundError(err) && status == Deleted {
+				return nil
 			}
+			return resource.NonRetryableError(err)
 		}
 
 		// Process wait for running.
@@ -433,12 +575,12 @@ func (s *AlikafkaService) WaitForAlikafkaInstance(id string, status Status, time
 				}
 			}
 		}
-
-		if time.Now().After(deadline) {
-			return WrapErrorf(err, WaitTimeoutMsg, id, GetFunc(1), timeout, object.InstanceId, id, ProviderERROR)
-		}
-		time.Sleep(DefaultIntervalShort * time.Second)
+		return resource.RetryableError(Error(GetTimeoutMessage("alibabacloudstack_alikafka_instance", string(status))))
+	})
+	if err != nil {
+		return WrapErrorf(err, IdMsg, id)
 	}
+	return nil
 }
 
 func (s *AlikafkaService) WaitForAllAlikafkaNodeRelease(id string, status string, timeout int) error {
@@ -612,7 +754,7 @@ func (s *AlikafkaService) WaitForAlikafkaSaslAcl(id string, status Status, timeo
 	}
 }
 
-func (s *AlikafkaService) DescribeTags(resourceId string, resourceTags map[string]interface{}, resourceType TagResourceType) (tags []alikafka.TagResource, err error) {
+func (s *AlikafkaService) DescribeTags(ctx context.Context, resourceId string, resourceTags map[string]interface{}, resourceType TagResourceType) (tags []alikafka.TagResource, err error) {
 	request := alikafka.CreateListTagResourcesRequest()
 	request.RegionId = s.client.RegionId
 	request.ResourceType = string(resourceType)
@@ -632,7 +774,7 @@ func (s *AlikafkaService) DescribeTags(resourceId string, resourceTags map[strin
 	wait := incrementalWait(3*time.Second, 5*time.Second)
 	var raw interface{}
 
-	err = resource.Retry(5*time.Minute, func() *resource.RetryError {
+	err = resource.RetryContext(ctx, 5*time.Minute, func() *resource.RetryError {
 		raw, err = s.client.WithAlikafkaClient(func(alikafkaClient *alikafka.Client) (interface{}, error) {
 			return alikafkaClient.ListTagResources(request)
 		})
@@ -655,9 +797,15 @@ func (s *AlikafkaService) DescribeTags(resourceId string, resourceTags map[strin
 	return response.TagResources.TagResource, nil
 }
 
-func (s *AlikafkaService) setInstanceTags(d *schema.ResourceData, resourceType TagResourceType) error {
-	if d.HasChange("tags") {
-		oraw, nraw := d.GetChange("tags")
+func (s *AlikafkaService) setInstanceTags(ctx context.Context, d *schema.ResourceData, resourceType TagResourceType) error {
+	changed := hasTagsAllChange(d, s.client)
+	oraw, nraw := getTagsAllChange(d, s.client)
+	// The topics do not read their tags back, so the provider default_tags are not applied to them.
+	if resourceType == TagResourceTopic {
+		changed = d.HasChange("tags")
+		oraw, nraw = d.GetChange("tags")
+	}
+	if changed {
 		o := oraw.(map[string]interface{})
 		n := nraw.(map[string]interface{})
 		create, remove := s.diffTags(s.tagsFromMap(o), s.tagsFromMap(n))
@@ -674,7 +822,7 @@ func (s *AlikafkaService) setInstanceTags(d *schema.ResourceData, resourceType T
 			request.RegionId = s.client.RegionId
 
 			wait := incrementalWait(2*time.Second, 1*time.Second)
-			err := resource.Retry(10*time.Minute, func() *resource.RetryError {
+			err := resource.RetryContext(ctx, 10*time.Minute, func() *resource.RetryError {
 				raw, err := s.client.WithAlikafkaClient(func(client *alikafka.Client) (interface{}, error) {
 					return client.UntagResources(request)
 				})
@@ -702,7 +850,7 @@ func (s *AlikafkaService) setInstanceTags(d *schema.ResourceData, resourceType T
 			request.RegionId = s.client.RegionId
 
 			wait := incrementalWait(2*time.Second, 1*time.Second)
-			err := resource.Retry(10*time.Minute, func() *resource.RetryError {
+			err := resource.RetryContext(ctx, 10*time.Minute, func() *resource.RetryError {
 				raw, err := s.client.WithAlikafkaClient(func(client *alikafka.Client) (interface{}, error) {
 					return client.TagResources(request)
 				})
@@ -721,8 +869,6 @@ func (s *AlikafkaService) setInstanceTags(d *schema.ResourceData, resourceType T
 				return WrapErrorf(err, DefaultErrorMsg, d.Id(), request.GetActionName(), AlibabacloudStackSdkGoERROR)
 			}
 		}
-
-		d.SetPartial("tags")
 	}
 
 	return nil
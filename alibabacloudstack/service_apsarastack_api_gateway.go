@@ -507,7 +507,7 @@ func (s *CloudApiService) DescribeTags(resourceId string, resourceTags map[strin
 }
 
 func (s *CloudApiService) setInstanceTags(d *schema.ResourceData, resourceType TagResourceType) error {
-	oraw, nraw := d.GetChange("tags")
+	oraw, nraw := getTagsAllChange(d, s.client)
 	o := oraw.(map[string]interface{})
 	n := nraw.(map[string]interface{})
 	create, remove := s.diffTags(s.tagsFromMap(o), s.tagsFromMap(n))
@@ -1,11 +1,13 @@
 package alibabacloudstack
 
 import (
+	"context"
 	"encoding/json"
 	"github.com/aliyun/alibaba-cloud-sdk-go/sdk/requests"
 	"github.com/aliyun/alibaba-cloud-sdk-go/sdk/responses"
 	"github.com/aliyun/alibaba-cloud-sdk-go/services/ecs"
 	"github.com/aliyun/terraform-provider-alibabacloudstack/alibabacloudstack/connectivity"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"strconv"
 	"strings"
 )
@@ -1008,6 +1010,51 @@ func (s *AscmService) DescribeAscmQuota(id string) (response *AscmQuota, err err
 	return resp, nil
 }
 
+// AscmQuotaUsage is the total and used amounts of an item of a quota.
+type AscmQuotaUsage struct {
+	Item  string
+	Total int
+	Used  int
+}
+
+// DescribeAscmQuotaUsage returns the usage of the quota items of the product for the organization or the resource group.
+// It returns nothing when no quota is set for them.
+func (s *AscmService) DescribeAscmQuotaUsage(productName, quotaType, quotaTypeId string) ([]AscmQuotaUsage, error) {
+	object, err := s.DescribeAscmQuota(strings.Join([]string{productName, quotaType, quotaTypeId}, COLON_SEPARATED))
+	if err != nil {
+		if NotFoundError(err) {
+			return nil, nil
+		}
+		return nil, WrapError(err)
+	}
+	if object.Code != "200" || object.Data.ID == 0 {
+		return nil, nil
+	}
+	quota := object.Data
+	switch productName {
+	case "ECS":
+		return []AscmQuotaUsage{
+			{Item: "cpu", Total: quota.TotalCPU, Used: quota.UsedCPU},
+			{Item: "mem", Total: quota.TotalMem, Used: quota.UsedMem},
+			{Item: "gpu", Total: quota.TotalGpu, Used: quota.UsedGpu},
+			{Item: "disk_cloud_ssd", Total: quota.TotalDiskCloudSsd, Used: quota.UsedDiskCloudSsd},
+			{Item: "disk_cloud_efficiency", Total: quota.TotalDiskCloudEfficiency, Used: quota.UsedDiskCloudEfficiency},
+		}, nil
+	case "SLB":
+		return []AscmQuotaUsage{
+			{Item: "vip_public", Total: quota.TotalVipPublic, Used: quota.UsedVipPublic},
+			{Item: "vip_internal", Total: quota.TotalVipInternal, Used: quota.UsedVipInternal},
+		}, nil
+	case "EIP":
+		return []AscmQuotaUsage{{Item: "eip", Total: quota.TotalEIP, Used: quota.UsedEIP}}, nil
+	case "VPC":
+		return []AscmQuotaUsage{{Item: "vpc", Total: quota.TotalVPC, Used: quota.UsedVPC}}, nil
+	case "OSS":
+		return []AscmQuotaUsage{{Item: "amount", Total: quota.TotalAmount, Used: quota.UsedAmount}}, nil
+	}
+	return nil, WrapError(Error("The quota usage of the product %s is not supported.", productName))
+}
+
 func (s *AscmService) DescribeAscmPasswordPolicy(id string) (response *PasswordPolicy, err error) {
 	var requestInfo *ecs.Client
 	//	did := strings.Split(id, COLON_SEPARATED)
@@ -1119,3 +1166,129 @@ func (s *AscmService) DescribeAscmUsergroupUser(id string) (response *User, err
 
 	return resp, nil
 }
+
+// DescribeAscmOrganizationByPath looks up the organization by the names along its path, such as
+// "root/dept-a/team-b". The path can start from any organization whose name is unique.
+func (s *AscmService) DescribeAscmOrganizationByPath(path string) (int, error) {
+	parentId := -1
+	for _, name := range strings.Split(strings.Trim(path, "/"), "/") {
+		object, err := s.DescribeAscmOrganization(name)
+		if err != nil {
+			return 0, WrapError(err)
+		}
+		var ids []int
+		for _, org := range object.Data {
+			if org.Name == name && (parentId < 0 || org.ParentID == parentId) {
+				ids = append(ids, org.ID)
+			}
+		}
+		if parentId, err = ascmUniqueId("Ascm Organization", path, ids); err != nil {
+			return 0, err
+		}
+	}
+	return parentId, nil
+}
+
+func (s *AscmService) DescribeAscmResourceGroupIdByName(name string) (int, error) {
+	object, err := s.DescribeAscmResourceGroup(name)
+	if err != nil {
+		return 0, WrapError(err)
+	}
+	var ids []int
+	for _, rg := range object.Data {
+		if rg.ResourceGroupName == name {
+			ids = append(ids, rg.ID)
+		}
+	}
+	return ascmUniqueId("Ascm Resource Group", name, ids)
+}
+
+func (s *AscmService) DescribeAscmRamRoleIdByName(name string) (int, error) {
+	object, err := s.DescribeAscmRamRole(name)
+	if err != nil {
+		return 0, WrapError(err)
+	}
+	var ids []int
+	for _, role := range object.Data {
+		if role.RoleName == name {
+			ids = append(ids, role.ID)
+		}
+	}
+	return ascmUniqueId("Ascm Ram Role", name, ids)
+}
+
+func (s *AscmService) DescribeAscmCustomRoleIdByName(name string) (int, error) {
+	object, err := s.DescribeAscmCustomRole(name)
+	if err != nil {
+		return 0, WrapError(err)
+	}
+	var ids []int
+	for _, role := range object.Data {
+		if role.RoleName == name {
+			ids = append(ids, role.ID)
+		}
+	}
+	return ascmUniqueId("Ascm Custom Role", name, ids)
+}
+
+func (s *AscmService) DescribeAscmUserGroupIdByName(name string) (int, error) {
+	object, err := s.DescribeAscmUserGroup(name)
+	if err != nil {
+		return 0, WrapError(err)
+	}
+	var ids []int
+	for _, group := range object.Data {
+		if group.GroupName == name {
+			ids = append(ids, group.Id)
+		}
+	}
+	return ascmUniqueId("Ascm User Group", name, ids)
+}
+
+func ascmUniqueId(resourceType, name string, ids []int) (int, error) {
+	if len(ids) == 0 {
+		return 0, WrapErrorf(Error(GetNotFoundMessage(resourceType, name)), NotFoundMsg, ProviderERROR)
+	}
+	if len(ids) > 1 {
+		return 0, WrapError(Error("There are %d %s named %s, please use the ID instead.", len(ids), resourceType, name))
+	}
+	return ids[0], nil
+}
+
+// ascmImportByName returns an importer for the resources whose ID is formatted as <name>:<id>.
+// It also accepts the name alone, and looks up the ID of the resource by it.
+func ascmImportByName(lookup func(s *AscmService, name string) (int, error)) schema.StateContextFunc {
+	return func(ctx context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+		if strings.Contains(d.Id(), COLON_SEPARATED) {
+			if _, err := ParseResourceId(d.Id(), 2); err != nil {
+				return nil, WrapError(err)
+			}
+			return []*schema.ResourceData{d}, nil
+		}
+		ascmService := &AscmService{meta.(*connectivity.AlibabacloudStackClient)}
+		id, err := lookup(ascmService, d.Id())
+		if err != nil {
+			return nil, WrapError(err)
+		}
+		path := strings.Split(strings.Trim(d.Id(), "/"), "/")
+		d.SetId(path[len(path)-1] + COLON_SEPARATED + strconv.Itoa(id))
+		return []*schema.ResourceData{d}, nil
+	}
+}
+
+// ascmImportUserGroupId returns an importer for the resources whose ID is the user group ID,
+// which also accepts the user group name.
+func ascmImportUserGroupId() schema.StateContextFunc {
+	return func(ctx context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+		if _, err := strconv.Atoi(d.Id()); err == nil {
+			return []*schema.ResourceData{d}, nil
+		}
+		ascmService := &AscmService{meta.(*connectivity.AlibabacloudStackClient)}
+		id, err := ascmService.DescribeAscmUserGroupIdByName(d.Id())
+		if err != nil {
+			return nil, WrapError(err)
+		}
+		d.SetId(strconv.Itoa(id))
+		return []*schema.ResourceData{d}, nil
+	}
+}
@@ -2,6 +2,7 @@ package alibabacloudstack
 
 import (
 	"encoding/json"
+	"fmt"
 	"log"
 	"strings"
 
@@ -195,3 +196,21 @@ func (s *BucketVpcService) UnBindBucket(vpcId string, bucket string) error {
 	}
 	return nil
 }
+
+func (s *BucketVpcService) DescribeOssBucketVpcAttachment(id string) (object map[string]interface{}, err error) {
+	parts, err := ParseResourceId(id, 2)
+	if err != nil {
+		return object, WrapError(err)
+	}
+	vpclist, err := s.BucketVpcList(parts[0])
+	if err != nil {
+		return object, WrapError(err)
+	}
+	for _, v := range vpclist.VpcList {
+		vpc, ok := v.(map[string]interface{})
+		if ok && fmt.Sprint(vpc["vpcId"]) == parts[1] {
+			return vpc, nil
+		}
+	}
+	return object, WrapErrorf(Error(GetNotFoundMessage("OssBucketVpcAttachment", id)), NotFoundMsg, ProviderERROR)
+}
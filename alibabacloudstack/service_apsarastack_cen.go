@@ -1,5 +1,283 @@
 package alibabacloudstack
 
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/PaesslerAG/jsonpath"
+	util "github.com/alibabacloud-go/tea-utils/service"
+	"github.com/aliyun/terraform-provider-alibabacloudstack/alibabacloudstack/connectivity"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
 const ChildInstanceTypeVpc = "VPC"
 const ChildInstanceTypeVbr = "VBR"
 const ChildInstanceTypeCcn = "CCN"
+
+type CenService struct {
+	client *connectivity.AlibabacloudStackClient
+}
+
+// DoCenRequest invokes the action of the cbn api. It retries the request when it is throttled or the cen
+// instance is busy with another operation, which happens when several children are attached at the same time.
+func (s *CenService) DoCenRequest(ctx context.Context, action string, request map[string]interface{}, timeout time.Duration) (response map[string]interface{}, err error) {
+	conn, err := s.client.NewCenClient()
+	if err != nil {
+		return nil, WrapError(err)
+	}
+	request["RegionId"] = s.client.RegionId
+	request["Product"] = "Cbn"
+	request["OrganizationId"] = s.client.Department
+	runtime := util.RuntimeOptions{}
+	runtime.SetAutoretry(true)
+	wait := incrementalWait(3*time.Second, 3*time.Second)
+	err = resource.RetryContext(ctx, timeout, func() *resource.RetryError {
+		response, err = conn.DoRequest(StringPointer(action), nil, StringPointer("POST"), StringPointer("2017-09-12"), StringPointer("AK"), nil, request, &runtime)
+		if err != nil {
+			if NeedRetry(err) || IsExpectedErrors(err, []string{"Operation.Blocking", "InvalidOperation.CenInstanceStatus", "InvalidStatus.Resource", "IncorrectStatus.cbnStatus"}) {
+				wait()
+				return resource.RetryableError(err)
+			}
+			return resource.NonRetryableError(err)
+		}
+		return nil
+	})
+	addDebug(action, response, request)
+	return response, err
+}
+
+// describeCenPages returns the objects of all pages of the describe action at the path of the response. The describe
+// methods of the resources read with the stop context of the client, because the checks of the tests call them with the id only.
+func (s *CenService) describeCenPages(ctx context.Context, action string, request map[string]interface{}, path string) ([]interface{}, error) {
+	request["PageSize"] = PageSizeLarge
+	request["PageNumber"] = 1
+	var objects []interface{}
+	for {
+		response, err := s.DoCenRequest(ctx, action, request, 5*time.Minute)
+		if err != nil {
+			return nil, err
+		}
+		v, err := jsonpath.Get(path, response)
+		if err != nil {
+			return nil, WrapErrorf(err, FailedGetAttributeMsg, action, path, response)
+		}
+		result, _ := v.([]interface{})
+		objects = append(objects, result...)
+		if len(result) < PageSizeLarge {
+			break
+		}
+		request["PageNumber"] = request["PageNumber"].(int) + 1
+	}
+	return objects, nil
+}
+
+func (s *CenService) DescribeCenInstance(id string) (object map[string]interface{}, err error) {
+	action := "DescribeCens"
+	request := map[string]interface{}{
+		"Filter.1.Key":     "CenId",
+		"Filter.1.Value.1": id,
+	}
+	objects, err := s.describeCenPages(s.client.StopContext(), action, request, "$.Cens.Cen")
+	if err != nil {
+		return nil, WrapErrorf(err, DefaultErrorMsg, id, action, AlibabacloudStackSdkGoERROR)
+	}
+	for _, v := range objects {
+		if item := v.(map[string]interface{}); fmt.Sprint(item["CenId"]) == id {
+			return item, nil
+		}
+	}
+	return nil, WrapErrorf(Error(GetNotFoundMessage("Cen Instance", id)), NotFoundMsg, ProviderERROR)
+}
+
+func (s *CenService) CenInstanceStateRefreshFunc(id string, failStates []string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		object, err := s.DescribeCenInstance(id)
+		if err != nil {
+			if NotFoundError(err) {
+				return nil, "", nil
+			}
+			return nil, "", WrapError(err)
+		}
+		for _, failState := range failStates {
+			if fmt.Sprint(object["Status"]) == failState {
+				return object, fmt.Sprint(object["Status"]), WrapError(Error(FailedToReachTargetStatus, fmt.Sprint(object["Status"])))
+			}
+		}
+		return object, fmt.Sprint(object["Status"]), nil
+	}
+}
+
+// DescribeCenAttachedChildInstances returns the children attached to the cen instance.
+func (s *CenService) DescribeCenAttachedChildInstances(cenId string, request map[string]interface{}) ([]interface{}, error) {
+	action := "DescribeCenAttachedChildInstances"
+	request["CenId"] = cenId
+	objects, err := s.describeCenPages(s.client.StopContext(), action, request, "$.ChildInstances.ChildInstance")
+	if err != nil {
+		if IsExpectedErrors(err, []string{"ParameterCenInstanceId", "Instance.NotExist"}) {
+			return nil, WrapErrorf(Error(GetNotFoundMessage("Cen Instance", cenId)), NotFoundMsg, ProviderERROR)
+		}
+		return nil, WrapErrorf(err, DefaultErrorMsg, cenId, action, AlibabacloudStackSdkGoERROR)
+	}
+	return objects, nil
+}
+
+func (s *CenService) DescribeCenInstanceAttachment(id string) (object map[string]interface{}, err error) {
+	parts, err := ParseResourceId(id, 2)
+	if err != nil {
+		return nil, WrapError(err)
+	}
+	objects, err := s.DescribeCenAttachedChildInstances(parts[0], map[string]interface{}{})
+	if err != nil {
+		return nil, WrapError(err)
+	}
+	for _, v := range objects {
+		if item := v.(map[string]interface{}); fmt.Sprint(item["ChildInstanceId"]) == parts[1] {
+			return item, nil
+		}
+	}
+	return nil, WrapErrorf(Error(GetNotFoundMessage("Cen Instance Attachment", id)), NotFoundMsg, ProviderERROR)
+}
+
+func (s *CenService) CenInstanceAttachmentStateRefreshFunc(id string, failStates []string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		object, err := s.DescribeCenInstanceAttachment(id)
+		if err != nil {
+			if NotFoundError(err) {
+				return nil, "", nil
+			}
+			return nil, "", WrapError(err)
+		}
+		for _, failState := range failStates {
+			if fmt.Sprint(object["Status"]) == failState {
+				return object, fmt.Sprint(object["Status"]), WrapError(Error(FailedToReachTargetStatus, fmt.Sprint(object["Status"])))
+			}
+		}
+		return object, fmt.Sprint(object["Status"]), nil
+	}
+}
+
+// cenRouteTableChild returns the request parameters of the vpc which owns the route table,
+// which are required by the actions of the published route entries.
+func (s *CenService) cenRouteTableChild(cenId, routeTableId string) (map[string]interface{}, error) {
+	vpcService := VpcService{s.client}
+	table, err := vpcService.DescribeRouteTable(routeTableId)
+	if err != nil {
+		return nil, WrapError(err)
+	}
+	return map[string]interface{}{
+		"CenId":                     cenId,
+		"ChildInstanceId":           table.VpcId,
+		"ChildInstanceType":         ChildInstanceTypeVpc,
+		"ChildInstanceRegionId":     s.client.RegionId,
+		"ChildInstanceRouteTableId": routeTableId,
+	}, nil
+}
+
+// DescribeCenPublishedRouteEntries returns the route entries of the route table which can be published to the cen instance.
+func (s *CenService) DescribeCenPublishedRouteEntries(cenId, routeTableId string) ([]interface{}, error) {
+	action := "DescribePublishedRouteEntries"
+	request, err := s.cenRouteTableChild(cenId, routeTableId)
+	if err != nil {
+		return nil, WrapError(err)
+	}
+	objects, err := s.describeCenPages(s.client.StopContext(), action, request, "$.PublishedRouteEntries.PublishedRouteEntry")
+	if err != nil {
+		if IsExpectedErrors(err, []string{"ParameterCenInstanceId", "Instance.NotExist"}) {
+			return nil, WrapErrorf(Error(GetNotFoundMessage("Cen Instance", cenId)), NotFoundMsg, ProviderERROR)
+		}
+		return nil, WrapErrorf(err, DefaultErrorMsg, cenId, action, AlibabacloudStackSdkGoERROR)
+	}
+	return objects, nil
+}
+
+func (s *CenService) DescribeCenRouteEntry(id string) (object map[string]interface{}, err error) {
+	parts, err := ParseResourceId(id, 3)
+	if err != nil {
+		return nil, WrapError(err)
+	}
+	objects, err := s.DescribeCenPublishedRouteEntries(parts[0], parts[1])
+	if err != nil {
+		return nil, WrapError(err)
+	}
+	for _, v := range objects {
+		item := v.(map[string]interface{})
+		if fmt.Sprint(item["DestinationCidrBlock"]) != parts[2] {
+			continue
+		}
+		// The entry which is not published is only a candidate of the route table.
+		if fmt.Sprint(item["PublishStatus"]) == "NonPublished" {
+			break
+		}
+		return item, nil
+	}
+	return nil, WrapErrorf(Error(GetNotFoundMessage("Cen Route Entry", id)), NotFoundMsg, ProviderERROR)
+}
+
+func (s *CenService) CenRouteEntryStateRefreshFunc(id string, failStates []string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		object, err := s.DescribeCenRouteEntry(id)
+		if err != nil {
+			if NotFoundError(err) {
+				return nil, "", nil
+			}
+			return nil, "", WrapError(err)
+		}
+		for _, failState := range failStates {
+			if fmt.Sprint(object["PublishStatus"]) == failState {
+				return object, fmt.Sprint(object["PublishStatus"]), WrapError(Error(FailedToReachTargetStatus, fmt.Sprint(object["PublishStatus"])))
+			}
+		}
+		return object, fmt.Sprint(object["PublishStatus"]), nil
+	}
+}
+
+func (s *CenService) DescribeCenBandwidthPackage(id string) (object map[string]interface{}, err error) {
+	action := "DescribeCenBandwidthPackages"
+	request := map[string]interface{}{
+		"Filter.1.Key":     "CenBandwidthPackageId",
+		"Filter.1.Value.1": id,
+	}
+	objects, err := s.describeCenPages(s.client.StopContext(), action, request, "$.CenBandwidthPackages.CenBandwidthPackage")
+	if err != nil {
+		return nil, WrapErrorf(err, DefaultErrorMsg, id, action, AlibabacloudStackSdkGoERROR)
+	}
+	for _, v := range objects {
+		if item := v.(map[string]interface{}); fmt.Sprint(item["CenBandwidthPackageId"]) == id {
+			return item, nil
+		}
+	}
+	return nil, WrapErrorf(Error(GetNotFoundMessage("Cen Bandwidth Package", id)), NotFoundMsg, ProviderERROR)
+}
+
+func (s *CenService) CenBandwidthPackageStateRefreshFunc(id string, failStates []string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		object, err := s.DescribeCenBandwidthPackage(id)
+		if err != nil {
+			if NotFoundError(err) {
+				return nil, "", nil
+			}
+			return nil, "", WrapError(err)
+		}
+		for _, failState := range failStates {
+			if fmt.Sprint(object["Status"]) == failState {
+				return object, fmt.Sprint(object["Status"]), WrapError(Error(FailedToReachTargetStatus, fmt.Sprint(object["Status"])))
+			}
+		}
+		return object, fmt.Sprint(object["Status"]), nil
+	}
+}
+
+// cenStringList converts a list of the response like {"CenId": ["cen-xxx"]} to the strings.
+func cenStringList(object interface{}, key string) []string {
+	result := make([]string, 0)
+	m, ok := object.(map[string]interface{})
+	if !ok {
+		return result
+	}
+	values, _ := m[key].([]interface{})
+	for _, v := range values {
+		result = append(result, fmt.Sprint(v))
+	}
+	return result
+}
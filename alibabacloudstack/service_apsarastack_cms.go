@@ -51,7 +51,7 @@ func (s *CmsService) DescribeCmsAlarm(id string) (alarm cms.Alarm, err error) {
 
 	wait := incrementalWait(3*time.Second, 5*time.Second)
 	var response *cms.DescribeMetricRuleListResponse
-	err = resource.Retry(10*time.Minute, func() *resource.RetryError {
+	err = resource.RetryContext(s.client.StopContext(), 10*time.Minute, func() *resource.RetryError {
 		raw, err := s.client.WithCmsClient(func(cmsClient *cms.Client) (interface{}, error) {
 			return cmsClient.DescribeMetricRuleList(request)
 		})
@@ -1,8 +1,13 @@
 package alibabacloudstack
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
+	roacs "github.com/alibabacloud-go/cs-20151215/v2/client"
+	openapi "github.com/alibabacloud-go/darabonba-openapi/client"
+	util "github.com/alibabacloud-go/tea-utils/service"
+	"github.com/alibabacloud-go/tea/tea"
 	"github.com/aliyun/alibaba-cloud-sdk-go/sdk/requests"
 	"github.com/aliyun/alibaba-cloud-sdk-go/sdk/responses"
 	"github.com/aliyun/alibaba-cloud-sdk-go/services/ecs"
@@ -10,7 +15,9 @@ import (
 	"github.com/denverdino/aliyungo/common"
 	"github.com/denverdino/aliyungo/cs"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"log"
+	"strconv"
 	"strings"
 	"time"
 )
@@ -318,7 +325,7 @@ func (s *CsService) DescribeCsKubernetesNodePool(id string) (nodePool *cs.NodePo
 	}
 	return
 }
-func (s *CsService) UpgradeCluster(clusterId string, args *cs.UpgradeClusterArgs) error {
+func (s *CsService) UpgradeCluster(ctx context.Context, clusterId string, args *cs.UpgradeClusterArgs) error {
 	invoker := NewInvoker()
 	err := invoker.Run(func() error {
 		_, e := s.client.WithCsClient(func(csClient *cs.Client) (interface{}, error) {
@@ -334,7 +341,7 @@ func (s *CsService) UpgradeCluster(clusterId string, args *cs.UpgradeClusterArgs
 		return WrapError(err)
 	}
 
-	state, upgradeError := s.WaitForUpgradeCluster(clusterId, "Upgrade")
+	state, upgradeError := s.WaitForUpgradeCluster(ctx, clusterId, "Upgrade")
 	if state == cs.Task_Status_Success && upgradeError == nil {
 		return nil
 	}
@@ -353,15 +360,15 @@ func (s *CsService) UpgradeCluster(clusterId string, args *cs.UpgradeClusterArgs
 		return WrapError(upgradeError)
 	}
 
-	if state, err := s.WaitForUpgradeCluster(clusterId, "CancelUpgrade"); err != nil || state != cs.Task_Status_Success {
+	if state, err := s.WaitForUpgradeCluster(ctx, clusterId, "CancelUpgrade"); err != nil || state != cs.Task_Status_Success {
 		log.Printf("[WARN] %s ACK Cluster cancel upgrade error: %#v", clusterId, err)
 	}
 
 	return WrapError(upgradeError)
 }
 
-func (s *CsService) WaitForUpgradeCluster(clusterId string, action string) (string, error) {
-	err := resource.Retry(UpgradeClusterTimeout, func() *resource.RetryError {
+func (s *CsService) WaitForUpgradeCluster(ctx context.Context, clusterId string, action string) (string, error) {
+	err := resource.RetryContext(ctx, UpgradeClusterTimeout, func() *resource.RetryError {
 		resp, err := s.client.WithCsClient(func(csClient *cs.Client) (interface{}, error) {
 			return csClient.QueryUpgradeClusterResult(clusterId)
 		})
@@ -605,6 +612,7 @@ type NodePool struct {
 			ScalingPolicy                    string        `json:"scaling_policy"`
 		} `json:"scaling_group"`
 		KubernetesConfig struct {
+			KubernetesVersion string `json:"kubernetes_version"`
 			RuntimeVersion    string `json:"runtime_version"`
 			CPUPolicy         string `json:"cpu_policy"`
 			CmsEnabled        bool   `json:"cms_enabled"`
@@ -767,3 +775,336 @@ type ClustersV1 struct {
 		ResourceGroupName  string    `json:"ResourceGroupName"`
 	} `json:"clusters"`
 }
+
+type CsTagResources struct {
+	TagResources struct {
+		TagResource []struct {
+			ResourceId   string `json:"resource_id"`
+			ResourceType string `json:"resource_type"`
+			TagKey       string `json:"tag_key"`
+			TagValue     string `json:"tag_value"`
+		} `json:"tag_resource"`
+	} `json:"tag_resources"`
+}
+
+func (s *CsService) processTagRequest(action string, params map[string]string) (*responses.CommonResponse, error) {
+	req := requests.NewCommonRequest()
+	if s.client.Config.Insecure {
+		req.SetHTTPSInsecure(s.client.Config.Insecure)
+	}
+	req.QueryParams = map[string]string{
+		"RegionId":         s.client.RegionId,
+		"AccessKeySecret":  s.client.SecretKey,
+		"Product":          "CS",
+		"Department":       s.client.Department,
+		"ResourceGroup":    s.client.ResourceGroup,
+		"Action":           action,
+		"AccountInfo":      "123456",
+		"Version":          "2015-12-15",
+		"SignatureVersion": "1.0",
+		"ProductName":      "cs",
+	}
+	for key, value := range params {
+		req.QueryParams[key] = value
+	}
+	req.Method = "POST"
+	req.Product = "CS"
+	req.Version = "2015-12-15"
+	req.ServiceCode = "cs"
+	if strings.ToLower(s.client.Config.Protocol) == "https" {
+		req.Scheme = "https"
+	} else {
+		req.Scheme = "http"
+	}
+	req.ApiName = action
+	req.Headers = map[string]string{"RegionId": s.client.RegionId}
+
+	raw, err := s.client.WithEcsClient(func(ecsClient *ecs.Client) (interface{}, error) {
+		return ecsClient.ProcessCommonRequest(req)
+	})
+	addDebug(action, raw, req)
+	if err != nil {
+		return nil, err
+	}
+	response, _ := raw.(*responses.CommonResponse)
+	if !response.IsSuccess() {
+		return nil, Error(response.GetHttpContentString())
+	}
+	return response, nil
+}
+
+func (s *CsService) ListTagResources(id string, resourceType TagResourceType) (tags map[string]string, err error) {
+	resourceIds, _ := json.Marshal([]string{id})
+	response, err := s.processTagRequest("ListTagResources", map[string]string{
+		"region_id":     s.client.RegionId,
+		"resource_ids":  string(resourceIds),
+		"resource_type": strings.ToUpper(string(resourceType)),
+	})
+	if err != nil {
+		return nil, WrapErrorf(err, DefaultErrorMsg, id, "ListTagResources", AlibabacloudStackSdkGoERROR)
+	}
+	var object CsTagResources
+	if err := json.Unmarshal(response.GetHttpContentBytes(), &object); err != nil {
+		return nil, WrapError(err)
+	}
+	tags = make(map[string]string)
+	for _, t := range object.TagResources.TagResource {
+		if !ignoredTags(t.TagKey, t.TagValue) {
+			tags[t.TagKey] = t.TagValue
+		}
+	}
+	return tags, nil
+}
+
+func (s *CsService) SetResourceTags(d *schema.ResourceData, resourceType TagResourceType) error {
+	if hasTagsAllChange(d, s.client) {
+		added, removed := parsingTags(d, s.client)
+		resourceIds, _ := json.Marshal([]string{d.Id()})
+
+		removedTagKeys := make([]string, 0)
+		for _, v := range removed {
+			if !ignoredTags(v, "") {
+				removedTagKeys = append(removedTagKeys, v)
+			}
+		}
+		if len(removedTagKeys) > 0 {
+			tagKeys, _ := json.Marshal(removedTagKeys)
+			if _, err := s.processTagRequest("UntagResources", map[string]string{
+				"region_id":     s.client.RegionId,
+				"resource_ids":  string(resourceIds),
+				"resource_type": strings.ToUpper(string(resourceType)),
+				"tag_keys":      string(tagKeys),
+			}); err != nil {
+				return WrapErrorf(err, DefaultErrorMsg, d.Id(), "UntagResources", AlibabacloudStackSdkGoERROR)
+			}
+		}
+		if len(added) > 0 {
+			tags := make([]cs.Tag, 0, len(added))
+			for key, value := range added {
+				tags = append(tags, cs.Tag{Key: key, Value: value.(string)})
+			}
+			body, err := json.Marshal(map[string]interface{}{
+				"region_id":     s.client.RegionId,
+				"resource_ids":  []string{d.Id()},
+				"resource_type": strings.ToUpper(string(resourceType)),
+				"tags":          tags,
+			})
+			if err != nil {
+				return WrapError(err)
+			}
+			if _, err := s.processTagRequest("TagResources", map[string]string{"X-acs-body": string(body)}); err != nil {
+				return WrapErrorf(err, DefaultErrorMsg, d.Id(), "TagResources", AlibabacloudStackSdkGoERROR)
+			}
+		}
+	}
+	return nil
+}
+
+func (s *CsService) DescribeCsClusterUserKubeconfig(clusterId string, privateIpAddress bool, temporaryDurationMinutes int) (*roacs.DescribeClusterUserKubeconfigResponseBody, error) {
+	client, err := s.client.NewRoaCsClient()
+	if err != nil {
+		return nil, WrapErrorf(err, DefaultErrorMsg, clusterId, "InitializeClient", err)
+	}
+	request := &roacs.DescribeClusterUserKubeconfigRequest{
+		PrivateIpAddress: tea.Bool(privateIpAddress),
+	}
+	if temporaryDurationMinutes > 0 {
+		request.TemporaryDurationMinutes = tea.Int64(int64(temporaryDurationMinutes))
+	}
+	invoker := NewInvoker()
+	var response *roacs.DescribeClusterUserKubeconfigResponse
+	if err := invoker.Run(func() error {
+		response, err = client.DescribeClusterUserKubeconfig(tea.String(clusterId), request)
+		return err
+	}); err != nil {
+		if IsExpectedErrors(err, []string{"ErrorClusterNotFound"}) {
+			return nil, WrapErrorf(err, NotFoundMsg, AlibabacloudStackSdkGoERROR)
+		}
+		return nil, WrapErrorf(err, DefaultErrorMsg, clusterId, "DescribeClusterUserKubeconfig", AlibabacloudStackSdkGoERROR)
+	}
+	addDebug("DescribeClusterUserKubeconfig", response, request)
+	if response == nil || response.Body == nil || tea.StringValue(response.Body.Config) == "" {
+		return nil, WrapErrorf(Error(GetNotFoundMessage("CsClusterUserKubeconfig", clusterId)), NotFoundMsg, ProviderERROR)
+	}
+	return response.Body, nil
+}
+
+// doCsRoaRequest calls the ROA apis of the CS which are not provided by the sdk yet, and returns the body of the response.
+func (s *CsService) doCsRoaRequest(action, method, pathname, bodyType string, body map[string]interface{}) (map[string]interface{}, error) {
+	client, err := s.client.NewRoaCsClient()
+	if err != nil {
+		return nil, WrapErrorf(err, DefaultErrorMsg, pathname, "InitializeClient", err)
+	}
+	request := &openapi.OpenApiRequest{
+		Headers: map[string]*string{},
+	}
+	if body != nil {
+		request.Body = body
+	}
+	invoker := NewInvoker()
+	var response map[string]interface{}
+	if err := invoker.Run(func() error {
+		// The protocol of the client is HTTP when the requests go through the local tea proxy.
+		response, err = client.DoROARequest(tea.String(action), tea.String("2015-12-15"), client.Protocol, tea.String(method), tea.String("AK"), tea.String(pathname), tea.String(bodyType), request, &util.RuntimeOptions{})
+		return err
+	}); err != nil {
+		return nil, WrapErrorf(err, DefaultErrorMsg, pathname, action, AlibabacloudStackSdkGoERROR)
+	}
+	addDebug(action, response, body)
+	if v, ok := response["body"].(map[string]interface{}); ok {
+		return v, nil
+	}
+	return map[string]interface{}{}, nil
+}
+
+func (s *CsService) CsKubernetesUpgradeStateRefreshFunc(clusterId string, failStates []string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		client, err := s.client.NewRoaCsClient()
+		if err != nil {
+			return nil, "", WrapErrorf(err, DefaultErrorMsg, clusterId, "InitializeClient", err)
+		}
+		response, err := client.GetUpgradeStatus(tea.String(clusterId))
+		if err != nil {
+			return nil, "", WrapErrorf(err, DefaultErrorMsg, clusterId, "GetUpgradeStatus", AlibabacloudStackSdkGoERROR)
+		}
+		object := response.Body
+		if object == nil {
+			object = &roacs.GetUpgradeStatusResponseBody{}
+		}
+		state, message := tea.StringValue(object.Status), tea.StringValue(object.ErrorMessage)
+		// The upgrade stops in the pause state when the task fails.
+		if object.UpgradeTask != nil && tea.StringValue(object.UpgradeTask.Status) == "failed" {
+			state = "failed"
+			if message == "" {
+				message = tea.StringValue(object.UpgradeTask.Message)
+			}
+		}
+		for _, failState := range failStates {
+			if state == failState {
+				return object, state, WrapError(Error(FailedToReachTargetStatus, fmt.Sprintf("%s: %s", state, message)))
+			}
+		}
+		return object, state, nil
+	}
+}
+
+func (s *CsService) CsKubernetesTaskStateRefreshFunc(taskId string, failStates []string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		client, err := s.client.NewRoaCsClient()
+		if err != nil {
+			return nil, "", WrapErrorf(err, DefaultErrorMsg, taskId, "InitializeClient", err)
+		}
+		response, err := client.DescribeTaskInfo(tea.String(taskId))
+		if err != nil {
+			return nil, "", WrapErrorf(err, DefaultErrorMsg, taskId, "DescribeTaskInfo", AlibabacloudStackSdkGoERROR)
+		}
+		object := response.Body
+		if object == nil {
+			object = &roacs.DescribeTaskInfoResponseBody{}
+		}
+		state := tea.StringValue(object.State)
+		for _, failState := range failStates {
+			if state == failState {
+				return object, state, WrapError(Error(FailedToReachTargetStatus, state))
+			}
+		}
+		return object, state, nil
+	}
+}
+
+// UpgradeCsKubernetesControlPlane upgrades the masters of the cluster to the next version. The failed upgrade is resumed
+// once, and then it is left paused so that it can be resumed by the next call after the cause is fixed.
+func (s *CsService) UpgradeCsKubernetesControlPlane(ctx context.Context, clusterId, version, nextVersion string, timeout time.Duration) error {
+	client, err := s.client.NewRoaCsClient()
+	if err != nil {
+		return WrapErrorf(err, DefaultErrorMsg, clusterId, "InitializeClient", err)
+	}
+	status, err := client.GetUpgradeStatus(tea.String(clusterId))
+	if err != nil {
+		return WrapErrorf(err, DefaultErrorMsg, clusterId, "GetUpgradeStatus", AlibabacloudStackSdkGoERROR)
+	}
+	if status.Body != nil && tea.StringValue(status.Body.Status) == "pause" {
+		log.Printf("[INFO] Resuming the paused upgrade of the ACK Cluster %s", clusterId)
+		if _, err := client.ResumeUpgradeCluster(tea.String(clusterId)); err != nil {
+			return WrapErrorf(err, DefaultErrorMsg, clusterId, "ResumeUpgradeCluster", AlibabacloudStackSdkGoERROR)
+		}
+	} else {
+		request := &roacs.UpgradeClusterRequest{
+			ComponentName: tea.String("k8s"),
+			Version:       tea.String(version),
+			NextVersion:   tea.String(nextVersion),
+		}
+		response, err := client.UpgradeCluster(tea.String(clusterId), request)
+		if err != nil {
+			return WrapErrorf(err, DefaultErrorMsg, clusterId, "UpgradeCluster", AlibabacloudStackSdkGoERROR)
+		}
+		addDebug("UpgradeCluster", response, request)
+	}
+
+	stateConf := BuildStateConf([]string{"", "running"}, []string{"success"}, timeout, 30*time.Second, s.CsKubernetesUpgradeStateRefreshFunc(clusterId, []string{"fail", "failed"}))
+	if _, err = stateConf.WaitForStateContext(ctx); err == nil {
+		return nil
+	}
+	log.Printf("[WARN] The upgrade of the ACK Cluster %s failed, and it is resumed once: %s", clusterId, err)
+	if _, e := client.ResumeUpgradeCluster(tea.String(clusterId)); e == nil {
+		if _, err = stateConf.WaitForStateContext(ctx); err == nil {
+			return nil
+		}
+	}
+	if _, e := client.PauseClusterUpgrade(tea.String(clusterId)); e != nil {
+		log.Printf("[WARN] Pausing the upgrade of the ACK Cluster %s failed: %s", clusterId, e)
+	}
+	return WrapErrorf(err, IdMsg+" The upgrade is paused, and it is resumed by the next apply.", clusterId)
+}
+
+// UpgradeCsKubernetesNodePool upgrades the nodes of the node pool to the version of the control plane. The failed task is
+// resumed once, and then it is left paused with its id in the error.
+func (s *CsService) UpgradeCsKubernetesNodePool(ctx context.Context, clusterId, nodePoolId, version string, timeout time.Duration) error {
+	body, err := s.doCsRoaRequest("UpgradeClusterNodepool", "POST", fmt.Sprintf("/clusters/%s/nodepools/%s/upgrade", clusterId, nodePoolId), "json", map[string]interface{}{
+		"kubernetes_version": version,
+	})
+	if err != nil {
+		return WrapError(err)
+	}
+	taskId := fmt.Sprint(body["task_id"])
+	if v, ok := body["task_id"]; !ok || v == nil || taskId == "" {
+		return WrapError(Error("The task of upgrading the node pool %s of the cluster %s is not returned.", nodePoolId, clusterId))
+	}
+
+	stateConf := BuildStateConf([]string{"", "running", "pending"}, []string{"success"}, timeout, 30*time.Second, s.CsKubernetesTaskStateRefreshFunc(taskId, []string{"fail", "failed"}))
+	if _, err = stateConf.WaitForStateContext(ctx); err == nil {
+		return nil
+	}
+	log.Printf("[WARN] The upgrade task %s of the node pool %s failed, and it is resumed once: %s", taskId, nodePoolId, err)
+	if _, e := s.doCsRoaRequest("ResumeTask", "POST", fmt.Sprintf("/tasks/%s/resume", taskId), "none", nil); e == nil {
+		if _, err = stateConf.WaitForStateContext(ctx); err == nil {
+			return nil
+		}
+	}
+	if _, e := s.doCsRoaRequest("PauseTask", "POST", fmt.Sprintf("/tasks/%s/pause", taskId), "none", nil); e != nil {
+		log.Printf("[WARN] Pausing the upgrade task %s of the node pool %s failed: %s", taskId, nodePoolId, e)
+	}
+	return WrapErrorf(err, IdMsg+" The upgrade task %s is paused.", fmt.Sprintf("%s%s%s", clusterId, COLON_SEPARATED, nodePoolId), taskId)
+}
+
+// compareCsKubernetesVersion compares the versions like 1.20.11-aliyun.1 by the numbers before the suffix.
+func compareCsKubernetesVersion(v1, v2 string) int {
+	parts1 := strings.Split(strings.SplitN(strings.TrimPrefix(v1, "v"), "-", 2)[0], ".")
+	parts2 := strings.Split(strings.SplitN(strings.TrimPrefix(v2, "v"), "-", 2)[0], ".")
+	for i := 0; i < len(parts1) || i < len(parts2); i++ {
+		var n1, n2 int
+		if i < len(parts1) {
+			n1, _ = strconv.Atoi(parts1[i])
+		}
+		if i < len(parts2) {
+			n2, _ = strconv.Atoi(parts2[i])
+		}
+		if n1 != n2 {
+			if n1 < n2 {
+				return -1
+			}
+			return 1
+		}
+	}
+	return 0
+}
@@ -0,0 +1,79 @@
+package alibabacloudstack
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/aliyun/terraform-provider-alibabacloudstack/alibabacloudstack/mockserver"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func TestCsServiceSetResourceTags(t *testing.T) {
+	server := mockserver.NewServer()
+	defer server.Close()
+	client := testMockClient(t, server)
+	client.Config.DefaultTags = map[string]string{"Environment": "test"}
+	csService := CsService{client}
+
+	r := &schema.Resource{Schema: map[string]*schema.Schema{"tags": tagsSchema()}}
+	withTagsAll(r)
+	d := r.TestResourceData()
+	d.SetId("c-mock-cluster")
+
+	steps := []struct {
+		tags     map[string]interface{}
+		expected map[string]string
+		calls    []string
+	}{
+		{
+			tags:     map[string]interface{}{"Created": "TF", "For": "acceptance"},
+			expected: map[string]string{"Created": "TF", "For": "acceptance", "Environment": "test"},
+			calls:    []string{"cs:TagResources"},
+		},
+		{
+			// The changed tag is removed and added again, and the removed tag is only removed.
+			tags:     map[string]interface{}{"Created": "TF-update"},
+			expected: map[string]string{"Created": "TF-update", "Environment": "test"},
+			calls:    []string{"cs:UntagResources", "cs:TagResources"},
+		},
+		{
+			tags:     map[string]interface{}{"Created": "TF-update"},
+			expected: map[string]string{"Created": "TF-update", "Environment": "test"},
+		},
+		{
+			// The provider default tags are kept when the resource tags are all removed.
+			tags:     map[string]interface{}{},
+			expected: map[string]string{"Environment": "test"},
+			calls:    []string{"cs:UntagResources", "cs:TagResources"},
+		},
+	}
+	for i, step := range steps {
+		d = r.Data(d.State())
+		d.Set("tags", step.tags)
+		before := len(server.Calls())
+		if err := csService.SetResourceTags(d, TagResourceCluster); err != nil {
+			t.Fatalf("step %d: setting the tags got an error: %v", i, err)
+		}
+		if calls := server.Calls()[before:]; len(calls) != len(step.calls) || (len(calls) > 0 && !reflect.DeepEqual(calls, step.calls)) {
+			t.Fatalf("step %d: expected the calls %v, got %v", i, step.calls, calls)
+		}
+		if tags := server.Tags(d.Id()); !reflect.DeepEqual(tags, step.expected) {
+			t.Fatalf("step %d: expected the tags %v to be applied, got %v", i, step.expected, tags)
+		}
+
+		tags, err := csService.ListTagResources(d.Id(), TagResourceCluster)
+		if err != nil {
+			t.Fatalf("step %d: listing the tags got an error: %v", i, err)
+		}
+		d.Set("tags", tags)
+		if err := setTagsAll(d, client, step.tags); err != nil {
+			t.Fatal(err)
+		}
+		if tags := d.Get("tags").(map[string]interface{}); !reflect.DeepEqual(tags, step.tags) {
+			t.Fatalf("step %d: expected the tags %v to be read, got %v", i, step.tags, tags)
+		}
+		if tagsAll := d.Get("tags_all").(map[string]interface{}); len(tagsAll) != len(step.expected) {
+			t.Fatalf("step %d: expected the tags_all %v to be read, got %v", i, step.expected, tagsAll)
+		}
+	}
+}
@@ -3,6 +3,7 @@ package alibabacloudstack
 import (
 	"github.com/aliyun/alibaba-cloud-sdk-go/sdk/requests"
 	"github.com/aliyun/alibaba-cloud-sdk-go/services/ecs"
+	"strconv"
 	"strings"
 	"time"
 
@@ -280,6 +281,86 @@ func (s *DatahubService) WaitForDatahubTopic(id string, status Status, timeout i
 	}
 }
 
+// doDatahubCommonRequest sends the datahub api through the common request and decodes the response into the result.
+func (s *DatahubService) doDatahubCommonRequest(action string, params map[string]string, result interface{}) error {
+	request := requests.NewCommonRequest()
+	request.Method = "GET"
+	request.Product = "datahub"
+	request.Version = "2019-11-20"
+	if strings.ToLower(s.client.Config.Protocol) == "https" {
+		request.Scheme = "https"
+	} else {
+		request.Scheme = "http"
+	}
+	request.ApiName = action
+	request.Headers = map[string]string{
+		"RegionId":              s.client.RegionId,
+		"x-acs-resourcegroupid": s.client.ResourceGroup,
+		"x-acs-regionid":        s.client.RegionId,
+		"x-acs-organizationid":  s.client.Department,
+	}
+	request.QueryParams = map[string]string{
+		"AccessKeySecret": s.client.SecretKey,
+		"AccessKeyId":     s.client.AccessKey,
+		"Product":         "datahub",
+		"Department":      s.client.Department,
+		"ResourceGroup":   s.client.ResourceGroup,
+		"RegionId":        s.client.RegionId,
+		"Action":          action,
+		"Version":         "2019-11-20",
+	}
+	for k, v := range params {
+		request.QueryParams[k] = v
+	}
+
+	raw, err := s.client.WithEcsClient(func(dataHubClient *ecs.Client) (interface{}, error) {
+		return dataHubClient.ProcessCommonRequest(request)
+	})
+	if err != nil {
+		return err
+	}
+	addDebug(action, raw, request, params)
+	bresponse, _ := raw.(*responses.CommonResponse)
+	return json.Unmarshal(bresponse.GetHttpContentBytes(), result)
+}
+
+func (s *DatahubService) ListDatahubProjects() ([]string, error) {
+	result := &datahub.ListProjectResult{}
+	if err := s.doDatahubCommonRequest("ListProject", nil, result); err != nil {
+		return nil, WrapErrorf(err, DataDefaultErrorMsg, "alibabacloudstack_datahub_projects", "ListProject", AlibabacloudStackDatahubSdkGo)
+	}
+	return result.ProjectNames, nil
+}
+
+func (s *DatahubService) ListDatahubTopics(projectName string) ([]string, error) {
+	result := &datahub.ListTopicResult{}
+	if err := s.doDatahubCommonRequest("ListTopic", map[string]string{"ProjectName": projectName}, result); err != nil {
+		return nil, WrapErrorf(err, DataDefaultErrorMsg, projectName, "ListTopic", AlibabacloudStackDatahubSdkGo)
+	}
+	return result.TopicNames, nil
+}
+
+func (s *DatahubService) ListDatahubSubscriptions(projectName, topicName string) ([]datahub.SubscriptionEntry, error) {
+	var subscriptions []datahub.SubscriptionEntry
+	for pageIndex := 1; ; pageIndex++ {
+		result := &datahub.ListSubscriptionResult{}
+		params := map[string]string{
+			"ProjectName": projectName,
+			"TopicName":   topicName,
+			"PageIndex":   strconv.Itoa(pageIndex),
+			"PageSize":    strconv.Itoa(PageSizeLarge),
+		}
+		if err := s.doDatahubCommonRequest("ListSubscription", params, result); err != nil {
+			return nil, WrapErrorf(err, DataDefaultErrorMsg, projectName+COLON_SEPARATED+topicName, "ListSubscription", AlibabacloudStackDatahubSdkGo)
+		}
+		subscriptions = append(subscriptions, result.Subscriptions...)
+		if len(result.Subscriptions) < PageSizeLarge || int64(len(subscriptions)) >= result.TotalCount {
+			break
+		}
+	}
+	return subscriptions, nil
+}
+
 func convUint64ToDate(t uint64) string {
 	return time.Unix(int64(t), 0).Format("2006-01-02 15:04:05")
 }
@@ -1,6 +1,7 @@
 package alibabacloudstack
 
 import (
+	"context"
 	"time"
 
 	"github.com/PaesslerAG/jsonpath"
@@ -33,7 +34,7 @@ func (s *DataworksPublicService) DescribeDataWorksFolder(id string) (object map[
 	runtime := util.RuntimeOptions{}
 	runtime.SetAutoretry(true)
 	wait := incrementalWait(3*time.Second, 3*time.Second)
-	err = resource.Retry(5*time.Minute, func() *resource.RetryError {
+	err = resource.RetryContext(s.client.StopContext(), 5*time.Minute, func() *resource.RetryError {
 		response, err = conn.DoRequest(StringPointer(action), nil, StringPointer("POST"), StringPointer("2020-05-18"), StringPointer("AK"), nil, request, &runtime)
 		if err != nil {
 			if NeedRetry(err) {
@@ -79,7 +80,7 @@ func (s *DataworksPublicService) GetFolder(id string) (object map[string]interfa
 	runtime := util.RuntimeOptions{}
 	runtime.SetAutoretry(true)
 	wait := incrementalWait(3*time.Second, 3*time.Second)
-	err = resource.Retry(5*time.Minute, func() *resource.RetryError {
+	err = resource.RetryContext(s.client.StopContext(), 5*time.Minute, func() *resource.RetryError {
 		response, err = conn.DoRequest(StringPointer(action), nil, StringPointer("POST"), StringPointer("2020-05-18"), StringPointer("AK"), nil, request, &runtime)
 		if err != nil {
 			if NeedRetry(err) {
@@ -122,7 +123,7 @@ func (s *DataworksPublicService) DescribeDataWorksConnection(id string) (object
 	runtime := util.RuntimeOptions{}
 	runtime.SetAutoretry(true)
 	wait := incrementalWait(3*time.Second, 3*time.Second)
-	err = resource.Retry(5*time.Minute, func() *resource.RetryError {
+	err = resource.RetryContext(s.client.StopContext(), 5*time.Minute, func() *resource.RetryError {
 		response, err = conn.DoRequest(StringPointer(action), nil, StringPointer("GET"), StringPointer("2020-05-18"), StringPointer("AK"), request, nil, &runtime)
 		if err != nil {
 			if NeedRetry(err) {
@@ -170,7 +171,7 @@ func (s *DataworksPublicService) DescribeDataWorksUser(id string) (object map[st
 	runtime := util.RuntimeOptions{}
 	runtime.SetAutoretry(true)
 	wait := incrementalWait(3*time.Second, 3*time.Second)
-	err = resource.Retry(5*time.Minute, func() *resource.RetryError {
+	err = resource.RetryContext(s.client.StopContext(), 5*time.Minute, func() *resource.RetryError {
 		response, err = conn.DoRequest(StringPointer(action), nil, StringPointer("POST"), StringPointer("2020-05-18"), StringPointer("AK"), request, nil, &runtime)
 		if err != nil {
 			if NeedRetry(err) {
@@ -219,7 +220,7 @@ func (s *DataworksPublicService) DescribeDataWorksUserRoleBinding(id string) (ob
 	runtime := util.RuntimeOptions{}
 	runtime.SetAutoretry(true)
 	wait := incrementalWait(3*time.Second, 3*time.Second)
-	err = resource.Retry(5*time.Minute, func() *resource.RetryError {
+	err = resource.RetryContext(s.client.StopContext(), 5*time.Minute, func() *resource.RetryError {
 		response, err = conn.DoRequest(StringPointer(action), nil, StringPointer("POST"), StringPointer("2020-05-18"), StringPointer("AK"), request, nil, &runtime)
 		if err != nil {
 			if NeedRetry(err) {
@@ -264,7 +265,7 @@ func (s *DataworksPublicService) DescribeDataWorksRemind(id string) (object map[
 	runtime := util.RuntimeOptions{}
 	runtime.SetAutoretry(true)
 	wait := incrementalWait(3*time.Second, 3*time.Second)
-	err = resource.Retry(5*time.Minute, func() *resource.RetryError {
+	err = resource.RetryContext(s.client.StopContext(), 5*time.Minute, func() *resource.RetryError {
 		response, err = conn.DoRequest(StringPointer(action), nil, StringPointer("POST"), StringPointer("2020-05-18"), StringPointer("AK"), request, nil, &runtime)
 		if err != nil {
 			if NeedRetry(err) {
@@ -311,7 +312,7 @@ func (s *DataworksPublicService) DescribeDataWorksProject(id string) (object map
 	runtime := util.RuntimeOptions{}
 	runtime.SetAutoretry(true)
 	wait := incrementalWait(3*time.Second, 3*time.Second)
-	err = resource.Retry(5*time.Minute, func() *resource.RetryError {
+	err = resource.RetryContext(s.client.StopContext(), 5*time.Minute, func() *resource.RetryError {
 		response, err = conn.DoRequest(StringPointer(action), nil, StringPointer("POST"), StringPointer("2020-05-18"), StringPointer("AK"), request, nil, &runtime)
 		if err != nil {
 			if NeedRetry(err) {
@@ -337,3 +338,99 @@ func (s *DataworksPublicService) DescribeDataWorksProject(id string) (object map
 	}
 	return object, nil
 }
+
+func (s *DataworksPublicService) ListDataWorksProjects(ctx context.Context) (objects []map[string]interface{}, err error) {
+	var response map[string]interface{}
+	conn, err := s.client.NewDataworkspublicClient()
+	if err != nil {
+		return nil, WrapError(err)
+	}
+	action := "ListProjects"
+	request := map[string]interface{}{
+		"RegionId":   "default",
+		"PageSize":   PageSizeLarge,
+		"PageNumber": 1,
+	}
+	runtime := util.RuntimeOptions{}
+	runtime.SetAutoretry(true)
+	for {
+		wait := incrementalWait(3*time.Second, 3*time.Second)
+		err = resource.RetryContext(ctx, 5*time.Minute, func() *resource.RetryError {
+			response, err = conn.DoRequest(StringPointer(action), nil, StringPointer("POST"), StringPointer("2020-05-18"), StringPointer("AK"), nil, request, &runtime)
+			if err != nil {
+				if NeedRetry(err) {
+					wait()
+					return resource.RetryableError(err)
+				}
+				return resource.NonRetryableError(err)
+			}
+			return nil
+		})
+		addDebug(action, response, request)
+		if err != nil {
+			return objects, WrapErrorf(err, DefaultErrorMsg, "alibabacloudstack_data_works_projects", action, AlibabacloudStackSdkGoERROR)
+		}
+		v, err := jsonpath.Get("$.PageResult.ProjectList", response)
+		if err != nil {
+			return objects, WrapErrorf(err, FailedGetAttributeMsg, "alibabacloudstack_data_works_projects", "$.PageResult.ProjectList", response)
+		}
+		result, _ := v.([]interface{})
+		for _, item := range result {
+			objects = append(objects, item.(map[string]interface{}))
+		}
+		if len(result) < PageSizeLarge {
+			break
+		}
+		request["PageNumber"] = request["PageNumber"].(int) + 1
+	}
+	return objects, nil
+}
+
+func (s *DataworksPublicService) ListDataWorksFolders(ctx context.Context, projectId, parentFolderPath string) (objects []map[string]interface{}, err error) {
+	var response map[string]interface{}
+	conn, err := s.client.NewDataworkspublicClient()
+	if err != nil {
+		return nil, WrapError(err)
+	}
+	action := "ListFolders"
+	request := map[string]interface{}{
+		"RegionId":         "default",
+		"ProjectId":        projectId,
+		"ParentFolderPath": parentFolderPath,
+		"PageSize":         PageSizeLarge,
+		"PageNumber":       1,
+	}
+	runtime := util.RuntimeOptions{}
+	runtime.SetAutoretry(true)
+	for {
+		wait := incrementalWait(3*time.Second, 3*time.Second)
+		err = resource.RetryContext(ctx, 5*time.Minute, func() *resource.RetryError {
+			response, err = conn.DoRequest(StringPointer(action), nil, StringPointer("POST"), StringPointer("2020-05-18"), StringPointer("AK"), nil, request, &runtime)
+			if err != nil {
+				if NeedRetry(err) {
+					wait()
+					return resource.RetryableError(err)
+				}
+				return resource.NonRetryableError(err)
+			}
+			return nil
+		})
+		addDebug(action, response, request)
+		if err != nil {
+			return objects, WrapErrorf(err, DefaultErrorMsg, projectId, action, AlibabacloudStackSdkGoERROR)
+		}
+		v, err := jsonpath.Get("$.Data.Folders", response)
+		if err != nil {
+			return objects, WrapErrorf(err, FailedGetAttributeMsg, projectId, "$.Data.Folders", response)
+		}
+		result, _ := v.([]interface{})
+		for _, item := range result {
+			objects = append(objects, item.(map[string]interface{}))
+		}
+		if len(result) < PageSizeLarge {
+			break
+		}
+		request["PageNumber"] = request["PageNumber"].(int) + 1
+	}
+	return objects, nil
+}
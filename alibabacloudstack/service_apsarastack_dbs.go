@@ -1,6 +1,7 @@
 package alibabacloudstack
 
 import (
+	"context"
 	"time"
 
 	"github.com/PaesslerAG/jsonpath"
@@ -29,7 +30,7 @@ func (s *DbsService) DescribeDbsBackupPlan(id string) (object map[string]interfa
 	runtime := util.RuntimeOptions{}
 	runtime.SetAutoretry(true)
 	wait := incrementalWait(3*time.Second, 3*time.Second)
-	err = resource.Retry(5*time.Minute, func() *resource.RetryError {
+	err = resource.RetryContext(s.client.StopContext(), 5*time.Minute, func() *resource.RetryError {
 		response, err = conn.DoRequest(StringPointer(action), nil, StringPointer("POST"), StringPointer("2019-03-06"), StringPointer("AK"), request, nil, &runtime)
 		if err != nil {
 			if NeedRetry(err) {
@@ -58,3 +59,49 @@ func (s *DbsService) DescribeDbsBackupPlan(id string) (object map[string]interfa
 
 	return object, nil
 }
+
+func (s *DbsService) DescribeDbsBackupPlans(ctx context.Context, request map[string]interface{}) (objects []map[string]interface{}, err error) {
+	var response map[string]interface{}
+	conn, err := s.client.NewDbsClient()
+	if err != nil {
+		return nil, WrapError(err)
+	}
+	action := "DescribeBackupPlanList"
+	request["RegionId"] = s.client.RegionId
+	request["PageSize"] = PageSizeLarge
+	request["PageNum"] = 0
+	runtime := util.RuntimeOptions{}
+	runtime.SetAutoretry(true)
+	for {
+		wait := incrementalWait(3*time.Second, 3*time.Second)
+		err = resource.RetryContext(ctx, 5*time.Minute, func() *resource.RetryError {
+			response, err = conn.DoRequest(StringPointer(action), nil, StringPointer("POST"), StringPointer("2019-03-06"), StringPointer("AK"), request, nil, &runtime)
+			if err != nil {
+				if NeedRetry(err) {
+					wait()
+					return resource.RetryableError(err)
+				}
+				return resource.NonRetryableError(err)
+			}
+			return nil
+		})
+		addDebug(action, response, request)
+		if err != nil {
+			return objects, WrapErrorf(err, DefaultErrorMsg, "alibabacloudstack_dbs_backup_plans", action, AlibabacloudStackSdkGoERROR)
+		}
+		v, err := jsonpath.Get("$.Items.BackupPlanDetail", response)
+		if err != nil {
+			return objects, WrapErrorf(err, FailedGetAttributeMsg, "alibabacloudstack_dbs_backup_plans", "$.Items.BackupPlanDetail", response)
+		}
+		result, _ := v.([]interface{})
+		for _, item := range result {
+			objects = append(objects, item.(map[string]interface{}))
+		}
+		if len(result) < PageSizeLarge {
+			break
+		}
+		// The page number of DescribeBackupPlanList starts from 0.
+		request["PageNum"] = request["PageNum"].(int) + 1
+	}
+	return objects, nil
+}
@@ -1,6 +1,7 @@
 package alibabacloudstack
 
 import (
+	"context"
 	"fmt"
 	"time"
 
@@ -29,7 +30,7 @@ func (s *DtsService) DescribeDtsJobMonitorRule(id string) (object map[string]int
 	runtime := util.RuntimeOptions{}
 	runtime.SetAutoretry(true)
 	wait := incrementalWait(3*time.Second, 3*time.Second)
-	err = resource.Retry(5*time.Minute, func() *resource.RetryError {
+	err = resource.RetryContext(s.client.StopContext(), 5*time.Minute, func() *resource.RetryError {
 		response, err = conn.DoRequest(StringPointer(action), nil, StringPointer("POST"), StringPointer("2020-01-01"), StringPointer("AK"), nil, request, &runtime)
 		if err != nil {
 			if NeedRetry(err) {
@@ -73,7 +74,7 @@ func (s *DtsService) DescribeDtsSubscriptionJob(id string) (object map[string]in
 	runtime := util.RuntimeOptions{}
 	runtime.SetAutoretry(true)
 	wait := incrementalWait(3*time.Second, 3*time.Second)
-	err = resource.Retry(5*time.Minute, func() *resource.RetryError {
+	err = resource.RetryContext(s.client.StopContext(), 5*time.Minute, func() *resource.RetryError {
 		response, err = conn.DoRequestWithOrg(StringPointer(action), nil, StringPointer("POST"), StringPointer("2020-01-01"), StringPointer("AK"), nil, request, &runtime)
 		if err != nil {
 			if NeedRetry(err) {
@@ -146,7 +147,7 @@ func (s *DtsService) DescribeDtsSynchronizationInstance(id string) (object map[s
 		runtime := util.RuntimeOptions{}
 		runtime.SetAutoretry(true)
 		wait := incrementalWait(3*time.Second, 3*time.Second)
-		err = resource.Retry(5*time.Minute, func() *resource.RetryError {
+		err = resource.RetryContext(s.client.StopContext(), 5*time.Minute, func() *resource.RetryError {
 			response, err = conn.DoRequest(StringPointer(action), nil, StringPointer("POST"), StringPointer("2020-01-01"), StringPointer("AK"), nil, request, &runtime)
 			if err != nil {
 				if NeedRetry(err) {
@@ -188,7 +189,7 @@ func (s *DtsService) DescribeDtsSynchronizationInstance(id string) (object map[s
 	return
 }
 
-func (s *DtsService) ListTagResources(id string, resourceType string) (object interface{}, err error) {
+func (s *DtsService) ListTagResources(ctx context.Context, id string, resourceType string) (object interface{}, err error) {
 	conn, err := s.client.NewDtsClient()
 	if err != nil {
 		return nil, WrapError(err)
@@ -204,7 +205,7 @@ func (s *DtsService) ListTagResources(id string, resourceType string) (object in
 
 	for {
 		wait := incrementalWait(3*time.Second, 5*time.Second)
-		err = resource.Retry(5*time.Minute, func() *resource.RetryError {
+		err = resource.RetryContext(ctx, 5*time.Minute, func() *resource.RetryError {
 			response, err := conn.DoRequest(StringPointer(action), nil, StringPointer("POST"), StringPointer("2020-01-01"), StringPointer("AK"), nil, request, &util.RuntimeOptions{})
 			if err != nil {
 				if IsExpectedErrors(err, []string{Throttling}) {
@@ -236,10 +237,10 @@ func (s *DtsService) ListTagResources(id string, resourceType string) (object in
 	return tags, nil
 }
 
-func (s *DtsService) SetResourceTags(d *schema.ResourceData, resourceType string) error {
+func (s *DtsService) SetResourceTags(ctx context.Context, d *schema.ResourceData, resourceType string) error {
 
-	if d.HasChange("tags") {
-		added, removed := parsingTags(d)
+	if hasTagsAllChange(d, s.client) {
+		added, removed := parsingTags(d, s.client)
 		conn, err := s.client.NewDtsClient()
 		if err != nil {
 			return WrapError(err)
@@ -262,7 +263,7 @@ func (s *DtsService) SetResourceTags(d *schema.ResourceData, resourceType string
 				request[fmt.Sprintf("TagKey.%d", i+1)] = key
 			}
 			wait := incrementalWait(2*time.Second, 1*time.Second)
-			err := resource.Retry(10*time.Minute, func() *resource.RetryError {
+			err := resource.RetryContext(ctx, 10*time.Minute, func() *resource.RetryError {
 				response, err := conn.DoRequest(StringPointer(action), nil, StringPointer("POST"), StringPointer("2020-01-01"), StringPointer("AK"), nil, request, &util.RuntimeOptions{})
 				if err != nil {
 					if IsThrottling(err) {
@@ -294,7 +295,7 @@ func (s *DtsService) SetResourceTags(d *schema.ResourceData, resourceType string
 			}
 
 			wait := incrementalWait(2*time.Second, 1*time.Second)
-			err := resource.Retry(10*time.Minute, func() *resource.RetryError {
+			err := resource.RetryContext(ctx, 10*time.Minute, func() *resource.RetryError {
 				response, err := conn.DoRequest(StringPointer(action), nil, StringPointer("POST"), StringPointer("2020-01-01"), StringPointer("AK"), nil, request, &util.RuntimeOptions{})
 				if err != nil {
 					if IsThrottling(err) {
@@ -334,7 +335,7 @@ func (s *DtsService) DescribeDtsSynchronizationJob(id string) (object map[string
 	runtime := util.RuntimeOptions{}
 	runtime.SetAutoretry(true)
 	wait := incrementalWait(3*time.Second, 3*time.Second)
-	err = resource.Retry(5*time.Minute, func() *resource.RetryError {
+	err = resource.RetryContext(s.client.StopContext(), 5*time.Minute, func() *resource.RetryError {
 		response, err = conn.DoRequest(StringPointer(action), nil, StringPointer("POST"), StringPointer("2020-01-01"), StringPointer("AK"), nil, request, &runtime)
 		if err != nil {
 			if NeedRetry(err) {
@@ -385,7 +386,7 @@ func (s *DtsService) DescribeDtsJobDetail(id string) (object map[string]interfac
 	runtime := util.RuntimeOptions{}
 	runtime.SetAutoretry(true)
 	wait := incrementalWait(3*time.Second, 3*time.Second)
-	err = resource.Retry(5*time.Minute, func() *resource.RetryError {
+	err = resource.RetryContext(s.client.StopContext(), 5*time.Minute, func() *resource.RetryError {
 		response, err = conn.DoRequest(StringPointer(action), nil, StringPointer("POST"), StringPointer("2020-01-01"), StringPointer("AK"), nil, request, &runtime)
 		if err != nil {
 			if NeedRetry(err) {
@@ -433,3 +434,58 @@ func (s *DtsService) DtsSynchronizationJobStateRefreshFunc(id string, failStates
 		return object, fmt.Sprint(object["Status"]), nil
 	}
 }
+
+// DescribeDtsJobs lists all of the dts jobs with the specified job type, such as SYNC, SUBSCRIBE or MIGRATION.
+func (s *DtsService) DescribeDtsJobs(ctx context.Context, jobType string) (objects []map[string]interface{}, err error) {
+	var response map[string]interface{}
+	conn, err := s.client.NewDtsClient()
+	if err != nil {
+		return nil, WrapError(err)
+	}
+	action := "DescribeDtsJobs"
+	request := map[string]interface{}{
+		"RegionId":   s.client.RegionId,
+		"JobType":    jobType,
+		"PageSize":   PageSizeLarge,
+		"PageNumber": 1,
+	}
+	request["product"] = "Dts"
+	request["OrganizationId"] = s.client.Department
+	request["ResourceId"] = s.client.ResourceGroup
+	runtime := util.RuntimeOptions{}
+	runtime.SetAutoretry(true)
+	for {
+		wait := incrementalWait(3*time.Second, 3*time.Second)
+		err = resource.RetryContext(ctx, 5*time.Minute, func() *resource.RetryError {
+			response, err = conn.DoRequest(StringPointer(action), nil, StringPointer("POST"), StringPointer("2020-01-01"), StringPointer("AK"), nil, request, &runtime)
+			if err != nil {
+				if NeedRetry(err) {
+					wait()
+					return resource.RetryableError(err)
+				}
+				return resource.NonRetryableError(err)
+			}
+			return nil
+		})
+		addDebug(action, response, request)
+		if err != nil {
+			return objects, WrapErrorf(err, DefaultErrorMsg, jobType, action, AlibabacloudStackSdkGoERROR)
+		}
+		if fmt.Sprint(response["Success"]) == "false" {
+			return objects, WrapError(fmt.Errorf("%s failed, response: %v", action, response))
+		}
+		v, err := jsonpath.Get("$.DtsJobList", response)
+		if err != nil {
+			return objects, WrapErrorf(err, FailedGetAttributeMsg, jobType, "$.DtsJobList", response)
+		}
+		result, _ := v.([]interface{})
+		for _, item := range result {
+			objects = append(objects, item.(map[string]interface{}))
+		}
+		if len(result) < PageSizeLarge {
+			break
+		}
+		request["PageNumber"] = request["PageNumber"].(int) + 1
+	}
+	return objects, nil
+}
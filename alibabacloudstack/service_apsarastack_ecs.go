@@ -1,9 +1,11 @@
 package alibabacloudstack
 
 import (
+	"context"
 	"encoding/json"
 	"errors"
 	"fmt"
+	"github.com/PaesslerAG/jsonpath"
 	util "github.com/alibabacloud-go/tea-utils/service"
 	"github.com/aliyun/alibaba-cloud-sdk-go/sdk/responses"
 	"github.com/aliyun/aliyun-datahub-sdk-go/datahub"
@@ -143,7 +145,7 @@ func (s *EcsService) DescribeInstance(id string) (instance ecs.Instance, err err
 
 	var response *ecs.DescribeInstancesResponse
 	wait := incrementalWait(1*time.Second, 1*time.Second)
-	err = resource.Retry(10*time.Minute, func() *resource.RetryError {
+	err = resource.RetryContext(s.client.StopContext(), 10*time.Minute, func() *resource.RetryError {
 		raw, err := s.client.WithEcsClient(func(ecsClient *ecs.Client) (interface{}, error) {
 			return ecsClient.DescribeInstances(request)
 		})
@@ -197,7 +199,7 @@ func (s *EcsService) DescribeInstanceAttribute(id string) (instance ecs.Describe
 	return *response, nil
 }
 
-func (s *EcsService) DescribeInstanceSystemDisk(id, rg string) (disk ecs.Disk, err error) {
+func (s *EcsService) DescribeInstanceSystemDisk(ctx context.Context, id, rg string) (disk ecs.Disk, err error) {
 	request := ecs.CreateDescribeDisksRequest()
 	request.InstanceId = id
 	//request.DiskType = string(DiskTypeSystem)
@@ -211,7 +213,7 @@ func (s *EcsService) DescribeInstanceSystemDisk(id, rg string) (disk ecs.Disk, e
 	request.QueryParams = map[string]string{"AccessKeySecret": s.client.SecretKey, "Product": "ecs", "Department": s.client.Department, "ResourceGroup": s.client.ResourceGroup}
 	var response *ecs.DescribeDisksResponse
 	wait := incrementalWait(1*time.Second, 1*time.Second)
-	err = resource.Retry(10*time.Minute, func() *resource.RetryError {
+	err = resource.RetryContext(ctx, 10*time.Minute, func() *resource.RetryError {
 		raw, err := s.client.WithEcsClient(func(ecsClient *ecs.Client) (interface{}, error) {
 			return ecsClient.DescribeDisks(request)
 		})
@@ -739,7 +741,37 @@ func (s *EcsService) DescribeImageById(id string) (image ecs.Image, err error) {
 	return resp.Images.Image[0], nil
 }
 
-func (s *EcsService) deleteImage(d *schema.ResourceData) error {
+func (s *EcsService) DescribeInstanceTypeById(id string) (instanceType ecs.InstanceType, err error) {
+	request := ecs.CreateDescribeInstanceTypesRequest()
+	request.RegionId = s.client.RegionId
+	if strings.ToLower(s.client.Config.Protocol) == "https" {
+		request.Scheme = "https"
+	} else {
+		request.Scheme = "http"
+	}
+	request.Headers = map[string]string{"RegionId": s.client.RegionId}
+	request.QueryParams = map[string]string{"AccessKeySecret": s.client.SecretKey, "Product": "ecs", "Department": s.client.Department, "ResourceGroup": s.client.ResourceGroup}
+	request.InstanceTypes = &[]string{id}
+	raw, err := s.client.WithEcsClient(func(ecsClient *ecs.Client) (interface{}, error) {
+		return ecsClient.DescribeInstanceTypes(request)
+	})
+	if err != nil {
+		return instanceType, WrapErrorf(err, DefaultErrorMsg, id, request.GetActionName(), AlibabacloudStackSdkGoERROR)
+	}
+	addDebug(request.GetActionName(), raw, request.RpcRequest, request)
+	resp, _ := raw.(*ecs.DescribeInstanceTypesResponse)
+	if resp != nil {
+		// The filter of the instance types is not supported by all the versions of the stack.
+		for _, instanceType := range resp.InstanceTypes.InstanceType {
+			if instanceType.InstanceTypeId == id {
+				return instanceType, nil
+			}
+		}
+	}
+	return instanceType, WrapErrorf(Error(GetNotFoundMessage("InstanceType", id)), NotFoundMsg, ProviderERROR)
+}
+
+func (s *EcsService) deleteImage(ctx context.Context, d *schema.ResourceData) error {
 
 	object, err := s.DescribeImageById(d.Id())
 	if err != nil {
@@ -772,18 +804,18 @@ func (s *EcsService) deleteImage(d *schema.ResourceData) error {
 	}
 	addDebug(request.GetActionName(), raw, request.RpcRequest, request)
 	stateConf := BuildStateConf([]string{}, []string{}, d.Timeout(schema.TimeoutCreate), 3*time.Second, s.ImageStateRefreshFunc(d.Id(), []string{}))
-	if _, err := stateConf.WaitForState(); err != nil {
+	if _, err := stateConf.WaitForStateContext(ctx); err != nil {
 		return WrapErrorf(err, IdMsg, d.Id())
 	}
 
 	return nil
 }
 
-func (s *EcsService) updateImage(d *schema.ResourceData) error {
+func (s *EcsService) updateImage(ctx context.Context, d *schema.ResourceData) error {
 
 	d.Partial(true)
 
-	err := setTags(s.client, TagResourceImage, d)
+	err := setTags(ctx, s.client, TagResourceImage, d)
 	if err != nil {
 		return WrapError(err)
 	} else {
@@ -1019,7 +1051,7 @@ func (s *EcsService) InstanceStateRefreshFunc(id string, failStates []string) re
 		return object, object.Status, nil
 	}
 }
-func (s *EcsService) deleteImageforDest(d *schema.ResourceData, region string) error {
+func (s *EcsService) deleteImageforDest(ctx context.Context, d *schema.ResourceData, region string) error {
 
 	object, err := s.DescribeImageById(d.Id())
 	if err != nil {
@@ -1052,7 +1084,7 @@ func (s *EcsService) deleteImageforDest(d *schema.ResourceData, region string) e
 	}
 	addDebug(request.GetActionName(), raw, request.RpcRequest, request)
 	stateConf := BuildStateConf([]string{}, []string{}, d.Timeout(schema.TimeoutCreate), 3*time.Second, s.ImageStateRefreshFunc(d.Id(), []string{}))
-	if _, err := stateConf.WaitForState(); err != nil {
+	if _, err := stateConf.WaitForStateContext(ctx); err != nil {
 		return WrapErrorf(err, IdMsg, d.Id())
 	}
 
@@ -1330,7 +1362,7 @@ func (s *EcsService) WaitForModifySecurityGroupPolicy(id, target string, timeout
 	}
 }
 
-func (s *EcsService) AttachKeyPair(keyName string, instanceIds []interface{}) error {
+func (s *EcsService) AttachKeyPair(ctx context.Context, keyName string, instanceIds []interface{}) error {
 	request := ecs.CreateAttachKeyPairRequest()
 	request.RegionId = s.client.RegionId
 	if strings.ToLower(s.client.Config.Protocol) == "https" {
@@ -1342,7 +1374,7 @@ func (s *EcsService) AttachKeyPair(keyName string, instanceIds []interface{}) er
 	request.QueryParams = map[string]string{"AccessKeySecret": s.client.SecretKey, "Product": "ecs", "Department": s.client.Department, "ResourceGroup": s.client.ResourceGroup}
 	request.KeyPairName = keyName
 	request.InstanceIds = convertListToJsonString(instanceIds)
-	err := resource.Retry(5*time.Minute, func() *resource.RetryError {
+	err := resource.RetryContext(ctx, 5*time.Minute, func() *resource.RetryError {
 		raw, err := s.client.WithEcsClient(func(ecsClient *ecs.Client) (interface{}, error) {
 			return ecsClient.AttachKeyPair(request)
 		})
@@ -1583,6 +1615,126 @@ func (s *EcsService) WaitForSnapshotPolicy(id string, status Status, timeout int
 	}
 }
 
+// ApplySnapshotPolicy applies the automatic snapshot policy to the disks, which replaces the policy applied to them before.
+func (s *EcsService) ApplySnapshotPolicy(ctx context.Context, policyId string, diskIds []string) error {
+	request := ecs.CreateApplyAutoSnapshotPolicyRequest()
+	request.RegionId = s.client.RegionId
+	if strings.ToLower(s.client.Config.Protocol) == "https" {
+		request.Scheme = "https"
+	} else {
+		request.Scheme = "http"
+	}
+	request.Headers = map[string]string{"RegionId": s.client.RegionId}
+	request.QueryParams = map[string]string{"AccessKeySecret": s.client.SecretKey, "Product": "ecs", "Department": s.client.Department, "ResourceGroup": s.client.ResourceGroup}
+	request.AutoSnapshotPolicyId = policyId
+	request.DiskIds = convertListToJsonString(convertListStringToListInterface(diskIds))
+	err := resource.RetryContext(ctx, 5*time.Minute, func() *resource.RetryError {
+		raw, err := s.client.WithEcsClient(func(ecsClient *ecs.Client) (interface{}, error) {
+			return ecsClient.ApplyAutoSnapshotPolicy(request)
+		})
+		if err != nil {
+			if IsExpectedErrors(err, SnapshotPolicyInvalidOperations) || NeedRetry(err) {
+				return resource.RetryableError(err)
+			}
+			return resource.NonRetryableError(err)
+		}
+		addDebug(request.GetActionName(), raw, request.RpcRequest, request)
+		return nil
+	})
+	if err != nil {
+		return WrapErrorf(err, DefaultErrorMsg, policyId, request.GetActionName(), AlibabacloudStackSdkGoERROR)
+	}
+	return nil
+}
+
+// CancelSnapshotPolicy cancels the automatic snapshot policy applied to the disks.
+func (s *EcsService) CancelSnapshotPolicy(ctx context.Context, diskIds []string) error {
+	request := ecs.CreateCancelAutoSnapshotPolicyRequest()
+	request.RegionId = s.client.RegionId
+	if strings.ToLower(s.client.Config.Protocol) == "https" {
+		request.Scheme = "https"
+	} else {
+		request.Scheme = "http"
+	}
+	request.Headers = map[string]string{"RegionId": s.client.RegionId}
+	request.QueryParams = map[string]string{"AccessKeySecret": s.client.SecretKey, "Product": "ecs", "Department": s.client.Department, "ResourceGroup": s.client.ResourceGroup}
+	request.DiskIds = convertListToJsonString(convertListStringToListInterface(diskIds))
+	err := resource.RetryContext(ctx, 5*time.Minute, func() *resource.RetryError {
+		raw, err := s.client.WithEcsClient(func(ecsClient *ecs.Client) (interface{}, error) {
+			return ecsClient.CancelAutoSnapshotPolicy(request)
+		})
+		if err != nil {
+			if IsExpectedErrors(err, SnapshotPolicyInvalidOperations) || NeedRetry(err) {
+				return resource.RetryableError(err)
+			}
+			return resource.NonRetryableError(err)
+		}
+		addDebug(request.GetActionName(), raw, request.RpcRequest, request)
+		return nil
+	})
+	if err != nil {
+		return WrapErrorf(err, DefaultErrorMsg, strings.Join(diskIds, ","), request.GetActionName(), AlibabacloudStackSdkGoERROR)
+	}
+	return nil
+}
+
+func (s *EcsService) DescribeSnapshotPolicyAttachment(id string) (disk ecs.Disk, err error) {
+	parts, err := ParseResourceId(id, 2)
+	if err != nil {
+		return disk, WrapError(err)
+	}
+	disk, err = s.DescribeDisk(parts[1])
+	if err != nil {
+		return disk, WrapError(err)
+	}
+	if disk.AutoSnapshotPolicyId != parts[0] {
+		err = WrapErrorf(Error(GetNotFoundMessage("SnapshotPolicyAttachment", id)), NotFoundMsg, ProviderERROR)
+	}
+	return
+}
+
+// DescribeSnapshotPolicyDisks returns the ids of the disks which the automatic snapshot policy is applied to.
+func (s *EcsService) DescribeSnapshotPolicyDisks(policyId string) ([]string, error) {
+	request := ecs.CreateDescribeDisksRequest()
+	request.RegionId = s.client.RegionId
+	if strings.ToLower(s.client.Config.Protocol) == "https" {
+		request.Scheme = "https"
+	} else {
+		request.Scheme = "http"
+	}
+	request.Headers = map[string]string{"RegionId": s.client.RegionId}
+	request.QueryParams = map[string]string{"AccessKeySecret": s.client.SecretKey, "Product": "ecs", "Department": s.client.Department, "ResourceGroup": s.client.ResourceGroup}
+	request.AutoSnapshotPolicyId = policyId
+	request.PageSize = requests.NewInteger(PageSizeLarge)
+	request.PageNumber = requests.NewInteger(1)
+	diskIds := make([]string, 0)
+	for {
+		raw, err := s.client.WithEcsClient(func(ecsClient *ecs.Client) (interface{}, error) {
+			return ecsClient.DescribeDisks(request)
+		})
+		if err != nil {
+			return nil, WrapErrorf(err, DefaultErrorMsg, policyId, request.GetActionName(), AlibabacloudStackSdkGoERROR)
+		}
+		addDebug(request.GetActionName(), raw, request.RpcRequest, request)
+		response, _ := raw.(*ecs.DescribeDisksResponse)
+		for _, disk := range response.Disks.Disk {
+			// The disks are filtered again in case that the filter is not supported by the api.
+			if disk.AutoSnapshotPolicyId == policyId {
+				diskIds = append(diskIds, disk.DiskId)
+			}
+		}
+		if len(response.Disks.Disk) < PageSizeLarge {
+			break
+		}
+		page, err := getNextpageNumber(request.PageNumber)
+		if err != nil {
+			return nil, WrapError(err)
+		}
+		request.PageNumber = page
+	}
+	return diskIds, nil
+}
+
 func (s *EcsService) DescribeLaunchTemplate(id string) (set ecs.LaunchTemplateSet, err error) {
 
 	request := ecs.CreateDescribeLaunchTemplatesRequest()
@@ -1786,7 +1938,7 @@ func (s *EcsService) ecsTagIgnored(t ecs.Tag) bool {
 }
 
 func (s *EcsService) SetResourceTags(d *schema.ResourceData, resourceType string) error {
-	oldItems, newItems := d.GetChange("tags")
+	oldItems, newItems := getTagsAllChange(d, s.client)
 	added := make([]ecs.TagResourcesTag, 0)
 	for key, value := range newItems.(map[string]interface{}) {
 		added = append(added, ecs.TagResourcesTag{
@@ -2091,7 +2243,7 @@ func (s *EcsService) DescribeEcsDeploymentSet(id string) (result *datahub.EcsDes
 	runtime := util.RuntimeOptions{}
 	runtime.SetAutoretry(true)
 	wait := incrementalWait(3*time.Second, 3*time.Second)
-	err = resource.Retry(5*time.Minute, func() *resource.RetryError {
+	err = resource.RetryContext(s.client.StopContext(), 5*time.Minute, func() *resource.RetryError {
 		raw, err := s.client.WithEcsClient(func(EcsClient *ecs.Client) (interface{}, error) {
 			return EcsClient.ProcessCommonRequest(request)
 		})
@@ -2186,3 +2338,129 @@ func (s *EcsService) DescribeEcsHpcCluster(id string) (result *datahub.EcsDescri
 	//object = v.([]interface{})[0].(map[string]interface{})
 	return resp, nil
 }
+
+// doEcsCommonRequest invokes the action of the ecs api with the common request, which supports the parameters
+// that are not defined by the ecs sdk, and returns the response as a map.
+func (s *EcsService) doEcsCommonRequest(ctx context.Context, action string, params map[string]string, timeout time.Duration) (map[string]interface{}, error) {
+	request := requests.NewCommonRequest()
+	request.Method = "POST"
+	request.Product = "Ecs"
+	request.Domain = s.client.Domain
+	request.Version = "2014-05-26"
+	request.RegionId = s.client.RegionId
+	if strings.ToLower(s.client.Config.Protocol) == "https" {
+		request.Scheme = "https"
+	} else {
+		request.Scheme = "http"
+	}
+	request.ApiName = action
+	request.Headers = map[string]string{"RegionId": s.client.RegionId}
+	request.QueryParams = map[string]string{
+		"AccessKeySecret": s.client.SecretKey,
+		"AccessKeyId":     s.client.AccessKey,
+		"RegionId":        s.client.RegionId,
+		"Product":         "Ecs",
+		"Version":         "2014-05-26",
+		"Department":      s.client.Department,
+		"ResourceGroup":   s.client.ResourceGroup,
+		"Action":          action,
+	}
+	for key, value := range params {
+		request.QueryParams[key] = value
+	}
+	response := make(map[string]interface{})
+	wait := incrementalWait(3*time.Second, 3*time.Second)
+	err := resource.RetryContext(ctx, timeout, func() *resource.RetryError {
+		raw, err := s.client.WithEcsClient(func(EcsClient *ecs.Client) (interface{}, error) {
+			return EcsClient.ProcessCommonRequest(request)
+		})
+		if err != nil {
+			if NeedRetry(err) {
+				wait()
+				return resource.RetryableError(err)
+			}
+			return resource.NonRetryableError(err)
+		}
+		addDebug(action, raw, request)
+		bresponse := raw.(*responses.CommonResponse)
+		if err := json.Unmarshal(bresponse.GetHttpContentBytes(), &response); err != nil {
+			return resource.NonRetryableError(err)
+		}
+		return nil
+	})
+	return response, err
+}
+
+func (s *EcsService) DescribeEcsInvocation(ctx context.Context, id string) (object map[string]interface{}, err error) {
+	action := "DescribeInvocations"
+	response, err := s.doEcsCommonRequest(ctx, action, map[string]string{
+		"InvokeId": id,
+	}, 5*time.Minute)
+	if err != nil {
+		if IsExpectedErrors(err, []string{"InvalidInvokeId.NotFound"}) {
+			return nil, WrapErrorf(Error(GetNotFoundMessage("EcsInvocation", id)), NotFoundMsg, ProviderERROR)
+		}
+		return nil, WrapErrorf(err, DefaultErrorMsg, id, action, AlibabacloudStackSdkGoERROR)
+	}
+	v, err := jsonpath.Get("$.Invocations.Invocation", response)
+	if err != nil {
+		return nil, WrapErrorf(err, FailedGetAttributeMsg, id, "$.Invocations.Invocation", response)
+	}
+	for _, item := range v.([]interface{}) {
+		if object := item.(map[string]interface{}); fmt.Sprint(object["InvokeId"]) == id {
+			return object, nil
+		}
+	}
+	return nil, WrapErrorf(Error(GetNotFoundMessage("EcsInvocation", id)), NotFoundMsg, ProviderERROR)
+}
+
+// DescribeEcsInvocationResults returns the results of the invocation on every instance.
+func (s *EcsService) DescribeEcsInvocationResults(ctx context.Context, id string) ([]interface{}, error) {
+	action := "DescribeInvocationResults"
+	params := map[string]string{
+		"InvokeId": id,
+		"PageSize": strconv.Itoa(PageSizeLarge),
+	}
+	var results []interface{}
+	for pageNumber := 1; ; pageNumber++ {
+		params["PageNumber"] = strconv.Itoa(pageNumber)
+		response, err := s.doEcsCommonRequest(ctx, action, params, 5*time.Minute)
+		if err != nil {
+			if IsExpectedErrors(err, []string{"InvalidInvokeId.NotFound"}) {
+				return nil, WrapErrorf(Error(GetNotFoundMessage("EcsInvocation", id)), NotFoundMsg, ProviderERROR)
+			}
+			return nil, WrapErrorf(err, DefaultErrorMsg, id, action, AlibabacloudStackSdkGoERROR)
+		}
+		v, err := jsonpath.Get("$.Invocation.InvocationResults.InvocationResult", response)
+		if err != nil {
+			return nil, WrapErrorf(err, FailedGetAttributeMsg, id, "$.Invocation.InvocationResults.InvocationResult", response)
+		}
+		result, _ := v.([]interface{})
+		results = append(results, result...)
+		if len(result) < PageSizeLarge {
+			break
+		}
+	}
+	return results, nil
+}
+
+func (s *EcsService) EcsInvocationStateRefreshFunc(ctx context.Context, id string, failStates []string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		object, err := s.DescribeEcsInvocation(ctx, id)
+		if err != nil {
+			if NotFoundError(err) {
+				// Set this to nil as if we didn't find anything.
+				return nil, "", nil
+			}
+			return nil, "", WrapError(err)
+		}
+
+		status := fmt.Sprint(object["InvocationStatus"])
+		for _, failState := range failStates {
+			if status == failState {
+				return object, status, WrapError(Error(FailedToReachTargetStatus, status))
+			}
+		}
+		return object, status, nil
+	}
+}
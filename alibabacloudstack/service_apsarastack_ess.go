@@ -1,6 +1,7 @@
 package alibabacloudstack
 
 import (
+	"context"
 	"fmt"
 	"reflect"
 	"strings"
@@ -10,6 +11,7 @@ import (
 	"github.com/aliyun/alibaba-cloud-sdk-go/services/ess"
 	"github.com/aliyun/terraform-provider-alibabacloudstack/alibabacloudstack/connectivity"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
 type EssService struct {
@@ -520,7 +522,7 @@ func (s *EssService) DescribeEssScalingConfifurations(id string) (configs []ess.
 	return
 }
 
-func (srv *EssService) EssRemoveInstances(id string, instanceIds []string) error {
+func (srv *EssService) EssRemoveInstances(ctx context.Context, id string, instanceIds []string) error {
 
 	if len(instanceIds) < 1 {
 		return nil
@@ -543,7 +545,7 @@ func (srv *EssService) EssRemoveInstances(id string, instanceIds []string) error
 	}
 
 	removed := instanceIds
-	if err := resource.Retry(5*time.Minute, func() *resource.RetryError {
+	if err := resource.RetryContext(ctx, 5*time.Minute, func() *resource.RetryError {
 		request := ess.CreateRemoveInstancesRequest()
 		request.ScalingGroupId = id
 		request.RegionId = srv.client.RegionId
@@ -689,3 +691,96 @@ func (s *EssService) WaitForEssAlarm(id string, status Status, timeout int) erro
 		}
 	}
 }
+
+func (s *EssService) setInstanceTags(d *schema.ResourceData, resourceType TagResourceType) error {
+	if hasTagsAllChange(d, s.client) {
+		oraw, nraw := getTagsAllChange(d, s.client)
+		o := oraw.(map[string]interface{})
+		n := nraw.(map[string]interface{})
+
+		var removed []string
+		for key, value := range o {
+			if v, ok := n[key]; !ok || v != value {
+				removed = append(removed, key)
+			}
+		}
+		if len(removed) > 0 {
+			request := ess.CreateUntagResourcesRequest()
+			request.RegionId = s.client.RegionId
+			request.Headers = map[string]string{"RegionId": s.client.RegionId}
+			request.QueryParams = map[string]string{"AccessKeySecret": s.client.SecretKey, "Product": "ess", "Department": s.client.Department, "ResourceGroup": s.client.ResourceGroup}
+			request.ResourceType = string(resourceType)
+			request.ResourceId = &[]string{d.Id()}
+			request.TagKey = &removed
+			raw, err := s.client.WithEssClient(func(essClient *ess.Client) (interface{}, error) {
+				return essClient.UntagResources(request)
+			})
+			if err != nil {
+				return WrapErrorf(err, DefaultErrorMsg, d.Id(), request.GetActionName(), AlibabacloudStackSdkGoERROR)
+			}
+			addDebug(request.GetActionName(), raw, request.RpcRequest, request)
+		}
+
+		if len(n) > 0 {
+			tags := make([]ess.TagResourcesTag, 0, len(n))
+			for key, value := range n {
+				tags = append(tags, ess.TagResourcesTag{
+					Key:   key,
+					Value: value.(string),
+				})
+			}
+			request := ess.CreateTagResourcesRequest()
+			request.RegionId = s.client.RegionId
+			request.Headers = map[string]string{"RegionId": s.client.RegionId}
+			request.QueryParams = map[string]string{"AccessKeySecret": s.client.SecretKey, "Product": "ess", "Department": s.client.Department, "ResourceGroup": s.client.ResourceGroup}
+			request.ResourceType = string(resourceType)
+			request.ResourceId = &[]string{d.Id()}
+			request.Tag = &tags
+			raw, err := s.client.WithEssClient(func(essClient *ess.Client) (interface{}, error) {
+				return essClient.TagResources(request)
+			})
+			if err != nil {
+				return WrapErrorf(err, DefaultErrorMsg, d.Id(), request.GetActionName(), AlibabacloudStackSdkGoERROR)
+			}
+			addDebug(request.GetActionName(), raw, request.RpcRequest, request)
+		}
+	}
+
+	return nil
+}
+
+func (s *EssService) DescribeTags(resourceId string, resourceType TagResourceType) (tags []ess.TagResource, err error) {
+	request := ess.CreateListTagResourcesRequest()
+	request.RegionId = s.client.RegionId
+	request.Headers = map[string]string{"RegionId": s.client.RegionId}
+	request.QueryParams = map[string]string{"AccessKeySecret": s.client.SecretKey, "Product": "ess", "Department": s.client.Department, "ResourceGroup": s.client.ResourceGroup}
+	request.ResourceType = string(resourceType)
+	request.ResourceId = &[]string{resourceId}
+	for {
+		raw, err := s.client.WithEssClient(func(essClient *ess.Client) (interface{}, error) {
+			return essClient.ListTagResources(request)
+		})
+		if err != nil {
+			return tags, WrapErrorf(err, DefaultErrorMsg, resourceId, request.GetActionName(), AlibabacloudStackSdkGoERROR)
+		}
+		addDebug(request.GetActionName(), raw, request.RpcRequest, request)
+		response, _ := raw.(*ess.ListTagResourcesResponse)
+		tags = append(tags, response.TagResources.TagResource...)
+		if response.NextToken == "" {
+			break
+		}
+		request.NextToken = response.NextToken
+	}
+
+	return tags, nil
+}
+
+func (s *EssService) tagsToMap(tags []ess.TagResource) map[string]string {
+	result := make(map[string]string)
+	for _, t := range tags {
+		if !ignoredTags(t.TagKey, t.TagValue) {
+			result[t.TagKey] = t.TagValue
+		}
+	}
+	return result
+}
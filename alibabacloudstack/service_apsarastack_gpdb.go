@@ -62,7 +62,7 @@ func (s *GpdbService) DescribeGpdbAccount(id string) (object map[string]interfac
 	runtime := util.RuntimeOptions{}
 	runtime.SetAutoretry(true)
 	wait := incrementalWait(3*time.Second, 3*time.Second)
-	err = resource.Retry(5*time.Minute, func() *resource.RetryError {
+	err = resource.RetryContext(s.client.StopContext(), 5*time.Minute, func() *resource.RetryError {
 
 		response, err = conn.DoRequest(StringPointer(action), nil, StringPointer("POST"), StringPointer("2016-05-03"), StringPointer("AK"), nil, request, &runtime)
 		if err != nil {
@@ -266,7 +266,7 @@ func (s *GpdbService) WaitForGpdbConnection(id string, status Status, timeout in
 }
 
 func (s *GpdbService) setInstanceTags(d *schema.ResourceData) error {
-	oraw, nraw := d.GetChange("tags")
+	oraw, nraw := getTagsAllChange(d, s.client)
 	o := oraw.(map[string]interface{})
 	n := nraw.(map[string]interface{})
 	create, remove := diffGpdbTags(gpdbTagsFromMap(o), gpdbTagsFromMap(n))
@@ -36,7 +36,7 @@ type HBaseService struct {
 }
 
 func (s *HBaseService) setInstanceTags(d *schema.ResourceData) error {
-	oraw, nraw := d.GetChange("tags")
+	oraw, nraw := getTagsAllChange(d, s.client)
 	o := oraw.(map[string]interface{})
 	n := nraw.(map[string]interface{})
 
@@ -151,7 +151,7 @@ func (s *HBaseService) DescribeHBaseInstance(id string) (object map[string]inter
 	runtime := util.RuntimeOptions{}
 	runtime.SetAutoretry(true)
 	wait := incrementalWait(3*time.Second, 3*time.Second)
-	err = resource.Retry(5*time.Minute, func() *resource.RetryError {
+	err = resource.RetryContext(s.client.StopContext(), 5*time.Minute, func() *resource.RetryError {
 		response, err = conn.DoRequest(StringPointer(action), nil, StringPointer("POST"), StringPointer("2019-01-01"), StringPointer("AK"), request, nil, &runtime)
 		if err != nil {
 			if NeedRetry(err) {
@@ -1,6 +1,7 @@
 package alibabacloudstack
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
 	"github.com/PaesslerAG/jsonpath"
@@ -68,7 +69,7 @@ func (s *KmsService) Decrypt(ciphertextBlob string, encryptionContext map[string
 	response, _ := raw.(*kms.DecryptResponse)
 	return response, err
 }
-func (s *KmsService) Decrypt2(ciphertextBlob string, encryptionContext map[string]interface{}) (plaintext string, err error) {
+func (s *KmsService) Decrypt2(ctx context.Context, ciphertextBlob string, encryptionContext map[string]interface{}) (plaintext string, err error) {
 	context, err := json.Marshal(encryptionContext)
 	if err != nil {
 		return plaintext, WrapError(err)
@@ -88,7 +89,7 @@ func (s *KmsService) Decrypt2(ciphertextBlob string, encryptionContext map[strin
 	request["Product"] = "Kms"
 	request["OrganizationId"] = s.client.Department
 	wait := incrementalWait(3*time.Second, 3*time.Second)
-	err = resource.Retry(5*time.Minute, func() *resource.RetryError {
+	err = resource.RetryContext(ctx, 5*time.Minute, func() *resource.RetryError {
 		response, err = conn.DoRequest(StringPointer(action), nil, StringPointer("POST"), StringPointer("2016-01-20"), StringPointer("AK"), nil, request, &util.RuntimeOptions{})
 		if err != nil {
 			if NeedRetry(err) {
@@ -162,7 +163,7 @@ func (s *KmsService) GetSecretValue(id string) (object kms.GetSecretValueRespons
 }
 
 func (s *KmsService) setResourceTags(d *schema.ResourceData, resourceType string) error {
-	oldItems, newItems := d.GetChange("tags")
+	oldItems, newItems := getTagsAllChange(d, s.client)
 	added := make([]JsonTag, 0)
 	for key, value := range newItems.(map[string]interface{}) {
 		added = append(added, JsonTag{
@@ -229,6 +230,27 @@ func (s *KmsService) setResourceTags(d *schema.ResourceData, resourceType string
 	return nil
 }
 
+func (s *KmsService) ListResourceTags(id string) (tags []kms.Tag, err error) {
+	request := kms.CreateListResourceTagsRequest()
+	request.RegionId = s.client.RegionId
+
+	request.Headers = map[string]string{"RegionId": s.client.RegionId}
+	request.QueryParams = map[string]string{"AccessKeySecret": s.client.SecretKey, "Product": "kms", "Department": s.client.Department, "ResourceGroup": s.client.ResourceGroup}
+
+	request.KeyId = id
+
+	raw, err := s.client.WithKmsClient(func(kmsClient *kms.Client) (interface{}, error) {
+		return kmsClient.ListResourceTags(request)
+	})
+	if err != nil {
+		err = WrapErrorf(err, DefaultErrorMsg, id, request.GetActionName(), AlibabacloudStackSdkGoERROR)
+		return
+	}
+	addDebug(request.GetActionName(), raw, request.RpcRequest, request)
+	response, _ := raw.(*kms.ListResourceTagsResponse)
+	return response.Tags.Tag, nil
+}
+
 func (s *KmsService) DescribeKmsAlias(id string) (object kms.KeyMetadata, err error) {
 	request := kms.CreateDescribeKeyRequest()
 	request.RegionId = s.client.RegionId
@@ -170,8 +170,8 @@ func (s *KvstoreService) ModifyInstanceConfig(id string, config string) error {
 }
 
 func (s *KvstoreService) setInstanceTags(d *schema.ResourceData) error {
-	if d.HasChange("tags") {
-		oraw, nraw := d.GetChange("tags")
+	if hasTagsAllChange(d, s.client) {
+		oraw, nraw := getTagsAllChange(d, s.client)
 		o := oraw.(map[string]interface{})
 		n := nraw.(map[string]interface{})
 		create, remove := s.diffTags(s.tagsFromMap(o), s.tagsFromMap(n))
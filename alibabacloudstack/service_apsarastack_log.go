@@ -1,10 +1,12 @@
 package alibabacloudstack
 
 import (
+	"context"
 	"encoding/json"
 	"github.com/aliyun/alibaba-cloud-sdk-go/sdk/requests"
 	"github.com/aliyun/alibaba-cloud-sdk-go/sdk/responses"
 	"github.com/aliyun/alibaba-cloud-sdk-go/services/ecs"
+	"strconv"
 	"strings"
 	"time"
 
@@ -69,7 +71,7 @@ func (s *LogService) DescribeLogProject(id string) (*LogProject, error) {
 		"projectName":     id,
 	}
 	var logProject *LogProject
-	err := resource.Retry(2*time.Minute, func() *resource.RetryError {
+	err := resource.RetryContext(s.client.StopContext(), 2*time.Minute, func() *resource.RetryError {
 		raw, err := s.client.WithEcsClient(func(slsClient *ecs.Client) (interface{}, error) {
 			return slsClient.ProcessCommonRequest(request)
 		})
@@ -109,6 +111,70 @@ func (s *LogService) DescribeLogProject(id string) (*LogProject, error) {
 	return logProject, nil
 }
 
+// DescribeLogProjects lists the log projects page by page, the projects are returned in the Projects field.
+func (s *LogService) DescribeLogProjects(ctx context.Context) (*LogProject, error) {
+	result := &LogProject{}
+	request := requests.NewCommonRequest()
+	request.Method = "POST"
+	request.Product = "SLS"
+	request.Domain = s.client.Domain
+	request.Version = "2020-03-31"
+	if strings.ToLower(s.client.Config.Protocol) == "https" {
+		request.Scheme = "https"
+	} else {
+		request.Scheme = "http"
+	}
+	request.ApiName = "ListProject"
+	request.Headers = map[string]string{"RegionId": s.client.RegionId}
+	request.QueryParams = map[string]string{
+		"AccessKeySecret": s.client.SecretKey,
+		"AccessKeyId":     s.client.AccessKey,
+		"Product":         "SLS",
+		"Department":      s.client.Department,
+		"ResourceGroup":   s.client.ResourceGroup,
+		"RegionId":        s.client.RegionId,
+		"Action":          "ListProject",
+		"Version":         "2020-03-31",
+		"size":            "500",
+	}
+	for offset := 0; ; {
+		request.QueryParams["offset"] = strconv.Itoa(offset)
+		var page *LogProject
+		err := resource.RetryContext(ctx, 2*time.Minute, func() *resource.RetryError {
+			raw, err := s.client.WithEcsClient(func(slsClient *ecs.Client) (interface{}, error) {
+				return slsClient.ProcessCommonRequest(request)
+			})
+			if err != nil {
+				if IsExpectedErrors(err, []string{LogClientTimeout}) {
+					time.Sleep(5 * time.Second)
+					return resource.RetryableError(err)
+				}
+				return resource.NonRetryableError(err)
+			}
+			addDebug("ListProject", raw, request)
+			response, _ := raw.(*responses.CommonResponse)
+			if err := json.Unmarshal(response.GetHttpContentBytes(), &page); err != nil {
+				return resource.NonRetryableError(err)
+			}
+			return nil
+		})
+		if err != nil {
+			return result, WrapErrorf(err, DefaultErrorMsg, "alibabacloudstack_log_projects", "ListProject", AlibabacloudStackLogGoSdkERROR)
+		}
+		if page == nil {
+			break
+		}
+		result.Projects = append(result.Projects, page.Projects...)
+		offset += len(page.Projects)
+		if len(page.Projects) < 500 || offset >= page.Total {
+			break
+		}
+	}
+	result.Total = len(result.Projects)
+	result.Count = len(result.Projects)
+	return result, nil
+}
+
 func (s *LogService) WaitForLogProject(id string, status Status, timeout int) error {
 	deadline := time.Now().Add(time.Duration(timeout) * time.Second)
 	for {
@@ -139,7 +205,7 @@ func (s *LogService) DescribeLogStore(id string) (*sls.LogStore, error) {
 	}
 	projectName, name := parts[0], parts[1]
 	var requestInfo *sls.Client
-	err = resource.Retry(2*time.Minute, func() *resource.RetryError {
+	err = resource.RetryContext(s.client.StopContext(), 2*time.Minute, func() *resource.RetryError {
 		raw, err := s.client.WithLogClient(func(slsClient *sls.Client) (interface{}, error) {
 			requestInfo = slsClient
 			return slsClient.GetLogStore(projectName, name)
@@ -206,7 +272,7 @@ func (s *LogService) DescribeLogStoreIndex(id string) (*sls.Index, error) {
 	}
 	projectName, name := parts[0], parts[1]
 	var requestInfo *sls.Client
-	err = resource.Retry(2*time.Minute, func() *resource.RetryError {
+	err = resource.RetryContext(s.client.StopContext(), 2*time.Minute, func() *resource.RetryError {
 		raw, err := s.client.WithLogClient(func(slsClient *sls.Client) (interface{}, error) {
 			requestInfo = slsClient
 			return slsClient.GetIndex(projectName, name)
@@ -248,7 +314,7 @@ func (s *LogService) DescribeLogMachineGroup(id string) (*sls.MachineGroup, erro
 	}
 	projectName, groupName := parts[0], parts[1]
 	var requestInfo *sls.Client
-	err = resource.Retry(2*time.Minute, func() *resource.RetryError {
+	err = resource.RetryContext(s.client.StopContext(), 2*time.Minute, func() *resource.RetryError {
 		raw, err := s.client.WithLogClient(func(slsClient *sls.Client) (interface{}, error) {
 			requestInfo = slsClient
 			return slsClient.GetMachineGroup(projectName, groupName)
@@ -317,7 +383,7 @@ func (s *LogService) DescribeLogtailConfig(id string) (*sls.LogConfig, error) {
 	}
 	projectName, configName := parts[0], parts[2]
 	var requestInfo *sls.Client
-	err = resource.Retry(2*time.Minute, func() *resource.RetryError {
+	err = resource.RetryContext(s.client.StopContext(), 2*time.Minute, func() *resource.RetryError {
 		raw, err := s.client.WithLogClient(func(slsClient *sls.Client) (interface{}, error) {
 			requestInfo = slsClient
 			return slsClient.GetConfig(projectName, configName)
@@ -384,7 +450,7 @@ func (s *LogService) DescribeLogtailAttachment(id string) (groupName string, err
 	projectName, configName, name := parts[0], parts[1], parts[2]
 	var groupNames []string
 	var requestInfo *sls.Client
-	err = resource.Retry(2*time.Minute, func() *resource.RetryError {
+	err = resource.RetryContext(s.client.StopContext(), 2*time.Minute, func() *resource.RetryError {
 
 		raw, err := s.client.WithLogClient(func(slsClient *sls.Client) (interface{}, error) {
 			requestInfo = slsClient
@@ -457,7 +523,7 @@ func (s *LogService) DescribeLogAlert(id string) (*sls.Alert, error) {
 	}
 	projectName, alertName := parts[0], parts[1]
 	var requestInfo *sls.Client
-	err = resource.Retry(2*time.Minute, func() *resource.RetryError {
+	err = resource.RetryContext(s.client.StopContext(), 2*time.Minute, func() *resource.RetryError {
 		raw, err := s.client.WithLogClient(func(slsClient *sls.Client) (interface{}, error) {
 			requestInfo = slsClient
 			return slsClient.GetAlert(projectName, alertName)
@@ -518,12 +584,12 @@ func (s *LogService) WaitForLogstoreAlert(id string, status Status, timeout int)
 	}
 }
 
-func (s *LogService) CreateLogDashboard(project, name string) error {
+func (s *LogService) CreateLogDashboard(ctx context.Context, project, name string) error {
 	dashboard := sls.Dashboard{
 		DashboardName: name,
 		ChartList:     []sls.Chart{},
 	}
-	err := resource.Retry(2*time.Minute, func() *resource.RetryError {
+	err := resource.RetryContext(ctx, 2*time.Minute, func() *resource.RetryError {
 		raw, err := s.client.WithLogClient(func(slsClient *sls.Client) (interface{}, error) {
 			return nil, slsClient.CreateDashboard(project, dashboard)
 		})
@@ -551,12 +617,12 @@ func (s *LogService) CreateLogDashboard(project, name string) error {
 	return nil
 }
 
-func CreateDashboard(project, name string, client *sls.Client) error {
+func CreateDashboard(ctx context.Context, project, name string, client *sls.Client) error {
 	dashboard := sls.Dashboard{
 		DashboardName: name,
 		ChartList:     []sls.Chart{},
 	}
-	err := resource.Retry(2*time.Minute, func() *resource.RetryError {
+	err := resource.RetryContext(ctx, 2*time.Minute, func() *resource.RetryError {
 		err := client.CreateDashboard(project, dashboard)
 		if err != nil {
 			if err.(*sls.Error).Message == "specified dashboard already exists" {
@@ -605,10 +671,7 @@ func GetCharTitile(project, dashboard, char string, client *sls.Client) string {
 	for _, v := range board.ChartList {
 		if v.Display.DisplayName == char {
 			return v.Title
-		} else {
-			return char
 		}
-
 	}
 	return char
 }
@@ -621,7 +684,7 @@ func (s *LogService) DescribeLogDashboard(id string) (*sls.Dashboard, error) {
 	}
 	projectName, dashboardName := parts[0], parts[1]
 	var requestInfo *sls.Client
-	err = resource.Retry(2*time.Minute, func() *resource.RetryError {
+	err = resource.RetryContext(s.client.StopContext(), 2*time.Minute, func() *resource.RetryError {
 		raw, err := s.client.WithLogClient(func(slsClient *sls.Client) (interface{}, error) {
 			requestInfo = slsClient
 			return slsClient.GetDashboard(projectName, dashboardName)
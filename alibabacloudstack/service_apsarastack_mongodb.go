@@ -355,7 +355,7 @@ func (s *MongoDBService) DescribeDBInstanceSSL(id string) (*dds.DescribeDBInstan
 	response := &dds.DescribeDBInstanceSSLResponse{}
 	request := dds.CreateDescribeDBInstanceSSLRequest()
 
-	err := resource.Retry(10*time.Minute, func() *resource.RetryError {
+	err := resource.RetryContext(s.client.StopContext(), 10*time.Minute, func() *resource.RetryError {
 		instance, err := s.DescribeMongoDBInstance(id)
 		if err != nil {
 			if IsExpectedErrors(err, []string{"InvalidDBInstanceId.NotFound"}) {
@@ -438,7 +438,7 @@ func (s *MongoDBService) ResetAccountPassword(d *schema.ResourceData, password s
 }
 
 func (s *MongoDBService) setInstanceTags(d *schema.ResourceData) error {
-	oraw, nraw := d.GetChange("tags")
+	oraw, nraw := getTagsAllChange(d, s.client)
 	o := oraw.(map[string]interface{})
 	n := nraw.(map[string]interface{})
 
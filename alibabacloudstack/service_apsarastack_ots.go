@@ -1,6 +1,7 @@
 package alibabacloudstack
 
 import (
+	"context"
 	"strings"
 
 	"time"
@@ -32,13 +33,13 @@ func (s *OtsService) getPrimaryKeyType(primaryKeyType string) tablestore.Primary
 	return keyType
 }
 
-func (s *OtsService) ListOtsTable(instanceName string) (table *tablestore.ListTableResponse, err error) {
+func (s *OtsService) ListOtsTable(ctx context.Context, instanceName string) (table *tablestore.ListTableResponse, err error) {
 	if _, err := s.DescribeOtsInstance(instanceName); err != nil {
 		return nil, WrapError(err)
 	}
 	var raw interface{}
 	var requestInfo *tablestore.TableStoreClient
-	err = resource.Retry(5*time.Minute, func() *resource.RetryError {
+	err = resource.RetryContext(ctx, 5*time.Minute, func() *resource.RetryError {
 		raw, err = s.client.WithTableStoreClient(instanceName, func(tableStoreClient *tablestore.TableStoreClient) (interface{}, error) {
 			requestInfo = tableStoreClient
 			return tableStoreClient.ListTable()
@@ -80,7 +81,7 @@ func (s *OtsService) DescribeOtsTable(id string) (*tablestore.DescribeTableRespo
 	}
 	var raw interface{}
 	var requestInfo *tablestore.TableStoreClient
-	err = resource.Retry(5*time.Minute, func() *resource.RetryError {
+	err = resource.RetryContext(s.client.StopContext(), 5*time.Minute, func() *resource.RetryError {
 		raw, err = s.client.WithTableStoreClient(instanceName, func(tableStoreClient *tablestore.TableStoreClient) (interface{}, error) {
 			requestInfo = tableStoreClient
 			return tableStoreClient.DescribeTable(request)
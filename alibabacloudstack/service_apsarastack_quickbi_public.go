@@ -27,7 +27,7 @@ func (s *QuickbiPublicService) DescribeQuickBiUser(id string) (object map[string
 	runtime := util.RuntimeOptions{}
 	runtime.SetAutoretry(true)
 	wait := incrementalWait(3*time.Second, 3*time.Second)
-	err = resource.Retry(5*time.Minute, func() *resource.RetryError {
+	err = resource.RetryContext(s.client.StopContext(), 5*time.Minute, func() *resource.RetryError {
 		response, err = conn.DoRequest(StringPointer(action), nil, StringPointer("POST"), StringPointer("2022-03-01"), StringPointer("AK"), nil, request, &runtime)
 		if err != nil {
 			if NeedRetry(err) {
@@ -66,7 +66,7 @@ func (s *QuickbiPublicService) QueryUserInfoByUserId(id string) (object map[stri
 	runtime := util.RuntimeOptions{}
 	runtime.SetAutoretry(true)
 	wait := incrementalWait(3*time.Second, 3*time.Second)
-	err = resource.Retry(5*time.Minute, func() *resource.RetryError {
+	err = resource.RetryContext(s.client.StopContext(), 5*time.Minute, func() *resource.RetryError {
 		response, err = conn.DoRequest(StringPointer(action), nil, StringPointer("POST"), StringPointer("2022-03-01"), StringPointer("AK"), nil, request, &runtime)
 		if err != nil {
 			if NeedRetry(err) {
@@ -105,7 +105,7 @@ func (s *QuickbiPublicService) DescribeQuickBiUserGroup(id string) (object map[s
 	//runtime := util.RuntimeOptions{}
 	//runtime.SetAutoretry(true)
 	//wait := incrementalWait(3*time.Second, 3*time.Second)
-	//err = resource.Retry(5*time.Minute, func() *resource.RetryError {
+	//err = resource.RetryContext(s.client.StopContext(), 5*time.Minute, func() *resource.RetryError {
 	//	response, err = conn.DoRequest(StringPointer(action), nil, StringPointer("POST"), StringPointer("2022-03-01"), StringPointer("AK"), nil, request, &runtime)
 	//	if err != nil {
 	//		if NeedRetry(err) {
@@ -144,7 +144,7 @@ func (s *QuickbiPublicService) DescribeQuickBiWorkspace(id string) (object map[s
 	//runtime := util.RuntimeOptions{}
 	//runtime.SetAutoretry(true)
 	//wait := incrementalWait(3*time.Second, 3*time.Second)
-	//err = resource.Retry(5*time.Minute, func() *resource.RetryError {
+	//err = resource.RetryContext(s.client.StopContext(), 5*time.Minute, func() *resource.RetryError {
 	//	response, err = conn.DoRequest(StringPointer(action), nil, StringPointer("POST"), StringPointer("2022-03-01"), StringPointer("AK"), nil, request, &runtime)
 	//	if err != nil {
 	//		if NeedRetry(err) {
@@ -1,6 +1,7 @@
 package alibabacloudstack
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
 	"log"
@@ -235,7 +236,7 @@ func (s *RdsService) DescribeDBDatabase(id string) (*rds.Database, error) {
 	request.DBInstanceId = parts[0]
 	request.DBName = dbName
 
-	err = resource.Retry(5*time.Minute, func() *resource.RetryError {
+	err = resource.RetryContext(s.client.StopContext(), 5*time.Minute, func() *resource.RetryError {
 		raw, err := s.client.WithRdsClient(func(rdsClient *rds.Client) (interface{}, error) {
 			return rdsClient.DescribeDatabases(request)
 		})
@@ -507,7 +508,7 @@ func (s *RdsService) DescribeDBReadWriteSplittingConnection(id string) (*rds.DBI
 	return ds, WrapErrorf(Error(GetNotFoundMessage("ReadWriteSplittingConnection", id)), NotFoundMsg, ProviderERROR)
 }
 
-func (s *RdsService) GrantAccountPrivilege(id, dbName string) error {
+func (s *RdsService) GrantAccountPrivilege(ctx context.Context, id, dbName string) error {
 	parts, err := ParseResourceId(id, 3)
 	if err != nil {
 		return WrapError(err)
@@ -526,7 +527,7 @@ func (s *RdsService) GrantAccountPrivilege(id, dbName string) error {
 	request.DBName = dbName
 	request.AccountPrivilege = parts[2]
 
-	err = resource.Retry(3*time.Minute, func() *resource.RetryError {
+	err = resource.RetryContext(ctx, 3*time.Minute, func() *resource.RetryError {
 		raw, err := s.client.WithRdsClient(func(rdsClient *rds.Client) (interface{}, error) {
 			return rdsClient.GrantAccountPrivilege(request)
 		})
@@ -553,7 +554,7 @@ func (s *RdsService) GrantAccountPrivilege(id, dbName string) error {
 	return nil
 }
 
-func (s *RdsService) RevokeAccountPrivilege(id, dbName string) error {
+func (s *RdsService) RevokeAccountPrivilege(ctx context.Context, id, dbName string) error {
 	parts, err := ParseResourceId(id, 3)
 	if err != nil {
 		return WrapError(err)
@@ -571,7 +572,7 @@ func (s *RdsService) RevokeAccountPrivilege(id, dbName string) error {
 	request.AccountName = parts[1]
 	request.DBName = dbName
 
-	err = resource.Retry(3*time.Minute, func() *resource.RetryError {
+	err = resource.RetryContext(ctx, 3*time.Minute, func() *resource.RetryError {
 		raw, err := s.client.WithRdsClient(func(rdsClient *rds.Client) (interface{}, error) {
 			return rdsClient.RevokeAccountPrivilege(request)
 		})
@@ -1458,9 +1459,9 @@ func (s *RdsService) flattenDBSecurityIPs(list []rds.DBInstanceIPArray) []map[st
 	return result
 }
 
-func (s *RdsService) setInstanceTags(d *schema.ResourceData) error {
-	if d.HasChange("tags") {
-		oraw, nraw := d.GetChange("tags")
+func (s *RdsService) setInstanceTags(ctx context.Context, d *schema.ResourceData) error {
+	if hasTagsAllChange(d, s.client) {
+		oraw, nraw := getTagsAllChange(d, s.client)
 		o := oraw.(map[string]interface{})
 		n := nraw.(map[string]interface{})
 		remove, add := diffRdsTags(o, n)
@@ -1480,7 +1481,7 @@ func (s *RdsService) setInstanceTags(d *schema.ResourceData) error {
 			request.QueryParams = map[string]string{"AccessKeySecret": s.client.SecretKey, "Product": "rds", "Department": s.client.Department, "ResourceGroup": s.client.ResourceGroup}
 
 			wait := incrementalWait(1*time.Second, 2*time.Second)
-			err := resource.Retry(10*time.Minute, func() *resource.RetryError {
+			err := resource.RetryContext(ctx, 10*time.Minute, func() *resource.RetryError {
 				raw, err := s.client.WithRdsClient(func(client *rds.Client) (interface{}, error) {
 					return client.UntagResources(request)
 				})
@@ -1514,7 +1515,7 @@ func (s *RdsService) setInstanceTags(d *schema.ResourceData) error {
 			request.Headers = map[string]string{"RegionId": s.client.RegionId}
 			request.QueryParams = map[string]string{"AccessKeySecret": s.client.SecretKey, "Product": "rds", "Department": s.client.Department, "ResourceGroup": s.client.ResourceGroup}
 			wait := incrementalWait(1*time.Second, 2*time.Second)
-			err := resource.Retry(10*time.Minute, func() *resource.RetryError {
+			err := resource.RetryContext(ctx, 10*time.Minute, func() *resource.RetryError {
 				raw, err := s.client.WithRdsClient(func(client *rds.Client) (interface{}, error) {
 					return client.TagResources(request)
 				})
@@ -1,6 +1,7 @@
 package alibabacloudstack
 
 import (
+	"context"
 	"fmt"
 	"time"
 
@@ -27,6 +28,9 @@ func (s *RosService) DescribeRosChangeSet(id string) (object map[string]interfac
 		"ChangeSetId":  id,
 		"ShowTemplate": true,
 	}
+	request["Product"] = "ROS"
+	request["product"] = "ROS"
+	request["OrganizationId"] = s.client.Department
 	runtime := util.RuntimeOptions{}
 	runtime.SetAutoretry(true)
 	response, err = conn.DoRequest(StringPointer(action), nil, StringPointer("POST"), StringPointer("2019-09-10"), StringPointer("AK"), nil, request, &runtime)
@@ -156,7 +160,7 @@ func (s *RosService) GetStackPolicy(id string) (object map[string]interface{}, e
 	return object, nil
 }
 
-func (s *RosService) ListTagResources(id string, resourceType string) (object interface{}, err error) {
+func (s *RosService) ListTagResources(ctx context.Context, id string, resourceType string) (object interface{}, err error) {
 	conn, err := s.client.NewRosClient()
 	if err != nil {
 		return nil, WrapError(err)
@@ -175,7 +179,7 @@ func (s *RosService) ListTagResources(id string, resourceType string) (object in
 
 	for {
 		wait := incrementalWait(3*time.Second, 5*time.Second)
-		err = resource.Retry(5*time.Minute, func() *resource.RetryError {
+		err = resource.RetryContext(ctx, 5*time.Minute, func() *resource.RetryError {
 			response, err := conn.DoRequest(StringPointer(action), nil, StringPointer("POST"), StringPointer("2019-09-10"), StringPointer("AK"), nil, request, &util.RuntimeOptions{})
 			if err != nil {
 				if IsExpectedErrors(err, []string{Throttling}) {
@@ -207,10 +211,10 @@ func (s *RosService) ListTagResources(id string, resourceType string) (object in
 	return tags, nil
 }
 
-func (s *RosService) SetResourceTags(d *schema.ResourceData, resourceType string) error {
+func (s *RosService) SetResourceTags(ctx context.Context, d *schema.ResourceData, resourceType string) error {
 
-	if d.HasChange("tags") {
-		added, removed := parsingTags(d)
+	if hasTagsAllChange(d, s.client) {
+		added, removed := parsingTags(d, s.client)
 		conn, err := s.client.NewRosClient()
 		if err != nil {
 			return WrapError(err)
@@ -236,7 +240,7 @@ func (s *RosService) SetResourceTags(d *schema.ResourceData, resourceType string
 				request[fmt.Sprintf("TagKey.%d", i+1)] = key
 			}
 			wait := incrementalWait(2*time.Second, 1*time.Second)
-			err := resource.Retry(10*time.Minute, func() *resource.RetryError {
+			err := resource.RetryContext(ctx, 10*time.Minute, func() *resource.RetryError {
 				response, err := conn.DoRequest(StringPointer(action), nil, StringPointer("POST"), StringPointer("2019-09-10"), StringPointer("AK"), nil, request, &util.RuntimeOptions{})
 				if err != nil {
 					if IsThrottling(err) {
@@ -271,7 +275,7 @@ func (s *RosService) SetResourceTags(d *schema.ResourceData, resourceType string
 			}
 
 			wait := incrementalWait(2*time.Second, 1*time.Second)
-			err := resource.Retry(10*time.Minute, func() *resource.RetryError {
+			err := resource.RetryContext(ctx, 10*time.Minute, func() *resource.RetryError {
 				response, err := conn.DoRequest(StringPointer(action), nil, StringPointer("POST"), StringPointer("2019-09-10"), StringPointer("AK"), nil, request, &util.RuntimeOptions{})
 				if err != nil {
 					if IsThrottling(err) {
@@ -347,6 +351,122 @@ func (s *RosService) RosStackGroupStateRefreshFunc(id string, failStates []strin
 	}
 }
 
+func (s *RosService) DescribeRosStackInstance(id string) (object map[string]interface{}, err error) {
+	var response map[string]interface{}
+	conn, err := s.client.NewRosClient()
+	if err != nil {
+		return nil, WrapError(err)
+	}
+	parts, err := ParseResourceId(id, 3)
+	if err != nil {
+		return nil, WrapError(err)
+	}
+	action := "GetStackInstance"
+	request := map[string]interface{}{
+		"RegionId":               s.client.RegionId,
+		"StackGroupName":         parts[0],
+		"StackInstanceAccountId": parts[1],
+		"StackInstanceRegionId":  parts[2],
+	}
+	request["Product"] = "ROS"
+	request["product"] = "ROS"
+	request["OrganizationId"] = s.client.Department
+	runtime := util.RuntimeOptions{}
+	runtime.SetAutoretry(true)
+	response, err = conn.DoRequest(StringPointer(action), nil, StringPointer("POST"), StringPointer("2019-09-10"), StringPointer("AK"), nil, request, &runtime)
+	if err != nil {
+		if IsExpectedErrors(err, []string{"StackGroupNotFound", "StackInstanceNotFound"}) {
+			err = WrapErrorf(Error(GetNotFoundMessage("RosStackInstance", id)), NotFoundMsg, ProviderERROR)
+			return object, err
+		}
+		err = WrapErrorf(err, DefaultErrorMsg, id, action, AlibabacloudStackSdkGoERROR)
+		return object, err
+	}
+	addDebug(action, response, request)
+	v, err := jsonpath.Get("$.StackInstance", response)
+	if err != nil {
+		return object, WrapErrorf(err, FailedGetAttributeMsg, id, "$.StackInstance", response)
+	}
+	object = v.(map[string]interface{})
+	return object, nil
+}
+
+func (s *RosService) RosStackInstanceStateRefreshFunc(id string, failStates []string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		object, err := s.DescribeRosStackInstance(id)
+		if err != nil {
+			if NotFoundError(err) {
+				// Set this to nil as if we didn't find anything.
+				return nil, "", nil
+			}
+			return nil, "", WrapError(err)
+		}
+
+		for _, failState := range failStates {
+			if object["Status"].(string) == failState {
+				return object, object["Status"].(string), WrapError(Error(FailedToReachTargetStatus, object["Status"].(string)))
+			}
+		}
+		return object, object["Status"].(string), nil
+	}
+}
+
+// DescribeRosStackGroupOperation returns the operation of the stack group, which is started when the stack group
+// is updated or the stack instances are created, updated or deleted.
+func (s *RosService) DescribeRosStackGroupOperation(id string) (object map[string]interface{}, err error) {
+	var response map[string]interface{}
+	conn, err := s.client.NewRosClient()
+	if err != nil {
+		return nil, WrapError(err)
+	}
+	action := "GetStackGroupOperation"
+	request := map[string]interface{}{
+		"RegionId":    s.client.RegionId,
+		"OperationId": id,
+	}
+	request["Product"] = "ROS"
+	request["product"] = "ROS"
+	request["OrganizationId"] = s.client.Department
+	runtime := util.RuntimeOptions{}
+	runtime.SetAutoretry(true)
+	response, err = conn.DoRequest(StringPointer(action), nil, StringPointer("POST"), StringPointer("2019-09-10"), StringPointer("AK"), nil, request, &runtime)
+	if err != nil {
+		if IsExpectedErrors(err, []string{"StackGroupOperationNotFound"}) {
+			err = WrapErrorf(Error(GetNotFoundMessage("RosStackGroupOperation", id)), NotFoundMsg, ProviderERROR)
+			return object, err
+		}
+		err = WrapErrorf(err, DefaultErrorMsg, id, action, AlibabacloudStackSdkGoERROR)
+		return object, err
+	}
+	addDebug(action, response, request)
+	v, err := jsonpath.Get("$.StackGroupOperation", response)
+	if err != nil {
+		return object, WrapErrorf(err, FailedGetAttributeMsg, id, "$.StackGroupOperation", response)
+	}
+	object = v.(map[string]interface{})
+	return object, nil
+}
+
+func (s *RosService) RosStackGroupOperationStateRefreshFunc(id string, failStates []string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		object, err := s.DescribeRosStackGroupOperation(id)
+		if err != nil {
+			if NotFoundError(err) {
+				// Set this to nil as if we didn't find anything.
+				return nil, "", nil
+			}
+			return nil, "", WrapError(err)
+		}
+
+		for _, failState := range failStates {
+			if object["Status"].(string) == failState {
+				return object, object["Status"].(string), WrapError(Error(FailedToReachTargetStatus, object["Status"].(string)))
+			}
+		}
+		return object, object["Status"].(string), nil
+	}
+}
+
 func (s *RosService) DescribeRosTemplate(id string) (object map[string]interface{}, err error) {
 	var response map[string]interface{}
 	conn, err := s.client.NewRosClient()
@@ -1,6 +1,7 @@
 package alibabacloudstack
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
 	"log"
@@ -214,7 +215,7 @@ func (s *SlbService) DescribeSlbListener(id string) (listener map[string]interfa
 	request.QueryParams["LoadBalancerId"] = parts[0]
 	port, _ := strconv.Atoi(parts[2])
 	request.QueryParams["ListenerPort"] = string(requests.NewInteger(port))
-	err = resource.Retry(5*time.Minute, func() *resource.RetryError {
+	err = resource.RetryContext(s.client.StopContext(), 5*time.Minute, func() *resource.RetryError {
 		raw, err := s.client.WithSlbClient(func(slbClient *slb.Client) (interface{}, error) {
 			return slbClient.ProcessCommonRequest(request)
 		})
@@ -711,7 +712,7 @@ func (s *SlbService) DescribeDomainExtensionAttribute(domainExtensionId string)
 	request.DomainExtensionId = domainExtensionId
 	var raw interface{}
 	var err error
-	err = resource.Retry(5*time.Minute, func() *resource.RetryError {
+	err = resource.RetryContext(s.client.StopContext(), 5*time.Minute, func() *resource.RetryError {
 		raw, err = s.client.WithSlbClient(func(slbClient *slb.Client) (interface{}, error) {
 			return slbClient.DescribeDomainExtensionAttribute(request)
 		})
@@ -758,8 +759,8 @@ func (s *SlbService) WaitForSlbDomainExtension(id string, status Status, timeout
 	}
 }
 
-func (s *SlbService) setInstanceTags(d *schema.ResourceData, resourceType TagResourceType) error {
-	oraw, nraw := d.GetChange("tags")
+func (s *SlbService) setInstanceTags(ctx context.Context, d *schema.ResourceData, resourceType TagResourceType) error {
+	oraw, nraw := getTagsAllChange(d, s.client)
 	o := oraw.(map[string]interface{})
 	n := nraw.(map[string]interface{})
 	create, remove := s.diffTags(s.tagsFromMap(o), s.tagsFromMap(n))
@@ -784,7 +785,7 @@ func (s *SlbService) setInstanceTags(d *schema.ResourceData, resourceType TagRes
 		request.RegionId = s.client.RegionId
 
 		wait := incrementalWait(1*time.Second, 1*time.Second)
-		err := resource.Retry(10*time.Minute, func() *resource.RetryError {
+		err := resource.RetryContext(ctx, 10*time.Minute, func() *resource.RetryError {
 			raw, err := s.client.WithSlbClient(func(client *slb.Client) (interface{}, error) {
 				return client.RemoveTags(request)
 			})
@@ -820,7 +821,7 @@ func (s *SlbService) setInstanceTags(d *schema.ResourceData, resourceType TagRes
 		request.RegionId = s.client.RegionId
 
 		wait := incrementalWait(1*time.Second, 1*time.Second)
-		err := resource.Retry(10*time.Minute, func() *resource.RetryError {
+		err := resource.RetryContext(ctx, 10*time.Minute, func() *resource.RetryError {
 			raw, err := s.client.WithSlbClient(func(client *slb.Client) (interface{}, error) {
 				return client.AddTags(request)
 			})
@@ -898,7 +899,7 @@ func (s *SlbService) tagsFromMap(m map[string]interface{}) []slb.TagSet {
 	return result
 }
 
-func (s *SlbService) DescribeTags(resourceId string, resourceTags map[string]interface{}, resourceType TagResourceType) (tags []slb.TagSet, err error) {
+func (s *SlbService) DescribeTags(ctx context.Context, resourceId string, resourceTags map[string]interface{}, resourceType TagResourceType) (tags []slb.TagSet, err error) {
 	request := slb.CreateDescribeTagsRequest()
 	request.RegionId = s.client.RegionId
 	if strings.ToLower(s.client.Config.Protocol) == "https" {
@@ -921,7 +922,7 @@ func (s *SlbService) DescribeTags(resourceId string, resourceTags map[string]int
 		s2 := string(bytes)
 		request.Tags = fmt.Sprint(s2)
 	}
-	err = resource.Retry(5*time.Minute, func() *resource.RetryError {
+	err = resource.RetryContext(ctx, 5*time.Minute, func() *resource.RetryError {
 		raw, err := s.client.WithSlbClient(func(Client *slb.Client) (interface{}, error) {
 			return Client.DescribeTags(request)
 		})
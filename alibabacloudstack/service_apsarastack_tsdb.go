@@ -0,0 +1,109 @@
+package alibabacloudstack
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/PaesslerAG/jsonpath"
+	util "github.com/alibabacloud-go/tea-utils/service"
+	"github.com/aliyun/terraform-provider-alibabacloudstack/alibabacloudstack/connectivity"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+type TsdbService struct {
+	client *connectivity.AlibabacloudStackClient
+}
+
+// doTsdbRequest sends the request to the hitsdb api with the common parameters of the Apsara Stack.
+func (s *TsdbService) doTsdbRequest(action string, request map[string]interface{}) (map[string]interface{}, error) {
+	conn, err := s.client.NewHitsdbClient()
+	if err != nil {
+		return nil, WrapError(err)
+	}
+	request["RegionId"] = s.client.RegionId
+	request["Product"] = "hitsdb"
+	request["OrganizationId"] = s.client.Department
+	runtime := util.RuntimeOptions{}
+	runtime.SetAutoretry(true)
+	response, err := conn.DoRequest(StringPointer(action), nil, StringPointer("POST"), StringPointer("2017-06-01"), StringPointer("AK"), nil, request, &runtime)
+	addDebug(action, response, request)
+	return response, err
+}
+
+func (s *TsdbService) DescribeTsdbInstance(id string) (object map[string]interface{}, err error) {
+	action := "DescribeHiTSDBInstance"
+	request := map[string]interface{}{
+		"InstanceId": id,
+	}
+	response, err := s.doTsdbRequest(action, request)
+	if err != nil {
+		if IsExpectedErrors(err, []string{"Instance.IsDeleted", "Instance.NotFound", "InvalidInstanceId.NotFound"}) {
+			err = WrapErrorf(Error(GetNotFoundMessage("TsdbInstance", id)), NotFoundMsg, ProviderERROR)
+			return object, err
+		}
+		err = WrapErrorf(err, DefaultErrorMsg, id, action, AlibabacloudStackSdkGoERROR)
+		return object, err
+	}
+	v, err := jsonpath.Get("$", response)
+	if err != nil {
+		return object, WrapErrorf(err, FailedGetAttributeMsg, id, "$", response)
+	}
+	object = v.(map[string]interface{})
+	if fmt.Sprint(object["InstanceId"]) != id {
+		return object, WrapErrorf(Error(GetNotFoundMessage("TsdbInstance", id)), NotFoundMsg, ProviderERROR)
+	}
+	return object, nil
+}
+
+func (s *TsdbService) DescribeTsdbInstanceSecurityIps(id string) ([]string, error) {
+	action := "DescribeHiTSDBInstanceSecurityIpList"
+	request := map[string]interface{}{
+		"InstanceId": id,
+	}
+	response, err := s.doTsdbRequest(action, request)
+	if err != nil {
+		return nil, WrapErrorf(err, DefaultErrorMsg, id, action, AlibabacloudStackSdkGoERROR)
+	}
+	v, err := jsonpath.Get("$.SecurityIpList", response)
+	if err != nil {
+		return nil, WrapErrorf(err, FailedGetAttributeMsg, id, "$.SecurityIpList", response)
+	}
+	if m, ok := v.(map[string]interface{}); ok {
+		v = m["SecurityIp"]
+	}
+	items, _ := v.([]interface{})
+	ips := make([]string, 0)
+	for _, item := range items {
+		ip := fmt.Sprint(item)
+		if m, ok := item.(map[string]interface{}); ok {
+			ip = fmt.Sprint(m["Ip"])
+		}
+		// The ips of one group are returned joined by commas.
+		for _, i := range strings.Split(ip, ",") {
+			if i != "" {
+				ips = append(ips, i)
+			}
+		}
+	}
+	return ips, nil
+}
+
+func (s *TsdbService) TsdbInstanceStateRefreshFunc(id string, failStates []string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		object, err := s.DescribeTsdbInstance(id)
+		if err != nil {
+			if NotFoundError(err) {
+				// Set this to nil as if we didn't find anything.
+				return nil, "", nil
+			}
+			return nil, "", WrapError(err)
+		}
+
+		for _, failState := range failStates {
+			if fmt.Sprint(object["Status"]) == failState {
+				return object, fmt.Sprint(object["Status"]), WrapError(Error(FailedToReachTargetStatus, fmt.Sprint(object["Status"])))
+			}
+		}
+		return object, fmt.Sprint(object["Status"]), nil
+	}
+}
@@ -1,6 +1,7 @@
 package alibabacloudstack
 
 import (
+	"context"
 	"fmt"
 	"github.com/PaesslerAG/jsonpath"
 	util "github.com/alibabacloud-go/tea-utils/service"
@@ -1060,7 +1061,7 @@ func (s *VpcService) DescribeNetworkAcl(id string) (object map[string]interface{
 	runtime := util.RuntimeOptions{}
 	runtime.SetAutoretry(true)
 	wait := incrementalWait(3*time.Second, 3*time.Second)
-	err = resource.Retry(5*time.Minute, func() *resource.RetryError {
+	err = resource.RetryContext(s.client.StopContext(), 5*time.Minute, func() *resource.RetryError {
 		response, err = conn.DoRequest(StringPointer(action), nil, StringPointer("POST"), StringPointer("2016-04-28"), StringPointer("AK"), nil, request, &runtime)
 		if err != nil {
 			if NeedRetry(err) {
@@ -1180,7 +1181,7 @@ func (s *VpcService) WaitForNetworkAclAttachment(id string, resource []vpc.Resou
 	}
 }
 
-func (s *VpcService) DescribeTags(resourceId string, resourceTags map[string]interface{}, resourceType TagResourceType) (tags []vpc.TagResource, err error) {
+func (s *VpcService) DescribeTags(ctx context.Context, resourceId string, resourceTags map[string]interface{}, resourceType TagResourceType) (tags []vpc.TagResource, err error) {
 	request := vpc.CreateListTagResourcesRequest()
 	request.RegionId = s.client.RegionId
 	if strings.ToLower(s.client.Config.Protocol) == "https" {
@@ -1206,7 +1207,7 @@ func (s *VpcService) DescribeTags(resourceId string, resourceTags map[string]int
 	wait := incrementalWait(3*time.Second, 5*time.Second)
 	var raw interface{}
 
-	err = resource.Retry(5*time.Minute, func() *resource.RetryError {
+	err = resource.RetryContext(ctx, 5*time.Minute, func() *resource.RetryError {
 		raw, err = s.client.WithVpcClient(func(vpcClient *vpc.Client) (interface{}, error) {
 			return vpcClient.ListTagResources(request)
 		})
@@ -1229,9 +1230,9 @@ func (s *VpcService) DescribeTags(resourceId string, resourceTags map[string]int
 	return response.TagResources.TagResource, nil
 }
 
-func (s *VpcService) setInstanceTags(d *schema.ResourceData, resourceType TagResourceType) error {
-	if d.HasChange("tags") {
-		oraw, nraw := d.GetChange("tags")
+func (s *VpcService) setInstanceTags(ctx context.Context, d *schema.ResourceData, resourceType TagResourceType) error {
+	if hasTagsAllChange(d, s.client) {
+		oraw, nraw := getTagsAllChange(d, s.client)
 		o := oraw.(map[string]interface{})
 		n := nraw.(map[string]interface{})
 		create, remove := s.diffTags(s.tagsFromMap(o), s.tagsFromMap(n))
@@ -1255,7 +1256,7 @@ func (s *VpcService) setInstanceTags(d *schema.ResourceData, resourceType TagRes
 			request.QueryParams = map[string]string{"AccessKeySecret": s.client.SecretKey, "Product": "vpc", "Department": s.client.Department, "ResourceGroup": s.client.ResourceGroup}
 
 			wait := incrementalWait(2*time.Second, 1*time.Second)
-			err := resource.Retry(10*time.Minute, func() *resource.RetryError {
+			err := resource.RetryContext(ctx, 10*time.Minute, func() *resource.RetryError {
 				raw, err := s.client.WithVpcClient(func(client *vpc.Client) (interface{}, error) {
 					return client.UnTagResources(request)
 				})
@@ -1290,7 +1291,7 @@ func (s *VpcService) setInstanceTags(d *schema.ResourceData, resourceType TagRes
 			request.QueryParams = map[string]string{"AccessKeySecret": s.client.SecretKey, "Product": "vpc", "Department": s.client.Department, "ResourceGroup": s.client.ResourceGroup}
 
 			wait := incrementalWait(2*time.Second, 1*time.Second)
-			err := resource.Retry(10*time.Minute, func() *resource.RetryError {
+			err := resource.RetryContext(ctx, 10*time.Minute, func() *resource.RetryError {
 				raw, err := s.client.WithVpcClient(func(client *vpc.Client) (interface{}, error) {
 					return client.TagResources(request)
 				})
@@ -1441,7 +1442,7 @@ func (s *VpcService) NetworkAclStateRefreshFunc(id string, failStates []string)
 	}
 }
 
-func (s *VpcService) DeleteAclResources(id string) (object map[string]interface{}, err error) {
+func (s *VpcService) DeleteAclResources(ctx context.Context, id string) (object map[string]interface{}, err error) {
 	acl, err := s.DescribeNetworkAcl(id)
 	if err != nil {
 		return object, WrapError(err)
@@ -1480,7 +1481,7 @@ func (s *VpcService) DeleteAclResources(id string) (object map[string]interface{
 	runtime := util.RuntimeOptions{}
 	runtime.SetAutoretry(true)
 	wait := incrementalWait(3*time.Second, 3*time.Second)
-	err = resource.Retry(10*time.Minute, func() *resource.RetryError {
+	err = resource.RetryContext(ctx, 10*time.Minute, func() *resource.RetryError {
 		response, err = conn.DoRequest(StringPointer(action), nil, StringPointer("POST"), StringPointer("2016-04-28"), StringPointer("AK"), nil, request, &runtime)
 		if err != nil {
 			if NeedRetry(err) {
@@ -1496,7 +1497,7 @@ func (s *VpcService) DeleteAclResources(id string) (object map[string]interface{
 		return response, WrapErrorf(err, DefaultErrorMsg, id, action, AlibabacloudStackSdkGoERROR)
 	}
 	stateConf := BuildStateConf([]string{}, []string{"Available"}, 10*time.Minute, 5*time.Second, s.NetworkAclStateRefreshFunc(id, []string{}))
-	if _, err := stateConf.WaitForState(); err != nil {
+	if _, err := stateConf.WaitForStateContext(ctx); err != nil {
 		return response, WrapErrorf(err, IdMsg, id)
 	}
 	return object, nil
@@ -1525,7 +1526,7 @@ func (s *VpcService) DescribeVpcIpv6EgressRule(id string) (object map[string]int
 		runtime := util.RuntimeOptions{}
 		runtime.SetAutoretry(true)
 		wait := incrementalWait(3*time.Second, 3*time.Second)
-		err = resource.Retry(5*time.Minute, func() *resource.RetryError {
+		err = resource.RetryContext(s.client.StopContext(), 5*time.Minute, func() *resource.RetryError {
 			request["Product"] = "Vpc"
 			request["OrganizationId"] = s.client.Department
 			response, err = conn.DoRequest(StringPointer(action), nil, StringPointer("POST"), StringPointer("2016-04-28"), StringPointer("AK"), nil, request, &runtime)
@@ -1600,7 +1601,7 @@ func (s *VpcService) DescribeVpcIpv6Gateway(id string) (object map[string]interf
 	runtime := util.RuntimeOptions{}
 	runtime.SetAutoretry(true)
 	wait := incrementalWait(3*time.Second, 3*time.Second)
-	err = resource.Retry(5*time.Minute, func() *resource.RetryError {
+	err = resource.RetryContext(s.client.StopContext(), 5*time.Minute, func() *resource.RetryError {
 		request["Product"] = "Vpc"
 		request["OrganizationId"] = s.client.Department
 		response, err = conn.DoRequest(StringPointer(action), nil, StringPointer("POST"), StringPointer("2016-04-28"), StringPointer("AK"), nil, request, &runtime)
@@ -1662,7 +1663,7 @@ func (s *VpcService) DescribeVpcIpv6InternetBandwidth(id string) (object map[str
 	runtime := util.RuntimeOptions{}
 	runtime.SetAutoretry(true)
 	wait := incrementalWait(3*time.Second, 3*time.Second)
-	err = resource.Retry(5*time.Minute, func() *resource.RetryError {
+	err = resource.RetryContext(s.client.StopContext(), 5*time.Minute, func() *resource.RetryError {
 		request["Product"] = "Vpc"
 		request["OrganizationId"] = s.client.Department
 		response, err = conn.DoRequest(StringPointer(action), nil, StringPointer("POST"), StringPointer("2016-04-28"), StringPointer("AK"), nil, request, &runtime)
@@ -1743,10 +1744,10 @@ func (s *VpcService) setInstanceSecondaryCidrBlocks(d *schema.ResourceData) erro
 	return nil
 }
 
-func (s *VpcService) SetResourceTags(d *schema.ResourceData, resourceType string) error {
+func (s *VpcService) SetResourceTags(ctx context.Context, d *schema.ResourceData, resourceType string) error {
 
-	if d.HasChange("tags") {
-		added, removed := parsingTags(d)
+	if hasTagsAllChange(d, s.client) {
+		added, removed := parsingTags(d, s.client)
 		conn, err := s.client.NewVpcClient()
 		if err != nil {
 			return WrapError(err)
@@ -1769,7 +1770,7 @@ func (s *VpcService) SetResourceTags(d *schema.ResourceData, resourceType string
 				request[fmt.Sprintf("TagKey.%d", i+1)] = key
 			}
 			wait := incrementalWait(2*time.Second, 1*time.Second)
-			err := resource.Retry(10*time.Minute, func() *resource.RetryError {
+			err := resource.RetryContext(ctx, 10*time.Minute, func() *resource.RetryError {
 				request["Product"] = "Vpc"
 				request["OrganizationId"] = s.client.Department
 				response, err := conn.DoRequest(StringPointer(action), nil, StringPointer("POST"), StringPointer("2016-04-28"), StringPointer("AK"), nil, request, &util.RuntimeOptions{})
@@ -1803,7 +1804,7 @@ func (s *VpcService) SetResourceTags(d *schema.ResourceData, resourceType string
 			}
 
 			wait := incrementalWait(2*time.Second, 1*time.Second)
-			err := resource.Retry(10*time.Minute, func() *resource.RetryError {
+			err := resource.RetryContext(ctx, 10*time.Minute, func() *resource.RetryError {
 				request["Product"] = "Vpc"
 				request["OrganizationId"] = s.client.Department
 				response, err := conn.DoRequest(StringPointer(action), nil, StringPointer("POST"), StringPointer("2016-04-28"), StringPointer("AK"), nil, request, &util.RuntimeOptions{})
@@ -8,6 +8,7 @@ import (
 	"encoding/json"
 	"fmt"
 
+	"github.com/aliyun/alibaba-cloud-sdk-go/sdk/requests"
 	"github.com/aliyun/alibaba-cloud-sdk-go/services/vpc"
 	"github.com/aliyun/terraform-provider-alibabacloudstack/alibabacloudstack/connectivity"
 )
@@ -165,6 +166,36 @@ func (s *VpnGatewayService) DescribeVpnRouteEntry(id string) (v vpc.VpnRouteEntr
 	return v, WrapErrorf(Error(GetNotFoundMessage("VpnRouterEntry", id)), NotFoundMsg, ProviderERROR)
 }
 
+func (s *VpnGatewayService) DescribeVpnRouteEntries(gatewayId string) ([]vpc.VpnRouteEntry, error) {
+	request := vpc.CreateDescribeVpnRouteEntriesRequest()
+	request.Headers["x-ascm-product-name"] = "Vpc"
+	request.Headers["x-acs-organizationId"] = s.client.Department
+	request.VpnGatewayId = gatewayId
+	request.PageSize = requests.NewInteger(PageSizeLarge)
+	request.PageNumber = requests.NewInteger(1)
+	var entries []vpc.VpnRouteEntry
+	for {
+		raw, err := s.client.WithVpcClient(func(vpcClient *vpc.Client) (interface{}, error) {
+			return vpcClient.DescribeVpnRouteEntries(request)
+		})
+		if err != nil {
+			return nil, WrapErrorf(err, DefaultErrorMsg, gatewayId, request.GetActionName(), AlibabacloudStackSdkGoERROR)
+		}
+		addDebug(request.GetActionName(), raw, request.RpcRequest, request)
+		response, _ := raw.(*vpc.DescribeVpnRouteEntriesResponse)
+		entries = append(entries, response.VpnRouteEntries.VpnRouteEntry...)
+		if len(response.VpnRouteEntries.VpnRouteEntry) < PageSizeLarge {
+			break
+		}
+		page, err := getNextpageNumber(request.PageNumber)
+		if err != nil {
+			return nil, WrapError(err)
+		}
+		request.PageNumber = page
+	}
+	return entries, nil
+}
+
 func (s *VpnGatewayService) WaitForVpnGateway(id string, status Status, timeout int) error {
 	deadline := time.Now().Add(time.Duration(timeout) * time.Second)
 	for {
@@ -1,7 +1,9 @@
 package alibabacloudstack
 
 import (
+	"context"
 	"log"
+	"reflect"
 	"strings"
 
 	"github.com/aliyun/alibaba-cloud-sdk-go/services/slb"
@@ -22,6 +24,7 @@ import (
 	"github.com/aliyun/alibaba-cloud-sdk-go/services/rds"
 	"github.com/aliyun/alibaba-cloud-sdk-go/services/vpc"
 	"github.com/aliyun/terraform-provider-alibabacloudstack/alibabacloudstack/connectivity"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
@@ -62,10 +65,10 @@ func elasticsearchTagIgnored(tagKey, tagValue string) bool {
 
 // setTags is a helper to set the tags for a resource. It expects the
 // tags field to be named "tags"
-func setTags(client *connectivity.AlibabacloudStackClient, resourceType TagResourceType, d *schema.ResourceData) error {
-	if d.HasChange("tags") {
-		oraw, nraw := d.GetChange("tags")
-		return updateTags(client, []string{d.Id()}, resourceType, oraw, nraw)
+func setTags(ctx context.Context, client *connectivity.AlibabacloudStackClient, resourceType TagResourceType, d *schema.ResourceData) error {
+	if hasTagsAllChange(d, client) {
+		oraw, nraw := getTagsAllChange(d, client)
+		return updateTags(ctx, client, []string{d.Id()}, resourceType, oraw, nraw)
 	}
 
 	return nil
@@ -80,7 +83,7 @@ func setCdnTags(client *connectivity.AlibabacloudStackClient, resourceType TagRe
 	return nil
 }
 
-func setVolumeTags(client *connectivity.AlibabacloudStackClient, resourceType TagResourceType, d *schema.ResourceData) error {
+func setVolumeTags(ctx context.Context, client *connectivity.AlibabacloudStackClient, resourceType TagResourceType, d *schema.ResourceData) error {
 	ecsService := EcsService{client}
 	if d.HasChange("volume_tags") {
 		request := ecs.CreateDescribeDisksRequest()
@@ -94,7 +97,7 @@ func setVolumeTags(client *connectivity.AlibabacloudStackClient, resourceType Ta
 		request.InstanceId = d.Id()
 		var response *ecs.DescribeDisksResponse
 		wait := incrementalWait(1*time.Second, 1*time.Second)
-		err := resource.Retry(10*time.Minute, func() *resource.RetryError {
+		err := resource.RetryContext(ctx, 10*time.Minute, func() *resource.RetryError {
 			raw, err := client.WithEcsClient(func(ecsClient *ecs.Client) (interface{}, error) {
 				return ecsClient.DescribeDisks(request)
 			})
@@ -135,13 +138,13 @@ func setVolumeTags(client *connectivity.AlibabacloudStackClient, resourceType Ta
 		if d.IsNewResource() {
 			oraw = systemDiskTag
 		}
-		return updateTags(client, ids, resourceType, oraw, nraw)
+		return updateTags(ctx, client, ids, resourceType, oraw, nraw)
 	}
 
 	return nil
 }
 
-func updateTags(client *connectivity.AlibabacloudStackClient, ids []string, resourceType TagResourceType, oraw, nraw interface{}) error {
+func updateTags(ctx context.Context, client *connectivity.AlibabacloudStackClient, ids []string, resourceType TagResourceType, oraw, nraw interface{}) error {
 	o := oraw.(map[string]interface{})
 	n := nraw.(map[string]interface{})
 	create, remove := diffTags(tagsFromMap(o), tagsFromMap(n))
@@ -166,7 +169,7 @@ func updateTags(client *connectivity.AlibabacloudStackClient, ids []string, reso
 		request.TagKey = &tagsKey
 
 		wait := incrementalWait(1*time.Second, 1*time.Second)
-		err := resource.Retry(10*time.Minute, func() *resource.RetryError {
+		err := resource.RetryContext(ctx, 10*time.Minute, func() *resource.RetryError {
 			raw, err := client.WithEcsClient(func(ecsClient *ecs.Client) (interface{}, error) {
 				return ecsClient.UntagResources(request)
 			})
@@ -209,7 +212,7 @@ func updateTags(client *connectivity.AlibabacloudStackClient, ids []string, reso
 		request.Tag = &tags
 
 		wait := incrementalWait(1*time.Second, 1*time.Second)
-		err := resource.Retry(10*time.Minute, func() *resource.RetryError {
+		err := resource.RetryContext(ctx, 10*time.Minute, func() *resource.RetryError {
 			raw, err := client.WithEcsClient(func(ecsClient *ecs.Client) (interface{}, error) {
 				return ecsClient.TagResources(request)
 			})
@@ -595,8 +598,8 @@ func ignoredTags(tagKey, tagValue string) bool {
 	}
 	return false
 }
-func parsingTags(d *schema.ResourceData) (map[string]interface{}, []string) {
-	oraw, nraw := d.GetChange("tags")
+func parsingTags(d *schema.ResourceData, client *connectivity.AlibabacloudStackClient) (map[string]interface{}, []string) {
+	oraw, nraw := getTagsAllChange(d, client)
 	removedTags := oraw.(map[string]interface{})
 	addedTags := nraw.(map[string]interface{})
 	// Build the list of what to remove
@@ -611,3 +614,157 @@ func parsingTags(d *schema.ResourceData) (map[string]interface{}, []string) {
 
 	return addedTags, removed
 }
+
+// tagsAllUnsupportedResources are the resources whose tags are not applied to or read from the stack yet,
+// so the provider default_tags can not be merged into them.
+var tagsAllUnsupportedResources = map[string]bool{
+	"alibabacloudstack_alikafka_topic": true,
+	"alibabacloudstack_adb_db_cluster": true,
+	"alibabacloudstack_oss_bucket":     true,
+	"alibabacloudstack_ros_stack":      true,
+	"alibabacloudstack_ros_template":   true,
+}
+
+// mergeDefaultTags merges the provider default_tags into the resource tags, and the resource tags win.
+func mergeDefaultTags(client *connectivity.AlibabacloudStackClient, tags map[string]interface{}) map[string]interface{} {
+	merged := make(map[string]interface{})
+	if client != nil && client.Config != nil {
+		for key, value := range client.Config.DefaultTags {
+			merged[key] = value
+		}
+	}
+	for key, value := range tags {
+		merged[key] = value
+	}
+	return merged
+}
+
+// tagIgnoredByProvider reports whether the tag key is ignored by the provider ignore_tags.
+func tagIgnoredByProvider(client *connectivity.AlibabacloudStackClient, key string) bool {
+	if client == nil || client.Config == nil {
+		return false
+	}
+	for _, k := range client.Config.IgnoreTagKeys {
+		if k == key {
+			return true
+		}
+	}
+	for _, prefix := range client.Config.IgnoreTagKeyPrefixes {
+		if strings.HasPrefix(key, prefix) {
+			return true
+		}
+	}
+	return false
+}
+
+// getTagsAllChange returns the tags applied to the resource before and after the change. The old tags are
+// the tags_all in the state and the new tags are the resource tags merged with the provider default_tags.
+// They are worked out from the configuration, because tags_all is unknown when it's planned as computed.
+func getTagsAllChange(d *schema.ResourceData, client *connectivity.AlibabacloudStackClient) (interface{}, interface{}) {
+	oraw, _ := d.GetChange("tags_all")
+	o, ok := oraw.(map[string]interface{})
+	if !ok || len(o) == 0 {
+		oraw, _ = d.GetChange("tags")
+		o, _ = oraw.(map[string]interface{})
+	}
+	if o == nil {
+		o = make(map[string]interface{})
+	}
+	return o, getTagsAll(d, client)
+}
+
+// getTagsAll returns the resource tags merged with the provider default_tags.
+func getTagsAll(d *schema.ResourceData, client *connectivity.AlibabacloudStackClient) map[string]interface{} {
+	tags, _ := d.Get("tags").(map[string]interface{})
+	return mergeDefaultTags(client, tags)
+}
+
+// getTagsAllOk is like GetOk of the tags, and it returns the tags merged with the provider default_tags.
+func getTagsAllOk(d *schema.ResourceData, client *connectivity.AlibabacloudStackClient) (interface{}, bool) {
+	tags := getTagsAll(d, client)
+	return tags, len(tags) > 0
+}
+
+func hasTagsAllChange(d *schema.ResourceData, client *connectivity.AlibabacloudStackClient) bool {
+	o, n := getTagsAllChange(d, client)
+	return !reflect.DeepEqual(o, n)
+}
+
+// withTagsAll adds the computed tags_all to the taggable resource. It's planned with the provider default_tags,
+// and the tags read from the stack are filtered by the provider ignore_tags before they are saved.
+func withTagsAll(r *schema.Resource) {
+	r.Schema["tags_all"] = &schema.Schema{
+		Type:     schema.TypeMap,
+		Computed: true,
+		Elem:     &schema.Schema{Type: schema.TypeString},
+	}
+
+	tagsAllCustomizeDiff := func(ctx context.Context, d *schema.ResourceDiff, meta interface{}) error {
+		if !d.NewValueKnown("tags") {
+			return d.SetNewComputed("tags_all")
+		}
+		client, _ := meta.(*connectivity.AlibabacloudStackClient)
+		tags, _ := d.Get("tags").(map[string]interface{})
+		tagsAll := mergeDefaultTags(client, tags)
+		if o, _ := d.GetChange("tags_all"); reflect.DeepEqual(o, tagsAll) {
+			return nil
+		}
+		return d.SetNew("tags_all", tagsAll)
+	}
+	if r.CustomizeDiff != nil {
+		r.CustomizeDiff = customizeDiffAll(r.CustomizeDiff, tagsAllCustomizeDiff)
+	} else {
+		r.CustomizeDiff = tagsAllCustomizeDiff
+	}
+
+	type contextFunc = func(context.Context, *schema.ResourceData, interface{}) diag.Diagnostics
+	wrap := func(f contextFunc) contextFunc {
+		if f == nil {
+			return nil
+		}
+		return func(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+			prior, _ := d.Get("tags").(map[string]interface{})
+			diags := f(ctx, d, meta)
+			if diags.HasError() || d.Id() == "" {
+				return diags
+			}
+			client, _ := meta.(*connectivity.AlibabacloudStackClient)
+			if err := setTagsAll(d, client, prior); err != nil {
+				diags = append(diags, diag.FromErr(err)...)
+			}
+			return diags
+		}
+	}
+	r.CreateContext = wrap(r.CreateContext)
+	r.ReadContext = wrap(r.ReadContext)
+	r.UpdateContext = wrap(r.UpdateContext)
+}
+
+// setTagsAll saves the tags read from the stack as tags_all without the ignored tags, and leaves the
+// default tags out of the resource tags unless they are set by the resource with the same values.
+func setTagsAll(d *schema.ResourceData, client *connectivity.AlibabacloudStackClient, prior map[string]interface{}) error {
+	remote, _ := d.Get("tags").(map[string]interface{})
+	tagsAll := make(map[string]interface{})
+	tags := make(map[string]interface{})
+	for key, value := range remote {
+		if tagIgnoredByProvider(client, key) {
+			continue
+		}
+		tagsAll[key] = value
+		if client != nil && client.Config != nil {
+			if defaultValue, ok := client.Config.DefaultTags[key]; ok && defaultValue == value {
+				if priorValue, ok := prior[key]; !ok || priorValue != value {
+					continue
+				}
+			}
+		}
+		tags[key] = value
+	}
+	if err := d.Set("tags", tags); err != nil {
+		return WrapError(err)
+	}
+	if err := d.Set("tags_all", tagsAll); err != nil {
+		return WrapError(err)
+	}
+	return nil
+}
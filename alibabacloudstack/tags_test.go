@@ -0,0 +1,69 @@
+package alibabacloudstack
+
+import (
+	"context"
+	"reflect"
+	"testing"
+
+	"github.com/aliyun/terraform-provider-alibabacloudstack/alibabacloudstack/connectivity"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func TestTagsAllCustomizeDiff(t *testing.T) {
+	r := Provider().ResourcesMap["alibabacloudstack_vpc"]
+	client := &connectivity.AlibabacloudStackClient{Config: &connectivity.Config{
+		DefaultTags: map[string]string{"Environment": "test", "Owner": "provider"},
+	}}
+	raw := map[string]interface{}{
+		"cidr_block": "172.16.0.0/12",
+		"tags":       map[string]interface{}{"Owner": "vpc"},
+	}
+
+	diff, err := r.Diff(context.Background(), nil, terraform.NewResourceConfigRaw(raw), client)
+	if err != nil {
+		t.Fatal(err)
+	}
+	expected := map[string]string{"tags_all.%": "2", "tags_all.Environment": "test", "tags_all.Owner": "vpc"}
+	for key, value := range expected {
+		if attr, ok := diff.Attributes[key]; !ok || attr.New != value {
+			t.Fatalf("expected %s to be planned as %s, got %#v", key, value, attr)
+		}
+	}
+
+	if _, ok := Provider().ResourcesMap["alibabacloudstack_oss_bucket"].Schema["tags_all"]; ok {
+		t.Fatalf("expected the resource which does not apply tags not to have tags_all")
+	}
+}
+
+func TestSetTagsAll(t *testing.T) {
+	client := &connectivity.AlibabacloudStackClient{Config: &connectivity.Config{
+		DefaultTags:          map[string]string{"Environment": "test", "Owner": "provider"},
+		IgnoreTagKeys:        []string{"CreatedBy"},
+		IgnoreTagKeyPrefixes: []string{"acs:"},
+	}}
+	r := &schema.Resource{Schema: map[string]*schema.Schema{"tags": tagsSchema()}}
+	withTagsAll(r)
+	d := r.TestResourceData()
+	d.SetId("vpc-test")
+	d.Set("tags", map[string]interface{}{
+		"Environment":   "test",
+		"Owner":         "provider",
+		"Name":          "vpc",
+		"CreatedBy":     "console",
+		"acs:autoscale": "true",
+	})
+
+	if err := setTagsAll(d, client, map[string]interface{}{"Owner": "provider"}); err != nil {
+		t.Fatal(err)
+	}
+	if tags := d.Get("tags").(map[string]interface{}); !reflect.DeepEqual(tags, map[string]interface{}{"Owner": "provider", "Name": "vpc"}) {
+		t.Fatalf("unexpected tags: %v", tags)
+	}
+	if tagsAll := d.Get("tags_all").(map[string]interface{}); !reflect.DeepEqual(tagsAll, map[string]interface{}{"Environment": "test", "Owner": "provider", "Name": "vpc"}) {
+		t.Fatalf("unexpected tags_all: %v", tagsAll)
+	}
+	if tagsAll := getTagsAll(d, client); !reflect.DeepEqual(tagsAll, map[string]interface{}{"Environment": "test", "Owner": "provider", "Name": "vpc"}) {
+		t.Fatalf("unexpected tags merged with the default tags: %v", tagsAll)
+	}
+}
@@ -25,6 +25,7 @@ require (
 	github.com/denverdino/aliyungo v0.0.0-20200831100606-661b4d73f397
 	github.com/go-yaml/yaml v2.1.0+incompatible
 	github.com/google/uuid v1.1.2
+	github.com/hashicorp/go-cty v1.4.1-0.20200414143053-d3edf31b6320
 	github.com/hashicorp/go-uuid v1.0.1
 	github.com/hashicorp/terraform v0.13.5
 	github.com/hashicorp/terraform-plugin-sdk/v2 v2.7.0